@@ -5,7 +5,6 @@ go 1.23.0
 toolchain go1.24.9
 
 require (
-	github.com/cloudinary/cloudinary-go/v2 v2.7.0
 	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
 	github.com/oklog/ulid/v2 v2.1.0
@@ -32,6 +31,7 @@ require (
 	golang.org/x/text v0.28.0
 	golang.org/x/time v0.5.0
 	google.golang.org/api v0.180.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20250818200422-3122310a409c
 	google.golang.org/grpc v1.75.0
 	google.golang.org/protobuf v1.36.7
 	nhooyr.io/websocket v1.8.6
@@ -77,7 +77,6 @@ require (
 	golang.org/x/oauth2 v0.30.0 // indirect
 	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
-	google.golang.org/genproto/googleapis/api v0.0.0-20250818200422-3122310a409c // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250818200422-3122310a409c // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -14,6 +14,7 @@ import (
 	"exam-bank-system/apps/backend/internal/migration"
 	"exam-bank-system/apps/backend/internal/seeder"
 	"exam-bank-system/apps/backend/internal/server"
+	"exam-bank-system/apps/backend/internal/service/storage"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
 
 	_ "github.com/lib/pq"
@@ -133,6 +134,10 @@ func (a *App) initGRPCServer() error {
 	v1.RegisterUserServiceServer(a.grpcServer, a.container.GetEnhancedUserGRPCService())
 	v1.RegisterQuestionServiceServer(a.grpcServer, a.container.GetQuestionGRPCService())
 	v1.RegisterQuestionFilterServiceServer(a.grpcServer, a.container.GetQuestionFilterGRPCService())
+	v1.RegisterQuestionReviewServiceServer(a.grpcServer, a.container.GetQuestionReviewGRPCService())
+	v1.RegisterQuestionReportServiceServer(a.grpcServer, a.container.GetQuestionReportGRPCService())
+	v1.RegisterOrganisationServiceServer(a.grpcServer, a.container.GetOrganisationGRPCService())
+	v1.RegisterGuardianServiceServer(a.grpcServer, a.container.GetGuardianGRPCService())
 	v1.RegisterExamServiceServer(a.grpcServer, a.container.GetExamGRPCService())
 	v1.RegisterProfileServiceServer(a.grpcServer, a.container.GetProfileGRPCService())
 	v1.RegisterAdminServiceServer(a.grpcServer, a.container.GetAdminGRPCService())
@@ -175,6 +180,17 @@ func (a *App) Run() error {
 	a.container.StartMetricsScheduler()
 	log.Println("[OK] Metrics scheduler started (recording interval: 5 minutes, retention: 30 days)")
 
+	// Start JWT key rotation (no-op when signing with HS256)
+	a.container.StartJWTKeyRotation()
+
+	// Start weekly guardian progress digests
+	a.container.StartGuardianDigests()
+	a.container.StartPrivacyWorker()
+	a.container.StartLibraryUploadCleanup()
+	a.container.StartLibraryTextIndexer()
+	a.container.StartLibraryRecommendations()
+	a.container.StartSearchHistoryCleanup()
+
 	// Start MapCode event listener for cache invalidation
 	a.container.MapCodeMgmt.StartEventListener(context.Background())
 	log.Println("[OK] MapCode event listener started for cross-instance cache invalidation")
@@ -183,6 +199,16 @@ func (a *App) Run() error {
 	if a.config.Production.HTTPGatewayEnabled {
 		// Initialize HTTP server with gRPC-Gateway and gRPC-Web support
 		a.httpServer = server.NewHTTPServer(a.config.Server.HTTPPort, a.config.Server.GRPCPort, a.grpcServer)
+		a.httpServer.SetKeyRing(a.container.JWTKeyRing)
+		if local, ok := a.container.BlobStore.(*storage.LocalBlobStore); ok {
+			a.httpServer.SetBlobHandler(local.MountPath(), local)
+		}
+		if downloads := a.container.LibraryDownloadService; downloads != nil {
+			a.httpServer.SetDownloadHandler(downloads.MountPath(), downloads)
+		}
+		if uploads := a.container.LibraryUploadService; uploads != nil {
+			a.httpServer.SetUploadHandler(uploads.MountPath(), uploads)
+		}
 
 		// Start HTTP server in a goroutine
 		go func() {
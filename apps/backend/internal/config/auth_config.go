@@ -23,6 +23,15 @@ type AuthConfig struct {
 	// Rate Limiting Configuration
 	RateLimit RateLimitAuthConfig
 
+	// Two-Factor Configuration
+	TwoFactor TwoFactorAuthConfig
+
+	// Passwordless Configuration
+	Passwordless PasswordlessAuthConfig
+
+	// Login Risk Configuration
+	LoginRisk LoginRiskAuthConfig
+
 	// Feature Flags
 	Features AuthFeatureFlags
 }
@@ -41,6 +50,14 @@ type JWTAuthConfig struct {
 	// Token settings
 	Issuer           string
 	RefreshThreshold time.Duration // When to refresh token before expiry
+
+	// Asymmetric signing (RS256 or EdDSA); HS256 keeps using the shared secret
+	SigningAlgorithm    string
+	KeyRotationInterval time.Duration // Age at which a new signing key is created
+	KeyActivationDelay  time.Duration // Time a new key is published before it signs
+	KeyRefreshInterval  time.Duration // How often instances reload the keyring
+	KeyEncryptionKey    string        // Encrypts private keys at rest
+	AcceptLegacyHS256   bool          // Accept HS256 tokens issued before switching
 }
 
 // SessionAuthConfig holds session-specific configuration
@@ -66,6 +83,9 @@ type OAuthAuthConfig struct {
 	// Google OAuth
 	Google GoogleOAuthConfig
 
+	// Generic OpenID Connect providers (Microsoft, Keycloak, school SSO)
+	OIDCProviders []OIDCProviderConfig
+
 	// OAuth timeouts
 	Timeout       time.Duration
 	RetryAttempts int
@@ -80,6 +100,28 @@ type GoogleOAuthConfig struct {
 	Scopes       []string
 }
 
+// OIDCProviderConfig holds one generic OIDC provider, read from OIDC_<NAME>_* variables
+type OIDCProviderConfig struct {
+	Name         string // Lower-case provider key, e.g. "microsoft"
+	DisplayName  string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+
+	// Claim mapping; empty values use the standard OIDC claims
+	EmailClaim  string
+	NameClaim   string
+	AvatarClaim string
+	RoleClaim   string
+
+	// Role claim value -> user role, e.g. "teacher" -> "TEACHER"
+	RoleMapping map[string]string
+
+	// Treat emails as verified when the provider sends no email_verified claim
+	AssumeEmailVerified bool
+}
+
 // SecurityAuthConfig holds security-specific configuration
 type SecurityAuthConfig struct {
 	// Password hashing
@@ -116,12 +158,50 @@ type RateLimitAuthConfig struct {
 	SuspiciousActivityThreshold int
 }
 
+// TwoFactorAuthConfig holds TOTP two-factor configuration
+type TwoFactorAuthConfig struct {
+	Issuer        string   // Shown in authenticator apps
+	EnforcedRoles []string // Roles that must enroll before they can log in
+
+	// Login challenge
+	ChallengeTTL         time.Duration
+	MaxChallengeAttempts int
+
+	// Key used to encrypt TOTP secrets at rest
+	EncryptionKey string
+}
+
+// PasswordlessAuthConfig holds email code and magic-link login configuration
+type PasswordlessAuthConfig struct {
+	CodeTTL        time.Duration
+	MaxAttempts    int
+	ResendCooldown time.Duration // Minimum time between two emails to the same user
+
+	// Key used to hash stored codes
+	HashKey string
+}
+
+// LoginRiskAuthConfig holds adaptive login risk configuration
+type LoginRiskAuthConfig struct {
+	StepUpScore int // Risk scores at or above this require TOTP or an email code
+	DenyScore   int // Risk scores at or above this are refused
+
+	// Local CSV of IPv4 ranges (start,end,country,city,latitude,longitude) used for
+	// impossible-travel checks; the check is skipped when empty
+	GeoIPDatabasePath string
+
+	// Time zone the time-of-day check compares login hours in
+	Timezone string
+}
+
 // AuthFeatureFlags holds authentication feature flags
 type AuthFeatureFlags struct {
 	// Authentication methods
 	EnableEmailPassword bool
 	EnableGoogleOAuth   bool
+	EnableOIDC          bool
 	EnableTwoFactor     bool
+	EnablePasswordless  bool
 
 	// Email features
 	EnableEmailVerification  bool
@@ -161,6 +241,12 @@ func LoadAuthConfig() *AuthConfig {
 
 		Issuer:           "exam-bank-system",
 		RefreshThreshold: 5 * time.Minute, // Refresh 5 minutes before expiry
+
+		SigningAlgorithm:    getEnv("JWT_SIGNING_ALGORITHM", "RS256"),
+		KeyRotationInterval: time.Duration(getIntEnv("JWT_KEY_ROTATION_DAYS", 30)) * 24 * time.Hour,
+		KeyActivationDelay:  10 * time.Minute, // Two refresh intervals, so every instance has the key
+		KeyRefreshInterval:  5 * time.Minute,
+		AcceptLegacyHS256:   getBoolEnv("JWT_ACCEPT_LEGACY_HS256", true),
 	}
 
 	// Use separate secrets if provided, otherwise use main secret
@@ -170,6 +256,7 @@ func LoadAuthConfig() *AuthConfig {
 	if jwtConfig.RefreshSecret == "" {
 		jwtConfig.RefreshSecret = jwtConfig.Secret
 	}
+	jwtConfig.KeyEncryptionKey = getEnv("JWT_KEY_ENCRYPTION_KEY", jwtConfig.Secret)
 
 	// Session Configuration
 	sessionConfig := SessionAuthConfig{
@@ -196,6 +283,7 @@ func LoadAuthConfig() *AuthConfig {
 			RedirectURI:  getEnv("GOOGLE_REDIRECT_URI", "http://localhost:3000/api/auth/callback/google"),
 			Scopes:       []string{"openid", "email", "profile"},
 		},
+		OIDCProviders: loadOIDCProviders(),
 
 		Timeout:       30 * time.Second,
 		RetryAttempts: 3,
@@ -240,12 +328,44 @@ func LoadAuthConfig() *AuthConfig {
 		SuspiciousActivityThreshold: 80,
 	}
 
+	// Two-Factor Configuration
+	twoFactorConfig := TwoFactorAuthConfig{
+		Issuer:        getEnv("TWO_FACTOR_ISSUER", "NyNus"),
+		EnforcedRoles: parseRoleList(getEnv("TWO_FACTOR_ENFORCED_ROLES", "ADMIN,TEACHER")),
+
+		ChallengeTTL:         5 * time.Minute,
+		MaxChallengeAttempts: 5,
+
+		EncryptionKey: getEnv("TWO_FACTOR_ENCRYPTION_KEY", jwtConfig.Secret),
+	}
+
+	// Passwordless Configuration
+	passwordlessConfig := PasswordlessAuthConfig{
+		CodeTTL:        time.Duration(getIntEnv("PASSWORDLESS_CODE_TTL_MINUTES", 10)) * time.Minute,
+		MaxAttempts:    getIntEnv("PASSWORDLESS_MAX_ATTEMPTS", 5),
+		ResendCooldown: time.Minute,
+
+		HashKey: getEnv("PASSWORDLESS_HASH_KEY", jwtConfig.Secret),
+	}
+
+	// Login Risk Configuration
+	loginRiskConfig := LoginRiskAuthConfig{
+		StepUpScore: getIntEnv("LOGIN_RISK_STEP_UP_SCORE", 40),
+		DenyScore:   getIntEnv("LOGIN_RISK_DENY_SCORE", 85),
+
+		GeoIPDatabasePath: getEnv("LOGIN_RISK_GEOIP_DB", ""),
+
+		Timezone: getEnv("LOGIN_RISK_TIMEZONE", "Asia/Ho_Chi_Minh"),
+	}
+
 	// Feature Flags
 	featureFlags := AuthFeatureFlags{
 		// Authentication methods
 		EnableEmailPassword: true,
 		EnableGoogleOAuth:   oauthConfig.Google.Enabled,
-		EnableTwoFactor:     false, // Future feature
+		EnableOIDC:          len(oauthConfig.OIDCProviders) > 0,
+		EnableTwoFactor:     getBoolEnv("ENABLE_TWO_FACTOR", false),
+		EnablePasswordless:  getBoolEnv("ENABLE_PASSWORDLESS_LOGIN", true),
 
 		// Email features
 		EnableEmailVerification:  isProduction,
@@ -258,7 +378,7 @@ func LoadAuthConfig() *AuthConfig {
 		EnableConcurrentSessionLimits: false, // Simplified - disabled
 
 		// Security features
-		EnableRiskScoring:                 false, // Simplified - disabled
+		EnableRiskScoring:                 getBoolEnv("ENABLE_LOGIN_RISK_SCORING", false),
 		EnableSuspiciousActivityDetection: false, // Simplified - disabled
 		EnableAutoLogout:                  true,
 
@@ -268,13 +388,74 @@ func LoadAuthConfig() *AuthConfig {
 	}
 
 	return &AuthConfig{
-		JWT:       jwtConfig,
-		Session:   sessionConfig,
-		OAuth:     oauthConfig,
-		Security:  securityConfig,
-		RateLimit: rateLimitConfig,
-		Features:  featureFlags,
+		JWT:          jwtConfig,
+		Session:      sessionConfig,
+		OAuth:        oauthConfig,
+		Security:     securityConfig,
+		RateLimit:    rateLimitConfig,
+		TwoFactor:    twoFactorConfig,
+		Passwordless: passwordlessConfig,
+		LoginRisk:    loginRiskConfig,
+		Features:     featureFlags,
+	}
+}
+
+// parseRoleList splits a comma-separated role list, e.g. "ADMIN,TEACHER"
+func parseRoleList(value string) []string {
+	var roles []string
+	for _, role := range strings.Split(value, ",") {
+		if role = strings.ToUpper(strings.TrimSpace(role)); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// loadOIDCProviders reads the providers listed in OIDC_PROVIDERS, e.g.
+// OIDC_PROVIDERS=microsoft,keycloak with OIDC_MICROSOFT_ISSUER_URL, OIDC_MICROSOFT_CLIENT_ID, ...
+func loadOIDCProviders() []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range strings.Split(getEnv("OIDC_PROVIDERS", ""), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			DisplayName:  getEnv(prefix+"DISPLAY_NAME", name),
+			IssuerURL:    getEnv(prefix+"ISSUER_URL", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "openid email profile")),
+
+			EmailClaim:  getEnv(prefix+"EMAIL_CLAIM", ""),
+			NameClaim:   getEnv(prefix+"NAME_CLAIM", ""),
+			AvatarClaim: getEnv(prefix+"AVATAR_CLAIM", ""),
+			RoleClaim:   getEnv(prefix+"ROLE_CLAIM", ""),
+			RoleMapping: parseRoleMapping(getEnv(prefix+"ROLE_MAPPING", "")),
+
+			AssumeEmailVerified: getBoolEnv(prefix+"ASSUME_EMAIL_VERIFIED", false),
+		})
+	}
+	return providers
+}
+
+// parseRoleMapping parses "claim-value:ROLE" pairs, e.g. "teacher:TEACHER,student:STUDENT"
+func parseRoleMapping(value string) map[string]string {
+	mapping := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		claimValue, role, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		claimValue, role = strings.TrimSpace(claimValue), strings.ToUpper(strings.TrimSpace(role))
+		if claimValue != "" && role != "" {
+			mapping[claimValue] = role
+		}
 	}
+	return mapping
 }
 
 // GetJWTSecret returns the appropriate JWT secret based on token type
@@ -296,8 +477,14 @@ func (c *AuthConfig) IsFeatureEnabled(feature string) bool {
 		return c.Features.EnableEmailPassword
 	case "google_oauth":
 		return c.Features.EnableGoogleOAuth
+	case "oidc":
+		return c.Features.EnableOIDC
 	case "two_factor":
 		return c.Features.EnableTwoFactor
+	case "passwordless":
+		return c.Features.EnablePasswordless
+	case "risk_scoring":
+		return c.Features.EnableRiskScoring
 	case "email_verification":
 		return c.Features.EnableEmailVerification
 	case "password_reset":
@@ -350,6 +537,13 @@ func (c *AuthConfig) ValidateAuthConfig() error {
 		return fmt.Errorf("JWT secret must be set and not use default value")
 	}
 
+	// Validate signing algorithm
+	switch c.JWT.SigningAlgorithm {
+	case "HS256", "RS256", "EdDSA":
+	default:
+		return fmt.Errorf("JWT signing algorithm must be HS256, RS256 or EdDSA, got %q", c.JWT.SigningAlgorithm)
+	}
+
 	// Validate OAuth configuration if enabled
 	if c.Features.EnableGoogleOAuth {
 		if c.OAuth.Google.ClientID == "" || c.OAuth.Google.ClientSecret == "" {
@@ -357,6 +551,16 @@ func (c *AuthConfig) ValidateAuthConfig() error {
 		}
 	}
 
+	// Validate generic OIDC providers
+	for _, provider := range c.OAuth.OIDCProviders {
+		if provider.IssuerURL == "" || provider.ClientID == "" {
+			return fmt.Errorf("OIDC provider %q requires ISSUER_URL and CLIENT_ID", provider.Name)
+		}
+		if provider.Name == "google" {
+			return fmt.Errorf("OIDC provider name %q is reserved for Google OAuth", provider.Name)
+		}
+	}
+
 	// Validate bcrypt cost
 	if c.Security.BcryptCost < 10 || c.Security.BcryptCost > 15 {
 		return fmt.Errorf("bcrypt cost must be between 10 and 15, got %d", c.Security.BcryptCost)
@@ -24,11 +24,26 @@ type Config struct {
 	// Image Processing configuration
 	ImageProcessing ImageProcessingConfig
 
-	// Google Drive configuration
-	GoogleDrive GoogleDriveConfig
+	// Blob storage configuration
+	Storage StorageConfig
 
-	// Cloudinary configuration
-	Cloudinary CloudinaryConfig
+	// Signed library download configuration
+	Downloads DownloadConfig
+
+	// Resumable library upload configuration
+	Uploads UploadConfig
+
+	// Full-text indexing of library PDFs
+	TextIndex TextIndexConfig
+
+	// Nightly library recommendations
+	Recommendations RecommendationConfig
+
+	// Library and question search history
+	SearchHistory SearchHistoryConfig
+
+	// Moderation queue of library uploads and contributed questions
+	Moderation ModerationConfig
 
 	// Redis configuration
 	Redis RedisConfig
@@ -72,23 +87,70 @@ type ImageProcessingConfig struct {
 	MaxConcurrency int
 }
 
-// GoogleDriveConfig holds Google Drive configuration
-type GoogleDriveConfig struct {
+// StorageConfig holds blob storage configuration
+type StorageConfig struct {
+	Driver        string // local or s3
+	LocalRoot     string
+	LocalBaseURL  string // Absolute URL the local driver's handler is served at
+	SigningKey    string // HMAC key of local presigned links
+	PublicBaseURL string // Optional CDN or bucket URL serving public/ keys
+
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UsePathStyle    bool
+}
+
+// DownloadConfig holds signed library download link configuration
+type DownloadConfig struct {
+	Enabled        bool
+	BaseURL        string // Absolute URL the download handler is served at
+	SigningKey     string // HMAC key of download links
+	LinkTTLSeconds int
+	MaxPerDay      int // Per-user downloads over a rolling 24 hours; 0 disables the limit
+}
+
+// UploadConfig holds resumable (tus) library upload configuration
+type UploadConfig struct {
+	Enabled           bool
+	BaseURL           string // Absolute URL the tus handler is served at
+	StagingDir        string // Local directory holding partial uploads
+	MaxActiveSessions int    // Unfinished uploads per user
+	MaxGBPerDay       int    // Bytes a user may upload over a rolling 24 hours, in GB
+	SessionTTLHours   int    // Idle time before an unfinished upload expires
+}
+
+// TextIndexConfig holds full-text indexing of library book and exam PDFs
+type TextIndexConfig struct {
+	Enabled         bool
+	IntervalMinutes int // How often new and changed files are indexed
+	BatchSize       int // Files indexed per pass
+	MaxPages        int // Pages indexed per file
+}
+
+// RecommendationConfig holds the nightly computation of library recommendations
+type RecommendationConfig struct {
 	Enabled      bool
-	ClientID     string
-	ClientSecret string
-	RefreshToken string
-	RootFolderID string
+	RunHour      int // Local hour from which the nightly run starts
+	LookbackDays int // Usage and exam results older than this are ignored
+	PerUser      int // Recommendations kept per student
 }
 
-// CloudinaryConfig holds Cloudinary configuration
-type CloudinaryConfig struct {
-	Enabled    bool
-	CloudName  string
-	APIKey     string
-	APISecret  string
-	Folder     string // e.g., "exam-bank/questions"
-	UseRealSDK bool   // Flag to switch between simulation and real SDK
+// SearchHistoryConfig holds logging of library and question searches
+type SearchHistoryConfig struct {
+	Enabled          bool
+	RetentionDays    int // Searches older than this are deleted
+	TrendingDays     int // Window of trending suggestions
+	MinTrendingUsers int // Distinct users a query needs to be suggested as trending
+}
+
+// ModerationConfig holds the moderation queue of pending library items and questions
+type ModerationConfig struct {
+	SLAHours      int // Submissions waiting longer are overdue
+	WorkloadDays  int // Window of reviewer workload metrics
+	MaxBulkAction int // Targets per bulk approve or reject
 }
 
 // RedisConfig holds Redis configuration
@@ -138,20 +200,56 @@ func LoadConfig() *Config {
 			ImageQuality:   85,
 			MaxConcurrency: 5,
 		},
-		GoogleDrive: GoogleDriveConfig{
-			Enabled:      getEnv("GOOGLE_DRIVE_ENABLED", "false") == "true",
-			ClientID:     getEnv("DRIVE_CLIENT_ID", ""),
-			ClientSecret: getEnv("DRIVE_CLIENT_SECRET", ""),
-			RefreshToken: getEnv("DRIVE_REFRESH_TOKEN", ""),
-			RootFolderID: getEnv("DRIVE_ROOT_FOLDER_ID", ""),
+		Storage: StorageConfig{
+			Driver:            getEnv("STORAGE_DRIVER", "local"),
+			LocalRoot:         getEnv("STORAGE_LOCAL_ROOT", "./storage"),
+			LocalBaseURL:      getEnv("STORAGE_LOCAL_BASE_URL", "http://localhost:8080/blobs"),
+			SigningKey:        getEnv("STORAGE_SIGNING_KEY", ""),
+			PublicBaseURL:     getEnv("STORAGE_PUBLIC_BASE_URL", ""),
+			S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+			S3Region:          getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:          getEnv("S3_BUCKET", ""),
+			S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+			S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+			S3UsePathStyle:    getEnv("S3_USE_PATH_STYLE", "true") == "true",
+		},
+		Downloads: DownloadConfig{
+			Enabled:        getEnv("DOWNLOAD_LINKS_ENABLED", "true") == "true",
+			BaseURL:        getEnv("DOWNLOAD_BASE_URL", "http://localhost:8080/downloads"),
+			SigningKey:     getEnv("DOWNLOAD_SIGNING_KEY", ""),
+			LinkTTLSeconds: getIntEnv("DOWNLOAD_LINK_TTL_SECONDS", 300),
+			MaxPerDay:      getIntEnv("LIBRARY_DOWNLOADS_PER_DAY", 20),
 		},
-		Cloudinary: CloudinaryConfig{
-			Enabled:    getEnv("CLOUDINARY_ENABLED", "false") == "true",
-			CloudName:  getEnv("CLOUDINARY_CLOUD_NAME", ""),
-			APIKey:     getEnv("CLOUDINARY_API_KEY", ""),
-			APISecret:  getEnv("CLOUDINARY_API_SECRET", ""),
-			Folder:     getEnv("CLOUDINARY_FOLDER", "exam-bank/questions"),
-			UseRealSDK: getEnv("CLOUDINARY_USE_REAL_SDK", "false") == "true",
+		Uploads: UploadConfig{
+			Enabled:           getEnv("LIBRARY_UPLOADS_ENABLED", "true") == "true",
+			BaseURL:           getEnv("LIBRARY_UPLOAD_BASE_URL", "http://localhost:8080/uploads"),
+			StagingDir:        getEnv("LIBRARY_UPLOAD_STAGING_DIR", "./tmp/uploads"),
+			MaxActiveSessions: getIntEnv("LIBRARY_UPLOAD_MAX_ACTIVE", 5),
+			MaxGBPerDay:       getIntEnv("LIBRARY_UPLOAD_GB_PER_DAY", 10),
+			SessionTTLHours:   getIntEnv("LIBRARY_UPLOAD_TTL_HOURS", 24),
+		},
+		TextIndex: TextIndexConfig{
+			Enabled:         getEnv("LIBRARY_TEXT_INDEX_ENABLED", "true") == "true",
+			IntervalMinutes: getIntEnv("LIBRARY_TEXT_INDEX_INTERVAL_MINUTES", 5),
+			BatchSize:       getIntEnv("LIBRARY_TEXT_INDEX_BATCH_SIZE", 20),
+			MaxPages:        getIntEnv("LIBRARY_TEXT_INDEX_MAX_PAGES", 2000),
+		},
+		Recommendations: RecommendationConfig{
+			Enabled:      getEnv("LIBRARY_RECOMMENDATIONS_ENABLED", "true") == "true",
+			RunHour:      getIntEnv("LIBRARY_RECOMMENDATIONS_RUN_HOUR", 2),
+			LookbackDays: getIntEnv("LIBRARY_RECOMMENDATIONS_LOOKBACK_DAYS", 180),
+			PerUser:      getIntEnv("LIBRARY_RECOMMENDATIONS_PER_USER", 30),
+		},
+		SearchHistory: SearchHistoryConfig{
+			Enabled:          getEnv("SEARCH_HISTORY_ENABLED", "true") == "true",
+			RetentionDays:    getIntEnv("SEARCH_HISTORY_RETENTION_DAYS", 180),
+			TrendingDays:     getIntEnv("SEARCH_TRENDING_DAYS", 7),
+			MinTrendingUsers: getIntEnv("SEARCH_TRENDING_MIN_USERS", 3),
+		},
+		Moderation: ModerationConfig{
+			SLAHours:      getIntEnv("MODERATION_SLA_HOURS", 48),
+			WorkloadDays:  getIntEnv("MODERATION_WORKLOAD_DAYS", 30),
+			MaxBulkAction: getIntEnv("MODERATION_MAX_BULK", 100),
 		},
 		Redis: RedisConfig{
 			URL:          getEnv("REDIS_URL", "redis://localhost:6379"),
@@ -208,9 +306,39 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("image processing validation failed: %w", err)
 	}
 
-	// Validate Google Drive configuration
-	if err := c.validateGoogleDrive(); err != nil {
-		return fmt.Errorf("Google Drive validation failed: %w", err)
+	// Validate blob storage configuration
+	if err := c.validateStorage(); err != nil {
+		return fmt.Errorf("storage validation failed: %w", err)
+	}
+
+	// Validate signed download configuration
+	if err := c.validateDownloads(); err != nil {
+		return fmt.Errorf("download validation failed: %w", err)
+	}
+
+	// Validate resumable upload configuration
+	if err := c.validateUploads(); err != nil {
+		return fmt.Errorf("upload validation failed: %w", err)
+	}
+
+	// Validate full-text indexing configuration
+	if err := c.validateTextIndex(); err != nil {
+		return fmt.Errorf("text index validation failed: %w", err)
+	}
+
+	// Validate recommendation configuration
+	if err := c.validateRecommendations(); err != nil {
+		return fmt.Errorf("recommendation validation failed: %w", err)
+	}
+
+	// Validate search history configuration
+	if err := c.validateSearchHistory(); err != nil {
+		return fmt.Errorf("search history validation failed: %w", err)
+	}
+
+	// Validate moderation configuration
+	if err := c.validateModeration(); err != nil {
+		return fmt.Errorf("moderation validation failed: %w", err)
 	}
 
 	return nil
@@ -341,23 +469,142 @@ func (c *Config) validateImageProcessing() error {
 	return nil
 }
 
-// validateGoogleDrive validates Google Drive configuration
-func (c *Config) validateGoogleDrive() error {
-	if !c.GoogleDrive.Enabled {
+// validateStorage validates blob storage configuration
+func (c *Config) validateStorage() error {
+	switch c.Storage.Driver {
+	case "local":
+		if c.Storage.LocalRoot == "" {
+			return fmt.Errorf("STORAGE_LOCAL_ROOT is required for the local driver")
+		}
+		if c.Storage.LocalBaseURL == "" {
+			return fmt.Errorf("STORAGE_LOCAL_BASE_URL is required for the local driver")
+		}
+	case "s3":
+		if c.Storage.S3Endpoint == "" {
+			return fmt.Errorf("S3_ENDPOINT is required for the s3 driver")
+		}
+		if c.Storage.S3Bucket == "" {
+			return fmt.Errorf("S3_BUCKET is required for the s3 driver")
+		}
+		if c.Storage.S3AccessKeyID == "" || c.Storage.S3SecretAccessKey == "" {
+			return fmt.Errorf("S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required for the s3 driver")
+		}
+	default:
+		return fmt.Errorf("STORAGE_DRIVER must be local or s3, got: %s", c.Storage.Driver)
+	}
+
+	return nil
+}
+
+// validateDownloads validates signed library download configuration
+func (c *Config) validateDownloads() error {
+	if !c.Downloads.Enabled {
 		return nil // Skip validation if disabled
 	}
+	if c.Downloads.BaseURL == "" {
+		return fmt.Errorf("DOWNLOAD_BASE_URL is required when download links are enabled")
+	}
+	if c.Downloads.LinkTTLSeconds <= 0 {
+		return fmt.Errorf("DOWNLOAD_LINK_TTL_SECONDS must be positive, got: %d", c.Downloads.LinkTTLSeconds)
+	}
+	if c.Downloads.MaxPerDay < 0 {
+		return fmt.Errorf("LIBRARY_DOWNLOADS_PER_DAY cannot be negative, got: %d", c.Downloads.MaxPerDay)
+	}
+
+	return nil
+}
+
+// validateUploads validates resumable library upload configuration
+func (c *Config) validateUploads() error {
+	if !c.Uploads.Enabled {
+		return nil // Skip validation if disabled
+	}
+	if c.Uploads.BaseURL == "" {
+		return fmt.Errorf("LIBRARY_UPLOAD_BASE_URL is required when uploads are enabled")
+	}
+	if c.Uploads.StagingDir == "" {
+		return fmt.Errorf("LIBRARY_UPLOAD_STAGING_DIR is required when uploads are enabled")
+	}
+	if c.Uploads.MaxActiveSessions <= 0 {
+		return fmt.Errorf("LIBRARY_UPLOAD_MAX_ACTIVE must be positive, got: %d", c.Uploads.MaxActiveSessions)
+	}
+	if c.Uploads.MaxGBPerDay <= 0 {
+		return fmt.Errorf("LIBRARY_UPLOAD_GB_PER_DAY must be positive, got: %d", c.Uploads.MaxGBPerDay)
+	}
+	if c.Uploads.SessionTTLHours <= 0 {
+		return fmt.Errorf("LIBRARY_UPLOAD_TTL_HOURS must be positive, got: %d", c.Uploads.SessionTTLHours)
+	}
+
+	return nil
+}
 
-	if c.GoogleDrive.ClientID == "" {
-		return fmt.Errorf("DRIVE_CLIENT_ID is required when Google Drive is enabled")
+// validateTextIndex validates full-text indexing configuration
+func (c *Config) validateTextIndex() error {
+	if !c.TextIndex.Enabled {
+		return nil // Skip validation if disabled
 	}
-	if c.GoogleDrive.ClientSecret == "" {
-		return fmt.Errorf("DRIVE_CLIENT_SECRET is required when Google Drive is enabled")
+	if c.TextIndex.IntervalMinutes <= 0 {
+		return fmt.Errorf("LIBRARY_TEXT_INDEX_INTERVAL_MINUTES must be positive, got: %d", c.TextIndex.IntervalMinutes)
+	}
+	if c.TextIndex.BatchSize <= 0 {
+		return fmt.Errorf("LIBRARY_TEXT_INDEX_BATCH_SIZE must be positive, got: %d", c.TextIndex.BatchSize)
+	}
+	if c.TextIndex.MaxPages <= 0 {
+		return fmt.Errorf("LIBRARY_TEXT_INDEX_MAX_PAGES must be positive, got: %d", c.TextIndex.MaxPages)
+	}
+
+	return nil
+}
+
+// validateRecommendations validates recommendation configuration
+func (c *Config) validateRecommendations() error {
+	if !c.Recommendations.Enabled {
+		return nil // Skip validation if disabled
+	}
+	if c.Recommendations.RunHour < 0 || c.Recommendations.RunHour > 23 {
+		return fmt.Errorf("LIBRARY_RECOMMENDATIONS_RUN_HOUR must be between 0 and 23, got: %d", c.Recommendations.RunHour)
+	}
+	if c.Recommendations.LookbackDays <= 0 {
+		return fmt.Errorf("LIBRARY_RECOMMENDATIONS_LOOKBACK_DAYS must be positive, got: %d", c.Recommendations.LookbackDays)
+	}
+	if c.Recommendations.PerUser <= 0 {
+		return fmt.Errorf("LIBRARY_RECOMMENDATIONS_PER_USER must be positive, got: %d", c.Recommendations.PerUser)
+	}
+
+	return nil
+}
+
+// validateSearchHistory validates search history configuration
+func (c *Config) validateSearchHistory() error {
+	if !c.SearchHistory.Enabled {
+		return nil // Skip validation if disabled
+	}
+	if c.SearchHistory.RetentionDays <= 0 {
+		return fmt.Errorf("SEARCH_HISTORY_RETENTION_DAYS must be positive, got: %d", c.SearchHistory.RetentionDays)
+	}
+	if c.SearchHistory.TrendingDays <= 0 {
+		return fmt.Errorf("SEARCH_TRENDING_DAYS must be positive, got: %d", c.SearchHistory.TrendingDays)
+	}
+	if c.SearchHistory.TrendingDays > c.SearchHistory.RetentionDays {
+		return fmt.Errorf("SEARCH_TRENDING_DAYS (%d) must not exceed SEARCH_HISTORY_RETENTION_DAYS (%d)", c.SearchHistory.TrendingDays, c.SearchHistory.RetentionDays)
+	}
+	if c.SearchHistory.MinTrendingUsers <= 0 {
+		return fmt.Errorf("SEARCH_TRENDING_MIN_USERS must be positive, got: %d", c.SearchHistory.MinTrendingUsers)
+	}
+
+	return nil
+}
+
+// validateModeration validates moderation queue configuration
+func (c *Config) validateModeration() error {
+	if c.Moderation.SLAHours <= 0 {
+		return fmt.Errorf("MODERATION_SLA_HOURS must be positive, got: %d", c.Moderation.SLAHours)
 	}
-	if c.GoogleDrive.RefreshToken == "" {
-		return fmt.Errorf("DRIVE_REFRESH_TOKEN is required when Google Drive is enabled")
+	if c.Moderation.WorkloadDays <= 0 {
+		return fmt.Errorf("MODERATION_WORKLOAD_DAYS must be positive, got: %d", c.Moderation.WorkloadDays)
 	}
-	if c.GoogleDrive.RootFolderID == "" {
-		return fmt.Errorf("DRIVE_ROOT_FOLDER_ID is required when Google Drive is enabled")
+	if c.Moderation.MaxBulkAction <= 0 {
+		return fmt.Errorf("MODERATION_MAX_BULK must be positive, got: %d", c.Moderation.MaxBulkAction)
 	}
 
 	return nil
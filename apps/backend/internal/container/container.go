@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -20,6 +21,9 @@ import (
 	"exam-bank-system/apps/backend/internal/repository/interfaces"
 	"exam-bank-system/apps/backend/internal/server"
 	"exam-bank-system/apps/backend/internal/service/auth"
+	"exam-bank-system/apps/backend/internal/service/auth/apikey"
+	"exam-bank-system/apps/backend/internal/service/auth/rbac"
+	"exam-bank-system/apps/backend/internal/service/auth/risk"
 	book_mgmt "exam-bank-system/apps/backend/internal/service/content/book"
 	contact_mgmt "exam-bank-system/apps/backend/internal/service/content/contact"
 	mapcode_mgmt "exam-bank-system/apps/backend/internal/service/content/mapcode"
@@ -28,21 +32,37 @@ import (
 	"exam-bank-system/apps/backend/internal/service/exam/scoring"
 	"exam-bank-system/apps/backend/internal/service/focus"
 	bookmarksvc "exam-bank-system/apps/backend/internal/service/library/bookmark"
+	"exam-bank-system/apps/backend/internal/service/library/collection"
+	"exam-bank-system/apps/backend/internal/service/library/download"
+	"exam-bank-system/apps/backend/internal/service/library/moderation"
 	ratingsvc "exam-bank-system/apps/backend/internal/service/library/rating"
+	"exam-bank-system/apps/backend/internal/service/library/recommend"
+	"exam-bank-system/apps/backend/internal/service/library/textindex"
+	"exam-bank-system/apps/backend/internal/service/library/upload"
+	"exam-bank-system/apps/backend/internal/service/library/versioning"
 	videosvc "exam-bank-system/apps/backend/internal/service/library/video"
 	"exam-bank-system/apps/backend/internal/service/metrics"
 	"exam-bank-system/apps/backend/internal/service/notification"
+	"exam-bank-system/apps/backend/internal/service/organisation"
 	"exam-bank-system/apps/backend/internal/service/question"
+	"exam-bank-system/apps/backend/internal/service/searchhistory"
+	"exam-bank-system/apps/backend/internal/service/storage"
 	system "exam-bank-system/apps/backend/internal/service/system"
 	"exam-bank-system/apps/backend/internal/service/system/analytics"
 	image_processing "exam-bank-system/apps/backend/internal/service/system/image_processing"
 	"exam-bank-system/apps/backend/internal/service/system/performance"
 	"exam-bank-system/apps/backend/internal/service/system/security"
+	"exam-bank-system/apps/backend/internal/service/user/guardian"
 	"exam-bank-system/apps/backend/internal/service/user/oauth"
+	"exam-bank-system/apps/backend/internal/service/user/passwordless"
+	"exam-bank-system/apps/backend/internal/service/user/privacy"
 	"exam-bank-system/apps/backend/internal/service/user/session"
+	"exam-bank-system/apps/backend/internal/service/user/twofactor"
 	"exam-bank-system/apps/backend/internal/services/email"
 	"exam-bank-system/apps/backend/internal/util"
+	"exam-bank-system/apps/backend/internal/validation"
 	"exam-bank-system/apps/backend/internal/websocket"
+	"exam-bank-system/apps/backend/pkg/proto/common"
 	"github.com/sirupsen/logrus"
 )
 
@@ -68,33 +88,53 @@ type Container struct {
 	OpenSearchConfig *opensearch.Config
 
 	// Repositories
-	UserRepo               *repository.UserRepository // Legacy repository
-	UserRepoWrapper        repository.IUserRepository // Interface implementation
-	AnswerRepo             *repository.AnswerRepository
-	SessionRepo            repository.SessionRepository
-	OAuthAccountRepo       repository.OAuthAccountRepository
-	ResourceAccessRepo     repository.ResourceAccessRepository
-	EnrollmentRepo         repository.EnrollmentRepository
-	NotificationRepo       repository.NotificationRepository
-	UserPreferenceRepo     repository.UserPreferenceRepository
-	AuditLogRepo           repository.AuditLogRepository
-	RefreshTokenRepo       *repository.RefreshTokenRepository // NEW: Refresh token rotation support
-	QuestionRepo           interfaces.QuestionRepository
-	QuestionCodeRepo       interfaces.QuestionCodeRepository
-	QuestionImageRepo      interfaces.QuestionImageRepository
-	QuestionVersionRepo    repository.QuestionVersionRepository // NEW: Version control support
-	ExamRepo               interfaces.ExamRepository
-	ContactRepo            *repository.ContactRepository
-	NewsletterRepo         *repository.NewsletterRepository
-	MapCodeRepo            *repository.MapCodeRepository
-	MapCodeTranslationRepo *repository.MapCodeTranslationRepository
-	BookRepo               repository.BookRepository
-	LibraryExamRepo        repository.LibraryExamRepository
-	LibraryVideoRepo       repository.LibraryVideoRepository
-	ItemRatingRepo         repository.ItemRatingRepository
-	UserBookmarkRepo       repository.UserBookmarkRepository
-	LibraryItemRepo        repository.LibraryItemRepository
-	MetricsRepo            interfaces.MetricsRepository // NEW: Metrics history repository
+	UserRepo                  *repository.UserRepository // Legacy repository
+	UserRepoWrapper           repository.IUserRepository // Interface implementation
+	AnswerRepo                *repository.AnswerRepository
+	SessionRepo               repository.SessionRepository
+	OAuthAccountRepo          repository.OAuthAccountRepository
+	ResourceAccessRepo        repository.ResourceAccessRepository
+	EnrollmentRepo            repository.EnrollmentRepository
+	NotificationRepo          repository.NotificationRepository
+	UserPreferenceRepo        repository.UserPreferenceRepository
+	AuditLogRepo              repository.AuditLogRepository
+	RefreshTokenRepo          *repository.RefreshTokenRepository // NEW: Refresh token rotation support
+	TwoFactorRepo             *repository.TwoFactorRepository
+	LoginCodeRepo             *repository.LoginCodeRepository
+	OIDCLoginStateRepo        *repository.OIDCLoginStateRepository
+	PermissionRepo            *repository.PermissionRepository
+	OrganisationRepo          *repository.OrganisationRepository
+	GuardianLinkRepo          *repository.GuardianLinkRepository
+	PrivacyRepo               *repository.PrivacyRepository
+	LibraryUploadRepo         *repository.LibraryUploadRepository
+	LibraryTextIndexRepo      *repository.LibraryTextIndexRepository
+	LibraryRecommendationRepo *repository.LibraryRecommendationRepository
+	SearchHistoryRepo         *repository.SearchHistoryRepository
+	LibraryCollectionRepo     *repository.LibraryCollectionRepository
+	LibraryItemVersionRepo    *repository.LibraryItemVersionRepository
+	ModerationRepo            *repository.ModerationRepository
+	SecurityEventRepo         *repository.SecurityEventRepository
+	LoginHistoryRepo          *repository.LoginHistoryRepository
+	APIKeyRepo                *repository.APIKeyRepository
+	JWTSigningKeyRepo         *repository.JWTSigningKeyRepository
+	QuestionRepo              interfaces.QuestionRepository
+	QuestionCodeRepo          interfaces.QuestionCodeRepository
+	QuestionImageRepo         interfaces.QuestionImageRepository
+	QuestionVersionRepo       repository.QuestionVersionRepository // NEW: Version control support
+	QuestionReviewRepo        repository.QuestionReviewRepository
+	QuestionReportRepo        repository.QuestionReportRepository
+	ExamRepo                  interfaces.ExamRepository
+	ContactRepo               *repository.ContactRepository
+	NewsletterRepo            *repository.NewsletterRepository
+	MapCodeRepo               *repository.MapCodeRepository
+	MapCodeTranslationRepo    *repository.MapCodeTranslationRepository
+	BookRepo                  repository.BookRepository
+	LibraryExamRepo           repository.LibraryExamRepository
+	LibraryVideoRepo          repository.LibraryVideoRepository
+	ItemRatingRepo            repository.ItemRatingRepository
+	UserBookmarkRepo          repository.UserBookmarkRepository
+	LibraryItemRepo           repository.LibraryItemRepository
+	MetricsRepo               interfaces.MetricsRepository // NEW: Metrics history repository
 
 	// Focus Room Repositories
 	FocusRoomRepo      interfaces.FocusRoomRepository
@@ -106,11 +146,17 @@ type Container struct {
 	ChatMessageRepo    interfaces.ChatMessageRepository
 	AchievementRepo    interfaces.AchievementRepository
 
+	// Blob storage for library files and question images
+	BlobStore storage.BlobStore
+
 	// Services
 	AuthMgmt               *auth.AuthMgmt
 	QuestionService        *question.QuestionService
 	QuestionFilterService  *question.QuestionFilterService
 	QuestionVersionService *question.VersionService // NEW: Version control service
+	QuestionReviewService  *question.ReviewService
+	QuestionReportService  *question.ReportService
+	OrganisationService    *organisation.Service
 	ExamService            *exam.ExamService
 	ContactMgmt            *contact_mgmt.ContactMgmt
 	NewsletterMgmt         *newsletter_mgmt.NewsletterMgmt
@@ -119,10 +165,27 @@ type Container struct {
 	LibraryVideoService    *videosvc.Service
 	LibraryRatingService   *ratingsvc.Service
 	LibraryBookmarkService *bookmarksvc.Service
+	LibraryDownloadService *download.Service      // Nil when signed download links are disabled
+	LibraryUploadService   *upload.Service        // Nil when resumable uploads are disabled
+	LibraryTextIndexer     *textindex.Service     // Nil when full-text indexing or OpenSearch is disabled
+	LibraryRecommendations *recommend.Service     // Nil when recommendations are disabled
+	SearchHistory          *searchhistory.Service // Nil when search history is disabled
+	LibraryCollections     *collection.Service
+	LibraryVersions        *versioning.Service
+	LibraryModeration      *moderation.Service
+	LibraryPageIndex       *opensearch.LibraryPageRepository
 	AutoGradingService     *scoring.AutoGradingService // NEW: Auto-grading service for exams
 	UnifiedJWTService      *auth.UnifiedJWTService     // UNIFIED: Single JWT service replacing JWTService and EnhancedJWTService
+	JWTKeyRing             *auth.KeyRing               // Asymmetric signing keys (nil when using HS256)
 	OAuthService           *oauth.OAuthService
 	SessionService         *session.SessionService
+	TwoFactorService       *twofactor.TwoFactorService
+	PasswordlessService    *passwordless.PasswordlessService
+	GuardianService        *guardian.Service
+	PrivacyService         *privacy.Service
+	PermissionEvaluator    *rbac.Evaluator
+	LoginRiskEvaluator     *risk.Evaluator // nil unless ENABLE_LOGIN_RISK_SCORING
+	APIKeyService          *apikey.Service
 	NotificationSvc        *notification.NotificationService
 	ResourceProtectionSvc  *system.ResourceProtectionService
 	EmailService           *email.EmailService
@@ -174,6 +237,10 @@ type Container struct {
 	BookGRPCService           *grpc.BookServiceServer
 	LibraryGRPCService        *grpc.LibraryServiceServer
 	AnalyticsGRPCService      *grpc.AnalyticsServiceServer // NEW: Analytics gRPC service
+	QuestionReviewGRPCService *grpc.QuestionReviewServiceServer
+	QuestionReportGRPCService *grpc.QuestionReportServiceServer
+	OrganisationGRPCService   *grpc.OrganisationServiceServer
+	GuardianGRPCService       *grpc.GuardianServiceServer
 	FocusRoomGRPCService      *grpc.FocusRoomServiceServer // NEW: Focus Room gRPC service
 
 	// Configuration
@@ -247,6 +314,10 @@ func (c *Container) initOpenSearch() {
 		}
 	}
 
+	if index := os.Getenv("OPENSEARCH_LIBRARY_PAGES_INDEX"); index != "" {
+		c.OpenSearchConfig.LibraryPagesIndex = index
+	}
+
 	// Create OpenSearch client
 	client, err := opensearch.NewClient(c.OpenSearchConfig)
 	if err != nil {
@@ -295,6 +366,26 @@ func (c *Container) initRepositories() {
 
 	// Initialize QuestionVersionRepository for version control
 	c.QuestionVersionRepo = repository.NewQuestionVersionRepository(c.DBX)
+	c.QuestionReviewRepo = repository.NewQuestionReviewRepository(c.DB)
+	c.QuestionReportRepo = repository.NewQuestionReportRepository(c.DB)
+	c.TwoFactorRepo = repository.NewTwoFactorRepository(c.DB)
+	c.LoginCodeRepo = repository.NewLoginCodeRepository(c.DB)
+	c.OIDCLoginStateRepo = repository.NewOIDCLoginStateRepository(c.DB)
+	c.PermissionRepo = repository.NewPermissionRepository(c.DB)
+	c.OrganisationRepo = repository.NewOrganisationRepository(c.DB)
+	c.GuardianLinkRepo = repository.NewGuardianLinkRepository(c.DB)
+	c.PrivacyRepo = repository.NewPrivacyRepository(c.DB)
+	c.LibraryUploadRepo = repository.NewLibraryUploadRepository(c.DB)
+	c.LibraryTextIndexRepo = repository.NewLibraryTextIndexRepository(c.DB)
+	c.LibraryRecommendationRepo = repository.NewLibraryRecommendationRepository(c.DB)
+	c.SearchHistoryRepo = repository.NewSearchHistoryRepository(c.DB)
+	c.LibraryCollectionRepo = repository.NewLibraryCollectionRepository(c.DB)
+	c.LibraryItemVersionRepo = repository.NewLibraryItemVersionRepository(c.DB)
+	c.ModerationRepo = repository.NewModerationRepository(c.DB)
+	c.SecurityEventRepo = repository.NewSecurityEventRepository(c.DB, repoLogger)
+	c.LoginHistoryRepo = repository.NewLoginHistoryRepository(c.DB)
+	c.APIKeyRepo = repository.NewAPIKeyRepository(c.DB)
+	c.JWTSigningKeyRepo = repository.NewJWTSigningKeyRepository(c.DB)
 
 	// Initialize MetricsRepository for metrics history
 	metricsLogger := logrus.New()
@@ -337,6 +428,7 @@ func (c *Container) initServices() {
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize JWT service: %v", err))
 	}
+	c.initJWTKeyRing(jwtLogger)
 
 	// Auth management service using UnifiedJWTService
 	c.AuthMgmt = auth.NewAuthMgmt(c.DB, c.UnifiedJWTService)
@@ -373,26 +465,27 @@ func (c *Container) initServices() {
 		}
 	}
 
-	// Initialize CloudinaryUploader if configured
-	if appConfig.Cloudinary.Enabled {
-		cloudinaryConfig := &image_processing.CloudinaryConfig{
-			CloudName:  appConfig.Cloudinary.CloudName,
-			APIKey:     appConfig.Cloudinary.APIKey,
-			APISecret:  appConfig.Cloudinary.APISecret,
-			Folder:     appConfig.Cloudinary.Folder,
-			UseRealSDK: appConfig.Cloudinary.UseRealSDK,
-		}
-
-		_, err := image_processing.NewCloudinaryUploader(cloudinaryConfig, logger)
-		if err != nil {
-			logger.WithError(err).Warn("Failed to initialize CloudinaryUploader, continuing without it")
-		} else {
-			mode := "SIMULATION"
-			if appConfig.Cloudinary.UseRealSDK {
-				mode = "REAL_SDK"
-			}
-			log.Printf("[OK] CloudinaryUploader initialized successfully (mode: %s)", mode)
-		}
+	// Initialize blob storage; uploads are unavailable if it fails
+	blobStore, err := storage.NewBlobStore(storage.BlobStoreConfig{
+		Driver:       appConfig.Storage.Driver,
+		LocalRoot:    appConfig.Storage.LocalRoot,
+		LocalBaseURL: appConfig.Storage.LocalBaseURL,
+		SigningKey:   appConfig.Storage.SigningKey,
+		S3: storage.S3Config{
+			Endpoint:        appConfig.Storage.S3Endpoint,
+			Region:          appConfig.Storage.S3Region,
+			Bucket:          appConfig.Storage.S3Bucket,
+			AccessKeyID:     appConfig.Storage.S3AccessKeyID,
+			SecretAccessKey: appConfig.Storage.S3SecretAccessKey,
+			UsePathStyle:    appConfig.Storage.S3UsePathStyle,
+		},
+		PublicBaseURL: appConfig.Storage.PublicBaseURL,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to initialize blob storage, continuing without it")
+	} else {
+		c.BlobStore = blobStore
+		log.Printf("[OK] Blob storage initialized successfully (driver: %s)", appConfig.Storage.Driver)
 	}
 
 	c.QuestionService = question.NewQuestionService(
@@ -402,6 +495,11 @@ func (c *Container) initServices() {
 		imageProcessor,
 		logger,
 	)
+	if c.BlobStore != nil {
+		if uploader, err := image_processing.NewImageUploader(c.BlobStore, logger); err == nil {
+			c.QuestionService.SetImageUploader(uploader)
+		}
+	}
 
 	// Initialize QuestionFilterService with database connection and OpenSearch client
 	c.QuestionFilterService = question.NewQuestionFilterService(c.DB, c.OpenSearchClient)
@@ -429,6 +527,21 @@ func (c *Container) initServices() {
 		logger,
 	)
 
+	// Initialize error report triage; suspended questions are kept out of new exams
+	reportThreshold := question.DefaultReportSuspendThreshold
+	if thresholdStr := getEnvOrDefault("QUESTION_REPORT_SUSPEND_THRESHOLD", ""); thresholdStr != "" {
+		if threshold, err := strconv.Atoi(thresholdStr); err == nil && threshold > 0 {
+			reportThreshold = threshold
+		}
+	}
+	c.QuestionReportService = question.NewReportService(
+		c.QuestionReportRepo,
+		c.QuestionRepo,
+		c.AutoGradingService,
+		reportThreshold,
+	)
+	c.ExamService.SetSuspensionChecker(c.QuestionReportService)
+
 	// Initialize ContactMgmt with repository
 	c.ContactMgmt = contact_mgmt.NewContactMgmt(c.ContactRepo)
 
@@ -437,17 +550,95 @@ func (c *Container) initServices() {
 
 	// Initialize MapCodeMgmt with repositories
 	c.MapCodeMgmt = mapcode_mgmt.NewMapCodeMgmt(c.MapCodeRepo, c.MapCodeTranslationRepo)
+	c.MapCodeMgmt.SetCoverageSource(c.QuestionCodeRepo)
 
 	// Initialize Book management service
 	c.BookMgmt = book_mgmt.NewBookService(c.BookRepo)
 	c.LibraryVideoService = videosvc.NewService(c.LibraryVideoRepo)
 	c.LibraryRatingService = ratingsvc.NewService(c.ItemRatingRepo, c.LibraryItemRepo)
 	c.LibraryBookmarkService = bookmarksvc.NewService(c.UserBookmarkRepo)
+	if appConfig.Downloads.Enabled {
+		downloads, err := download.NewService(download.Config{
+			BaseURL:    appConfig.Downloads.BaseURL,
+			SigningKey: appConfig.Downloads.SigningKey,
+			LinkTTL:    time.Duration(appConfig.Downloads.LinkTTLSeconds) * time.Second,
+		}, c.BookMgmt, c.LibraryExamRepo, c.UserRepoWrapper, c.BlobStore)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to initialize signed downloads, serving raw file links")
+		} else {
+			c.LibraryDownloadService = downloads
+		}
+	}
+	if appConfig.Uploads.Enabled && c.BlobStore != nil {
+		uploads, err := upload.NewService(upload.Config{
+			BaseURL:           appConfig.Uploads.BaseURL,
+			StagingDir:        appConfig.Uploads.StagingDir,
+			MaxActiveSessions: appConfig.Uploads.MaxActiveSessions,
+			MaxBytesPerDay:    int64(appConfig.Uploads.MaxGBPerDay) * 1024 * 1024 * 1024,
+			SessionTTL:        time.Duration(appConfig.Uploads.SessionTTLHours) * time.Hour,
+		}, c.LibraryUploadRepo, c.BlobStore, validation.NewFileValidator(), c.UnifiedJWTService)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to initialize resumable library uploads")
+		} else {
+			c.LibraryUploadService = uploads
+		}
+	}
+	if appConfig.TextIndex.Enabled && c.OpenSearchClient != nil && c.OpenSearchClient.IsEnabled() {
+		c.LibraryPageIndex = opensearch.NewLibraryPageRepository(c.OpenSearchClient)
+		c.LibraryTextIndexer = textindex.NewService(textindex.Config{
+			Interval:  time.Duration(appConfig.TextIndex.IntervalMinutes) * time.Minute,
+			BatchSize: appConfig.TextIndex.BatchSize,
+			MaxPages:  appConfig.TextIndex.MaxPages,
+		}, c.LibraryTextIndexRepo, c.LibraryPageIndex, c.BlobStore)
+	}
+	if appConfig.Recommendations.Enabled {
+		c.LibraryRecommendations = recommend.NewService(recommend.Config{
+			RunHour:  appConfig.Recommendations.RunHour,
+			Lookback: time.Duration(appConfig.Recommendations.LookbackDays) * 24 * time.Hour,
+			PerUser:  appConfig.Recommendations.PerUser,
+		}, c.LibraryRecommendationRepo)
+		c.LibraryRecommendations.SetChapterNames(c.MapCodeMgmt)
+	}
+	if appConfig.SearchHistory.Enabled {
+		c.SearchHistory = searchhistory.NewService(searchhistory.Config{
+			Retention:        time.Duration(appConfig.SearchHistory.RetentionDays) * 24 * time.Hour,
+			TrendingWindow:   time.Duration(appConfig.SearchHistory.TrendingDays) * 24 * time.Hour,
+			MinTrendingUsers: appConfig.SearchHistory.MinTrendingUsers,
+		}, c.SearchHistoryRepo)
+	}
+
+	// Initialize organisations, classes and rosters
+	c.OrganisationService = organisation.NewService(c.OrganisationRepo, organisation.Config{})
+
+	// Initialize study collections, shared with classes through the organisation rosters
+	c.LibraryCollections = collection.NewService(c.LibraryCollectionRepo, c.OrganisationRepo, collection.Config{})
 
 	// Initialize Notification and Session services first (needed by OAuth)
 	c.NotificationSvc = notification.NewNotificationService(c.NotificationRepo, c.UserPreferenceRepo)
 	c.SessionService = session.NewSessionService(c.SessionRepo, c.UserRepoWrapper, c.NotificationSvc)
 
+	// Initialize library file versions (notifies downloaders and bookmarkers of new files)
+	c.LibraryVersions = versioning.NewService(c.LibraryItemVersionRepo, c.NotificationSvc)
+
+	// Personal data export and account deletion
+	c.PrivacyService = privacy.NewService(c.PrivacyRepo, c.UserRepoWrapper, c.SessionService, c.NotificationSvc, privacy.Config{})
+
+	// Initialize question review workflow (notifies authors and reviewers)
+	c.QuestionReviewService = question.NewReviewService(
+		c.QuestionReviewRepo,
+		c.QuestionRepo,
+		c.QuestionCodeRepo,
+		c.QuestionVersionRepo,
+		c.NotificationSvc,
+	)
+
+	// Initialize the moderation queue of library uploads and contributed questions
+	c.LibraryModeration = moderation.NewService(c.ModerationRepo, c.QuestionReviewService, c.NotificationSvc, moderation.Config{
+		SLA:            time.Duration(appConfig.Moderation.SLAHours) * time.Hour,
+		WorkloadWindow: time.Duration(appConfig.Moderation.WorkloadDays) * 24 * time.Hour,
+		MaxBulk:        appConfig.Moderation.MaxBulkAction,
+	})
+
 	// Initialize OAuth Service with proper configuration
 	googleClientID := getEnvOrDefault("GOOGLE_CLIENT_ID", "")
 	googleClientSecret := getEnvOrDefault("GOOGLE_CLIENT_SECRET", "")
@@ -470,8 +661,51 @@ func (c *Container) initServices() {
 		oauthLogger, // Inject logger
 	)
 
+	// Register generic OIDC providers (Microsoft, Keycloak, school SSO)
+	oidcRegistry, err := oauth.NewProviderRegistry(oidcProviderConfigs(c.Config.Auth.OAuth.OIDCProviders, oauthLogger), nil, oauthLogger)
+	if err != nil {
+		oauthLogger.WithError(err).Error("Invalid OIDC provider configuration, OIDC login disabled")
+	} else {
+		c.OAuthService.SetOIDCProviders(oidcRegistry)
+	}
+	c.OAuthService.SetOIDCStateStore(c.OIDCLoginStateRepo)
+
+	// Initialize Two-Factor Service (TOTP second login step)
+	twoFactorConfig := c.Config.Auth.TwoFactor
+	c.TwoFactorService = twofactor.NewTwoFactorService(c.TwoFactorRepo, c.AuditLogRepo, twofactor.Config{
+		Enabled:              c.Config.Auth.Features.EnableTwoFactor,
+		Issuer:               twoFactorConfig.Issuer,
+		EnforcedRoles:        twoFactorConfig.EnforcedRoles,
+		ChallengeTTL:         twoFactorConfig.ChallengeTTL,
+		MaxChallengeAttempts: twoFactorConfig.MaxChallengeAttempts,
+		EncryptionKey:        twoFactorConfig.EncryptionKey,
+	})
+
 	// Initialize Email Service (uses environment variables internally)
 	c.EmailService = email.NewEmailService()
+	c.TwoFactorService.SetMailer(c.EmailService) // Email codes for login risk step-up
+
+	// Initialize Passwordless Service (email code and magic-link login)
+	passwordlessConfig := c.Config.Auth.Passwordless
+	c.PasswordlessService = passwordless.NewPasswordlessService(c.LoginCodeRepo, c.UserRepoWrapper, c.EmailService, passwordless.Config{
+		Enabled:        c.Config.Auth.Features.EnablePasswordless,
+		CodeTTL:        passwordlessConfig.CodeTTL,
+		MaxAttempts:    passwordlessConfig.MaxAttempts,
+		ResendCooldown: passwordlessConfig.ResendCooldown,
+		HashKey:        passwordlessConfig.HashKey,
+	})
+
+	// Initialize Login Risk Evaluator (step-up or deny for unusual logins)
+	c.initLoginRisk()
+
+	// Initialize Permission Evaluator (RBAC policy and user grants, cached)
+	rbacLogger := logrus.New()
+	rbacLogger.SetLevel(logrus.InfoLevel)
+	rbacLogger.SetFormatter(util.StandardLogrusFormatter())
+	c.PermissionEvaluator = rbac.NewEvaluator(c.PermissionRepo, rbac.Config{}, rbacLogger)
+
+	// Initialize API Key Service (service accounts for integrations)
+	c.APIKeyService = apikey.NewService(c.APIKeyRepo, apikey.Config{})
 
 	// Resource Protection Service
 	c.ResourceProtectionSvc = system.NewResourceProtectionService(
@@ -480,6 +714,7 @@ func (c *Container) initServices() {
 		c.AuditLogRepo,
 		c.NotificationSvc,
 	)
+	c.ResourceProtectionSvc.SetDailyDownloadLimit(appConfig.Downloads.MaxPerDay)
 
 	// Performance Service
 	performanceConfig := performance.DefaultConfig()
@@ -506,6 +741,17 @@ func (c *Container) initServices() {
 	c.AchievementService = focus.NewAchievementService(c.AchievementRepo, c.UserStreakRepo)
 	c.ChatService = focus.NewChatService(c.ChatMessageRepo, c.FocusRoomRepo)
 
+	// Guardian links: read-only progress access and weekly digests for parents
+	c.GuardianService = guardian.NewService(
+		c.GuardianLinkRepo,
+		c.UserRepoWrapper,
+		c.AnalyticsFocusService,
+		c.StreakService,
+		c.NotificationSvc,
+		c.EmailService,
+		guardian.Config{},
+	)
+
 	log.Println("[OK] Focus Room services initialized successfully")
 
 	// Metrics Scheduler - Records metrics every 5 minutes
@@ -523,9 +769,11 @@ func (c *Container) initServices() {
 // initMiddleware initializes all middleware dependencies
 func (c *Container) initMiddleware() {
 	c.AuthInterceptor = middleware.NewAuthInterceptor(c.AuthMgmt, c.SessionService, c.UserRepoWrapper)
+	c.AuthInterceptor.SetAPIKeyService(c.APIKeyService)
 	c.SessionInterceptor = middleware.NewSessionInterceptor(c.SessionService, c.SessionRepo)
-	c.RoleLevelInterceptor = middleware.NewRoleLevelInterceptor()
+	c.RoleLevelInterceptor = middleware.NewRoleLevelInterceptor(c.PermissionEvaluator)
 	c.RateLimitInterceptor = middleware.NewRateLimitInterceptor()
+	c.RateLimitInterceptor.SetAPIKeyService(c.APIKeyService)
 	c.CSRFInterceptor = middleware.NewCSRFInterceptor(c.Config.Auth.Security.EnableCSRF) // NEW: CSRF protection
 	c.AuditLogInterceptor = middleware.NewAuditLogInterceptor(c.AuditLogRepo)
 	c.ResourceProtectionInterceptor = middleware.NewResourceProtectionInterceptor(c.ResourceProtectionSvc)
@@ -550,15 +798,21 @@ func (c *Container) initGRPCServices() {
 		c.EmailService,
 		bcryptCost,
 	)
+	c.EnhancedUserGRPCService.SetTwoFactorService(c.TwoFactorService)
+	c.EnhancedUserGRPCService.SetPasswordlessService(c.PasswordlessService)
+	c.EnhancedUserGRPCService.SetLoginRiskEvaluator(c.LoginRiskEvaluator)
 
 	c.QuestionGRPCService = grpc.NewQuestionServiceServer(c.QuestionService, c.QuestionVersionService)
+	c.QuestionGRPCService.SetReviewService(c.QuestionReviewService)
 	c.QuestionFilterGRPCService = grpc.NewQuestionFilterServiceServer(c.QuestionFilterService)
-	c.ExamGRPCService = grpc.NewExamServiceServer(c.ExamService, c.AutoGradingService, c.ExamRepo)
+	c.ExamGRPCService = grpc.NewExamServiceServer(c.ExamService, c.AutoGradingService, c.ExamRepo, c.QuestionService)
+	c.ExamGRPCService.SetOrganisationScope(c.OrganisationService)
 	c.ProfileGRPCService = grpc.NewProfileServiceServer(
 		c.UserRepoWrapper,
 		c.SessionService,
 		c.UserPreferenceRepo,
 	)
+	c.ProfileGRPCService.SetPrivacyService(c.PrivacyService)
 	c.AdminGRPCService = grpc.NewAdminServiceServer(
 		c.UserRepoWrapper,
 		c.MetricsRepo, // NEW: Metrics repository
@@ -569,6 +823,9 @@ func (c *Container) initGRPCServices() {
 		c.SessionRepo,
 		c.NotificationRepo,
 	)
+	c.AdminGRPCService.SetPermissionManagement(c.PermissionRepo, c.PermissionEvaluator)
+	c.AdminGRPCService.SetSecurityEventRepository(c.SecurityEventRepo)
+	c.AdminGRPCService.SetAPIKeyService(c.APIKeyService)
 	// NEW: Security & Token Management Service (Phase 6.2)
 	securityLogger := logrus.New()
 	securityLogger.SetLevel(logrus.InfoLevel)
@@ -589,12 +846,43 @@ func (c *Container) initGRPCServices() {
 		c.LibraryBookmarkService,
 		c.LibraryItemRepo,
 	)
+	c.LibraryGRPCService.SetOrganisationScope(c.OrganisationService)
+	if c.BlobStore != nil {
+		c.LibraryGRPCService.SetBlobStore(c.BlobStore)
+	}
+	if c.LibraryDownloadService != nil {
+		c.LibraryGRPCService.SetDownloadLinks(c.LibraryDownloadService)
+	}
+	if c.LibraryPageIndex != nil {
+		c.LibraryGRPCService.SetContentSearch(c.LibraryPageIndex)
+	}
+	if c.LibraryRecommendations != nil {
+		c.LibraryGRPCService.SetRecommendations(c.LibraryRecommendations)
+	}
+	c.LibraryGRPCService.SetCollections(c.LibraryCollections)
+	c.LibraryGRPCService.SetVersions(c.LibraryVersions)
+	c.LibraryGRPCService.SetModeration(c.LibraryModeration)
+	searchLogger := logrus.New()
+	searchLogger.SetLevel(logrus.InfoLevel)
+	searchLogger.SetFormatter(util.StandardLogrusFormatter())
+	searchSuggestions := grpc.NewLibrarySearchSuggestionsHandler(c.DB, searchLogger)
+	if c.SearchHistory != nil {
+		searchSuggestions.SetHistory(c.SearchHistory)
+		c.LibraryGRPCService.SetSearchHistory(c.SearchHistory)
+		c.QuestionFilterGRPCService.SetSearchHistory(c.SearchHistory)
+	}
+	c.LibraryGRPCService.SetSearchSuggestions(searchSuggestions)
+	c.LibraryGRPCService.SetResourceProtection(c.ResourceProtectionSvc)
 	c.NotificationGRPCService = grpc.NewNotificationServiceServer(
 		c.NotificationRepo,
 		c.UserPreferenceRepo,
 	)
 	c.MapCodeGRPCService = grpc.NewMapCodeServiceServer(c.MapCodeMgmt)
 	c.AnalyticsGRPCService = grpc.NewAnalyticsServiceServer(c.TeacherAnalyticsService)
+	c.QuestionReviewGRPCService = grpc.NewQuestionReviewServiceServer(c.QuestionReviewService)
+	c.QuestionReportGRPCService = grpc.NewQuestionReportServiceServer(c.QuestionReportService)
+	c.OrganisationGRPCService = grpc.NewOrganisationServiceServer(c.OrganisationService)
+	c.GuardianGRPCService = grpc.NewGuardianServiceServer(c.GuardianService)
 
 	// Focus Room gRPC Service
 	c.FocusRoomGRPCService = grpc.NewFocusRoomServiceServer(
@@ -605,6 +893,7 @@ func (c *Container) initGRPCServices() {
 		c.LeaderboardService,
 		c.FocusTaskService,
 	)
+	c.FocusRoomGRPCService.SetOrganisationScope(c.OrganisationService)
 
 	log.Println("[OK] Focus Room gRPC service initialized successfully")
 }
@@ -778,6 +1067,26 @@ func (c *Container) GetAnalyticsGRPCService() *grpc.AnalyticsServiceServer {
 	return c.AnalyticsGRPCService
 }
 
+// GetQuestionReviewGRPCService returns the question review gRPC service
+func (c *Container) GetQuestionReviewGRPCService() *grpc.QuestionReviewServiceServer {
+	return c.QuestionReviewGRPCService
+}
+
+// GetOrganisationGRPCService returns the organisation and class gRPC service
+func (c *Container) GetOrganisationGRPCService() *grpc.OrganisationServiceServer {
+	return c.OrganisationGRPCService
+}
+
+// GetGuardianGRPCService returns the guardian gRPC service
+func (c *Container) GetGuardianGRPCService() *grpc.GuardianServiceServer {
+	return c.GuardianGRPCService
+}
+
+// GetQuestionReportGRPCService returns the question error report gRPC service
+func (c *Container) GetQuestionReportGRPCService() *grpc.QuestionReportServiceServer {
+	return c.QuestionReportGRPCService
+}
+
 // GetFocusRoomGRPCService returns the focus room gRPC service
 func (c *Container) GetFocusRoomGRPCService() *grpc.FocusRoomServiceServer {
 	return c.FocusRoomGRPCService
@@ -811,6 +1120,7 @@ func (c *Container) initWebSocketComponents(cfg *config.RedisConfig) {
 
 	// Create JWT authenticator for WebSocket
 	jwtAuth := websocket.NewJWTAuthenticator(c.JWTSecret)
+	jwtAuth.SetKeyfunc(c.UnifiedJWTService.Keyfunc) // Verify through the same keyring as gRPC
 
 	// Create WebSocket handler
 	c.WebSocketHandler = websocket.NewHandler(c.WebSocketManager, jwtAuth)
@@ -874,6 +1184,135 @@ func (c *Container) StartWebSocketServer() {
 	}()
 }
 
+// initJWTKeyRing switches JWT signing to RS256/EdDSA when configured.
+// Falls back to HS256 if the keyring cannot be loaded.
+func (c *Container) initJWTKeyRing(logger *logrus.Logger) {
+	jwtConfig := c.Config.Auth.JWT
+	if jwtConfig.SigningAlgorithm == "" || jwtConfig.SigningAlgorithm == "HS256" {
+		log.Println("[INFO] JWT signing with HS256 shared secret")
+		return
+	}
+
+	keyRing, err := auth.NewKeyRing(c.JWTSigningKeyRepo, auth.KeyRingConfig{
+		Algorithm:        jwtConfig.SigningAlgorithm,
+		RotationInterval: jwtConfig.KeyRotationInterval,
+		VerificationTTL:  auth.RefreshTokenExpiry,
+		ActivationDelay:  jwtConfig.KeyActivationDelay,
+		RefreshInterval:  jwtConfig.KeyRefreshInterval,
+		EncryptionKey:    jwtConfig.KeyEncryptionKey,
+	}, logger)
+	if err != nil {
+		log.Printf("[WARN] Failed to create JWT keyring, using HS256: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := keyRing.Load(ctx); err != nil {
+		log.Printf("[WARN] Failed to load JWT signing keys, using HS256: %v", err)
+		return
+	}
+
+	c.JWTKeyRing = keyRing
+	c.UnifiedJWTService.SetKeyRing(keyRing, jwtConfig.AcceptLegacyHS256)
+	log.Printf("[OK] JWT signing with %s keyring (%d verification keys)", keyRing.Algorithm(), len(keyRing.Keys()))
+}
+
+// initLoginRisk creates the login risk evaluator when risk scoring is enabled. A missing
+// GeoIP database only disables the impossible-travel signal.
+func (c *Container) initLoginRisk() {
+	if !c.Config.Auth.Features.EnableRiskScoring {
+		return
+	}
+	riskConfig := c.Config.Auth.LoginRisk
+
+	var geo *risk.GeoIPDatabase
+	if riskConfig.GeoIPDatabasePath != "" {
+		db, err := risk.LoadGeoIPDatabase(riskConfig.GeoIPDatabasePath)
+		if err != nil {
+			log.Printf("[WARN] Failed to load GeoIP database, impossible-travel check disabled: %v", err)
+		} else {
+			geo = db
+			log.Printf("[OK] GeoIP database loaded (%d ranges)", db.Len())
+		}
+	}
+
+	location, err := time.LoadLocation(riskConfig.Timezone)
+	if err != nil {
+		log.Printf("[WARN] Unknown login risk timezone %q, using UTC: %v", riskConfig.Timezone, err)
+		location = time.UTC
+	}
+
+	c.LoginRiskEvaluator = risk.NewEvaluator(c.LoginHistoryRepo, c.SecurityEventRepo, geo, risk.Config{
+		StepUpScore: riskConfig.StepUpScore,
+		DenyScore:   riskConfig.DenyScore,
+		Location:    location,
+	})
+}
+
+// StartJWTKeyRotation starts the scheduled JWT key reload and rotation
+func (c *Container) StartJWTKeyRotation() {
+	if c.JWTKeyRing == nil {
+		return
+	}
+	c.JWTKeyRing.Start()
+	log.Printf("[OK] [KeyRing] JWT key rotation started (rotation_interval=%v)", c.Config.Auth.JWT.KeyRotationInterval)
+}
+
+// StartGuardianDigests starts the weekly guardian progress digest loop
+func (c *Container) StartGuardianDigests() {
+	if c.GuardianService == nil {
+		return
+	}
+	c.GuardianService.Start()
+	log.Println("[OK] [Guardian] Weekly progress digests started")
+}
+
+// StartPrivacyWorker starts the data export and account deletion worker
+func (c *Container) StartPrivacyWorker() {
+	if c.PrivacyService == nil {
+		return
+	}
+	c.PrivacyService.Start()
+	log.Println("[OK] [Privacy] Data export and account deletion worker started")
+}
+
+// StartLibraryUploadCleanup starts the worker that expires abandoned uploads
+func (c *Container) StartLibraryUploadCleanup() {
+	if c.LibraryUploadService == nil {
+		return
+	}
+	c.LibraryUploadService.Start()
+	log.Println("[OK] [Upload] Abandoned upload cleanup started")
+}
+
+// StartLibraryTextIndexer starts the worker that indexes library PDF contents
+func (c *Container) StartLibraryTextIndexer() {
+	if c.LibraryTextIndexer == nil {
+		return
+	}
+	c.LibraryTextIndexer.Start()
+	log.Println("[OK] [TextIndex] Library full-text indexer started")
+}
+
+// StartLibraryRecommendations starts the worker that recomputes library recommendations nightly
+func (c *Container) StartLibraryRecommendations() {
+	if c.LibraryRecommendations == nil {
+		return
+	}
+	c.LibraryRecommendations.Start()
+	log.Println("[OK] [Recommend] Library recommendation worker started")
+}
+
+// StartSearchHistoryCleanup starts the worker that deletes searches past retention
+func (c *Container) StartSearchHistoryCleanup() {
+	if c.SearchHistory == nil {
+		return
+	}
+	c.SearchHistory.Start()
+	log.Println("[OK] [SearchHistory] Search history cleanup started")
+}
+
 // StartMetricsScheduler starts the metrics recording scheduler
 func (c *Container) StartMetricsScheduler() {
 	if c.MetricsScheduler == nil {
@@ -904,6 +1343,41 @@ func (c *Container) Cleanup() {
 		}
 	}
 
+	// Stop guardian digests
+	if c.GuardianService != nil {
+		c.GuardianService.Stop()
+	}
+
+	// Stop privacy worker
+	if c.PrivacyService != nil {
+		c.PrivacyService.Stop()
+	}
+
+	// Stop upload cleanup
+	if c.LibraryUploadService != nil {
+		c.LibraryUploadService.Stop()
+	}
+
+	// Stop full-text indexer
+	if c.LibraryTextIndexer != nil {
+		c.LibraryTextIndexer.Stop()
+	}
+
+	// Stop recommendation worker
+	if c.LibraryRecommendations != nil {
+		c.LibraryRecommendations.Stop()
+	}
+
+	// Stop search history cleanup
+	if c.SearchHistory != nil {
+		c.SearchHistory.Stop()
+	}
+
+	// Stop JWT key rotation
+	if c.JWTKeyRing != nil {
+		c.JWTKeyRing.Stop()
+	}
+
 	// Stop WebSocket server
 	if c.WebSocketServer != nil {
 		if err := c.WebSocketServer.Shutdown(); err != nil {
@@ -947,6 +1421,43 @@ func (c *Container) Cleanup() {
 	log.Println("[OK] Cleanup complete")
 }
 
+// oidcProviderConfigs converts OIDC provider settings to OAuth service configuration
+func oidcProviderConfigs(settings []config.OIDCProviderConfig, logger *logrus.Logger) []oauth.OIDCProviderConfig {
+	configs := make([]oauth.OIDCProviderConfig, 0, len(settings))
+	for _, provider := range settings {
+		roleMapping := make(map[string]common.UserRole)
+		for claimValue, roleName := range provider.RoleMapping {
+			role, ok := common.UserRole_value["USER_ROLE_"+roleName]
+			if !ok {
+				logger.WithFields(logrus.Fields{
+					"provider": provider.Name,
+					"role":     roleName,
+				}).Warn("Ignoring unknown role in OIDC role mapping")
+				continue
+			}
+			roleMapping[claimValue] = common.UserRole(role)
+		}
+
+		configs = append(configs, oauth.OIDCProviderConfig{
+			Name:         provider.Name,
+			DisplayName:  provider.DisplayName,
+			IssuerURL:    provider.IssuerURL,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			Scopes:       provider.Scopes,
+			Claims: oauth.ClaimMapping{
+				Email:  provider.EmailClaim,
+				Name:   provider.NameClaim,
+				Avatar: provider.AvatarClaim,
+				Role:   provider.RoleClaim,
+			},
+			RoleMapping:         roleMapping,
+			AssumeEmailVerified: provider.AssumeEmailVerified,
+		})
+	}
+	return configs
+}
+
 // getEnvOrDefault gets environment variable or returns default value
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -0,0 +1,14 @@
+-- ==========================================
+-- Question Review Workflow - Rollback
+-- Migration 000042 DOWN
+-- ==========================================
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN ('SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT'))
+        NOT VALID;
+
+DROP TABLE IF EXISTS question_review_comments CASCADE;
+DROP TABLE IF EXISTS question_reviews CASCADE;
+DROP TABLE IF EXISTS question_reviewer_subjects CASCADE;
@@ -0,0 +1,78 @@
+-- ==========================================
+-- Question Review Workflow
+-- Migration 000042
+-- ==========================================
+
+-- Question Reviewer Subjects Table
+-- Maps reviewers to the QuestionCode subjects (and optionally grades) they cover
+CREATE TABLE IF NOT EXISTS question_reviewer_subjects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reviewer_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    subject CHAR(1) NOT NULL,           -- QuestionCode subject component (P, L, H, ...)
+    grade CHAR(1),                      -- NULL = all grades
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (reviewer_id, subject, grade)
+);
+
+-- Question Reviews Table
+-- One row per review round; a question may be reviewed several times
+CREATE TABLE IF NOT EXISTS question_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    question_id TEXT NOT NULL REFERENCES question(id) ON DELETE CASCADE,
+    author_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reviewer_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'IN_REVIEW'
+        CHECK (status IN ('IN_REVIEW', 'CHANGES_REQUESTED', 'APPROVED', 'REJECTED')),
+    version_number INT NOT NULL DEFAULT 0 CHECK (version_number >= 0), -- question_versions.version_number under review
+    subject CHAR(1),
+    grade CHAR(1),
+    decision_note TEXT,
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Only one open review round per question
+CREATE UNIQUE INDEX IF NOT EXISTS idx_question_reviews_open
+    ON question_reviews(question_id)
+    WHERE status IN ('IN_REVIEW', 'CHANGES_REQUESTED');
+CREATE INDEX IF NOT EXISTS idx_question_reviews_reviewer_status ON question_reviews(reviewer_id, status);
+CREATE INDEX IF NOT EXISTS idx_question_reviews_author ON question_reviews(author_id);
+CREATE INDEX IF NOT EXISTS idx_question_reviews_question ON question_reviews(question_id, created_at DESC);
+
+-- Question Review Comments Table
+-- Threaded inline comments anchored to a question version
+CREATE TABLE IF NOT EXISTS question_review_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL REFERENCES question_reviews(id) ON DELETE CASCADE,
+    question_id TEXT NOT NULL REFERENCES question(id) ON DELETE CASCADE,
+    version_number INT NOT NULL DEFAULT 0 CHECK (version_number >= 0),
+    parent_id UUID REFERENCES question_review_comments(id) ON DELETE CASCADE,
+    author_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    field VARCHAR(50),                  -- content, solution, answers, ... (NULL = general comment)
+    line_start INT CHECK (line_start IS NULL OR line_start > 0),
+    line_end INT CHECK (line_end IS NULL OR line_end >= line_start),
+    body TEXT NOT NULL,
+    is_resolved BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_review_comments_review ON question_review_comments(review_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_question_review_comments_parent ON question_review_comments(parent_id) WHERE parent_id IS NOT NULL;
+
+-- Allow review notifications (and the service-level types already sent by NotificationService)
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW'
+        ));
+
+COMMENT ON TABLE question_reviewer_subjects IS 'Reviewer assignment by QuestionCode subject/grade';
+COMMENT ON TABLE question_reviews IS 'Review rounds moving questions from PENDING to ACTIVE';
+COMMENT ON TABLE question_review_comments IS 'Threaded inline review comments tied to question_versions';
@@ -0,0 +1,18 @@
+-- ==========================================
+-- Question Error Report Triage - Rollback
+-- Migration 000043 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS question_suspensions CASCADE;
+
+DROP INDEX IF EXISTS idx_question_feedback_report_queue;
+DROP INDEX IF EXISTS idx_question_feedback_active_report;
+
+ALTER TABLE question_feedback
+    DROP COLUMN IF EXISTS resolution_note,
+    DROP COLUMN IF EXISTS resolved_at,
+    DROP COLUMN IF EXISTS resolved_by,
+    DROP COLUMN IF EXISTS is_credible,
+    DROP COLUMN IF EXISTS attempt_id,
+    DROP COLUMN IF EXISTS report_status,
+    DROP COLUMN IF EXISTS report_category;
@@ -0,0 +1,43 @@
+-- ==========================================
+-- Question Error Report Triage
+-- Migration 000043
+-- ==========================================
+
+-- Extend question_feedback so REPORT entries can be triaged
+ALTER TABLE question_feedback
+    ADD COLUMN IF NOT EXISTS report_category VARCHAR(20)
+        CHECK (report_category IN ('WRONG_KEY', 'TYPO', 'AMBIGUOUS', 'BROKEN_IMAGE', 'OTHER')),
+    ADD COLUMN IF NOT EXISTS report_status VARCHAR(20)
+        CHECK (report_status IN ('OPEN', 'ACKNOWLEDGED', 'RESOLVED', 'DISMISSED')),
+    ADD COLUMN IF NOT EXISTS attempt_id UUID REFERENCES exam_attempts(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS is_credible BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS resolved_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS resolution_note TEXT;
+
+-- Existing reports enter the queue as OPEN
+UPDATE question_feedback
+SET report_status = 'OPEN', report_category = COALESCE(report_category, 'OTHER')
+WHERE feedback_type = 'REPORT' AND report_status IS NULL;
+
+-- One active report per user per question
+CREATE UNIQUE INDEX IF NOT EXISTS idx_question_feedback_active_report
+    ON question_feedback(question_id, user_id)
+    WHERE feedback_type = 'REPORT' AND report_status IN ('OPEN', 'ACKNOWLEDGED') AND user_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_question_feedback_report_queue
+    ON question_feedback(report_status, question_id)
+    WHERE feedback_type = 'REPORT';
+
+-- Question Suspensions Table
+-- A suspended question cannot be added to new exams until the suspension is lifted
+CREATE TABLE IF NOT EXISTS question_suspensions (
+    question_id TEXT PRIMARY KEY REFERENCES question(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    credible_reports INT NOT NULL DEFAULT 0,
+    suspended_by TEXT REFERENCES users(id) ON DELETE SET NULL, -- NULL = automatic suspension
+    suspended_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE question_suspensions IS 'Questions withheld from new exams while error reports are triaged';
+COMMENT ON COLUMN question_feedback.is_credible IS 'Reporter answered the question in a submitted attempt';
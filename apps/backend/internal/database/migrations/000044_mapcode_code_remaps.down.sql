@@ -0,0 +1,6 @@
+-- ==========================================
+-- MapCode Question Code Remaps - Rollback
+-- Migration 000044 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS mapcode_code_remaps CASCADE;
@@ -0,0 +1,28 @@
+-- ==========================================
+-- MapCode Question Code Remaps
+-- Migration 000044
+-- ==========================================
+
+-- Audit trail of question code remaps applied when a MapCode version
+-- restructures the hierarchy. Rows are written in the same transaction
+-- that moves the questions, one row per old→new mapping.
+CREATE TABLE IF NOT EXISTS mapcode_code_remaps (
+    id                  UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    batch_id            UUID NOT NULL,
+    version_id          UUID REFERENCES mapcode_versions(id) ON DELETE SET NULL,
+    old_code            VARCHAR(7) NOT NULL,
+    new_code            VARCHAR(7) NOT NULL REFERENCES question_code(code) ON DELETE RESTRICT,
+    questions_updated   INT NOT NULL DEFAULT 0,
+    performed_by        TEXT,
+    reason              TEXT,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_mapcode_code_remaps_distinct CHECK (old_code <> new_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mapcode_code_remaps_batch ON mapcode_code_remaps(batch_id);
+CREATE INDEX IF NOT EXISTS idx_mapcode_code_remaps_old_code ON mapcode_code_remaps(old_code);
+CREATE INDEX IF NOT EXISTS idx_mapcode_code_remaps_new_code ON mapcode_code_remaps(new_code);
+CREATE INDEX IF NOT EXISTS idx_mapcode_code_remaps_created_at ON mapcode_code_remaps(created_at DESC);
+
+COMMENT ON TABLE mapcode_code_remaps IS 'Audit trail of question code remaps between MapCode versions';
@@ -0,0 +1,8 @@
+-- ==========================================
+-- Two-Factor Authentication (TOTP) - Rollback
+-- Migration 000045 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS two_factor_challenges CASCADE;
+DROP TABLE IF EXISTS user_recovery_codes CASCADE;
+DROP TABLE IF EXISTS user_two_factor CASCADE;
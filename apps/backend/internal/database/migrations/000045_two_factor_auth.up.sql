@@ -0,0 +1,55 @@
+-- ==========================================
+-- Two-Factor Authentication (TOTP)
+-- Migration 000045
+-- ==========================================
+
+-- One row per user that started TOTP enrollment. The secret is stored
+-- encrypted; enabled_at stays NULL until the first code is confirmed.
+-- last_used_step prevents a code from being replayed within its window.
+CREATE TABLE IF NOT EXISTS user_two_factor (
+    user_id             TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_encrypted    TEXT NOT NULL,
+    enabled             BOOLEAN NOT NULL DEFAULT FALSE,
+    enabled_at          TIMESTAMPTZ,
+    last_used_step      BIGINT NOT NULL DEFAULT 0,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One-time recovery codes, stored as SHA-256 hashes
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id                  TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    user_id             TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash           TEXT NOT NULL,
+    used_at             TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_user_recovery_codes_hash UNIQUE (user_id, code_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_recovery_codes_unused ON user_recovery_codes(user_id) WHERE used_at IS NULL;
+
+-- Short-lived challenges issued by Login when a second factor is needed.
+-- purpose is LOGIN (verify an existing factor) or ENROLL (enforced role
+-- without a factor; the code confirms enrollment).
+CREATE TABLE IF NOT EXISTS two_factor_challenges (
+    id                  TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    user_id             TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash          TEXT NOT NULL UNIQUE,
+    purpose             VARCHAR(10) NOT NULL DEFAULT 'LOGIN',
+    ip_address          TEXT,
+    user_agent          TEXT,
+    attempts            INT NOT NULL DEFAULT 0,
+    expires_at          TIMESTAMPTZ NOT NULL,
+    consumed_at         TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_two_factor_challenges_purpose CHECK (purpose IN ('LOGIN', 'ENROLL'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_two_factor_challenges_user ON two_factor_challenges(user_id);
+CREATE INDEX IF NOT EXISTS idx_two_factor_challenges_expires ON two_factor_challenges(expires_at);
+
+COMMENT ON TABLE user_two_factor IS 'TOTP second factor per user';
+COMMENT ON TABLE user_recovery_codes IS 'Hashed one-time recovery codes for two-factor login';
+COMMENT ON TABLE two_factor_challenges IS 'Pending second-step login challenges';
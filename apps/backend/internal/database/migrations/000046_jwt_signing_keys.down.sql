@@ -0,0 +1,6 @@
+-- ==========================================
+-- JWT Signing Keys - Rollback
+-- Migration 000046 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS jwt_signing_keys CASCADE;
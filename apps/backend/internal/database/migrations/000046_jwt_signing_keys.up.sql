@@ -0,0 +1,23 @@
+-- ==========================================
+-- JWT Signing Keys (asymmetric keyring)
+-- Migration 000046
+-- ==========================================
+
+-- Keys used to sign access and refresh tokens. The newest activated key
+-- signs; every unexpired key verifies and is published in the JWKS.
+-- activates_at lets other instances and JWKS consumers load a new key
+-- before it is used. Private keys are stored encrypted.
+CREATE TABLE IF NOT EXISTS jwt_signing_keys (
+    kid                     TEXT PRIMARY KEY,
+    algorithm               TEXT NOT NULL CHECK (algorithm IN ('RS256', 'EdDSA')),
+    private_key_encrypted   TEXT NOT NULL,
+    public_key_pem          TEXT NOT NULL,
+    created_at              TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    activates_at            TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at              TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_jwt_signing_keys_expires ON jwt_signing_keys(expires_at);
+CREATE INDEX IF NOT EXISTS idx_jwt_signing_keys_created ON jwt_signing_keys(created_at DESC);
+
+COMMENT ON TABLE jwt_signing_keys IS 'Asymmetric JWT signing keys, rotated on a schedule and published via /.well-known/jwks.json';
@@ -0,0 +1,6 @@
+-- ==========================================
+-- Passwordless Login - Rollback
+-- Migration 000047 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS login_codes CASCADE;
@@ -0,0 +1,26 @@
+-- ==========================================
+-- Passwordless Login (email code / magic link)
+-- Migration 000047
+-- ==========================================
+
+-- Each request issues a 6-digit code and a magic-link token for the same
+-- login. Both are stored hashed, are single use, expire after a few
+-- minutes and are bound to the device fingerprint that requested them.
+CREATE TABLE IF NOT EXISTS login_codes (
+    id                  TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    user_id             TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash           TEXT NOT NULL,
+    link_token_hash     TEXT NOT NULL UNIQUE,
+    device_fingerprint  TEXT NOT NULL,
+    ip_address          TEXT,
+    user_agent          TEXT,
+    attempts            INT NOT NULL DEFAULT 0,
+    expires_at          TIMESTAMPTZ NOT NULL,
+    used_at             TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_codes_user_created ON login_codes(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_login_codes_expires ON login_codes(expires_at);
+
+COMMENT ON TABLE login_codes IS 'Single-use passwordless login codes and magic-link tokens (hashed)';
@@ -0,0 +1,9 @@
+-- ==========================================
+-- Role-Based Access Control - Rollback
+-- Migration 000048 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS rbac_user_grants;
+DROP TABLE IF EXISTS rbac_method_permissions;
+DROP TABLE IF EXISTS rbac_role_permissions;
+DROP TABLE IF EXISTS rbac_permissions;
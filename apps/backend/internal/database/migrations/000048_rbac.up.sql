@@ -0,0 +1,230 @@
+-- ==========================================
+-- Role-Based Access Control (permissions, role sets, user grants)
+-- Migration 000048
+-- ==========================================
+
+-- Named permissions checked by the RBAC evaluator
+CREATE TABLE IF NOT EXISTS rbac_permissions (
+    name        TEXT PRIMARY KEY CHECK (name ~ '^[a-z][a-z0-9_.]{1,63}$'),
+    description TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Roles are permission sets; the role names follow users.role
+CREATE TABLE IF NOT EXISTS rbac_role_permissions (
+    role        TEXT NOT NULL CHECK (role IN ('GUEST', 'STUDENT', 'TUTOR', 'TEACHER', 'ADMIN')),
+    permission  TEXT NOT NULL REFERENCES rbac_permissions(name) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (role, permission)
+);
+
+-- gRPC method -> required permission. Level bounds apply to role-based access
+-- only; resource_field names the request field holding the resource ID that
+-- resource-scoped grants are matched against.
+CREATE TABLE IF NOT EXISTS rbac_method_permissions (
+    method          TEXT PRIMARY KEY,
+    permission      TEXT NOT NULL REFERENCES rbac_permissions(name) ON DELETE CASCADE,
+    min_level       INT NOT NULL DEFAULT 0 CHECK (min_level >= 0),
+    max_level       INT NOT NULL DEFAULT 0 CHECK (max_level >= 0),
+    resource_type   TEXT NOT NULL DEFAULT '',
+    resource_field  TEXT NOT NULL DEFAULT '',
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Per-user grants, optionally scoped to one resource and optionally expiring
+CREATE TABLE IF NOT EXISTS rbac_user_grants (
+    id              TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    user_id         TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    permission      TEXT NOT NULL REFERENCES rbac_permissions(name) ON DELETE CASCADE,
+    resource_type   TEXT NOT NULL DEFAULT '',
+    resource_id     TEXT NOT NULL DEFAULT '',
+    granted_by      TEXT REFERENCES users(id) ON DELETE SET NULL,
+    reason          TEXT NOT NULL DEFAULT '',
+    expires_at      TIMESTAMPTZ,
+    revoked_at      TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((resource_type = '') = (resource_id = ''))
+);
+
+CREATE INDEX IF NOT EXISTS idx_rbac_user_grants_active ON rbac_user_grants(user_id) WHERE revoked_at IS NULL;
+
+COMMENT ON TABLE rbac_permissions IS 'Named permissions checked by the RBAC evaluator';
+COMMENT ON TABLE rbac_role_permissions IS 'Permission set of each role';
+COMMENT ON TABLE rbac_method_permissions IS 'Permission and level bounds required by each gRPC method';
+COMMENT ON TABLE rbac_user_grants IS 'Per-user permission grants, optionally resource-scoped and expiring';
+
+-- Seed from the former hard-coded maps in AuthInterceptor and RoleLevelInterceptor.
+-- Both applied to every call, so a role keeps a permission only where both allowed it.
+INSERT INTO rbac_permissions (name, description) VALUES
+    ('admin.audit.read', 'Read audit logs and resource access history'),
+    ('admin.users.manage', 'List users and change their role, level, status and 2FA'),
+    ('contact.manage', 'Manage contact form submissions'),
+    ('content.preview', 'Preview questions, exams and courses'),
+    ('exam.manage', 'Create, update and delete exams'),
+    ('exam.read', 'Read and list exams'),
+    ('exam.results.read', 'Read exam results'),
+    ('exam.take', 'Start and submit exams'),
+    ('mapcode.read', 'Read MapCode coverage, diffs and remaps'),
+    ('mapcode.remap', 'Remap question codes between MapCode versions'),
+    ('newsletter.manage', 'Manage newsletter subscriptions'),
+    ('profile.read', 'Read own profile, sessions and preferences'),
+    ('profile.update', 'Update own profile, sessions and preferences'),
+    ('question.read', 'Read and search questions'),
+    ('question.write', 'Create, update, delete and import questions'),
+    ('question_report.create', 'Report an error in a question'),
+    ('question_report.moderate', 'Triage question error reports and suspensions'),
+    ('question_review.assign', 'Assign reviewers and reviewer subjects'),
+    ('question_review.review', 'Submit, review and comment on questions in review'),
+    ('rbac.manage', 'Manage permissions, role permission sets and user grants'),
+    ('tutoring.schedule', 'Schedule tutoring sessions'),
+    ('tutoring.study_group.create', 'Create tutoring study groups'),
+    ('user.list', 'List users'),
+    ('user.read', 'Read user details'),
+    ('user.students.read', 'Read the student list')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO rbac_role_permissions (role, permission) VALUES
+    ('ADMIN', 'admin.audit.read'),
+    ('ADMIN', 'admin.users.manage'),
+    ('ADMIN', 'contact.manage'),
+    ('ADMIN', 'content.preview'),
+    ('ADMIN', 'exam.manage'),
+    ('ADMIN', 'exam.read'),
+    ('ADMIN', 'exam.results.read'),
+    ('ADMIN', 'mapcode.read'),
+    ('ADMIN', 'mapcode.remap'),
+    ('ADMIN', 'newsletter.manage'),
+    ('ADMIN', 'profile.read'),
+    ('ADMIN', 'profile.update'),
+    ('ADMIN', 'question.read'),
+    ('ADMIN', 'question.write'),
+    ('ADMIN', 'question_report.create'),
+    ('ADMIN', 'question_report.moderate'),
+    ('ADMIN', 'question_review.assign'),
+    ('ADMIN', 'question_review.review'),
+    ('ADMIN', 'rbac.manage'),
+    ('ADMIN', 'user.list'),
+    ('ADMIN', 'user.read'),
+    ('ADMIN', 'user.students.read'),
+    ('TEACHER', 'content.preview'),
+    ('TEACHER', 'exam.manage'),
+    ('TEACHER', 'exam.read'),
+    ('TEACHER', 'exam.results.read'),
+    ('TEACHER', 'mapcode.read'),
+    ('TEACHER', 'profile.read'),
+    ('TEACHER', 'profile.update'),
+    ('TEACHER', 'question.read'),
+    ('TEACHER', 'question.write'),
+    ('TEACHER', 'question_report.create'),
+    ('TEACHER', 'question_report.moderate'),
+    ('TEACHER', 'question_review.review'),
+    ('TEACHER', 'tutoring.study_group.create'),
+    ('TEACHER', 'user.list'),
+    ('TEACHER', 'user.read'),
+    ('TEACHER', 'user.students.read'),
+    ('TUTOR', 'content.preview'),
+    ('TUTOR', 'exam.read'),
+    ('TUTOR', 'exam.results.read'),
+    ('TUTOR', 'exam.take'),
+    ('TUTOR', 'profile.read'),
+    ('TUTOR', 'profile.update'),
+    ('TUTOR', 'question.read'),
+    ('TUTOR', 'question_report.create'),
+    ('TUTOR', 'tutoring.schedule'),
+    ('TUTOR', 'tutoring.study_group.create'),
+    ('TUTOR', 'user.read'),
+    ('TUTOR', 'user.students.read'),
+    ('STUDENT', 'content.preview'),
+    ('STUDENT', 'exam.read'),
+    ('STUDENT', 'exam.results.read'),
+    ('STUDENT', 'exam.take'),
+    ('STUDENT', 'profile.read'),
+    ('STUDENT', 'profile.update'),
+    ('STUDENT', 'question.read'),
+    ('STUDENT', 'question_report.create'),
+    ('STUDENT', 'user.read'),
+    ('GUEST', 'content.preview')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO rbac_method_permissions (method, permission, min_level, max_level, resource_type, resource_field) VALUES
+    ('/v1.AdminService/CreatePermission', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/GetAuditLogs', 'admin.audit.read', 0, 0, '', ''),
+    ('/v1.AdminService/GetResourceAccess', 'admin.audit.read', 0, 0, '', ''),
+    ('/v1.AdminService/GrantPermission', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListMethodPermissions', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListPermissionGrants', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListPermissions', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListRolePermissions', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListUsers', 'admin.users.manage', 0, 0, '', ''),
+    ('/v1.AdminService/RevokePermissionGrant', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/SetMethodPermission', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/SetRolePermissions', 'rbac.manage', 0, 0, '', ''),
+    ('/v1.AdminService/UpdateUserLevel', 'admin.users.manage', 0, 0, '', ''),
+    ('/v1.AdminService/UpdateUserRole', 'admin.users.manage', 0, 0, '', ''),
+    ('/v1.AdminService/UpdateUserStatus', 'admin.users.manage', 0, 0, '', ''),
+    ('/v1.ContactService/DeleteContact', 'contact.manage', 0, 0, '', ''),
+    ('/v1.ContactService/GetContact', 'contact.manage', 0, 0, '', ''),
+    ('/v1.ContactService/ListContacts', 'contact.manage', 0, 0, '', ''),
+    ('/v1.ContactService/UpdateContactStatus', 'contact.manage', 0, 0, '', ''),
+    ('/v1.CourseService/GetCoursePreview', 'content.preview', 0, 0, '', ''),
+    ('/v1.ExamService/CreateExam', 'exam.manage', 2, 0, '', ''),
+    ('/v1.ExamService/DeleteExam', 'exam.manage', 5, 0, 'exam', 'id'),
+    ('/v1.ExamService/GetExam', 'exam.read', 0, 0, '', ''),
+    ('/v1.ExamService/GetExamPreview', 'content.preview', 0, 0, '', ''),
+    ('/v1.ExamService/GetResults', 'exam.results.read', 0, 0, '', ''),
+    ('/v1.ExamService/ListExams', 'exam.read', 0, 0, '', ''),
+    ('/v1.ExamService/StartExam', 'exam.take', 0, 0, 'exam', 'exam_id'),
+    ('/v1.ExamService/SubmitExam', 'exam.take', 0, 0, '', ''),
+    ('/v1.ExamService/UpdateExam', 'exam.manage', 2, 0, 'exam', 'id'),
+    ('/v1.MapCodeService/DiffVersions', 'mapcode.read', 0, 0, '', ''),
+    ('/v1.MapCodeService/ExportCoverageReport', 'mapcode.read', 0, 0, '', ''),
+    ('/v1.MapCodeService/GetCoverageReport', 'mapcode.read', 0, 0, '', ''),
+    ('/v1.MapCodeService/ListCodeRemaps', 'mapcode.read', 0, 0, '', ''),
+    ('/v1.MapCodeService/PreviewActivation', 'mapcode.read', 0, 0, '', ''),
+    ('/v1.MapCodeService/RemapQuestionCodes', 'mapcode.remap', 0, 0, '', ''),
+    ('/v1.NewsletterService/DeleteSubscription', 'newsletter.manage', 0, 0, '', ''),
+    ('/v1.NewsletterService/GetSubscription', 'newsletter.manage', 0, 0, '', ''),
+    ('/v1.NewsletterService/ListSubscriptions', 'newsletter.manage', 0, 0, '', ''),
+    ('/v1.NewsletterService/UpdateSubscriptionTags', 'newsletter.manage', 0, 0, '', ''),
+    ('/v1.ProfileService/GetPreferences', 'profile.read', 0, 0, '', ''),
+    ('/v1.ProfileService/GetProfile', 'profile.read', 0, 0, '', ''),
+    ('/v1.ProfileService/GetSessions', 'profile.read', 0, 0, '', ''),
+    ('/v1.ProfileService/TerminateSession', 'profile.update', 0, 0, '', ''),
+    ('/v1.ProfileService/UpdatePreferences', 'profile.update', 0, 0, '', ''),
+    ('/v1.ProfileService/UpdateProfile', 'profile.update', 0, 0, '', ''),
+    ('/v1.QuestionFilterService/GetQuestionsByQuestionCode', 'question.read', 0, 0, '', ''),
+    ('/v1.QuestionFilterService/ListQuestionsByFilter', 'question.read', 0, 0, '', ''),
+    ('/v1.QuestionFilterService/SearchQuestions', 'question.read', 0, 0, '', ''),
+    ('/v1.QuestionReportService/AcknowledgeErrorReports', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReportService/DismissErrorReports', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReportService/LiftQuestionSuspension', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReportService/ListQuestionErrorReports', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReportService/ListReportQueue', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReportService/ReportQuestionError', 'question_report.create', 0, 0, '', ''),
+    ('/v1.QuestionReportService/ResolveErrorReports', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReportService/SuspendQuestion', 'question_report.moderate', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/AddReviewComment', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/AssignReviewer', 'question_review.assign', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/GetReview', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/GetReviewerDashboard', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/ListQuestionReviews', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/ListReviewComments', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/ListReviewerSubjects', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/ResolveReviewComment', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/SetReviewerSubjects', 'question_review.assign', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/SubmitQuestionForReview', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionReviewService/SubmitReviewDecision', 'question_review.review', 0, 0, '', ''),
+    ('/v1.QuestionService/CreateQuestion', 'question.write', 1, 0, '', ''),
+    ('/v1.QuestionService/DeleteQuestion', 'question.write', 3, 0, 'question', 'id'),
+    ('/v1.QuestionService/GetQuestion', 'question.read', 0, 0, 'question', 'id'),
+    ('/v1.QuestionService/GetQuestionPreview', 'content.preview', 0, 0, '', ''),
+    ('/v1.QuestionService/ImportQuestions', 'question.write', 0, 0, '', ''),
+    ('/v1.QuestionService/ListQuestions', 'question.read', 0, 0, '', ''),
+    ('/v1.QuestionService/UpdateQuestion', 'question.write', 1, 0, 'question', 'id'),
+    ('/v1.TutoringService/CreateStudyGroup', 'tutoring.study_group.create', 3, 0, '', ''),
+    ('/v1.TutoringService/ScheduleTutoring', 'tutoring.schedule', 2, 0, '', ''),
+    ('/v1.UserService/GetStudentList', 'user.students.read', 0, 0, '', ''),
+    ('/v1.UserService/GetUser', 'user.read', 0, 0, '', ''),
+    ('/v1.UserService/ListUsers', 'user.list', 0, 0, '', ''),
+    ('/v1.UserService/ResetUserTwoFactor', 'admin.users.manage', 0, 0, '', '')
+ON CONFLICT (method) DO NOTHING;
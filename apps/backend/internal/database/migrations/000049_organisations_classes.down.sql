@@ -0,0 +1,17 @@
+-- ==========================================
+-- Organisations, classes and rosters - Rollback
+-- Migration 000049 DOWN
+-- ==========================================
+
+DELETE FROM rbac_method_permissions WHERE method LIKE '/v1.OrganisationService/%';
+DELETE FROM rbac_permissions WHERE name IN ('organisation.create', 'class.manage');
+
+DROP INDEX IF EXISTS idx_library_items_organisation;
+DROP INDEX IF EXISTS idx_exams_organisation;
+ALTER TABLE library_items DROP COLUMN IF EXISTS organisation_id;
+ALTER TABLE exams DROP COLUMN IF EXISTS organisation_id;
+
+DROP TABLE IF EXISTS class_members;
+DROP TABLE IF EXISTS classes;
+DROP TABLE IF EXISTS organisation_members;
+DROP TABLE IF EXISTS organisations;
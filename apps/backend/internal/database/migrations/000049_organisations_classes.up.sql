@@ -0,0 +1,85 @@
+-- ==========================================
+-- Organisations, classes and rosters
+-- Migration 000049
+-- ==========================================
+
+-- Schools and other tenants; users join them through organisation_members
+CREATE TABLE IF NOT EXISTS organisations (
+    id          TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    name        TEXT NOT NULL,
+    slug        TEXT NOT NULL UNIQUE CHECK (slug ~ '^[a-z0-9][a-z0-9-]{1,62}$'),
+    created_by  TEXT REFERENCES users(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    archived_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS organisation_members (
+    organisation_id TEXT NOT NULL REFERENCES organisations(id) ON DELETE CASCADE,
+    user_id         TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role            TEXT NOT NULL CHECK (role IN ('OWNER', 'ADMIN', 'TEACHER', 'STUDENT')),
+    joined_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organisation_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organisation_members_user ON organisation_members(user_id);
+
+-- Teacher-owned classes; organisation_id is NULL for classes outside any organisation
+CREATE TABLE IF NOT EXISTS classes (
+    id                TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    organisation_id   TEXT REFERENCES organisations(id) ON DELETE CASCADE,
+    name              TEXT NOT NULL,
+    subject           TEXT NOT NULL DEFAULT '',
+    grade             INT NOT NULL DEFAULT 0,
+    school_year       TEXT NOT NULL DEFAULT '',
+    owner_id          TEXT REFERENCES users(id) ON DELETE SET NULL,
+    join_code         TEXT NOT NULL UNIQUE,
+    join_code_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    archived_at       TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_classes_organisation ON classes(organisation_id) WHERE organisation_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS class_members (
+    class_id  TEXT NOT NULL REFERENCES classes(id) ON DELETE CASCADE,
+    user_id   TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role      TEXT NOT NULL CHECK (role IN ('TEACHER', 'STUDENT')),
+    added_by  TEXT REFERENCES users(id) ON DELETE SET NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (class_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_class_members_user ON class_members(user_id, role);
+
+-- Organisation scoping; NULL keeps the resource visible to everyone
+ALTER TABLE exams ADD COLUMN IF NOT EXISTS organisation_id TEXT REFERENCES organisations(id) ON DELETE SET NULL;
+ALTER TABLE library_items ADD COLUMN IF NOT EXISTS organisation_id TEXT REFERENCES organisations(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_exams_organisation ON exams(organisation_id) WHERE organisation_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_library_items_organisation ON library_items(organisation_id) WHERE organisation_id IS NOT NULL;
+
+COMMENT ON TABLE organisations IS 'Schools and other tenants that own classes, exams and library items';
+COMMENT ON TABLE organisation_members IS 'Organisation membership with an organisation-level role';
+COMMENT ON TABLE classes IS 'Teacher-owned classes with a join code';
+COMMENT ON TABLE class_members IS 'Class rosters: teachers and students';
+
+INSERT INTO rbac_permissions (name, description) VALUES
+    ('organisation.create', 'Create organisations'),
+    ('class.manage', 'Create classes and import rosters')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO rbac_role_permissions (role, permission) VALUES
+    ('ADMIN', 'organisation.create'),
+    ('ADMIN', 'class.manage'),
+    ('TEACHER', 'organisation.create'),
+    ('TEACHER', 'class.manage'),
+    ('TUTOR', 'class.manage')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO rbac_method_permissions (method, permission, min_level, max_level, resource_type, resource_field) VALUES
+    ('/v1.OrganisationService/CreateOrganisation', 'organisation.create', 0, 0, '', ''),
+    ('/v1.OrganisationService/CreateClass', 'class.manage', 0, 0, '', ''),
+    ('/v1.OrganisationService/ImportClassRoster', 'class.manage', 0, 0, 'class', 'class_id')
+ON CONFLICT (method) DO NOTHING;
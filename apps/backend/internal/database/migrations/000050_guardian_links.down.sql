@@ -0,0 +1,16 @@
+-- ==========================================
+-- Guardian links between parents and students - Rollback
+-- Migration 000050 DOWN
+-- ==========================================
+
+DELETE FROM notifications WHERE type IN ('GUARDIAN_LINK', 'PROGRESS_DIGEST');
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW'
+        ));
+
+DROP TABLE IF EXISTS guardian_links;
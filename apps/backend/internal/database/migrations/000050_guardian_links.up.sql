@@ -0,0 +1,39 @@
+-- ==========================================
+-- Guardian links between parents and students
+-- Migration 000050
+-- ==========================================
+
+-- A guardian asks to follow a student; the link only becomes ACTIVE once the
+-- student confirms it, and the student can revoke it at any time
+CREATE TABLE IF NOT EXISTS guardian_links (
+    id             TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    guardian_id    TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    student_id     TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    relationship   TEXT NOT NULL DEFAULT 'PARENT' CHECK (relationship IN ('PARENT', 'GUARDIAN', 'OTHER')),
+    status         TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'ACTIVE', 'DECLINED', 'REVOKED')),
+    digest_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    responded_at   TIMESTAMPTZ,
+    revoked_at     TIMESTAMPTZ,
+    last_digest_at TIMESTAMPTZ,
+    CHECK (guardian_id <> student_id)
+);
+
+-- At most one open link per guardian and student
+CREATE UNIQUE INDEX IF NOT EXISTS idx_guardian_links_open
+    ON guardian_links(guardian_id, student_id) WHERE status IN ('PENDING', 'ACTIVE');
+CREATE INDEX IF NOT EXISTS idx_guardian_links_student ON guardian_links(student_id);
+CREATE INDEX IF NOT EXISTS idx_guardian_links_digest
+    ON guardian_links(last_digest_at) WHERE status = 'ACTIVE' AND digest_enabled;
+
+-- Guardian link requests and weekly progress digests are delivered as notifications
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW', 'GUARDIAN_LINK', 'PROGRESS_DIGEST'
+        ));
+
+COMMENT ON TABLE guardian_links IS 'Read-only progress access for parents and guardians, confirmed and revocable by the student';
@@ -0,0 +1,14 @@
+-- ==========================================
+-- Personal data export and account deletion - Rollback
+-- Migration 000051 DOWN
+-- ==========================================
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+UPDATE users SET status = 'INACTIVE' WHERE status = 'DELETED';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users
+    ADD CONSTRAINT users_status_check
+        CHECK (status IN ('ACTIVE', 'INACTIVE', 'SUSPENDED'));
+
+DROP TABLE IF EXISTS account_deletion_requests;
+DROP TABLE IF EXISTS data_export_jobs;
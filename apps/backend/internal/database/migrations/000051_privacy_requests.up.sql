@@ -0,0 +1,52 @@
+-- ==========================================
+-- Personal data export and account deletion
+-- Migration 000051
+-- ==========================================
+
+-- A user's request for a copy of their personal data. A background worker
+-- builds the zip archive; it is kept until expires_at and then dropped.
+CREATE TABLE IF NOT EXISTS data_export_jobs (
+    id           TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    user_id      TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status       TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'RUNNING', 'READY', 'FAILED', 'EXPIRED')),
+    archive      BYTEA,
+    file_size    BIGINT NOT NULL DEFAULT 0,
+    error        TEXT,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ
+);
+
+-- At most one export in progress per user
+CREATE UNIQUE INDEX IF NOT EXISTS idx_data_export_jobs_open
+    ON data_export_jobs(user_id) WHERE status IN ('PENDING', 'RUNNING');
+CREATE INDEX IF NOT EXISTS idx_data_export_jobs_user ON data_export_jobs(user_id, requested_at DESC);
+CREATE INDEX IF NOT EXISTS idx_data_export_jobs_pending ON data_export_jobs(requested_at) WHERE status = 'PENDING';
+
+-- A scheduled account deletion. The account is erased once scheduled_for has
+-- passed unless the user cancels first; completed requests are kept as the
+-- record that the erasure happened.
+CREATE TABLE IF NOT EXISTS account_deletion_requests (
+    id            TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    user_id       TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status        TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'CANCELLED', 'COMPLETED')),
+    reason        TEXT,
+    requested_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    cancelled_at  TIMESTAMPTZ,
+    completed_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_account_deletion_requests_pending
+    ON account_deletion_requests(user_id) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_account_deletion_requests_due
+    ON account_deletion_requests(scheduled_for) WHERE status = 'PENDING';
+
+-- Erased accounts stay as anonymised tombstones so exam statistics and audit
+-- logs that reference them remain valid
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users
+    ADD CONSTRAINT users_status_check
+        CHECK (status IN ('ACTIVE', 'INACTIVE', 'SUSPENDED', 'DELETED'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
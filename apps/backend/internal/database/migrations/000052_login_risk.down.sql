@@ -0,0 +1,13 @@
+-- ==========================================
+-- Adaptive login risk: email step-up challenges - Rollback
+-- Migration 000052 DOWN
+-- ==========================================
+
+DROP INDEX IF EXISTS idx_user_sessions_user_created;
+DROP INDEX IF EXISTS idx_security_events_threat_created;
+
+DELETE FROM two_factor_challenges WHERE purpose = 'EMAIL';
+ALTER TABLE two_factor_challenges DROP CONSTRAINT IF EXISTS chk_two_factor_challenges_purpose;
+ALTER TABLE two_factor_challenges
+    ADD CONSTRAINT chk_two_factor_challenges_purpose CHECK (purpose IN ('LOGIN', 'ENROLL'));
+ALTER TABLE two_factor_challenges DROP COLUMN IF EXISTS code_hash;
@@ -0,0 +1,17 @@
+-- ==========================================
+-- Adaptive login risk: email step-up challenges
+-- Migration 000052
+-- ==========================================
+
+-- Risky logins without a TOTP factor are challenged with a code sent by
+-- email. The code is stored hashed on the challenge it belongs to.
+ALTER TABLE two_factor_challenges ADD COLUMN IF NOT EXISTS code_hash TEXT;
+ALTER TABLE two_factor_challenges DROP CONSTRAINT IF EXISTS chk_two_factor_challenges_purpose;
+ALTER TABLE two_factor_challenges
+    ADD CONSTRAINT chk_two_factor_challenges_purpose CHECK (purpose IN ('LOGIN', 'ENROLL', 'EMAIL'));
+
+-- Login risk decisions are recorded as LOGIN_RISK security events
+CREATE INDEX IF NOT EXISTS idx_security_events_threat_created ON security_events(threat_type, created_at DESC);
+
+-- Device and time-of-day history is read from recent sessions
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_created ON user_sessions(user_id, created_at DESC);
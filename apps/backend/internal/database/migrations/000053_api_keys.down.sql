@@ -0,0 +1,14 @@
+-- ==========================================
+-- Service accounts and scoped API keys - Rollback
+-- Migration 000053 DOWN
+-- ==========================================
+
+DELETE FROM rbac_method_permissions WHERE permission = 'service_accounts.manage';
+DELETE FROM rbac_role_permissions WHERE permission = 'service_accounts.manage';
+DELETE FROM rbac_permissions WHERE name = 'service_accounts.manage';
+
+-- The backing users rows go with the accounts
+DELETE FROM users WHERE id IN (SELECT id FROM service_accounts);
+
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS service_accounts;
@@ -0,0 +1,59 @@
+-- ==========================================
+-- Service accounts and scoped API keys
+-- Migration 000053
+-- ==========================================
+
+-- A non-human identity for integrations (imports, exports, LMS syncs). Each
+-- service account is backed by a users row so roles, levels, grants and audit
+-- logs work unchanged; that row has no usable password.
+CREATE TABLE IF NOT EXISTS service_accounts (
+    id          TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    name        TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    created_by  TEXT REFERENCES users(id) ON DELETE SET NULL,
+    disabled_at TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- An API key of a service account. Only the SHA-256 hash of the secret is
+-- stored; the prefix identifies the key in requests, logs and the admin UI.
+-- scopes lists the gRPC methods the key may call ("/v1.Service/Method" or
+-- "/v1.Service/*").
+CREATE TABLE IF NOT EXISTS api_keys (
+    id                    TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    service_account_id    TEXT NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    name                  TEXT NOT NULL,
+    prefix                TEXT NOT NULL UNIQUE,
+    key_hash              TEXT NOT NULL,
+    scopes                TEXT[] NOT NULL DEFAULT '{}',
+    rate_limit_per_minute INT NOT NULL DEFAULT 60 CHECK (rate_limit_per_minute > 0),
+    expires_at            TIMESTAMPTZ NOT NULL,
+    last_used_at          TIMESTAMPTZ,
+    last_used_ip          TEXT,
+    revoked_at            TIMESTAMPTZ,
+    created_by            TEXT REFERENCES users(id) ON DELETE SET NULL,
+    created_at            TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_service_account ON api_keys(service_account_id, created_at DESC);
+
+COMMENT ON TABLE service_accounts IS 'Non-human accounts used by integrations through API keys';
+COMMENT ON TABLE api_keys IS 'Hashed, scoped and rate-limited API keys of service accounts';
+
+INSERT INTO rbac_permissions (name, description) VALUES
+    ('service_accounts.manage', 'Manage service accounts and their API keys')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO rbac_role_permissions (role, permission) VALUES
+    ('ADMIN', 'service_accounts.manage')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO rbac_method_permissions (method, permission, min_level, max_level, resource_type, resource_field) VALUES
+    ('/v1.AdminService/CreateServiceAccount', 'service_accounts.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListServiceAccounts', 'service_accounts.manage', 0, 0, '', ''),
+    ('/v1.AdminService/DisableServiceAccount', 'service_accounts.manage', 0, 0, '', ''),
+    ('/v1.AdminService/CreateApiKey', 'service_accounts.manage', 0, 0, '', ''),
+    ('/v1.AdminService/ListApiKeys', 'service_accounts.manage', 0, 0, '', ''),
+    ('/v1.AdminService/RevokeApiKey', 'service_accounts.manage', 0, 0, '', '')
+ON CONFLICT (method) DO NOTHING;
@@ -0,0 +1,9 @@
+-- ==========================================
+-- Keyset indexes for the unified library listing - Rollback
+-- Migration 000054 DOWN
+-- ==========================================
+
+DROP INDEX IF EXISTS idx_library_items_keyset_title;
+DROP INDEX IF EXISTS idx_library_items_keyset_rating;
+DROP INDEX IF EXISTS idx_library_items_keyset_downloads;
+DROP INDEX IF EXISTS idx_library_items_keyset_created;
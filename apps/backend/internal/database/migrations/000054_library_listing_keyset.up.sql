@@ -0,0 +1,11 @@
+-- ==========================================
+-- Keyset indexes for the unified library listing
+-- Migration 000054
+-- ==========================================
+
+-- Each sort option of ListItems orders by its key and then by id; these indexes let
+-- a cursor page seek straight to its first row instead of skipping earlier pages.
+CREATE INDEX IF NOT EXISTS idx_library_items_keyset_created ON library_items(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_library_items_keyset_downloads ON library_items(download_count, id);
+CREATE INDEX IF NOT EXISTS idx_library_items_keyset_rating ON library_items(average_rating, id);
+CREATE INDEX IF NOT EXISTS idx_library_items_keyset_title ON library_items(LOWER(name), id);
@@ -0,0 +1,6 @@
+-- ==========================================
+-- Resumable library uploads - Rollback
+-- Migration 000055 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS library_upload_sessions;
@@ -0,0 +1,31 @@
+-- ==========================================
+-- Resumable library uploads
+-- Migration 000055
+-- ==========================================
+
+-- A tus upload in progress. Bytes are staged on the backend's disk until
+-- upload_offset reaches upload_length; the file is then validated, moved to blob
+-- storage under file_id and a pending library item is created from metadata.
+CREATE TABLE IF NOT EXISTS library_upload_sessions (
+    id              TEXT PRIMARY KEY,
+    user_id         TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_type       TEXT NOT NULL CHECK (item_type IN ('book', 'exam', 'video')),
+    filename        TEXT NOT NULL,
+    content_type    TEXT NOT NULL DEFAULT '',
+    metadata        JSONB NOT NULL DEFAULT '{}',
+    upload_length   BIGINT NOT NULL CHECK (upload_length > 0),
+    upload_offset   BIGINT NOT NULL DEFAULT 0 CHECK (upload_offset >= 0 AND upload_offset <= upload_length),
+    status          TEXT NOT NULL DEFAULT 'uploading' CHECK (status IN ('uploading', 'completed', 'failed', 'terminated', 'expired')),
+    error           TEXT NOT NULL DEFAULT '',
+    checksum_sha256 TEXT NOT NULL DEFAULT '',
+    file_id         TEXT,
+    library_item_id TEXT REFERENCES library_items(id) ON DELETE SET NULL,
+    expires_at      TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Quota checks sum a user's recent sessions
+CREATE INDEX IF NOT EXISTS idx_library_upload_sessions_user ON library_upload_sessions(user_id, created_at DESC);
+-- The cleanup worker looks for abandoned uploads
+CREATE INDEX IF NOT EXISTS idx_library_upload_sessions_expiry ON library_upload_sessions(expires_at) WHERE status = 'uploading';
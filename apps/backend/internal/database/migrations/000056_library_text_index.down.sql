@@ -0,0 +1,6 @@
+-- ==========================================
+-- Library full-text indexing - Rollback
+-- Migration 000056 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS library_text_index;
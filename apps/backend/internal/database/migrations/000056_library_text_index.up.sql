@@ -0,0 +1,17 @@
+-- ==========================================
+-- Library full-text indexing
+-- Migration 000056
+-- ==========================================
+
+-- Tracks which file of each book or exam has its page text in the OpenSearch
+-- library pages index. file_key is the file_id and file_url the text was extracted
+-- from; the indexer re-indexes an item whenever they no longer match. There is no
+-- foreign key so rows outlive deleted items until their pages leave the index.
+CREATE TABLE IF NOT EXISTS library_text_index (
+    library_item_id TEXT PRIMARY KEY,
+    file_key        TEXT NOT NULL,
+    status          TEXT NOT NULL CHECK (status IN ('indexed', 'empty', 'failed')),
+    page_count      INTEGER NOT NULL DEFAULT 0,
+    error           TEXT NOT NULL DEFAULT '',
+    indexed_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,10 @@
+-- ==========================================
+-- Library recommendations - Rollback
+-- Migration 000057 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS library_recommendation_runs;
+DROP INDEX IF EXISTS idx_library_user_recommendations_rank;
+DROP TABLE IF EXISTS library_user_recommendations;
+DROP INDEX IF EXISTS idx_library_item_similarities_rank;
+DROP TABLE IF EXISTS library_item_similarities;
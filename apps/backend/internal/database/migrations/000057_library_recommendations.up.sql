@@ -0,0 +1,45 @@
+-- ==========================================
+-- Library recommendations
+-- Migration 000057
+-- ==========================================
+
+-- Similar items of each item, rebuilt by the nightly recommendation job from
+-- co-downloads and shared subject, grade and tags. reason is the stronger signal.
+CREATE TABLE IF NOT EXISTS library_item_similarities (
+    item_id         TEXT NOT NULL REFERENCES library_items(id) ON DELETE CASCADE,
+    similar_item_id TEXT NOT NULL REFERENCES library_items(id) ON DELETE CASCADE,
+    score           DOUBLE PRECISION NOT NULL,
+    reason          TEXT NOT NULL CHECK (reason IN ('co_download', 'content')),
+    computed_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (item_id, similar_item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_library_item_similarities_rank
+    ON library_item_similarities(item_id, score DESC);
+
+-- Personal recommendations of recently active students. source_item_id is the item
+-- a similar_item recommendation was derived from; question_code is the grade,
+-- subject and chapter prefix of a weak_chapter recommendation.
+CREATE TABLE IF NOT EXISTS library_user_recommendations (
+    user_id         TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    library_item_id TEXT NOT NULL REFERENCES library_items(id) ON DELETE CASCADE,
+    score           DOUBLE PRECISION NOT NULL,
+    reason          TEXT NOT NULL CHECK (reason IN ('similar_item', 'weak_chapter')),
+    source_item_id  TEXT,
+    question_code   TEXT,
+    explanation     TEXT NOT NULL,
+    computed_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, library_item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_library_user_recommendations_rank
+    ON library_user_recommendations(user_id, score DESC);
+
+-- One row per completed nightly run, used to decide when the next run is due
+CREATE TABLE IF NOT EXISTS library_recommendation_runs (
+    id           BIGSERIAL PRIMARY KEY,
+    started_at   TIMESTAMPTZ NOT NULL,
+    finished_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    similarities INTEGER NOT NULL DEFAULT 0,
+    users        INTEGER NOT NULL DEFAULT 0
+);
@@ -0,0 +1,6 @@
+-- ==========================================
+-- Search history - Rollback
+-- Migration 000058 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS search_history;
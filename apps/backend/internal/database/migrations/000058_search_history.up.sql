@@ -0,0 +1,39 @@
+-- ==========================================
+-- Search history
+-- Migration 000058
+-- Replaces 022_create_search_history_table.sql, which the migrator never ran
+-- ==========================================
+
+-- One row per library or question search. normalized_query is lowercased with
+-- whitespace collapsed so trending and zero-result reports group spellings
+-- together; clicked_item_id is the first result the searcher opened.
+CREATE TABLE IF NOT EXISTS search_history (
+    id               TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    scope            TEXT NOT NULL CHECK (scope IN ('library', 'question')),
+    user_id          TEXT REFERENCES users(id) ON DELETE CASCADE,
+    query            TEXT NOT NULL,
+    normalized_query TEXT NOT NULL,
+    filters          JSONB NOT NULL DEFAULT '{}',
+    result_count     INTEGER NOT NULL DEFAULT 0,
+    clicked_item_id  TEXT,
+    clicked_at       TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Recent searches of a user
+CREATE INDEX IF NOT EXISTS idx_search_history_user
+    ON search_history(user_id, scope, created_at DESC)
+    WHERE user_id IS NOT NULL;
+
+-- Trending queries and prefix suggestions per scope
+CREATE INDEX IF NOT EXISTS idx_search_history_query
+    ON search_history(scope, normalized_query text_pattern_ops, created_at);
+
+-- Zero-result report
+CREATE INDEX IF NOT EXISTS idx_search_history_zero_results
+    ON search_history(scope, created_at)
+    WHERE result_count = 0;
+
+-- Retention job
+CREATE INDEX IF NOT EXISTS idx_search_history_created_at
+    ON search_history(created_at);
@@ -0,0 +1,9 @@
+-- ==========================================
+-- Library collections - Rollback
+-- Migration 000059 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS library_collection_progress;
+DROP TABLE IF EXISTS library_collection_items;
+DROP TABLE IF EXISTS library_collection_sections;
+DROP TABLE IF EXISTS library_collections;
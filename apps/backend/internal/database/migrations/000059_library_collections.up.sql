@@ -0,0 +1,69 @@
+-- ==========================================
+-- Library collections
+-- Migration 000059
+-- ==========================================
+
+-- Ordered study packs of library items and practice question sets. private
+-- collections are seen by their owner only, class collections by members of
+-- class_id and public ones by everyone; member items keep their own access rules.
+CREATE TABLE IF NOT EXISTS library_collections (
+    id             TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    owner_id       TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title          TEXT NOT NULL,
+    description    TEXT NOT NULL DEFAULT '',
+    visibility     TEXT NOT NULL DEFAULT 'private' CHECK (visibility IN ('private', 'class', 'public')),
+    -- A class collection whose class is deleted is only visible to its owner
+    class_id       TEXT REFERENCES classes(id) ON DELETE SET NULL,
+    copied_from_id TEXT REFERENCES library_collections(id) ON DELETE SET NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_library_collections_owner
+    ON library_collections(owner_id, updated_at DESC);
+CREATE INDEX IF NOT EXISTS idx_library_collections_class
+    ON library_collections(class_id) WHERE visibility = 'class';
+CREATE INDEX IF NOT EXISTS idx_library_collections_public
+    ON library_collections(updated_at DESC) WHERE visibility = 'public';
+
+CREATE TABLE IF NOT EXISTS library_collection_sections (
+    collection_id TEXT NOT NULL REFERENCES library_collections(id) ON DELETE CASCADE,
+    position      INT NOT NULL,
+    title         TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (collection_id, position)
+);
+
+-- A member is either a library item or an exam from the question bank used as
+-- a practice question set
+CREATE TABLE IF NOT EXISTS library_collection_items (
+    collection_id    TEXT NOT NULL,
+    section_position INT NOT NULL,
+    position         INT NOT NULL,
+    library_item_id  TEXT REFERENCES library_items(id) ON DELETE CASCADE,
+    exam_id          UUID REFERENCES exams(id) ON DELETE CASCADE,
+    note             TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (collection_id, section_position, position),
+    FOREIGN KEY (collection_id, section_position)
+        REFERENCES library_collection_sections(collection_id, position) ON DELETE CASCADE,
+    CHECK ((library_item_id IS NULL) <> (exam_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_library_collection_items_library_item
+    ON library_collection_items(library_item_id) WHERE library_item_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_library_collection_items_exam
+    ON library_collection_items(exam_id) WHERE exam_id IS NOT NULL;
+
+-- Items a student marked as done. Keyed by the member rather than its position
+-- so progress survives reordering. Question sets also count as done once the
+-- student has a submitted attempt of the exam.
+CREATE TABLE IF NOT EXISTS library_collection_progress (
+    collection_id TEXT NOT NULL REFERENCES library_collections(id) ON DELETE CASCADE,
+    user_id       TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_kind     TEXT NOT NULL CHECK (item_kind IN ('library_item', 'question_set')),
+    item_id       TEXT NOT NULL,
+    completed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (collection_id, user_id, item_kind, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_library_collection_progress_user
+    ON library_collection_progress(user_id);
@@ -0,0 +1,17 @@
+-- ==========================================
+-- Library item versions - Rollback
+-- Migration 000060 DOWN
+-- ==========================================
+
+DELETE FROM notifications WHERE type = 'LIBRARY_ITEM_UPDATE';
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW', 'GUARDIAN_LINK', 'PROGRESS_DIGEST'
+        ));
+
+DROP INDEX IF EXISTS idx_download_history_item_time;
+DROP TABLE IF EXISTS library_item_versions;
@@ -0,0 +1,46 @@
+-- ==========================================
+-- Library item versions
+-- Migration 000060
+-- ==========================================
+
+-- Every file a library item has served. The highest version is the current file,
+-- mirrored in library_items.file_*; older files are kept for rollback. Downloads
+-- belong to the version that was current at downloaded_at.
+CREATE TABLE IF NOT EXISTS library_item_versions (
+    id              TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    library_item_id TEXT NOT NULL REFERENCES library_items(id) ON DELETE CASCADE,
+    version         INT NOT NULL CHECK (version > 0),
+    file_url        TEXT,
+    file_id         TEXT,
+    file_size       BIGINT,
+    file_type       TEXT,
+    changelog       TEXT NOT NULL DEFAULT '',
+    -- Set when the version restores the file of an older one
+    restored_from   INT,
+    created_by      TEXT REFERENCES users(id) ON DELETE SET NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (library_item_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_download_history_item_time
+    ON download_history(library_item_id, downloaded_at);
+
+-- Existing files become version 1; items created later get it on their first replacement
+INSERT INTO library_item_versions (library_item_id, version, file_url, file_id, file_size, file_type, changelog, created_by, created_at)
+SELECT id, 1, file_url, file_id, file_size, file_type, '', uploaded_by, created_at
+FROM library_items
+WHERE file_url IS NOT NULL OR file_id IS NOT NULL
+ON CONFLICT (library_item_id, version) DO NOTHING;
+
+-- Downloaders and bookmarkers are told when a file is replaced or rolled back
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW', 'GUARDIAN_LINK', 'PROGRESS_DIGEST',
+            'LIBRARY_ITEM_UPDATE'
+        ));
+
+COMMENT ON TABLE library_item_versions IS 'File history of library items with changelog notes, for rollback and per-version download stats';
@@ -0,0 +1,20 @@
+-- ==========================================
+-- Moderation queue - Rollback
+-- Migration 000061 DOWN
+-- ==========================================
+
+DELETE FROM notifications WHERE type = 'LIBRARY_MODERATION';
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW', 'GUARDIAN_LINK', 'PROGRESS_DIGEST',
+            'LIBRARY_ITEM_UPDATE'
+        ));
+
+DROP TABLE IF EXISTS moderation_decisions;
+DROP INDEX IF EXISTS idx_question_content_md5;
+DROP INDEX IF EXISTS idx_library_items_file_sha256;
+ALTER TABLE library_items DROP COLUMN IF EXISTS file_sha256;
@@ -0,0 +1,55 @@
+-- ==========================================
+-- Moderation queue
+-- Migration 000061
+-- ==========================================
+
+-- SHA-256 of the served file, known for files uploaded through tus. The
+-- moderation queue flags pending items sharing a hash with another item.
+ALTER TABLE library_items ADD COLUMN IF NOT EXISTS file_sha256 TEXT;
+
+UPDATE library_items li
+SET file_sha256 = s.checksum_sha256
+FROM library_upload_sessions s
+WHERE s.library_item_id = li.id
+  AND s.status = 'completed'
+  AND s.checksum_sha256 <> ''
+  AND s.file_id = li.file_id
+  AND li.file_sha256 IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_library_items_file_sha256 ON library_items(file_sha256) WHERE file_sha256 IS NOT NULL;
+-- Duplicate detection of contributed questions compares content digests
+CREATE INDEX IF NOT EXISTS idx_question_content_md5 ON question(md5(content));
+
+-- One approve or reject decision taken from the moderation queue. submitted_at
+-- is copied from the submission so handling time and SLA stay answerable after
+-- the item or question changes.
+CREATE TABLE IF NOT EXISTS moderation_decisions (
+    id           TEXT PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    target_type  TEXT NOT NULL CHECK (target_type IN ('library_item', 'question')),
+    target_id    TEXT NOT NULL,
+    decision     TEXT NOT NULL CHECK (decision IN ('approved', 'rejected')),
+    reason_code  TEXT NOT NULL DEFAULT '',
+    reason       TEXT NOT NULL DEFAULT '',
+    submitter_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+    reviewer_id  TEXT REFERENCES users(id) ON DELETE SET NULL,
+    submitted_at TIMESTAMPTZ NOT NULL,
+    decided_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    within_sla   BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_decisions_target ON moderation_decisions(target_type, target_id, decided_at DESC);
+CREATE INDEX IF NOT EXISTS idx_moderation_decisions_reviewer ON moderation_decisions(decided_at, reviewer_id);
+
+-- Allow decision notifications to uploaders
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications
+    ADD CONSTRAINT notifications_type_check
+        CHECK (type IN (
+            'SECURITY_ALERT', 'COURSE_UPDATE', 'SYSTEM_MESSAGE', 'ACHIEVEMENT', 'SOCIAL', 'PAYMENT',
+            'ACCOUNT_ACTIVITY', 'NEW_CONTENT', 'EXAM_REMINDER', 'LOGIN_ALERT', 'PASSWORD_CHANGE',
+            'SESSION_EXPIRED', 'ENROLLMENT_UPDATE', 'QUESTION_REVIEW', 'GUARDIAN_LINK', 'PROGRESS_DIGEST',
+            'LIBRARY_ITEM_UPDATE', 'LIBRARY_MODERATION'
+        ));
+
+COMMENT ON TABLE moderation_decisions IS 'Approve/reject decisions on library uploads and contributed questions with reasons, for SLA and reviewer workload';
+COMMENT ON COLUMN library_items.file_sha256 IS 'SHA-256 of the served file when known, for duplicate detection';
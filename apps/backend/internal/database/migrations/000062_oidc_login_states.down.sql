@@ -0,0 +1,6 @@
+-- ==========================================
+-- OIDC Login State - Rollback
+-- Migration 000062 DOWN
+-- ==========================================
+
+DROP TABLE IF EXISTS oidc_login_states CASCADE;
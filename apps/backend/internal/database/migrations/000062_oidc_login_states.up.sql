@@ -0,0 +1,20 @@
+-- ==========================================
+-- OIDC Login State (state and nonce of authorization requests)
+-- Migration 000062
+-- ==========================================
+
+-- StartOIDCLogin stores the state and nonce it sends to the provider. The
+-- callback consumes the state once, and the ID token must carry the stored
+-- nonce, so a token issued for another login cannot be replayed.
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    state_hash    TEXT PRIMARY KEY,
+    provider      TEXT NOT NULL,
+    nonce         TEXT NOT NULL,
+    redirect_uri  TEXT NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_expires ON oidc_login_states(expires_at);
+
+COMMENT ON TABLE oidc_login_states IS 'Single-use OIDC authorization state (hashed) with the expected ID token nonce';
@@ -0,0 +1,6 @@
+-- ==========================================
+-- Library search report permission - Rollback
+-- Migration 000063 DOWN
+-- ==========================================
+
+DELETE FROM rbac_permissions WHERE name = 'library.search_report.read';
@@ -0,0 +1,15 @@
+-- ==========================================
+-- Library search report permission
+-- Migration 000063
+-- ==========================================
+
+-- GetSearchReport shows what every user searched for; teachers and admins hold it
+-- by default and other users can be granted it through rbac_user_grants.
+INSERT INTO rbac_permissions (name, description) VALUES
+    ('library.search_report.read', 'Read trending and zero-result library and question searches')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO rbac_role_permissions (role, permission) VALUES
+    ('ADMIN', 'library.search_report.read'),
+    ('TEACHER', 'library.search_report.read')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,6 @@
+-- ==========================================
+-- Library item versions permission - Rollback
+-- Migration 000064 DOWN
+-- ==========================================
+
+DELETE FROM rbac_permissions WHERE name = 'library.versions.manage';
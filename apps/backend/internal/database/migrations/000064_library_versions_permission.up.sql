@@ -0,0 +1,16 @@
+-- ==========================================
+-- Library item versions permission
+-- Migration 000064
+-- ==========================================
+
+-- Replacing and rolling back item files, and seeing the file locations of
+-- versions. Teachers and admins hold it by default; grants scoped to a
+-- library_item let other users manage the versions of that item only.
+INSERT INTO rbac_permissions (name, description) VALUES
+    ('library.versions.manage', 'Replace and roll back library item files and see version file locations')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO rbac_role_permissions (role, permission) VALUES
+    ('ADMIN', 'library.versions.manage'),
+    ('TEACHER', 'library.versions.manage')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,6 @@
+-- ==========================================
+-- Library moderation permission - Rollback
+-- Migration 000065 DOWN
+-- ==========================================
+
+DELETE FROM rbac_permissions WHERE name = 'library.moderate';
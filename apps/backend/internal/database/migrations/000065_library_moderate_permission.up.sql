@@ -0,0 +1,14 @@
+-- ==========================================
+-- Library moderation permission
+-- Migration 000065
+-- ==========================================
+
+-- The moderation queue RPCs (queue, decisions, reasons, workload). Admins hold it
+-- by default; other reviewers can be granted it through rbac_user_grants.
+INSERT INTO rbac_permissions (name, description) VALUES
+    ('library.moderate', 'Review the moderation queue and approve or reject pending library items and questions')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO rbac_role_permissions (role, permission) VALUES
+    ('ADMIN', 'library.moderate')
+ON CONFLICT DO NOTHING;
@@ -58,9 +58,43 @@ type MapCodeConfig struct {
 	Levels      map[string]string `json:"levels"`
 	Lessons     map[string]string `json:"lessons"`
 	Forms       map[string]string `json:"forms"`
+	Tree        []*MapCodeNode    `json:"tree,omitempty"` // Grade roots of the full hierarchy (dash-based files only)
 	CreatedAt   time.Time         `json:"created_at"`
 }
 
+// MapCodeNode represents one node of the grade→subject→chapter→lesson→form hierarchy.
+// Keys are only unique among siblings, so the flat maps above cannot rebuild the tree.
+type MapCodeNode struct {
+	Key      string         `json:"key"`
+	Name     string         `json:"name"`
+	Children []*MapCodeNode `json:"children,omitempty"`
+}
+
+// QuestionCodeUsage is a question code in use together with its parsed components
+type QuestionCodeUsage struct {
+	Code          string `json:"code"`
+	Grade         string `json:"grade"`
+	Subject       string `json:"subject"`
+	Chapter       string `json:"chapter"`
+	Level         string `json:"level"`
+	Lesson        string `json:"lesson"`
+	Form          string `json:"form"` // Empty for ID5 codes
+	QuestionCount int64  `json:"question_count"`
+}
+
+// MapCodeRemap records one old→new question code move (audit trail)
+type MapCodeRemap struct {
+	ID               string    `json:"id"`
+	BatchID          string    `json:"batch_id"`
+	VersionID        string    `json:"version_id"` // Version the new codes were validated against
+	OldCode          string    `json:"old_code"`
+	NewCode          string    `json:"new_code"`
+	QuestionsUpdated int64     `json:"questions_updated"`
+	PerformedBy      string    `json:"performed_by"`
+	Reason           string    `json:"reason"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
 // MapCodeVersionStatus represents the status of version operations
 type MapCodeVersionStatus string
 
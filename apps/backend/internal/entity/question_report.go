@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"time"
+)
+
+// ReportCategory classifies a student error report on a question
+type ReportCategory string
+
+const (
+	ReportCategoryWrongKey    ReportCategory = "WRONG_KEY"
+	ReportCategoryTypo        ReportCategory = "TYPO"
+	ReportCategoryAmbiguous   ReportCategory = "AMBIGUOUS"
+	ReportCategoryBrokenImage ReportCategory = "BROKEN_IMAGE"
+	ReportCategoryOther       ReportCategory = "OTHER"
+)
+
+// IsValid reports whether the category is a known value
+func (c ReportCategory) IsValid() bool {
+	switch c {
+	case ReportCategoryWrongKey, ReportCategoryTypo, ReportCategoryAmbiguous,
+		ReportCategoryBrokenImage, ReportCategoryOther:
+		return true
+	}
+	return false
+}
+
+// ReportStatus tracks a report through triage
+type ReportStatus string
+
+const (
+	ReportStatusOpen         ReportStatus = "OPEN"
+	ReportStatusAcknowledged ReportStatus = "ACKNOWLEDGED"
+	ReportStatusResolved     ReportStatus = "RESOLVED"
+	ReportStatusDismissed    ReportStatus = "DISMISSED"
+)
+
+// IsActive reports whether the report still needs attention
+func (s ReportStatus) IsActive() bool {
+	return s == ReportStatusOpen || s == ReportStatusAcknowledged
+}
+
+// QuestionErrorReport is a REPORT entry in question_feedback with its triage data
+type QuestionErrorReport struct {
+	ID             string         `json:"id" db:"id"`
+	QuestionID     string         `json:"question_id" db:"question_id"`
+	UserID         *string        `json:"user_id,omitempty" db:"user_id"`
+	Category       ReportCategory `json:"report_category" db:"report_category"`
+	Status         ReportStatus   `json:"report_status" db:"report_status"`
+	Content        *string        `json:"content,omitempty" db:"content"`
+	AttemptID      *string        `json:"attempt_id,omitempty" db:"attempt_id"`
+	IsCredible     bool           `json:"is_credible" db:"is_credible"`
+	ResolvedBy     *string        `json:"resolved_by,omitempty" db:"resolved_by"`
+	ResolvedAt     *time.Time     `json:"resolved_at,omitempty" db:"resolved_at"`
+	ResolutionNote *string        `json:"resolution_note,omitempty" db:"resolution_note"`
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+}
+
+// TableName returns the table name for QuestionErrorReport
+func (QuestionErrorReport) TableName() string {
+	return "question_feedback"
+}
+
+// ReportTriageItem groups the active reports of one question for the triage queue
+type ReportTriageItem struct {
+	QuestionID      string                 `json:"question_id"`
+	QuestionCodeID  string                 `json:"question_code_id"`
+	ContentPreview  string                 `json:"content_preview"`
+	Status          ReportStatus           `json:"status"` // OPEN if any report is still unacknowledged
+	ReportCount     int                    `json:"report_count"`
+	CredibleCount   int                    `json:"credible_count"`
+	CategoryCounts  map[ReportCategory]int `json:"category_counts"`
+	IsSuspended     bool                   `json:"is_suspended"`
+	FirstReportedAt time.Time              `json:"first_reported_at"`
+	LastReportedAt  time.Time              `json:"last_reported_at"`
+}
+
+// QuestionSuspension withholds a question from new exams
+type QuestionSuspension struct {
+	QuestionID      string    `json:"question_id" db:"question_id"`
+	Reason          string    `json:"reason" db:"reason"`
+	CredibleReports int       `json:"credible_reports" db:"credible_reports"`
+	SuspendedBy     *string   `json:"suspended_by,omitempty" db:"suspended_by"`
+	SuspendedAt     time.Time `json:"suspended_at" db:"suspended_at"`
+}
+
+// TableName returns the table name for QuestionSuspension
+func (QuestionSuspension) TableName() string {
+	return "question_suspensions"
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuestionReviewStatus represents the state of a review round
+type QuestionReviewStatus string
+
+const (
+	ReviewStatusInReview         QuestionReviewStatus = "IN_REVIEW"
+	ReviewStatusChangesRequested QuestionReviewStatus = "CHANGES_REQUESTED"
+	ReviewStatusApproved         QuestionReviewStatus = "APPROVED"
+	ReviewStatusRejected         QuestionReviewStatus = "REJECTED"
+)
+
+// IsOpen reports whether the review round still awaits a final decision
+func (s QuestionReviewStatus) IsOpen() bool {
+	return s == ReviewStatusInReview || s == ReviewStatusChangesRequested
+}
+
+// QuestionReview represents one review round of a contributed question
+type QuestionReview struct {
+	ID            uuid.UUID            `json:"id" db:"id"`
+	QuestionID    string               `json:"question_id" db:"question_id"`
+	AuthorID      string               `json:"author_id" db:"author_id"`
+	ReviewerID    *string              `json:"reviewer_id,omitempty" db:"reviewer_id"`
+	Status        QuestionReviewStatus `json:"status" db:"status"`
+	VersionNumber int32                `json:"version_number" db:"version_number"`
+	Subject       *string              `json:"subject,omitempty" db:"subject"`
+	Grade         *string              `json:"grade,omitempty" db:"grade"`
+	DecisionNote  *string              `json:"decision_note,omitempty" db:"decision_note"`
+	SubmittedAt   time.Time            `json:"submitted_at" db:"submitted_at"`
+	DecidedAt     *time.Time           `json:"decided_at,omitempty" db:"decided_at"`
+	CreatedAt     time.Time            `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for QuestionReview
+func (QuestionReview) TableName() string {
+	return "question_reviews"
+}
+
+// QuestionReviewComment represents an inline comment on a question version.
+// Replies reference their parent through ParentID.
+type QuestionReviewComment struct {
+	ID            uuid.UUID  `json:"id" db:"id"`
+	ReviewID      uuid.UUID  `json:"review_id" db:"review_id"`
+	QuestionID    string     `json:"question_id" db:"question_id"`
+	VersionNumber int32      `json:"version_number" db:"version_number"`
+	ParentID      *uuid.UUID `json:"parent_id,omitempty" db:"parent_id"`
+	AuthorID      string     `json:"author_id" db:"author_id"`
+	Field         *string    `json:"field,omitempty" db:"field"`
+	LineStart     *int32     `json:"line_start,omitempty" db:"line_start"`
+	LineEnd       *int32     `json:"line_end,omitempty" db:"line_end"`
+	Body          string     `json:"body" db:"body"`
+	IsResolved    bool       `json:"is_resolved" db:"is_resolved"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// TableName returns the table name for QuestionReviewComment
+func (QuestionReviewComment) TableName() string {
+	return "question_review_comments"
+}
+
+// ReviewerSubject assigns a reviewer to a QuestionCode subject (and optional grade)
+type ReviewerSubject struct {
+	ID         uuid.UUID `json:"id" db:"id"`
+	ReviewerID string    `json:"reviewer_id" db:"reviewer_id"`
+	Subject    string    `json:"subject" db:"subject"`
+	Grade      *string   `json:"grade,omitempty" db:"grade"`
+	IsActive   bool      `json:"is_active" db:"is_active"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// TableName returns the table name for ReviewerSubject
+func (ReviewerSubject) TableName() string {
+	return "question_reviewer_subjects"
+}
+
+// ReviewerWorkload summarises a reviewer's queue for dashboards and assignment
+type ReviewerWorkload struct {
+	ReviewerID       string `json:"reviewer_id"`
+	InReview         int    `json:"in_review"`
+	ChangesRequested int    `json:"changes_requested"`
+	ApprovedRecent   int    `json:"approved_recent"`
+	RejectedRecent   int    `json:"rejected_recent"`
+}
+
+// OutstandingReview is a review awaiting action together with question context
+type OutstandingReview struct {
+	Review             QuestionReview `json:"review"`
+	QuestionCodeID     string         `json:"question_code_id"`
+	QuestionType       string         `json:"question_type"`
+	ContentPreview     string         `json:"content_preview"`
+	UnresolvedComments int            `json:"unresolved_comments"`
+}
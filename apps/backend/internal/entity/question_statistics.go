@@ -100,6 +100,19 @@ type QuestionCodeStatistics struct {
 	LastUpdated time.Time `json:"last_updated"`
 }
 
+// QuestionCoverageCount is the number of questions for one
+// grade/subject/chapter/lesson/form combination, split by type and difficulty
+type QuestionCoverageCount struct {
+	Grade      string `json:"grade"`
+	Subject    string `json:"subject"`
+	Chapter    string `json:"chapter"`
+	Lesson     string `json:"lesson"`
+	Form       string `json:"form"`
+	Type       string `json:"type"`
+	Difficulty string `json:"difficulty"`
+	Count      int64  `json:"count"`
+}
+
 // TrendData represents trend information over time
 type TrendData struct {
 	Date  time.Time `json:"date"`
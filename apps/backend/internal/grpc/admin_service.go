@@ -11,6 +11,8 @@ import (
 	"exam-bank-system/apps/backend/internal/middleware"
 	"exam-bank-system/apps/backend/internal/repository"
 	"exam-bank-system/apps/backend/internal/repository/interfaces"
+	"exam-bank-system/apps/backend/internal/service/auth/apikey"
+	"exam-bank-system/apps/backend/internal/service/auth/rbac"
 	"exam-bank-system/apps/backend/internal/service/notification"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
@@ -39,6 +41,16 @@ type AdminServiceServer struct {
 	notificationRepo repository.NotificationRepository
 	metricsRepo      interfaces.MetricsRepository // NEW: Metrics repository for time-series data
 
+	// Permission management (RBAC); set via SetPermissionManagement
+	permissionRepo      *repository.PermissionRepository
+	permissionEvaluator *rbac.Evaluator
+
+	// Security events for GetSecurityAlerts; set via SetSecurityEventRepository
+	securityEventRepo *repository.SecurityEventRepository
+
+	// Service accounts and API keys; set via SetAPIKeyService
+	apiKeyService *apikey.Service
+
 	// Cache for system stats - thread-safe with sync.Map
 	// Key: "system_stats" (global cache, not per-user since all admins see same data)
 	// Value: *SystemStatsCache
@@ -0,0 +1,232 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/apikey"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetAPIKeyService enables the service account and API key RPCs
+func (s *AdminServiceServer) SetAPIKeyService(apiKeyService *apikey.Service) {
+	s.apiKeyService = apiKeyService
+}
+
+// CreateServiceAccount creates a service account for an integration
+func (s *AdminServiceServer) CreateServiceAccount(ctx context.Context, req *v1.CreateServiceAccountRequest) (*v1.CreateServiceAccountResponse, error) {
+	if err := s.checkAPIKeyManagement(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.validateRoleLevel(req.Role, req.Level); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	createdBy, _ := middleware.GetUserIDFromContext(ctx)
+	account, err := s.apiKeyService.CreateServiceAccount(ctx, req.Name, req.Description,
+		convertProtoRoleToString(req.Role), int(req.Level), createdBy)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &v1.CreateServiceAccountResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Service account created successfully",
+		},
+		ServiceAccount: serviceAccountToProto(account),
+	}, nil
+}
+
+// ListServiceAccounts lists all service accounts
+func (s *AdminServiceServer) ListServiceAccounts(ctx context.Context, req *v1.ListServiceAccountsRequest) (*v1.ListServiceAccountsResponse, error) {
+	if err := s.checkAPIKeyManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.apiKeyService.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	result := make([]*v1.ServiceAccount, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, serviceAccountToProto(account))
+	}
+
+	return &v1.ListServiceAccountsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Service accounts retrieved successfully",
+		},
+		ServiceAccounts: result,
+	}, nil
+}
+
+// DisableServiceAccount disables a service account and revokes all of its keys
+func (s *AdminServiceServer) DisableServiceAccount(ctx context.Context, req *v1.DisableServiceAccountRequest) (*v1.DisableServiceAccountResponse, error) {
+	if err := s.checkAPIKeyManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.apiKeyService.DisableServiceAccount(ctx, req.ServiceAccountId); err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &v1.DisableServiceAccountResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Service account disabled successfully",
+		},
+	}, nil
+}
+
+// CreateApiKey issues an API key. The secret is only returned in this response.
+func (s *AdminServiceServer) CreateApiKey(ctx context.Context, req *v1.CreateApiKeyRequest) (*v1.CreateApiKeyResponse, error) {
+	if err := s.checkAPIKeyManagement(ctx); err != nil {
+		return nil, err
+	}
+	if req.TtlDays < 0 || req.RateLimitPerMinute < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_days and rate_limit_per_minute must not be negative")
+	}
+
+	createdBy, _ := middleware.GetUserIDFromContext(ctx)
+	ttl := time.Duration(req.TtlDays) * 24 * time.Hour
+	key, secret, err := s.apiKeyService.CreateKey(ctx, req.ServiceAccountId, req.Name, req.Scopes, ttl,
+		int(req.RateLimitPerMinute), createdBy)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &v1.CreateApiKeyResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "API key created; store the secret now, it will not be shown again",
+		},
+		ApiKey: apiKeyToProto(key, time.Now()),
+		Secret: secret,
+	}, nil
+}
+
+// ListApiKeys lists the keys of a service account without their secrets
+func (s *AdminServiceServer) ListApiKeys(ctx context.Context, req *v1.ListApiKeysRequest) (*v1.ListApiKeysResponse, error) {
+	if err := s.checkAPIKeyManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.apiKeyService.ListKeys(ctx, req.ServiceAccountId)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	now := time.Now()
+	result := make([]*v1.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, apiKeyToProto(key, now))
+	}
+
+	return &v1.ListApiKeysResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "API keys retrieved successfully",
+		},
+		ApiKeys: result,
+	}, nil
+}
+
+// RevokeApiKey revokes an API key
+func (s *AdminServiceServer) RevokeApiKey(ctx context.Context, req *v1.RevokeApiKeyRequest) (*v1.RevokeApiKeyResponse, error) {
+	if err := s.checkAPIKeyManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.apiKeyService.RevokeKey(ctx, req.ServiceAccountId, req.KeyId); err != nil {
+		return nil, apiKeyError(err)
+	}
+
+	return &v1.RevokeApiKeyResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "API key revoked successfully",
+		},
+	}, nil
+}
+
+// checkAPIKeyManagement requires the API key service and an interactive admin; the
+// API key service also refuses these methods for keys, this covers misconfigured scopes
+func (s *AdminServiceServer) checkAPIKeyManagement(ctx context.Context) error {
+	if s.apiKeyService == nil {
+		return status.Errorf(codes.Unimplemented, "service accounts are not enabled")
+	}
+	if _, ok := middleware.GetAPIKeyFromContext(ctx); ok {
+		return status.Errorf(codes.PermissionDenied, "API keys cannot manage service accounts")
+	}
+	return nil
+}
+
+func apiKeyError(err error) error {
+	switch {
+	case errors.Is(err, apikey.ErrAccountNotFound), errors.Is(err, apikey.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, apikey.ErrAccountExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, apikey.ErrAccountDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, apikey.ErrInvalidName), errors.Is(err, apikey.ErrInvalidRole),
+		errors.Is(err, apikey.ErrInvalidScope), errors.Is(err, apikey.ErrInvalidTTL),
+		errors.Is(err, apikey.ErrInvalidRateLimit):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "service account operation failed: %v", err)
+	}
+}
+
+func serviceAccountToProto(a *repository.ServiceAccount) *v1.ServiceAccount {
+	account := &v1.ServiceAccount{
+		Id:             a.ID,
+		Name:           a.Name,
+		Description:    a.Description,
+		Email:          a.Email,
+		Role:           ConvertRoleToProto(a.Role),
+		Level:          int32(a.Level),
+		CreatedBy:      a.CreatedBy,
+		ActiveKeyCount: int32(a.KeyCount),
+		Disabled:       a.DisabledAt != nil,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+	}
+	if a.DisabledAt != nil {
+		account.DisabledAt = timestamppb.New(*a.DisabledAt)
+	}
+	return account
+}
+
+func apiKeyToProto(k *repository.APIKey, now time.Time) *v1.ApiKey {
+	key := &v1.ApiKey{
+		Id:                 k.ID,
+		ServiceAccountId:   k.ServiceAccountID,
+		Name:               k.Name,
+		Prefix:             k.Prefix,
+		Scopes:             k.Scopes,
+		RateLimitPerMinute: int32(k.RateLimitPerMinute),
+		ExpiresAt:          timestamppb.New(k.ExpiresAt),
+		LastUsedIp:         k.LastUsedIP,
+		CreatedBy:          k.CreatedBy,
+		CreatedAt:          timestamppb.New(k.CreatedAt),
+		Active:             k.RevokedAt == nil && k.ExpiresAt.After(now),
+	}
+	if k.LastUsedAt != nil {
+		key.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		key.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return key
+}
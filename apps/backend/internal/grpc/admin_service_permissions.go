@@ -0,0 +1,426 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/rbac"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// rbacManagePermission guards the permission management RPCs themselves; it cannot
+// be taken away from ADMIN or moved off those RPCs, so admins cannot lock themselves out
+const rbacManagePermission = "rbac.manage"
+
+var permissionNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_.]{1,63}$`)
+
+// SetPermissionManagement enables the permission management RPCs
+func (s *AdminServiceServer) SetPermissionManagement(permissionRepo *repository.PermissionRepository, evaluator *rbac.Evaluator) {
+	s.permissionRepo = permissionRepo
+	s.permissionEvaluator = evaluator
+}
+
+// ListPermissions lists all named permissions
+func (s *AdminServiceServer) ListPermissions(ctx context.Context, req *v1.ListPermissionsRequest) (*v1.ListPermissionsResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.permissionRepo.ListPermissions(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list permissions: %v", err)
+	}
+
+	result := make([]*v1.PermissionInfo, 0, len(permissions))
+	for _, p := range permissions {
+		result = append(result, &v1.PermissionInfo{Name: p.Name, Description: p.Description})
+	}
+
+	return &v1.ListPermissionsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Permissions retrieved successfully",
+		},
+		Permissions: result,
+	}, nil
+}
+
+// CreatePermission creates a named permission or updates its description
+func (s *AdminServiceServer) CreatePermission(ctx context.Context, req *v1.CreatePermissionRequest) (*v1.CreatePermissionResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+	if !permissionNamePattern.MatchString(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "permission name must be lowercase letters, digits, '_' or '.'")
+	}
+
+	permission := &repository.Permission{Name: req.Name, Description: strings.TrimSpace(req.Description)}
+	if err := s.permissionRepo.UpsertPermission(ctx, permission); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create permission: %v", err)
+	}
+
+	return &v1.CreatePermissionResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Permission saved successfully",
+		},
+		Permission: &v1.PermissionInfo{Name: permission.Name, Description: permission.Description},
+	}, nil
+}
+
+// ListRolePermissions lists the permission set of every role
+func (s *AdminServiceServer) ListRolePermissions(ctx context.Context, req *v1.ListRolePermissionsRequest) (*v1.ListRolePermissionsResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	rolePermissions, err := s.permissionRepo.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list role permissions: %v", err)
+	}
+
+	byRole := make(map[string][]string)
+	for _, rp := range rolePermissions {
+		byRole[rp.Role] = append(byRole[rp.Role], rp.Permission)
+	}
+
+	roles := []common.UserRole{
+		common.UserRole_USER_ROLE_ADMIN,
+		common.UserRole_USER_ROLE_TEACHER,
+		common.UserRole_USER_ROLE_TUTOR,
+		common.UserRole_USER_ROLE_STUDENT,
+		common.UserRole_USER_ROLE_GUEST,
+	}
+	result := make([]*v1.RolePermissionSet, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, &v1.RolePermissionSet{
+			Role:        role,
+			Permissions: byRole[convertProtoRoleToString(role)],
+		})
+	}
+
+	return &v1.ListRolePermissionsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Role permissions retrieved successfully",
+		},
+		Roles: result,
+	}, nil
+}
+
+// SetRolePermissions replaces the permission set of a role
+func (s *AdminServiceServer) SetRolePermissions(ctx context.Context, req *v1.SetRolePermissionsRequest) (*v1.SetRolePermissionsResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	role := convertProtoRoleToString(req.Role)
+	if role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", req.Role)
+	}
+	permissions, err := s.validatePermissions(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if req.Role == common.UserRole_USER_ROLE_ADMIN && !containsString(permissions, rbacManagePermission) {
+		return nil, status.Errorf(codes.FailedPrecondition, "ADMIN must keep the %s permission", rbacManagePermission)
+	}
+
+	if err := s.permissionRepo.SetRolePermissions(ctx, role, permissions); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set role permissions: %v", err)
+	}
+	s.permissionEvaluator.Invalidate()
+
+	return &v1.SetRolePermissionsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Role permissions updated successfully",
+		},
+		Role: &v1.RolePermissionSet{Role: req.Role, Permissions: permissions},
+	}, nil
+}
+
+// ListMethodPermissions lists the permission required by each protected method
+func (s *AdminServiceServer) ListMethodPermissions(ctx context.Context, req *v1.ListMethodPermissionsRequest) (*v1.ListMethodPermissionsResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	methods, err := s.permissionRepo.ListMethodPermissions(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list method permissions: %v", err)
+	}
+
+	result := make([]*v1.MethodPermission, 0, len(methods))
+	for _, m := range methods {
+		result = append(result, methodPermissionToProto(m))
+	}
+
+	return &v1.ListMethodPermissionsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Method permissions retrieved successfully",
+		},
+		Methods: result,
+	}, nil
+}
+
+// SetMethodPermission sets or removes the permission a method requires
+func (s *AdminServiceServer) SetMethodPermission(ctx context.Context, req *v1.SetMethodPermissionRequest) (*v1.SetMethodPermissionResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	m := req.Method
+	if m == nil || !strings.HasPrefix(m.Method, "/") || !strings.Contains(m.Method[1:], "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "method must be a full gRPC method name such as /v1.ExamService/DeleteExam")
+	}
+	if err := s.checkMethodNotProtected(ctx, m.Method); err != nil {
+		return nil, err
+	}
+
+	if req.Remove {
+		if err := s.permissionRepo.DeleteMethodPermission(ctx, m.Method); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "no permission is set for %s", m.Method)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to remove method permission: %v", err)
+		}
+		s.permissionEvaluator.Invalidate()
+
+		return &v1.SetMethodPermissionResponse{
+			Response: &common.Response{
+				Success: true,
+				Message: "Method permission removed successfully",
+			},
+		}, nil
+	}
+
+	if _, err := s.validatePermissions(ctx, []string{m.Permission}); err != nil {
+		return nil, err
+	}
+	if m.MinLevel < 0 || m.MaxLevel < 0 || (m.MaxLevel > 0 && m.MinLevel > m.MaxLevel) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid level bounds %d-%d", m.MinLevel, m.MaxLevel)
+	}
+	if (m.ResourceType == "") != (m.ResourceField == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type and resource_field must be set together")
+	}
+
+	method := &repository.MethodPermission{
+		Method:        m.Method,
+		Permission:    m.Permission,
+		MinLevel:      int(m.MinLevel),
+		MaxLevel:      int(m.MaxLevel),
+		ResourceType:  m.ResourceType,
+		ResourceField: m.ResourceField,
+	}
+	if err := s.permissionRepo.UpsertMethodPermission(ctx, method); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set method permission: %v", err)
+	}
+	s.permissionEvaluator.Invalidate()
+
+	return &v1.SetMethodPermissionResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Method permission updated successfully",
+		},
+		Method: methodPermissionToProto(method),
+	}, nil
+}
+
+// ListPermissionGrants lists a user's grants, including revoked and expired ones
+func (s *AdminServiceServer) ListPermissionGrants(ctx context.Context, req *v1.ListPermissionGrantsRequest) (*v1.ListPermissionGrantsResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+
+	grants, err := s.permissionRepo.ListGrants(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list grants: %v", err)
+	}
+
+	now := time.Now()
+	result := make([]*v1.PermissionGrant, 0, len(grants))
+	for _, g := range grants {
+		result = append(result, permissionGrantToProto(g, now))
+	}
+
+	return &v1.ListPermissionGrantsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Permission grants retrieved successfully",
+		},
+		Grants: result,
+	}, nil
+}
+
+// GrantPermission grants a permission to a user, optionally for one resource and until a deadline
+func (s *AdminServiceServer) GrantPermission(ctx context.Context, req *v1.GrantPermissionRequest) (*v1.GrantPermissionResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+	if (req.ResourceType == "") != (req.ResourceId == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type and resource_id must be set together")
+	}
+	if _, err := s.validatePermissions(ctx, []string{req.Permission}); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepo.GetByID(ctx, req.UserId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	grant := &repository.PermissionGrant{
+		UserID:       req.UserId,
+		Permission:   req.Permission,
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceId,
+		Reason:       strings.TrimSpace(req.Reason),
+	}
+	grant.GrantedBy, _ = middleware.GetUserIDFromContext(ctx)
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		if !expiresAt.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		grant.ExpiresAt = &expiresAt
+	}
+
+	if err := s.permissionRepo.CreateGrant(ctx, grant); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to grant permission: %v", err)
+	}
+	s.permissionEvaluator.InvalidateUser(grant.UserID)
+
+	return &v1.GrantPermissionResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Permission granted successfully",
+		},
+		Grant: permissionGrantToProto(grant, time.Now()),
+	}, nil
+}
+
+// RevokePermissionGrant revokes an active grant
+func (s *AdminServiceServer) RevokePermissionGrant(ctx context.Context, req *v1.RevokePermissionGrantRequest) (*v1.RevokePermissionGrantResponse, error) {
+	if err := s.checkPermissionManagement(ctx); err != nil {
+		return nil, err
+	}
+
+	grant, err := s.permissionRepo.RevokeGrant(ctx, req.GrantId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "active grant not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke grant: %v", err)
+	}
+	s.permissionEvaluator.InvalidateUser(grant.UserID)
+
+	return &v1.RevokePermissionGrantResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: "Permission grant revoked successfully",
+		},
+		Grant: permissionGrantToProto(grant, time.Now()),
+	}, nil
+}
+
+// checkPermissionManagement rejects calls when permission management is not wired up.
+// Access itself is enforced by RoleLevelInterceptor through the rbac.manage permission.
+func (s *AdminServiceServer) checkPermissionManagement(ctx context.Context) error {
+	if s.permissionRepo == nil || s.permissionEvaluator == nil {
+		return status.Errorf(codes.Unimplemented, "permission management is not enabled")
+	}
+	return nil
+}
+
+// validatePermissions checks that every name is a known permission and removes duplicates
+func (s *AdminServiceServer) validatePermissions(ctx context.Context, names []string) ([]string, error) {
+	known, err := s.permissionRepo.ListPermissions(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list permissions: %v", err)
+	}
+	exists := make(map[string]bool, len(known))
+	for _, p := range known {
+		exists[p.Name] = true
+	}
+
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if !exists[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown permission %q", name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+	return result, nil
+}
+
+// checkMethodNotProtected refuses to change the rules guarding permission management
+func (s *AdminServiceServer) checkMethodNotProtected(ctx context.Context, method string) error {
+	methods, err := s.permissionRepo.ListMethodPermissions(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list method permissions: %v", err)
+	}
+	for _, m := range methods {
+		if m.Method == method && m.Permission == rbacManagePermission {
+			return status.Errorf(codes.FailedPrecondition, "%s guards permission management and cannot be changed", method)
+		}
+	}
+	return nil
+}
+
+func methodPermissionToProto(m *repository.MethodPermission) *v1.MethodPermission {
+	return &v1.MethodPermission{
+		Method:        m.Method,
+		Permission:    m.Permission,
+		MinLevel:      int32(m.MinLevel),
+		MaxLevel:      int32(m.MaxLevel),
+		ResourceType:  m.ResourceType,
+		ResourceField: m.ResourceField,
+	}
+}
+
+func permissionGrantToProto(g *repository.PermissionGrant, now time.Time) *v1.PermissionGrant {
+	grant := &v1.PermissionGrant{
+		Id:           g.ID,
+		UserId:       g.UserID,
+		Permission:   g.Permission,
+		ResourceType: g.ResourceType,
+		ResourceId:   g.ResourceID,
+		GrantedBy:    g.GrantedBy,
+		Reason:       g.Reason,
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+		Active:       g.RevokedAt == nil && (g.ExpiresAt == nil || g.ExpiresAt.After(now)),
+	}
+	if g.ExpiresAt != nil {
+		grant.ExpiresAt = timestamppb.New(*g.ExpiresAt)
+	}
+	if g.RevokedAt != nil {
+		grant.RevokedAt = timestamppb.New(*g.RevokedAt)
+	}
+	return grant
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetSecurityEventRepository enables GetSecurityAlerts
+func (s *AdminServiceServer) SetSecurityEventRepository(securityEventRepo *repository.SecurityEventRepository) {
+	s.securityEventRepo = securityEventRepo
+}
+
+// GetSecurityAlerts lists recorded security events, newest first. Login risk decisions
+// are stored with alert type LOGIN_RISK; allowed logins are resolved, so unresolved_only
+// returns the challenged and refused ones.
+func (s *AdminServiceServer) GetSecurityAlerts(ctx context.Context, req *v1.GetSecurityAlertsRequest) (*v1.GetSecurityAlertsResponse, error) {
+	if err := s.checkAdminPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	if s.securityEventRepo == nil {
+		return nil, status.Errorf(codes.Unimplemented, "security events not configured")
+	}
+
+	page, limit := 1, 50
+	if req.Pagination != nil {
+		if req.Pagination.Limit > 0 && req.Pagination.Limit <= 200 {
+			limit = int(req.Pagination.Limit)
+		}
+		if req.Pagination.Page > 0 {
+			page = int(req.Pagination.Page)
+		}
+	}
+
+	events, total, err := s.securityEventRepo.FindWithFilters(ctx, repository.SecurityEventFilters{
+		UserID:         req.UserId,
+		ThreatType:     req.AlertType,
+		UnresolvedOnly: req.UnresolvedOnly,
+		Limit:          limit,
+		Offset:         (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get security alerts: %v", err)
+	}
+
+	alerts := make([]*v1.SecurityAlert, 0, len(events))
+	for _, event := range events {
+		alerts = append(alerts, securityEventToAlert(event))
+	}
+
+	return &v1.GetSecurityAlertsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: fmt.Sprintf("Found %d security alerts", total),
+		},
+		Alerts: alerts,
+		Pagination: &common.PaginationResponse{
+			Page:       int32(page),
+			Limit:      int32(limit),
+			TotalCount: int32(total),
+			TotalPages: int32((total + limit - 1) / limit),
+		},
+	}, nil
+}
+
+// securityEventToAlert flattens a security event; the columns without a proto field go
+// into the details JSON next to the event metadata
+func securityEventToAlert(event *entity.SecurityEvent) *v1.SecurityAlert {
+	details := map[string]interface{}{
+		"id":                 uuid.UUID(event.ID.Bytes).String(),
+		"risk_score":         event.RiskScore.Int,
+		"status":             event.Status.String,
+		"ip_address":         event.IPAddress.String,
+		"user_agent":         event.UserAgent.String,
+		"device_fingerprint": event.DeviceFingerprint.String,
+		"location":           event.Location.String,
+		"created_at":         event.CreatedAt.Time.Unix(),
+	}
+	if len(event.Metadata.Bytes) > 0 {
+		details["metadata"] = json.RawMessage(event.Metadata.Bytes)
+	}
+	raw, err := json.Marshal(details)
+	if err != nil {
+		raw = []byte("{}")
+	}
+
+	return &v1.SecurityAlert{
+		UserId:    event.UserID.String,
+		AlertType: event.ThreatType.String,
+		Message:   event.Description.String,
+		Details:   string(raw),
+	}
+}
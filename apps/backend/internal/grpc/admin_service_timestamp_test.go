@@ -67,6 +67,9 @@ func (m *mockResourceAccessRepo) GetSuspiciousAccesses(ctx context.Context, minR
 func (m *mockResourceAccessRepo) CountUserAccesses(ctx context.Context, userID string, since time.Time) (int, error) {
 	return 0, nil
 }
+func (m *mockResourceAccessRepo) CountUserActions(ctx context.Context, userID, action string, since time.Time) (int, error) {
+	return 0, nil
+}
 func (m *mockResourceAccessRepo) CalculateRiskScore(ctx context.Context, userID string) (int, error) {
 	return 0, nil
 }
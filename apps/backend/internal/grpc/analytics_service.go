@@ -99,7 +99,7 @@ func (s *AnalyticsServiceServer) GetTeacherStudents(ctx context.Context, req *v1
 	}
 
 	// Get students data
-	students, total, err := s.teacherAnalytics.GetTeacherStudents(ctx, req.GetTeacherId(), page, pageSize)
+	students, total, err := s.teacherAnalytics.GetTeacherStudents(ctx, req.GetTeacherId(), req.GetClassId(), req.GetOrganisationId(), page, pageSize)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get teacher students: %v", err)
 	}
@@ -163,7 +163,7 @@ func (s *AnalyticsServiceServer) GetTeacherExams(ctx context.Context, req *v1.Ge
 	}
 
 	// Get exams data
-	exams, total, err := s.teacherAnalytics.GetTeacherExams(ctx, req.GetTeacherId(), req.GetStatus(), page, pageSize)
+	exams, total, err := s.teacherAnalytics.GetTeacherExams(ctx, req.GetTeacherId(), req.GetStatus(), req.GetOrganisationId(), page, pageSize)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get teacher exams: %v", err)
 	}
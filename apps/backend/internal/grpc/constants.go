@@ -82,6 +82,7 @@ const (
 	ErrAccountLocked        = "account is locked until %v"
 	ErrAccountInactive      = "user account is %s"
 	ErrTooManyLoginAttempts = "account has been locked due to too many failed login attempts. Try again after 30 minutes"
+	ErrLoginRiskDenied      = "login blocked because it looks suspicious. Try again from a known device or contact support"
 	ErrUserNotAuthenticated = "user not authenticated"
 	ErrPermissionDenied     = "only admin can update other users"
 
@@ -114,4 +115,27 @@ const (
 	MsgUserRetrievedSuccess   = "User retrieved successfully"
 	MsgUserUpdatedSuccess     = "User updated successfully"
 	MsgVerificationEmailSent  = "Verification email sent successfully"
+
+	// Two-factor messages
+	MsgTwoFactorRequired        = "Two-factor verification required"
+	MsgTwoFactorEnrollRequired  = "Two-factor enrollment required for this account"
+	MsgTwoFactorStatusRetrieved = "Two-factor status retrieved successfully"
+	MsgTwoFactorEnrollStarted   = "Scan the secret with your authenticator app and confirm with a code"
+	MsgTwoFactorEnabled         = "Two-factor authentication enabled"
+	MsgTwoFactorDisabled        = "Two-factor authentication disabled"
+	MsgRecoveryCodesRegenerated = "Recovery codes regenerated"
+	MsgTwoFactorReset           = "Two-factor authentication reset"
+
+	// Passwordless messages
+	MsgLoginCodeSent = "If the email exists, a login code has been sent"
+
+	// Login risk messages
+	MsgLoginVerificationRequired = "Unusual sign-in: enter the code sent to your email"
+
+	// OIDC messages
+	MsgOIDCProvidersRetrieved  = "OIDC providers retrieved successfully"
+	MsgOIDCLoginStarted        = "OIDC login started"
+	MsgLinkedAccountsRetrieved = "Linked accounts retrieved successfully"
+	MsgAccountLinked           = "Account linked successfully"
+	MsgAccountUnlinked         = "Account unlinked successfully"
 )
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"exam-bank-system/apps/backend/internal/entity"
@@ -9,6 +10,8 @@ import (
 	"exam-bank-system/apps/backend/internal/repository/interfaces"
 	"exam-bank-system/apps/backend/internal/service/exam"
 	"exam-bank-system/apps/backend/internal/service/exam/scoring"
+	"exam-bank-system/apps/backend/internal/service/organisation"
+	"exam-bank-system/apps/backend/internal/service/question"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
 
@@ -24,6 +27,10 @@ type ExamServiceServer struct {
 	examService *exam.ExamService
 	autoGrading *scoring.AutoGradingService
 	examRepo    interfaces.ExamRepository
+	questions   *question.QuestionService
+
+	// Optional organisation scoping; nil leaves every exam visible
+	organisations *organisation.Service
 }
 
 // NewExamServiceServer creates a new ExamServiceServer
@@ -31,14 +38,21 @@ func NewExamServiceServer(
 	examService *exam.ExamService,
 	autoGrading *scoring.AutoGradingService,
 	examRepo interfaces.ExamRepository,
+	questions *question.QuestionService,
 ) *ExamServiceServer {
 	return &ExamServiceServer{
 		examService: examService,
 		autoGrading: autoGrading,
 		examRepo:    examRepo,
+		questions:   questions,
 	}
 }
 
+// SetOrganisationScope enables organisation scoping of exams
+func (s *ExamServiceServer) SetOrganisationScope(organisations *organisation.Service) {
+	s.organisations = organisations
+}
+
 // CreateExam creates a new exam
 func (s *ExamServiceServer) CreateExam(ctx context.Context, req *v1.CreateExamRequest) (*v1.CreateExamResponse, error) {
 	// Get user from context for authorization
@@ -54,6 +68,15 @@ func (s *ExamServiceServer) CreateExam(ctx context.Context, req *v1.CreateExamRe
 	if req.GetDurationMinutes() <= 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "exam duration must be positive")
 	}
+	orgID := req.GetOrganisationId()
+	if orgID != "" {
+		if s.organisations == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "organisations are not enabled")
+		}
+		if err := s.organisations.RequireStaff(ctx, organisationActor(ctx, userID), orgID); err != nil {
+			return nil, organisationError(err)
+		}
+	}
 
 	// Convert protobuf to entity (now with all fields)
 	exam := convertProtoToExam(req, userID)
@@ -63,6 +86,11 @@ func (s *ExamServiceServer) CreateExam(ctx context.Context, req *v1.CreateExamRe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create exam: %v", err)
 	}
+	if orgID != "" {
+		if err := s.organisations.AssignExam(ctx, organisationActor(ctx, userID), exam.ID, orgID); err != nil {
+			return nil, organisationError(err)
+		}
+	}
 
 	// Convert entity back to protobuf
 	protoExam := convertExamToProto(exam)
@@ -79,7 +107,7 @@ func (s *ExamServiceServer) CreateExam(ctx context.Context, req *v1.CreateExamRe
 // GetExam retrieves an exam by ID
 func (s *ExamServiceServer) GetExam(ctx context.Context, req *v1.GetExamRequest) (*v1.GetExamResponse, error) {
 	// Get user from context for authorization
-	_, err := middleware.GetUserIDFromContext(ctx)
+	userID, err := middleware.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user from context: %v", err)
 	}
@@ -88,6 +116,9 @@ func (s *ExamServiceServer) GetExam(ctx context.Context, req *v1.GetExamRequest)
 	if req.GetId() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "exam ID is required")
 	}
+	if err := s.checkExamOrganisation(ctx, userID, req.GetId()); err != nil {
+		return nil, err
+	}
 
 	// Get exam from service management layer
 	exam, err := s.examService.GetExamByID(ctx, req.GetId())
@@ -187,11 +218,22 @@ func (s *ExamServiceServer) SubmitExam(ctx context.Context, req *v1.SubmitExamRe
 // ListExams lists exams with pagination
 func (s *ExamServiceServer) ListExams(ctx context.Context, req *v1.ListExamsRequest) (*v1.ListExamsResponse, error) {
 	// Get user from context for authorization
-	_, err := middleware.GetUserIDFromContext(ctx)
+	userID, err := middleware.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user from context: %v", err)
 	}
 
+	// Hide exams of organisations the caller does not belong to
+	filters := &interfaces.ExamFilters{OrganisationID: req.GetOrganisationId()}
+	if s.organisations != nil {
+		orgIDs, scoped, err := s.organisations.VisibleOrganisations(ctx, organisationActor(ctx, userID))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to resolve organisations: %v", err)
+		}
+		filters.ScopeToOrganisations = scoped
+		filters.VisibleOrganisations = orgIDs
+	}
+
 	// Convert pagination (using defaults since protobuf fields may not be available)
 	pagination := &interfaces.Pagination{
 		Offset:     0,  // TODO: Get from request when protobuf is regenerated
@@ -212,7 +254,7 @@ func (s *ExamServiceServer) ListExams(ctx context.Context, req *v1.ListExamsRequ
 	}
 
 	// Get exams from service management layer
-	exams, _, err := s.examService.ListExams(ctx, nil, pagination)
+	exams, _, err := s.examService.ListExams(ctx, filters, pagination)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list exams: %v", err)
 	}
@@ -402,6 +444,9 @@ func (s *ExamServiceServer) AddQuestionToExam(ctx context.Context, req *v1.AddQu
 	// Add question to exam through service management layer
 	err = s.examService.AddQuestionToExam(ctx, req.GetExamId(), req.GetQuestionId(), int(req.GetPoints()))
 	if err != nil {
+		if errors.Is(err, exam.ErrQuestionSuspended) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to add question to exam: %v", err)
 	}
 
@@ -495,13 +540,37 @@ func (s *ExamServiceServer) GetExamQuestions(ctx context.Context, req *v1.GetExa
 	}
 
 	// Get exam questions through repository (since ExamService doesn't have this method yet)
-	// TODO: Add GetExamQuestions method to ExamService service
+	examQuestions, err := s.examRepo.GetQuestions(ctx, req.GetExamId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get exam questions: %v", err)
+	}
+
+	protoQuestions := make([]*v1.ExamQuestion, 0, len(examQuestions))
+	for _, eq := range examQuestions {
+		protoQuestions = append(protoQuestions, convertExamQuestionToProto(eq))
+	}
+
+	if req.GetIncludeRendered() && s.questions != nil && len(examQuestions) > 0 {
+		ids := make([]string, 0, len(examQuestions))
+		for _, eq := range examQuestions {
+			ids = append(ids, eq.QuestionID)
+		}
+		rendered, err := s.questions.RenderQuestionsByIDs(ctx, ids)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to render exam questions: %v", err)
+		}
+		// Solutions are left out, this listing is used while taking the exam
+		for i, eq := range examQuestions {
+			protoQuestions[i].Rendered = convertRenderedQuestionToProto(rendered[eq.QuestionID], false)
+		}
+	}
+
 	return &v1.GetExamQuestionsResponse{
 		Response: &common.Response{
 			Success: true,
 			Message: "Exam questions retrieved successfully",
 		},
-		Questions: []*v1.ExamQuestion{}, // TODO: Implement when ExamService has this method
+		Questions: protoQuestions,
 	}, nil
 }
 
@@ -518,6 +587,10 @@ func (s *ExamServiceServer) StartExam(ctx context.Context, req *v1.StartExamRequ
 		return nil, status.Errorf(codes.InvalidArgument, "exam ID is required")
 	}
 
+	if err := s.checkExamOrganisation(ctx, userID, req.GetExamId()); err != nil {
+		return nil, err
+	}
+
 	// Verify exam exists and is active
 	exam, err := s.examRepo.GetByID(ctx, req.GetExamId())
 	if err != nil {
@@ -808,6 +881,19 @@ func (s *ExamServiceServer) GetUserPerformance(ctx context.Context, req *v1.GetU
 // Helper functions for conversion between protobuf and entity
 
 // convertExamToProto converts entity.Exam to protobuf Exam (complete implementation)
+// convertExamQuestionToProto converts an exam question link to proto
+func convertExamQuestionToProto(eq *entity.ExamQuestion) *v1.ExamQuestion {
+	return &v1.ExamQuestion{
+		Id:          eq.ID,
+		ExamId:      eq.ExamID,
+		QuestionId:  eq.QuestionID,
+		OrderNumber: int32(eq.OrderNumber),
+		Points:      int32(eq.Points),
+		IsBonus:     eq.IsBonus,
+		CreatedAt:   timestamppb.New(eq.CreatedAt),
+	}
+}
+
 func convertExamToProto(exam *entity.Exam) *v1.Exam {
 	if exam == nil {
 		return nil
@@ -1212,3 +1298,21 @@ func convertAnswerToProto(answer *entity.ExamAnswer) *v1.ExamAnswer {
 
 	return protoAnswer
 }
+
+// checkExamOrganisation denies access to exams of organisations the caller does not belong to
+func (s *ExamServiceServer) checkExamOrganisation(ctx context.Context, userID, examID string) error {
+	if s.organisations == nil {
+		return nil
+	}
+	ok, err := s.organisations.CanAccessExam(ctx, organisationActor(ctx, userID), examID)
+	if err != nil {
+		if errors.Is(err, organisation.ErrNotFound) {
+			return status.Errorf(codes.NotFound, "exam not found")
+		}
+		return status.Errorf(codes.Internal, "failed to check exam organisation: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "exam belongs to another organisation")
+	}
+	return nil
+}
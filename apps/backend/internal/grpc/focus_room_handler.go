@@ -8,6 +8,7 @@ import (
 	"exam-bank-system/apps/backend/internal/middleware"
 	"exam-bank-system/apps/backend/internal/repository/interfaces"
 	"exam-bank-system/apps/backend/internal/service/focus"
+	"exam-bank-system/apps/backend/internal/service/organisation"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
 
 	"github.com/google/uuid"
@@ -26,6 +27,7 @@ type FocusRoomServiceServer struct {
 	streakService      *focus.StreakService
 	leaderboardService *focus.LeaderboardService
 	taskService        *focus.TaskService
+	organisations      *organisation.Service
 }
 
 // NewFocusRoomServiceServer creates a new FocusRoomServiceServer
@@ -47,6 +49,11 @@ func NewFocusRoomServiceServer(
 	}
 }
 
+// SetOrganisationScope enables class leaderboards backed by organisation class rosters
+func (s *FocusRoomServiceServer) SetOrganisationScope(organisations *organisation.Service) {
+	s.organisations = organisations
+}
+
 // CreateRoom creates a new focus room
 func (s *FocusRoomServiceServer) CreateRoom(ctx context.Context, req *v1.CreateRoomRequest) (*v1.Room, error) {
 	// Get user from context
@@ -718,7 +725,16 @@ func (s *FocusRoomServiceServer) GetLeaderboard(ctx context.Context, req *v1.Get
 	}
 
 	// Get leaderboard entries
-	entries, err := s.leaderboardService.GetLeaderboard(ctx, "global", period, limit)
+	var entries []*entity.LeaderboardEntry
+	var err error
+	if classID := req.GetClassId(); classID != "" {
+		if err := s.checkClassMembership(ctx, classID); err != nil {
+			return nil, err
+		}
+		entries, err = s.leaderboardService.GetClassLeaderboard(ctx, classID, period, limit)
+	} else {
+		entries, err = s.leaderboardService.GetLeaderboard(ctx, "global", period, limit)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get leaderboard: %v", err)
 	}
@@ -740,6 +756,21 @@ func (s *FocusRoomServiceServer) GetLeaderboard(ctx context.Context, req *v1.Get
 	}, nil
 }
 
+// checkClassMembership makes sure the caller may see the class leaderboard
+func (s *FocusRoomServiceServer) checkClassMembership(ctx context.Context, classID string) error {
+	if s.organisations == nil {
+		return status.Error(codes.Unimplemented, "class leaderboards are not available")
+	}
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.organisations.GetClass(ctx, actor, classID); err != nil {
+		return organisationError(err)
+	}
+	return nil
+}
+
 // GetUserRank retrieves user's rank
 func (s *FocusRoomServiceServer) GetUserRank(ctx context.Context, req *v1.GetUserRankRequest) (*v1.UserRankResponse, error) {
 	// Get user from context
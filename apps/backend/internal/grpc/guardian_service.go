@@ -0,0 +1,269 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/user/guardian"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GuardianServiceServer implements the GuardianService
+type GuardianServiceServer struct {
+	v1.UnimplementedGuardianServiceServer
+	guardians *guardian.Service
+}
+
+// NewGuardianServiceServer creates a new guardian service
+func NewGuardianServiceServer(guardians *guardian.Service) *GuardianServiceServer {
+	return &GuardianServiceServer{guardians: guardians}
+}
+
+// RequestGuardianLink asks a student to let the caller follow their progress
+func (s *GuardianServiceServer) RequestGuardianLink(ctx context.Context, req *v1.RequestGuardianLinkRequest) (*v1.RequestGuardianLinkResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	link, err := s.guardians.RequestLink(ctx, userID, req.GetStudentEmail(), req.GetRelationship())
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &v1.RequestGuardianLinkResponse{
+		Response: &common.Response{Success: true, Message: "Request sent, waiting for the student to confirm"},
+		Link:     guardianLinkToProto(link),
+	}, nil
+}
+
+// ListLinkedStudents lists the students the caller follows or has asked to follow
+func (s *GuardianServiceServer) ListLinkedStudents(ctx context.Context, req *v1.ListLinkedStudentsRequest) (*v1.ListLinkedStudentsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	links, err := s.guardians.ListStudents(ctx, userID)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &v1.ListLinkedStudentsResponse{
+		Response: &common.Response{Success: true},
+		Links:    guardianLinksToProto(links),
+	}, nil
+}
+
+// SetGuardianDigest turns the weekly digest for a linked student on or off
+func (s *GuardianServiceServer) SetGuardianDigest(ctx context.Context, req *v1.SetGuardianDigestRequest) (*v1.SetGuardianDigestResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	if err := s.guardians.SetDigest(ctx, userID, req.GetLinkId(), req.GetEnabled()); err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &v1.SetGuardianDigestResponse{
+		Response: &common.Response{Success: true, Message: "Digest preference updated"},
+	}, nil
+}
+
+// GetStudentExamResults returns a linked student's exam results
+func (s *GuardianServiceServer) GetStudentExamResults(ctx context.Context, req *v1.GetStudentExamResultsRequest) (*v1.GetStudentExamResultsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	page := req.GetPagination().GetPage()
+	if page < 1 {
+		page = 1
+	}
+	limit := req.GetPagination().GetLimit()
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	var since time.Time
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+
+	results, total, err := s.guardians.ExamResults(ctx, userID, req.GetStudentId(), since, int(limit), int((page-1)*limit))
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	protoResults := make([]*v1.StudentExamResult, 0, len(results))
+	for _, result := range results {
+		protoResults = append(protoResults, &v1.StudentExamResult{
+			AttemptId:   result.AttemptID,
+			ExamId:      result.ExamID,
+			ExamTitle:   result.ExamTitle,
+			Subject:     result.Subject,
+			Score:       int32(result.Score),
+			TotalPoints: int32(result.TotalPoints),
+			Percentage:  result.Percentage,
+			Passed:      result.Passed,
+			SubmittedAt: timestamppb.New(result.SubmittedAt),
+		})
+	}
+
+	return &v1.GetStudentExamResultsResponse{
+		Response: &common.Response{Success: true},
+		Results:  protoResults,
+		Pagination: &common.PaginationResponse{
+			Page:       page,
+			Limit:      limit,
+			TotalCount: int32(total),
+			TotalPages: (int32(total) + limit - 1) / limit,
+		},
+	}, nil
+}
+
+// GetStudentFocusStats returns a linked student's weekly focus time and streak
+func (s *GuardianServiceServer) GetStudentFocusStats(ctx context.Context, req *v1.GetStudentFocusStatsRequest) (*v1.GetStudentFocusStatsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	var weekStart time.Time
+	if req.GetWeekStart() != "" {
+		weekStart, err = time.Parse("2006-01-02", req.GetWeekStart())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid week start format: %v", err)
+		}
+	} else {
+		// Start of current week (Monday)
+		now := time.Now()
+		weekStart = now.AddDate(0, 0, -int(now.Weekday())+1)
+	}
+
+	weekly, streak, err := s.guardians.FocusStats(ctx, userID, req.GetStudentId(), weekStart)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &v1.GetStudentFocusStatsResponse{
+		Response: &common.Response{Success: true},
+		Weekly:   convertWeeklyStatsToProto(weekly),
+		Streak:   convertStreakToProto(streak),
+	}, nil
+}
+
+// ListMyGuardians lists the guardians following the calling student, including pending requests
+func (s *GuardianServiceServer) ListMyGuardians(ctx context.Context, req *v1.ListMyGuardiansRequest) (*v1.ListMyGuardiansResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	links, err := s.guardians.ListGuardians(ctx, userID)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &v1.ListMyGuardiansResponse{
+		Response: &common.Response{Success: true},
+		Links:    guardianLinksToProto(links),
+	}, nil
+}
+
+// RespondGuardianLink lets the calling student accept or decline a guardian request
+func (s *GuardianServiceServer) RespondGuardianLink(ctx context.Context, req *v1.RespondGuardianLinkRequest) (*v1.RespondGuardianLinkResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	link, err := s.guardians.Respond(ctx, userID, req.GetLinkId(), req.GetAccept())
+	if err != nil {
+		return nil, guardianError(err)
+	}
+
+	message := "Guardian request declined"
+	if req.GetAccept() {
+		message = "Guardian request accepted"
+	}
+	return &v1.RespondGuardianLinkResponse{
+		Response: &common.Response{Success: true, Message: message},
+		Link:     guardianLinkToProto(link),
+	}, nil
+}
+
+// RevokeGuardianLink ends a guardian link from either side
+func (s *GuardianServiceServer) RevokeGuardianLink(ctx context.Context, req *v1.RevokeGuardianLinkRequest) (*v1.RevokeGuardianLinkResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	if err := s.guardians.Revoke(ctx, userID, req.GetLinkId()); err != nil {
+		return nil, guardianError(err)
+	}
+
+	return &v1.RevokeGuardianLinkResponse{
+		Response: &common.Response{Success: true, Message: "Guardian access revoked"},
+	}, nil
+}
+
+// guardianError maps guardian service errors to gRPC status codes
+func guardianError(err error) error {
+	switch {
+	case errors.Is(err, guardian.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, guardian.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, guardian.ErrAlreadyLinked):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, guardian.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "guardian operation failed: %v", err)
+	}
+}
+
+func guardianLinksToProto(links []*repository.GuardianLink) []*v1.GuardianLink {
+	result := make([]*v1.GuardianLink, 0, len(links))
+	for _, link := range links {
+		result = append(result, guardianLinkToProto(link))
+	}
+	return result
+}
+
+func guardianLinkToProto(link *repository.GuardianLink) *v1.GuardianLink {
+	proto := &v1.GuardianLink{
+		Id:            link.ID,
+		GuardianId:    link.GuardianID,
+		GuardianEmail: link.GuardianEmail,
+		GuardianName:  link.GuardianName,
+		StudentId:     link.StudentID,
+		StudentEmail:  link.StudentEmail,
+		StudentName:   link.StudentName,
+		Relationship:  link.Relationship,
+		Status:        link.Status,
+		DigestEnabled: link.DigestEnabled,
+		CreatedAt:     timestamppb.New(link.CreatedAt),
+	}
+	if link.RespondedAt != nil {
+		proto.RespondedAt = timestamppb.New(*link.RespondedAt)
+	}
+	if link.LastDigestAt != nil {
+		proto.LastDigestAt = timestamppb.New(*link.LastDigestAt)
+	}
+	return proto
+}
@@ -0,0 +1,444 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/library/collection"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultCollectionPageSize = 20
+	maxCollectionPageSize     = 100
+)
+
+// SetCollections enables the collection RPCs
+func (s *LibraryServiceServer) SetCollections(collections *collection.Service) {
+	s.collections = collections
+}
+
+// CreateCollection creates an empty collection owned by the caller
+func (s *LibraryServiceServer) CreateCollection(ctx context.Context, req *v1.CreateCollectionRequest) (*v1.CollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	c, err := s.collections.Create(ctx, collectionActor(ctx), collection.Input{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Visibility:  req.GetVisibility(),
+		ClassID:     req.GetClassId(),
+	})
+	if err != nil {
+		return nil, s.collectionError(err, "create collection")
+	}
+	return s.collectionResponse(ctx, c.ID, "Collection created successfully")
+}
+
+// GetCollection returns a collection with its sections. Members the caller may not open
+// are returned locked.
+func (s *LibraryServiceServer) GetCollection(ctx context.Context, req *v1.GetCollectionRequest) (*v1.CollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	return s.collectionResponse(ctx, req.GetId(), "Collection loaded successfully")
+}
+
+// ListCollections lists the caller's own and class collections, or public ones
+func (s *LibraryServiceServer) ListCollections(ctx context.Context, req *v1.ListCollectionsRequest) (*v1.ListCollectionsResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	page := int(req.GetPagination().GetPage())
+	if page <= 0 {
+		page = 1
+	}
+	limit := int(req.GetPagination().GetLimit())
+	if limit <= 0 || limit > maxCollectionPageSize {
+		limit = defaultCollectionPageSize
+	}
+
+	actor := collectionActor(ctx)
+	collections, err := s.collections.List(ctx, actor, strings.ToLower(strings.TrimSpace(req.GetScope())), limit, (page-1)*limit)
+	if err != nil {
+		return nil, s.collectionError(err, "list collections")
+	}
+
+	resp := &v1.ListCollectionsResponse{
+		Response:    &common.Response{Success: true, Message: "Collections loaded successfully"},
+		Collections: make([]*v1.LibraryCollection, 0, len(collections)),
+	}
+	for _, c := range collections {
+		resp.Collections = append(resp.Collections, toProtoCollection(c, s.collections.CanEdit(actor, c)))
+	}
+	return resp, nil
+}
+
+// UpdateCollection changes the title, description and visibility of a collection
+func (s *LibraryServiceServer) UpdateCollection(ctx context.Context, req *v1.UpdateCollectionRequest) (*v1.CollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	_, err := s.collections.Update(ctx, collectionActor(ctx), req.GetId(), collection.Input{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Visibility:  req.GetVisibility(),
+		ClassID:     req.GetClassId(),
+	})
+	if err != nil {
+		return nil, s.collectionError(err, "update collection")
+	}
+	return s.collectionResponse(ctx, req.GetId(), "Collection updated successfully")
+}
+
+// SetCollectionContent replaces the sections and members of a collection. Every member
+// must exist and be open to the caller.
+func (s *LibraryServiceServer) SetCollectionContent(ctx context.Context, req *v1.SetCollectionContentRequest) (*v1.CollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	sections := make([]repository.LibraryCollectionSection, 0, len(req.GetSections()))
+	var refs []repository.LibraryCollectionItem
+	for _, in := range req.GetSections() {
+		section := repository.LibraryCollectionSection{Title: in.GetTitle()}
+		for _, ref := range in.GetItems() {
+			item := fromProtoCollectionItemRef(ref)
+			section.Items = append(section.Items, item)
+			refs = append(refs, item)
+		}
+		sections = append(sections, section)
+	}
+	if err := s.checkCollectionItems(ctx, refs); err != nil {
+		return nil, err
+	}
+
+	if err := s.collections.SetContent(ctx, collectionActor(ctx), req.GetId(), sections); err != nil {
+		return nil, s.collectionError(err, "set collection content")
+	}
+	return s.collectionResponse(ctx, req.GetId(), "Collection content saved successfully")
+}
+
+// AddCollectionItem appends a member the caller may open to a section of a collection
+func (s *LibraryServiceServer) AddCollectionItem(ctx context.Context, req *v1.AddCollectionItemRequest) (*v1.CollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	if req.GetItem() == nil {
+		return nil, status.Error(codes.InvalidArgument, "item is required")
+	}
+	item := fromProtoCollectionItemRef(req.GetItem())
+	if err := s.checkCollectionItems(ctx, []repository.LibraryCollectionItem{item}); err != nil {
+		return nil, err
+	}
+	section := -1
+	if req.GetSection() != nil {
+		section = int(req.GetSection().GetValue())
+		if section < 0 {
+			return nil, status.Error(codes.InvalidArgument, "section must not be negative")
+		}
+	}
+
+	if err := s.collections.AddItem(ctx, collectionActor(ctx), req.GetId(), section, item); err != nil {
+		return nil, s.collectionError(err, "add collection item")
+	}
+	return s.collectionResponse(ctx, req.GetId(), "Item added to collection")
+}
+
+// DeleteCollection deletes a collection with its content and progress
+func (s *LibraryServiceServer) DeleteCollection(ctx context.Context, req *v1.DeleteCollectionRequest) (*v1.DeleteCollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	if err := s.collections.Delete(ctx, collectionActor(ctx), req.GetId()); err != nil {
+		return nil, s.collectionError(err, "delete collection")
+	}
+	return &v1.DeleteCollectionResponse{
+		Response: &common.Response{Success: true, Message: "Collection deleted successfully"},
+	}, nil
+}
+
+// CopyCollection adds a private copy of a collection the caller may view to their
+// collections
+func (s *LibraryServiceServer) CopyCollection(ctx context.Context, req *v1.CopyCollectionRequest) (*v1.CollectionResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	c, err := s.collections.Copy(ctx, collectionActor(ctx), req.GetId(), req.GetTitle())
+	if err != nil {
+		return nil, s.collectionError(err, "copy collection")
+	}
+	return s.collectionResponse(ctx, c.ID, "Collection copied successfully")
+}
+
+// SetCollectionProgress marks a member the caller may open as completed or not
+func (s *LibraryServiceServer) SetCollectionProgress(ctx context.Context, req *v1.SetCollectionProgressRequest) (*v1.SetCollectionProgressResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	actor := collectionActor(ctx)
+	kind := strings.TrimSpace(req.GetKind())
+	itemID := strings.TrimSpace(req.GetItemId())
+
+	_, sections, err := s.collections.Get(ctx, actor, req.GetId())
+	if err != nil {
+		return nil, s.collectionError(err, "get collection")
+	}
+	accessible, err := s.collectionAccess(ctx, sections)
+	if err != nil {
+		return nil, err
+	}
+	if key := collection.ProgressKey(kind, itemID); !accessible[key] {
+		if hasCollectionItem(sections, kind, itemID) {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+	}
+
+	if err := s.collections.SetProgress(ctx, actor, req.GetId(), kind, itemID, req.GetCompleted()); err != nil {
+		return nil, s.collectionError(err, "set collection progress")
+	}
+	return &v1.SetCollectionProgressResponse{
+		Response: &common.Response{Success: true, Message: "Progress saved"},
+	}, nil
+}
+
+// GetCollectionProgress returns per-student progress: every student for the owner and
+// class teachers, only the caller otherwise
+func (s *LibraryServiceServer) GetCollectionProgress(ctx context.Context, req *v1.GetCollectionProgressRequest) (*v1.GetCollectionProgressResponse, error) {
+	if s.collections == nil {
+		return nil, status.Error(codes.Unavailable, "collections are not enabled")
+	}
+	students, err := s.collections.Progress(ctx, collectionActor(ctx), req.GetId())
+	if err != nil {
+		return nil, s.collectionError(err, "get collection progress")
+	}
+
+	resp := &v1.GetCollectionProgressResponse{
+		Response: &common.Response{Success: true, Message: "Progress loaded successfully"},
+		Students: make([]*v1.CollectionStudentProgress, 0, len(students)),
+	}
+	for _, student := range students {
+		resp.TotalItems = int32(student.Total)
+		progress := &v1.CollectionStudentProgress{
+			UserId:         student.UserID,
+			Name:           student.Name,
+			Email:          student.Email,
+			CompletedCount: int32(len(student.Completed)),
+		}
+		for _, p := range student.Completed {
+			progress.Completed = append(progress.Completed, &v1.CollectionItemRef{Kind: p.Kind, ItemId: p.ItemID})
+		}
+		resp.Students = append(resp.Students, progress)
+	}
+	return resp, nil
+}
+
+// collectionResponse loads a collection for the caller with its content, locks and progress
+func (s *LibraryServiceServer) collectionResponse(ctx context.Context, id, message string) (*v1.CollectionResponse, error) {
+	actor := collectionActor(ctx)
+	c, sections, err := s.collections.Get(ctx, actor, id)
+	if err != nil {
+		return nil, s.collectionError(err, "get collection")
+	}
+	accessible, err := s.collectionAccess(ctx, sections)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.collections.Completed(ctx, id, actor.UserID)
+	if err != nil {
+		return nil, s.collectionError(err, "get collection progress")
+	}
+
+	var refs []repository.LibraryItemRef
+	for _, section := range sections {
+		for _, item := range section.Items {
+			if item.Kind == repository.CollectionItemLibrary && accessible[collection.ProgressKey(item.Kind, item.ItemID)] {
+				refs = append(refs, repository.LibraryItemRef{ID: item.ItemID, Type: item.ItemType})
+			}
+		}
+	}
+	items, err := s.loadLibraryItems(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	loaded := make(map[string]*v1.LibraryItem, len(items))
+	for _, item := range items {
+		loaded[item.GetId()] = item
+	}
+
+	out := toProtoCollection(c, s.collections.CanEdit(actor, c))
+	for _, section := range sections {
+		protoSection := &v1.LibraryCollectionSection{Title: section.Title}
+		for _, item := range section.Items {
+			key := collection.ProgressKey(item.Kind, item.ItemID)
+			entry := &v1.LibraryCollectionEntry{
+				Kind:       item.Kind,
+				ItemId:     item.ItemID,
+				Title:      item.Title,
+				Note:       item.Note,
+				Accessible: accessible[key],
+			}
+			if entry.Accessible && item.Kind == repository.CollectionItemLibrary {
+				entry.LibraryItem = loaded[item.ItemID]
+			}
+			if at, ok := completed[key]; ok {
+				entry.Completed = true
+				entry.CompletedAt = timestamppb.New(at)
+				out.CompletedCount++
+			}
+			protoSection.Entries = append(protoSection.Entries, entry)
+		}
+		out.Sections = append(out.Sections, protoSection)
+	}
+
+	return &v1.CollectionResponse{
+		Response:   &common.Response{Success: true, Message: message},
+		Collection: out,
+	}, nil
+}
+
+// collectionAccess returns the members the caller may open, keyed by
+// collection.ProgressKey. Library items go through the same role, level and target role
+// rules as GetItem; both kinds must be published and in an organisation the caller sees.
+func (s *LibraryServiceServer) collectionAccess(ctx context.Context, sections []repository.LibraryCollectionSection) (map[string]bool, error) {
+	var items []repository.LibraryCollectionItem
+	for _, section := range sections {
+		items = append(items, section.Items...)
+	}
+	return s.accessibleCollectionItems(ctx, items)
+}
+
+func (s *LibraryServiceServer) accessibleCollectionItems(ctx context.Context, items []repository.LibraryCollectionItem) (map[string]bool, error) {
+	userRole, userLevel := userRoleLevelFromContext(ctx)
+
+	var member map[string]bool
+	if s.organisations != nil {
+		orgIDs, scoped, err := s.organisations.VisibleOrganisations(ctx, libraryActor(ctx, userRole))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check item organisations: %v", err)
+		}
+		if scoped {
+			member = make(map[string]bool, len(orgIDs))
+			for _, id := range orgIDs {
+				member[id] = true
+			}
+		}
+	}
+
+	accessible := make(map[string]bool, len(items))
+	for _, item := range items {
+		if !item.Available {
+			continue
+		}
+		if member != nil && item.OrganisationID != "" && !member[item.OrganisationID] {
+			continue
+		}
+		if item.Kind == repository.CollectionItemLibrary && !hasAccess(userRole, userLevel, accessRequirement{
+			requiredRole:  defaultRole(item.RequiredRole),
+			requiredLevel: item.RequiredLevel,
+			targetRoles:   item.TargetRoles,
+		}) {
+			continue
+		}
+		accessible[collection.ProgressKey(item.Kind, item.ItemID)] = true
+	}
+	return accessible, nil
+}
+
+// checkCollectionItems rejects members that do not exist or that the caller may not open,
+// so collections only hand out what their owner can see
+func (s *LibraryServiceServer) checkCollectionItems(ctx context.Context, refs []repository.LibraryCollectionItem) error {
+	if len(refs) == 0 {
+		return nil
+	}
+	described, err := s.collections.Describe(ctx, refs)
+	if err != nil {
+		return s.collectionError(err, "describe collection items")
+	}
+	items := make([]repository.LibraryCollectionItem, 0, len(described))
+	for _, item := range described {
+		items = append(items, item)
+	}
+	accessible, err := s.accessibleCollectionItems(ctx, items)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		key := collection.ProgressKey(ref.Kind, ref.ItemID)
+		if _, ok := described[key]; !ok {
+			if ref.Kind != repository.CollectionItemLibrary && ref.Kind != repository.CollectionItemQuestionSet {
+				return status.Errorf(codes.InvalidArgument, "item kind must be %s or %s",
+					repository.CollectionItemLibrary, repository.CollectionItemQuestionSet)
+			}
+			return status.Errorf(codes.NotFound, "%s %s not found", ref.Kind, ref.ItemID)
+		}
+		if !accessible[key] {
+			return status.Errorf(codes.PermissionDenied, "access denied to %s %s", ref.Kind, ref.ItemID)
+		}
+	}
+	return nil
+}
+
+// collectionError maps collection service errors to gRPC status codes
+func (s *LibraryServiceServer) collectionError(err error, op string) error {
+	switch {
+	case errors.Is(err, collection.ErrNotFound):
+		return status.Error(codes.NotFound, "collection not found")
+	case errors.Is(err, collection.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, collection.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.WithError(err).Error(op)
+		return status.Errorf(codes.Internal, "failed to %s: %v", op, err)
+	}
+}
+
+func collectionActor(ctx context.Context) collection.Actor {
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	role, _ := middleware.GetUserRoleFromContext(ctx)
+	return collection.Actor{UserID: userID, Role: role}
+}
+
+func hasCollectionItem(sections []repository.LibraryCollectionSection, kind, itemID string) bool {
+	for _, section := range sections {
+		for _, item := range section.Items {
+			if item.Kind == kind && item.ItemID == itemID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func fromProtoCollectionItemRef(ref *v1.CollectionItemRef) repository.LibraryCollectionItem {
+	return repository.LibraryCollectionItem{
+		Kind:   strings.TrimSpace(ref.GetKind()),
+		ItemID: strings.TrimSpace(ref.GetItemId()),
+		Note:   ref.GetNote(),
+	}
+}
+
+func toProtoCollection(c *repository.LibraryCollection, canEdit bool) *v1.LibraryCollection {
+	return &v1.LibraryCollection{
+		Id:           c.ID,
+		OwnerId:      c.OwnerID,
+		OwnerName:    c.OwnerName,
+		Title:        c.Title,
+		Description:  c.Description,
+		Visibility:   c.Visibility,
+		ClassId:      c.ClassID,
+		CopiedFromId: c.CopiedFromID,
+		ItemCount:    int32(c.ItemCount),
+		CanEdit:      canEdit,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+		UpdatedAt:    timestamppb.New(c.UpdatedAt),
+	}
+}
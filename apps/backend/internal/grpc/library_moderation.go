@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/service/library/moderation"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// moderatePermission guards the moderation queue RPCs; seeded for ADMIN
+const moderatePermission = "library.moderate"
+
+// SetModeration enables the moderation queue RPCs and records ApproveItem decisions with
+// their reasons
+func (s *LibraryServiceServer) SetModeration(moderation *moderation.Service) {
+	s.moderation = moderation
+}
+
+// ListModerationQueue returns pending library items and questions in review, oldest first,
+// with their SLA status and automatic checks
+func (s *LibraryServiceServer) ListModerationQueue(ctx context.Context, req *v1.ListModerationQueueRequest) (*v1.ListModerationQueueResponse, error) {
+	if err := s.authorizeModeration(ctx); err != nil {
+		return nil, err
+	}
+
+	queue, err := s.moderation.Queue(ctx, moderation.QueueFilter{
+		TargetType:  strings.TrimSpace(req.GetType()),
+		OverdueOnly: req.GetOverdueOnly(),
+		Limit:       int(req.GetLimit()),
+		Offset:      int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, s.moderationError(err, "list moderation queue")
+	}
+
+	resp := &v1.ListModerationQueueResponse{
+		Response:         &common.Response{Success: true, Message: "Moderation queue loaded successfully"},
+		Entries:          make([]*v1.ModerationQueueEntry, 0, len(queue.Entries)),
+		Total:            int32(queue.Total),
+		PendingItems:     int32(queue.Stats.PendingItems),
+		PendingQuestions: int32(queue.Stats.PendingQuestions),
+		Overdue:          int32(queue.Stats.Overdue),
+		SlaHours:         int32(queue.SLA.Hours()),
+	}
+	if queue.Stats.OldestAt.Valid {
+		resp.OldestSubmittedAt = timestamppb.New(queue.Stats.OldestAt.Time)
+	}
+	for _, e := range queue.Entries {
+		resp.Entries = append(resp.Entries, toProtoModerationEntry(e))
+	}
+	return resp, nil
+}
+
+// DecideModeration approves or rejects up to the configured number of targets at once.
+// Each target succeeds or fails on its own; failures are reported per target.
+func (s *LibraryServiceServer) DecideModeration(ctx context.Context, req *v1.DecideModerationRequest) (*v1.DecideModerationResponse, error) {
+	if err := s.authorizeModeration(ctx); err != nil {
+		return nil, err
+	}
+
+	targets := make([]moderation.Target, 0, len(req.GetTargets()))
+	for _, t := range req.GetTargets() {
+		targets = append(targets, moderation.Target{Type: strings.TrimSpace(t.GetType()), ID: t.GetId()})
+	}
+	reviewerID, _ := middleware.GetUserIDFromContext(ctx)
+	results, err := s.moderation.Decide(ctx, strings.TrimSpace(reviewerID), moderation.Decision{
+		Targets:    targets,
+		Approve:    req.GetApprove(),
+		ReasonCode: req.GetReasonCode(),
+		Note:       req.GetNote(),
+	})
+	if err != nil {
+		return nil, s.moderationError(err, "decide moderation")
+	}
+
+	resp := &v1.DecideModerationResponse{Results: make([]*v1.ModerationResult, 0, len(results))}
+	for _, r := range results {
+		result := &v1.ModerationResult{
+			Target:  &v1.ModerationTarget{Type: r.Target.Type, Id: r.Target.ID},
+			Success: r.Err == nil,
+		}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+			resp.Failed++
+			if !errors.Is(r.Err, moderation.ErrNotFound) {
+				s.logger.WithError(r.Err).WithField("target_id", r.Target.ID).Error("moderation decision")
+			}
+		} else {
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	resp.Response = &common.Response{Success: resp.Failed == 0, Message: "Moderation decisions applied"}
+	if resp.Failed > 0 {
+		resp.Response.Message = "Some moderation decisions failed"
+	}
+	return resp, nil
+}
+
+// ListModerationReasons returns the rejection reason templates
+func (s *LibraryServiceServer) ListModerationReasons(ctx context.Context, req *v1.ListModerationReasonsRequest) (*v1.ListModerationReasonsResponse, error) {
+	if err := s.authorizeModeration(ctx); err != nil {
+		return nil, err
+	}
+
+	reasons := s.moderation.Reasons()
+	resp := &v1.ListModerationReasonsResponse{
+		Response: &common.Response{Success: true, Message: "Moderation reasons loaded successfully"},
+		Reasons:  make([]*v1.ModerationReason, 0, len(reasons)),
+	}
+	for _, r := range reasons {
+		resp.Reasons = append(resp.Reasons, &v1.ModerationReason{
+			Code:         r.Code,
+			Title:        r.Title,
+			Message:      r.Message,
+			NoteRequired: r.Code == moderation.ReasonOther,
+		})
+	}
+	return resp, nil
+}
+
+// GetModerationWorkload returns what each reviewer decided within the workload window
+func (s *LibraryServiceServer) GetModerationWorkload(ctx context.Context, req *v1.GetModerationWorkloadRequest) (*v1.GetModerationWorkloadResponse, error) {
+	if err := s.authorizeModeration(ctx); err != nil {
+		return nil, err
+	}
+
+	workload, since, err := s.moderation.Workload(ctx)
+	if err != nil {
+		return nil, s.moderationError(err, "load moderation workload")
+	}
+	resp := &v1.GetModerationWorkloadResponse{
+		Response:  &common.Response{Success: true, Message: "Moderation workload loaded successfully"},
+		Reviewers: make([]*v1.ModeratorWorkload, 0, len(workload)),
+		Since:     timestamppb.New(since),
+		SlaHours:  int32(s.moderation.SLA().Hours()),
+	}
+	for _, w := range workload {
+		resp.Reviewers = append(resp.Reviewers, &v1.ModeratorWorkload{
+			ReviewerId:       w.ReviewerID,
+			ReviewerName:     w.ReviewerName,
+			Decisions:        int32(w.Decisions),
+			Approved:         int32(w.Approved),
+			Rejected:         int32(w.Rejected),
+			OverSla:          int32(w.OverSLA),
+			AvgHandlingHours: w.AvgHandlingTime.Hours(),
+			LastDecisionAt:   timestamppb.New(w.LastDecisionAt),
+		})
+	}
+	return resp, nil
+}
+
+// authorizeModeration requires the moderation permission
+func (s *LibraryServiceServer) authorizeModeration(ctx context.Context) error {
+	if s.moderation == nil {
+		return status.Error(codes.Unavailable, "moderation queue is not enabled")
+	}
+	if !middleware.HasPermission(ctx, moderatePermission, "", "") {
+		return status.Error(codes.PermissionDenied, "permission to moderate content required")
+	}
+	return nil
+}
+
+// moderationError maps moderation service errors to gRPC status codes
+func (s *LibraryServiceServer) moderationError(err error, op string) error {
+	switch {
+	case errors.Is(err, moderation.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, moderation.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.WithError(err).Error(op)
+		return status.Errorf(codes.Internal, "failed to %s: %v", op, err)
+	}
+}
+
+func toProtoModerationEntry(e moderation.Entry) *v1.ModerationQueueEntry {
+	out := &v1.ModerationQueueEntry{
+		Target:        &v1.ModerationTarget{Type: e.TargetType, Id: e.TargetID},
+		ReviewId:      e.ReviewID,
+		Title:         e.Title,
+		Kind:          e.Kind,
+		SubmitterId:   e.SubmitterID,
+		SubmitterName: e.SubmitterName,
+		ReviewerId:    e.ReviewerID,
+		SubmittedAt:   timestamppb.New(e.SubmittedAt),
+		DueAt:         timestamppb.New(e.DueAt),
+		AgeSeconds:    int64(e.Age.Seconds()),
+		Overdue:       e.Overdue,
+		Checks:        make([]*v1.ModerationCheck, 0, len(e.Checks)),
+		DuplicateIds:  e.Duplicates,
+	}
+	for _, c := range e.Checks {
+		out.Checks = append(out.Checks, &v1.ModerationCheck{Code: c.Code, Passed: c.Passed, Detail: c.Detail})
+	}
+	return out
+}
@@ -0,0 +1,259 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/rbac"
+	booksvc "exam-bank-system/apps/backend/internal/service/content/book"
+	"exam-bank-system/apps/backend/internal/service/library/moderation"
+	"exam-bank-system/apps/backend/internal/service/library/versioning"
+	"exam-bank-system/apps/backend/internal/service/searchhistory"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// permissionPolicy is an rbac.Store holding the seeded role permissions and user grants
+type permissionPolicy struct {
+	roles  []repository.RolePermission
+	grants []*repository.PermissionGrant
+}
+
+func (p *permissionPolicy) ListRolePermissions(ctx context.Context) ([]repository.RolePermission, error) {
+	return p.roles, nil
+}
+
+func (p *permissionPolicy) ListMethodPermissions(ctx context.Context) ([]*repository.MethodPermission, error) {
+	return nil, nil
+}
+
+func (p *permissionPolicy) ListActiveGrants(ctx context.Context, userID string, now time.Time) ([]*repository.PermissionGrant, error) {
+	var out []*repository.PermissionGrant
+	for _, g := range p.grants {
+		if g.UserID == userID {
+			out = append(out, g)
+		}
+	}
+	return out, nil
+}
+
+// permissionCase is a caller and whether the permission check should let them through
+type permissionCase struct {
+	name    string
+	role    string
+	allowed bool
+}
+
+// runPermissionCases calls the RPC as each caller with the evaluator in the context, the
+// way the role-level interceptor hands it to handlers. A STUDENT holding a user grant of
+// permission is always allowed, and a call without an evaluator is always denied.
+func runPermissionCases(t *testing.T, permission string, roles []repository.RolePermission, cases []permissionCase, call func(ctx context.Context) error) {
+	t.Helper()
+	policy := &permissionPolicy{roles: roles, grants: []*repository.PermissionGrant{
+		{UserID: "granted-student", Permission: permission},
+	}}
+	evaluator := rbac.NewEvaluator(policy, rbac.Config{}, nil)
+
+	cases = append(cases, permissionCase{"student with grant", "STUDENT", true})
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			userID := "user-" + tc.role
+			if tc.name == "student with grant" {
+				userID = "granted-student"
+			}
+			ctx := middleware.WithUserContext(context.Background(), userID, "", tc.role, 0)
+			err := call(middleware.WithPermissionEvaluator(ctx, evaluator))
+			if denied := status.Code(err) == codes.PermissionDenied; denied == tc.allowed {
+				t.Fatalf("%s: allowed=%v, got %v", tc.role, tc.allowed, err)
+			}
+		})
+	}
+
+	t.Run("no evaluator", func(t *testing.T) {
+		ctx := middleware.WithUserContext(context.Background(), "user-ADMIN", "", "ADMIN", 0)
+		if err := call(ctx); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied without an evaluator, got %v", err)
+		}
+	})
+}
+
+type emptySearchStats struct {
+	searchhistory.Store
+}
+
+func (emptySearchStats) ListTrending(ctx context.Context, filter repository.SearchTrendFilter) ([]repository.SearchQueryStat, error) {
+	return nil, nil
+}
+
+func (emptySearchStats) ListZeroResults(ctx context.Context, scope string, since time.Time, limit int) ([]repository.SearchQueryStat, error) {
+	return nil, nil
+}
+
+func TestGetSearchReport_RequiresPermission(t *testing.T) {
+	server := &LibraryServiceServer{logger: logrus.WithField("component", "test")}
+	server.SetSearchHistory(searchhistory.NewService(searchhistory.Config{}, emptySearchStats{}))
+
+	roles := []repository.RolePermission{
+		{Role: "ADMIN", Permission: searchReportPermission},
+		{Role: "TEACHER", Permission: searchReportPermission},
+	}
+	runPermissionCases(t, searchReportPermission, roles, []permissionCase{
+		{"admin", "ADMIN", true},
+		{"teacher", "TEACHER", true},
+		{"tutor", "TUTOR", false},
+		{"student", "STUDENT", false},
+	}, func(ctx context.Context) error {
+		_, err := server.GetSearchReport(ctx, &v1.GetSearchReportRequest{})
+		return err
+	})
+}
+
+// openItem is a library item anyone may open
+type openItem struct {
+	repository.LibraryItemRepository
+}
+
+func (openItem) GetAccessMetadata(ctx context.Context, itemID string) (repository.LibraryItemAccess, error) {
+	return repository.LibraryItemAccess{ItemType: "book", RequiredRole: "GUEST"}, nil
+}
+
+type oneVersion struct {
+	versioning.Store
+}
+
+func (oneVersion) ListVersions(ctx context.Context, itemID string) ([]repository.LibraryItemVersion, error) {
+	return []repository.LibraryItemVersion{{Version: 1, File: repository.LibraryItemFile{URL: "https://cdn.example.com/v1.pdf"}}}, nil
+}
+
+func TestItemVersions_RequirePermission(t *testing.T) {
+	server := &LibraryServiceServer{logger: logrus.WithField("component", "test"), itemRepo: openItem{}}
+	server.SetVersions(versioning.NewService(oneVersion{}, nil))
+	roles := []repository.RolePermission{
+		{Role: "ADMIN", Permission: versionsManagePermission},
+		{Role: "TEACHER", Permission: versionsManagePermission},
+	}
+
+	// Version 0 is rejected as invalid once the caller is authorized
+	runPermissionCases(t, versionsManagePermission, roles, []permissionCase{
+		{"admin", "ADMIN", true},
+		{"teacher", "TEACHER", true},
+		{"tutor", "TUTOR", false},
+		{"student", "STUDENT", false},
+	}, func(ctx context.Context) error {
+		_, err := server.RollbackItemVersion(ctx, &v1.RollbackItemVersionRequest{Id: "item-1"})
+		return err
+	})
+
+	// File locations follow the same permission, and grants may be scoped to one item
+	evaluator := rbac.NewEvaluator(&permissionPolicy{roles: roles, grants: []*repository.PermissionGrant{
+		{UserID: "item-editor", Permission: versionsManagePermission, ResourceType: libraryItemResource, ResourceID: "item-1"},
+	}}, rbac.Config{}, nil)
+	callers := []struct {
+		userID, role, itemID string
+		withFile             bool
+	}{
+		{"teacher-1", "TEACHER", "item-1", true},
+		{"student-1", "STUDENT", "item-1", false},
+		{"item-editor", "STUDENT", "item-1", true},
+		{"item-editor", "STUDENT", "item-2", false},
+	}
+	for _, c := range callers {
+		ctx := middleware.WithPermissionEvaluator(middleware.WithUserContext(context.Background(), c.userID, "", c.role, 0), evaluator)
+		resp, err := server.ListItemVersions(ctx, &v1.ListItemVersionsRequest{Id: c.itemID})
+		if err != nil {
+			t.Fatalf("%s on %s: %v", c.userID, c.itemID, err)
+		}
+		if got := resp.Versions[0].FileUrl != ""; got != c.withFile {
+			t.Errorf("%s on %s: file shown=%v, want %v", c.userID, c.itemID, got, c.withFile)
+		}
+	}
+}
+
+func TestModeration_RequiresPermission(t *testing.T) {
+	server := &LibraryServiceServer{logger: logrus.WithField("component", "test")}
+	server.SetModeration(moderation.NewService(nil, nil, nil, moderation.Config{}))
+
+	runPermissionCases(t, moderatePermission, []repository.RolePermission{
+		{Role: "ADMIN", Permission: moderatePermission},
+	}, []permissionCase{
+		{"admin", "ADMIN", true},
+		{"teacher", "TEACHER", false},
+		{"student", "STUDENT", false},
+	}, func(ctx context.Context) error {
+		_, err := server.ListModerationReasons(ctx, &v1.ListModerationReasonsRequest{})
+		return err
+	})
+}
+
+// uploadedBook is a single book uploaded by teacher-1
+type uploadedBook struct {
+	repository.BookRepository
+	repository.LibraryItemRepository
+}
+
+func (uploadedBook) book() *entity.Book {
+	return &entity.Book{
+		ID:           "book-1",
+		Title:        "Đại số 10",
+		FileURL:      sql.NullString{String: "https://cdn.example.com/book-1.pdf", Valid: true},
+		FileID:       sql.NullString{String: "library/book-1.pdf", Valid: true},
+		UploadedBy:   sql.NullString{String: "teacher-1", Valid: true},
+		RequiredRole: "GUEST",
+	}
+}
+
+func (b uploadedBook) GetByID(ctx context.Context, id string) (*entity.Book, error) {
+	return b.book(), nil
+}
+
+func (b uploadedBook) List(ctx context.Context, filters repository.BookListFilters) ([]*entity.Book, int, error) {
+	return []*entity.Book{b.book()}, 1, nil
+}
+
+func (uploadedBook) ListVisible(ctx context.Context, filters repository.LibraryItemListFilters) (*repository.LibraryItemPage, error) {
+	return &repository.LibraryItemPage{Items: []repository.LibraryItemRef{{ID: "book-1", Type: "book"}}, Total: 1}, nil
+}
+
+func TestItemFiles_OnlyShownToUploaderAndAdmin(t *testing.T) {
+	books := uploadedBook{}
+	server := &LibraryServiceServer{
+		logger:      logrus.WithField("component", "test"),
+		itemRepo:    books,
+		bookService: booksvc.NewBookService(books),
+	}
+
+	callers := []struct {
+		userID, role string
+		withFile     bool
+	}{
+		{"student-1", "STUDENT", false},
+		{"teacher-2", "TEACHER", false},
+		{"teacher-1", "TEACHER", true},
+		{"admin-1", "ADMIN", true},
+	}
+	for _, c := range callers {
+		ctx := middleware.WithUserContext(context.Background(), c.userID, "", c.role, 0)
+
+		list, err := server.ListItems(ctx, &v1.ListLibraryItemsRequest{})
+		if err != nil {
+			t.Fatalf("%s: ListItems: %v", c.userID, err)
+		}
+		got, err := server.GetItem(ctx, &v1.GetLibraryItemRequest{Id: "book-1"})
+		if err != nil {
+			t.Fatalf("%s: GetItem: %v", c.userID, err)
+		}
+		for name, item := range map[string]*v1.LibraryItem{"ListItems": list.Items[0], "GetItem": got.Item} {
+			if shown := item.FileUrl != "" || item.FileId != ""; shown != c.withFile {
+				t.Errorf("%s: %s file shown=%v, want %v", c.userID, name, shown, c.withFile)
+			}
+		}
+	}
+}
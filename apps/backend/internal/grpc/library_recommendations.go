@@ -0,0 +1,243 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/library/recommend"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRecommendationLimit = 10
+	maxRecommendationLimit     = 50
+	// Candidates fetched per returned item, since some may be hidden from the viewer
+	recommendationOverFetch = 3
+)
+
+// SetRecommendations enables GetRecommendedItems and GetSimilarItems
+func (s *LibraryServiceServer) SetRecommendations(recommendations *recommend.Service) {
+	s.recommendations = recommendations
+}
+
+// GetRecommendedItems returns the caller's precomputed recommendations that they may
+// open. Guests and students the nightly run has not covered get the most downloaded items.
+func (s *LibraryServiceServer) GetRecommendedItems(ctx context.Context, req *v1.GetRecommendedItemsRequest) (*v1.GetRecommendedItemsResponse, error) {
+	if s.recommendations == nil {
+		return nil, status.Error(codes.Unavailable, "recommendations are not enabled")
+	}
+	types, err := resolveLibraryItemTypes(&v1.LibraryFilter{Types: req.GetTypes()})
+	if err != nil {
+		return nil, err
+	}
+	limit := recommendationLimit(req.GetLimit())
+
+	filters, err := s.recommendationFilters(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []recommend.Recommendation
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	if userID = strings.TrimSpace(userID); userID != "" {
+		candidates, err = s.recommendations.Recommendations(ctx, userID, recommendationFetchLimit(limit))
+		if err != nil {
+			s.logger.WithError(err).Error("list library recommendations")
+			return nil, status.Errorf(codes.Internal, "failed to get recommendations: %v", err)
+		}
+	}
+
+	recommendations, err := s.visibleRecommendations(ctx, filters, candidates, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(recommendations) == 0 {
+		recommendations, err = s.popularRecommendations(ctx, filters, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.GetRecommendedItemsResponse{
+		Response:        &common.Response{Success: true, Message: "Recommendations loaded successfully"},
+		Recommendations: recommendations,
+	}, nil
+}
+
+// GetSimilarItems returns items similar to one the caller may open, for "students also
+// used" lists on the item page
+func (s *LibraryServiceServer) GetSimilarItems(ctx context.Context, req *v1.GetSimilarItemsRequest) (*v1.GetSimilarItemsResponse, error) {
+	if s.recommendations == nil {
+		return nil, status.Error(codes.Unavailable, "recommendations are not enabled")
+	}
+	itemID := strings.TrimSpace(req.GetId())
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	limit := recommendationLimit(req.GetLimit())
+
+	userRole, userLevel := userRoleLevelFromContext(ctx)
+	access, err := s.itemRepo.GetAccessMetadata(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load item metadata: %v", err)
+	}
+	if !hasAccess(userRole, userLevel, accessRequirement{
+		requiredRole:  defaultRole(access.RequiredRole),
+		requiredLevel: access.RequiredLevel,
+		targetRoles:   access.TargetRoles,
+	}) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	if err := s.checkItemOrganisation(ctx, itemID, userRole); err != nil {
+		return nil, err
+	}
+
+	types, _ := resolveLibraryItemTypes(nil)
+	filters, err := s.recommendationFilters(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.recommendations.Similar(ctx, itemID, recommendationFetchLimit(limit))
+	if err != nil {
+		s.logger.WithError(err).Error("list similar library items")
+		return nil, status.Errorf(codes.Internal, "failed to get similar items: %v", err)
+	}
+	recommendations, err := s.visibleRecommendations(ctx, filters, candidates, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetSimilarItemsResponse{
+		Response:        &common.Response{Success: true, Message: "Similar items loaded successfully"},
+		Recommendations: recommendations,
+	}, nil
+}
+
+// recommendationFilters lists active items of the given types the caller may open
+func (s *LibraryServiceServer) recommendationFilters(ctx context.Context, types []v1.LibraryItemType) (repository.LibraryItemListFilters, error) {
+	userRole, userLevel := userRoleLevelFromContext(ctx)
+	filters := libraryListFilters(&v1.ListLibraryItemsRequest{
+		Filter: &v1.LibraryFilter{OnlyActive: true},
+	}, types, userRole, userLevel)
+	if s.organisations != nil {
+		orgIDs, scoped, err := s.organisations.VisibleOrganisations(ctx, libraryActor(ctx, userRole))
+		if err != nil {
+			return filters, status.Errorf(codes.Internal, "failed to check item organisations: %v", err)
+		}
+		filters.ScopeOrganisations = scoped
+		filters.OrganisationIDs = orgIDs
+	}
+	return filters, nil
+}
+
+// visibleRecommendations drops candidates the caller may not open or that no longer
+// exist, keeping the ranking, and loads the first limit of them
+func (s *LibraryServiceServer) visibleRecommendations(ctx context.Context, filters repository.LibraryItemListFilters, candidates []recommend.Recommendation, limit int) ([]*v1.LibraryRecommendation, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	filters.ItemIDs = make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		filters.ItemIDs = append(filters.ItemIDs, c.ItemID)
+	}
+	filters.Limit = len(candidates)
+
+	page, err := s.itemRepo.ListVisible(ctx, filters)
+	if err != nil {
+		s.logger.WithError(err).Error("filter library recommendations")
+		return nil, status.Errorf(codes.Internal, "failed to get recommendations: %v", err)
+	}
+	visible := make(map[string]repository.LibraryItemRef, len(page.Items))
+	for _, ref := range page.Items {
+		visible[ref.ID] = ref
+	}
+
+	var refs []repository.LibraryItemRef
+	byID := make(map[string]recommend.Recommendation, limit)
+	for _, c := range candidates {
+		ref, ok := visible[c.ItemID]
+		if !ok {
+			continue
+		}
+		if _, dup := byID[c.ItemID]; dup {
+			continue
+		}
+		refs = append(refs, ref)
+		byID[c.ItemID] = c
+		if len(refs) == limit {
+			break
+		}
+	}
+
+	items, err := s.loadLibraryItems(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	recommendations := make([]*v1.LibraryRecommendation, 0, len(items))
+	for _, item := range items {
+		c := byID[item.GetId()]
+		recommendations = append(recommendations, &v1.LibraryRecommendation{
+			Item:         item,
+			Reason:       c.Reason,
+			Explanation:  c.Explanation,
+			Score:        c.Score,
+			SourceItemId: c.SourceItemID,
+			QuestionCode: c.QuestionCode,
+		})
+	}
+	return recommendations, nil
+}
+
+// popularRecommendations returns the most downloaded items the caller may open
+func (s *LibraryServiceServer) popularRecommendations(ctx context.Context, filters repository.LibraryItemListFilters, limit int) ([]*v1.LibraryRecommendation, error) {
+	filters.SortBy = "download_count"
+	filters.Limit = limit
+
+	page, err := s.itemRepo.ListVisible(ctx, filters)
+	if err != nil {
+		s.logger.WithError(err).Error("list popular library items")
+		return nil, status.Errorf(codes.Internal, "failed to get recommendations: %v", err)
+	}
+	items, err := s.loadLibraryItems(ctx, page.Items)
+	if err != nil {
+		return nil, err
+	}
+	recommendations := make([]*v1.LibraryRecommendation, 0, len(items))
+	for _, item := range items {
+		recommendations = append(recommendations, &v1.LibraryRecommendation{
+			Item:        item,
+			Reason:      recommend.ReasonPopular,
+			Explanation: recommend.PopularExplanation,
+		})
+	}
+	return recommendations, nil
+}
+
+func recommendationLimit(requested int32) int {
+	limit := int(requested)
+	if limit <= 0 {
+		return defaultRecommendationLimit
+	}
+	if limit > maxRecommendationLimit {
+		return maxRecommendationLimit
+	}
+	return limit
+}
+
+func recommendationFetchLimit(limit int) int {
+	fetch := limit * recommendationOverFetch
+	if fetch > 100 {
+		fetch = 100
+	}
+	return fetch
+}
@@ -0,0 +1,211 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/searchhistory"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSearchReportDays  = 7
+	maxSearchReportDays      = 365
+	defaultSearchReportLimit = 50
+	maxSearchReportLimit     = 500
+)
+
+// searchReportPermission guards GetSearchReport; seeded for TEACHER and ADMIN
+const searchReportPermission = "library.search_report.read"
+
+// SetSearchSuggestions enables GetSearchSuggestions
+func (s *LibraryServiceServer) SetSearchSuggestions(suggestions *LibrarySearchSuggestionsHandler) {
+	s.suggestions = suggestions
+}
+
+// SetSearchHistory logs library searches and enables RecordSearchClick,
+// ClearSearchHistory and GetSearchReport
+func (s *LibraryServiceServer) SetSearchHistory(history *searchhistory.Service) {
+	s.searchHistory = history
+}
+
+// GetSearchSuggestions completes what the user is typing from their recent searches,
+// trending queries and the catalog
+func (s *LibraryServiceServer) GetSearchSuggestions(ctx context.Context, req *v1.SearchSuggestionsRequest) (*v1.SearchSuggestionsResponse, error) {
+	if s.suggestions == nil {
+		return nil, status.Error(codes.Unavailable, "search suggestions are not enabled")
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > 50 {
+		limit = 10
+	}
+
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	suggestions, err := s.suggestions.GetSearchSuggestions(ctx, userID, req.GetQuery(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.SearchSuggestionsResponse{
+		Response:    &common.Response{Success: true, Message: "Suggestions loaded successfully"},
+		Suggestions: make([]*v1.SearchSuggestion, 0, len(suggestions)),
+	}
+	for _, suggestion := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &v1.SearchSuggestion{
+			Text:       suggestion.Text,
+			Type:       suggestion.Type,
+			Count:      suggestion.Count,
+			IsTrending: suggestion.IsTrending,
+		})
+	}
+	return resp, nil
+}
+
+// RecordSearchClick stores the result opened after a library or question search
+func (s *LibraryServiceServer) RecordSearchClick(ctx context.Context, req *v1.RecordSearchClickRequest) (*v1.RecordSearchClickResponse, error) {
+	if s.searchHistory == nil {
+		return nil, status.Error(codes.Unavailable, "search history is not enabled")
+	}
+	searchID := strings.TrimSpace(req.GetSearchId())
+	itemID := strings.TrimSpace(req.GetItemId())
+	if searchID == "" || itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "search id and item id are required")
+	}
+
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	if err := s.searchHistory.RecordClick(ctx, searchID, userID, itemID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "search not found")
+		}
+		s.logger.WithError(err).Error("record search click")
+		return nil, status.Errorf(codes.Internal, "failed to record click: %v", err)
+	}
+
+	return &v1.RecordSearchClickResponse{
+		Response: &common.Response{Success: true, Message: "Click recorded"},
+	}, nil
+}
+
+// ClearSearchHistory deletes the caller's library and question searches
+func (s *LibraryServiceServer) ClearSearchHistory(ctx context.Context, req *v1.ClearSearchHistoryRequest) (*v1.ClearSearchHistoryResponse, error) {
+	if s.searchHistory == nil {
+		return nil, status.Error(codes.Unavailable, "search history is not enabled")
+	}
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil || strings.TrimSpace(userID) == "" {
+		return nil, status.Error(codes.Unauthenticated, "user authentication required")
+	}
+
+	deleted, err := s.searchHistory.ClearHistory(ctx, userID)
+	if err != nil {
+		s.logger.WithError(err).Error("clear search history")
+		return nil, status.Errorf(codes.Internal, "failed to clear search history: %v", err)
+	}
+
+	return &v1.ClearSearchHistoryResponse{
+		Response: &common.Response{Success: true, Message: "Search history cleared"},
+		Deleted:  deleted,
+	}, nil
+}
+
+// GetSearchReport lists trending and zero-result queries so content leads can see what
+// students look for and what the library is missing
+func (s *LibraryServiceServer) GetSearchReport(ctx context.Context, req *v1.GetSearchReportRequest) (*v1.GetSearchReportResponse, error) {
+	if s.searchHistory == nil {
+		return nil, status.Error(codes.Unavailable, "search history is not enabled")
+	}
+	if !middleware.HasPermission(ctx, searchReportPermission, "", "") {
+		return nil, status.Error(codes.PermissionDenied, "permission to read search reports required")
+	}
+
+	scope := strings.ToLower(strings.TrimSpace(req.GetScope()))
+	if scope == "" {
+		scope = searchhistory.ScopeLibrary
+	}
+	if !searchhistory.ValidScope(scope) {
+		return nil, status.Error(codes.InvalidArgument, "scope must be library or question")
+	}
+	days := int(req.GetDays())
+	if days <= 0 || days > maxSearchReportDays {
+		days = defaultSearchReportDays
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > maxSearchReportLimit {
+		limit = defaultSearchReportLimit
+	}
+	window := time.Duration(days) * 24 * time.Hour
+
+	trending, err := s.searchHistory.Trending(ctx, scope, window, limit)
+	if err != nil {
+		s.logger.WithError(err).Error("list trending searches")
+		return nil, status.Errorf(codes.Internal, "failed to build search report: %v", err)
+	}
+	zeroResults, err := s.searchHistory.ZeroResults(ctx, scope, window, limit)
+	if err != nil {
+		s.logger.WithError(err).Error("list zero-result searches")
+		return nil, status.Errorf(codes.Internal, "failed to build search report: %v", err)
+	}
+
+	return &v1.GetSearchReportResponse{
+		Response:    &common.Response{Success: true, Message: "Search report loaded successfully"},
+		Trending:    toProtoSearchQueryStats(trending),
+		ZeroResults: toProtoSearchQueryStats(zeroResults),
+	}, nil
+}
+
+// logLibrarySearch records the first page of a search and returns its ID. Failures are
+// logged and never fail the search.
+func (s *LibraryServiceServer) logLibrarySearch(ctx context.Context, req *v1.ListLibraryItemsRequest, resultCount int) string {
+	return logSearch(ctx, s.searchHistory, searchhistory.ScopeLibrary, req.GetSearch(), req.GetFilter(), resultCount, func(err error) {
+		s.logger.WithError(err).Warn("failed to log library search")
+	})
+}
+
+// logSearch logs a search with its filters as JSON and returns the search ID, or an
+// empty ID when history is disabled or logging failed
+func logSearch(ctx context.Context, history *searchhistory.Service, scope, query string, filters proto.Message, resultCount int, onError func(error)) string {
+	if history == nil || strings.TrimSpace(query) == "" {
+		return ""
+	}
+	entry := searchhistory.Entry{Scope: scope, Query: query, ResultCount: resultCount}
+	entry.UserID, _ = middleware.GetUserIDFromContext(ctx)
+	if filters != nil && filters.ProtoReflect().IsValid() {
+		if raw, err := protojson.Marshal(filters); err == nil {
+			entry.Filters = json.RawMessage(raw)
+		}
+	}
+
+	id, err := history.Log(ctx, entry)
+	if err != nil {
+		onError(err)
+		return ""
+	}
+	return id
+}
+
+func toProtoSearchQueryStats(stats []repository.SearchQueryStat) []*v1.SearchQueryStat {
+	out := make([]*v1.SearchQueryStat, 0, len(stats))
+	for _, stat := range stats {
+		out = append(out, &v1.SearchQueryStat{
+			Query:          stat.Query,
+			Searches:       stat.Searches,
+			Users:          stat.Users,
+			Clicks:         stat.Clicks,
+			AverageResults: stat.AverageResults,
+			LastSearchedAt: timestamppb.New(stat.LastSearchedAt),
+		})
+	}
+	return out
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"strings"
 
+	"exam-bank-system/apps/backend/internal/service/searchhistory"
+
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,6 +16,9 @@ import (
 type LibrarySearchSuggestionsHandler struct {
 	db     *sql.DB
 	logger *logrus.Logger
+
+	// Optional search history; nil limits suggestions to the catalog and trending tags
+	history *searchhistory.Service
 }
 
 // NewLibrarySearchSuggestionsHandler creates a new search suggestions handler
@@ -24,70 +29,125 @@ func NewLibrarySearchSuggestionsHandler(db *sql.DB, logger *logrus.Logger) *Libr
 	}
 }
 
+// SetHistory adds the user's recent searches and trending queries to the suggestions
+func (h *LibrarySearchSuggestionsHandler) SetHistory(history *searchhistory.Service) {
+	h.history = history
+}
+
 // SearchSuggestion represents a search suggestion
 type SearchSuggestion struct {
 	Text       string
-	Type       string // "title", "subject", "tag", "trending"
-	Count      int64  // Number of matches
+	Type       string // "recent", "trending", "title", "subject", "tag"
+	Count      int64  // Number of matches, or users who searched a trending query
 	IsTrending bool
 }
 
-// GetSearchSuggestions retrieves search suggestions based on query
-func (h *LibrarySearchSuggestionsHandler) GetSearchSuggestions(ctx context.Context, query string, limit int) ([]*SearchSuggestion, error) {
-	if query == "" {
-		return h.getTrendingSuggestions(ctx, limit)
+// GetSearchSuggestions retrieves search suggestions based on query. Past searches come
+// first: the user's recent queries, then queries trending among all users. With a query
+// they are completed from item titles, subjects and tags; without one, from trending tags.
+func (h *LibrarySearchSuggestionsHandler) GetSearchSuggestions(ctx context.Context, userID, query string, limit int) ([]*SearchSuggestion, error) {
+	if limit <= 0 {
+		limit = 10
 	}
+	query = strings.TrimSpace(query)
 
 	h.logger.WithFields(logrus.Fields{
 		"query": query,
 		"limit": limit,
 	}).Debug("Getting search suggestions")
 
-	if limit <= 0 {
-		limit = 10
+	suggestions := h.getHistorySuggestions(ctx, userID, query, limit)
+	remaining := limit - len(suggestions)
+	if remaining <= 0 {
+		return suggestions, nil
 	}
 
-	suggestions := make([]*SearchSuggestion, 0)
+	var catalog []*SearchSuggestion
+	if query == "" {
+		trending, err := h.getTrendingSuggestions(ctx, remaining)
+		if err != nil {
+			return nil, err
+		}
+		catalog = trending
+	} else {
+		perSource := (remaining + 2) / 3
 
-	// Get title suggestions
-	titleSuggestions, err := h.getTitleSuggestions(ctx, query, limit)
-	if err == nil {
-		suggestions = append(suggestions, titleSuggestions...)
-	}
+		// Get title suggestions
+		if titleSuggestions, err := h.getTitleSuggestions(ctx, query, perSource); err == nil {
+			catalog = append(catalog, titleSuggestions...)
+		}
 
-	// Get subject suggestions
-	subjectSuggestions, err := h.getSubjectSuggestions(ctx, query, limit)
-	if err == nil {
-		suggestions = append(suggestions, subjectSuggestions...)
-	}
+		// Get subject suggestions
+		if subjectSuggestions, err := h.getSubjectSuggestions(ctx, query, perSource); err == nil {
+			catalog = append(catalog, subjectSuggestions...)
+		}
 
-	// Get tag suggestions
-	tagSuggestions, err := h.getTagSuggestions(ctx, query, limit)
-	if err == nil {
-		suggestions = append(suggestions, tagSuggestions...)
+		// Get tag suggestions
+		if tagSuggestions, err := h.getTagSuggestions(ctx, query, perSource); err == nil {
+			catalog = append(catalog, tagSuggestions...)
+		}
 	}
 
-	// Limit total results
-	if len(suggestions) > limit {
-		suggestions = suggestions[:limit]
+	seen := make(map[string]bool, len(suggestions))
+	for _, s := range suggestions {
+		seen[searchhistory.Normalize(s.Text)] = true
+	}
+	for _, s := range catalog {
+		if len(suggestions) >= limit {
+			break
+		}
+		key := searchhistory.Normalize(s.Text)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		suggestions = append(suggestions, s)
 	}
 
 	return suggestions, nil
 }
 
+// getHistorySuggestions returns past searches for the query, using at most half of the
+// limit when the query is completed from the catalog too. History errors are logged and
+// leave the catalog suggestions.
+func (h *LibrarySearchSuggestionsHandler) getHistorySuggestions(ctx context.Context, userID, query string, limit int) []*SearchSuggestion {
+	if h.history == nil {
+		return nil
+	}
+	if query != "" {
+		limit = (limit + 1) / 2
+	}
+	past, err := h.history.Suggestions(ctx, searchhistory.ScopeLibrary, userID, query, limit)
+	if err != nil {
+		h.logger.WithError(err).Warn("Failed to get search history suggestions")
+		return nil
+	}
+
+	suggestions := make([]*SearchSuggestion, 0, len(past))
+	for _, p := range past {
+		suggestions = append(suggestions, &SearchSuggestion{
+			Text:       p.Text,
+			Type:       p.Type,
+			Count:      p.Count,
+			IsTrending: p.Type == searchhistory.SuggestionTrending,
+		})
+	}
+	return suggestions
+}
+
 // getTitleSuggestions gets suggestions from item titles
 func (h *LibrarySearchSuggestionsHandler) getTitleSuggestions(ctx context.Context, query string, limit int) ([]*SearchSuggestion, error) {
 	sqlQuery := `
-		SELECT title, type, download_count
+		SELECT name, type, download_count
 		FROM library_items
-		WHERE is_active = true 
+		WHERE is_active = true
 		AND upload_status = 'approved'
-		AND title ILIKE $1
+		AND name ILIKE $1
 		ORDER BY download_count DESC
 		LIMIT $2
 	`
 
-	rows, err := h.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit/3)
+	rows, err := h.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit)
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get title suggestions")
 		return nil, err
@@ -117,10 +177,10 @@ func (h *LibrarySearchSuggestionsHandler) getTitleSuggestions(ctx context.Contex
 // getSubjectSuggestions gets suggestions from subjects
 func (h *LibrarySearchSuggestionsHandler) getSubjectSuggestions(ctx context.Context, query string, limit int) ([]*SearchSuggestion, error) {
 	sqlQuery := `
-		SELECT DISTINCT em.subject, COUNT(*) as count
+		SELECT em.subject, COUNT(*) as count
 		FROM exam_metadata em
-		INNER JOIN library_items li ON em.item_id = li.id
-		WHERE li.is_active = true 
+		INNER JOIN library_items li ON em.library_item_id = li.id
+		WHERE li.is_active = true
 		AND li.upload_status = 'approved'
 		AND em.subject ILIKE $1
 		GROUP BY em.subject
@@ -128,7 +188,7 @@ func (h *LibrarySearchSuggestionsHandler) getSubjectSuggestions(ctx context.Cont
 		LIMIT $2
 	`
 
-	rows, err := h.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit/3)
+	rows, err := h.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit)
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get subject suggestions")
 		return nil, err
@@ -165,7 +225,7 @@ func (h *LibrarySearchSuggestionsHandler) getTagSuggestions(ctx context.Context,
 		LIMIT $2
 	`
 
-	rows, err := h.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit/3)
+	rows, err := h.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit)
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get tag suggestions")
 		return nil, err
@@ -193,7 +253,7 @@ func (h *LibrarySearchSuggestionsHandler) getTagSuggestions(ctx context.Context,
 	return suggestions, nil
 }
 
-// getTrendingSuggestions gets trending suggestions when no query provided
+// getTrendingSuggestions gets trending tags when no query provided
 func (h *LibrarySearchSuggestionsHandler) getTrendingSuggestions(ctx context.Context, limit int) ([]*SearchSuggestion, error) {
 	h.logger.Debug("Getting trending suggestions")
 
@@ -224,81 +284,11 @@ func (h *LibrarySearchSuggestionsHandler) getTrendingSuggestions(ctx context.Con
 
 		suggestions = append(suggestions, &SearchSuggestion{
 			Text:       name,
-			Type:       "trending",
+			Type:       "tag",
 			Count:      count,
 			IsTrending: true,
 		})
 	}
 
-	// Get popular searches (if we have search history table)
-	// TODO: Implement when search_history table is available
-
 	return suggestions, nil
 }
-
-// GetPopularSearches retrieves most popular recent searches
-func (h *LibrarySearchSuggestionsHandler) GetPopularSearches(ctx context.Context, limit int) ([]*SearchSuggestion, error) {
-	h.logger.WithField("limit", limit).Debug("Getting popular searches")
-
-	// TODO: Implement when search_history table is available
-	// For now, return trending tags as a placeholder
-	return h.getTrendingSuggestions(ctx, limit)
-}
-
-// LogSearch logs a search query (for trending analysis)
-func (h *LibrarySearchSuggestionsHandler) LogSearch(ctx context.Context, userID string, query string) error {
-	if query == "" {
-		return nil
-	}
-
-	// Normalize query
-	normalizedQuery := strings.TrimSpace(strings.ToLower(query))
-
-	h.logger.WithFields(logrus.Fields{
-		"user_id": userID,
-		"query":   normalizedQuery,
-	}).Debug("Logging search query")
-
-	// TODO: Implement search_history table and logging
-	// For now, just log to logger
-	h.logger.WithFields(logrus.Fields{
-		"user_id": userID,
-		"query":   normalizedQuery,
-	}).Info("Search query logged")
-
-	return nil
-}
-
-// GetRecentSearches retrieves user's recent searches
-func (h *LibrarySearchSuggestionsHandler) GetRecentSearches(ctx context.Context, userID string, limit int) ([]*SearchSuggestion, error) {
-	h.logger.WithFields(logrus.Fields{
-		"user_id": userID,
-		"limit":   limit,
-	}).Debug("Getting recent searches")
-
-	// TODO: Implement when search_history table is available
-	// For now, return empty array
-	return []*SearchSuggestion{}, nil
-}
-
-// NOTE: gRPC endpoint integration example:
-// func (s *LibraryService) GetSearchSuggestions(ctx context.Context, req *pb.SearchSuggestionsRequest) (*pb.SearchSuggestionsResponse, error) {
-//     suggestions, err := s.searchHandler.GetSearchSuggestions(ctx, req.Query, int(req.Limit))
-//     if err != nil {
-//         return nil, err
-//     }
-//
-//     pbSuggestions := make([]*pb.SearchSuggestion, len(suggestions))
-//     for i, s := range suggestions {
-//         pbSuggestions[i] = &pb.SearchSuggestion{
-//             Text:       s.Text,
-//             Type:       s.Type,
-//             Count:      s.Count,
-//             IsTrending: s.IsTrending,
-//         }
-//     }
-//
-//     return &pb.SearchSuggestionsResponse{
-//         Suggestions: pbSuggestions,
-//     }, nil
-// }
@@ -6,17 +6,25 @@ import (
 	"errors"
 	"fmt"
 	"path"
-	"sort"
 	"strings"
-	"time"
 
 	"exam-bank-system/apps/backend/internal/entity"
 	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/opensearch"
 	"exam-bank-system/apps/backend/internal/repository"
 	booksvc "exam-bank-system/apps/backend/internal/service/content/book"
 	bookmarksvc "exam-bank-system/apps/backend/internal/service/library/bookmark"
+	"exam-bank-system/apps/backend/internal/service/library/collection"
+	"exam-bank-system/apps/backend/internal/service/library/download"
+	"exam-bank-system/apps/backend/internal/service/library/moderation"
 	ratingsvc "exam-bank-system/apps/backend/internal/service/library/rating"
+	"exam-bank-system/apps/backend/internal/service/library/recommend"
+	"exam-bank-system/apps/backend/internal/service/library/versioning"
 	videosvc "exam-bank-system/apps/backend/internal/service/library/video"
+	"exam-bank-system/apps/backend/internal/service/organisation"
+	"exam-bank-system/apps/backend/internal/service/searchhistory"
+	"exam-bank-system/apps/backend/internal/service/storage"
+	system "exam-bank-system/apps/backend/internal/service/system"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
 
@@ -29,6 +37,9 @@ import (
 
 const defaultPageLimit = 20
 
+// maxContentMatches bounds the items a search takes from the file text index
+const maxContentMatches = 200
+
 var roleHierarchy = map[string]int{
 	"GUEST":   0,
 	"STUDENT": 1,
@@ -37,16 +48,6 @@ var roleHierarchy = map[string]int{
 	"ADMIN":   4,
 }
 
-type aggregatedItem struct {
-	proto     *v1.LibraryItem
-	createdAt time.Time
-	download  int64
-	rating    float64
-	title     string
-	required  accessRequirement
-	itemID    string
-}
-
 type accessRequirement struct {
 	requiredRole  string
 	requiredLevel sql.NullInt32
@@ -63,6 +64,37 @@ type LibraryServiceServer struct {
 	bookmarkService *bookmarksvc.Service
 	itemRepo        repository.LibraryItemRepository
 	logger          *logrus.Entry
+
+	// Optional organisation scoping; nil leaves every item visible
+	organisations *organisation.Service
+
+	// Optional blob store; files uploaded to it are served through presigned links
+	blobs storage.BlobStore
+
+	// Optional signed, watermarked download links for books and exams
+	downloads *download.Service
+
+	// Optional per-user download quota and risk tracking
+	protection *system.ResourceProtectionService
+
+	// Optional search over the page text of book and exam files
+	contentSearch *opensearch.LibraryPageRepository
+
+	// Optional precomputed recommendations and similar items
+	recommendations *recommend.Service
+
+	// Optional search suggestions and search history
+	suggestions   *LibrarySearchSuggestionsHandler
+	searchHistory *searchhistory.Service
+
+	// Optional study collections
+	collections *collection.Service
+
+	// Optional file history of library items
+	versions *versioning.Service
+
+	// Optional moderation queue with decision reasons and SLA tracking
+	moderation *moderation.Service
 }
 
 // NewLibraryServiceServer creates a new library service handler.
@@ -85,11 +117,48 @@ func NewLibraryServiceServer(
 	}
 }
 
-// ListItems returns library items (books/exams/videos) with RBAC filtering.
+// SetOrganisationScope enables organisation scoping of library items
+func (s *LibraryServiceServer) SetOrganisationScope(organisations *organisation.Service) {
+	s.organisations = organisations
+}
+
+// SetBlobStore enables presigned download links for files kept in the blob store
+func (s *LibraryServiceServer) SetBlobStore(blobs storage.BlobStore) {
+	s.blobs = blobs
+}
+
+// SetDownloadLinks makes DownloadItem return signed links that serve watermarked copies
+// of book and exam files instead of the raw file URL
+func (s *LibraryServiceServer) SetDownloadLinks(downloads *download.Service) {
+	s.downloads = downloads
+}
+
+// SetResourceProtection enforces the daily download limit and tracks downloads for
+// risk scoring
+func (s *LibraryServiceServer) SetResourceProtection(protection *system.ResourceProtectionService) {
+	s.protection = protection
+}
+
+// SetContentSearch makes SearchItems also match books and exams by the text of their
+// files and report the matching page
+func (s *LibraryServiceServer) SetContentSearch(pages *opensearch.LibraryPageRepository) {
+	s.contentSearch = pages
+}
+
+// ListItems returns library items (books/exams/videos) with RBAC filtering. Filtering,
+// access rules and keyset pagination run in the database; page numbers still work for
+// clients that do not send a cursor.
 func (s *LibraryServiceServer) ListItems(ctx context.Context, req *v1.ListLibraryItemsRequest) (*v1.ListLibraryItemsResponse, error) {
+	resp, _, err := s.listItems(ctx, req, false)
+	return resp, err
+}
+
+// listItems runs a listing; with searchContent the search also matches file text and
+// the best page of each matched item is returned
+func (s *LibraryServiceServer) listItems(ctx context.Context, req *v1.ListLibraryItemsRequest, searchContent bool) (*v1.ListLibraryItemsResponse, map[string]opensearch.LibraryPageHit, error) {
 	types, err := resolveLibraryItemTypes(req.GetFilter())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	limit := int(req.GetPagination().GetLimit())
@@ -102,35 +171,44 @@ func (s *LibraryServiceServer) ListItems(ctx context.Context, req *v1.ListLibrar
 	}
 
 	userRole, userLevel := userRoleLevelFromContext(ctx)
+	filters := libraryListFilters(req, types, userRole, userLevel)
+	filters.Limit = limit
+	filters.Offset = (page - 1) * limit
+	if s.organisations != nil {
+		orgIDs, scoped, err := s.organisations.VisibleOrganisations(ctx, libraryActor(ctx, userRole))
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to check item organisations: %v", err)
+		}
+		filters.ScopeOrganisations = scoped
+		filters.OrganisationIDs = orgIDs
+	}
 
-	items, err := s.fetchAndFilterItems(ctx, req, types, userRole, userLevel, page, limit)
-	if err != nil {
-		return nil, err
+	var contentHits map[string]opensearch.LibraryPageHit
+	if searchContent && filters.Search != "" {
+		contentHits = s.searchContent(ctx, filters.Search, filters.Types)
+		for id := range contentHits {
+			filters.SearchItemIDs = append(filters.SearchItemIDs, id)
+		}
 	}
 
-	totalCount := len(items)
-	start := (page - 1) * limit
-	if start >= totalCount {
-		return &v1.ListLibraryItemsResponse{
-			Response: &common.Response{Success: true, Message: "Items loaded successfully"},
-			Items:    []*v1.LibraryItem{},
-			Pagination: &common.PaginationResponse{
-				Page:       int32(page),
-				Limit:      int32(limit),
-				TotalPages: int32((totalCount + limit - 1) / limit),
-				TotalCount: int32(totalCount),
-			},
-		}, nil
+	result, err := s.itemRepo.ListVisible(ctx, filters)
+	if errors.Is(err, repository.ErrInvalidInput) {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid or mismatched cursor")
+	}
+	if err != nil {
+		s.logger.WithError(err).Error("list library items")
+		return nil, nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
 	}
 
-	end := start + limit
-	if end > totalCount {
-		end = totalCount
+	items, err := s.loadLibraryItems(ctx, result.Items)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	pageItems := make([]*v1.LibraryItem, 0, end-start)
-	for _, item := range items[start:end] {
-		pageItems = append(pageItems, item.proto)
+	// Searches are logged once, not for every page fetched
+	var searchID string
+	if filters.Search != "" && filters.Cursor == "" && page == 1 {
+		searchID = s.logLibrarySearch(ctx, req, result.Total)
 	}
 
 	return &v1.ListLibraryItemsResponse{
@@ -138,14 +216,44 @@ func (s *LibraryServiceServer) ListItems(ctx context.Context, req *v1.ListLibrar
 			Success: true,
 			Message: "Items loaded successfully",
 		},
-		Items: pageItems,
+		Items: items,
 		Pagination: &common.PaginationResponse{
 			Page:       int32(page),
 			Limit:      int32(limit),
-			TotalPages: int32((totalCount + limit - 1) / limit),
-			TotalCount: int32(totalCount),
+			TotalPages: int32((result.Total + limit - 1) / limit),
+			TotalCount: int32(result.Total),
 		},
-	}, nil
+		NextCursor: result.NextCursor,
+		SearchId:   searchID,
+	}, contentHits, nil
+}
+
+// searchContent returns the best matching page of each book or exam whose file text
+// matches the query. Index failures fall back to metadata search.
+func (s *LibraryServiceServer) searchContent(ctx context.Context, query string, types []string) map[string]opensearch.LibraryPageHit {
+	if s.contentSearch == nil {
+		return nil
+	}
+	var indexed []string
+	for _, t := range types {
+		if t == "book" || t == "exam" {
+			indexed = append(indexed, t)
+		}
+	}
+	if len(indexed) == 0 {
+		return nil
+	}
+
+	hits, err := s.contentSearch.Search(ctx, query, indexed, maxContentMatches)
+	if err != nil {
+		s.logger.WithError(err).Warn("library content search failed, matching metadata only")
+		return nil
+	}
+	byItem := make(map[string]opensearch.LibraryPageHit, len(hits))
+	for _, hit := range hits {
+		byItem[hit.ItemID] = hit
+	}
+	return byItem
 }
 
 // GetItem returns a single library item by ID.
@@ -156,6 +264,9 @@ func (s *LibraryServiceServer) GetItem(ctx context.Context, req *v1.GetLibraryIt
 	}
 
 	userRole, userLevel := userRoleLevelFromContext(ctx)
+	if err := s.checkItemOrganisation(ctx, id, userRole); err != nil {
+		return nil, err
+	}
 
 	// Try book domain first.
 	if book, err := s.bookService.GetBook(ctx, id); err == nil {
@@ -167,6 +278,7 @@ func (s *LibraryServiceServer) GetItem(ctx context.Context, req *v1.GetLibraryIt
 		}) {
 			return nil, status.Error(codes.PermissionDenied, "access denied")
 		}
+		hideFileLocation(ctx, item)
 		return &v1.GetLibraryItemResponse{
 			Response: &common.Response{Success: true, Message: "Item fetched successfully"},
 			Item:     item,
@@ -186,6 +298,7 @@ func (s *LibraryServiceServer) GetItem(ctx context.Context, req *v1.GetLibraryIt
 		}) {
 			return nil, status.Error(codes.PermissionDenied, "access denied")
 		}
+		hideFileLocation(ctx, item)
 		return &v1.GetLibraryItemResponse{
 			Response: &common.Response{Success: true, Message: "Item fetched successfully"},
 			Item:     item,
@@ -205,6 +318,7 @@ func (s *LibraryServiceServer) GetItem(ctx context.Context, req *v1.GetLibraryIt
 		}) {
 			return nil, status.Error(codes.PermissionDenied, "access denied")
 		}
+		hideFileLocation(ctx, item)
 		return &v1.GetLibraryItemResponse{
 			Response: &common.Response{Success: true, Message: "Item fetched successfully"},
 			Item:     item,
@@ -283,12 +397,32 @@ func (s *LibraryServiceServer) UpdateItem(ctx context.Context, req *v1.UpdateLib
 		return nil, status.Errorf(codes.InvalidArgument, "invalid payload: %v", err)
 	}
 
+	// File changes become a new version so the old file stays available for rollback
+	var replacement *repository.LibraryItemFile
+	if s.versions != nil {
+		replacement = takeFileChange(&input, existing)
+	}
+
 	book, err := s.bookService.UpdateBook(ctx, bookID, input)
 	if err != nil {
 		s.logger.WithError(err).Error("update library book item")
 		return nil, status.Errorf(codes.Internal, "failed to update item: %v", err)
 	}
 
+	if replacement != nil {
+		note := strings.TrimSpace(req.GetChangeNote())
+		if note == "" {
+			note = defaultFileChangeNote
+		}
+		userID, _ := middleware.GetUserIDFromContext(ctx)
+		if _, err := s.versions.Replace(ctx, strings.TrimSpace(userID), bookID, *replacement, note); err != nil {
+			return nil, s.versionError(err, "replace library item file")
+		}
+		if book, err = s.bookService.GetBook(ctx, bookID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to reload item: %v", err)
+		}
+	}
+
 	return &v1.UpdateLibraryItemResponse{
 		Response: &common.Response{
 			Success: true,
@@ -324,11 +458,36 @@ func (s *LibraryServiceServer) ApproveItem(ctx context.Context, req *v1.ApproveL
 		reviewer = pointerFromString(reviewerID)
 	}
 
-	if err := s.itemRepo.UpdateApproval(ctx, req.GetId(), newStatus, reviewer); err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "item not found")
+	// Decisions on pending items go through moderation, which records the reason and
+	// notifies the uploader; items that already left the queue change status directly
+	decided := false
+	if s.moderation != nil && (newStatus == "approved" || newStatus == "rejected") {
+		decision := moderation.Decision{
+			Targets: []moderation.Target{{Type: repository.ModerationTargetLibraryItem, ID: req.GetId()}},
+			Approve: newStatus == "approved",
+			Note:    req.GetReviewerNote(),
+		}
+		if !decision.Approve {
+			decision.ReasonCode = moderation.ReasonOther
+		}
+		moderatorID, _ := middleware.GetUserIDFromContext(ctx)
+		results, err := s.moderation.Decide(ctx, strings.TrimSpace(moderatorID), decision)
+		if err != nil {
+			return nil, s.moderationError(err, "update approval")
+		}
+		if err := results[0].Err; err != nil && !errors.Is(err, moderation.ErrNotFound) {
+			return nil, s.moderationError(err, "update approval")
+		}
+		decided = results[0].Err == nil
+	}
+
+	if !decided {
+		if err := s.itemRepo.UpdateApproval(ctx, req.GetId(), newStatus, reviewer); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "item not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to update approval: %v", err)
 		}
-		return nil, status.Errorf(codes.Internal, "failed to update approval: %v", err)
 	}
 
 	itemResp, err := s.GetItem(ctx, &v1.GetLibraryItemRequest{Id: req.GetId()})
@@ -404,13 +563,20 @@ func (s *LibraryServiceServer) BookmarkItem(ctx context.Context, req *v1.Bookmar
 	}, nil
 }
 
-// DownloadItem increments download count and returns download URL.
+// DownloadItem increments download count and returns download URL. With signed links
+// enabled, books and exams are served through a short-lived link bound to the caller.
 func (s *LibraryServiceServer) DownloadItem(ctx context.Context, req *v1.DownloadLibraryItemRequest) (*v1.DownloadLibraryItemResponse, error) {
 	itemID := strings.TrimSpace(req.GetId())
 	if itemID == "" {
 		return nil, status.Error(codes.InvalidArgument, "item id is required")
 	}
 
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	userID = strings.TrimSpace(userID)
+	if userID == "" && (s.downloads != nil || s.protection != nil) {
+		return nil, status.Error(codes.Unauthenticated, "user authentication required")
+	}
+
 	userRole, userLevel := userRoleLevelFromContext(ctx)
 	access, err := s.itemRepo.GetAccessMetadata(ctx, itemID)
 	if err != nil {
@@ -427,29 +593,77 @@ func (s *LibraryServiceServer) DownloadItem(ctx context.Context, req *v1.Downloa
 	}) {
 		return nil, status.Error(codes.PermissionDenied, "access denied")
 	}
+	if s.organisations != nil && access.OrganisationID != "" {
+		ok, err := s.organisations.CanAccess(ctx, libraryActor(ctx, userRole), access.OrganisationID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check item organisation: %v", err)
+		}
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+	}
+
+	itemType := strings.ToLower(access.ItemType)
+	if itemType != "book" && itemType != "exam" && itemType != "video" {
+		return nil, status.Error(codes.InvalidArgument, "unsupported item type")
+	}
+
+	var auditUser *string
+	if userID != "" {
+		auditUser = &userID
+	}
+	ipAddress, userAgent := getClientIP(ctx), getUserAgent(ctx)
+
+	if s.protection != nil {
+		err := s.protection.ValidateDownload(ctx, &system.ResourceAccessAttempt{
+			UserID:       userID,
+			ResourceType: strings.ToUpper(itemType),
+			ResourceID:   itemID,
+			IPAddress:    ipAddress,
+			UserAgent:    userAgent,
+			Metadata:     map[string]interface{}{},
+		})
+		switch {
+		case errors.Is(err, system.ErrDownloadLimitExceeded):
+			return nil, status.Error(codes.ResourceExhausted, "daily download limit reached, try again later")
+		case errors.Is(err, system.ErrAccessBlocked):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed to validate download: %v", err)
+		}
+	}
 
 	var downloadURL string
-	switch strings.ToLower(access.ItemType) {
+	var expiresAt *timestamppb.Timestamp
+	switch itemType {
 	case "book":
-		if _, err := s.bookService.IncrementDownload(ctx, itemID, booksvc.DownloadAudit{}); err != nil {
+		audit := booksvc.DownloadAudit{UserID: auditUser, IPAddress: ipAddress, UserAgent: userAgent}
+		if _, err := s.bookService.IncrementDownload(ctx, itemID, audit); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to record download: %v", err)
 		}
 		book, err := s.bookService.GetBook(ctx, itemID)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to load item: %v", err)
 		}
-		downloadURL = stringFromNull(book.FileURL)
+		downloadURL, expiresAt, err = s.signedDownloadURL(ctx, itemID, itemType, userID, stringFromNull(book.FileID), stringFromNull(book.FileURL))
+		if err != nil {
+			return nil, err
+		}
 	case "exam":
-		if _, err := s.examRepo.IncrementDownloadCount(ctx, itemID, nil, "", ""); err != nil {
+		if _, err := s.examRepo.IncrementDownloadCount(ctx, itemID, auditUser, ipAddress, userAgent); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to record download: %v", err)
 		}
 		exam, err := s.examRepo.GetByID(ctx, itemID)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to load item: %v", err)
 		}
-		downloadURL = stringFromNull(exam.FileURL)
+		downloadURL, expiresAt, err = s.signedDownloadURL(ctx, itemID, itemType, userID, stringFromNull(exam.FileID), stringFromNull(exam.FileURL))
+		if err != nil {
+			return nil, err
+		}
 	case "video":
-		if _, err := s.videoService.IncrementDownload(ctx, itemID, videosvc.DownloadAudit{}); err != nil {
+		audit := videosvc.DownloadAudit{UserID: auditUser, IPAddress: ipAddress, UserAgent: userAgent}
+		if _, err := s.videoService.IncrementDownload(ctx, itemID, audit); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to record download: %v", err)
 		}
 		video, err := s.videoService.Get(ctx, itemID)
@@ -457,8 +671,13 @@ func (s *LibraryServiceServer) DownloadItem(ctx context.Context, req *v1.Downloa
 			return nil, status.Errorf(codes.Internal, "failed to load item: %v", err)
 		}
 		downloadURL = video.YoutubeURL
-	default:
-		return nil, status.Error(codes.InvalidArgument, "unsupported item type")
+		if downloadURL == "" {
+			// Uploaded recordings have no YouTube source; stream them from storage
+			downloadURL, err = s.fileDownloadURL(ctx, stringFromNull(video.FileID), stringFromNull(video.FileURL))
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return &v1.DownloadLibraryItemResponse{
@@ -467,134 +686,88 @@ func (s *LibraryServiceServer) DownloadItem(ctx context.Context, req *v1.Downloa
 			Message: "Download recorded successfully",
 		},
 		DownloadUrl: downloadURL,
+		ExpiresAt:   expiresAt,
 	}, nil
 }
 
-// SearchItems reuses ListItems behavior with search query.
+// signedDownloadURL issues a watermarking link when signed links are enabled, and falls
+// back to fileDownloadURL otherwise
+func (s *LibraryServiceServer) signedDownloadURL(ctx context.Context, itemID, itemType, userID, fileID, fileURL string) (string, *timestamppb.Timestamp, error) {
+	if s.downloads == nil {
+		link, err := s.fileDownloadURL(ctx, fileID, fileURL)
+		return link, nil, err
+	}
+	if fileID == "" && fileURL == "" {
+		return "", nil, nil
+	}
+	link, expires, err := s.downloads.SignedURL(download.Grant{ItemID: itemID, ItemType: itemType, UserID: userID})
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to sign download link: %v", err)
+	}
+	return link, timestamppb.New(expires), nil
+}
+
+// fileDownloadURL presigns files uploaded to the blob store and passes other links through
+func (s *LibraryServiceServer) fileDownloadURL(ctx context.Context, fileID, fileURL string) (string, error) {
+	if s.blobs == nil || !strings.HasPrefix(fileID, LibraryBlobPrefix) {
+		return fileURL, nil
+	}
+	link, err := s.blobs.PresignGet(ctx, fileID, LibraryDownloadLinkTTL)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to sign download link: %v", err)
+	}
+	return link, nil
+}
+
+// SearchItems reuses ListItems behavior with search query. Books and exams also match
+// by the text of their files when content search is enabled, and the matching page of
+// each such item on the page is returned with a highlighted snippet.
 func (s *LibraryServiceServer) SearchItems(ctx context.Context, req *v1.SearchLibraryItemsRequest) (*v1.SearchLibraryItemsResponse, error) {
 	listReq := &v1.ListLibraryItemsRequest{
 		Pagination: req.GetPagination(),
 		Filter:     req.GetFilter(),
 		Search:     req.GetQuery(),
+		Cursor:     req.GetCursor(),
 	}
 
-	resp, err := s.ListItems(ctx, listReq)
+	resp, contentHits, err := s.listItems(ctx, listReq, true)
 	if err != nil {
 		return nil, err
 	}
 
-	return &v1.SearchLibraryItemsResponse{
-		Response:   resp.GetResponse(),
-		Items:      resp.GetItems(),
-		Pagination: resp.GetPagination(),
-	}, nil
-}
-
-// ---- helper logic ----
-
-func (s *LibraryServiceServer) fetchAndFilterItems(
-	ctx context.Context,
-	req *v1.ListLibraryItemsRequest,
-	types []v1.LibraryItemType,
-	userRole string,
-	userLevel int,
-	page int,
-	limit int,
-) ([]aggregatedItem, error) {
-	fetchLimit := limit * page
-	if fetchLimit < limit {
-		fetchLimit = limit
-	}
-
-	var results []aggregatedItem
-	for _, t := range types {
-		switch t {
-		case v1.LibraryItemType_LIBRARY_ITEM_TYPE_BOOK:
-			items, err := s.fetchBooks(ctx, req, fetchLimit)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, items...)
-		case v1.LibraryItemType_LIBRARY_ITEM_TYPE_EXAM:
-			items, err := s.fetchExams(ctx, req, fetchLimit)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, items...)
-		case v1.LibraryItemType_LIBRARY_ITEM_TYPE_VIDEO:
-			items, err := s.fetchVideos(ctx, req, fetchLimit)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, items...)
-		}
-	}
-
-	filtered := make([]aggregatedItem, 0, len(results))
-	for _, item := range results {
-		if hasAccess(strings.ToUpper(userRole), userLevel, item.required) {
-			filtered = append(filtered, item)
+	var matches []*v1.LibraryContentMatch
+	for _, item := range resp.GetItems() {
+		if hit, ok := contentHits[item.GetId()]; ok {
+			matches = append(matches, &v1.LibraryContentMatch{
+				ItemId:  hit.ItemID,
+				Page:    int32(hit.Page),
+				Snippet: hit.Snippet,
+			})
 		}
 	}
 
-	sortAggregatedItems(filtered, strings.TrimSpace(req.GetSortBy()), strings.TrimSpace(req.GetSortOrder()))
-
-	return filtered, nil
+	return &v1.SearchLibraryItemsResponse{
+		Response:       resp.GetResponse(),
+		Items:          resp.GetItems(),
+		Pagination:     resp.GetPagination(),
+		NextCursor:     resp.GetNextCursor(),
+		ContentMatches: matches,
+		SearchId:       resp.GetSearchId(),
+	}, nil
 }
 
-func (s *LibraryServiceServer) fetchBooks(ctx context.Context, req *v1.ListLibraryItemsRequest, fetchLimit int) ([]aggregatedItem, error) {
-	filter := req.GetFilter()
-	if filter == nil {
-		filter = &v1.LibraryFilter{}
-	}
-
-	bookFilters := repository.BookListFilters{
-		Limit:     fetchLimit,
-		Offset:    0,
-		Search:    strings.TrimSpace(req.GetSearch()),
-		SortBy:    strings.TrimSpace(req.GetSortBy()),
-		SortOrder: strings.TrimSpace(req.GetSortOrder()),
-	}
-	if filter.GetOnlyActive() {
-		active := true
-		bookFilters.IsActive = &active
-	}
-
-	books, _, err := s.bookService.ListBooks(ctx, bookFilters)
-	if err != nil {
-		s.logger.WithError(err).Error("list library items (books)")
-		return nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
-	}
-
-	items := make([]aggregatedItem, 0, len(books))
-	for _, book := range books {
-		proto := toProtoLibraryItemFromBook(book)
-		items = append(items, aggregatedItem{
-			proto:     proto,
-			createdAt: proto.GetCreatedAt().AsTime(),
-			download:  int64(book.DownloadCount),
-			rating:    book.AverageRating,
-			title:     proto.GetName(),
-			itemID:    proto.GetId(),
-			required: accessRequirement{
-				requiredRole:  defaultRole(proto.GetRequiredRole()),
-				requiredLevel: nullInt32ToSQL(proto.GetRequiredLevel()),
-				targetRoles:   proto.GetTargetRoles(),
-			},
-		})
-	}
-	return items, nil
-}
+// ---- helper logic ----
 
-func (s *LibraryServiceServer) fetchExams(ctx context.Context, req *v1.ListLibraryItemsRequest, fetchLimit int) ([]aggregatedItem, error) {
+// libraryListFilters maps a list request onto the repository listing filters
+func libraryListFilters(req *v1.ListLibraryItemsRequest, types []v1.LibraryItemType, userRole string, userLevel int) repository.LibraryItemListFilters {
 	filter := req.GetFilter()
 	if filter == nil {
 		filter = &v1.LibraryFilter{}
 	}
 
-	examFilters := repository.LibraryExamListFilters{
-		Limit:        fetchLimit,
-		Offset:       0,
+	filters := repository.LibraryItemListFilters{
+		OnlyActive:   filter.GetOnlyActive(),
+		Search:       strings.TrimSpace(req.GetSearch()),
 		Subjects:     filter.GetSubjects(),
 		Grades:       filter.GetGrades(),
 		Province:     strings.TrimSpace(filter.GetProvince()),
@@ -602,120 +775,90 @@ func (s *LibraryServiceServer) fetchExams(ctx context.Context, req *v1.ListLibra
 		Semester:     strings.TrimSpace(filter.GetSemester()),
 		Difficulty:   strings.TrimSpace(filter.GetDifficultyLevel()),
 		ExamType:     strings.TrimSpace(filter.GetExamType()),
-		Search:       strings.TrimSpace(req.GetSearch()),
+		VideoQuality: strings.TrimSpace(filter.GetVideoQuality()),
+		ViewerRole:   userRole,
+		ViewerLevel:  userLevel,
 		SortBy:       strings.TrimSpace(req.GetSortBy()),
 		SortOrder:    strings.TrimSpace(req.GetSortOrder()),
+		Cursor:       strings.TrimSpace(req.GetCursor()),
 	}
-	if filter.GetOnlyActive() {
-		active := true
-		examFilters.OnlyActive = &active
-	}
-
-	exams, _, err := s.examRepo.List(ctx, examFilters)
-	if err != nil {
-		s.logger.WithError(err).Error("list library items (exams)")
-		return nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
-	}
-
-	items := make([]aggregatedItem, 0, len(exams))
-	for _, exam := range exams {
-		proto := toProtoLibraryItemFromExam(exam)
-		items = append(items, aggregatedItem{
-			proto:     proto,
-			createdAt: proto.GetCreatedAt().AsTime(),
-			download:  int64(exam.DownloadCount),
-			rating:    exam.AverageRating,
-			title:     proto.GetName(),
-			itemID:    proto.GetId(),
-			required: accessRequirement{
-				requiredRole:  defaultRole(proto.GetRequiredRole()),
-				requiredLevel: nullInt32ToSQL(proto.GetRequiredLevel()),
-				targetRoles:   proto.GetTargetRoles(),
-			},
-		})
+	for _, t := range types {
+		switch t {
+		case v1.LibraryItemType_LIBRARY_ITEM_TYPE_BOOK:
+			filters.Types = append(filters.Types, "book")
+		case v1.LibraryItemType_LIBRARY_ITEM_TYPE_EXAM:
+			filters.Types = append(filters.Types, "exam")
+		case v1.LibraryItemType_LIBRARY_ITEM_TYPE_VIDEO:
+			filters.Types = append(filters.Types, "video")
+		}
 	}
-	return items, nil
+	return filters
 }
 
-func (s *LibraryServiceServer) fetchVideos(ctx context.Context, req *v1.ListLibraryItemsRequest, fetchLimit int) ([]aggregatedItem, error) {
-	filter := req.GetFilter()
-	if filter == nil {
-		filter = &v1.LibraryFilter{}
+// loadLibraryItems loads the details of a listing page with one query per item type
+// and returns them in page order
+func (s *LibraryServiceServer) loadLibraryItems(ctx context.Context, refs []repository.LibraryItemRef) ([]*v1.LibraryItem, error) {
+	idsByType := make(map[string][]string)
+	for _, ref := range refs {
+		idsByType[ref.Type] = append(idsByType[ref.Type], ref.ID)
 	}
 
-	videoFilters := repository.LibraryVideoListFilters{
-		Limit:     fetchLimit,
-		Offset:    0,
-		Subjects:  filter.GetSubjects(),
-		Grades:    filter.GetGrades(),
-		Quality:   strings.TrimSpace(filter.GetVideoQuality()),
-		Search:    strings.TrimSpace(req.GetSearch()),
-		SortBy:    strings.TrimSpace(req.GetSortBy()),
-		SortOrder: strings.TrimSpace(req.GetSortOrder()),
+	loaded := make(map[string]*v1.LibraryItem, len(refs))
+	if ids := idsByType["book"]; len(ids) > 0 {
+		books, _, err := s.bookService.ListBooks(ctx, repository.BookListFilters{IDs: ids, Limit: len(ids)})
+		if err != nil {
+			s.logger.WithError(err).Error("load library items (books)")
+			return nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
+		}
+		for _, book := range books {
+			loaded[book.ID] = toProtoLibraryItemFromBook(book)
+		}
+	}
+	if ids := idsByType["exam"]; len(ids) > 0 {
+		exams, _, err := s.examRepo.List(ctx, repository.LibraryExamListFilters{IDs: ids, Limit: len(ids)})
+		if err != nil {
+			s.logger.WithError(err).Error("load library items (exams)")
+			return nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
+		}
+		for _, exam := range exams {
+			loaded[exam.ID] = toProtoLibraryItemFromExam(exam)
+		}
 	}
-	if filter.GetOnlyActive() {
-		active := true
-		videoFilters.OnlyActive = &active
+	if ids := idsByType["video"]; len(ids) > 0 {
+		videos, _, err := s.videoService.List(ctx, repository.LibraryVideoListFilters{IDs: ids, Limit: len(ids)})
+		if err != nil {
+			s.logger.WithError(err).Error("load library items (videos)")
+			return nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
+		}
+		for _, video := range videos {
+			loaded[video.ID] = toProtoLibraryItemFromVideo(video)
+		}
 	}
 
-	videos, _, err := s.videoService.List(ctx, videoFilters)
-	if err != nil {
-		s.logger.WithError(err).Error("list library items (videos)")
-		return nil, status.Errorf(codes.Internal, "failed to list items: %v", err)
-	}
-
-	items := make([]aggregatedItem, 0, len(videos))
-	for _, video := range videos {
-		proto := toProtoLibraryItemFromVideo(video)
-		items = append(items, aggregatedItem{
-			proto:     proto,
-			createdAt: proto.GetCreatedAt().AsTime(),
-			download:  int64(video.DownloadCount),
-			rating:    video.AverageRating,
-			title:     proto.GetName(),
-			itemID:    proto.GetId(),
-			required: accessRequirement{
-				requiredRole:  defaultRole(proto.GetRequiredRole()),
-				requiredLevel: nullInt32ToSQL(proto.GetRequiredLevel()),
-				targetRoles:   proto.GetTargetRoles(),
-			},
-		})
+	items := make([]*v1.LibraryItem, 0, len(refs))
+	for _, ref := range refs {
+		// An item deleted between the two queries is simply skipped
+		if item, ok := loaded[ref.ID]; ok {
+			hideFileLocation(ctx, item)
+			items = append(items, item)
+		}
 	}
 	return items, nil
 }
 
-func sortAggregatedItems(items []aggregatedItem, sortBy, sortOrder string) {
-	orderDesc := !strings.EqualFold(sortOrder, "asc")
-	switch strings.ToLower(sortBy) {
-	case "download_count":
-		sort.SliceStable(items, func(i, j int) bool {
-			if orderDesc {
-				return items[i].download > items[j].download
-			}
-			return items[i].download < items[j].download
-		})
-	case "rating":
-		sort.SliceStable(items, func(i, j int) bool {
-			if orderDesc {
-				return items[i].rating > items[j].rating
-			}
-			return items[i].rating < items[j].rating
-		})
-	case "title":
-		sort.SliceStable(items, func(i, j int) bool {
-			if orderDesc {
-				return strings.ToLower(items[i].title) > strings.ToLower(items[j].title)
-			}
-			return strings.ToLower(items[i].title) < strings.ToLower(items[j].title)
-		})
-	default: // created_at
-		sort.SliceStable(items, func(i, j int) bool {
-			if orderDesc {
-				return items[i].createdAt.After(items[j].createdAt)
-			}
-			return items[i].createdAt.Before(items[j].createdAt)
-		})
+// hideFileLocation clears the stored file of an item unless the caller uploaded it or is
+// an admin. Everyone else gets files from DownloadItem, which signs and watermarks the
+// link and counts it against the daily download limit.
+func hideFileLocation(ctx context.Context, item *v1.LibraryItem) {
+	userRole, _ := userRoleLevelFromContext(ctx)
+	if userRole == "ADMIN" {
+		return
+	}
+	if userID, _ := middleware.GetUserIDFromContext(ctx); userID != "" && userID == item.GetUploadedBy() {
+		return
 	}
+	item.FileUrl = ""
+	item.FileId = ""
 }
 
 func resolveLibraryItemTypes(filter *v1.LibraryFilter) ([]v1.LibraryItemType, error) {
@@ -802,6 +945,26 @@ func containsRole(roles []string, role string) bool {
 	return false
 }
 
+// checkItemOrganisation denies access to items of organisations the caller does not belong to
+func (s *LibraryServiceServer) checkItemOrganisation(ctx context.Context, itemID, userRole string) error {
+	if s.organisations == nil {
+		return nil
+	}
+	ok, err := s.organisations.CanAccessLibraryItem(ctx, libraryActor(ctx, userRole), itemID)
+	if err != nil && !errors.Is(err, organisation.ErrNotFound) {
+		return status.Errorf(codes.Internal, "failed to check item organisation: %v", err)
+	}
+	if err == nil && !ok {
+		return status.Error(codes.PermissionDenied, "access denied")
+	}
+	return nil
+}
+
+func libraryActor(ctx context.Context, userRole string) organisation.Actor {
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	return organisation.Actor{UserID: userID, Role: userRole}
+}
+
 func userRoleLevelFromContext(ctx context.Context) (string, int) {
 	role, err := middleware.GetUserRoleFromContext(ctx)
 	if err != nil || role == "" {
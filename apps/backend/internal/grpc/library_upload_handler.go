@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,9 +15,16 @@ import (
 	"exam-bank-system/apps/backend/internal/validation"
 )
 
+// LibraryBlobPrefix is the key prefix of uploaded library files; DownloadItem presigns
+// file IDs carrying it
+const LibraryBlobPrefix = storage.LibraryPrefix
+
+// LibraryDownloadLinkTTL is the lifetime of presigned library file links
+const LibraryDownloadLinkTTL = 15 * time.Minute
+
 // LibraryUploadHandler handles file upload operations for library items
 type LibraryUploadHandler struct {
-	driveService  *storage.GoogleDriveService
+	blobs         storage.BlobStore
 	validator     *validation.FileValidator
 	logger        *logrus.Logger
 	maxUploadSize int64
@@ -23,13 +32,13 @@ type LibraryUploadHandler struct {
 
 // NewLibraryUploadHandler creates a new upload handler
 func NewLibraryUploadHandler(
-	driveService *storage.GoogleDriveService,
+	blobs storage.BlobStore,
 	validator *validation.FileValidator,
 	logger *logrus.Logger,
 	maxUploadSize int64,
 ) *LibraryUploadHandler {
 	return &LibraryUploadHandler{
-		driveService:  driveService,
+		blobs:         blobs,
 		validator:     validator,
 		logger:        logger,
 		maxUploadSize: maxUploadSize,
@@ -56,9 +65,8 @@ type UploadFileResponse struct {
 
 // HandleUpload processes a file upload
 func (h *LibraryUploadHandler) HandleUpload(ctx context.Context, req *UploadFileRequest) (*UploadFileResponse, error) {
-	// Check if Google Drive service is initialized
-	if !h.driveService.IsInitialized() {
-		return nil, status.Error(codes.Unavailable, "Upload service not available - Google Drive credentials required")
+	if h.blobs == nil {
+		return nil, status.Error(codes.Unavailable, "Upload service not available - storage not configured")
 	}
 
 	h.logger.WithFields(logrus.Fields{
@@ -84,9 +92,8 @@ func (h *LibraryUploadHandler) HandleUpload(ctx context.Context, req *UploadFile
 	case "image":
 		err = h.validator.ValidateImage(req.Filename, req.Size)
 	case "video":
-		// Videos are typically YouTube links, not direct uploads
-		// But we support video file uploads if needed
-		return nil, status.Error(codes.Unimplemented, "Video file uploads not yet supported - use YouTube URL instead")
+		// Large recordings should use the resumable upload endpoint instead
+		err = h.validator.ValidateVideo(req.Filename, req.Size)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Invalid item type")
 	}
@@ -104,23 +111,30 @@ func (h *LibraryUploadHandler) HandleUpload(ctx context.Context, req *UploadFile
 		}).Warn("Filename was sanitized")
 	}
 
-	// Upload to Google Drive
-	uploadResult, err := h.driveService.UploadFile(ctx, sanitizedName, req.ContentType, req.Content)
+	// Upload to the blob store; the key is stored as the item's file ID
+	uploadedAt := time.Now()
+	key := fmt.Sprintf("%s%s/%s/%s-%s", LibraryBlobPrefix, req.ItemType, uploadedAt.UTC().Format("2006/01"), uuid.New().String(), sanitizedName)
+	if _, err := h.blobs.Put(ctx, key, req.Content, req.Size, req.ContentType); err != nil {
+		h.logger.WithError(err).Error("Failed to upload file to storage")
+		return nil, status.Error(codes.Internal, "Failed to upload file")
+	}
+
+	// Files are private; hand out a short-lived link for previews
+	fileURL, err := h.blobs.PresignGet(ctx, key, LibraryDownloadLinkTTL)
 	if err != nil {
-		h.logger.WithError(err).Error("Failed to upload file to Google Drive")
+		h.logger.WithError(err).Error("Failed to sign uploaded file link")
 		return nil, status.Error(codes.Internal, "Failed to upload file")
 	}
 
 	h.logger.WithFields(logrus.Fields{
-		"file_id":   uploadResult.FileID,
-		"file_name": uploadResult.FileName,
+		"file_id":   key,
+		"file_name": sanitizedName,
 	}).Info("File uploaded successfully")
 
 	return &UploadFileResponse{
-		FileID:       uploadResult.FileID,
-		FileURL:      uploadResult.DownloadURL,
-		ThumbnailURL: uploadResult.ThumbnailURL,
-		UploadedAt:   uploadResult.CreatedAt,
+		FileID:     key,
+		FileURL:    fileURL,
+		UploadedAt: uploadedAt,
 	}, nil
 }
 
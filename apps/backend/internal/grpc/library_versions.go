@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	booksvc "exam-bank-system/apps/backend/internal/service/content/book"
+	"exam-bank-system/apps/backend/internal/service/library/versioning"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultFileChangeNote is the changelog of files replaced through UpdateItem without a note
+const defaultFileChangeNote = "Cập nhật tệp"
+
+// versionsManagePermission lets a caller see version file locations and replace or roll
+// back files; seeded for TEACHER and ADMIN, and grantable per item
+const versionsManagePermission = "library.versions.manage"
+
+// libraryItemResource is the resource type of grants scoped to one library item
+const libraryItemResource = "library_item"
+
+// SetVersions keeps replaced files as versions and enables ListItemVersions,
+// ReplaceItemFile and RollbackItemVersion
+func (s *LibraryServiceServer) SetVersions(versions *versioning.Service) {
+	s.versions = versions
+}
+
+// ListItemVersions returns the file history of an item the caller may open. File
+// locations are only shown to callers who may manage the item's versions.
+func (s *LibraryServiceServer) ListItemVersions(ctx context.Context, req *v1.ListItemVersionsRequest) (*v1.ListItemVersionsResponse, error) {
+	if s.versions == nil {
+		return nil, status.Error(codes.Unavailable, "item versioning is not enabled")
+	}
+	itemID := strings.TrimSpace(req.GetId())
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+
+	userRole, userLevel := userRoleLevelFromContext(ctx)
+	access, err := s.itemRepo.GetAccessMetadata(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load item metadata: %v", err)
+	}
+	if !hasAccess(userRole, userLevel, accessRequirement{
+		requiredRole:  defaultRole(access.RequiredRole),
+		requiredLevel: access.RequiredLevel,
+		targetRoles:   access.TargetRoles,
+	}) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+	if err := s.checkItemOrganisation(ctx, itemID, userRole); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.versions.Versions(ctx, itemID)
+	if err != nil {
+		s.logger.WithError(err).Error("list library item versions")
+		return nil, status.Errorf(codes.Internal, "failed to list versions: %v", err)
+	}
+
+	staff := middleware.HasPermission(ctx, versionsManagePermission, libraryItemResource, itemID)
+	resp := &v1.ListItemVersionsResponse{
+		Response: &common.Response{Success: true, Message: "Versions loaded successfully"},
+		Versions: make([]*v1.LibraryItemVersion, 0, len(versions)),
+	}
+	for i, version := range versions {
+		resp.Versions = append(resp.Versions, toProtoItemVersion(version, i == 0, staff))
+	}
+	return resp, nil
+}
+
+// ReplaceItemFile makes a new file the current version of an item, keeping the old one
+// for rollback, and notifies users who downloaded or bookmarked the item
+func (s *LibraryServiceServer) ReplaceItemFile(ctx context.Context, req *v1.ReplaceItemFileRequest) (*v1.ItemVersionResponse, error) {
+	itemID, userID, err := s.authorizeVersionChange(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	file := repository.LibraryItemFile{
+		URL:  strings.TrimSpace(req.GetFileUrl()),
+		ID:   strings.TrimSpace(req.GetFileId()),
+		Type: guessFileType(req.GetFileUrl(), req.GetFileId()),
+	}
+	if size := int64PointerFromWrapper(req.GetFileSize()); size != nil {
+		file.Size = sql.NullInt64{Int64: *size, Valid: true}
+	}
+
+	version, err := s.versions.Replace(ctx, userID, itemID, file, req.GetChangelog())
+	if err != nil {
+		return nil, s.versionError(err, "replace library item file")
+	}
+	return &v1.ItemVersionResponse{
+		Response: &common.Response{Success: true, Message: "File replaced successfully"},
+		Version:  toProtoItemVersion(*version, true, true),
+	}, nil
+}
+
+// RollbackItemVersion serves the file of an older version again, recorded as a new version
+func (s *LibraryServiceServer) RollbackItemVersion(ctx context.Context, req *v1.RollbackItemVersionRequest) (*v1.ItemVersionResponse, error) {
+	itemID, userID, err := s.authorizeVersionChange(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.versions.Rollback(ctx, userID, itemID, int(req.GetVersion()), req.GetNote())
+	if err != nil {
+		return nil, s.versionError(err, "roll back library item version")
+	}
+	return &v1.ItemVersionResponse{
+		Response: &common.Response{Success: true, Message: "Version restored successfully"},
+		Version:  toProtoItemVersion(*version, true, true),
+	}, nil
+}
+
+// authorizeVersionChange requires the versions permission for the item and access to
+// the item's organisation
+func (s *LibraryServiceServer) authorizeVersionChange(ctx context.Context, id string) (string, string, error) {
+	if s.versions == nil {
+		return "", "", status.Error(codes.Unavailable, "item versioning is not enabled")
+	}
+	itemID := strings.TrimSpace(id)
+	if itemID == "" {
+		return "", "", status.Error(codes.InvalidArgument, "item id is required")
+	}
+	if !middleware.HasPermission(ctx, versionsManagePermission, libraryItemResource, itemID) {
+		return "", "", status.Error(codes.PermissionDenied, "permission to change item files required")
+	}
+	userRole, _ := userRoleLevelFromContext(ctx)
+	if err := s.checkItemOrganisation(ctx, itemID, userRole); err != nil {
+		return "", "", err
+	}
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	return itemID, strings.TrimSpace(userID), nil
+}
+
+// versionError maps versioning service errors to gRPC status codes
+func (s *LibraryServiceServer) versionError(err error, op string) error {
+	switch {
+	case errors.Is(err, versioning.ErrNotFound):
+		return status.Error(codes.NotFound, "item or version not found")
+	case errors.Is(err, versioning.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		s.logger.WithError(err).Error(op)
+		return status.Errorf(codes.Internal, "failed to %s: %v", op, err)
+	}
+}
+
+func toProtoItemVersion(v repository.LibraryItemVersion, current, withFile bool) *v1.LibraryItemVersion {
+	out := &v1.LibraryItemVersion{
+		Version:       int32(v.Version),
+		Current:       current,
+		Changelog:     v.Changelog,
+		RestoredFrom:  int32(v.RestoredFrom),
+		FileType:      v.File.Type,
+		CreatedBy:     v.CreatedBy,
+		CreatedByName: v.CreatedByName,
+		CreatedAt:     timestamppb.New(v.CreatedAt),
+		Downloads:     v.Downloads,
+		Downloaders:   v.Downloaders,
+	}
+	if v.File.Size.Valid {
+		out.FileSize = v.File.Size.Int64
+	}
+	if withFile {
+		out.FileUrl = v.File.URL
+		out.FileId = v.File.ID
+	}
+	return out
+}
+
+// takeFileChange removes a file change from an update, keeping the existing file, and
+// returns the new file; nil when the update keeps the file
+func takeFileChange(input *booksvc.UpdateBookInput, existing *entity.Book) *repository.LibraryItemFile {
+	current := repository.LibraryItemFile{URL: stringFromNull(existing.FileURL), ID: stringFromNull(existing.FileID)}
+	file := repository.LibraryItemFile{URL: strings.TrimSpace(input.FileURL), ID: strings.TrimSpace(input.FileID), Type: input.FileType}
+	if file.Empty() || file.Same(current) {
+		return nil
+	}
+	if input.FileSize != nil {
+		file.Size = sql.NullInt64{Int64: *input.FileSize, Valid: true}
+	}
+	if file.Type == "" {
+		file.Type = guessFileType(file.URL, file.ID)
+	}
+
+	input.FileURL = current.URL
+	input.FileID = current.ID
+	input.FileSize = int64PointerFromNull(existing.FileSize)
+	input.FileType = stringFromNull(existing.FileType)
+	return &file
+}
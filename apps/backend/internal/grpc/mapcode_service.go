@@ -5,9 +5,12 @@ import (
 	"fmt"
 
 	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/middleware"
 	mapcode "exam-bank-system/apps/backend/internal/service/content/mapcode"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	pb "exam-bank-system/apps/backend/pkg/proto/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -551,4 +554,321 @@ func (s *MapCodeServiceServer) ExportVersion(ctx context.Context, req *pb.Export
 	}, nil
 }
 
+// GetCoverageReport reports question counts for every node of a MapCode version
+func (s *MapCodeServiceServer) GetCoverageReport(ctx context.Context, req *pb.GetCoverageReportRequest) (*pb.GetCoverageReportResponse, error) {
+	report, err := s.mapCodeMgmt.GetCoverageReport(ctx, req.VersionId, coverageTargetsFromProto(req.Targets))
+	if err != nil {
+		return &pb.GetCoverageReportResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: fmt.Sprintf("Failed to build coverage report: %v", err),
+			},
+		}, nil
+	}
+
+	return &pb.GetCoverageReportResponse{
+		Status: &common.Response{
+			Success: true,
+			Message: "Coverage report generated successfully",
+		},
+		Report: &pb.CoverageReport{
+			VersionId:         report.VersionID,
+			Version:           report.Version,
+			Targets:           coverageTargetsToProto(report.Targets),
+			Grades:            coverageNodesToProto(report.Grades),
+			TotalQuestions:    report.TotalQuestions,
+			UnmappedQuestions: report.UnmappedQuestions,
+			NodeCount:         int32(report.NodeCount),
+			EmptyCount:        int32(report.EmptyCount),
+			ThinCount:         int32(report.ThinCount),
+			GeneratedAt:       timestamppb.New(report.GeneratedAt),
+		},
+	}, nil
+}
+
+// ExportCoverageReport exports the coverage report as Markdown or CSV
+func (s *MapCodeServiceServer) ExportCoverageReport(ctx context.Context, req *pb.ExportCoverageReportRequest) (*pb.ExportCoverageReportResponse, error) {
+	// Default format to markdown if not specified
+	format := req.Format
+	if format == "" {
+		format = "markdown"
+	}
+
+	content, filename, err := s.mapCodeMgmt.ExportCoverageReport(ctx, req.VersionId, format, coverageTargetsFromProto(req.Targets))
+	if err != nil {
+		return &pb.ExportCoverageReportResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: fmt.Sprintf("Export failed: %v", err),
+			},
+		}, nil
+	}
+
+	return &pb.ExportCoverageReportResponse{
+		Status: &common.Response{
+			Success: true,
+			Message: fmt.Sprintf("Coverage report exported successfully as %s", format),
+		},
+		Content:  content,
+		Filename: filename,
+	}, nil
+}
+
+// coverageTargetsFromProto converts request targets; unset targets fall back to defaults
+func coverageTargetsFromProto(targets *pb.CoverageTargets) mapcode.CoverageTargets {
+	if targets == nil {
+		return mapcode.CoverageTargets{}
+	}
+	return mapcode.CoverageTargets{
+		Chapter: targets.Chapter,
+		Lesson:  targets.Lesson,
+		Form:    targets.Form,
+	}
+}
+
+func coverageTargetsToProto(targets mapcode.CoverageTargets) *pb.CoverageTargets {
+	return &pb.CoverageTargets{
+		Chapter: targets.Chapter,
+		Lesson:  targets.Lesson,
+		Form:    targets.Form,
+	}
+}
+
+func coverageNodesToProto(nodes []*mapcode.CoverageNode) []*pb.CoverageNode {
+	result := make([]*pb.CoverageNode, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, &pb.CoverageNode{
+			Depth:        node.Depth,
+			Code:         node.Code,
+			Name:         node.Name,
+			Total:        node.Total,
+			ByType:       node.ByType,
+			ByDifficulty: node.ByDifficulty,
+			Target:       node.Target,
+			Status:       coverageStatusToProto(node.Status),
+			Children:     coverageNodesToProto(node.Children),
+		})
+	}
+	return result
+}
+
+func coverageStatusToProto(status mapcode.CoverageStatus) pb.CoverageStatus {
+	switch status {
+	case mapcode.CoverageStatusOK:
+		return pb.CoverageStatus_COVERAGE_STATUS_OK
+	case mapcode.CoverageStatusThin:
+		return pb.CoverageStatus_COVERAGE_STATUS_THIN
+	case mapcode.CoverageStatusEmpty:
+		return pb.CoverageStatus_COVERAGE_STATUS_EMPTY
+	default:
+		return pb.CoverageStatus_COVERAGE_STATUS_UNSPECIFIED
+	}
+}
+
+// DiffVersions compares two MapCode versions node by node
+func (s *MapCodeServiceServer) DiffVersions(ctx context.Context, req *pb.DiffVersionsRequest) (*pb.DiffVersionsResponse, error) {
+	if req.ToVersionId == "" {
+		return &pb.DiffVersionsResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: "Target version ID is required",
+			},
+		}, nil
+	}
+
+	diff, err := s.mapCodeMgmt.DiffVersions(ctx, req.FromVersionId, req.ToVersionId)
+	if err != nil {
+		return &pb.DiffVersionsResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: fmt.Sprintf("Failed to diff versions: %v", err),
+			},
+		}, nil
+	}
+
+	return &pb.DiffVersionsResponse{
+		Status: &common.Response{
+			Success: true,
+			Message: fmt.Sprintf("%d added, %d removed, %d renamed", len(diff.Added), len(diff.Removed), len(diff.Renamed)),
+		},
+		Diff: versionDiffToProto(diff),
+	}, nil
+}
+
+// PreviewActivation reports the questions affected by activating a version (dry run)
+func (s *MapCodeServiceServer) PreviewActivation(ctx context.Context, req *pb.PreviewActivationRequest) (*pb.PreviewActivationResponse, error) {
+	if req.VersionId == "" {
+		return &pb.PreviewActivationResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: "Version ID is required",
+			},
+		}, nil
+	}
+
+	impact, err := s.mapCodeMgmt.PreviewActivation(ctx, req.VersionId)
+	if err != nil {
+		return &pb.PreviewActivationResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: fmt.Sprintf("Failed to preview activation: %v", err),
+			},
+		}, nil
+	}
+
+	return &pb.PreviewActivationResponse{
+		Status: &common.Response{
+			Success: true,
+			Message: fmt.Sprintf("%d questions would no longer resolve", impact.UnresolvedQuestions),
+		},
+		VersionId:           impact.VersionID,
+		Version:             impact.Version,
+		Diff:                versionDiffToProto(impact.Diff),
+		CodesChecked:        int32(impact.CodesChecked),
+		UnresolvedCodes:     codeImpactsToProto(impact.UnresolvedCodes),
+		UnresolvedQuestions: impact.UnresolvedQuestions,
+		RenamedCodes:        codeImpactsToProto(impact.RenamedCodes),
+		RenamedQuestions:    impact.RenamedQuestions,
+	}, nil
+}
+
+// RemapQuestionCodes moves questions from old to new codes in one transaction
+func (s *MapCodeServiceServer) RemapQuestionCodes(ctx context.Context, req *pb.RemapQuestionCodesRequest) (*pb.RemapQuestionCodesResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	mappings := make([]mapcode.CodeMapping, 0, len(req.Mappings))
+	for _, m := range req.Mappings {
+		mappings = append(mappings, mapcode.CodeMapping{OldCode: m.OldCode, NewCode: m.NewCode})
+	}
+
+	result, err := s.mapCodeMgmt.RemapQuestionCodes(ctx, req.VersionId, mappings, userID, req.Reason, req.DryRun)
+	if err != nil {
+		return &pb.RemapQuestionCodesResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: fmt.Sprintf("Remap failed: %v", err),
+			},
+		}, nil
+	}
+
+	message := fmt.Sprintf("%d questions remapped", result.QuestionsUpdated)
+	if result.DryRun {
+		message = fmt.Sprintf("%d questions would be remapped", result.QuestionsUpdated)
+	}
+
+	return &pb.RemapQuestionCodesResponse{
+		Status: &common.Response{
+			Success: true,
+			Message: message,
+		},
+		BatchId:          result.BatchID,
+		DryRun:           result.DryRun,
+		Remaps:           codeRemapsToProto(result.Remaps),
+		QuestionsUpdated: result.QuestionsUpdated,
+	}, nil
+}
+
+// ListCodeRemaps returns the code remap audit trail
+func (s *MapCodeServiceServer) ListCodeRemaps(ctx context.Context, req *pb.ListCodeRemapsRequest) (*pb.ListCodeRemapsResponse, error) {
+	page := int32(1)
+	limit := int32(20)
+	if req.GetPagination() != nil {
+		if req.GetPagination().GetPage() > 0 {
+			page = req.GetPagination().GetPage()
+		}
+		if req.GetPagination().GetLimit() > 0 && req.GetPagination().GetLimit() <= 100 {
+			limit = req.GetPagination().GetLimit()
+		}
+	}
+
+	remaps, total, err := s.mapCodeMgmt.ListCodeRemaps(ctx, req.Code, int(limit), int((page-1)*limit))
+	if err != nil {
+		return &pb.ListCodeRemapsResponse{
+			Status: &common.Response{
+				Success: false,
+				Message: fmt.Sprintf("Failed to list code remaps: %v", err),
+			},
+		}, nil
+	}
+
+	totalCount := int32(total)
+	return &pb.ListCodeRemapsResponse{
+		Status: &common.Response{
+			Success: true,
+			Message: "Code remaps retrieved successfully",
+		},
+		Remaps: codeRemapsToProto(remaps),
+		Pagination: &common.PaginationResponse{
+			Page:       page,
+			Limit:      limit,
+			TotalCount: totalCount,
+			TotalPages: (totalCount + limit - 1) / limit,
+		},
+	}, nil
+}
+
+func versionDiffToProto(diff *mapcode.VersionDiff) *pb.MapCodeVersionDiff {
+	if diff == nil {
+		return nil
+	}
+	return &pb.MapCodeVersionDiff{
+		FromVersionId: diff.FromVersionID,
+		FromVersion:   diff.FromVersion,
+		ToVersionId:   diff.ToVersionID,
+		ToVersion:     diff.ToVersion,
+		Added:         nodeChangesToProto(diff.Added),
+		Removed:       nodeChangesToProto(diff.Removed),
+		Renamed:       nodeChangesToProto(diff.Renamed),
+	}
+}
+
+func nodeChangesToProto(changes []mapcode.MapCodeNodeChange) []*pb.MapCodeNodeChange {
+	result := make([]*pb.MapCodeNodeChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &pb.MapCodeNodeChange{
+			Depth:   c.Depth,
+			Code:    c.Code,
+			OldName: c.OldName,
+			NewName: c.NewName,
+		})
+	}
+	return result
+}
+
+func codeImpactsToProto(impacts []mapcode.CodeImpact) []*pb.CodeImpact {
+	result := make([]*pb.CodeImpact, 0, len(impacts))
+	for _, i := range impacts {
+		result = append(result, &pb.CodeImpact{
+			Code:          i.Code,
+			QuestionCount: i.QuestionCount,
+			Reason:        i.Reason,
+		})
+	}
+	return result
+}
+
+func codeRemapsToProto(remaps []*entity.MapCodeRemap) []*pb.MapCodeRemap {
+	result := make([]*pb.MapCodeRemap, 0, len(remaps))
+	for _, r := range remaps {
+		remap := &pb.MapCodeRemap{
+			Id:               r.ID,
+			BatchId:          r.BatchID,
+			VersionId:        r.VersionID,
+			OldCode:          r.OldCode,
+			NewCode:          r.NewCode,
+			QuestionsUpdated: r.QuestionsUpdated,
+			PerformedBy:      r.PerformedBy,
+			Reason:           r.Reason,
+		}
+		if !r.CreatedAt.IsZero() {
+			remap.CreatedAt = timestamppb.New(r.CreatedAt)
+		}
+		result = append(result, remap)
+	}
+	return result
+}
+
 // Last verified: 2025-10-31 19:27:30.
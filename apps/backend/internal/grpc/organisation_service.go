@@ -0,0 +1,420 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/organisation"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OrganisationServiceServer implements the OrganisationService
+type OrganisationServiceServer struct {
+	v1.UnimplementedOrganisationServiceServer
+	organisations *organisation.Service
+}
+
+// NewOrganisationServiceServer creates a new organisation service
+func NewOrganisationServiceServer(organisations *organisation.Service) *OrganisationServiceServer {
+	return &OrganisationServiceServer{organisations: organisations}
+}
+
+// CreateOrganisation creates an organisation owned by the caller
+func (s *OrganisationServiceServer) CreateOrganisation(ctx context.Context, req *v1.CreateOrganisationRequest) (*v1.CreateOrganisationResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.organisations.CreateOrganisation(ctx, actor, req.GetName(), req.GetSlug())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.CreateOrganisationResponse{
+		Response:     &common.Response{Success: true, Message: "Organisation created"},
+		Organisation: organisationToProto(org, organisation.RoleOwner),
+	}, nil
+}
+
+// ListOrganisations lists the caller's organisations
+func (s *OrganisationServiceServer) ListOrganisations(ctx context.Context, req *v1.ListOrganisationsRequest) (*v1.ListOrganisationsResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := s.organisations.ListOrganisations(ctx, actor)
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	result := make([]*v1.Organisation, 0, len(orgs))
+	for _, org := range orgs {
+		result = append(result, organisationToProto(org, ""))
+	}
+	return &v1.ListOrganisationsResponse{
+		Response:      &common.Response{Success: true, Message: "Organisations retrieved"},
+		Organisations: result,
+	}, nil
+}
+
+// GetOrganisation returns an organisation the caller belongs to
+func (s *OrganisationServiceServer) GetOrganisation(ctx context.Context, req *v1.GetOrganisationRequest) (*v1.GetOrganisationResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, role, err := s.organisations.GetOrganisation(ctx, actor, req.GetId())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.GetOrganisationResponse{
+		Response:     &common.Response{Success: true, Message: "Organisation retrieved"},
+		Organisation: organisationToProto(org, role),
+	}, nil
+}
+
+// ListOrganisationMembers lists the members of an organisation
+func (s *OrganisationServiceServer) ListOrganisationMembers(ctx context.Context, req *v1.ListOrganisationMembersRequest) (*v1.ListOrganisationMembersResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.organisations.ListMembers(ctx, actor, req.GetOrganisationId())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	result := make([]*v1.OrganisationMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, &v1.OrganisationMember{
+			UserId:    m.UserID,
+			Email:     m.Email,
+			FirstName: m.FirstName,
+			LastName:  m.LastName,
+			Role:      m.Role,
+			JoinedAt:  timestamppb.New(m.JoinedAt),
+		})
+	}
+	return &v1.ListOrganisationMembersResponse{
+		Response: &common.Response{Success: true, Message: "Members retrieved"},
+		Members:  result,
+	}, nil
+}
+
+// SetOrganisationMember adds a member or changes their role
+func (s *OrganisationServiceServer) SetOrganisationMember(ctx context.Context, req *v1.SetOrganisationMemberRequest) (*v1.SetOrganisationMemberResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.organisations.SetMember(ctx, actor, req.GetOrganisationId(), req.GetUserId(), req.GetRole()); err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.SetOrganisationMemberResponse{
+		Response: &common.Response{Success: true, Message: "Member updated"},
+	}, nil
+}
+
+// RemoveOrganisationMember removes a member from an organisation and its classes
+func (s *OrganisationServiceServer) RemoveOrganisationMember(ctx context.Context, req *v1.RemoveOrganisationMemberRequest) (*v1.RemoveOrganisationMemberResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.organisations.RemoveMember(ctx, actor, req.GetOrganisationId(), req.GetUserId()); err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.RemoveOrganisationMemberResponse{
+		Response: &common.Response{Success: true, Message: "Member removed"},
+	}, nil
+}
+
+// AssignToOrganisation moves an exam or library item into an organisation or makes it public
+func (s *OrganisationServiceServer) AssignToOrganisation(ctx context.Context, req *v1.AssignToOrganisationRequest) (*v1.AssignToOrganisationResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetResourceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource_id is required")
+	}
+
+	switch req.GetResourceType() {
+	case "exam":
+		err = s.organisations.AssignExam(ctx, actor, req.GetResourceId(), req.GetOrganisationId())
+	case "library_item":
+		err = s.organisations.AssignLibraryItem(ctx, actor, req.GetResourceId(), req.GetOrganisationId())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "resource_type must be exam or library_item")
+	}
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.AssignToOrganisationResponse{
+		Response: &common.Response{Success: true, Message: "Resource organisation updated"},
+	}, nil
+}
+
+// CreateClass creates a class owned by the caller
+func (s *OrganisationServiceServer) CreateClass(ctx context.Context, req *v1.CreateClassRequest) (*v1.CreateClassResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := s.organisations.CreateClass(ctx, actor, organisation.ClassInput{
+		OrganisationID: req.GetOrganisationId(),
+		Name:           req.GetName(),
+		Subject:        req.GetSubject(),
+		Grade:          int(req.GetGrade()),
+		SchoolYear:     req.GetSchoolYear(),
+	})
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.CreateClassResponse{
+		Response:    &common.Response{Success: true, Message: "Class created"},
+		SchoolClass: classToProto(class),
+	}, nil
+}
+
+// ListClasses lists the caller's classes, or every class of an organisation for its staff
+func (s *OrganisationServiceServer) ListClasses(ctx context.Context, req *v1.ListClassesRequest) (*v1.ListClassesResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	classes, err := s.organisations.ListClasses(ctx, actor, req.GetOrganisationId())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	result := make([]*v1.SchoolClass, 0, len(classes))
+	for _, class := range classes {
+		result = append(result, classToProto(class))
+	}
+	return &v1.ListClassesResponse{
+		Response: &common.Response{Success: true, Message: "Classes retrieved"},
+		Classes:  result,
+	}, nil
+}
+
+// GetClass returns a class the caller belongs to or manages
+func (s *OrganisationServiceServer) GetClass(ctx context.Context, req *v1.GetClassRequest) (*v1.GetClassResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := s.organisations.GetClass(ctx, actor, req.GetId())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.GetClassResponse{
+		Response:    &common.Response{Success: true, Message: "Class retrieved"},
+		SchoolClass: classToProto(class),
+	}, nil
+}
+
+// ResetClassJoinCode issues a new join code or disables joining by code
+func (s *OrganisationServiceServer) ResetClassJoinCode(ctx context.Context, req *v1.ResetClassJoinCodeRequest) (*v1.ResetClassJoinCodeResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := s.organisations.ResetJoinCode(ctx, actor, req.GetClassId(), !req.GetDisable())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.ResetClassJoinCodeResponse{
+		Response:    &common.Response{Success: true, Message: "Join code updated"},
+		SchoolClass: classToProto(class),
+	}, nil
+}
+
+// JoinClass adds the caller to a class as a student
+func (s *OrganisationServiceServer) JoinClass(ctx context.Context, req *v1.JoinClassRequest) (*v1.JoinClassResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := s.organisations.JoinClass(ctx, actor, req.GetJoinCode())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.JoinClassResponse{
+		Response:    &common.Response{Success: true, Message: "Joined class"},
+		SchoolClass: classToProto(class),
+	}, nil
+}
+
+// GetClassRoster returns the members of a class
+func (s *OrganisationServiceServer) GetClassRoster(ctx context.Context, req *v1.GetClassRosterRequest) (*v1.GetClassRosterResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.organisations.GetRoster(ctx, actor, req.GetClassId())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	result := make([]*v1.ClassMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, &v1.ClassMember{
+			UserId:    m.UserID,
+			Email:     m.Email,
+			FirstName: m.FirstName,
+			LastName:  m.LastName,
+			Role:      m.Role,
+			AddedBy:   m.AddedBy,
+			JoinedAt:  timestamppb.New(m.JoinedAt),
+		})
+	}
+	return &v1.GetClassRosterResponse{
+		Response: &common.Response{Success: true, Message: "Roster retrieved"},
+		Members:  result,
+	}, nil
+}
+
+// AddClassMember adds a student or co-teacher to a class
+func (s *OrganisationServiceServer) AddClassMember(ctx context.Context, req *v1.AddClassMemberRequest) (*v1.AddClassMemberResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.organisations.AddClassMember(ctx, actor, req.GetClassId(), req.GetUserId(), req.GetRole()); err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.AddClassMemberResponse{
+		Response: &common.Response{Success: true, Message: "Class member added"},
+	}, nil
+}
+
+// RemoveClassMember removes a user from a class
+func (s *OrganisationServiceServer) RemoveClassMember(ctx context.Context, req *v1.RemoveClassMemberRequest) (*v1.RemoveClassMemberResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.organisations.RemoveClassMember(ctx, actor, req.GetClassId(), req.GetUserId()); err != nil {
+		return nil, organisationError(err)
+	}
+
+	return &v1.RemoveClassMemberResponse{
+		Response: &common.Response{Success: true, Message: "Class member removed"},
+	}, nil
+}
+
+// ImportClassRoster adds the students listed in a CSV file to a class
+func (s *OrganisationServiceServer) ImportClassRoster(ctx context.Context, req *v1.ImportClassRosterRequest) (*v1.ImportClassRosterResponse, error) {
+	actor, err := organisationActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetCsvData()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "csv_data is required")
+	}
+
+	result, err := s.organisations.ImportRoster(ctx, actor, req.GetClassId(), req.GetCsvData())
+	if err != nil {
+		return nil, organisationError(err)
+	}
+
+	rowErrors := make([]*v1.RosterRowError, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		rowErrors = append(rowErrors, &v1.RosterRowError{Line: int32(e.Line), Email: e.Email, Message: e.Message})
+	}
+	return &v1.ImportClassRosterResponse{
+		Response:      &common.Response{Success: true, Message: "Roster imported"},
+		Added:         int32(result.Added),
+		AlreadyMember: int32(result.AlreadyMember),
+		Errors:        rowErrors,
+	}, nil
+}
+
+func organisationActorFromContext(ctx context.Context) (organisation.Actor, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return organisation.Actor{}, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+	return organisationActor(ctx, userID), nil
+}
+
+// organisationActor builds the actor for an already authenticated user
+func organisationActor(ctx context.Context, userID string) organisation.Actor {
+	role, _ := middleware.GetUserRoleFromContext(ctx)
+	return organisation.Actor{UserID: userID, Role: role}
+}
+
+// organisationError maps organisation service errors to gRPC status codes
+func organisationError(err error) error {
+	switch {
+	case errors.Is(err, organisation.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, organisation.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, organisation.ErrSlugTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, organisation.ErrInvalidInput), errors.Is(err, organisation.ErrInvalidJoinCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "organisation operation failed: %v", err)
+	}
+}
+
+func organisationToProto(org *repository.Organisation, role string) *v1.Organisation {
+	return &v1.Organisation{
+		Id:        org.ID,
+		Name:      org.Name,
+		Slug:      org.Slug,
+		CreatedBy: org.CreatedBy,
+		MyRole:    role,
+		CreatedAt: timestamppb.New(org.CreatedAt),
+		UpdatedAt: timestamppb.New(org.UpdatedAt),
+	}
+}
+
+func classToProto(class *repository.Class) *v1.SchoolClass {
+	return &v1.SchoolClass{
+		Id:              class.ID,
+		OrganisationId:  class.OrganisationID,
+		Name:            class.Name,
+		Subject:         class.Subject,
+		Grade:           int32(class.Grade),
+		SchoolYear:      class.SchoolYear,
+		OwnerId:         class.OwnerID,
+		JoinCode:        class.JoinCode,
+		JoinCodeEnabled: class.JoinCodeEnabled,
+		StudentCount:    int32(class.StudentCount),
+		CreatedAt:       timestamppb.New(class.CreatedAt),
+	}
+}
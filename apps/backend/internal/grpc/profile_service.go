@@ -2,10 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"exam-bank-system/apps/backend/internal/middleware"
 	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/user/privacy"
 	"exam-bank-system/apps/backend/internal/service/user/session"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
@@ -21,6 +23,7 @@ type ProfileServiceServer struct {
 	userRepo       repository.IUserRepository
 	sessionService *session.SessionService
 	preferenceRepo repository.UserPreferenceRepository
+	privacy        *privacy.Service
 }
 
 // NewProfileServiceServer creates a new profile service
@@ -36,6 +39,11 @@ func NewProfileServiceServer(
 	}
 }
 
+// SetPrivacyService enables personal data exports and account deletion
+func (s *ProfileServiceServer) SetPrivacyService(privacyService *privacy.Service) {
+	s.privacy = privacyService
+}
+
 // GetProfile gets the user profile
 func (s *ProfileServiceServer) GetProfile(ctx context.Context, req *v1.GetProfileRequest) (*v1.GetProfileResponse, error) {
 	// Get user ID from context or use provided ID
@@ -386,6 +394,177 @@ func (s *ProfileServiceServer) UpdatePreferences(ctx context.Context, req *v1.Up
 	}, nil
 }
 
+// RequestDataExport queues a zip export of the caller's personal data
+func (s *ProfileServiceServer) RequestDataExport(ctx context.Context, req *v1.RequestDataExportRequest) (*v1.RequestDataExportResponse, error) {
+	userID, err := s.privacyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.privacy.RequestExport(ctx, userID)
+	if err != nil {
+		return nil, privacyError(err)
+	}
+
+	return &v1.RequestDataExportResponse{
+		Response: &common.Response{Success: true, Message: "Data export requested, you will be notified when it is ready"},
+		Export:   dataExportToProto(job),
+	}, nil
+}
+
+// ListDataExports lists the caller's recent data exports
+func (s *ProfileServiceServer) ListDataExports(ctx context.Context, req *v1.ListDataExportsRequest) (*v1.ListDataExportsResponse, error) {
+	userID, err := s.privacyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.privacy.ListExports(ctx, userID)
+	if err != nil {
+		return nil, privacyError(err)
+	}
+
+	exports := make([]*v1.DataExport, 0, len(jobs))
+	for _, job := range jobs {
+		exports = append(exports, dataExportToProto(job))
+	}
+	return &v1.ListDataExportsResponse{
+		Response: &common.Response{Success: true},
+		Exports:  exports,
+	}, nil
+}
+
+// DownloadDataExport returns the archive of a finished data export
+func (s *ProfileServiceServer) DownloadDataExport(ctx context.Context, req *v1.DownloadDataExportRequest) (*v1.DownloadDataExportResponse, error) {
+	userID, err := s.privacyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExportId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "export_id is required")
+	}
+
+	archive, filename, err := s.privacy.DownloadExport(ctx, userID, req.GetExportId())
+	if err != nil {
+		return nil, privacyError(err)
+	}
+
+	return &v1.DownloadDataExportResponse{
+		Response: &common.Response{Success: true},
+		Archive:  archive,
+		Filename: filename,
+	}, nil
+}
+
+// DeleteAccount schedules the caller's account for erasure after the grace period
+func (s *ProfileServiceServer) DeleteAccount(ctx context.Context, req *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error) {
+	userID, err := s.privacyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := s.privacy.RequestDeletion(ctx, userID, req.GetConfirmEmail(), req.GetReason())
+	if err != nil {
+		return nil, privacyError(err)
+	}
+
+	return &v1.DeleteAccountResponse{
+		Response: &common.Response{Success: true, Message: "Account scheduled for deletion, sign in again before the scheduled date to cancel"},
+		Deletion: accountDeletionToProto(deletion),
+	}, nil
+}
+
+// CancelAccountDeletion cancels the caller's pending account deletion
+func (s *ProfileServiceServer) CancelAccountDeletion(ctx context.Context, req *v1.CancelAccountDeletionRequest) (*v1.CancelAccountDeletionResponse, error) {
+	userID, err := s.privacyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.privacy.CancelDeletion(ctx, userID); err != nil {
+		return nil, privacyError(err)
+	}
+
+	return &v1.CancelAccountDeletionResponse{
+		Response: &common.Response{Success: true, Message: "Account deletion cancelled"},
+	}, nil
+}
+
+// GetAccountDeletionStatus reports whether the caller's account is scheduled for deletion
+func (s *ProfileServiceServer) GetAccountDeletionStatus(ctx context.Context, req *v1.GetAccountDeletionStatusRequest) (*v1.GetAccountDeletionStatusResponse, error) {
+	userID, err := s.privacyCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion, err := s.privacy.DeletionStatus(ctx, userID)
+	if errors.Is(err, privacy.ErrNotFound) {
+		return &v1.GetAccountDeletionStatusResponse{Response: &common.Response{Success: true}}, nil
+	}
+	if err != nil {
+		return nil, privacyError(err)
+	}
+
+	return &v1.GetAccountDeletionStatusResponse{
+		Response: &common.Response{Success: true},
+		Pending:  true,
+		Deletion: accountDeletionToProto(deletion),
+	}, nil
+}
+
+func (s *ProfileServiceServer) privacyCaller(ctx context.Context) (string, error) {
+	if s.privacy == nil {
+		return "", status.Error(codes.Unimplemented, "privacy requests are not enabled")
+	}
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+	return userID, nil
+}
+
+// privacyError maps privacy service errors to gRPC status codes
+func privacyError(err error) error {
+	switch {
+	case errors.Is(err, privacy.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, privacy.ErrExportInProgress), errors.Is(err, privacy.ErrDeletionPending):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, privacy.ErrExportNotReady):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, privacy.ErrConfirmationInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "privacy request failed: %v", err)
+	}
+}
+
+func dataExportToProto(job *repository.DataExportJob) *v1.DataExport {
+	proto := &v1.DataExport{
+		Id:          job.ID,
+		Status:      job.Status,
+		FileSize:    job.FileSize,
+		Error:       job.Error,
+		RequestedAt: timestamppb.New(job.RequestedAt),
+	}
+	if job.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*job.CompletedAt)
+	}
+	if job.ExpiresAt != nil {
+		proto.ExpiresAt = timestamppb.New(*job.ExpiresAt)
+	}
+	return proto
+}
+
+func accountDeletionToProto(deletion *repository.AccountDeletionRequest) *v1.AccountDeletion {
+	return &v1.AccountDeletion{
+		Id:           deletion.ID,
+		Status:       deletion.Status,
+		RequestedAt:  timestamppb.New(deletion.RequestedAt),
+		ScheduledFor: timestamppb.New(deletion.ScheduledFor),
+	}
+}
+
 // getSessionTokenFromContext extracts session token from context
 func getSessionTokenFromContext(ctx context.Context) string {
 	// TODO: Implement session token extraction from context
@@ -2,19 +2,25 @@ package grpc
 
 import (
 	"context"
+	"log"
 
 	"exam-bank-system/apps/backend/internal/middleware"
 	"exam-bank-system/apps/backend/internal/service/question"
+	"exam-bank-system/apps/backend/internal/service/searchhistory"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // QuestionFilterServiceServer implements the QuestionFilterService gRPC server
 type QuestionFilterServiceServer struct {
 	v1.UnimplementedQuestionFilterServiceServer
 	questionFilterService *question.QuestionFilterService
+
+	// Optional search history
+	searchHistory *searchhistory.Service
 }
 
 // NewQuestionFilterServiceServer creates a new QuestionFilterServiceServer
@@ -24,6 +30,11 @@ func NewQuestionFilterServiceServer(questionFilterService *question.QuestionFilt
 	}
 }
 
+// SetSearchHistory logs question searches for trending and zero-result reports
+func (s *QuestionFilterServiceServer) SetSearchHistory(history *searchhistory.Service) {
+	s.searchHistory = history
+}
+
 // ========================================
 // QUESTION FILTER ENDPOINTS
 // ========================================
@@ -69,6 +80,16 @@ func (s *QuestionFilterServiceServer) SearchQuestions(ctx context.Context, req *
 		return nil, status.Errorf(codes.Internal, "failed to search questions: %v", err)
 	}
 
+	// Searches are logged once, not for every page fetched
+	if req.GetPagination().GetPage() <= 1 {
+		filters := proto.Clone(req).(*v1.SearchQuestionsRequest)
+		filters.Query = ""
+		filters.Pagination = nil
+		response.SearchId = logSearch(ctx, s.searchHistory, searchhistory.ScopeQuestion, req.GetQuery(), filters, int(response.GetTotalCount()), func(err error) {
+			log.Printf("[WARN] [SearchHistory] Failed to log question search: %v", err)
+		})
+	}
+
 	return response, nil
 }
 
@@ -0,0 +1,335 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/question"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QuestionReportServiceServer implements the QuestionReportService
+type QuestionReportServiceServer struct {
+	v1.UnimplementedQuestionReportServiceServer
+	reportService *question.ReportService
+}
+
+// NewQuestionReportServiceServer creates a new question report service
+func NewQuestionReportServiceServer(reportService *question.ReportService) *QuestionReportServiceServer {
+	return &QuestionReportServiceServer{
+		reportService: reportService,
+	}
+}
+
+// ReportQuestionError files a student error report
+func (s *QuestionReportServiceServer) ReportQuestionError(ctx context.Context, req *v1.ReportQuestionErrorRequest) (*v1.ReportQuestionErrorResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	report, err := s.reportService.SubmitReport(ctx, userID, question.ReportInput{
+		QuestionID: req.GetQuestionId(),
+		Category:   reportCategoryFromProto(req.GetCategory()),
+		Content:    req.GetContent(),
+		AttemptID:  req.GetAttemptId(),
+	})
+	if err != nil {
+		return nil, reportError(err)
+	}
+
+	return &v1.ReportQuestionErrorResponse{
+		Response: &common.Response{Success: true, Message: "Thank you, your report has been submitted"},
+		Report:   errorReportToProto(report),
+	}, nil
+}
+
+// ListReportQueue returns questions with active reports for triage
+func (s *QuestionReportServiceServer) ListReportQueue(ctx context.Context, req *v1.ListReportQueueRequest) (*v1.ListReportQueueResponse, error) {
+	page := int32(1)
+	limit := int32(20)
+	if req.GetPagination() != nil {
+		if req.GetPagination().GetPage() > 0 {
+			page = req.GetPagination().GetPage()
+		}
+		if req.GetPagination().GetLimit() > 0 && req.GetPagination().GetLimit() <= 100 {
+			limit = req.GetPagination().GetLimit()
+		}
+	}
+
+	filter := repository.ReportQueueFilter{
+		Status:   reportStatusFromProto(req.GetStatus()),
+		Category: reportCategoryFromProto(req.GetCategory()),
+	}
+	items, total, err := s.reportService.ListQueue(ctx, filter, int(limit), int((page-1)*limit))
+	if err != nil {
+		return nil, reportError(err)
+	}
+
+	protoItems := make([]*v1.ReportQueueItem, 0, len(items))
+	for _, item := range items {
+		pi := &v1.ReportQueueItem{
+			QuestionId:      item.QuestionID,
+			QuestionCodeId:  item.QuestionCodeID,
+			ContentPreview:  item.ContentPreview,
+			Status:          reportStatusToProto(item.Status),
+			ReportCount:     int32(item.ReportCount),
+			CredibleCount:   int32(item.CredibleCount),
+			IsSuspended:     item.IsSuspended,
+			FirstReportedAt: timestamppb.New(item.FirstReportedAt),
+			LastReportedAt:  timestamppb.New(item.LastReportedAt),
+		}
+		for _, cat := range []entity.ReportCategory{
+			entity.ReportCategoryWrongKey,
+			entity.ReportCategoryTypo,
+			entity.ReportCategoryAmbiguous,
+			entity.ReportCategoryBrokenImage,
+			entity.ReportCategoryOther,
+		} {
+			if n := item.CategoryCounts[cat]; n > 0 {
+				pi.Categories = append(pi.Categories, &v1.ErrorReportCategoryCount{
+					Category: reportCategoryToProto(cat),
+					Count:    int32(n),
+				})
+			}
+		}
+		protoItems = append(protoItems, pi)
+	}
+
+	totalCount := int32(total)
+	return &v1.ListReportQueueResponse{
+		Response: &common.Response{Success: true, Message: "Report queue retrieved successfully"},
+		Items:    protoItems,
+		Pagination: &common.PaginationResponse{
+			Page:       page,
+			Limit:      limit,
+			TotalCount: totalCount,
+			TotalPages: (totalCount + limit - 1) / limit,
+		},
+	}, nil
+}
+
+// ListQuestionErrorReports returns the reports filed against a question
+func (s *QuestionReportServiceServer) ListQuestionErrorReports(ctx context.Context, req *v1.ListQuestionErrorReportsRequest) (*v1.ListQuestionErrorReportsResponse, error) {
+	reports, err := s.reportService.ListReports(ctx, req.GetQuestionId(), req.GetActiveOnly())
+	if err != nil {
+		return nil, reportError(err)
+	}
+
+	protoReports := make([]*v1.QuestionErrorReport, 0, len(reports))
+	for _, r := range reports {
+		protoReports = append(protoReports, errorReportToProto(r))
+	}
+
+	return &v1.ListQuestionErrorReportsResponse{
+		Response: &common.Response{Success: true, Message: "Reports retrieved successfully"},
+		Reports:  protoReports,
+	}, nil
+}
+
+// AcknowledgeErrorReports marks the open reports of a question as being worked on
+func (s *QuestionReportServiceServer) AcknowledgeErrorReports(ctx context.Context, req *v1.AcknowledgeErrorReportsRequest) (*v1.AcknowledgeErrorReportsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	n, err := s.reportService.Acknowledge(ctx, userID, req.GetQuestionId())
+	if err != nil {
+		return nil, reportError(err)
+	}
+
+	return &v1.AcknowledgeErrorReportsResponse{
+		Response:     &common.Response{Success: true, Message: "Reports acknowledged"},
+		UpdatedCount: int32(n),
+	}, nil
+}
+
+// ResolveErrorReports resolves the reports and re-grades attempts when the answer key was fixed
+func (s *QuestionReportServiceServer) ResolveErrorReports(ctx context.Context, req *v1.ResolveErrorReportsRequest) (*v1.ResolveErrorReportsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	resolution, err := s.reportService.Resolve(ctx, userID, req.GetQuestionId(), req.GetNote(), req.GetAnswerKeyFixed())
+	if err != nil {
+		return nil, reportError(err)
+	}
+
+	message := "Reports resolved"
+	if len(resolution.FailedAttempts) > 0 {
+		message = "Reports resolved; some attempts could not be re-graded"
+	}
+	return &v1.ResolveErrorReportsResponse{
+		Response:         &common.Response{Success: true, Message: message},
+		ResolvedCount:    int32(resolution.ResolvedReports),
+		RegradedAttempts: int32(resolution.RegradedAttempts),
+		FailedAttemptIds: resolution.FailedAttempts,
+	}, nil
+}
+
+// DismissErrorReports closes the reports without changes
+func (s *QuestionReportServiceServer) DismissErrorReports(ctx context.Context, req *v1.DismissErrorReportsRequest) (*v1.DismissErrorReportsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	n, err := s.reportService.Dismiss(ctx, userID, req.GetQuestionId(), req.GetNote())
+	if err != nil {
+		return nil, reportError(err)
+	}
+
+	return &v1.DismissErrorReportsResponse{
+		Response:       &common.Response{Success: true, Message: "Reports dismissed"},
+		DismissedCount: int32(n),
+	}, nil
+}
+
+// SuspendQuestion manually suspends a question from new exams
+func (s *QuestionReportServiceServer) SuspendQuestion(ctx context.Context, req *v1.SuspendQuestionRequest) (*v1.SuspendQuestionResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+
+	if err := s.reportService.Suspend(ctx, userID, req.GetQuestionId(), req.GetReason()); err != nil {
+		return nil, reportError(err)
+	}
+
+	return &v1.SuspendQuestionResponse{
+		Response: &common.Response{Success: true, Message: "Question suspended from new exams"},
+	}, nil
+}
+
+// LiftQuestionSuspension lifts a question suspension
+func (s *QuestionReportServiceServer) LiftQuestionSuspension(ctx context.Context, req *v1.LiftQuestionSuspensionRequest) (*v1.LiftQuestionSuspensionResponse, error) {
+	if req.GetQuestionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "question ID is required")
+	}
+
+	if err := s.reportService.LiftSuspension(ctx, req.GetQuestionId()); err != nil {
+		return nil, reportError(err)
+	}
+
+	return &v1.LiftQuestionSuspensionResponse{
+		Response: &common.Response{Success: true, Message: "Question suspension lifted"},
+	}, nil
+}
+
+// reportError maps report triage errors to gRPC status codes
+func reportError(err error) error {
+	switch {
+	case errors.Is(err, question.ErrReportInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, question.ErrReportDuplicate):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, question.ErrReportNothingOpen):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "report operation failed: %v", err)
+	}
+}
+
+func reportCategoryFromProto(c v1.ErrorReportCategory) entity.ReportCategory {
+	switch c {
+	case v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_WRONG_KEY:
+		return entity.ReportCategoryWrongKey
+	case v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_TYPO:
+		return entity.ReportCategoryTypo
+	case v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_AMBIGUOUS:
+		return entity.ReportCategoryAmbiguous
+	case v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_BROKEN_IMAGE:
+		return entity.ReportCategoryBrokenImage
+	case v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_OTHER:
+		return entity.ReportCategoryOther
+	default:
+		return ""
+	}
+}
+
+func reportCategoryToProto(c entity.ReportCategory) v1.ErrorReportCategory {
+	switch c {
+	case entity.ReportCategoryWrongKey:
+		return v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_WRONG_KEY
+	case entity.ReportCategoryTypo:
+		return v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_TYPO
+	case entity.ReportCategoryAmbiguous:
+		return v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_AMBIGUOUS
+	case entity.ReportCategoryBrokenImage:
+		return v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_BROKEN_IMAGE
+	case entity.ReportCategoryOther:
+		return v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_OTHER
+	default:
+		return v1.ErrorReportCategory_ERROR_REPORT_CATEGORY_UNSPECIFIED
+	}
+}
+
+func reportStatusFromProto(s v1.ErrorReportStatus) entity.ReportStatus {
+	switch s {
+	case v1.ErrorReportStatus_ERROR_REPORT_STATUS_OPEN:
+		return entity.ReportStatusOpen
+	case v1.ErrorReportStatus_ERROR_REPORT_STATUS_ACKNOWLEDGED:
+		return entity.ReportStatusAcknowledged
+	case v1.ErrorReportStatus_ERROR_REPORT_STATUS_RESOLVED:
+		return entity.ReportStatusResolved
+	case v1.ErrorReportStatus_ERROR_REPORT_STATUS_DISMISSED:
+		return entity.ReportStatusDismissed
+	default:
+		return ""
+	}
+}
+
+func reportStatusToProto(s entity.ReportStatus) v1.ErrorReportStatus {
+	switch s {
+	case entity.ReportStatusOpen:
+		return v1.ErrorReportStatus_ERROR_REPORT_STATUS_OPEN
+	case entity.ReportStatusAcknowledged:
+		return v1.ErrorReportStatus_ERROR_REPORT_STATUS_ACKNOWLEDGED
+	case entity.ReportStatusResolved:
+		return v1.ErrorReportStatus_ERROR_REPORT_STATUS_RESOLVED
+	case entity.ReportStatusDismissed:
+		return v1.ErrorReportStatus_ERROR_REPORT_STATUS_DISMISSED
+	default:
+		return v1.ErrorReportStatus_ERROR_REPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func errorReportToProto(r *entity.QuestionErrorReport) *v1.QuestionErrorReport {
+	pb := &v1.QuestionErrorReport{
+		Id:         r.ID,
+		QuestionId: r.QuestionID,
+		Category:   reportCategoryToProto(r.Category),
+		Status:     reportStatusToProto(r.Status),
+		IsCredible: r.IsCredible,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+	}
+	if r.UserID != nil {
+		pb.UserId = *r.UserID
+	}
+	if r.Content != nil {
+		pb.Content = *r.Content
+	}
+	if r.AttemptID != nil {
+		pb.AttemptId = *r.AttemptID
+	}
+	if r.ResolvedBy != nil {
+		pb.ResolvedBy = *r.ResolvedBy
+	}
+	if r.ResolutionNote != nil {
+		pb.ResolutionNote = *r.ResolutionNote
+	}
+	if r.ResolvedAt != nil {
+		pb.ResolvedAt = timestamppb.New(*r.ResolvedAt)
+	}
+	return pb
+}
@@ -0,0 +1,444 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/service/question"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QuestionReviewServiceServer implements the QuestionReviewService
+type QuestionReviewServiceServer struct {
+	v1.UnimplementedQuestionReviewServiceServer
+	reviewService *question.ReviewService
+}
+
+// NewQuestionReviewServiceServer creates a new question review service
+func NewQuestionReviewServiceServer(reviewService *question.ReviewService) *QuestionReviewServiceServer {
+	return &QuestionReviewServiceServer{
+		reviewService: reviewService,
+	}
+}
+
+// SubmitQuestionForReview opens (or reopens) a review round for a question
+func (s *QuestionReviewServiceServer) SubmitQuestionForReview(ctx context.Context, req *v1.SubmitQuestionForReviewRequest) (*v1.SubmitQuestionForReviewResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.SubmitForReview(ctx, actor, req.GetQuestionId())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.SubmitQuestionForReviewResponse{
+		Response: &common.Response{Success: true, Message: "Question submitted for review"},
+		Review:   reviewToProto(review),
+	}, nil
+}
+
+// AssignReviewer assigns a reviewer to an open review
+func (s *QuestionReviewServiceServer) AssignReviewer(ctx context.Context, req *v1.AssignReviewerRequest) (*v1.AssignReviewerResponse, error) {
+	reviewID, err := parseReviewUUID(req.GetReviewId(), "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.AssignReviewer(ctx, reviewID, req.GetReviewerId())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.AssignReviewerResponse{
+		Response: &common.Response{Success: true, Message: "Reviewer assigned"},
+		Review:   reviewToProto(review),
+	}, nil
+}
+
+// SubmitReviewDecision approves, rejects or requests changes on a review
+func (s *QuestionReviewServiceServer) SubmitReviewDecision(ctx context.Context, req *v1.SubmitReviewDecisionRequest) (*v1.SubmitReviewDecisionResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reviewID, err := parseReviewUUID(req.GetReviewId(), "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.SubmitDecision(ctx, actor, reviewID, reviewStatusFromProto(req.GetDecision()), req.GetNote())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.SubmitReviewDecisionResponse{
+		Response: &common.Response{Success: true, Message: "Review decision recorded"},
+		Review:   reviewToProto(review),
+	}, nil
+}
+
+// GetReview returns a single review round
+func (s *QuestionReviewServiceServer) GetReview(ctx context.Context, req *v1.GetReviewRequest) (*v1.GetReviewResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reviewID, err := parseReviewUUID(req.GetReviewId(), "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.GetReview(ctx, actor, reviewID)
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.GetReviewResponse{
+		Response: &common.Response{Success: true, Message: "Review retrieved successfully"},
+		Review:   reviewToProto(review),
+	}, nil
+}
+
+// ListQuestionReviews returns the review history of a question
+func (s *QuestionReviewServiceServer) ListQuestionReviews(ctx context.Context, req *v1.ListQuestionReviewsRequest) (*v1.ListQuestionReviewsResponse, error) {
+	reviews, err := s.reviewService.ListQuestionReviews(ctx, req.GetQuestionId())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	protoReviews := make([]*v1.QuestionReview, 0, len(reviews))
+	for _, r := range reviews {
+		protoReviews = append(protoReviews, reviewToProto(r))
+	}
+
+	return &v1.ListQuestionReviewsResponse{
+		Response: &common.Response{Success: true, Message: "Reviews retrieved successfully"},
+		Reviews:  protoReviews,
+	}, nil
+}
+
+// AddReviewComment adds an inline comment or reply
+func (s *QuestionReviewServiceServer) AddReviewComment(ctx context.Context, req *v1.AddReviewCommentRequest) (*v1.AddReviewCommentResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reviewID, err := parseReviewUUID(req.GetReviewId(), "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	input := question.CommentInput{Body: req.GetBody()}
+	if req.GetParentId() != "" {
+		parentID, err := parseReviewUUID(req.GetParentId(), "parent_id")
+		if err != nil {
+			return nil, err
+		}
+		input.ParentID = &parentID
+	}
+	if req.GetField() != "" {
+		field := req.GetField()
+		input.Field = &field
+	}
+	if req.GetLineStart() > 0 {
+		lineStart := req.GetLineStart()
+		input.LineStart = &lineStart
+	}
+	if req.GetLineEnd() > 0 {
+		lineEnd := req.GetLineEnd()
+		input.LineEnd = &lineEnd
+	}
+
+	comment, err := s.reviewService.AddComment(ctx, actor, reviewID, input)
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.AddReviewCommentResponse{
+		Response: &common.Response{Success: true, Message: "Comment added"},
+		Comment:  reviewCommentToProto(comment),
+	}, nil
+}
+
+// ListReviewComments returns all comments of a review
+func (s *QuestionReviewServiceServer) ListReviewComments(ctx context.Context, req *v1.ListReviewCommentsRequest) (*v1.ListReviewCommentsResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reviewID, err := parseReviewUUID(req.GetReviewId(), "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.reviewService.ListComments(ctx, actor, reviewID)
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	protoComments := make([]*v1.QuestionReviewComment, 0, len(comments))
+	for _, c := range comments {
+		protoComments = append(protoComments, reviewCommentToProto(c))
+	}
+
+	return &v1.ListReviewCommentsResponse{
+		Response: &common.Response{Success: true, Message: "Comments retrieved successfully"},
+		Comments: protoComments,
+	}, nil
+}
+
+// ResolveReviewComment resolves or reopens a comment thread
+func (s *QuestionReviewServiceServer) ResolveReviewComment(ctx context.Context, req *v1.ResolveReviewCommentRequest) (*v1.ResolveReviewCommentResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	commentID, err := parseReviewUUID(req.GetCommentId(), "comment_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewService.ResolveComment(ctx, actor, commentID, req.GetResolved()); err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.ResolveReviewCommentResponse{
+		Response: &common.Response{Success: true, Message: "Comment updated"},
+	}, nil
+}
+
+// GetReviewerDashboard lists outstanding reviews for the caller (or any reviewer for admins)
+func (s *QuestionReviewServiceServer) GetReviewerDashboard(ctx context.Context, req *v1.GetReviewerDashboardRequest) (*v1.GetReviewerDashboardResponse, error) {
+	actor, err := reviewActorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewerID := req.GetReviewerId()
+	if reviewerID == "" {
+		reviewerID = actor.UserID
+	}
+	if reviewerID != actor.UserID && !actor.IsAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "access denied: you can only view your own dashboard")
+	}
+
+	page := int32(1)
+	limit := int32(20)
+	if req.GetPagination() != nil {
+		if req.GetPagination().GetPage() > 0 {
+			page = req.GetPagination().GetPage()
+		}
+		if req.GetPagination().GetLimit() > 0 && req.GetPagination().GetLimit() <= 100 {
+			limit = req.GetPagination().GetLimit()
+		}
+	}
+
+	dashboard, err := s.reviewService.GetReviewerDashboard(ctx, reviewerID, int(limit), int((page-1)*limit))
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	outstanding := make([]*v1.OutstandingReview, 0, len(dashboard.Items))
+	for _, item := range dashboard.Items {
+		outstanding = append(outstanding, &v1.OutstandingReview{
+			Review:             reviewToProto(&item.Review),
+			QuestionCodeId:     item.QuestionCodeID,
+			QuestionType:       item.QuestionType,
+			ContentPreview:     item.ContentPreview,
+			UnresolvedComments: int32(item.UnresolvedComments),
+		})
+	}
+
+	total := int32(dashboard.Total)
+	return &v1.GetReviewerDashboardResponse{
+		Response: &common.Response{Success: true, Message: "Reviewer dashboard retrieved successfully"},
+		Workload: &v1.ReviewerWorkload{
+			InReview:         int32(dashboard.Workload.InReview),
+			ChangesRequested: int32(dashboard.Workload.ChangesRequested),
+			ApprovedRecent:   int32(dashboard.Workload.ApprovedRecent),
+			RejectedRecent:   int32(dashboard.Workload.RejectedRecent),
+		},
+		Outstanding: outstanding,
+		Pagination: &common.PaginationResponse{
+			Page:       page,
+			Limit:      limit,
+			TotalCount: total,
+			TotalPages: (total + limit - 1) / limit,
+		},
+	}, nil
+}
+
+// SetReviewerSubjects replaces the subjects a reviewer is responsible for
+func (s *QuestionReviewServiceServer) SetReviewerSubjects(ctx context.Context, req *v1.SetReviewerSubjectsRequest) (*v1.SetReviewerSubjectsResponse, error) {
+	subjects := make([]*entity.ReviewerSubject, 0, len(req.GetSubjects()))
+	for _, subj := range req.GetSubjects() {
+		rs := &entity.ReviewerSubject{
+			Subject:  subj.GetSubject(),
+			IsActive: subj.GetIsActive(),
+		}
+		if subj.GetGrade() != "" {
+			grade := subj.GetGrade()
+			rs.Grade = &grade
+		}
+		subjects = append(subjects, rs)
+	}
+
+	if err := s.reviewService.SetReviewerSubjects(ctx, req.GetReviewerId(), subjects); err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &v1.SetReviewerSubjectsResponse{
+		Response: &common.Response{Success: true, Message: "Reviewer subjects updated"},
+	}, nil
+}
+
+// ListReviewerSubjects lists the subjects a reviewer is responsible for
+func (s *QuestionReviewServiceServer) ListReviewerSubjects(ctx context.Context, req *v1.ListReviewerSubjectsRequest) (*v1.ListReviewerSubjectsResponse, error) {
+	subjects, err := s.reviewService.ListReviewerSubjects(ctx, req.GetReviewerId())
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	protoSubjects := make([]*v1.ReviewerSubject, 0, len(subjects))
+	for _, subj := range subjects {
+		ps := &v1.ReviewerSubject{Subject: subj.Subject, IsActive: subj.IsActive}
+		if subj.Grade != nil {
+			ps.Grade = *subj.Grade
+		}
+		protoSubjects = append(protoSubjects, ps)
+	}
+
+	return &v1.ListReviewerSubjectsResponse{
+		Response: &common.Response{Success: true, Message: "Reviewer subjects retrieved successfully"},
+		Subjects: protoSubjects,
+	}, nil
+}
+
+func reviewActorFromContext(ctx context.Context) (question.ReviewActor, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return question.ReviewActor{}, status.Errorf(codes.Unauthenticated, "failed to get user from context: %v", err)
+	}
+	role, _ := middleware.GetUserRoleFromContext(ctx)
+	return question.ReviewActor{UserID: userID, IsAdmin: role == "ADMIN"}, nil
+}
+
+func parseReviewUUID(value, field string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return id, nil
+}
+
+// reviewError maps review workflow errors to gRPC status codes
+func reviewError(err error) error {
+	switch {
+	case errors.Is(err, question.ErrReviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, question.ErrReviewPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, question.ErrReviewAlreadyOpen):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, question.ErrReviewInvalidTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, question.ErrReviewInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "review operation failed: %v", err)
+	}
+}
+
+func reviewStatusFromProto(s v1.QuestionReviewStatus) entity.QuestionReviewStatus {
+	switch s {
+	case v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_IN_REVIEW:
+		return entity.ReviewStatusInReview
+	case v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_CHANGES_REQUESTED:
+		return entity.ReviewStatusChangesRequested
+	case v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_APPROVED:
+		return entity.ReviewStatusApproved
+	case v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_REJECTED:
+		return entity.ReviewStatusRejected
+	default:
+		return ""
+	}
+}
+
+func reviewStatusToProto(s entity.QuestionReviewStatus) v1.QuestionReviewStatus {
+	switch s {
+	case entity.ReviewStatusInReview:
+		return v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_IN_REVIEW
+	case entity.ReviewStatusChangesRequested:
+		return v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_CHANGES_REQUESTED
+	case entity.ReviewStatusApproved:
+		return v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_APPROVED
+	case entity.ReviewStatusRejected:
+		return v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_REJECTED
+	default:
+		return v1.QuestionReviewStatus_QUESTION_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+func reviewToProto(r *entity.QuestionReview) *v1.QuestionReview {
+	pb := &v1.QuestionReview{
+		Id:            r.ID.String(),
+		QuestionId:    r.QuestionID,
+		AuthorId:      r.AuthorID,
+		Status:        reviewStatusToProto(r.Status),
+		VersionNumber: r.VersionNumber,
+		SubmittedAt:   timestamppb.New(r.SubmittedAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+	if r.ReviewerID != nil {
+		pb.ReviewerId = *r.ReviewerID
+	}
+	if r.Subject != nil {
+		pb.Subject = *r.Subject
+	}
+	if r.Grade != nil {
+		pb.Grade = *r.Grade
+	}
+	if r.DecisionNote != nil {
+		pb.DecisionNote = *r.DecisionNote
+	}
+	if r.DecidedAt != nil {
+		pb.DecidedAt = timestamppb.New(*r.DecidedAt)
+	}
+	return pb
+}
+
+func reviewCommentToProto(c *entity.QuestionReviewComment) *v1.QuestionReviewComment {
+	pb := &v1.QuestionReviewComment{
+		Id:            c.ID.String(),
+		ReviewId:      c.ReviewID.String(),
+		QuestionId:    c.QuestionID,
+		VersionNumber: c.VersionNumber,
+		AuthorId:      c.AuthorID,
+		Body:          c.Body,
+		IsResolved:    c.IsResolved,
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+	}
+	if c.ParentID != nil {
+		pb.ParentId = c.ParentID.String()
+	}
+	if c.Field != nil {
+		pb.Field = *c.Field
+	}
+	if c.LineStart != nil {
+		pb.LineStart = *c.LineStart
+	}
+	if c.LineEnd != nil {
+		pb.LineEnd = *c.LineEnd
+	}
+	return pb
+}
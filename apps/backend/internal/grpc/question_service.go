@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"log"
 	"strings"
 
 	"exam-bank-system/apps/backend/internal/entity"
@@ -27,6 +28,7 @@ type QuestionServiceServer struct {
 	v1.UnimplementedQuestionServiceServer
 	questionService *question.QuestionService
 	versionService  *question.VersionService
+	reviewService   *question.ReviewService
 }
 
 // NewQuestionServiceServer creates a new QuestionServiceServer
@@ -40,6 +42,11 @@ func NewQuestionServiceServer(
 	}
 }
 
+// SetReviewService opens a review round for each question a non-admin creates or imports
+func (s *QuestionServiceServer) SetReviewService(reviewService *question.ReviewService) {
+	s.reviewService = reviewService
+}
+
 // CreateQuestion creates a new question
 func (s *QuestionServiceServer) CreateQuestion(ctx context.Context, req *v1.CreateQuestionRequest) (*v1.CreateQuestionResponse, error) {
 	// Get user from context for authorization
@@ -94,6 +101,12 @@ func (s *QuestionServiceServer) CreateQuestion(ctx context.Context, req *v1.Crea
 		entityQuestion.Creator = util.StringToPgText(userID)
 	}
 
+	// Only admins may create questions that skip review
+	heldForReview := requiresReview(ctx)
+	if heldForReview {
+		entityQuestion.Status = util.StringToPgText(string(entity.QuestionStatusPending))
+	}
+
 	// Create question through service layer
 	err = s.questionService.CreateQuestion(ctx, entityQuestion)
 	if err != nil {
@@ -103,6 +116,9 @@ func (s *QuestionServiceServer) CreateQuestion(ctx context.Context, req *v1.Crea
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create question: %v", err)
 	}
+	if heldForReview {
+		s.submitForReview(ctx, userID, entityQuestion.ID.String)
+	}
 
 	// Get created question to return with all fields populated
 	createdQuestion, err := s.questionService.GetQuestionByID(ctx, entityQuestion.ID.String)
@@ -144,6 +160,14 @@ func (s *QuestionServiceServer) GetQuestion(ctx context.Context, req *v1.GetQues
 	// Convert entity to proto
 	protoQuestion := convertQuestionToProto(&question)
 
+	if req.GetIncludeRendered() {
+		rendered, err := s.questionService.RenderQuestion(ctx, &question)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to render question: %v", err)
+		}
+		protoQuestion.Rendered = convertRenderedQuestionToProto(rendered, true)
+	}
+
 	return &v1.GetQuestionResponse{
 		Response: &common.Response{
 			Success: true,
@@ -232,7 +256,14 @@ func (s *QuestionServiceServer) UpdateQuestion(ctx context.Context, req *v1.Upda
 
 	// Update status if provided
 	if req.GetStatus() != common.QuestionStatus_QUESTION_STATUS_UNSPECIFIED {
-		updatedQuestion.Status = util.StringToPgText(convertProtoQuestionStatusToString(req.GetStatus()))
+		newStatus := convertProtoQuestionStatusToString(req.GetStatus())
+		// Only admins may activate directly; everyone else goes through QuestionReviewService
+		if newStatus == string(entity.QuestionStatusActive) && existingQuestion.Status.String != newStatus {
+			if role, _ := middleware.GetUserRoleFromContext(ctx); role != "ADMIN" {
+				return nil, status.Errorf(codes.FailedPrecondition, "question must be approved through review before it can be activated")
+			}
+		}
+		updatedQuestion.Status = util.StringToPgText(newStatus)
 	}
 
 	// Update difficulty if provided
@@ -394,12 +425,24 @@ func (s *QuestionServiceServer) ImportQuestions(ctx context.Context, req *v1.Imp
 		CsvDataBase64: req.GetCsvDataBase64(),
 		UpsertMode:    req.GetUpsertMode(),
 	}
+	// Questions held for review are credited to the importer, who submits them
+	heldForReview := requiresReview(ctx)
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	if heldForReview {
+		serviceReq.Status = string(entity.QuestionStatusPending)
+		serviceReq.Creator = userID
+	}
 
 	// Call QuestionService to import questions
 	result, err := s.questionService.ImportQuestions(ctx, serviceReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to import questions: %v", err)
 	}
+	if heldForReview {
+		for _, id := range result.CreatedIDs {
+			s.submitForReview(ctx, userID, id)
+		}
+	}
 
 	// Convert service response to proto response
 	var protoErrors []*v1.ImportError
@@ -475,6 +518,30 @@ func convertQuestionToProto(question *entity.Question) *v1.Question {
 	}
 }
 
+// convertRenderedQuestionToProto converts a rendering, optionally without the solution
+func convertRenderedQuestionToProto(rendered *latex.RenderedQuestion, includeSolution bool) *v1.RenderedQuestion {
+	if rendered == nil {
+		return nil
+	}
+
+	choices := make([]*v1.RenderedChoice, 0, len(rendered.Choices))
+	for _, choice := range rendered.Choices {
+		choices = append(choices, &v1.RenderedChoice{
+			Label: choice.Label,
+			Html:  choice.HTML,
+		})
+	}
+
+	result := &v1.RenderedQuestion{
+		ContentHtml: rendered.ContentHTML,
+		Choices:     choices,
+	}
+	if includeSolution {
+		result.SolutionHtml = rendered.SolutionHTML
+	}
+	return result
+}
+
 func convertQuestionType(t string) common.QuestionType {
 	switch strings.ToUpper(t) {
 	case "MC":
@@ -693,6 +760,11 @@ func (s *QuestionServiceServer) CreateQuestionFromLatex(ctx context.Context, req
 	var createdQuestions []*v1.Question
 	var createdCodes []*v1.QuestionCode
 	var failedCount int32
+	heldForReview := requiresReview(ctx)
+	initialStatus := string(entity.QuestionStatusActive)
+	if heldForReview {
+		initialStatus = string(entity.QuestionStatusPending)
+	}
 
 	for _, parsedQuestion := range parsedQuestions {
 		// Skip unsupported question types
@@ -718,7 +790,7 @@ func (s *QuestionServiceServer) CreateQuestionFromLatex(ctx context.Context, req
 		// parsedQuestion is already entity.Question, just update some fields
 		entityQuestion := &parsedQuestion
 		entityQuestion.Creator = util.StringToPgText(userID)
-		entityQuestion.Status = util.StringToPgText("ACTIVE") // Set as active when creating
+		entityQuestion.Status = util.StringToPgText(initialStatus)
 		if entityQuestion.Difficulty.Status != pgtype.Present {
 			entityQuestion.Difficulty = util.StringToPgText("MEDIUM") // Default difficulty if not set
 		}
@@ -730,6 +802,9 @@ func (s *QuestionServiceServer) CreateQuestionFromLatex(ctx context.Context, req
 			failedCount++
 			continue
 		}
+		if heldForReview {
+			s.submitForReview(ctx, userID, entityQuestion.ID.String)
+		}
 
 		// Add to created questions list
 		protoQuestion := convertQuestionToProto(entityQuestion)
@@ -884,6 +959,11 @@ func (s *QuestionServiceServer) ImportLatex(ctx context.Context, req *v1.ImportL
 	var skippedCount int32
 	var errors []*v1.ImportError
 	totalProcessed := int32(len(parsedQuestions))
+	heldForReview := requiresReview(ctx)
+	initialStatus := string(entity.QuestionStatusActive)
+	if heldForReview {
+		initialStatus = string(entity.QuestionStatusPending)
+	}
 
 	for i, parsedQuestion := range parsedQuestions {
 		// Skip unsupported question types
@@ -956,7 +1036,7 @@ func (s *QuestionServiceServer) ImportLatex(ctx context.Context, req *v1.ImportL
 		// Create new question
 		entityQuestion := &parsedQuestion
 		entityQuestion.Creator = util.StringToPgText(userID)
-		entityQuestion.Status = util.StringToPgText("ACTIVE")
+		entityQuestion.Status = util.StringToPgText(initialStatus)
 		if entityQuestion.Difficulty.Status != pgtype.Present {
 			entityQuestion.Difficulty = util.StringToPgText("MEDIUM")
 		}
@@ -973,6 +1053,9 @@ func (s *QuestionServiceServer) ImportLatex(ctx context.Context, req *v1.ImportL
 			})
 		} else {
 			createdCount++
+			if heldForReview {
+				s.submitForReview(ctx, userID, entityQuestion.ID.String)
+			}
 		}
 	}
 
@@ -1003,6 +1086,24 @@ func (s *QuestionServiceServer) ImportLatex(ctx context.Context, req *v1.ImportL
 	}, nil
 }
 
+// requiresReview reports whether questions created by the caller start PENDING and go
+// through QuestionReviewService; only admins may create ACTIVE questions directly
+func requiresReview(ctx context.Context) bool {
+	role, _ := middleware.GetUserRoleFromContext(ctx)
+	return role != "ADMIN"
+}
+
+// submitForReview opens a review round for a new question. A failure is logged and leaves
+// the question PENDING, so the author can still submit it through QuestionReviewService.
+func (s *QuestionServiceServer) submitForReview(ctx context.Context, userID, questionID string) {
+	if s.reviewService == nil {
+		return
+	}
+	if _, err := s.reviewService.SubmitForReview(ctx, question.ReviewActor{UserID: userID}, questionID); err != nil {
+		log.Printf("[WARN] [QuestionService] Failed to open review for question %s: %v", questionID, err)
+	}
+}
+
 // ToggleFavorite toggles the favorite status of a question
 func (s *QuestionServiceServer) ToggleFavorite(ctx context.Context, req *v1.ToggleFavoriteRequest) (*v1.ToggleFavoriteResponse, error) {
 	// Validate request
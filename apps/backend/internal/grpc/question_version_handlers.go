@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"exam-bank-system/apps/backend/internal/middleware"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	pb "exam-bank-system/apps/backend/pkg/proto/v1"
 )
@@ -244,6 +245,12 @@ func (s *QuestionServiceServer) BulkUpdateQuestions(
 	if len(req.QuestionIds) > 100 {
 		return nil, status.Error(codes.InvalidArgument, "cannot update more than 100 questions at once")
 	}
+	if req.Status == "ACTIVE" {
+		// Bulk activation bypasses review and is reserved for admins
+		if role, _ := middleware.GetUserRoleFromContext(ctx); role != "ADMIN" {
+			return nil, status.Error(codes.FailedPrecondition, "questions must be approved through review before they can be activated")
+		}
+	}
 
 	// Track results
 	successCount := 0
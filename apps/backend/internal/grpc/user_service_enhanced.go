@@ -11,8 +11,11 @@ import (
 	"exam-bank-system/apps/backend/internal/middleware"
 	"exam-bank-system/apps/backend/internal/repository"
 	"exam-bank-system/apps/backend/internal/service/auth"
+	"exam-bank-system/apps/backend/internal/service/auth/risk"
 	"exam-bank-system/apps/backend/internal/service/user/oauth"
+	"exam-bank-system/apps/backend/internal/service/user/passwordless"
 	"exam-bank-system/apps/backend/internal/service/user/session"
+	"exam-bank-system/apps/backend/internal/service/user/twofactor"
 	"exam-bank-system/apps/backend/internal/services/email"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
@@ -39,6 +42,9 @@ type EnhancedUserServiceServer struct {
 	loginHandler         *LoginHandler
 	registrationHandler  *RegistrationHandler
 	passwordResetHandler *PasswordResetHandler
+	twoFactorService     *twofactor.TwoFactorService
+	passwordlessService  *passwordless.PasswordlessService
+	riskEvaluator        *risk.Evaluator
 	bcryptCost           int
 }
 
@@ -122,9 +128,46 @@ func (s *EnhancedUserServiceServer) Login(ctx context.Context, req *v1.LoginRequ
 
 	log.Printf("DEBUG: Login - Account security check passed")
 
+	// Score the login before the failed attempts are reset; they are one of the signals
+	loginRisk := s.assessLogin(ctx, user)
+	if loginRisk.denied() {
+		return nil, s.denyRiskyLogin(ctx, user, loginRisk)
+	}
+
 	// Reset login attempts after successful authentication
 	_ = s.loginHandler.ResetLoginAttempts(ctx, user.ID)
 
+	return s.issueLogin(ctx, user, loginRisk)
+}
+
+// issueLogin finishes a first-factor login: it returns a two-factor challenge when
+// one is required or the login risk asks for a step-up, otherwise tokens and a session
+func (s *EnhancedUserServiceServer) issueLogin(ctx context.Context, user *repository.User, loginRisk *loginRisk) (*v1.LoginResponse, error) {
+	// Second factor: return a challenge instead of tokens
+	if s.twoFactorService != nil && s.twoFactorService.Enabled() {
+		purpose, err := s.twoFactorService.LoginRequirement(ctx, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check two-factor settings: %v", err)
+		}
+		if purpose != "" {
+			loginRisk.satisfyStepUp(risk.StepUpTOTP)
+			s.recordLoginRisk(ctx, user, loginRisk)
+			return s.twoFactorChallengeResponse(ctx, user, purpose)
+		}
+	}
+
+	// Risky login without a TOTP factor: challenge with an emailed code
+	if loginRisk.needsStepUp() {
+		return s.emailStepUpResponse(ctx, user, loginRisk)
+	}
+
+	s.recordLoginRisk(ctx, user, loginRisk)
+	return s.completeLogin(ctx, user, nil)
+}
+
+// completeLogin issues tokens and a session for an authenticated user
+// recoveryCodes are only set when login also completed two-factor enrollment
+func (s *EnhancedUserServiceServer) completeLogin(ctx context.Context, user *repository.User, recoveryCodes []string) (*v1.LoginResponse, error) {
 	// Get client context for token generation
 	ipAddress := getClientIP(ctx)
 	userAgent := getUserAgent(ctx)
@@ -157,10 +200,11 @@ func (s *EnhancedUserServiceServer) Login(ctx context.Context, req *v1.LoginRequ
 			Success: true,
 			Message: MsgLoginSuccess,
 		},
-		AccessToken:  tokenResponse.AccessToken,
-		RefreshToken: tokenResponse.RefreshToken,
-		SessionToken: sessionToken,
-		User:         ConvertUserToProto(user),
+		AccessToken:   tokenResponse.AccessToken,
+		RefreshToken:  tokenResponse.RefreshToken,
+		SessionToken:  sessionToken,
+		User:          ConvertUserToProto(user),
+		RecoveryCodes: recoveryCodes,
 	}, nil
 }
 
@@ -215,16 +259,23 @@ func (s *EnhancedUserServiceServer) Register(ctx context.Context, req *v1.Regist
 //   - *v1.LoginResponse: Response chá»©a tokens vÃ  user info
 //   - error: Error náº¿u OAuth authentication tháº¥t báº¡i
 func (s *EnhancedUserServiceServer) GoogleLogin(ctx context.Context, req *v1.GoogleLoginRequest) (*v1.LoginResponse, error) {
-	// Get client IP from metadata
-	ipAddress := getClientIP(ctx)
-
-	// Delegate to OAuth service
-	response, err := s.oauthService.GoogleLogin(ctx, req.IdToken, ipAddress)
+	user, err := s.oauthService.ResolveGoogleUser(ctx, req.IdToken)
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	// Check account security (locked, inactive, suspended)
+	if err := s.loginHandler.CheckAccountSecurity(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// Same second-factor and risk checks as a password login
+	loginRisk := s.assessLogin(ctx, user)
+	if loginRisk.denied() {
+		return nil, s.denyRiskyLogin(ctx, user, loginRisk)
+	}
+
+	return s.issueLogin(ctx, user, loginRisk)
 }
 
 // RefreshToken refreshes the access token with rotation for enhanced security
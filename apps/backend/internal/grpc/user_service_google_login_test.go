@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/risk"
+	"exam-bank-system/apps/backend/internal/service/user/oauth"
+	"exam-bank-system/apps/backend/internal/service/user/twofactor"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// googleUsers resolves Google logins to one existing user. Methods GoogleLogin does not
+// use fall through to the nil embedded interface.
+type googleUsers struct {
+	repository.IUserRepository
+	user *repository.User
+}
+
+func (r *googleUsers) GetByGoogleID(ctx context.Context, googleID string) (*repository.User, error) {
+	if googleID == r.user.GoogleID {
+		return r.user, nil
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+type googleAccounts struct {
+	repository.OAuthAccountRepository
+}
+
+func (googleAccounts) Upsert(ctx context.Context, account *repository.OAuthAccount) error {
+	return nil
+}
+
+type fakeGoogleVerifier struct{ info *oauth.GoogleUserInfo }
+
+func (f fakeGoogleVerifier) VerifyIDToken(ctx context.Context, idToken string) (*oauth.GoogleUserInfo, error) {
+	return f.info, nil
+}
+
+// factorStore keeps the user's TOTP factor and the challenges issued to them
+type factorStore struct {
+	*repository.TwoFactorRepository
+	factor     *repository.UserTwoFactor
+	challenges []*repository.TwoFactorChallenge
+}
+
+func (f *factorStore) GetFactor(ctx context.Context, userID string) (*repository.UserTwoFactor, error) {
+	if f.factor == nil {
+		return nil, repository.ErrNotFound
+	}
+	return f.factor, nil
+}
+
+func (f *factorStore) SavePendingFactor(ctx context.Context, userID, secretEncrypted string) error {
+	f.factor = &repository.UserTwoFactor{UserID: userID, SecretEncrypted: secretEncrypted}
+	return nil
+}
+
+func (f *factorStore) CreateChallenge(ctx context.Context, challenge *repository.TwoFactorChallenge) error {
+	f.challenges = append(f.challenges, challenge)
+	return nil
+}
+
+func newGoogleLoginServer(user *repository.User, factors *factorStore) *EnhancedUserServiceServer {
+	users := &googleUsers{user: user}
+	oauthService := oauth.NewOAuthService(users, googleAccounts{}, nil, nil, nil, "client-id", "", "", nil)
+	oauthService.SetGoogleTokenVerifier(fakeGoogleVerifier{info: &oauth.GoogleUserInfo{
+		ID:            user.GoogleID,
+		Email:         user.Email,
+		EmailVerified: true,
+	}})
+
+	server := NewEnhancedUserServiceServer(oauthService, nil, nil, users, nil, MinBcryptCost)
+	server.SetTwoFactorService(twofactor.NewTwoFactorService(factors, nil, twofactor.Config{
+		Enabled:       true,
+		EnforcedRoles: []string{"ADMIN", "TEACHER"},
+		EncryptionKey: "test-key",
+	}))
+	return server
+}
+
+func assertChallengeWithoutTokens(t *testing.T, resp *v1.LoginResponse, method string) {
+	t.Helper()
+	if !resp.TwoFactorRequired || resp.ChallengeToken == "" || resp.ChallengeMethod != method {
+		t.Fatalf("expected a %s challenge, got %+v", method, resp)
+	}
+	if resp.AccessToken != "" || resp.RefreshToken != "" || resp.SessionToken != "" {
+		t.Fatal("tokens were issued before the second factor")
+	}
+}
+
+func TestGoogleLogin_TwoFactorChallenge(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    common.UserRole
+		factor  *repository.UserTwoFactor
+		purpose string
+	}{
+		{"enrolled student", common.UserRole_USER_ROLE_STUDENT, &repository.UserTwoFactor{UserID: "user-1", Enabled: true}, repository.TwoFactorChallengeLogin},
+		{"enrolled admin", common.UserRole_USER_ROLE_ADMIN, &repository.UserTwoFactor{UserID: "user-1", Enabled: true}, repository.TwoFactorChallengeLogin},
+		{"teacher without factor", common.UserRole_USER_ROLE_TEACHER, nil, repository.TwoFactorChallengeEnroll},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &repository.User{ID: "user-1", Email: "gv@example.com", GoogleID: "google-1", Role: tt.role, Status: StatusActive}
+			factors := &factorStore{factor: tt.factor}
+			server := newGoogleLoginServer(user, factors)
+
+			resp, err := server.GoogleLogin(context.Background(), &v1.GoogleLoginRequest{IdToken: "id-token"})
+			if err != nil {
+				t.Fatalf("GoogleLogin: %v", err)
+			}
+			assertChallengeWithoutTokens(t, resp, risk.StepUpTOTP)
+			if len(factors.challenges) != 1 || factors.challenges[0].Purpose != tt.purpose {
+				t.Fatalf("expected one %s challenge, got %+v", tt.purpose, factors.challenges)
+			}
+			if tt.purpose == repository.TwoFactorChallengeEnroll && resp.TwoFactorEnrollment == nil {
+				t.Fatal("enforced enrollment did not return a secret")
+			}
+		})
+	}
+}
+
+type noLoginHistory struct{}
+
+func (noLoginHistory) RecentLogins(ctx context.Context, userID string, since time.Time, limit int) ([]*repository.LoginRecord, error) {
+	return nil, nil
+}
+
+type securityEvents struct{ events []*entity.SecurityEvent }
+
+func (e *securityEvents) Create(ctx context.Context, event *entity.SecurityEvent) error {
+	e.events = append(e.events, event)
+	return nil
+}
+
+type codeMailer struct{ sent []string }
+
+func (m *codeMailer) SendLoginVerificationEmail(toEmail, userName, code, device, ipAddress string, ttl time.Duration) error {
+	m.sent = append(m.sent, toEmail)
+	return nil
+}
+
+func TestGoogleLogin_RiskEvaluation(t *testing.T) {
+	enrolled := &repository.UserTwoFactor{UserID: "user-1", Enabled: true}
+	tests := []struct {
+		name        string
+		failures    int // Each failed attempt adds 10 points
+		factor      *repository.UserTwoFactor
+		wantDenied  bool
+		wantMethod  string
+		wantPurpose string
+		wantStatus  string
+	}{
+		{"high risk is denied", 3, enrolled, true, "", "", "MITIGATED"},
+		{"step-up without factor gets an email code", 1, nil, false, risk.StepUpEmail, repository.TwoFactorChallengeEmail, "DETECTED"},
+		{"step-up with factor gets a TOTP challenge", 1, enrolled, false, risk.StepUpTOTP, repository.TwoFactorChallengeLogin, "DETECTED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &repository.User{
+				ID: "user-1", Email: "hs@example.com", GoogleID: "google-1",
+				Role: common.UserRole_USER_ROLE_STUDENT, Status: StatusActive, LoginAttempts: tt.failures,
+			}
+			factors := &factorStore{factor: tt.factor}
+			server := newGoogleLoginServer(user, factors)
+			mailer := &codeMailer{}
+			server.twoFactorService.SetMailer(mailer)
+			events := &securityEvents{}
+			server.SetLoginRiskEvaluator(risk.NewEvaluator(noLoginHistory{}, events, nil, risk.Config{StepUpScore: 10, DenyScore: 30}))
+
+			resp, err := server.GoogleLogin(context.Background(), &v1.GoogleLoginRequest{IdToken: "id-token"})
+			if tt.wantDenied {
+				if status.Code(err) != codes.PermissionDenied {
+					t.Fatalf("expected PermissionDenied, got %v %+v", err, resp)
+				}
+				if len(factors.challenges) != 0 {
+					t.Fatal("a denied login was challenged")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("GoogleLogin: %v", err)
+				}
+				assertChallengeWithoutTokens(t, resp, tt.wantMethod)
+				if len(factors.challenges) != 1 || factors.challenges[0].Purpose != tt.wantPurpose {
+					t.Fatalf("expected one %s challenge, got %+v", tt.wantPurpose, factors.challenges)
+				}
+				if tt.wantMethod == risk.StepUpEmail && len(mailer.sent) != 1 {
+					t.Fatalf("expected one email code, sent %d", len(mailer.sent))
+				}
+			}
+
+			if len(events.events) != 1 {
+				t.Fatalf("expected one security event, got %d", len(events.events))
+			}
+			event := events.events[0]
+			if event.ThreatType.String != risk.ThreatType || event.Status.String != tt.wantStatus || event.UserID.String != user.ID {
+				t.Fatalf("unexpected security event %s/%s for %s", event.ThreatType.String, event.Status.String, event.UserID.String)
+			}
+			if tt.wantMethod != "" && !strings.Contains(string(event.Metadata.Bytes), tt.wantMethod) {
+				t.Fatalf("event metadata %s does not record the %s step-up", event.Metadata.Bytes, tt.wantMethod)
+			}
+		})
+	}
+}
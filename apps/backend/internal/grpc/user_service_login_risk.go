@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/risk"
+	"exam-bank-system/apps/backend/internal/service/user/twofactor"
+	"exam-bank-system/apps/backend/internal/util"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// loginRisk is the risk assessment of a login in progress
+type loginRisk struct {
+	assessment *risk.Assessment
+	login      risk.LoginContext
+}
+
+// SetLoginRiskEvaluator enables adaptive risk scoring of password, passwordless, Google and OIDC logins
+func (s *EnhancedUserServiceServer) SetLoginRiskEvaluator(evaluator *risk.Evaluator) {
+	s.riskEvaluator = evaluator
+}
+
+// assessLogin scores a login whose first factor has been verified. It returns nil when
+// risk scoring is disabled.
+func (s *EnhancedUserServiceServer) assessLogin(ctx context.Context, user *repository.User) *loginRisk {
+	if s.riskEvaluator == nil {
+		return nil
+	}
+	userAgent := getUserAgent(ctx)
+	login := risk.LoginContext{
+		IPAddress:         getClientIP(ctx),
+		UserAgent:         userAgent,
+		DeviceFingerprint: generateDeviceFingerprint(userAgent),
+	}
+	return &loginRisk{assessment: s.riskEvaluator.Evaluate(ctx, user, login), login: login}
+}
+
+// denyRiskyLogin records a refused login and returns the error for the client
+func (s *EnhancedUserServiceServer) denyRiskyLogin(ctx context.Context, user *repository.User, lr *loginRisk) error {
+	lr.assessment.Decision = risk.DecisionDeny
+	s.recordLoginRisk(ctx, user, lr)
+	return status.Error(codes.PermissionDenied, ErrLoginRiskDenied)
+}
+
+// recordLoginRisk stores the final decision as a security event
+func (s *EnhancedUserServiceServer) recordLoginRisk(ctx context.Context, user *repository.User, lr *loginRisk) {
+	if lr == nil {
+		return
+	}
+	s.riskEvaluator.Record(ctx, user, lr.login, lr.assessment)
+}
+
+func (lr *loginRisk) denied() bool {
+	return lr != nil && lr.assessment.Decision == risk.DecisionDeny
+}
+
+// satisfyStepUp marks a step-up as covered by the given second factor
+func (lr *loginRisk) satisfyStepUp(method string) {
+	if lr != nil && lr.assessment.Decision == risk.DecisionStepUp && lr.assessment.StepUpMethod == "" {
+		lr.assessment.StepUpMethod = method
+	}
+}
+
+// needsStepUp reports whether the login still needs a second factor
+func (lr *loginRisk) needsStepUp() bool {
+	return lr != nil && lr.assessment.Decision == risk.DecisionStepUp && lr.assessment.StepUpMethod == ""
+}
+
+// emailStepUpResponse challenges a risky login without a TOTP factor with a code sent by
+// email. Logins that cannot be challenged are refused.
+func (s *EnhancedUserServiceServer) emailStepUpResponse(ctx context.Context, user *repository.User, lr *loginRisk) (*v1.LoginResponse, error) {
+	if s.twoFactorService == nil {
+		return nil, s.denyRiskyLogin(ctx, user, lr)
+	}
+
+	challenge, err := s.twoFactorService.StartEmailChallenge(ctx, user, util.GetDeviceDisplayName(lr.login.UserAgent), twoFactorClient(ctx))
+	if errors.Is(err, twofactor.ErrDisabled) {
+		return nil, s.denyRiskyLogin(ctx, user, lr)
+	}
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	lr.satisfyStepUp(risk.StepUpEmail)
+	s.recordLoginRisk(ctx, user, lr)
+
+	return &v1.LoginResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgLoginVerificationRequired,
+		},
+		User:               ConvertUserToProto(user),
+		TwoFactorRequired:  true,
+		ChallengeToken:     challenge.Token,
+		ChallengeExpiresAt: challenge.ExpiresAt.Unix(),
+		ChallengeMethod:    risk.StepUpEmail,
+	}, nil
+}
@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/user/oauth"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// ListOIDCProviders lists the configured OIDC providers for the login page
+func (s *EnhancedUserServiceServer) ListOIDCProviders(ctx context.Context, req *v1.ListOIDCProvidersRequest) (*v1.ListOIDCProvidersResponse, error) {
+	var providers []*v1.OIDCProviderInfo
+	for _, provider := range s.oauthService.OIDCProviders() {
+		config := provider.Config()
+		info := &v1.OIDCProviderInfo{
+			Name:        config.Name,
+			DisplayName: config.DisplayName,
+			ClientId:    config.ClientID,
+			Scopes:      config.Scopes,
+		}
+		// An unreachable provider is still listed; the frontend can retry later
+		if doc, err := provider.Discovery(ctx); err == nil {
+			info.AuthorizationEndpoint = doc.AuthorizationEndpoint
+		}
+		providers = append(providers, info)
+	}
+
+	return &v1.ListOIDCProvidersResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgOIDCProvidersRetrieved,
+		},
+		Providers: providers,
+	}, nil
+}
+
+// StartOIDCLogin issues the state and nonce of an authorization request and returns the
+// provider URL to redirect to
+func (s *EnhancedUserServiceServer) StartOIDCLogin(ctx context.Context, req *v1.StartOIDCLoginRequest) (*v1.StartOIDCLoginResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	authorization, err := s.oauthService.StartOIDCLogin(ctx, req.Provider, req.RedirectUri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.StartOIDCLoginResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgOIDCLoginStarted,
+		},
+		AuthorizationUrl: authorization.URL,
+		State:            authorization.State,
+		ExpiresAt:        authorization.ExpiresAt.Unix(),
+	}, nil
+}
+
+// OIDCLogin logs in with an ID token or authorization code from a configured OIDC
+// provider and issues the same session and tokens as Login
+func (s *EnhancedUserServiceServer) OIDCLogin(ctx context.Context, req *v1.OIDCLoginRequest) (*v1.LoginResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	user, err := s.oauthService.ResolveOIDCUser(ctx, oauth.OIDCCredentials{
+		Provider:     req.Provider,
+		IDToken:      req.IdToken,
+		Code:         req.Code,
+		RedirectURI:  req.RedirectUri,
+		CodeVerifier: req.CodeVerifier,
+		State:        req.State,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check account security (locked, inactive, suspended)
+	if err := s.loginHandler.CheckAccountSecurity(ctx, user); err != nil {
+		return nil, err
+	}
+
+	loginRisk := s.assessLogin(ctx, user)
+	if loginRisk.denied() {
+		return nil, s.denyRiskyLogin(ctx, user, loginRisk)
+	}
+
+	return s.issueLogin(ctx, user, loginRisk)
+}
+
+// ListLinkedAccounts lists the external accounts linked to the current user
+func (s *EnhancedUserServiceServer) ListLinkedAccounts(ctx context.Context, req *v1.ListLinkedAccountsRequest) (*v1.ListLinkedAccountsResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, ErrUserNotAuthenticated)
+	}
+
+	accounts, err := s.oauthService.LinkedAccounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListLinkedAccountsResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgLinkedAccountsRetrieved,
+		},
+		Accounts: linkedAccountsToProto(accounts),
+	}, nil
+}
+
+// LinkOIDCAccount links an OIDC identity to the current user
+func (s *EnhancedUserServiceServer) LinkOIDCAccount(ctx context.Context, req *v1.LinkOIDCAccountRequest) (*v1.LinkOIDCAccountResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, ErrUserNotAuthenticated)
+	}
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	accounts, err := s.oauthService.LinkOIDCAccount(ctx, userID, oauth.OIDCCredentials{
+		Provider:     req.Provider,
+		IDToken:      req.IdToken,
+		Code:         req.Code,
+		RedirectURI:  req.RedirectUri,
+		CodeVerifier: req.CodeVerifier,
+		State:        req.State,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.LinkOIDCAccountResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgAccountLinked,
+		},
+		Accounts: linkedAccountsToProto(accounts),
+	}, nil
+}
+
+// UnlinkOIDCAccount removes a provider link from the current user
+func (s *EnhancedUserServiceServer) UnlinkOIDCAccount(ctx context.Context, req *v1.UnlinkOIDCAccountRequest) (*v1.UnlinkOIDCAccountResponse, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, ErrUserNotAuthenticated)
+	}
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	accounts, err := s.oauthService.UnlinkOIDCAccount(ctx, userID, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UnlinkOIDCAccountResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgAccountUnlinked,
+		},
+		Accounts: linkedAccountsToProto(accounts),
+	}, nil
+}
+
+// linkedAccountsToProto converts OAuth accounts without exposing stored tokens
+func linkedAccountsToProto(accounts []*repository.OAuthAccount) []*v1.LinkedAccount {
+	result := make([]*v1.LinkedAccount, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, &v1.LinkedAccount{
+			Provider:          account.Provider,
+			ProviderAccountId: account.ProviderAccountID,
+			LinkedAt:          account.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/risk"
+	"exam-bank-system/apps/backend/internal/service/user/passwordless"
+	"exam-bank-system/apps/backend/internal/util"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// SetPasswordlessService enables email code and magic-link login
+func (s *EnhancedUserServiceServer) SetPasswordlessService(service *passwordless.PasswordlessService) {
+	s.passwordlessService = service
+}
+
+// RequestLoginCode emails a one-time login code and magic link.
+// The response is the same whether or not the email belongs to an account.
+func (s *EnhancedUserServiceServer) RequestLoginCode(ctx context.Context, req *v1.RequestLoginCodeRequest) (*v1.RequestLoginCodeResponse, error) {
+	if s.passwordlessService == nil {
+		return nil, passwordlessError(passwordless.ErrDisabled)
+	}
+	if req.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ErrEmailRequired)
+	}
+
+	if err := s.passwordlessService.RequestLoginCode(ctx, req.Email, passwordlessClient(ctx)); err != nil {
+		return nil, passwordlessError(err)
+	}
+
+	return &v1.RequestLoginCodeResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgLoginCodeSent,
+		},
+		ExpiresInSeconds: int64(s.passwordlessService.CodeTTL().Seconds()),
+	}, nil
+}
+
+// VerifyLoginCode logs in with an emailed code or magic-link token and issues the
+// same session and tokens as Login, including the two-factor step when required
+func (s *EnhancedUserServiceServer) VerifyLoginCode(ctx context.Context, req *v1.VerifyLoginCodeRequest) (*v1.LoginResponse, error) {
+	if s.passwordlessService == nil {
+		return nil, passwordlessError(passwordless.ErrDisabled)
+	}
+
+	client := passwordlessClient(ctx)
+	var user *repository.User
+	var err error
+	switch {
+	case req.LinkToken != "":
+		user, err = s.passwordlessService.VerifyLoginLink(ctx, req.LinkToken, client)
+	case req.Email != "" && req.Code != "":
+		user, err = s.passwordlessService.VerifyLoginCode(ctx, req.Email, req.Code, client)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "email and code, or link_token, are required")
+	}
+	if err != nil {
+		return nil, passwordlessError(err)
+	}
+
+	// Check account security (locked, inactive, suspended)
+	if err := s.loginHandler.CheckAccountSecurity(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// The login code already proved access to the mailbox, which is what an email
+	// step-up would ask for; only refused logins are stopped here
+	loginRisk := s.assessLogin(ctx, user)
+	if loginRisk.denied() {
+		return nil, s.denyRiskyLogin(ctx, user, loginRisk)
+	}
+	loginRisk.satisfyStepUp(risk.StepUpLoginCode)
+
+	return s.issueLogin(ctx, user, loginRisk)
+}
+
+// passwordlessClient binds codes to the requesting device
+func passwordlessClient(ctx context.Context) passwordless.ClientInfo {
+	ipAddress := getClientIP(ctx)
+	userAgent := getUserAgent(ctx)
+	return passwordless.ClientInfo{
+		IPAddress:         ipAddress,
+		UserAgent:         userAgent,
+		DeviceFingerprint: util.GenerateDeviceFingerprint(userAgent, ipAddress, ""),
+	}
+}
+
+// passwordlessError maps service errors to gRPC status codes
+func passwordlessError(err error) error {
+	switch {
+	case errors.Is(err, passwordless.ErrDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, passwordless.ErrInvalidCode), errors.Is(err, passwordless.ErrDeviceMismatch):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "passwordless login failed: %v", err)
+	}
+}
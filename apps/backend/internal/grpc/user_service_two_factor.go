@@ -0,0 +1,261 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"exam-bank-system/apps/backend/internal/middleware"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth/risk"
+	"exam-bank-system/apps/backend/internal/service/user/twofactor"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// SetTwoFactorService enables the TOTP second login step and two-factor management RPCs
+func (s *EnhancedUserServiceServer) SetTwoFactorService(service *twofactor.TwoFactorService) {
+	s.twoFactorService = service
+}
+
+// twoFactorChallengeResponse returns a challenge token in place of the login tokens
+func (s *EnhancedUserServiceServer) twoFactorChallengeResponse(ctx context.Context, user *repository.User, purpose string) (*v1.LoginResponse, error) {
+	challenge, err := s.twoFactorService.StartLoginChallenge(ctx, user, purpose, twoFactorClient(ctx))
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	message := MsgTwoFactorRequired
+	var enrollment *v1.TwoFactorEnrollment
+	if challenge.Enrollment != nil {
+		message = MsgTwoFactorEnrollRequired
+		enrollment = &v1.TwoFactorEnrollment{
+			Secret:          challenge.Enrollment.Secret,
+			ProvisioningUri: challenge.Enrollment.ProvisioningURI,
+		}
+	}
+
+	return &v1.LoginResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: message,
+		},
+		User:                ConvertUserToProto(user),
+		TwoFactorRequired:   true,
+		ChallengeToken:      challenge.Token,
+		ChallengeExpiresAt:  challenge.ExpiresAt.Unix(),
+		TwoFactorEnrollment: enrollment,
+		ChallengeMethod:     risk.StepUpTOTP,
+	}, nil
+}
+
+// VerifyTwoFactorLogin completes a login that returned a two-factor challenge
+func (s *EnhancedUserServiceServer) VerifyTwoFactorLogin(ctx context.Context, req *v1.VerifyTwoFactorLoginRequest) (*v1.LoginResponse, error) {
+	if s.twoFactorService == nil {
+		return nil, twoFactorError(twofactor.ErrDisabled)
+	}
+	if req.ChallengeToken == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_token and code are required")
+	}
+
+	result, err := s.twoFactorService.CompleteLoginChallenge(ctx, req.ChallengeToken, req.Code, twoFactorClient(ctx))
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	// The account may have been locked or deactivated since the password step
+	user, err := s.userRepo.GetByID(ctx, result.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, ErrUserNotFound)
+	}
+	if err := s.loginHandler.CheckAccountSecurity(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, user, result.RecoveryCodes)
+}
+
+// GetTwoFactorStatus returns the current user's two-factor state
+func (s *EnhancedUserServiceServer) GetTwoFactorStatus(ctx context.Context, req *v1.GetTwoFactorStatusRequest) (*v1.GetTwoFactorStatusResponse, error) {
+	user, err := s.twoFactorUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.twoFactorService.GetStatus(ctx, user)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	response := &v1.GetTwoFactorStatusResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgTwoFactorStatusRetrieved,
+		},
+		Enabled:                state.Enabled,
+		Pending:                state.Pending,
+		Enforced:               state.Enforced,
+		RecoveryCodesRemaining: int32(state.RecoveryCodesRemaining),
+	}
+	if state.EnabledAt != nil {
+		response.EnabledAt = state.EnabledAt.Unix()
+	}
+	return response, nil
+}
+
+// BeginTwoFactorEnrollment creates a pending TOTP secret for the current user
+func (s *EnhancedUserServiceServer) BeginTwoFactorEnrollment(ctx context.Context, req *v1.BeginTwoFactorEnrollmentRequest) (*v1.BeginTwoFactorEnrollmentResponse, error) {
+	user, err := s.twoFactorUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.twoFactorService.BeginEnrollment(ctx, user, twoFactorClient(ctx))
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	return &v1.BeginTwoFactorEnrollmentResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgTwoFactorEnrollStarted,
+		},
+		Enrollment: &v1.TwoFactorEnrollment{
+			Secret:          enrollment.Secret,
+			ProvisioningUri: enrollment.ProvisioningURI,
+		},
+	}, nil
+}
+
+// ConfirmTwoFactorEnrollment enables the pending secret and returns recovery codes
+func (s *EnhancedUserServiceServer) ConfirmTwoFactorEnrollment(ctx context.Context, req *v1.ConfirmTwoFactorEnrollmentRequest) (*v1.ConfirmTwoFactorEnrollmentResponse, error) {
+	user, err := s.twoFactorUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.twoFactorService.ConfirmEnrollment(ctx, user.ID, req.Code, twoFactorClient(ctx))
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	return &v1.ConfirmTwoFactorEnrollmentResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgTwoFactorEnabled,
+		},
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTwoFactor removes the current user's factor
+func (s *EnhancedUserServiceServer) DisableTwoFactor(ctx context.Context, req *v1.DisableTwoFactorRequest) (*v1.DisableTwoFactorResponse, error) {
+	user, err := s.twoFactorUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorService.Disable(ctx, user, req.Code, twoFactorClient(ctx)); err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	return &v1.DisableTwoFactorResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgTwoFactorDisabled,
+		},
+	}, nil
+}
+
+// RegenerateRecoveryCodes replaces the current user's recovery codes
+func (s *EnhancedUserServiceServer) RegenerateRecoveryCodes(ctx context.Context, req *v1.RegenerateRecoveryCodesRequest) (*v1.RegenerateRecoveryCodesResponse, error) {
+	user, err := s.twoFactorUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.twoFactorService.RegenerateRecoveryCodes(ctx, user.ID, req.Code, twoFactorClient(ctx))
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	return &v1.RegenerateRecoveryCodesResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgRecoveryCodesRegenerated,
+		},
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// ResetUserTwoFactor removes another user's factor (admin only, enforced by the role interceptor)
+func (s *EnhancedUserServiceServer) ResetUserTwoFactor(ctx context.Context, req *v1.ResetUserTwoFactorRequest) (*v1.ResetUserTwoFactorResponse, error) {
+	admin, err := s.twoFactorUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if _, err := s.userRepo.GetByID(ctx, req.UserId); err != nil {
+		return nil, status.Errorf(codes.NotFound, ErrUserNotFound)
+	}
+
+	if err := s.twoFactorService.Reset(ctx, admin.ID, req.UserId, twoFactorClient(ctx)); err != nil {
+		return nil, twoFactorError(err)
+	}
+
+	return &v1.ResetUserTwoFactorResponse{
+		Response: &common.Response{
+			Success: true,
+			Message: MsgTwoFactorReset,
+		},
+	}, nil
+}
+
+// twoFactorUser loads the authenticated user for two-factor management RPCs
+func (s *EnhancedUserServiceServer) twoFactorUser(ctx context.Context) (*repository.User, error) {
+	if s.twoFactorService == nil {
+		return nil, twoFactorError(twofactor.ErrDisabled)
+	}
+
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, ErrUserNotAuthenticated)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, ErrUserNotFound)
+	}
+	return user, nil
+}
+
+func twoFactorClient(ctx context.Context) twofactor.ClientInfo {
+	return twofactor.ClientInfo{
+		IPAddress: getClientIP(ctx),
+		UserAgent: getUserAgent(ctx),
+	}
+}
+
+// twoFactorError maps two-factor service errors to gRPC status codes
+func twoFactorError(err error) error {
+	switch {
+	case errors.Is(err, twofactor.ErrDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, twofactor.ErrInvalidCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, twofactor.ErrChallengeInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, twofactor.ErrAlreadyEnabled):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, twofactor.ErrNotEnrolled), errors.Is(err, twofactor.ErrEnrollmentNeeded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, twofactor.ErrEnforced):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "two-factor operation failed: %v", err)
+	}
+}
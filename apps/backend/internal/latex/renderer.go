@@ -0,0 +1,686 @@
+package latex
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+// DefaultRenderCacheSize bounds the number of rendered questions kept in memory
+const DefaultRenderCacheSize = 2000
+
+// Image references are swapped for these markers before rendering so that
+// figures survive the walk through the surrounding markup
+const (
+	imageMarkerStart = '\uE000'
+	imageMarkerEnd   = '\uE001'
+)
+
+// paragraphBreak separates flow content; block elements are always surrounded by it
+const paragraphBreak = "\uE002"
+
+var (
+	imageRefPattern    = regexp.MustCompile(`\\begin\{tikzpicture\}[\s\S]*?\\end\{tikzpicture\}|\\includegraphics(?:\[[^\]]*\])?\{[^}]+\}`)
+	choiceCmdPattern   = regexp.MustCompile(`\\choice(TF)?\b(?:\[[^\]]*\])?`)
+	shortansCmdPattern = regexp.MustCompile(`\\shortans(?:\[[^\]]*\])?`)
+	answerMarkPattern  = regexp.MustCompile(`\\(True|False)\b`)
+)
+
+// Commands rendered as inline formatting around their argument
+var inlineCommandTags = map[string]string{
+	"textbf":    "strong",
+	"textit":    "em",
+	"emph":      "em",
+	"underline": "u",
+}
+
+// Commands dropped together with their arguments
+var droppedCommands = map[string]bool{
+	"vspace":       true,
+	"hspace":       true,
+	"label":        true,
+	"setlength":    true,
+	"noindent":     true,
+	"hfill":        true,
+	"centering":    true,
+	"newpage":      true,
+	"allowbreak":   true,
+	"displaystyle": true,
+}
+
+// Environments passed to the client as display math
+var mathEnvironments = map[string]bool{
+	"align": true, "align*": true, "equation": true, "equation*": true,
+	"gather": true, "gather*": true, "cases": true, "array": true,
+}
+
+// Literal characters produced by escaped symbols
+var escapedSymbols = map[byte]string{
+	'%': "%", '&': "&amp;", '_': "_", '#': "#", '$': "$", '{': "{", '}': "}", ' ': " ",
+}
+
+// RenderedChoice is one answer option rendered to HTML. Correctness markers are
+// stripped so the rendering can be shown to students.
+type RenderedChoice struct {
+	Label string `json:"label"`
+	HTML  string `json:"html"`
+}
+
+// RenderedQuestion is question content rendered to sanitised HTML. Math is emitted
+// as KaTeX-ready elements carrying the escaped TeX source in data-latex.
+type RenderedQuestion struct {
+	ContentHTML  string           `json:"content_html"`
+	Choices      []RenderedChoice `json:"choices,omitempty"`
+	SolutionHTML string           `json:"solution_html,omitempty"`
+}
+
+// renderCacheEntry is a cached rendering with the question version it was built from
+type renderCacheEntry struct {
+	stamp    string
+	rendered *RenderedQuestion
+}
+
+// Renderer converts question LaTeX with our custom macros (\choice, \immini,
+// \loigiai, \True, ...) into HTML. Renderings are cached per question version.
+type Renderer struct {
+	bp        *BracketParser
+	mu        sync.Mutex
+	cache     map[string]renderCacheEntry
+	cacheSize int
+}
+
+// NewRenderer creates a new renderer with the default cache size
+func NewRenderer() *Renderer {
+	return &Renderer{
+		bp:        NewBracketParser(),
+		cache:     make(map[string]renderCacheEntry),
+		cacheSize: DefaultRenderCacheSize,
+	}
+}
+
+// RenderQuestion renders a stored question, resolving image references against its
+// uploaded images. The result is shared between callers and must not be modified.
+func (r *Renderer) RenderQuestion(question *entity.Question, images []*entity.QuestionImage) *RenderedQuestion {
+	source := question.RawContent.String
+	if strings.TrimSpace(source) == "" {
+		source = question.Content.String
+	}
+
+	urls := resolveImageURLs(images)
+	id := question.ID.String
+	stamp := renderStamp(question, urls)
+
+	if id != "" {
+		r.mu.Lock()
+		entry, ok := r.cache[id]
+		r.mu.Unlock()
+		if ok && entry.stamp == stamp {
+			return entry.rendered
+		}
+	}
+
+	rendered := r.Render(source, urls)
+
+	if id != "" {
+		r.mu.Lock()
+		if len(r.cache) >= r.cacheSize {
+			// Evict an arbitrary entry; stale versions are replaced on access anyway
+			for key := range r.cache {
+				delete(r.cache, key)
+				break
+			}
+		}
+		r.cache[id] = renderCacheEntry{stamp: stamp, rendered: rendered}
+		r.mu.Unlock()
+	}
+
+	return rendered
+}
+
+// Render renders raw question LaTeX. imageURLs maps image names as produced by the
+// import pipeline (e.g. "QUESTION-1", "SOLUTION-ext-2") to their URLs; references
+// without a URL are rendered as placeholders.
+func (r *Renderer) Render(source string, imageURLs map[string]string) *RenderedQuestion {
+	if envs := r.bp.ExtractEnvironmentContent(source, "ex"); len(envs) > 0 {
+		source = envs[0]
+	}
+	source = NewContentExtractor().RemoveMetadataPatterns(source)
+
+	w := &htmlWriter{bp: r.bp}
+	source = w.replaceImages(source, imageURLs)
+
+	body, solution := r.splitSolution(source)
+	body, choices, trueFalse := r.splitChoices(body)
+	body = r.removeCommandWithArg(body, shortansCmdPattern)
+
+	rendered := &RenderedQuestion{
+		ContentHTML:  w.flow(body, false),
+		SolutionHTML: w.flow(solution, false),
+	}
+	for i, choice := range choices {
+		rendered.Choices = append(rendered.Choices, RenderedChoice{
+			Label: choiceLabel(i, trueFalse),
+			HTML:  w.flow(answerMarkPattern.ReplaceAllString(choice, ""), true),
+		})
+	}
+	return rendered
+}
+
+// splitSolution removes the \loigiai{...} block and returns it separately
+func (r *Renderer) splitSolution(content string) (body, solution string) {
+	start := strings.Index(content, "\\loigiai")
+	if start == -1 {
+		return content, ""
+	}
+	braceStart := skipSpaces(content, start+len("\\loigiai"))
+	if braceStart >= len(content) || content[braceStart] != '{' {
+		return content, ""
+	}
+	solution, end := r.group(content, braceStart)
+	return content[:start] + content[end:], solution
+}
+
+// splitChoices removes the \choice or \choiceTF command and returns its options
+func (r *Renderer) splitChoices(content string) (body string, choices []string, trueFalse bool) {
+	loc := choiceCmdPattern.FindStringSubmatchIndex(content)
+	if loc == nil {
+		return content, nil, false
+	}
+
+	pos := loc[1]
+	for {
+		next := skipSpaces(content, pos)
+		if next >= len(content) || content[next] != '{' {
+			break
+		}
+		choice, end := r.group(content, next)
+		choices = append(choices, strings.TrimSpace(choice))
+		pos = end
+	}
+	return content[:loc[0]] + content[pos:], choices, loc[2] != -1
+}
+
+// removeCommandWithArg removes every match of pattern together with its brace argument
+func (r *Renderer) removeCommandWithArg(content string, pattern *regexp.Regexp) string {
+	for {
+		loc := pattern.FindStringIndex(content)
+		if loc == nil {
+			return content
+		}
+		end := loc[1]
+		if next := skipSpaces(content, end); next < len(content) && content[next] == '{' {
+			_, end = r.group(content, next)
+		}
+		content = content[:loc[0]] + content[end:]
+	}
+}
+
+// group returns the content of the brace group at start and the position after it
+func (r *Renderer) group(content string, start int) (string, int) {
+	inner := r.bp.ExtractContentFromBraces(content, start)
+	end := start + len(inner) + 2
+	if end > len(content) {
+		end = len(content)
+	}
+	return inner, end
+}
+
+// choiceLabel labels MC options A, B, C... and TF statements a, b, c...
+func choiceLabel(i int, trueFalse bool) string {
+	base := 'A'
+	if trueFalse {
+		base = 'a'
+	}
+	if i < 26 {
+		return string(rune(int(base) + i))
+	}
+	return strconv.Itoa(i + 1)
+}
+
+// resolveImageURLs maps uploaded images to their names relative to the question subcount
+func resolveImageURLs(images []*entity.QuestionImage) map[string]string {
+	urls := make(map[string]string, len(images))
+	for _, img := range images {
+		if img == nil || img.Status.String != string(entity.ImageStatusUploaded) {
+			continue
+		}
+		link := safeImageURL(img.DriveURL.String)
+		if link == "" {
+			link = safeImageURL(img.WebContentLink.String)
+		}
+		if link == "" {
+			link = safeImageURL(img.ImagePath.String)
+		}
+		name := imageNameFromPath(img.ImagePath.String)
+		if link == "" || name == "" {
+			continue
+		}
+		urls[name] = link
+	}
+	return urls
+}
+
+// imageNameFromPath extracts "QUESTION-1" or "SOLUTION-ext-2" from a stored image path
+func imageNameFromPath(imagePath string) string {
+	base := path.Base(strings.ReplaceAll(imagePath, "\\", "/"))
+	base = strings.TrimSuffix(base, path.Ext(base))
+	for _, imageType := range []entity.ImageType{entity.ImageTypeQuestion, entity.ImageTypeSolution} {
+		if i := strings.LastIndex(base, string(imageType)+"-"); i != -1 {
+			return base[i:]
+		}
+	}
+	return ""
+}
+
+// safeImageURL accepts absolute http(s) URLs and site-relative paths only
+func safeImageURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	switch {
+	case u.Scheme == "http" || u.Scheme == "https":
+		if u.Host == "" {
+			return ""
+		}
+		return u.String()
+	case u.Scheme == "" && u.Host == "" && strings.HasPrefix(raw, "/") && !strings.HasPrefix(raw, "//"):
+		return u.String()
+	}
+	return ""
+}
+
+// renderStamp identifies the question version and resolved images a rendering was built from
+func renderStamp(question *entity.Question, urls map[string]string) string {
+	var b strings.Builder
+	b.WriteString(question.UpdatedAt.Time.UTC().Format("20060102T150405.000000000"))
+	b.WriteString(strconv.Itoa(len(question.RawContent.String)))
+
+	names := make([]string, 0, len(urls))
+	for name := range urls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString("|" + name + "=" + urls[name])
+	}
+	return b.String()
+}
+
+// htmlWriter walks LaTeX source and emits escaped HTML
+type htmlWriter struct {
+	bp      *BracketParser
+	figures []string
+}
+
+// replaceImages swaps TikZ pictures and \includegraphics for markers, numbering them
+// the same way the import pipeline names the generated images
+func (w *htmlWriter) replaceImages(content string, imageURLs map[string]string) string {
+	solutionStart := strings.Index(content, "\\loigiai")
+	tikzCount, includeCount := 0, 0
+
+	return imageRefPattern.ReplaceAllStringFunc(content, func(match string) string {
+		imageType := entity.ImageTypeQuestion
+		if solutionStart != -1 && strings.Index(content, match) > solutionStart {
+			imageType = entity.ImageTypeSolution
+		}
+
+		var name string
+		if strings.HasPrefix(match, "\\includegraphics") {
+			includeCount++
+			name = fmt.Sprintf("%s-ext-%d", imageType, includeCount)
+		} else {
+			tikzCount++
+			name = fmt.Sprintf("%s-%d", imageType, tikzCount)
+		}
+
+		var figure string
+		if link, ok := imageURLs[name]; ok {
+			figure = fmt.Sprintf(`<figure class="latex-image"><img src="%s" alt="%s" loading="lazy"></figure>`,
+				html.EscapeString(link), html.EscapeString(name))
+		} else {
+			figure = fmt.Sprintf(`<figure class="latex-image latex-image-missing" data-image="%s"></figure>`,
+				html.EscapeString(name))
+		}
+		w.figures = append(w.figures, figure)
+		return string(imageMarkerStart) + strconv.Itoa(len(w.figures)-1) + string(imageMarkerEnd)
+	})
+}
+
+// flow renders content and wraps inline runs in paragraphs. With compact set, a
+// single inline run is returned without a wrapper.
+func (w *htmlWriter) flow(content string, compact bool) string {
+	parts := strings.Split(w.inline(content), paragraphBreak)
+
+	var blocks []string
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if isBlockHTML(part) {
+			blocks = append(blocks, part)
+		} else {
+			blocks = append(blocks, "<p>"+part+"</p>")
+		}
+	}
+
+	if compact && len(blocks) == 1 && strings.HasPrefix(blocks[0], "<p>") {
+		return strings.TrimSuffix(strings.TrimPrefix(blocks[0], "<p>"), "</p>")
+	}
+	return strings.Join(blocks, "")
+}
+
+// inline renders content to HTML, separating block elements with paragraphBreak
+func (w *htmlWriter) inline(s string) string {
+	var b strings.Builder
+	block := func(h string) {
+		b.WriteString(paragraphBreak + h + paragraphBreak)
+	}
+
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case strings.HasPrefix(s[i:], "$$"):
+			if end := strings.Index(s[i+2:], "$$"); end != -1 {
+				block(displayMath(s[i+2 : i+2+end]))
+				i += end + 4
+				continue
+			}
+			b.WriteString("$$")
+			i += 2
+
+		case c == '$':
+			if end := findUnescaped(s, i+1, '$'); end != -1 {
+				b.WriteString(inlineMath(s[i+1 : end]))
+				i = end + 1
+				continue
+			}
+			b.WriteString("$")
+			i++
+
+		case strings.HasPrefix(s[i:], "\\["):
+			if end := strings.Index(s[i+2:], "\\]"); end != -1 {
+				block(displayMath(s[i+2 : i+2+end]))
+				i += end + 4
+				continue
+			}
+			b.WriteString("[")
+			i += 2
+
+		case strings.HasPrefix(s[i:], "\\("):
+			if end := strings.Index(s[i+2:], "\\)"); end != -1 {
+				b.WriteString(inlineMath(s[i+2 : i+2+end]))
+				i += end + 4
+				continue
+			}
+			b.WriteString("(")
+			i += 2
+
+		case strings.HasPrefix(s[i:], "\\\\"):
+			b.WriteString("<br>")
+			i += 2
+
+		case c == '\\':
+			i = w.command(s, i, &b, block)
+
+		case c == '%':
+			// Comment to end of line
+			if end := strings.IndexByte(s[i:], '\n'); end != -1 {
+				i += end + 1
+			} else {
+				i = len(s)
+			}
+
+		case c == '{' || c == '}':
+			i++
+
+		case c == '~':
+			b.WriteString("&nbsp;")
+			i++
+
+		case c == '\n':
+			// A blank line starts a new paragraph
+			next := i + 1
+			for next < len(s) && (s[next] == ' ' || s[next] == '\t' || s[next] == '\r') {
+				next++
+			}
+			if next < len(s) && s[next] == '\n' {
+				b.WriteString(paragraphBreak)
+				for next < len(s) && unicode.IsSpace(rune(s[next])) {
+					next++
+				}
+				i = next
+				continue
+			}
+			b.WriteByte(' ')
+			i++
+
+		case strings.HasPrefix(s[i:], string(imageMarkerStart)):
+			start := i + len(string(imageMarkerStart))
+			end := strings.Index(s[start:], string(imageMarkerEnd))
+			if end == -1 {
+				i = start
+				continue
+			}
+			if n, err := strconv.Atoi(s[start : start+end]); err == nil && n < len(w.figures) {
+				block(w.figures[n])
+			}
+			i = start + end + len(string(imageMarkerEnd))
+
+		default:
+			// Copy a run of plain text
+			end := i + 1
+			for end < len(s) && !strings.ContainsRune("$\\%{}~\n", rune(s[end])) && !strings.HasPrefix(s[end:], string(imageMarkerStart)) {
+				end++
+			}
+			b.WriteString(html.EscapeString(s[i:end]))
+			i = end
+		}
+	}
+
+	return b.String()
+}
+
+// command renders the command starting at s[i] and returns the position after it
+func (w *htmlWriter) command(s string, i int, b *strings.Builder, block func(string)) int {
+	if i+1 < len(s) && !isLetter(s[i+1]) {
+		if sym, ok := escapedSymbols[s[i+1]]; ok {
+			b.WriteString(sym)
+		}
+		return i + 2
+	}
+
+	end := i + 1
+	for end < len(s) && isLetter(s[end]) {
+		end++
+	}
+	name := s[i+1 : end]
+	pos := end
+
+	switch name {
+	case "begin":
+		return w.environment(s, i, pos, block)
+
+	case "immini":
+		var primary, side string
+		primary, pos = w.arg(s, skipOptional(s, skipSpaces(s, pos)))
+		side, pos = w.arg(s, pos)
+		block(`<div class="latex-immini"><div class="latex-immini-main">` + w.flow(primary, false) +
+			`</div><div class="latex-immini-side">` + w.flow(side, false) + `</div></div>`)
+		return pos
+
+	case "newline", "par":
+		b.WriteString("<br>")
+		return pos
+	}
+
+	if tag, ok := inlineCommandTags[name]; ok {
+		var inner string
+		inner, pos = w.arg(s, pos)
+		b.WriteString("<" + tag + ">" + w.inline(inner) + "</" + tag + ">")
+		return pos
+	}
+
+	if droppedCommands[name] {
+		pos = skipOptional(s, pos)
+		for next := skipSpaces(s, pos); next < len(s) && s[next] == '{'; next = skipSpaces(s, pos) {
+			_, pos = w.arg(s, pos)
+		}
+		return pos
+	}
+
+	// Unknown commands keep the text of their arguments
+	pos = skipOptional(s, pos)
+	for pos < len(s) && s[pos] == '{' {
+		inner, next := w.arg(s, pos)
+		b.WriteString(w.inline(inner))
+		pos = next
+	}
+	return pos
+}
+
+// environment renders \begin{name}...\end{name} starting at s[start]; pos is after \begin
+func (w *htmlWriter) environment(s string, start, pos int, block func(string)) int {
+	name, pos := w.arg(s, pos)
+	pos = skipOptional(s, pos)
+	beginTag := "\\begin{" + name + "}"
+	endTag := "\\end{" + name + "}"
+
+	endPos := w.bp.findMatchingEndTag(s, pos, beginTag, endTag)
+	if endPos == -1 {
+		return pos
+	}
+	inner := s[pos:endPos]
+	next := endPos + len(endTag)
+
+	switch {
+	case mathEnvironments[name]:
+		block(displayMath(s[start:next]))
+	case name == "itemize" || name == "enumerate":
+		tag := "ul"
+		if name == "enumerate" {
+			tag = "ol"
+		}
+		var items strings.Builder
+		for _, item := range w.splitItems(inner) {
+			items.WriteString("<li>" + w.flow(item, true) + "</li>")
+		}
+		block("<" + tag + ">" + items.String() + "</" + tag + ">")
+	case name == "center":
+		block(`<div class="latex-center">` + w.flow(inner, false) + `</div>`)
+	default:
+		block(`<div class="latex-env" data-env="` + html.EscapeString(name) + `">` + w.flow(inner, false) + `</div>`)
+	}
+	return next
+}
+
+// splitItems splits list content on top-level \item commands
+func (w *htmlWriter) splitItems(content string) []string {
+	var items []string
+	depth := 0
+	current := -1
+	for i := 0; i < len(content); i++ {
+		switch {
+		case strings.HasPrefix(content[i:], "\\begin{"):
+			depth++
+		case strings.HasPrefix(content[i:], "\\end{"):
+			depth--
+		case depth == 0 && strings.HasPrefix(content[i:], "\\item") &&
+			(i+5 >= len(content) || !isLetter(content[i+5])):
+			if current != -1 {
+				items = append(items, content[current:i])
+			}
+			current = skipOptional(content, i+5)
+		}
+	}
+	if current != -1 {
+		items = append(items, content[current:])
+	}
+	return items
+}
+
+// arg reads the brace argument at or after pos (skipping spaces); a missing argument is empty
+func (w *htmlWriter) arg(s string, pos int) (string, int) {
+	next := skipSpaces(s, pos)
+	if next >= len(s) || s[next] != '{' {
+		return "", pos
+	}
+	inner := w.bp.ExtractContentFromBraces(s, next)
+	end := next + len(inner) + 2
+	if end > len(s) {
+		end = len(s)
+	}
+	return inner, end
+}
+
+func inlineMath(tex string) string {
+	tex = strings.TrimSpace(tex)
+	escaped := html.EscapeString(tex)
+	return `<span class="math-inline" data-latex="` + escaped + `">` + escaped + `</span>`
+}
+
+func displayMath(tex string) string {
+	tex = strings.TrimSpace(tex)
+	escaped := html.EscapeString(tex)
+	return `<div class="math-display" data-latex="` + escaped + `">` + escaped + `</div>`
+}
+
+// isBlockHTML reports whether rendered output starts with one of our block elements
+func isBlockHTML(s string) bool {
+	for _, tag := range []string{"<div", "<ul>", "<ol>", "<figure"} {
+		if strings.HasPrefix(s, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// findUnescaped finds the next c at or after from that is not preceded by a backslash
+func findUnescaped(s string, from int, c byte) int {
+	for i := from; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// skipOptional skips an optional [..] argument at pos
+func skipOptional(s string, pos int) int {
+	if pos < len(s) && s[pos] == '[' {
+		if end := strings.IndexByte(s[pos:], ']'); end != -1 {
+			return pos + end + 1
+		}
+	}
+	return pos
+}
+
+func skipSpaces(s string, pos int) int {
+	for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t' || s[pos] == '\n' || s[pos] == '\r') {
+		pos++
+	}
+	return pos
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,134 @@
+package latex
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"github.com/jackc/pgtype"
+)
+
+const rendererTestMC = `%[0P1N1-1]
+\begin{ex}%[Nguồn: Đề thi thử]
+	Cho hàm số $y=x^2$ và \textbf{đồ thị} <b>(P)</b>.
+	\immini[thm]{Tìm giá trị nhỏ nhất:
+	$$\min_{x} y$$}{\begin{tikzpicture}\draw (0,0)--(1,1);\end{tikzpicture}}
+	\choice
+	{$0$}
+	{\True $1$}
+	{$2$}
+	{$3$}
+	\loigiai{Ta có $y\ge 0$.\\Hình vẽ: \includegraphics[width=3cm]{hinh.png}}
+\end{ex}`
+
+func TestRender_MultipleChoice(t *testing.T) {
+	r := NewRenderer()
+	rendered := r.Render(rendererTestMC, map[string]string{
+		"QUESTION-1": "https://cdn.example.com/q/TL.1-QUESTION-1.png",
+	})
+
+	content := rendered.ContentHTML
+	for _, want := range []string{
+		`<span class="math-inline" data-latex="y=x^2">y=x^2</span>`,
+		`<strong>đồ thị</strong>`,
+		`&lt;b&gt;(P)&lt;/b&gt;`,
+		`<div class="latex-immini"><div class="latex-immini-main">`,
+		`<div class="math-display" data-latex="\min_{x} y">`,
+		`<img src="https://cdn.example.com/q/TL.1-QUESTION-1.png" alt="QUESTION-1" loading="lazy">`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q:\n%s", want, content)
+		}
+	}
+	for _, leaked := range []string{"0P1N1-1", "Nguồn", "\\choice", "\\True", "loigiai", "<b>"} {
+		if strings.Contains(content, leaked) {
+			t.Errorf("content leaks %q:\n%s", leaked, content)
+		}
+	}
+
+	if len(rendered.Choices) != 4 {
+		t.Fatalf("expected 4 choices, got %d", len(rendered.Choices))
+	}
+	if got := rendered.Choices[1]; got.Label != "B" || got.HTML != `<span class="math-inline" data-latex="1">1</span>` {
+		t.Errorf("unexpected choice B: %+v", got)
+	}
+
+	solution := rendered.SolutionHTML
+	if !strings.Contains(solution, "<br>") || !strings.Contains(solution, `data-image="SOLUTION-ext-1"`) {
+		t.Errorf("unexpected solution: %s", solution)
+	}
+}
+
+func TestRender_TrueFalseAndLists(t *testing.T) {
+	r := NewRenderer()
+	rendered := r.Render(`\begin{ex}
+Xét các mệnh đề sau.
+
+\begin{itemize}
+\item Mệnh đề \textit{một}
+\item Mệnh đề hai
+\end{itemize}
+\choiceTF
+{\True $a>0$}
+{$b<0$ \False}
+{$c=0$}
+\shortans{42}
+\end{ex}`, nil)
+
+	if !strings.HasPrefix(rendered.ContentHTML, "<p>Xét các mệnh đề sau.</p><ul><li>Mệnh đề <em>một</em></li>") {
+		t.Errorf("unexpected content: %s", rendered.ContentHTML)
+	}
+	if strings.Contains(rendered.ContentHTML, "42") {
+		t.Errorf("short answer leaked: %s", rendered.ContentHTML)
+	}
+	if len(rendered.Choices) != 3 || rendered.Choices[0].Label != "a" || rendered.Choices[2].Label != "c" {
+		t.Fatalf("unexpected choices: %+v", rendered.Choices)
+	}
+	for _, choice := range rendered.Choices {
+		if strings.Contains(choice.HTML, "True") || strings.Contains(choice.HTML, "False") {
+			t.Errorf("answer marker leaked: %s", choice.HTML)
+		}
+	}
+}
+
+func TestResolveImageURLs(t *testing.T) {
+	text := func(s string) pgtype.Text { return pgtype.Text{String: s, Status: pgtype.Present} }
+
+	urls := resolveImageURLs([]*entity.QuestionImage{
+		{ImagePath: text("/uploads/TL.1-QUESTION-1.png"), DriveURL: text("https://drive.example.com/a"), Status: text("UPLOADED")},
+		{ImagePath: text("/uploads/TL.1-SOLUTION-ext-2.png"), DriveURL: text("javascript:alert(1)"), Status: text("UPLOADED")},
+		{ImagePath: text("/uploads/TL.1-QUESTION-3.png"), Status: text("PENDING")},
+	})
+
+	if urls["QUESTION-1"] != "https://drive.example.com/a" {
+		t.Errorf("expected drive URL, got %q", urls["QUESTION-1"])
+	}
+	// Unsafe URLs fall back to the stored path
+	if urls["SOLUTION-ext-2"] != "/uploads/TL.1-SOLUTION-ext-2.png" {
+		t.Errorf("expected path fallback, got %q", urls["SOLUTION-ext-2"])
+	}
+	if _, ok := urls["QUESTION-3"]; ok {
+		t.Error("images not yet uploaded must not resolve")
+	}
+}
+
+func TestRenderQuestion_CachedPerVersion(t *testing.T) {
+	r := NewRenderer()
+	question := &entity.Question{
+		ID:         pgtype.Text{String: "q-1", Status: pgtype.Present},
+		RawContent: pgtype.Text{String: `\begin{ex}Một\end{ex}`, Status: pgtype.Present},
+		UpdatedAt:  pgtype.Timestamptz{Time: time.Unix(100, 0), Status: pgtype.Present},
+	}
+
+	first := r.RenderQuestion(question, nil)
+	if second := r.RenderQuestion(question, nil); second != first {
+		t.Error("expected cached rendering for unchanged question")
+	}
+
+	question.RawContent.String = `\begin{ex}Hai\end{ex}`
+	question.UpdatedAt.Time = time.Unix(200, 0)
+	if updated := r.RenderQuestion(question, nil); updated == first || updated.ContentHTML != "<p>Hai</p>" {
+		t.Errorf("expected re-render after update, got %+v", updated)
+	}
+}
@@ -55,6 +55,41 @@ func initializeAuditConfig() map[string]AuditConfig {
 			LogResponse:  false,
 			LogOnFailure: true,
 		},
+		"/v1.UserService/OIDCLogin": {
+			Action:       "OIDC_LOGIN",
+			Resource:     "AUTH",
+			LogRequest:   false,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.UserService/LinkOIDCAccount": {
+			Action:       "OIDC_ACCOUNT_LINKED",
+			Resource:     "USER",
+			LogRequest:   false,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.UserService/UnlinkOIDCAccount": {
+			Action:       "OIDC_ACCOUNT_UNLINKED",
+			Resource:     "USER",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.UserService/VerifyTwoFactorLogin": {
+			Action:       "TWO_FACTOR_LOGIN",
+			Resource:     "AUTH",
+			LogRequest:   false, // Don't log codes
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.UserService/VerifyLoginCode": {
+			Action:       "PASSWORDLESS_LOGIN",
+			Resource:     "AUTH",
+			LogRequest:   false, // Don't log codes
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
 		"/v1.UserService/Register": {
 			Action:       "USER_REGISTER",
 			Resource:     "USER",
@@ -85,6 +120,157 @@ func initializeAuditConfig() map[string]AuditConfig {
 			LogResponse:  true,
 			LogOnFailure: true,
 		},
+		"/v1.AdminService/CreatePermission": {
+			Action:       "CREATE_PERMISSION",
+			Resource:     "PERMISSION",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/SetRolePermissions": {
+			Action:       "SET_ROLE_PERMISSIONS",
+			Resource:     "PERMISSION",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/SetMethodPermission": {
+			Action:       "SET_METHOD_PERMISSION",
+			Resource:     "PERMISSION",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/GrantPermission": {
+			Action:       "GRANT_PERMISSION",
+			Resource:     "USER",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/RevokePermissionGrant": {
+			Action:       "REVOKE_PERMISSION_GRANT",
+			Resource:     "USER",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/CreateServiceAccount": {
+			Action:       "CREATE_SERVICE_ACCOUNT",
+			Resource:     "SERVICE_ACCOUNT",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/DisableServiceAccount": {
+			Action:       "DISABLE_SERVICE_ACCOUNT",
+			Resource:     "SERVICE_ACCOUNT",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/CreateApiKey": {
+			Action:       "CREATE_API_KEY",
+			Resource:     "API_KEY",
+			LogRequest:   true,
+			LogResponse:  false, // Contains the key secret
+			LogOnFailure: true,
+		},
+		"/v1.AdminService/RevokeApiKey": {
+			Action:       "REVOKE_API_KEY",
+			Resource:     "API_KEY",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+
+		// Organisation and class management
+		"/v1.OrganisationService/CreateOrganisation": {
+			Action:       "CREATE_ORGANISATION",
+			Resource:     "ORGANISATION",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/SetOrganisationMember": {
+			Action:       "SET_ORGANISATION_MEMBER",
+			Resource:     "ORGANISATION",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/RemoveOrganisationMember": {
+			Action:       "REMOVE_ORGANISATION_MEMBER",
+			Resource:     "ORGANISATION",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/AssignToOrganisation": {
+			Action:       "ASSIGN_TO_ORGANISATION",
+			Resource:     "ORGANISATION",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/CreateClass": {
+			Action:       "CREATE_CLASS",
+			Resource:     "CLASS",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/ResetClassJoinCode": {
+			Action:       "RESET_CLASS_JOIN_CODE",
+			Resource:     "CLASS",
+			LogRequest:   true,
+			LogResponse:  false, // Don't log the new join code
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/AddClassMember": {
+			Action:       "ADD_CLASS_MEMBER",
+			Resource:     "CLASS",
+			LogRequest:   true,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/RemoveClassMember": {
+			Action:       "REMOVE_CLASS_MEMBER",
+			Resource:     "CLASS",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.OrganisationService/ImportClassRoster": {
+			Action:       "IMPORT_CLASS_ROSTER",
+			Resource:     "CLASS",
+			LogRequest:   false, // Roster files contain student emails
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+
+		// Guardian access to student progress
+		"/v1.GuardianService/RequestGuardianLink": {
+			Action:       "REQUEST_GUARDIAN_LINK",
+			Resource:     "GUARDIAN_LINK",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.GuardianService/RespondGuardianLink": {
+			Action:       "RESPOND_GUARDIAN_LINK",
+			Resource:     "GUARDIAN_LINK",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
+		"/v1.GuardianService/RevokeGuardianLink": {
+			Action:       "REVOKE_GUARDIAN_LINK",
+			Resource:     "GUARDIAN_LINK",
+			LogRequest:   true,
+			LogResponse:  false,
+			LogOnFailure: true,
+		},
 
 		// Question management
 		"/v1.QuestionService/CreateQuestion": {
@@ -161,6 +347,36 @@ func initializeAuditConfig() map[string]AuditConfig {
 			LogResponse:  true,
 			LogOnFailure: true,
 		},
+
+		// Personal data export and account deletion
+		"/v1.ProfileService/RequestDataExport": {
+			Action:       "REQUEST_DATA_EXPORT",
+			Resource:     "PRIVACY",
+			LogRequest:   false,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.ProfileService/DownloadDataExport": {
+			Action:       "DOWNLOAD_DATA_EXPORT",
+			Resource:     "PRIVACY",
+			LogRequest:   true,
+			LogResponse:  false, // Don't log the archive
+			LogOnFailure: true,
+		},
+		"/v1.ProfileService/DeleteAccount": {
+			Action:       "REQUEST_ACCOUNT_DELETION",
+			Resource:     "PRIVACY",
+			LogRequest:   false, // Contains the confirmation email
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
+		"/v1.ProfileService/CancelAccountDeletion": {
+			Action:       "CANCEL_ACCOUNT_DELETION",
+			Resource:     "PRIVACY",
+			LogRequest:   false,
+			LogResponse:  true,
+			LogOnFailure: true,
+		},
 	}
 }
 
@@ -226,8 +442,21 @@ func (a *AuditLogInterceptor) Unary() grpc.UnaryServerInterceptor {
 
 			// Create audit log entry
 			metadata := map[string]interface{}{
-				"method":   info.FullMethod,
-				"duration": duration.Milliseconds(),
+				"method":     info.FullMethod,
+				"duration":   duration.Milliseconds(),
+				"actor_type": "ANONYMOUS",
+			}
+
+			// Service account calls name the key that made them
+			if userID != "" {
+				metadata["actor_type"] = "USER"
+			}
+			if key, ok := GetAPIKeyFromContext(ctx); ok {
+				metadata["actor_type"] = "API_KEY"
+				metadata["api_key_id"] = key.KeyID
+				metadata["api_key_prefix"] = key.Prefix
+				metadata["api_key_name"] = key.KeyName
+				metadata["service_account"] = key.AccountName
 			}
 
 			// Only add request/response data if they exist
@@ -328,6 +557,7 @@ func sanitizeRequestData(data map[string]interface{}, method string) {
 // Remove sensitive data from response logs
 func sanitizeResponseData(data map[string]interface{}, method string) {
 	// Remove tokens from responses
+	delete(data, "secret")
 	delete(data, "access_token")
 	delete(data, "refresh_token")
 	delete(data, "session_token")
@@ -8,6 +8,9 @@ const (
 	AuthorizationHeader = "authorization"
 	BearerPrefix        = "Bearer "
 
+	// APIKeyHeader carries a service account API key when no Authorization header is sent
+	APIKeyHeader = "x-api-key"
+
 	// Error messages
 	ErrMetadataNotProvided        = "metadata is not provided"
 	ErrAuthTokenNotProvided       = "authorization token is not provided"
@@ -26,4 +29,5 @@ const (
 	ErrInvalidCSRFToken           = "invalid CSRF token"
 	ErrRateLimitExceeded          = "rate limit exceeded"
 	ErrResourceAccessDenied       = "resource access denied"
+	ErrAPIKeyVerificationFailed   = "failed to verify API key, please try again later"
 )
@@ -2,11 +2,12 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"strings"
 
-	"exam-bank-system/apps/backend/internal/constant"
 	"exam-bank-system/apps/backend/internal/repository"
 	"exam-bank-system/apps/backend/internal/service/auth"
+	"exam-bank-system/apps/backend/internal/service/auth/apikey"
 	"exam-bank-system/apps/backend/internal/service/user/session"
 
 	"google.golang.org/grpc"
@@ -25,11 +26,20 @@ const (
 	userLevelKey contextKey = "user_level"
 )
 
+// apiKeyPrincipalKey carries the API key of service account requests
+type apiKeyPrincipalKey struct{}
+
 // Public endpoints that don't require authentication (available to everyone including non-authenticated users)
 var ignoreAuthEndpoints = []string{
 	"/v1.UserService/Login",
 	"/v1.UserService/Register",
 	"/v1.UserService/GoogleLogin",
+	"/v1.UserService/VerifyTwoFactorLogin", // Second login step, authenticated by the challenge token
+	"/v1.UserService/RequestLoginCode",     // Passwordless login
+	"/v1.UserService/VerifyLoginCode",      // Passwordless login
+	"/v1.UserService/ListOIDCProviders",    // Login page provider buttons
+	"/v1.UserService/StartOIDCLogin",
+	"/v1.UserService/OIDCLogin",
 	"/v1.UserService/RefreshToken",
 	"/v1.UserService/ForgotPassword",
 	"/v1.UserService/VerifyEmail",
@@ -43,84 +53,16 @@ var ignoreAuthEndpoints = []string{
 	"/v1.CourseService/GetPublicCourses",     // GUEST cÃ³ thá»ƒ xem course preview
 }
 
-// Role-based access control (RBAC) configuration vá»›i 5 roles hierarchy
-// Hierarchy: GUEST < STUDENT < TUTOR < TEACHER < ADMIN
-var rbacDecider = map[string][]string{
-	// User Management APIs
-	"/v1.UserService/GetUser":        {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.UserService/ListUsers":      {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.UserService/GetStudentList": {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor}, // Tutors cáº§n xem danh sÃ¡ch há»c sinh
-
-	// Question Management APIs
-	"/v1.QuestionService/CreateQuestion":  {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.QuestionService/UpdateQuestion":  {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.QuestionService/DeleteQuestion":  {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.QuestionService/GetQuestion":     {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.QuestionService/ListQuestions":   {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.QuestionService/ImportQuestions": {constant.RoleAdmin, constant.RoleTeacher},
-
-	// Question Filter Service APIs - Táº¥t cáº£ authenticated users cÃ³ thá»ƒ search questions
-	"/v1.QuestionFilterService/ListQuestionsByFilter":      {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.QuestionFilterService/SearchQuestions":            {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.QuestionFilterService/GetQuestionsByQuestionCode": {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-
-	// Exam Management APIs
-	"/v1.ExamService/CreateExam": {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.ExamService/UpdateExam": {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.ExamService/DeleteExam": {constant.RoleAdmin, constant.RoleTeacher},
-	"/v1.ExamService/GetExam":    {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.ExamService/ListExams":  {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-
-	// Exam Attempt APIs - Students vÃ  Tutors cÃ³ thá»ƒ lÃ m bÃ i thi
-	"/v1.ExamService/StartExam":  {constant.RoleStudent, constant.RoleTutor},
-	"/v1.ExamService/SubmitExam": {constant.RoleStudent, constant.RoleTutor},
-	"/v1.ExamService/GetResults": {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-
-	// Profile & Session Management APIs - Táº¥t cáº£ authenticated users
-	"/v1.ProfileService/GetProfile":        {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.ProfileService/UpdateProfile":     {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.ProfileService/GetSessions":       {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.ProfileService/TerminateSession":  {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.ProfileService/GetPreferences":    {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-	"/v1.ProfileService/UpdatePreferences": {constant.RoleAdmin, constant.RoleTeacher, constant.RoleTutor, constant.RoleStudent},
-
-	// Contact Management APIs (Admin only)
-	"/v1.ContactService/ListContacts":        {constant.RoleAdmin},
-	"/v1.ContactService/GetContact":          {constant.RoleAdmin},
-	"/v1.ContactService/UpdateContactStatus": {constant.RoleAdmin},
-	"/v1.ContactService/DeleteContact":       {constant.RoleAdmin},
-
-	// Newsletter Management APIs (Admin only)
-	"/v1.NewsletterService/ListSubscriptions":      {constant.RoleAdmin},
-	"/v1.NewsletterService/GetSubscription":        {constant.RoleAdmin},
-	"/v1.NewsletterService/UpdateSubscriptionTags": {constant.RoleAdmin},
-	"/v1.NewsletterService/DeleteSubscription":     {constant.RoleAdmin},
-
-	// Admin Service APIs (Admin only)
-	"/v1.AdminService/ListUsers":         {constant.RoleAdmin},
-	"/v1.AdminService/UpdateUserRole":    {constant.RoleAdmin},
-	"/v1.AdminService/UpdateUserLevel":   {constant.RoleAdmin},
-	"/v1.AdminService/UpdateUserStatus":  {constant.RoleAdmin},
-	"/v1.AdminService/GetAuditLogs":      {constant.RoleAdmin},
-	"/v1.AdminService/GetResourceAccess": {constant.RoleAdmin},
-
-	// GUEST-specific endpoints - Giá»›i háº¡n content cho authenticated GUEST users
-	"/v1.QuestionService/GetQuestionPreview": {constant.RoleGuest, constant.RoleStudent, constant.RoleTutor, constant.RoleTeacher, constant.RoleAdmin},
-	"/v1.ExamService/GetExamPreview":         {constant.RoleGuest, constant.RoleStudent, constant.RoleTutor, constant.RoleTeacher, constant.RoleAdmin},
-	"/v1.CourseService/GetCoursePreview":     {constant.RoleGuest, constant.RoleStudent, constant.RoleTutor, constant.RoleTeacher, constant.RoleAdmin},
-
-	// Level-based content access (sáº½ Ä‘Æ°á»£c kiá»ƒm tra thÃªm trong interceptor logic)
-	// Higher level users can access lower level content within same role
-}
-
 type AuthInterceptor struct {
-	authService     *auth.AuthMgmt
-	sessionService  *session.SessionService
-	userRepo        repository.IUserRepository
-	publicMethods   map[string]bool
-	roleBasedAccess map[string][]string
-	enableOAuth     bool
-	enableSession   bool
+	authService    *auth.AuthMgmt
+	sessionService *session.SessionService
+	userRepo       repository.IUserRepository
+	publicMethods  map[string]bool
+	enableOAuth    bool
+	enableSession  bool
+
+	// Service account API keys; set via SetAPIKeyService
+	apiKeyService *apikey.Service
 }
 
 func NewAuthInterceptor(
@@ -135,16 +77,20 @@ func NewAuthInterceptor(
 	}
 
 	return &AuthInterceptor{
-		authService:     authService,
-		sessionService:  sessionService,
-		userRepo:        userRepo,
-		publicMethods:   publicMethods,
-		roleBasedAccess: rbacDecider,
-		enableOAuth:     true,
-		enableSession:   true,
+		authService:    authService,
+		sessionService: sessionService,
+		userRepo:       userRepo,
+		publicMethods:  publicMethods,
+		enableOAuth:    true,
+		enableSession:  true,
 	}
 }
 
+// SetAPIKeyService enables authentication with service account API keys
+func (interceptor *AuthInterceptor) SetAPIKeyService(apiKeyService *apikey.Service) {
+	interceptor.apiKeyService = apiKeyService
+}
+
 func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -165,6 +111,13 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 
 		values := md[AuthorizationHeader]
 		if len(values) == 0 {
+			if keys := md[APIKeyHeader]; len(keys) > 0 && interceptor.apiKeyService != nil {
+				ctx, err := interceptor.authenticateAPIKey(ctx, md, keys[0], info.FullMethod)
+				if err != nil {
+					return nil, err
+				}
+				return handler(ctx, req)
+			}
 			return nil, status.Errorf(codes.Unauthenticated, ErrAuthTokenNotProvided)
 		}
 
@@ -182,23 +135,7 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, status.Errorf(codes.Unauthenticated, ErrInvalidToken, err)
 		}
 
-		// Check role-based authorization
-		allowedRoles, hasRoleRestriction := interceptor.roleBasedAccess[info.FullMethod]
-		if hasRoleRestriction {
-			userRole := claims.Role
-			hasPermission := false
-
-			for _, allowedRole := range allowedRoles {
-				if userRole == allowedRole {
-					hasPermission = true
-					break
-				}
-			}
-
-			if !hasPermission {
-				return nil, status.Errorf(codes.PermissionDenied, ErrInsufficientPermissions, userRole, info.FullMethod)
-			}
-		}
+		// Authorization (role, level and grants) is left to RoleLevelInterceptor
 
 		// Get user level from database if needed
 		var userLevel int
@@ -218,6 +155,35 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	}
 }
 
+// authenticateAPIKey authenticates a service account by API key and checks the key's
+// scopes. Role, level and grants of the account are left to RoleLevelInterceptor.
+func (interceptor *AuthInterceptor) authenticateAPIKey(ctx context.Context, md metadata.MD, rawKey, method string) (context.Context, error) {
+	principal, err := interceptor.apiKeyService.Authenticate(ctx, rawKey, method, extractClientIP(md))
+	switch {
+	case err == nil:
+	case errors.Is(err, apikey.ErrScopeDenied):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, apikey.ErrInvalidKey), errors.Is(err, apikey.ErrKeyExpired), errors.Is(err, apikey.ErrKeyRevoked):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return nil, status.Errorf(codes.Unavailable, ErrAPIKeyVerificationFailed)
+	}
+
+	ctx = context.WithValue(ctx, userIDKey, principal.ServiceAccountID)
+	ctx = context.WithValue(ctx, userEmailKey, principal.Email)
+	ctx = context.WithValue(ctx, userRoleKey, principal.Role)
+	ctx = context.WithValue(ctx, userLevelKey, principal.Level)
+	ctx = context.WithValue(ctx, apiKeyPrincipalKey{}, principal)
+	return ctx, nil
+}
+
+// GetAPIKeyFromContext returns the API key a request was authenticated with; ok is
+// false for user requests
+func GetAPIKeyFromContext(ctx context.Context) (*apikey.Principal, bool) {
+	principal, ok := ctx.Value(apiKeyPrincipalKey{}).(*apikey.Principal)
+	return principal, ok
+}
+
 // GetUserIDFromContext extracts user ID from context
 // TrÃ­ch xuáº¥t user ID tá»« context (Ä‘Æ°á»£c inject bá»Ÿi auth interceptor)
 func GetUserIDFromContext(ctx context.Context) (string, error) {
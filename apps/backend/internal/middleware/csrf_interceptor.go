@@ -25,9 +25,15 @@ func NewCSRFInterceptor(enabled bool) *CSRFInterceptor {
 	// Define public endpoints that don't require CSRF protection
 	publicEndpoints := map[string]bool{
 		// Authentication endpoints (no session yet)
-		"/v1.UserService/Login":       true,
-		"/v1.UserService/Register":    true,
-		"/v1.UserService/GoogleLogin": true,
+		"/v1.UserService/Login":                true,
+		"/v1.UserService/Register":             true,
+		"/v1.UserService/GoogleLogin":          true,
+		"/v1.UserService/VerifyTwoFactorLogin": true,
+		"/v1.UserService/RequestLoginCode":     true,
+		"/v1.UserService/VerifyLoginCode":      true,
+		"/v1.UserService/ListOIDCProviders":    true,
+		"/v1.UserService/StartOIDCLogin":       true,
+		"/v1.UserService/OIDCLogin":            true,
 
 		// Health check
 		"/grpc.health.v1.Health/Check": true,
@@ -83,6 +89,12 @@ func (i *CSRFInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, status.Errorf(codes.InvalidArgument, "missing metadata")
 		}
 
+		// API key requests carry no browser cookies to forge. With an Authorization
+		// header the key is ignored by AuthInterceptor, so the token is still required.
+		if len(md[APIKeyHeader]) > 0 && len(md[AuthorizationHeader]) == 0 {
+			return handler(ctx, req)
+		}
+
 		// Extract CSRF token from x-csrf-token header
 		csrfTokens := md["x-csrf-token"]
 		if len(csrfTokens) == 0 {
@@ -138,6 +138,32 @@ func TestCSRFInterceptor_MissingCSRFHeader(t *testing.T) {
 	assert.Contains(t, st.Message(), "missing CSRF token")
 }
 
+// Test API key requests skip CSRF validation unless they also send a bearer token
+func TestCSRFInterceptor_APIKey(t *testing.T) {
+	interceptor := NewCSRFInterceptor(true) // CSRF enabled
+	info := &grpc.UnaryServerInfo{
+		FullMethod: protectedMethod,
+	}
+
+	md := metadata.New(map[string]string{
+		"x-api-key": "nyk_0a1b2c3d_secret",
+	})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	resp, err := interceptor.Unary()(ctx, nil, info, mockHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, "success", resp)
+
+	md = metadata.New(map[string]string{
+		"x-api-key":     "nyk_0a1b2c3d_secret",
+		"authorization": "Bearer test-token",
+	})
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	_, err = interceptor.Unary()(ctx, nil, info, mockHandler)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
 // Test invalid CSRF token rejection
 func TestCSRFInterceptor_InvalidToken(t *testing.T) {
 	interceptor := NewCSRFInterceptor(true) // CSRF enabled
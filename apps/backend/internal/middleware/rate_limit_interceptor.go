@@ -7,6 +7,8 @@ import (
 	"sync"
 	"time"
 
+	"exam-bank-system/apps/backend/internal/service/auth/apikey"
+
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -26,8 +28,14 @@ type RateLimitInterceptor struct {
 
 	// Cleanup goroutine
 	cleanupTicker *time.Ticker
+
+	// Per-key limits of service account API keys; set via SetAPIKeyService
+	apiKeyService *apikey.Service
 }
 
+// apiKeyLimiterMethod is the limiter key method shared by all RPCs of an API key
+const apiKeyLimiterMethod = "apikey"
+
 // userLimiter stores rate limiter and last access time for cleanup
 type userLimiter struct {
 	limiter    *rate.Limiter
@@ -55,6 +63,11 @@ func NewRateLimitInterceptor() *RateLimitInterceptor {
 	return r
 }
 
+// SetAPIKeyService enables per-key rate limits for requests made with an API key
+func (r *RateLimitInterceptor) SetAPIKeyService(apiKeyService *apikey.Service) {
+	r.apiKeyService = apiKeyService
+}
+
 // Initialize rate limits for different endpoint groups
 func initializeRateLimits() map[string]RateLimitConfig {
 	return map[string]RateLimitConfig{
@@ -64,6 +77,21 @@ func initializeRateLimits() map[string]RateLimitConfig {
 			Burst:             3,     // Allow 3 attempts quickly
 			PerUser:           false, // Limit by IP
 		},
+		"/v1.UserService/VerifyTwoFactorLogin": {
+			RequestsPerSecond: 0.2, // 1 request per 5 seconds
+			Burst:             5,
+			PerUser:           false, // Limit by IP
+		},
+		"/v1.UserService/RequestLoginCode": {
+			RequestsPerSecond: 0.017, // 1 request per minute
+			Burst:             2,
+			PerUser:           false, // Limit by IP
+		},
+		"/v1.UserService/VerifyLoginCode": {
+			RequestsPerSecond: 0.2, // 1 request per 5 seconds
+			Burst:             5,
+			PerUser:           false, // Limit by IP
+		},
 		"/v1.UserService/Register": {
 			RequestsPerSecond: 0.017, // 1 request per minute
 			Burst:             1,
@@ -86,6 +114,16 @@ func initializeRateLimits() map[string]RateLimitConfig {
 			Burst:             5,
 			PerUser:           false,
 		},
+		"/v1.UserService/StartOIDCLogin": {
+			RequestsPerSecond: 0.2, // 1 request per 5 seconds
+			Burst:             5,
+			PerUser:           false,
+		},
+		"/v1.UserService/OIDCLogin": {
+			RequestsPerSecond: 0.2, // 1 request per 5 seconds
+			Burst:             5,
+			PerUser:           false,
+		},
 		"/v1.UserService/RefreshToken": {
 			RequestsPerSecond: 0.5, // 1 request per 2 seconds
 			Burst:             3,
@@ -273,6 +311,20 @@ func (r *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
 			config = r.endpointLimits["default"]
 		}
 
+		// API keys share one bucket across all RPCs, sized by the key's own limit.
+		// IP-limited endpoints such as Login keep their limits.
+		if config.PerUser {
+			if limiter, identifier, ok := r.apiKeyLimiter(ctx); ok {
+				if !limiter.Allow() {
+					fmt.Printf("[RATE_LIMIT] ERROR: EXCEEDED for %s | Identifier: %s | Tokens remaining: %.2f | Key limit: %d/min\n",
+						info.FullMethod, identifier, limiter.Tokens(), limiter.Burst())
+					return nil, status.Errorf(codes.ResourceExhausted,
+						"rate limit exceeded for API key, please try again later")
+				}
+				return handler(ctx, req)
+			}
+		}
+
 		// Get identifier (user ID or IP)
 		identifier := r.getIdentifier(ctx, config.PerUser)
 		if identifier == "" {
@@ -329,6 +381,30 @@ func (r *RateLimitInterceptor) getIdentifier(ctx context.Context, perUser bool)
 	return ""
 }
 
+// apiKeyLimiter returns the limiter of the API key sent with a request. Requests with an
+// Authorization header authenticate as users, so their key header is ignored.
+func (r *RateLimitInterceptor) apiKeyLimiter(ctx context.Context) (*rate.Limiter, string, bool) {
+	if r.apiKeyService == nil {
+		return nil, "", false
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[AuthorizationHeader]) > 0 || len(md[APIKeyHeader]) == 0 {
+		return nil, "", false
+	}
+
+	prefix, perMinute, ok := r.apiKeyService.RateLimitFor(ctx, md[APIKeyHeader][0])
+	if !ok {
+		return nil, "", false
+	}
+	identifier := "key:" + prefix
+	config := RateLimitConfig{
+		RequestsPerSecond: float64(perMinute) / 60,
+		Burst:             perMinute,
+		PerUser:           true,
+	}
+	return r.getLimiter(apiKeyLimiterMethod, identifier, config), identifier, true
+}
+
 // Get or create rate limiter for an identifier
 func (r *RateLimitInterceptor) getLimiter(method string, identifier string, config RateLimitConfig) *rate.Limiter {
 	key := r.limiterKey(method, identifier)
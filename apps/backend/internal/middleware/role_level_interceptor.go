@@ -2,9 +2,11 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 
+	"exam-bank-system/apps/backend/internal/service/auth/rbac"
 	"exam-bank-system/apps/backend/pkg/proto/common"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -44,171 +46,20 @@ const (
 	AccessLevelFull    = "FULL"    // Full access + download
 )
 
-// RoleLevelInterceptor handles role and level based authorization
+// RoleLevelInterceptor handles role and level based authorization.
+// Method requirements, role permission sets and user grants come from the
+// RBAC tables through the cached evaluator.
 type RoleLevelInterceptor struct {
-	rolePermissions map[string]RoleRequirement
+	evaluator *rbac.Evaluator
 }
 
-// RoleRequirement defines role and level requirements for an endpoint
-type RoleRequirement struct {
-	AllowedRoles []common.UserRole
-	MinLevel     int // Minimum level required (0 means no level requirement)
-	MaxLevel     int // Maximum level allowed (0 means no limit)
-}
+// permissionEvaluatorKey carries the evaluator to handlers for HasPermission checks
+type permissionEvaluatorKey struct{}
 
 // NewRoleLevelInterceptor creates a new role-level interceptor
-func NewRoleLevelInterceptor() *RoleLevelInterceptor {
+func NewRoleLevelInterceptor(evaluator *rbac.Evaluator) *RoleLevelInterceptor {
 	return &RoleLevelInterceptor{
-		rolePermissions: initializeRolePermissions(),
-	}
-}
-
-// Initialize role permissions for all endpoints
-func initializeRolePermissions() map[string]RoleRequirement {
-	return map[string]RoleRequirement{
-		// Admin Service - chá»‰ ADMIN
-		"/v1.AdminService/ListUsers": {
-			AllowedRoles: []common.UserRole{common.UserRole_USER_ROLE_ADMIN},
-		},
-		"/v1.AdminService/UpdateUserRole": {
-			AllowedRoles: []common.UserRole{common.UserRole_USER_ROLE_ADMIN},
-		},
-		"/v1.AdminService/UpdateUserLevel": {
-			AllowedRoles: []common.UserRole{common.UserRole_USER_ROLE_ADMIN},
-		},
-		"/v1.AdminService/UpdateUserStatus": {
-			AllowedRoles: []common.UserRole{common.UserRole_USER_ROLE_ADMIN},
-		},
-		"/v1.AdminService/GetAuditLogs": {
-			AllowedRoles: []common.UserRole{common.UserRole_USER_ROLE_ADMIN},
-		},
-		"/v1.AdminService/GetResourceAccess": {
-			AllowedRoles: []common.UserRole{common.UserRole_USER_ROLE_ADMIN},
-		},
-
-		// Question Management - TEACHER vÃ  ADMIN
-		"/v1.QuestionService/CreateQuestion": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 1, // Teacher pháº£i cÃ³ level >= 1
-		},
-		"/v1.QuestionService/UpdateQuestion": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 1,
-		},
-		"/v1.QuestionService/DeleteQuestion": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 3, // Chá»‰ teacher level cao má»›i Ä‘Æ°á»£c xÃ³a
-		},
-
-		// View Questions - Táº¥t cáº£ trá»« GUEST
-		"/v1.QuestionService/GetQuestion": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-				common.UserRole_USER_ROLE_TUTOR,
-				common.UserRole_USER_ROLE_STUDENT,
-			},
-		},
-		"/v1.QuestionService/ListQuestions": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-				common.UserRole_USER_ROLE_TUTOR,
-				common.UserRole_USER_ROLE_STUDENT,
-			},
-		},
-
-		// Exam Management - TEACHER level cao vÃ  ADMIN
-		"/v1.ExamService/CreateExam": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 2, // Teacher pháº£i cÃ³ level >= 2 Ä‘á»ƒ táº¡o exam
-		},
-		"/v1.ExamService/UpdateExam": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 2,
-		},
-		"/v1.ExamService/DeleteExam": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 5, // Chá»‰ teacher level ráº¥t cao má»›i Ä‘Æ°á»£c xÃ³a exam
-		},
-
-		// Exam Taking - STUDENT vÃ  TUTOR
-		"/v1.ExamService/StartExam": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_STUDENT,
-				common.UserRole_USER_ROLE_TUTOR,
-			},
-		},
-		"/v1.ExamService/SubmitExam": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_STUDENT,
-				common.UserRole_USER_ROLE_TUTOR,
-			},
-		},
-
-		// Results - Phá»¥ thuá»™c vÃ o level
-		"/v1.ExamService/GetResults": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-				common.UserRole_USER_ROLE_TUTOR,
-				common.UserRole_USER_ROLE_STUDENT,
-			},
-			// Student chá»‰ xem Ä‘Æ°á»£c káº¿t quáº£ cá»§a mÃ¬nh (check trong handler)
-		},
-
-		// Tutoring Features - TUTOR vá»›i level phÃ¹ há»£p
-		"/v1.TutoringService/CreateStudyGroup": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_TUTOR,
-				common.UserRole_USER_ROLE_TEACHER,
-			},
-			MinLevel: 3, // Tutor pháº£i cÃ³ level >= 3
-		},
-		"/v1.TutoringService/ScheduleTutoring": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_TUTOR,
-			},
-			MinLevel: 2,
-		},
-
-		// Profile Service - Táº¥t cáº£ user Ä‘Ã£ Ä‘Äƒng nháº­p
-		"/v1.ProfileService/GetProfile": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-				common.UserRole_USER_ROLE_TUTOR,
-				common.UserRole_USER_ROLE_STUDENT,
-				common.UserRole_USER_ROLE_GUEST,
-			},
-		},
-		"/v1.ProfileService/UpdateProfile": {
-			AllowedRoles: []common.UserRole{
-				common.UserRole_USER_ROLE_ADMIN,
-				common.UserRole_USER_ROLE_TEACHER,
-				common.UserRole_USER_ROLE_TUTOR,
-				common.UserRole_USER_ROLE_STUDENT,
-				// GUEST khÃ´ng Ä‘Æ°á»£c update profile
-			},
-		},
+		evaluator: evaluator,
 	}
 }
 
@@ -220,67 +71,33 @@ func (r *RoleLevelInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
+		ctx = WithPermissionEvaluator(ctx, r.evaluator)
+
 		// Skip for public endpoints
 		if isPublicEndpoint(info.FullMethod) {
 			return handler(ctx, req)
 		}
 
-		// Get role requirement for this endpoint
-		requirement, hasRequirement := r.rolePermissions[info.FullMethod]
-		if !hasRequirement {
-			// No specific requirement, allow all authenticated users
-			return handler(ctx, req)
+		userRole, roleErr := GetUserRoleFromContext(ctx)
+		userID, _ := GetUserIDFromContext(ctx)
+		subject := rbac.Subject{
+			UserID: userID,
+			Role:   userRole,
+			Level:  getUserLevelFromContext(ctx),
 		}
 
-		// Get user role from context
-		userRole, err := GetUserRoleFromContext(ctx)
-		if err != nil {
+		err := r.evaluator.Authorize(ctx, subject, info.FullMethod, req)
+		switch {
+		case err == nil:
+			return handler(ctx, req)
+		case errors.Is(err, rbac.ErrPolicyUnavailable):
+			// Fail closed rather than allowing every call
+			return nil, status.Errorf(codes.Unavailable, "authorization temporarily unavailable")
+		case roleErr != nil:
 			return nil, status.Errorf(codes.Unauthenticated, "user role not found")
+		default:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		}
-
-		// Convert string role to enum
-		roleEnum := stringToUserRole(userRole)
-
-		// Check if user's role is allowed
-		roleAllowed := false
-		for _, allowedRole := range requirement.AllowedRoles {
-			if roleEnum == allowedRole {
-				roleAllowed = true
-				break
-			}
-		}
-
-		if !roleAllowed {
-			return nil, status.Errorf(codes.PermissionDenied,
-				"role %s is not allowed to access %s", userRole, info.FullMethod)
-		}
-
-		// Check level requirements if applicable
-		if requirement.MinLevel > 0 || requirement.MaxLevel > 0 {
-			// Get user level from context (need to add this to auth interceptor)
-			userLevel := getUserLevelFromContext(ctx)
-
-			// ADMIN vÃ  GUEST khÃ´ng cÃ³ level
-			if roleEnum == common.UserRole_USER_ROLE_ADMIN ||
-				roleEnum == common.UserRole_USER_ROLE_GUEST {
-				// These roles don't have levels, skip level check
-				return handler(ctx, req)
-			}
-
-			// Check minimum level
-			if requirement.MinLevel > 0 && userLevel < requirement.MinLevel {
-				return nil, status.Errorf(codes.PermissionDenied,
-					"insufficient level: required %d, got %d", requirement.MinLevel, userLevel)
-			}
-
-			// Check maximum level
-			if requirement.MaxLevel > 0 && userLevel > requirement.MaxLevel {
-				return nil, status.Errorf(codes.PermissionDenied,
-					"level too high: maximum %d, got %d", requirement.MaxLevel, userLevel)
-			}
-		}
-
-		return handler(ctx, req)
 	}
 }
 
@@ -565,14 +382,36 @@ func CheckResourceOwnership(ctx context.Context, resourceOwnerID string) bool {
 	return userID == resourceOwnerID
 }
 
-// HasSpecialPermission checks for special permissions like course enrollment
+// WithPermissionEvaluator sets the evaluator HasPermission checks against.
+// The role-level interceptor sets it for every call; intended for tests and internal use.
+func WithPermissionEvaluator(ctx context.Context, evaluator *rbac.Evaluator) context.Context {
+	return context.WithValue(ctx, permissionEvaluatorKey{}, evaluator)
+}
+
+// HasPermission checks whether the current user holds permission through their
+// role or an active grant; resourceID limits the match to grants for that resource
+func HasPermission(ctx context.Context, permission, resourceType, resourceID string) bool {
+	evaluator, ok := ctx.Value(permissionEvaluatorKey{}).(*rbac.Evaluator)
+	if !ok || evaluator == nil {
+		return false
+	}
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	userRole, _ := GetUserRoleFromContext(ctx)
+
+	allowed, err := evaluator.HasPermission(ctx, rbac.Subject{
+		UserID: userID,
+		Role:   userRole,
+		Level:  getUserLevelFromContext(ctx),
+	}, permission, resourceType, resourceID)
+	return err == nil && allowed
+}
+
+// HasSpecialPermission checks for a grant of permissionType on a resource of any type
 func HasSpecialPermission(ctx context.Context, permissionType string, resourceID string) bool {
-	// This would typically check against database for:
-	// - Course enrollments
-	// - Special access grants
-	// - Temporary permissions
-	// For now, return false as placeholder
-	return false
+	return HasPermission(ctx, permissionType, "", resourceID)
 }
 
 // GetResourceAccessLevel determines the access level for a resource
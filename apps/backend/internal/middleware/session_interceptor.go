@@ -115,6 +115,12 @@ func isPublicEndpoint(method string) bool {
 		"/v1.UserService/Login",
 		"/v1.UserService/Register",
 		"/v1.UserService/GoogleLogin",
+		"/v1.UserService/VerifyTwoFactorLogin",
+		"/v1.UserService/RequestLoginCode",
+		"/v1.UserService/VerifyLoginCode",
+		"/v1.UserService/ListOIDCProviders",
+		"/v1.UserService/StartOIDCLogin",
+		"/v1.UserService/OIDCLogin",
 		"/v1.UserService/RefreshToken",
 		"/v1.UserService/ForgotPassword",
 		"/grpc.health.v1.Health/Check",
@@ -140,9 +140,14 @@ func (c *Client) CreateIndex(ctx context.Context, indexName string, settings map
 		return nil
 	}
 
-	// Add Vietnamese analysis settings if enabled
+	// Add Vietnamese analysis settings if enabled. A body with mappings keeps its
+	// index settings under "settings".
 	if c.config.IsVietnameseAnalysisEnabled() {
-		settings = c.addVietnameseAnalysisSettings(settings)
+		if inner, ok := settings["settings"].(map[string]interface{}); ok {
+			settings["settings"] = c.addVietnameseAnalysisSettings(inner)
+		} else {
+			settings = c.addVietnameseAnalysisSettings(settings)
+		}
 	}
 
 	settingsJSON, err := json.Marshal(settings)
@@ -315,24 +320,24 @@ func (c *Client) addVietnameseAnalysisSettings(settings map[string]interface{})
 		"vietnamese_stop_words": map[string]interface{}{
 			"type": "stop",
 			"stopwords": []string{
-				"vÃ ", "cá»§a", "cÃ³", "lÃ ", "Ä‘Æ°á»£c", "trong", "vá»›i", "Ä‘á»ƒ", "tá»«", "theo",
-				"vá»", "cho", "khi", "nhÆ°", "Ä‘Ã£", "sáº½", "bá»‹", "bá»Ÿi", "táº¡i", "trÃªn",
-				"dÆ°á»›i", "giá»¯a", "ngoÃ i", "sau", "trÆ°á»›c", "lÃºc", "láº§n", "cÃ¡c", "nhá»¯ng",
-				"má»—i", "táº¥t", "cáº£", "má»™t", "hai", "ba", "nhiá»u", "Ã­t", "ráº¥t", "khÃ¡",
+				"và", "của", "có", "là", "được", "trong", "với", "để", "từ", "theo",
+				"về", "cho", "khi", "như", "đã", "sẽ", "bị", "bởi", "tại", "trên",
+				"dưới", "giữa", "ngoài", "sau", "trước", "lúc", "lần", "các", "những",
+				"mỗi", "tất", "cả", "một", "hai", "ba", "nhiều", "ít", "rất", "khá",
 			},
 		},
 		"education_synonyms": map[string]interface{}{
 			"type": "synonym",
 			"synonyms": []string{
-				"toÃ¡n,toÃ¡n há»c,mathematics,math",
-				"lÃ½,váº­t lÃ½,physics",
-				"hÃ³a,hÃ³a há»c,chemistry",
-				"sinh,sinh há»c,biology",
-				"vÄƒn,ngá»¯ vÄƒn,literature",
-				"Ä‘áº¡o hÃ m,derivative",
-				"tÃ­ch phÃ¢n,integral",
-				"giá»›i háº¡n,limit",
-				"hÃ m sá»‘,function",
+				"toán,toán học,mathematics,math",
+				"lý,vật lý,physics",
+				"hóa,hóa học,chemistry",
+				"sinh,sinh học,biology",
+				"văn,ngữ văn,literature",
+				"đạo hàm,derivative",
+				"tích phân,integral",
+				"giới hạn,limit",
+				"hàm số,function",
 			},
 		},
 		"phonetic_vietnamese": map[string]interface{}{
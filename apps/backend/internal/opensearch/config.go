@@ -32,6 +32,9 @@ type Config struct {
 	IndexReplicas   int    `env:"OPENSEARCH_INDEX_REPLICAS" envDefault:"0"`
 	RefreshInterval string `env:"OPENSEARCH_REFRESH_INTERVAL" envDefault:"1s"`
 
+	// Page text of library books and exams
+	LibraryPagesIndex string `env:"OPENSEARCH_LIBRARY_PAGES_INDEX" envDefault:"library-pages"`
+
 	// Search settings
 	DefaultSize   int           `env:"OPENSEARCH_DEFAULT_SIZE" envDefault:"20"`
 	MaxSize       int           `env:"OPENSEARCH_MAX_SIZE" envDefault:"10000"`
@@ -106,6 +109,14 @@ func (c *Config) GetQuestionsAliasName() string {
 	return c.IndexPrefix
 }
 
+// GetLibraryPagesIndexName returns the library pages index name
+func (c *Config) GetLibraryPagesIndexName() string {
+	if c.LibraryPagesIndex == "" {
+		return "library-pages"
+	}
+	return c.LibraryPagesIndex
+}
+
 // IsVietnameseAnalysisEnabled returns true if Vietnamese analysis is enabled
 func (c *Config) IsVietnameseAnalysisEnabled() bool {
 	return c.Enabled && c.VietnameseEnabled
@@ -190,6 +201,7 @@ func DefaultConfig() *Config {
 		IndexShards:       1,
 		IndexReplicas:     0,
 		RefreshInterval:   "1s",
+		LibraryPagesIndex: "library-pages",
 		DefaultSize:       20,
 		MaxSize:           10000,
 		ScrollTimeout:     5 * time.Minute,
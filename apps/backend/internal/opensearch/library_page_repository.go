@@ -0,0 +1,304 @@
+package opensearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// libraryPagesBulkSize is the number of pages sent per bulk request
+const libraryPagesBulkSize = 100
+
+// LibraryPage is the text of one page of a library book or exam file
+type LibraryPage struct {
+	ItemID   string `json:"item_id"`
+	ItemType string `json:"item_type"`
+	Page     int    `json:"page"` // 1-based
+	Text     string `json:"text"`
+}
+
+// LibraryPageHit is the best matching page of an item
+type LibraryPageHit struct {
+	ItemID   string
+	ItemType string
+	Page     int
+	Snippet  string // HTML-escaped, with matches wrapped in <mark> tags
+	Score    float64
+}
+
+// LibraryPageRepository indexes and searches the page text of library files
+type LibraryPageRepository struct {
+	client *Client
+	config *Config
+	logger *log.Logger
+}
+
+// NewLibraryPageRepository creates a new OpenSearch library page repository
+func NewLibraryPageRepository(client *Client) *LibraryPageRepository {
+	return &LibraryPageRepository{
+		client: client,
+		config: client.GetConfig(),
+		logger: log.New(log.Writer(), "[OpenSearch-LibraryPages] ", log.LstdFlags),
+	}
+}
+
+// EnsureIndex creates the pages index with the Vietnamese analyzers when it is missing
+func (r *LibraryPageRepository) EnsureIndex(ctx context.Context) error {
+	if !r.client.IsEnabled() {
+		return nil
+	}
+
+	index := r.config.GetLibraryPagesIndexName()
+	exists, err := r.client.IndexExists(ctx, index)
+	if err != nil || exists {
+		return err
+	}
+
+	text := map[string]interface{}{
+		"type":            "text",
+		"analyzer":        r.config.GetAnalyzerName("content"),
+		"search_analyzer": r.config.GetAnalyzerName("search"),
+	}
+	body := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"number_of_shards":   r.config.IndexShards,
+			"number_of_replicas": r.config.IndexReplicas,
+			"refresh_interval":   r.config.RefreshInterval,
+		},
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"item_id":   map[string]interface{}{"type": "keyword"},
+				"item_type": map[string]interface{}{"type": "keyword"},
+				"page":      map[string]interface{}{"type": "integer"},
+				"text":      text,
+			},
+		},
+	}
+	return r.client.CreateIndex(ctx, index, body)
+}
+
+// ReplaceItemPages indexes the pages of an item's current file and removes the pages
+// of its previous file that were not replaced
+func (r *LibraryPageRepository) ReplaceItemPages(ctx context.Context, itemID string, pages []LibraryPage) error {
+	if !r.client.IsEnabled() {
+		return nil
+	}
+
+	for start := 0; start < len(pages); start += libraryPagesBulkSize {
+		end := start + libraryPagesBulkSize
+		if end > len(pages) {
+			end = len(pages)
+		}
+		if err := r.bulkIndex(ctx, pages[start:end]); err != nil {
+			return err
+		}
+	}
+
+	// Pages keep their IDs across versions; drop the old pages that were not overwritten
+	numbers := make([]int, 0, len(pages))
+	for _, page := range pages {
+		numbers = append(numbers, page.Page)
+	}
+	return r.deleteByQuery(ctx, map[string]interface{}{
+		"bool": map[string]interface{}{
+			"filter": []map[string]interface{}{
+				{"term": map[string]interface{}{"item_id": itemID}},
+			},
+			"must_not": []map[string]interface{}{
+				{"terms": map[string]interface{}{"page": numbers}},
+			},
+		},
+	})
+}
+
+// DeleteItem removes every page of an item
+func (r *LibraryPageRepository) DeleteItem(ctx context.Context, itemID string) error {
+	if !r.client.IsEnabled() {
+		return nil
+	}
+	return r.deleteByQuery(ctx, map[string]interface{}{
+		"term": map[string]interface{}{"item_id": itemID},
+	})
+}
+
+// Search returns the best matching page of each item whose text matches the query,
+// most relevant first. types limits the item types searched; empty searches all.
+func (r *LibraryPageRepository) Search(ctx context.Context, query string, types []string, size int) ([]LibraryPageHit, error) {
+	query = strings.TrimSpace(query)
+	if !r.client.IsEnabled() || query == "" {
+		return nil, nil
+	}
+
+	boolQuery := map[string]interface{}{
+		"must": []map[string]interface{}{
+			{"match": map[string]interface{}{"text": map[string]interface{}{
+				"query":                query,
+				"minimum_should_match": "60%",
+			}}},
+		},
+		// Pages containing the words together rank first
+		"should": []map[string]interface{}{
+			{"match_phrase": map[string]interface{}{"text": map[string]interface{}{
+				"query": query,
+				"slop":  3,
+			}}},
+		},
+	}
+	if len(types) > 0 {
+		boolQuery["filter"] = []map[string]interface{}{
+			{"terms": map[string]interface{}{"item_type": types}},
+		}
+	}
+	search := map[string]interface{}{
+		"size":     size,
+		"_source":  []string{"item_id", "item_type", "page"},
+		"query":    map[string]interface{}{"bool": boolQuery},
+		"collapse": map[string]interface{}{"field": "item_id"},
+		"highlight": map[string]interface{}{
+			// Escapes the page text so only the mark tags are HTML
+			"encoder": "html",
+			"fields": map[string]interface{}{
+				"text": map[string]interface{}{
+					"fragment_size":       160,
+					"number_of_fragments": 1,
+					"no_match_size":       160,
+					"pre_tags":            []string{"<mark>"},
+					"post_tags":           []string{"</mark>"},
+				},
+			},
+		},
+	}
+
+	searchJSON, err := json.Marshal(search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal library page query: %w", err)
+	}
+	req := opensearchapi.SearchRequest{
+		Index: []string{r.config.GetLibraryPagesIndexName()},
+		Body:  strings.NewReader(string(searchJSON)),
+	}
+	res, err := req.Do(ctx, r.client.client)
+	if err != nil {
+		return nil, fmt.Errorf("library page search request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		// Nothing has been indexed yet
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("library page search failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Hits struct {
+			Hits []struct {
+				Score     float64             `json:"_score"`
+				Source    LibraryPage         `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode library page search response: %w", err)
+	}
+
+	hits := make([]LibraryPageHit, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		snippet := ""
+		if fragments := hit.Highlight["text"]; len(fragments) > 0 {
+			snippet = fragments[0]
+		}
+		hits = append(hits, LibraryPageHit{
+			ItemID:   hit.Source.ItemID,
+			ItemType: hit.Source.ItemType,
+			Page:     hit.Source.Page,
+			Snippet:  snippet,
+			Score:    hit.Score,
+		})
+	}
+	return hits, nil
+}
+
+func (r *LibraryPageRepository) bulkIndex(ctx context.Context, pages []LibraryPage) error {
+	index := r.config.GetLibraryPagesIndexName()
+	var body strings.Builder
+	for _, page := range pages {
+		action := map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index": index,
+				"_id":    page.ItemID + "-" + strconv.Itoa(page.Page),
+			},
+		}
+		actionJSON, _ := json.Marshal(action)
+		body.Write(actionJSON)
+		body.WriteString("\n")
+		docJSON, _ := json.Marshal(page)
+		body.Write(docJSON)
+		body.WriteString("\n")
+	}
+
+	req := opensearchapi.BulkRequest{
+		Body:    strings.NewReader(body.String()),
+		Refresh: "wait_for",
+	}
+	res, err := req.Do(ctx, r.client.client)
+	if err != nil {
+		return fmt.Errorf("bulk index request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("bulk index failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Error json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	if response.Errors {
+		for _, item := range response.Items {
+			for _, result := range item {
+				if len(result.Error) > 0 {
+					return fmt.Errorf("bulk index failed: %s", result.Error)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *LibraryPageRepository) deleteByQuery(ctx context.Context, query map[string]interface{}) error {
+	queryJSON, err := json.Marshal(map[string]interface{}{"query": query})
+	if err != nil {
+		return fmt.Errorf("failed to marshal delete query: %w", err)
+	}
+
+	refresh := true
+	req := opensearchapi.DeleteByQueryRequest{
+		Index:   []string{r.config.GetLibraryPagesIndexName()},
+		Body:    strings.NewReader(string(queryJSON)),
+		Refresh: &refresh,
+	}
+	res, err := req.Do(ctx, r.client.client)
+	if err != nil {
+		return fmt.Errorf("delete library pages request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != 404 {
+		return fmt.Errorf("delete library pages failed with status: %s", res.Status())
+	}
+	return nil
+}
@@ -0,0 +1,741 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var (
+	ErrNotPDF    = errors.New("pdf: not a PDF file")
+	ErrEncrypted = errors.New("pdf: encrypted documents are not supported")
+	ErrNoPages   = errors.New("pdf: document has no pages")
+)
+
+// maxDecodedStream bounds decompressed object and xref streams
+const maxDecodedStream = 64 << 20
+
+var objectHeader = regexp.MustCompile(`(\d+)[ \t\r\n\f\x00]+(\d+)[ \t\r\n\f\x00]+obj\b`)
+
+// xrefEntry locates an object either at a file offset or inside an object stream
+type xrefEntry struct {
+	offset     int
+	gen        int
+	compressed bool
+	stream     int // object stream number when compressed
+}
+
+// Document is a parsed PDF file. Objects are loaded lazily from the original bytes.
+type Document struct {
+	data    []byte
+	xref    map[int]xrefEntry
+	trailer Dict
+	objects map[int]Object
+	loading map[int]bool
+	streams map[int]*objectStream
+
+	// Objects replaced or added for Write
+	replaced map[int]Object
+}
+
+type objectStream struct {
+	data    []byte
+	offsets map[int]int // object number -> offset in data
+}
+
+// Parse reads the cross-reference data and trailer. Files whose cross-reference data
+// is damaged are recovered by scanning for object headers.
+func Parse(data []byte) (*Document, error) {
+	doc, err := parse(data, false)
+	if err == nil || errors.Is(err, ErrNotPDF) || errors.Is(err, ErrEncrypted) {
+		return doc, err
+	}
+	return parse(data, true)
+}
+
+// parse reads the document from its cross-reference data, or by scanning the whole
+// file when reconstruct is set
+func parse(data []byte, reconstruct bool) (*Document, error) {
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	if !bytes.Contains(head, []byte("%PDF-")) {
+		return nil, ErrNotPDF
+	}
+
+	doc := newDocument(data)
+	var err error
+	if reconstruct {
+		err = doc.reconstruct()
+	} else {
+		err = doc.readXref()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := doc.catalog(); err != nil {
+		return nil, err
+	}
+	return doc, doc.checkSupported()
+}
+
+func newDocument(data []byte) *Document {
+	return &Document{
+		data:    data,
+		xref:    map[int]xrefEntry{},
+		objects: map[int]Object{},
+		loading: map[int]bool{},
+		streams: map[int]*objectStream{},
+	}
+}
+
+func (d *Document) checkSupported() error {
+	if _, ok := d.trailer["Encrypt"]; ok {
+		return ErrEncrypted
+	}
+	return nil
+}
+
+// Trailer returns the newest trailer dictionary
+func (d *Document) Trailer() Dict {
+	return d.trailer
+}
+
+// Resolve follows references until it reaches a direct object; missing objects are Null
+func (d *Document) Resolve(obj Object) (Object, error) {
+	for i := 0; i < 32; i++ {
+		ref, ok := obj.(Ref)
+		if !ok {
+			return obj, nil
+		}
+		var err error
+		if obj, err = d.Object(ref.Num); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("pdf: reference chain too long")
+}
+
+// resolveDict resolves obj and returns it as a dictionary, or nil
+func (d *Document) resolveDict(obj Object) Dict {
+	resolved, err := d.Resolve(obj)
+	if err != nil {
+		return nil
+	}
+	switch v := resolved.(type) {
+	case Dict:
+		return v
+	case *Stream:
+		return v.Dict
+	}
+	return nil
+}
+
+// Object loads an indirect object by number
+func (d *Document) Object(num int) (Object, error) {
+	if obj, ok := d.objects[num]; ok {
+		return obj, nil
+	}
+	entry, ok := d.xref[num]
+	if !ok {
+		return Null{}, nil
+	}
+	if d.loading[num] {
+		return nil, fmt.Errorf("pdf: object %d refers to itself", num)
+	}
+	d.loading[num] = true
+	defer delete(d.loading, num)
+
+	var obj Object
+	var err error
+	if entry.compressed {
+		obj, err = d.loadCompressed(num, entry)
+	} else {
+		obj, err = d.loadAt(num, entry.offset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.objects[num] = obj
+	return obj, nil
+}
+
+func (d *Document) loadAt(num, offset int) (Object, error) {
+	if offset < 0 || offset >= len(d.data) {
+		return nil, fmt.Errorf("pdf: object %d offset %d out of range", num, offset)
+	}
+	p := newParser(d.data, offset)
+	p.streamLength = d.lengthOf
+	ref, obj, err := p.parseIndirect()
+	if err != nil {
+		return nil, err
+	}
+	if ref.Num != num {
+		return nil, fmt.Errorf("pdf: expected object %d at offset %d, found %d", num, offset, ref.Num)
+	}
+	return obj, nil
+}
+
+func (d *Document) lengthOf(ref Ref) (int, bool) {
+	obj, err := d.Object(ref.Num)
+	if err != nil {
+		return 0, false
+	}
+	n, ok := obj.(Integer)
+	return int(n), ok
+}
+
+func (d *Document) loadCompressed(num int, entry xrefEntry) (Object, error) {
+	stm, err := d.objectStream(entry.stream)
+	if err != nil {
+		return nil, err
+	}
+	offset, ok := stm.offsets[num]
+	if !ok {
+		return Null{}, nil
+	}
+	obj, err := newParser(stm.data, offset).parseObject()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := obj.(keyword); ok {
+		return nil, fmt.Errorf("pdf: invalid object %d in object stream %d", num, entry.stream)
+	}
+	return obj, nil
+}
+
+func (d *Document) objectStream(num int) (*objectStream, error) {
+	if stm, ok := d.streams[num]; ok {
+		return stm, nil
+	}
+	obj, err := d.Object(num)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := obj.(*Stream)
+	if !ok || stream.Dict["Type"] != Name("ObjStm") {
+		return nil, fmt.Errorf("pdf: object %d is not an object stream", num)
+	}
+	data, err := decodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	count, _ := stream.Dict["N"].(Integer)
+	first, _ := stream.Dict["First"].(Integer)
+	if first < 0 || int(first) > len(data) {
+		return nil, fmt.Errorf("pdf: object stream %d has an invalid /First", num)
+	}
+
+	stm := &objectStream{data: data, offsets: map[int]int{}}
+	p := newParser(data[:first], 0)
+	for i := 0; i < int(count); i++ {
+		p.skipSpace()
+		objNum, ok := p.readUint()
+		if !ok {
+			break
+		}
+		p.skipSpace()
+		offset, ok := p.readUint()
+		if !ok {
+			break
+		}
+		if _, seen := stm.offsets[objNum]; !seen {
+			stm.offsets[objNum] = int(first) + offset
+		}
+	}
+	d.streams[num] = stm
+	return stm, nil
+}
+
+// readXref follows startxref and the /Prev chain; newer sections win
+func (d *Document) readXref() error {
+	idx := bytes.LastIndex(d.data, []byte("startxref"))
+	if idx < 0 {
+		return fmt.Errorf("pdf: missing startxref")
+	}
+	p := newParser(d.data, idx+len("startxref"))
+	p.skipSpace()
+	offset, ok := p.readUint()
+	if !ok {
+		return fmt.Errorf("pdf: invalid startxref")
+	}
+
+	visited := map[int]bool{}
+	for !visited[offset] && offset < len(d.data) {
+		visited[offset] = true
+		trailer, err := d.readXrefSection(offset)
+		if err != nil {
+			return err
+		}
+		if d.trailer == nil {
+			d.trailer = trailer
+		}
+		if stm, ok := trailer["XRefStm"].(Integer); ok && !visited[int(stm)] {
+			visited[int(stm)] = true
+			if _, err := d.readXrefStream(int(stm)); err != nil {
+				return err
+			}
+		}
+		prev, ok := trailer["Prev"].(Integer)
+		if !ok {
+			break
+		}
+		offset = int(prev)
+	}
+	if d.trailer == nil {
+		return fmt.Errorf("pdf: missing trailer")
+	}
+	return nil
+}
+
+func (d *Document) readXrefSection(offset int) (Dict, error) {
+	p := newParser(d.data, offset)
+	p.skipSpace()
+	if !bytes.HasPrefix(d.data[p.pos:], []byte("xref")) {
+		return d.readXrefStream(offset)
+	}
+	p.pos += len("xref")
+
+	for {
+		p.skipSpace()
+		if bytes.HasPrefix(d.data[p.pos:], []byte("trailer")) {
+			p.pos += len("trailer")
+			obj, err := p.parseObject()
+			if err != nil {
+				return nil, err
+			}
+			trailer, ok := obj.(Dict)
+			if !ok {
+				return nil, fmt.Errorf("pdf: invalid trailer")
+			}
+			return trailer, nil
+		}
+		start, ok := p.readUint()
+		if !ok {
+			return nil, p.errorf("invalid xref subsection")
+		}
+		p.skipSpace()
+		count, ok := p.readUint()
+		if !ok {
+			return nil, p.errorf("invalid xref subsection")
+		}
+		for i := 0; i < count; i++ {
+			p.skipSpace()
+			off, ok1 := p.readUint()
+			p.skipSpace()
+			gen, ok2 := p.readUint()
+			p.skipSpace()
+			if !ok1 || !ok2 || p.pos >= len(d.data) {
+				return nil, p.errorf("invalid xref entry")
+			}
+			kind := d.data[p.pos]
+			p.pos++
+			if _, seen := d.xref[start+i]; seen || kind != 'n' || start+i == 0 {
+				continue
+			}
+			d.xref[start+i] = xrefEntry{offset: off, gen: gen}
+		}
+	}
+}
+
+func (d *Document) readXrefStream(offset int) (Dict, error) {
+	obj, err := d.loadAtAnyNumber(offset)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := obj.(*Stream)
+	if !ok || stream.Dict["Type"] != Name("XRef") {
+		return nil, fmt.Errorf("pdf: no cross-reference data at offset %d", offset)
+	}
+	data, err := decodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	widths, _ := stream.Dict["W"].(Array)
+	if len(widths) != 3 {
+		return nil, fmt.Errorf("pdf: invalid xref stream /W")
+	}
+	var w [3]int
+	rowSize := 0
+	for i, v := range widths {
+		n, _ := v.(Integer)
+		if n < 0 || n > 8 {
+			return nil, fmt.Errorf("pdf: invalid xref stream /W")
+		}
+		w[i] = int(n)
+		rowSize += int(n)
+	}
+	if rowSize == 0 {
+		return nil, fmt.Errorf("pdf: invalid xref stream /W")
+	}
+
+	index, _ := stream.Dict["Index"].(Array)
+	if index == nil {
+		size, _ := stream.Dict["Size"].(Integer)
+		index = Array{Integer(0), size}
+	}
+	pos := 0
+	for i := 0; i+1 < len(index); i += 2 {
+		start, _ := index[i].(Integer)
+		count, _ := index[i+1].(Integer)
+		for j := 0; j < int(count); j++ {
+			if pos+rowSize > len(data) {
+				return stream.Dict, nil
+			}
+			row := data[pos : pos+rowSize]
+			pos += rowSize
+			kind := 1
+			if w[0] > 0 {
+				kind = readField(row[:w[0]])
+			}
+			f2 := readField(row[w[0] : w[0]+w[1]])
+			f3 := readField(row[w[0]+w[1]:])
+			num := int(start) + j
+			if _, seen := d.xref[num]; seen || num == 0 {
+				continue
+			}
+			switch kind {
+			case 1:
+				d.xref[num] = xrefEntry{offset: f2, gen: f3}
+			case 2:
+				d.xref[num] = xrefEntry{compressed: true, stream: f2}
+			}
+		}
+	}
+	return stream.Dict, nil
+}
+
+// loadAtAnyNumber parses the indirect object at offset without checking its number
+func (d *Document) loadAtAnyNumber(offset int) (Object, error) {
+	p := newParser(d.data, offset)
+	p.streamLength = d.lengthOf
+	_, obj, err := p.parseIndirect()
+	return obj, err
+}
+
+func readField(b []byte) int {
+	v := 0
+	for _, c := range b {
+		v = v<<8 | int(c)
+	}
+	return v
+}
+
+// reconstruct rebuilds the cross-reference table by scanning for object headers
+func (d *Document) reconstruct() error {
+	for _, m := range objectHeader.FindAllSubmatchIndex(d.data, -1) {
+		// Only accept headers at the start of a line or after whitespace
+		if m[0] > 0 && !isWhitespace(d.data[m[0]-1]) {
+			continue
+		}
+		num, _ := strconv.Atoi(string(d.data[m[2]:m[3]]))
+		gen, _ := strconv.Atoi(string(d.data[m[4]:m[5]]))
+		if num > 0 {
+			d.xref[num] = xrefEntry{offset: m[0], gen: gen}
+		}
+	}
+	if len(d.xref) == 0 {
+		return fmt.Errorf("pdf: no objects found")
+	}
+
+	// Register objects kept in object streams that have no header of their own
+	nums := d.sortedObjectNumbers()
+	for _, num := range nums {
+		obj, err := d.Object(num)
+		if err != nil {
+			continue
+		}
+		stream, ok := obj.(*Stream)
+		if !ok || stream.Dict["Type"] != Name("ObjStm") {
+			continue
+		}
+		stm, err := d.objectStream(num)
+		if err != nil {
+			continue
+		}
+		for inner := range stm.offsets {
+			if _, seen := d.xref[inner]; !seen {
+				d.xref[inner] = xrefEntry{compressed: true, stream: num}
+			}
+		}
+	}
+
+	// Use the last trailer that names a catalog, else search for the catalog itself
+	for end := len(d.data); ; {
+		idx := bytes.LastIndex(d.data[:end], []byte("trailer"))
+		if idx < 0 {
+			break
+		}
+		obj, err := newParser(d.data, idx+len("trailer")).parseObject()
+		if trailer, ok := obj.(Dict); err == nil && ok {
+			if _, ok := trailer["Root"].(Ref); ok {
+				d.trailer = trailer
+				return nil
+			}
+		}
+		end = idx
+	}
+	for _, num := range d.sortedObjectNumbers() {
+		obj, err := d.Object(num)
+		if err != nil {
+			continue
+		}
+		if dict, ok := obj.(Dict); ok && dict["Type"] == Name("Catalog") {
+			d.trailer = Dict{"Root": Ref{Num: num, Gen: d.xref[num].gen}}
+			return nil
+		}
+		if stream, ok := obj.(*Stream); ok && stream.Dict["Type"] == Name("XRef") {
+			if _, ok := stream.Dict["Root"].(Ref); ok {
+				d.trailer = stream.Dict
+			}
+		}
+	}
+	if d.trailer != nil {
+		return nil
+	}
+	return fmt.Errorf("pdf: document catalog not found")
+}
+
+func (d *Document) sortedObjectNumbers() []int {
+	nums := make([]int, 0, len(d.xref))
+	for num := range d.xref {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+func (d *Document) catalog() (Dict, error) {
+	catalog := d.resolveDict(d.trailer["Root"])
+	if catalog == nil {
+		return nil, fmt.Errorf("pdf: document catalog not found")
+	}
+	if d.resolveDict(catalog["Pages"]) == nil {
+		return nil, fmt.Errorf("pdf: page tree not found")
+	}
+	return catalog, nil
+}
+
+// Page is a leaf of the page tree with its inheritable attributes resolved
+type Page struct {
+	Ref       Ref
+	Dict      Dict
+	Resources Dict
+	Box       [4]float64 // Visible area: CropBox, else MediaBox
+	Rotate    int
+}
+
+// Pages walks the page tree in document order
+func (d *Document) Pages() ([]Page, error) {
+	catalog, err := d.catalog()
+	if err != nil {
+		return nil, err
+	}
+	root, ok := catalog["Pages"].(Ref)
+	if !ok {
+		return nil, fmt.Errorf("pdf: page tree root must be indirect")
+	}
+
+	var pages []Page
+	visited := map[int]bool{}
+	var walk func(ref Ref, inherited Dict, depth int) error
+	walk = func(ref Ref, inherited Dict, depth int) error {
+		if visited[ref.Num] || depth > maxNesting {
+			return fmt.Errorf("pdf: page tree contains a cycle")
+		}
+		visited[ref.Num] = true
+		node := d.resolveDict(ref)
+		if node == nil {
+			return nil
+		}
+
+		attrs := inherited.Copy()
+		for _, key := range []Name{"Resources", "MediaBox", "CropBox", "Rotate"} {
+			if v, ok := node[key]; ok {
+				attrs[key] = v
+			}
+		}
+
+		kids, hasKids := node["Kids"]
+		if node["Type"] == Name("Pages") || (node["Type"] != Name("Page") && hasKids) {
+			kidList, _ := d.Resolve(kids)
+			arr, _ := kidList.(Array)
+			for _, kid := range arr {
+				kidRef, ok := kid.(Ref)
+				if !ok {
+					continue
+				}
+				if err := walk(kidRef, attrs, depth+1); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		page := Page{Ref: ref, Dict: node, Resources: d.resolveDict(attrs["Resources"])}
+		page.Box = d.box(attrs["MediaBox"], [4]float64{0, 0, 612, 792})
+		page.Box = d.box(attrs["CropBox"], page.Box)
+		if rotate, err := d.Resolve(attrs["Rotate"]); err == nil {
+			if n, ok := rotate.(Integer); ok {
+				page.Rotate = ((int(n) % 360) + 360) % 360
+			}
+		}
+		pages = append(pages, page)
+		return nil
+	}
+
+	if err := walk(root, Dict{}, 0); err != nil {
+		return nil, err
+	}
+	if len(pages) == 0 {
+		return nil, ErrNoPages
+	}
+	return pages, nil
+}
+
+func (d *Document) box(obj Object, fallback [4]float64) [4]float64 {
+	resolved, err := d.Resolve(obj)
+	if err != nil {
+		return fallback
+	}
+	arr, ok := resolved.(Array)
+	if !ok || len(arr) != 4 {
+		return fallback
+	}
+	var box [4]float64
+	for i, v := range arr {
+		n, ok := number(v)
+		if !ok {
+			return fallback
+		}
+		box[i] = n
+	}
+	if box[0] > box[2] {
+		box[0], box[2] = box[2], box[0]
+	}
+	if box[1] > box[3] {
+		box[1], box[3] = box[3], box[1]
+	}
+	if box[2]-box[0] <= 0 || box[3]-box[1] <= 0 {
+		return fallback
+	}
+	return box
+}
+
+// decodeStream applies the stream filters; only FlateDecode is supported
+func decodeStream(stream *Stream) ([]byte, error) {
+	filters := []Object{}
+	params := []Object{}
+	switch f := stream.Dict["Filter"].(type) {
+	case nil:
+	case Name:
+		filters = append(filters, f)
+		params = append(params, stream.Dict["DecodeParms"])
+	case Array:
+		filters = f
+		if p, ok := stream.Dict["DecodeParms"].(Array); ok {
+			params = p
+		}
+	default:
+		return nil, fmt.Errorf("pdf: invalid stream filter")
+	}
+
+	data := stream.Data
+	for i, f := range filters {
+		name, _ := f.(Name)
+		if name != "FlateDecode" && name != "Fl" {
+			return nil, fmt.Errorf("pdf: unsupported stream filter %q", name)
+		}
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("pdf: invalid compressed stream: %w", err)
+		}
+		decoded, err := io.ReadAll(io.LimitReader(r, maxDecodedStream+1))
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("pdf: invalid compressed stream: %w", err)
+		}
+		if len(decoded) > maxDecodedStream {
+			return nil, fmt.Errorf("pdf: stream too large")
+		}
+		var param Dict
+		if i < len(params) {
+			param, _ = params[i].(Dict)
+		}
+		if data, err = unpredict(decoded, param); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// unpredict reverses PNG predictors, which cross-reference streams commonly use
+func unpredict(data []byte, params Dict) ([]byte, error) {
+	predictor, _ := params["Predictor"].(Integer)
+	if predictor < 10 {
+		if predictor > 1 {
+			return nil, fmt.Errorf("pdf: unsupported predictor %d", predictor)
+		}
+		return data, nil
+	}
+	columns := 1
+	if c, ok := params["Columns"].(Integer); ok && c > 0 {
+		columns = int(c)
+	}
+
+	rowSize := columns + 1
+	out := make([]byte, 0, len(data)/rowSize*columns)
+	prev := make([]byte, columns)
+	for pos := 0; pos+rowSize <= len(data); pos += rowSize {
+		kind := data[pos]
+		row := append([]byte(nil), data[pos+1:pos+rowSize]...)
+		for i := range row {
+			var left, upLeft byte
+			if i > 0 {
+				left = row[i-1]
+				upLeft = prev[i-1]
+			}
+			switch kind {
+			case 0:
+			case 1:
+				row[i] += left
+			case 2:
+				row[i] += prev[i]
+			case 3:
+				row[i] += byte((int(left) + int(prev[i])) / 2)
+			case 4:
+				row[i] += paeth(left, prev[i], upLeft)
+			default:
+				return nil, fmt.Errorf("pdf: invalid PNG predictor row")
+			}
+		}
+		out = append(out, row...)
+		prev = row
+	}
+	return out, nil
+}
+
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := abs(p-int(a)), abs(p-int(b)), abs(p-int(c))
+	if pa <= pb && pa <= pc {
+		return a
+	}
+	if pb <= pc {
+		return b
+	}
+	return c
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,266 @@
+package pdf
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// maxCMapEntries bounds the codes a ToUnicode CMap may define
+const maxCMapEntries = 1 << 17
+
+// font maps the character codes of shown strings to Unicode
+type font struct {
+	composite bool              // Type0 fonts use multi-byte codes
+	spaces    []codespace       // Code lengths declared by the ToUnicode CMap
+	toUnicode map[uint32]string // From the ToUnicode CMap; nil when absent
+	encoding  *[256]rune        // Simple fonts without ToUnicode
+}
+
+// codespace is a codespace range of a CMap
+type codespace struct {
+	low, high []byte
+}
+
+// loadFont builds the decoder of a font dictionary. Composite fonts are only
+// readable through their ToUnicode CMap.
+func (d *Document) loadFont(dict Dict) *font {
+	f := &font{composite: dict["Subtype"] == Name("Type0")}
+	if obj, err := d.Resolve(dict["ToUnicode"]); err == nil {
+		if stream, ok := obj.(*Stream); ok {
+			if data, err := decodeStream(stream); err == nil {
+				f.spaces, f.toUnicode = parseCMap(data)
+			}
+		}
+	}
+	if !f.composite {
+		f.encoding = d.simpleEncoding(dict)
+	}
+	return f
+}
+
+// decode converts a shown string to text
+func (f *font) decode(s []byte) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		n := f.codeLength(s[i:])
+		var code uint32
+		for _, c := range s[i : i+n] {
+			code = code<<8 | uint32(c)
+		}
+		i += n
+
+		if text, ok := f.toUnicode[code]; ok {
+			b.WriteString(text)
+		} else if f.encoding != nil && code < 256 {
+			if r := f.encoding[code]; r != 0 {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// codeLength returns the byte length of the code starting s
+func (f *font) codeLength(s []byte) int {
+	for _, space := range f.spaces {
+		n := len(space.low)
+		if n == 0 || n > len(s) {
+			continue
+		}
+		inside := true
+		for i := 0; i < n; i++ {
+			if s[i] < space.low[i] || s[i] > space.high[i] {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			return n
+		}
+	}
+	if f.composite && len(s) >= 2 {
+		return 2
+	}
+	return 1
+}
+
+// parseCMap reads the codespace ranges and bfchar/bfrange mappings of a ToUnicode CMap
+func parseCMap(data []byte) ([]codespace, map[uint32]string) {
+	var spaces []codespace
+	mapping := map[uint32]string{}
+	p := newParser(data, 0)
+	var operands []Object
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			break
+		}
+		start := p.pos
+		obj, err := p.parseObject()
+		if err != nil {
+			p.pos = start + 1
+			operands = operands[:0]
+			continue
+		}
+		op, ok := obj.(keyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+
+		switch op {
+		case "endcodespacerange":
+			for i := 0; i+1 < len(operands); i += 2 {
+				low, _ := operands[i].(String)
+				high, _ := operands[i+1].(String)
+				if len(low) > 0 && len(low) == len(high) && len(low) <= 4 {
+					spaces = append(spaces, codespace{low: low, high: high})
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands) && len(mapping) < maxCMapEntries; i += 2 {
+				src, _ := operands[i].(String)
+				dst, _ := operands[i+1].(String)
+				if len(src) > 0 && len(src) <= 4 {
+					mapping[codeOf(src)] = utf16Text(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				low, _ := operands[i].(String)
+				high, _ := operands[i+1].(String)
+				if len(low) == 0 || len(low) > 4 || len(low) != len(high) {
+					continue
+				}
+				lo, hi := codeOf(low), codeOf(high)
+				if hi < lo || hi-lo > 0xffff || len(mapping)+int(hi-lo) > maxCMapEntries {
+					continue
+				}
+				switch dst := operands[i+2].(type) {
+				case String:
+					base := utf16.Decode(utf16Units(dst))
+					if len(base) == 0 {
+						continue
+					}
+					for code := lo; code <= hi; code++ {
+						// The last character is incremented across the range
+						runes := append([]rune(nil), base...)
+						runes[len(runes)-1] += rune(code - lo)
+						mapping[code] = string(runes)
+					}
+				case Array:
+					for j, item := range dst {
+						if s, ok := item.(String); ok && lo+uint32(j) <= hi {
+							mapping[lo+uint32(j)] = utf16Text(s)
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+	return spaces, mapping
+}
+
+func codeOf(s []byte) uint32 {
+	var code uint32
+	for _, c := range s {
+		code = code<<8 | uint32(c)
+	}
+	return code
+}
+
+func utf16Units(s []byte) []uint16 {
+	units := make([]uint16, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+	}
+	return units
+}
+
+func utf16Text(s []byte) string {
+	return string(utf16.Decode(utf16Units(s)))
+}
+
+// simpleEncoding returns the code-to-rune table of a simple font: WinAnsi, which
+// agrees with the other base encodings on letters and digits, with /Differences applied
+func (d *Document) simpleEncoding(dict Dict) *[256]rune {
+	table := winAnsiEncoding()
+	enc, err := d.Resolve(dict["Encoding"])
+	if err != nil {
+		return &table
+	}
+	encDict, ok := enc.(Dict)
+	if !ok {
+		return &table
+	}
+
+	diffs, _ := d.Resolve(encDict["Differences"])
+	arr, _ := diffs.(Array)
+	code := -1
+	for _, item := range arr {
+		switch v := item.(type) {
+		case Integer:
+			code = int(v)
+		case Name:
+			if code >= 0 && code < 256 {
+				table[code] = glyphRune(string(v))
+				code++
+			}
+		}
+	}
+	return &table
+}
+
+// winAnsiEncoding is Windows-1252, which matches Latin-1 outside 0x80-0x9F
+func winAnsiEncoding() [256]rune {
+	var table [256]rune
+	for i := 0x20; i < 256; i++ {
+		table[i] = rune(i)
+	}
+	table[0x7f] = 0
+	high := []rune("€\u0000‚ƒ„…†‡ˆ‰Š‹Œ\u0000Ž\u0000\u0000‘’“”•–—˜™š›œ\u0000žŸ")
+	for i, r := range high {
+		table[0x80+i] = r
+	}
+	return table
+}
+
+// glyphNames covers the glyph names /Differences commonly use outside single letters
+var glyphNames = map[string]rune{
+	"space": ' ', "exclam": '!', "quotedbl": '"', "numbersign": '#', "dollar": '$',
+	"percent": '%', "ampersand": '&', "quotesingle": '\'', "quoteright": '’', "quoteleft": '‘',
+	"parenleft": '(', "parenright": ')', "asterisk": '*', "plus": '+', "comma": ',',
+	"hyphen": '-', "minus": '−', "period": '.', "slash": '/', "colon": ':', "semicolon": ';',
+	"less": '<', "equal": '=', "greater": '>', "question": '?', "at": '@',
+	"bracketleft": '[', "backslash": '\\', "bracketright": ']', "underscore": '_',
+	"braceleft": '{', "bar": '|', "braceright": '}', "asciitilde": '~',
+	"zero": '0', "one": '1', "two": '2', "three": '3', "four": '4',
+	"five": '5', "six": '6', "seven": '7', "eight": '8', "nine": '9',
+	"endash": '–', "emdash": '—', "quotedblleft": '“', "quotedblright": '”', "bullet": '•',
+	"ellipsis": '…', "degree": '°', "multiply": '×', "divide": '÷', "plusminus": '±',
+	"fi": 'ﬁ', "fl": 'ﬂ', "ff": 'ﬀ', "dotlessi": 'ı',
+}
+
+// glyphRune maps a glyph name to its rune, or 0 when unknown
+func glyphRune(name string) rune {
+	if r, ok := glyphNames[name]; ok {
+		return r
+	}
+	if len(name) == 1 {
+		return rune(name[0])
+	}
+	if hex, ok := strings.CutPrefix(name, "uni"); ok && len(hex) >= 4 {
+		// uniXXXXYYYY names a ligature; its first character is enough for search
+		if v, err := strconv.ParseUint(hex[:4], 16, 32); err == nil {
+			return rune(v)
+		}
+	}
+	if hex, ok := strings.CutPrefix(name, "u"); ok && len(hex) >= 4 && len(hex) <= 6 {
+		if v, err := strconv.ParseUint(hex, 16, 32); err == nil && v <= 0x10ffff {
+			return rune(v)
+		}
+	}
+	return 0
+}
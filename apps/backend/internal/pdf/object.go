@@ -0,0 +1,150 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Object is one of Null, Bool, Integer, Real, String, Name, Array, Dict, Ref or *Stream
+type Object interface{}
+
+// Null is the PDF null object
+type Null struct{}
+
+// Bool is a PDF boolean
+type Bool bool
+
+// Integer is a PDF integer
+type Integer int64
+
+// Real is a PDF real number
+type Real float64
+
+// String is a PDF string holding the decoded bytes
+type String []byte
+
+// Name is a PDF name without the leading slash
+type Name string
+
+// Array is a PDF array
+type Array []Object
+
+// Dict is a PDF dictionary
+type Dict map[Name]Object
+
+// Ref is an indirect reference
+type Ref struct {
+	Num int
+	Gen int
+}
+
+// Stream is a stream object; Data holds the raw, still encoded bytes
+type Stream struct {
+	Dict Dict
+	Data []byte
+}
+
+// keyword is a bare token such as obj, endobj, stream or R seen while parsing
+type keyword string
+
+// Copy returns a shallow copy of the dictionary
+func (d Dict) Copy() Dict {
+	out := make(Dict, len(d)+2)
+	for k, v := range d {
+		out[k] = v
+	}
+	return out
+}
+
+// number converts Integer and Real values to float64
+func number(obj Object) (float64, bool) {
+	switch v := obj.(type) {
+	case Integer:
+		return float64(v), true
+	case Real:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// writeObject serializes obj in PDF syntax. Dictionary keys are sorted so output is
+// deterministic; strings are written in hex form, which never needs escaping.
+func writeObject(buf *bytes.Buffer, obj Object) {
+	switch v := obj.(type) {
+	case nil, Null:
+		buf.WriteString("null")
+	case Bool:
+		if v {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+	case Integer:
+		buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case Real:
+		buf.WriteString(strconv.FormatFloat(float64(v), 'f', -1, 64))
+	case String:
+		fmt.Fprintf(buf, "<%X>", []byte(v))
+	case Name:
+		writeName(buf, v)
+	case Array:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			writeObject(buf, item)
+		}
+		buf.WriteByte(']')
+	case Dict:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, string(k))
+		}
+		sort.Strings(keys)
+		buf.WriteString("<<")
+		for _, k := range keys {
+			writeName(buf, Name(k))
+			buf.WriteByte(' ')
+			writeObject(buf, v[Name(k)])
+		}
+		buf.WriteString(">>")
+	case Ref:
+		fmt.Fprintf(buf, "%d %d R", v.Num, v.Gen)
+	case *Stream:
+		dict := v.Dict.Copy()
+		dict["Length"] = Integer(len(v.Data))
+		writeObject(buf, dict)
+		buf.WriteString("\nstream\n")
+		buf.Write(v.Data)
+		buf.WriteString("\nendstream")
+	default:
+		buf.WriteString("null")
+	}
+}
+
+func writeName(buf *bytes.Buffer, name Name) {
+	buf.WriteByte('/')
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c < 0x21 || c > 0x7e || c == '#' || isDelimiter(c) {
+			fmt.Fprintf(buf, "#%02X", c)
+		} else {
+			buf.WriteByte(c)
+		}
+	}
+}
+
+func isWhitespace(c byte) bool {
+	return c == 0 || c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
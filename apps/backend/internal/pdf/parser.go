@@ -0,0 +1,456 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// maxNesting bounds array and dictionary depth so hostile files cannot exhaust the stack
+const maxNesting = 256
+
+// parser reads PDF objects from a byte slice
+type parser struct {
+	data []byte
+	pos  int
+
+	// streamLength resolves indirect /Length values; nil when unavailable
+	streamLength func(Ref) (int, bool)
+}
+
+func newParser(data []byte, pos int) *parser {
+	return &parser{data: data, pos: pos}
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("pdf: offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// skipSpace skips whitespace and comments
+func (p *parser) skipSpace() {
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		switch {
+		case isWhitespace(c):
+			p.pos++
+		case c == '%':
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' && p.data[p.pos] != '\r' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// parseObject reads a direct object, or a keyword when the next token is one
+func (p *parser) parseObject() (Object, error) {
+	return p.parseNested(0)
+}
+
+func (p *parser) parseNested(depth int) (Object, error) {
+	if depth > maxNesting {
+		return nil, p.errorf("objects nested too deeply")
+	}
+	p.skipSpace()
+	if p.pos >= len(p.data) {
+		return nil, p.errorf("unexpected end of data")
+	}
+
+	c := p.data[p.pos]
+	switch {
+	case c == '/':
+		return p.parseName()
+	case c == '(':
+		return p.parseLiteralString()
+	case c == '<':
+		if p.pos+1 < len(p.data) && p.data[p.pos+1] == '<' {
+			return p.parseDict(depth)
+		}
+		return p.parseHexString()
+	case c == '[':
+		return p.parseArray(depth)
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumberOrRef()
+	case isDelimiter(c):
+		return nil, p.errorf("unexpected delimiter %q", c)
+	}
+
+	start := p.pos
+	for p.pos < len(p.data) && !isWhitespace(p.data[p.pos]) && !isDelimiter(p.data[p.pos]) {
+		p.pos++
+	}
+	switch word := string(p.data[start:p.pos]); word {
+	case "true":
+		return Bool(true), nil
+	case "false":
+		return Bool(false), nil
+	case "null":
+		return Null{}, nil
+	default:
+		return keyword(word), nil
+	}
+}
+
+func (p *parser) parseName() (Object, error) {
+	p.pos++ // '/'
+	var name []byte
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if isWhitespace(c) || isDelimiter(c) {
+			break
+		}
+		if c == '#' && p.pos+2 < len(p.data) {
+			if v, err := strconv.ParseUint(string(p.data[p.pos+1:p.pos+3]), 16, 8); err == nil {
+				name = append(name, byte(v))
+				p.pos += 3
+				continue
+			}
+		}
+		name = append(name, c)
+		p.pos++
+	}
+	return Name(name), nil
+}
+
+func (p *parser) parseLiteralString() (Object, error) {
+	p.pos++ // '('
+	var out []byte
+	depth := 1
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '(':
+			depth++
+			out = append(out, c)
+		case ')':
+			depth--
+			if depth == 0 {
+				return String(out), nil
+			}
+			out = append(out, c)
+		case '\r':
+			// End-of-line markers inside strings read as a single newline
+			if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+				p.pos++
+			}
+			out = append(out, '\n')
+		case '\\':
+			if p.pos >= len(p.data) {
+				return nil, p.errorf("unterminated string")
+			}
+			e := p.data[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				// Line continuation
+				if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+					p.pos++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '7'; i++ {
+						v = v*8 + int(p.data[p.pos]-'0')
+						p.pos++
+					}
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+		default:
+			out = append(out, c)
+		}
+	}
+	return nil, p.errorf("unterminated string")
+}
+
+func (p *parser) parseHexString() (Object, error) {
+	p.pos++ // '<'
+	var out []byte
+	var high byte
+	half := false
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		if c == '>' {
+			if half {
+				out = append(out, high<<4)
+			}
+			return String(out), nil
+		}
+		if isWhitespace(c) {
+			continue
+		}
+		v, ok := hexValue(c)
+		if !ok {
+			return nil, p.errorf("invalid hex string")
+		}
+		if half {
+			out = append(out, high<<4|v)
+		} else {
+			high = v
+		}
+		half = !half
+	}
+	return nil, p.errorf("unterminated hex string")
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+func (p *parser) parseArray(depth int) (Object, error) {
+	p.pos++ // '['
+	arr := Array{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, p.errorf("unterminated array")
+		}
+		if p.data[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+		obj, err := p.parseNested(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := obj.(keyword); ok {
+			return nil, p.errorf("unexpected keyword %q in array", obj)
+		}
+		arr = append(arr, obj)
+	}
+}
+
+func (p *parser) parseDict(depth int) (Object, error) {
+	p.pos += 2 // '<<'
+	dict := Dict{}
+	for {
+		p.skipSpace()
+		if p.pos+1 < len(p.data) && p.data[p.pos] == '>' && p.data[p.pos+1] == '>' {
+			p.pos += 2
+			return dict, nil
+		}
+		if p.pos >= len(p.data) {
+			return nil, p.errorf("unterminated dictionary")
+		}
+		key, err := p.parseNested(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(Name)
+		if !ok {
+			return nil, p.errorf("dictionary key is not a name")
+		}
+		value, err := p.parseNested(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := value.(keyword); ok {
+			return nil, p.errorf("unexpected keyword %q in dictionary", value)
+		}
+		dict[name] = value
+	}
+}
+
+// parseNumberOrRef reads a number; two unsigned integers followed by R form a reference
+func (p *parser) parseNumberOrRef() (Object, error) {
+	num, isInt, err := p.parseNumber()
+	if err != nil {
+		return nil, err
+	}
+	if !isInt {
+		return Real(num), nil
+	}
+
+	save := p.pos
+	p.skipSpace()
+	if gen, ok := p.readUint(); ok {
+		p.skipSpace()
+		if p.pos < len(p.data) && p.data[p.pos] == 'R' &&
+			(p.pos+1 == len(p.data) || isWhitespace(p.data[p.pos+1]) || isDelimiter(p.data[p.pos+1])) {
+			p.pos++
+			return Ref{Num: int(num), Gen: gen}, nil
+		}
+	}
+	p.pos = save
+	return Integer(int64(num)), nil
+}
+
+func (p *parser) parseNumber() (float64, bool, error) {
+	start := p.pos
+	if p.pos < len(p.data) && (p.data[p.pos] == '+' || p.data[p.pos] == '-') {
+		p.pos++
+	}
+	isInt := true
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if c == '.' {
+			isInt = false
+		} else if c < '0' || c > '9' {
+			break
+		}
+		p.pos++
+	}
+	text := string(p.data[start:p.pos])
+	if isInt {
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return 0, false, p.errorf("invalid number %q", text)
+		}
+		return float64(v), true, nil
+	}
+	if text == "." || text == "-." || text == "+." || text == "-" || text == "+" {
+		return 0, false, nil
+	}
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, false, p.errorf("invalid number %q", text)
+	}
+	return v, false, nil
+}
+
+// readUint reads an unsigned integer token without consuming anything on failure
+func (p *parser) readUint() (int, bool) {
+	start := p.pos
+	for p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+		p.pos++
+	}
+	if p.pos == start || (p.pos < len(p.data) && !isWhitespace(p.data[p.pos]) && !isDelimiter(p.data[p.pos])) {
+		p.pos = start
+		return 0, false
+	}
+	v, err := strconv.Atoi(string(p.data[start:p.pos]))
+	if err != nil {
+		p.pos = start
+		return 0, false
+	}
+	return v, true
+}
+
+// expectKeyword consumes the keyword or fails
+func (p *parser) expectKeyword(word string) error {
+	obj, err := p.parseObject()
+	if err != nil {
+		return err
+	}
+	if kw, ok := obj.(keyword); !ok || string(kw) != word {
+		return p.errorf("expected %q", word)
+	}
+	return nil
+}
+
+// parseIndirect reads "num gen obj ... endobj" at the current position
+func (p *parser) parseIndirect() (Ref, Object, error) {
+	p.skipSpace()
+	num, ok := p.readUint()
+	if !ok {
+		return Ref{}, nil, p.errorf("expected object number")
+	}
+	p.skipSpace()
+	gen, ok := p.readUint()
+	if !ok {
+		return Ref{}, nil, p.errorf("expected generation number")
+	}
+	if err := p.expectKeyword("obj"); err != nil {
+		return Ref{}, nil, err
+	}
+	ref := Ref{Num: num, Gen: gen}
+
+	obj, err := p.parseObject()
+	if err != nil {
+		return ref, nil, err
+	}
+	if kw, ok := obj.(keyword); ok {
+		// "n g obj endobj" is an empty object
+		if kw == "endobj" {
+			return ref, Null{}, nil
+		}
+		return ref, nil, p.errorf("unexpected keyword %q", kw)
+	}
+
+	dict, isDict := obj.(Dict)
+	save := p.pos
+	p.skipSpace()
+	if isDict && bytes.HasPrefix(p.data[p.pos:], []byte("stream")) {
+		p.pos += len("stream")
+		data, err := p.readStreamData(dict)
+		if err != nil {
+			return ref, nil, err
+		}
+		return ref, &Stream{Dict: dict, Data: data}, nil
+	}
+	p.pos = save
+	return ref, obj, nil
+}
+
+// readStreamData reads the bytes between "stream" and "endstream"; a wrong /Length
+// falls back to searching for the endstream keyword
+func (p *parser) readStreamData(dict Dict) ([]byte, error) {
+	if p.pos < len(p.data) && p.data[p.pos] == '\r' {
+		p.pos++
+	}
+	if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+		p.pos++
+	}
+	start := p.pos
+
+	length := -1
+	switch v := dict["Length"].(type) {
+	case Integer:
+		length = int(v)
+	case Ref:
+		if p.streamLength != nil {
+			if n, ok := p.streamLength(v); ok {
+				length = n
+			}
+		}
+	}
+	if length >= 0 && start+length <= len(p.data) {
+		end := start + length
+		rest := end
+		for rest < len(p.data) && isWhitespace(p.data[rest]) {
+			rest++
+		}
+		if bytes.HasPrefix(p.data[rest:], []byte("endstream")) {
+			p.pos = rest + len("endstream")
+			return p.data[start:end], nil
+		}
+	}
+
+	idx := bytes.Index(p.data[start:], []byte("endstream"))
+	if idx < 0 {
+		return nil, p.errorf("missing endstream")
+	}
+	end := start + idx
+	p.pos = end + len("endstream")
+	if end > start && p.data[end-1] == '\n' {
+		end--
+	}
+	if end > start && p.data[end-1] == '\r' {
+		end--
+	}
+	return p.data[start:end], nil
+}
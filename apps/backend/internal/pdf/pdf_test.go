@@ -0,0 +1,245 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildClassic writes objects in order with a classic cross-reference table
+func buildClassic(objects []string, trailer string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, body := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, body)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n\r\n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R %s >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, trailer, xref)
+	return buf.Bytes()
+}
+
+func contentStream(text string) string {
+	return fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(text), text)
+}
+
+func twoPagePDF(trailer string) []byte {
+	page := "BT /F1 12 Tf 72 720 Td (Original page) Tj ET"
+	return buildClassic([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 /MediaBox [0 0 595 842] /Resources << /Font << /F1 5 0 R /NbWmFont 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 6 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Contents [6 0 R] /Rotate 90 >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Times-Roman >>",
+		contentStream(page),
+	}, trailer)
+}
+
+// objectStreamPDF stores the page tree in a compressed object stream and uses an xref stream
+func objectStreamPDF(t *testing.T) []byte {
+	compress := func(data []byte) []byte {
+		var b bytes.Buffer
+		w := zlib.NewWriter(&b)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return b.Bytes()
+	}
+
+	inner := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 300 400] /Contents 4 0 R >>",
+	}
+	var header, body bytes.Buffer
+	for i, obj := range inner {
+		fmt.Fprintf(&header, "%d %d ", i+1, body.Len())
+		body.WriteString(obj + "\n")
+	}
+	objstm := compress(append(header.Bytes(), body.Bytes()...))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.5\n")
+	off4 := buf.Len()
+	fmt.Fprintf(&buf, "4 0 obj\n%s\nendobj\n", contentStream("0 0 m 100 100 l S"))
+	off5 := buf.Len()
+	fmt.Fprintf(&buf, "5 0 obj\n<< /Type /ObjStm /N 3 /First %d /Filter /FlateDecode /Length %d >>\nstream\n", header.Len(), len(objstm))
+	buf.Write(objstm)
+	buf.WriteString("\nendstream\nendobj\n")
+
+	off6 := buf.Len()
+	var rows bytes.Buffer
+	row := func(kind byte, a, b int) {
+		rows.Write([]byte{kind, byte(a >> 8), byte(a), byte(b)})
+	}
+	row(0, 0, 255)
+	row(2, 5, 0)
+	row(2, 5, 1)
+	row(2, 5, 2)
+	row(1, off4, 0)
+	row(1, off5, 0)
+	row(1, off6, 0)
+	xref := compress(rows.Bytes())
+	fmt.Fprintf(&buf, "6 0 obj\n<< /Type /XRef /Size 7 /W [1 2 1] /Root 1 0 R /Filter /FlateDecode /Length %d >>\nstream\n", len(xref))
+	buf.Write(xref)
+	fmt.Fprintf(&buf, "\nendstream\nendobj\nstartxref\n%d\n%%%%EOF\n", off6)
+	return buf.Bytes()
+}
+
+// pageText concatenates the decoded content streams of every page
+func pageText(t *testing.T, data []byte) ([]Page, string) {
+	doc, err := Parse(data)
+	require.NoError(t, err)
+	pages, err := doc.Pages()
+	require.NoError(t, err)
+
+	var text strings.Builder
+	for _, page := range pages {
+		contents, err := doc.Resolve(page.Dict["Contents"])
+		require.NoError(t, err)
+		arr, ok := contents.(Array)
+		if !ok {
+			arr = Array{page.Dict["Contents"]}
+		}
+		for _, item := range arr {
+			obj, err := doc.Resolve(item)
+			require.NoError(t, err)
+			stream, ok := obj.(*Stream)
+			require.True(t, ok)
+			decoded, err := decodeStream(stream)
+			require.NoError(t, err)
+			text.Write(decoded)
+			text.WriteByte('\n')
+		}
+	}
+	return pages, text.String()
+}
+
+func TestStamp_ClassicXref(t *testing.T) {
+	out, err := Stamp(twoPagePDF(""), Watermark{Footer: "Downloaded by Nguyễn Văn Đức (a@b.vn)", Diagonal: "a@b.vn"})
+	require.NoError(t, err)
+
+	pages, text := pageText(t, out)
+	require.Len(t, pages, 2)
+	assert.Contains(t, text, "Original page")
+	assert.Contains(t, text, `(Downloaded by Nguyen Van Duc \(a@b.vn\)) Tj`)
+	assert.Contains(t, text, "(a@b.vn) Tj")
+	// The rotated page draws in an upright coordinate system
+	assert.Contains(t, text, "0 1 -1 0 595 0 cm")
+
+	// An existing resource name is not overwritten
+	fonts := pages[0].Resources["Font"].(Dict)
+	assert.Equal(t, Ref{Num: 5}, fonts["NbWmFont"])
+	assert.Contains(t, fonts, Name("NbWmFont1"))
+	assert.Contains(t, pages[0].Resources["ExtGState"].(Dict), Name("NbWmState"))
+}
+
+func TestStamp_ObjectAndXrefStreams(t *testing.T) {
+	out, err := Stamp(objectStreamPDF(t), Watermark{Footer: "footer text", Diagonal: "diagonal"})
+	require.NoError(t, err)
+
+	pages, text := pageText(t, out)
+	require.Len(t, pages, 1)
+	assert.Equal(t, [4]float64{0, 0, 300, 400}, pages[0].Box)
+	assert.Contains(t, text, "0 0 m 100 100 l S")
+	assert.Contains(t, text, "(footer text) Tj")
+	assert.NotContains(t, string(out), "/ObjStm")
+}
+
+func TestStamp_BrokenXrefIsReconstructed(t *testing.T) {
+	data := twoPagePDF("")
+	// Point startxref somewhere useless and shift every object
+	data = bytes.Replace(data, []byte("%PDF-1.4\n"), []byte("%PDF-1.4\n% padding that invalidates offsets\n"), 1)
+
+	out, err := Stamp(data, Watermark{Diagonal: "mark"})
+	require.NoError(t, err)
+	pages, text := pageText(t, out)
+	assert.Len(t, pages, 2)
+	assert.Contains(t, text, "(mark) Tj")
+}
+
+func TestStamp_Rejections(t *testing.T) {
+	_, err := Stamp([]byte("hello"), Watermark{Footer: "x"})
+	assert.True(t, errors.Is(err, ErrNotPDF))
+
+	_, err = Stamp(twoPagePDF("/Encrypt << /Filter /Standard >>"), Watermark{Footer: "x"})
+	assert.True(t, errors.Is(err, ErrEncrypted))
+}
+
+func TestParse_HostileNesting(t *testing.T) {
+	p := newParser([]byte(strings.Repeat("[", 10000)), 0)
+	_, err := p.parseObject()
+	assert.Error(t, err)
+}
+
+func TestFoldText(t *testing.T) {
+	assert.Equal(t, "Tran Thi Ha - ?", foldText("  Trần Thị Hà - 漢 "))
+}
+
+func TestExtractText_SimpleFonts(t *testing.T) {
+	page1 := "BT /F1 12 Tf 72 720 Td (Bài 1.) Tj [(Hình)-250(Toán)] TJ 0 -14 Td (không gian) Tj ET"
+	page2 := "q BI /W 2 /H 1 /BPC 8 /CS /G ID \x00EI\xff EI Q BT /F2 10 Tf 1 0 0 1 72 700 Tm (\x01\x02) Tj ET /Fm1 Do"
+	data := buildClassic([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 5 0 R /Resources << /Font << /F1 7 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 6 0 R /Resources << /Font << /F2 8 0 R >> /XObject << /Fm1 9 0 R >> >> >>",
+		contentStream(toLatin1(page1)),
+		contentStream(page2),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Times-Roman /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Custom /Encoding << /Differences [1 /O /K] >> >>",
+		"<< /Type /XObject /Subtype /Form /BBox [0 0 100 100] /Resources << /Font << /F1 7 0 R >> >> /Length 31 >>\nstream\nBT /F1 9 Tf (Form text) Tj ET\nendstream",
+	}, "")
+
+	texts, err := ExtractText(data)
+	require.NoError(t, err)
+	require.Len(t, texts, 2)
+	assert.Equal(t, "Bài 1.Hình Toán\nkhông gian", texts[0])
+	assert.Equal(t, "OK Form text", texts[1])
+}
+
+func TestExtractText_ToUnicodeCMap(t *testing.T) {
+	cmap := strings.Join([]string{
+		"/CIDInit /ProcSet findresource begin 12 dict begin begincmap",
+		"/CMapName /Adobe-Identity-UCS def",
+		"1 begincodespacerange <0000> <FFFF> endcodespacerange",
+		"2 beginbfchar <0001> <0110> <0002> <1EC1> endbfchar",
+		"2 beginbfrange <0010> <0012> <0074> <0020> <0021> [<0068> <0069>] endbfrange",
+		"endcmap CMapName currentdict /CMap defineresource pop end end",
+	}, "\n")
+	// "Đề thi" drawn with two-byte glyph IDs
+	content := "BT /F1 12 Tf <00010002> Tj [<0003>-400<0010>] TJ <00200021> Tj ET"
+	data := buildClassic([]string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		contentStream(content),
+		"<< /Type /Font /Subtype /Type0 /BaseFont /Arial /Encoding /Identity-H /ToUnicode 6 0 R >>",
+		contentStream(cmap),
+	}, "")
+
+	texts, err := ExtractText(data)
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	assert.Equal(t, "Đề thi", texts[0])
+}
+
+// toLatin1 encodes text as WinAnsi bytes for simple-font test content
+func toLatin1(text string) string {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		out = append(out, byte(r))
+	}
+	return string(out)
+}
@@ -0,0 +1,305 @@
+package pdf
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// maxPageText bounds the text kept per page
+	maxPageText = 256 << 10
+	// maxFormDepth bounds nested form XObjects
+	maxFormDepth = 8
+	// maxOperands bounds the operand stack of a content stream operator
+	maxOperands = 64
+	// wordGap is the TJ adjustment, in thousandths of an em, read as a space
+	wordGap = 150
+)
+
+// ExtractText returns the text of every page in document order. Text drawn with fonts
+// that cannot be mapped to Unicode is left out, so a page may come back empty.
+func ExtractText(data []byte) ([]string, error) {
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := doc.Pages()
+	if err != nil {
+		return nil, err
+	}
+
+	fonts := map[Ref]*font{}
+	texts := make([]string, len(pages))
+	for i, page := range pages {
+		texts[i] = doc.pageText(page, fonts)
+	}
+	return texts, nil
+}
+
+// pageText runs the page's content streams and returns the normalised text
+func (d *Document) pageText(page Page, fonts map[Ref]*font) string {
+	x := &textExtractor{doc: d, fonts: fonts, forms: map[Ref]bool{}}
+	x.run(d.pageContents(page), page.Resources, 0)
+	return normalizeText(x.out.String())
+}
+
+// pageContents concatenates the decoded content streams of a page. Streams with
+// unsupported filters are skipped.
+func (d *Document) pageContents(page Page) []byte {
+	contents, err := d.Resolve(page.Dict["Contents"])
+	if err != nil {
+		return nil
+	}
+	arr, ok := contents.(Array)
+	if !ok {
+		arr = Array{contents}
+	}
+
+	var buf bytes.Buffer
+	for _, item := range arr {
+		obj, err := d.Resolve(item)
+		if err != nil {
+			continue
+		}
+		stream, ok := obj.(*Stream)
+		if !ok {
+			continue
+		}
+		data, err := decodeStream(stream)
+		if err != nil {
+			continue
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// textExtractor interprets the text operators of content streams
+type textExtractor struct {
+	doc   *Document
+	fonts map[Ref]*font
+	forms map[Ref]bool // Form XObjects already drawn on this page
+	font  *font
+	out   strings.Builder
+
+	lineY   float64
+	hasLine bool
+}
+
+func (x *textExtractor) run(content []byte, resources Dict, depth int) {
+	p := newParser(content, 0)
+	var operands []Object
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) || x.out.Len() > maxPageText {
+			return
+		}
+		start := p.pos
+		obj, err := p.parseObject()
+		if err != nil {
+			// Skip the offending byte and carry on with the next operator
+			p.pos = start + 1
+			operands = operands[:0]
+			continue
+		}
+		op, ok := obj.(keyword)
+		if !ok {
+			if len(operands) < maxOperands {
+				operands = append(operands, obj)
+			}
+			continue
+		}
+		if op == "BI" {
+			skipInlineImage(p)
+		} else {
+			x.apply(string(op), operands, resources, depth)
+		}
+		operands = operands[:0]
+	}
+}
+
+// apply handles one operator with its operands
+func (x *textExtractor) apply(op string, operands []Object, resources Dict, depth int) {
+	switch op {
+	case "Tf":
+		if len(operands) >= 1 {
+			name, _ := operands[0].(Name)
+			x.font = x.fontFor(resources, name)
+		}
+	case "Td", "TD":
+		if len(operands) >= 2 {
+			tx, _ := number(operands[0])
+			ty, _ := number(operands[1])
+			if ty != 0 {
+				x.lineY += ty
+				x.newline()
+			} else if tx != 0 {
+				x.space()
+			}
+		}
+	case "Tm":
+		if len(operands) >= 6 {
+			y, _ := number(operands[5])
+			if x.hasLine && y != x.lineY {
+				x.newline()
+			} else {
+				x.space()
+			}
+			x.lineY, x.hasLine = y, true
+		}
+	case "T*":
+		x.newline()
+	case "Tj":
+		if len(operands) >= 1 {
+			x.show(operands[0])
+		}
+	case "'":
+		x.newline()
+		if len(operands) >= 1 {
+			x.show(operands[0])
+		}
+	case "\"":
+		x.newline()
+		if len(operands) >= 3 {
+			x.show(operands[2])
+		}
+	case "TJ":
+		if len(operands) >= 1 {
+			arr, _ := operands[0].(Array)
+			for _, item := range arr {
+				if n, ok := number(item); ok {
+					if n < -wordGap {
+						x.space()
+					}
+					continue
+				}
+				x.show(item)
+			}
+		}
+	case "ET":
+		x.space()
+	case "Do":
+		if len(operands) >= 1 && depth < maxFormDepth {
+			name, _ := operands[0].(Name)
+			x.drawForm(resources, name, depth)
+		}
+	}
+}
+
+func (x *textExtractor) show(obj Object) {
+	s, ok := obj.(String)
+	if !ok || x.font == nil {
+		return
+	}
+	x.out.WriteString(x.font.decode(s))
+}
+
+func (x *textExtractor) space() {
+	if n := x.out.Len(); n > 0 {
+		if last := x.out.String()[n-1]; last != ' ' && last != '\n' {
+			x.out.WriteByte(' ')
+		}
+	}
+}
+
+func (x *textExtractor) newline() {
+	if n := x.out.Len(); n > 0 && x.out.String()[n-1] != '\n' {
+		x.out.WriteByte('\n')
+	}
+}
+
+// fontFor returns the decoder of a font resource, cached per document
+func (x *textExtractor) fontFor(resources Dict, name Name) *font {
+	obj := x.doc.resolveDict(resources["Font"])[name]
+	ref, isRef := obj.(Ref)
+	if isRef {
+		if f, ok := x.fonts[ref]; ok {
+			return f
+		}
+	}
+	dict := x.doc.resolveDict(obj)
+	if dict == nil {
+		return nil
+	}
+	f := x.doc.loadFont(dict)
+	if isRef {
+		x.fonts[ref] = f
+	}
+	return f
+}
+
+// drawForm runs the content of a form XObject with its own resources
+func (x *textExtractor) drawForm(resources Dict, name Name, depth int) {
+	obj := x.doc.resolveDict(resources["XObject"])[name]
+	ref, ok := obj.(Ref)
+	if !ok || x.forms[ref] {
+		return
+	}
+	x.forms[ref] = true
+
+	resolved, err := x.doc.Resolve(ref)
+	if err != nil {
+		return
+	}
+	stream, ok := resolved.(*Stream)
+	if !ok || stream.Dict["Subtype"] != Name("Form") {
+		return
+	}
+	data, err := decodeStream(stream)
+	if err != nil {
+		return
+	}
+	formResources := x.doc.resolveDict(stream.Dict["Resources"])
+	if formResources == nil {
+		formResources = resources
+	}
+	saved := x.font
+	x.run(data, formResources, depth+1)
+	x.font = saved
+}
+
+// skipInlineImage moves past the binary data of "BI ... ID <data> EI"
+func skipInlineImage(p *parser) {
+	for p.pos < len(p.data) {
+		p.skipSpace()
+		start := p.pos
+		obj, err := p.parseObject()
+		if err != nil {
+			p.pos = start + 1
+			continue
+		}
+		if obj == keyword("ID") {
+			break
+		}
+	}
+	for p.pos < len(p.data) {
+		idx := bytes.Index(p.data[p.pos:], []byte("EI"))
+		if idx < 0 {
+			p.pos = len(p.data)
+			return
+		}
+		end := p.pos + idx
+		p.pos = end + 2
+		if end > 0 && isWhitespace(p.data[end-1]) && (p.pos == len(p.data) || isWhitespace(p.data[p.pos])) {
+			return
+		}
+	}
+}
+
+// normalizeText composes Unicode, drops control characters and collapses spaces
+// within each line
+func normalizeText(text string) string {
+	var lines []string
+	for _, line := range strings.Split(norm.NFC.String(text), "\n") {
+		line = strings.Join(strings.FieldsFunc(line, func(r rune) bool {
+			return r == ' ' || r == '\u00a0' || r < 0x20 || r == 0xfffd
+		}), " ")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
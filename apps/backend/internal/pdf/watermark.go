@@ -0,0 +1,192 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Watermark is the text stamped over every page
+type Watermark struct {
+	Footer   string // Small line along the bottom edge
+	Diagonal string // Large translucent line across the page
+}
+
+// Stamp returns a rewritten copy of the PDF with the watermark drawn over every page.
+// Text is set in the standard Helvetica font, so characters outside Latin-1 are folded
+// to ASCII ("Nguyễn Văn Đức" becomes "Nguyen Van Duc").
+func Stamp(data []byte, mark Watermark) ([]byte, error) {
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	out, err := stamp(doc, mark)
+	if err == nil {
+		return out, nil
+	}
+	// Cross-reference data can look fine and still point at broken pages
+	if recovered, rerr := parse(data, true); rerr == nil {
+		if out, rerr := stamp(recovered, mark); rerr == nil {
+			return out, nil
+		}
+	}
+	return nil, err
+}
+
+func stamp(doc *Document, mark Watermark) ([]byte, error) {
+	pages, err := doc.Pages()
+	if err != nil {
+		return nil, err
+	}
+
+	font := doc.Add(Dict{
+		"Type":     Name("Font"),
+		"Subtype":  Name("Type1"),
+		"BaseFont": Name("Helvetica"),
+		"Encoding": Name("WinAnsiEncoding"),
+	})
+	state := doc.Add(Dict{"Type": Name("ExtGState"), "ca": Real(0.15), "CA": Real(0.15)})
+	// Saves the graphics state before the page's own content so the stamp starts clean
+	open := doc.Add(&Stream{Dict: Dict{}, Data: []byte("q\n")})
+
+	for _, page := range pages {
+		resources := page.Resources.Copy()
+		fonts := doc.resolveDict(resources["Font"]).Copy()
+		fontName := unusedName(fonts, "NbWmFont")
+		fonts[fontName] = font
+		states := doc.resolveDict(resources["ExtGState"]).Copy()
+		stateName := unusedName(states, "NbWmState")
+		states[stateName] = state
+		resources["Font"] = fonts
+		resources["ExtGState"] = states
+
+		contents := Array{open}
+		switch c := page.Dict["Contents"].(type) {
+		case Ref:
+			if resolved, err := doc.Resolve(c); err == nil {
+				if arr, ok := resolved.(Array); ok {
+					contents = append(contents, arr...)
+					break
+				}
+			}
+			contents = append(contents, c)
+		case Array:
+			contents = append(contents, c...)
+		}
+		contents = append(contents, doc.Add(&Stream{Dict: Dict{}, Data: stampContent(page, mark, fontName, stateName)}))
+
+		dict := page.Dict.Copy()
+		dict["Contents"] = contents
+		dict["Resources"] = resources
+		doc.Set(page.Ref.Num, dict)
+	}
+	return doc.Write()
+}
+
+func unusedName(dict Dict, base Name) Name {
+	name := base
+	for i := 1; dict[name] != nil; i++ {
+		name = base + Name(strconv.Itoa(i))
+	}
+	return name
+}
+
+// stampContent draws the watermark in an upright coordinate system that follows /Rotate
+func stampContent(page Page, mark Watermark, font, state Name) []byte {
+	x0, y0, x1, y1 := page.Box[0], page.Box[1], page.Box[2], page.Box[3]
+	width, height := x1-x0, y1-y0
+
+	var b bytes.Buffer
+	b.WriteString("Q\nq\n")
+	switch page.Rotate {
+	case 90:
+		fmt.Fprintf(&b, "0 1 -1 0 %s %s cm\n", num(x1), num(y0))
+		width, height = height, width
+	case 180:
+		fmt.Fprintf(&b, "-1 0 0 -1 %s %s cm\n", num(x1), num(y1))
+	case 270:
+		fmt.Fprintf(&b, "0 -1 1 0 %s %s cm\n", num(x0), num(y1))
+		width, height = height, width
+	default:
+		fmt.Fprintf(&b, "1 0 0 1 %s %s cm\n", num(x0), num(y0))
+	}
+
+	if text := foldText(mark.Footer); text != "" {
+		const margin = 12
+		size := 8.0
+		if w := textWidth(text) * size; w > width-2*margin && w > 0 {
+			size *= (width - 2*margin) / w
+		}
+		fmt.Fprintf(&b, "BT /%s %s Tf 0.3 g %d %d Td (%s) Tj ET\n", font, num(size), margin, margin-2, escapeText(text))
+	}
+
+	if text := foldText(mark.Diagonal); text != "" && textWidth(text) > 0 {
+		angle := math.Atan2(height, width)
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		size := math.Min(48, 0.7*math.Hypot(width, height)/textWidth(text))
+		w := textWidth(text) * size
+		// Centre the line, dropping the baseline by about half the cap height
+		x := width/2 - cos*w/2 + sin*size*0.35
+		y := height/2 - sin*w/2 - cos*size*0.35
+		fmt.Fprintf(&b, "q /%s gs BT /%s %s Tf 0.5 g %s %s %s %s %s %s Tm (%s) Tj ET Q\n",
+			state, font, num(size), num(cos), num(sin), num(-sin), num(cos), num(x), num(y), escapeText(text))
+	}
+
+	b.WriteString("Q\n")
+	return b.Bytes()
+}
+
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1000)/1000, 'f', -1, 64)
+}
+
+// foldText reduces text to printable ASCII, folding Vietnamese diacritics
+func foldText(text string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(text) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case r == 'đ':
+			b.WriteByte('d')
+		case r == 'Đ':
+			b.WriteByte('D')
+		case r >= 0x20 && r <= 0x7e:
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteByte(' ')
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(text)
+}
+
+// helveticaWidths are the glyph widths of printable ASCII in Helvetica, per 1000 em
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// textWidth is the width of folded text in ems
+func textWidth(text string) float64 {
+	total := 0
+	for i := 0; i < len(text); i++ {
+		if c := text[i]; c >= 0x20 && c <= 0x7e {
+			total += helveticaWidths[c-0x20]
+		}
+	}
+	return float64(total) / 1000
+}
@@ -0,0 +1,113 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// Set replaces an object by number; the change only affects Write
+func (d *Document) Set(num int, obj Object) {
+	if d.replaced == nil {
+		d.replaced = map[int]Object{}
+	}
+	d.replaced[num] = obj
+}
+
+// Add stores a new object and returns its reference
+func (d *Document) Add(obj Object) Ref {
+	next := 1
+	if size, ok := d.trailer["Size"].(Integer); ok && int(size) > next {
+		next = int(size)
+	}
+	for num := range d.xref {
+		if num >= next {
+			next = num + 1
+		}
+	}
+	for num := range d.replaced {
+		if num >= next {
+			next = num + 1
+		}
+	}
+	d.Set(next, obj)
+	return Ref{Num: next}
+}
+
+// Write serializes the document as a complete new file with a single classic
+// cross-reference table. Object and cross-reference streams are unpacked, so the
+// output contains no earlier revisions that a reader could fall back to.
+func (d *Document) Write() ([]byte, error) {
+	seen := map[int]bool{}
+	var nums []int
+	for num := range d.xref {
+		seen[num] = true
+		nums = append(nums, num)
+	}
+	for num := range d.replaced {
+		if !seen[num] {
+			nums = append(nums, num)
+		}
+	}
+	sort.Ints(nums)
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := map[int]int{}
+	gens := map[int]int{}
+	maxNum := 0
+	for _, num := range nums {
+		obj, ok := d.replaced[num]
+		if !ok {
+			var err error
+			if obj, err = d.Object(num); err != nil {
+				// Unreadable objects read as null, as a viewer would treat them
+				obj = Null{}
+			}
+		}
+		if stream, ok := obj.(*Stream); ok {
+			if t := stream.Dict["Type"]; t == Name("XRef") || t == Name("ObjStm") {
+				continue
+			}
+		}
+
+		gen := 0
+		if entry, ok := d.xref[num]; ok && !entry.compressed {
+			gen = entry.gen
+		}
+		offsets[num] = buf.Len()
+		gens[num] = gen
+		if num > maxNum {
+			maxNum = num
+		}
+		fmt.Fprintf(&buf, "%d %d obj\n", num, gen)
+		writeObject(&buf, obj)
+		buf.WriteString("\nendobj\n")
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n", maxNum+1)
+	buf.WriteString("0000000000 65535 f\r\n")
+	for num := 1; num <= maxNum; num++ {
+		if offset, ok := offsets[num]; ok {
+			fmt.Fprintf(&buf, "%010d %05d n\r\n", offset, gens[num])
+		} else {
+			buf.WriteString("0000000000 00001 f\r\n")
+		}
+	}
+
+	trailer := Dict{"Size": Integer(maxNum + 1), "Root": d.trailer["Root"]}
+	if info, ok := d.trailer["Info"].(Ref); ok {
+		if _, written := offsets[info.Num]; written {
+			trailer["Info"] = info
+		}
+	}
+	if id, ok := d.trailer["ID"].(Array); ok {
+		trailer["ID"] = id
+	}
+	buf.WriteString("trailer\n")
+	writeObject(&buf, trailer)
+	fmt.Fprintf(&buf, "\nstartxref\n%d\n%%%%EOF\n", xrefOffset)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,318 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/util"
+
+	"github.com/lib/pq"
+)
+
+// ServiceAccount is a non-human identity used by integrations. It is backed by a users
+// row with the same ID that carries its role and level and cannot log in.
+type ServiceAccount struct {
+	ID          string
+	Name        string
+	Description string
+	Email       string
+	Role        string
+	Level       int
+	CreatedBy   string
+	KeyCount    int // Active keys: not revoked and not expired
+	DisabledAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// APIKey is a service account credential. Only the hash of the secret is stored.
+type APIKey struct {
+	ID                 string
+	ServiceAccountID   string
+	Name               string
+	Prefix             string
+	KeyHash            string
+	Scopes             []string
+	RateLimitPerMinute int
+	ExpiresAt          time.Time
+	LastUsedAt         *time.Time
+	LastUsedIP         string
+	RevokedAt          *time.Time
+	CreatedBy          string
+	CreatedAt          time.Time
+}
+
+// APIKeyCredential is a key joined with the account it authenticates as
+type APIKeyCredential struct {
+	Key               *APIKey
+	AccountName       string
+	Email             string
+	Role              string
+	Level             int
+	AccountStatus     string
+	AccountDisabledAt *time.Time
+}
+
+// APIKeyRepository handles service accounts and their API keys
+type APIKeyRepository struct {
+	db *sql.DB
+}
+
+// NewAPIKeyRepository creates a new API key repository
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// unusablePasswordHash never matches a bcrypt comparison, so password login fails
+const unusablePasswordHash = "!"
+
+// CreateServiceAccount stores a service account together with its backing user
+func (r *APIKeyRepository) CreateServiceAccount(ctx context.Context, account *ServiceAccount) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	account.ID = util.ULIDNow()
+	var level sql.NullInt32
+	if account.Level > 0 {
+		level = sql.NullInt32{Int32: int32(account.Level), Valid: true}
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO users (
+			id, email, first_name, last_name, password_hash, role, level,
+			status, email_verified, is_active, created_at, updated_at
+		) VALUES ($1, $2, $3, 'Service Account', $4, $5, $6, 'ACTIVE', true, true, NOW(), NOW())
+	`, account.ID, account.Email, account.Name, unusablePasswordHash, account.Role, level); err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create service account user: %w", err)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO service_accounts (id, name, description, created_by) VALUES ($1, $2, $3, NULLIF($4, ''))
+		RETURNING created_at, updated_at
+	`, account.ID, account.Name, account.Description, account.CreatedBy).Scan(&account.CreatedAt, &account.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+const serviceAccountColumns = `sa.id, sa.name, sa.description, u.email, u.role, COALESCE(u.level, 0),
+	COALESCE(sa.created_by, ''), sa.disabled_at, sa.created_at, sa.updated_at,
+	(SELECT COUNT(*) FROM api_keys k WHERE k.service_account_id = sa.id
+		AND k.revoked_at IS NULL AND k.expires_at > NOW())`
+
+// GetServiceAccount returns a service account by ID, or ErrNotFound
+func (r *APIKeyRepository) GetServiceAccount(ctx context.Context, id string) (*ServiceAccount, error) {
+	accounts, err := r.queryServiceAccounts(ctx, `SELECT `+serviceAccountColumns+`
+		FROM service_accounts sa JOIN users u ON u.id = sa.id WHERE sa.id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, ErrNotFound
+	}
+	return accounts[0], nil
+}
+
+// ListServiceAccounts returns all service accounts, disabled ones last
+func (r *APIKeyRepository) ListServiceAccounts(ctx context.Context) ([]*ServiceAccount, error) {
+	accounts, err := r.queryServiceAccounts(ctx, `SELECT `+serviceAccountColumns+`
+		FROM service_accounts sa JOIN users u ON u.id = sa.id
+		ORDER BY sa.disabled_at IS NOT NULL, sa.name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// DisableServiceAccount disables an account, suspends its user and revokes its keys.
+// It returns ErrNotFound when the account does not exist or is already disabled.
+func (r *APIKeyRepository) DisableServiceAccount(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE service_accounts SET disabled_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND disabled_at IS NULL
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to disable service account: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE users SET status = 'SUSPENDED', is_active = false, updated_at = NOW() WHERE id = $1
+	`, id); err != nil {
+		return fmt.Errorf("failed to suspend service account user: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE api_keys SET revoked_at = NOW() WHERE service_account_id = $1 AND revoked_at IS NULL
+	`, id); err != nil {
+		return fmt.Errorf("failed to revoke service account keys: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) queryServiceAccounts(ctx context.Context, query string, args ...interface{}) ([]*ServiceAccount, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*ServiceAccount
+	for rows.Next() {
+		a := &ServiceAccount{}
+		var disabledAt sql.NullTime
+		if err := rows.Scan(&a.ID, &a.Name, &a.Description, &a.Email, &a.Role, &a.Level,
+			&a.CreatedBy, &disabledAt, &a.CreatedAt, &a.UpdatedAt, &a.KeyCount); err != nil {
+			return nil, err
+		}
+		if disabledAt.Valid {
+			a.DisabledAt = &disabledAt.Time
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, rows.Err()
+}
+
+// CreateKey stores a new API key
+func (r *APIKeyRepository) CreateKey(ctx context.Context, key *APIKey) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO api_keys (service_account_id, name, prefix, key_hash, scopes, rate_limit_per_minute, expires_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))
+		RETURNING id, created_at
+	`, key.ServiceAccountID, key.Name, key.Prefix, key.KeyHash, pq.Array(key.Scopes), key.RateLimitPerMinute,
+		key.ExpiresAt, key.CreatedBy).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+	return nil
+}
+
+const apiKeyColumns = `k.id, k.service_account_id, k.name, k.prefix, k.key_hash, k.scopes, k.rate_limit_per_minute,
+	k.expires_at, k.last_used_at, COALESCE(k.last_used_ip, ''), k.revoked_at, COALESCE(k.created_by, ''), k.created_at`
+
+// ListKeys returns the keys of a service account, newest first
+func (r *APIKeyRepository) ListKeys(ctx context.Context, serviceAccountID string) ([]*APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys k
+		WHERE k.service_account_id = $1 ORDER BY k.created_at DESC`, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// GetKeyByPrefix returns a key with its account, or ErrNotFound
+func (r *APIKeyRepository) GetKeyByPrefix(ctx context.Context, prefix string) (*APIKeyCredential, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+`, sa.name, u.email, u.role, COALESCE(u.level, 0),
+		u.status, sa.disabled_at
+		FROM api_keys k
+		JOIN service_accounts sa ON sa.id = k.service_account_id
+		JOIN users u ON u.id = sa.id
+		WHERE k.prefix = $1`, prefix)
+
+	cred := &APIKeyCredential{Key: &APIKey{}}
+	var lastUsedAt, revokedAt, disabledAt sql.NullTime
+	k := cred.Key
+	err := row.Scan(&k.ID, &k.ServiceAccountID, &k.Name, &k.Prefix, &k.KeyHash, pq.Array(&k.Scopes),
+		&k.RateLimitPerMinute, &k.ExpiresAt, &lastUsedAt, &k.LastUsedIP, &revokedAt, &k.CreatedBy, &k.CreatedAt,
+		&cred.AccountName, &cred.Email, &cred.Role, &cred.Level, &cred.AccountStatus, &disabledAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	if lastUsedAt.Valid {
+		k.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		k.RevokedAt = &revokedAt.Time
+	}
+	if disabledAt.Valid {
+		cred.AccountDisabledAt = &disabledAt.Time
+	}
+	return cred, nil
+}
+
+// RevokeKey revokes a key of a service account and returns its prefix. It returns
+// ErrNotFound when the key does not exist or is already revoked.
+func (r *APIKeyRepository) RevokeKey(ctx context.Context, serviceAccountID, keyID string) (string, error) {
+	var prefix string
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE api_keys SET revoked_at = NOW()
+		WHERE id = $1 AND service_account_id = $2 AND revoked_at IS NULL
+		RETURNING prefix
+	`, keyID, serviceAccountID).Scan(&prefix)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	return prefix, nil
+}
+
+// TouchKey records the last use of a key
+func (r *APIKeyRepository) TouchKey(ctx context.Context, keyID, ipAddress string, at time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `
+		UPDATE api_keys SET last_used_at = $2, last_used_ip = NULLIF($3, '') WHERE id = $1
+	`, keyID, at, ipAddress); err != nil {
+		return fmt.Errorf("failed to record API key use: %w", err)
+	}
+	return nil
+}
+
+func scanAPIKey(rows *sql.Rows) (*APIKey, error) {
+	k := &APIKey{}
+	var lastUsedAt, revokedAt sql.NullTime
+	if err := rows.Scan(&k.ID, &k.ServiceAccountID, &k.Name, &k.Prefix, &k.KeyHash, pq.Array(&k.Scopes),
+		&k.RateLimitPerMinute, &k.ExpiresAt, &lastUsedAt, &k.LastUsedIP, &revokedAt, &k.CreatedBy, &k.CreatedAt); err != nil {
+		return nil, err
+	}
+	if lastUsedAt.Valid {
+		k.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		k.RevokedAt = &revokedAt.Time
+	}
+	return k, nil
+}
@@ -223,6 +223,14 @@ func isSecurityEvent(action string) bool {
 		"TERMINATE_ALL_SESSIONS": true,
 		"UPDATE_USER_ROLE":       true,
 		"UPDATE_USER_STATUS":     true,
+
+		// Two-factor authentication
+		"TWO_FACTOR_ENABLED":                    true,
+		"TWO_FACTOR_DISABLED":                   true,
+		"TWO_FACTOR_FAILED":                     true,
+		"TWO_FACTOR_RECOVERY_CODE_USED":         true,
+		"TWO_FACTOR_RECOVERY_CODES_REGENERATED": true,
+		"TWO_FACTOR_RESET":                      true,
 	}
 	return securityActions[action]
 }
@@ -32,6 +32,7 @@ type BookListFilters struct {
 	Search    string
 	SortBy    string
 	SortOrder string
+	IDs       []string // Restricts the listing to these items, e.g. to load a page picked by ListVisible
 }
 
 // BookRepository Ä‘á»‹nh nghÄ©a cÃ¡c thao tÃ¡c dá»¯ liá»‡u cho BookService
@@ -66,6 +67,10 @@ func (r *bookRepository) List(ctx context.Context, filters BookListFilters) ([]*
 	args := []interface{}{}
 	conditions := []string{"li.type = 'book'"}
 
+	if len(filters.IDs) > 0 {
+		args = append(args, pq.Array(filters.IDs))
+		conditions = append(conditions, fmt.Sprintf("li.id = ANY($%d)", len(args)))
+	}
 	if filters.Category != "" {
 		args = append(args, filters.Category)
 		conditions = append(conditions, fmt.Sprintf("li.category = $%d", len(args)))
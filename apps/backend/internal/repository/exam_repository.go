@@ -306,6 +306,7 @@ func (r *ExamRepository) List(ctx context.Context, filters *interfaces.ExamFilte
 		whereClauses = append(whereClauses, fmt.Sprintf("subject = ANY(ARRAY[%s])", strings.Join(subjectPlaceholders, ",")))
 	}
 
+	whereClauses, args, argIndex = appendOrganisationFilters(filters, whereClauses, args, argIndex)
 	whereClause := strings.Join(whereClauses, " AND ")
 
 	// Count total records
@@ -822,6 +823,7 @@ func (r *ExamRepository) Count(ctx context.Context, filters *interfaces.ExamFilt
 		whereClauses = append(whereClauses, fmt.Sprintf("exam_type = ANY(ARRAY[%s])", strings.Join(typePlaceholders, ",")))
 	}
 
+	whereClauses, args, _ = appendOrganisationFilters(filters, whereClauses, args, argIndex)
 	whereClause := strings.Join(whereClauses, " AND ")
 	query := fmt.Sprintf("SELECT COUNT(*) FROM exams WHERE %s", whereClause)
 
@@ -834,6 +836,21 @@ func (r *ExamRepository) Count(ctx context.Context, filters *interfaces.ExamFilt
 	return count, nil
 }
 
+// appendOrganisationFilters adds the organisation filter and visibility scope to a WHERE clause
+func appendOrganisationFilters(filters *interfaces.ExamFilters, whereClauses []string, args []interface{}, argIndex int) ([]string, []interface{}, int) {
+	if filters.OrganisationID != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("organisation_id = $%d", argIndex))
+		args = append(args, filters.OrganisationID)
+		argIndex++
+	}
+	if filters.ScopeToOrganisations {
+		whereClauses = append(whereClauses, fmt.Sprintf("(organisation_id IS NULL OR organisation_id = ANY($%d))", argIndex))
+		args = append(args, pq.Array(filters.VisibleOrganisations))
+		argIndex++
+	}
+	return whereClauses, args, argIndex
+}
+
 // CountByStatus returns the count of exams by status
 func (r *ExamRepository) CountByStatus(ctx context.Context, status entity.ExamStatus) (int, error) {
 	query := "SELECT COUNT(*) FROM exams WHERE status = $1"
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GuardianLink gives a guardian read-only access to a student's progress
+type GuardianLink struct {
+	ID            string
+	GuardianID    string
+	StudentID     string
+	Relationship  string // PARENT, GUARDIAN or OTHER
+	Status        string // PENDING, ACTIVE, DECLINED or REVOKED
+	DigestEnabled bool
+	CreatedAt     time.Time
+	RespondedAt   *time.Time
+	RevokedAt     *time.Time
+	LastDigestAt  *time.Time
+
+	// Filled from users when listing
+	GuardianEmail string
+	GuardianName  string
+	StudentEmail  string
+	StudentName   string
+}
+
+// StudentExamResult is a submitted exam attempt as shown to a guardian
+type StudentExamResult struct {
+	AttemptID   string
+	ExamID      string
+	ExamTitle   string
+	Subject     string
+	Score       int
+	TotalPoints int
+	Percentage  float64
+	Passed      bool
+	SubmittedAt time.Time
+}
+
+// GuardianLinkRepository handles guardian links and the progress data guardians may read
+type GuardianLinkRepository struct {
+	db *sql.DB
+}
+
+// NewGuardianLinkRepository creates a new guardian link repository
+func NewGuardianLinkRepository(db *sql.DB) *GuardianLinkRepository {
+	return &GuardianLinkRepository{db: db}
+}
+
+const guardianLinkColumns = `
+	l.id, l.guardian_id, l.student_id, l.relationship, l.status, l.digest_enabled,
+	l.created_at, l.responded_at, l.revoked_at, l.last_digest_at,
+	g.email, TRIM(CONCAT(g.first_name, ' ', g.last_name)),
+	s.email, TRIM(CONCAT(s.first_name, ' ', s.last_name))
+`
+
+const guardianLinkFrom = `
+	FROM guardian_links l
+	JOIN users g ON g.id = l.guardian_id
+	JOIN users s ON s.id = l.student_id
+`
+
+// Create stores a new PENDING link. ErrDuplicateKey is returned when an open link already exists.
+func (r *GuardianLinkRepository) Create(ctx context.Context, link *GuardianLink) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO guardian_links (guardian_id, student_id, relationship, status)
+		VALUES ($1, $2, $3, 'PENDING')
+		RETURNING id, status, digest_enabled, created_at
+	`, link.GuardianID, link.StudentID, link.Relationship).Scan(&link.ID, &link.Status, &link.DigestEnabled, &link.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create guardian link: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns a link by ID
+func (r *GuardianLinkRepository) GetByID(ctx context.Context, id string) (*GuardianLink, error) {
+	links, err := r.queryLinks(ctx, `SELECT `+guardianLinkColumns+guardianLinkFrom+` WHERE l.id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guardian link: %w", err)
+	}
+	if len(links) == 0 {
+		return nil, ErrNotFound
+	}
+	return links[0], nil
+}
+
+// ListForGuardian returns the pending and active links of a guardian
+func (r *GuardianLinkRepository) ListForGuardian(ctx context.Context, guardianID string) ([]*GuardianLink, error) {
+	links, err := r.queryLinks(ctx, `SELECT `+guardianLinkColumns+guardianLinkFrom+`
+		WHERE l.guardian_id = $1 AND l.status IN ('PENDING', 'ACTIVE')
+		ORDER BY l.created_at DESC`, guardianID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guardian links: %w", err)
+	}
+	return links, nil
+}
+
+// ListForStudent returns the pending and active links of a student
+func (r *GuardianLinkRepository) ListForStudent(ctx context.Context, studentID string) ([]*GuardianLink, error) {
+	links, err := r.queryLinks(ctx, `SELECT `+guardianLinkColumns+guardianLinkFrom+`
+		WHERE l.student_id = $1 AND l.status IN ('PENDING', 'ACTIVE')
+		ORDER BY l.created_at DESC`, studentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list student guardians: %w", err)
+	}
+	return links, nil
+}
+
+// UpdateStatus moves a link from one status to another. It reports false when the link
+// was no longer in the expected status.
+func (r *GuardianLinkRepository) UpdateStatus(ctx context.Context, id, from, to string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE guardian_links SET
+			status = $3,
+			responded_at = CASE WHEN $3 IN ('ACTIVE', 'DECLINED') THEN NOW() ELSE responded_at END,
+			revoked_at = CASE WHEN $3 = 'REVOKED' THEN NOW() ELSE revoked_at END
+		WHERE id = $1 AND status = $2
+	`, id, from, to)
+	if err != nil {
+		return false, fmt.Errorf("failed to update guardian link: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update guardian link: %w", err)
+	}
+	return n > 0, nil
+}
+
+// SetDigestEnabled turns the weekly digest for a link on or off
+func (r *GuardianLinkRepository) SetDigestEnabled(ctx context.Context, id string, enabled bool) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE guardian_links SET digest_enabled = $2 WHERE id = $1`, id, enabled)
+	if err != nil {
+		return fmt.Errorf("failed to update guardian digest: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// HasActiveLink reports whether guardianID may currently read studentID's progress
+func (r *GuardianLinkRepository) HasActiveLink(ctx context.Context, guardianID, studentID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM guardian_links
+			WHERE guardian_id = $1 AND student_id = $2 AND status = 'ACTIVE'
+		)
+	`, guardianID, studentID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check guardian link: %w", err)
+	}
+	return exists, nil
+}
+
+// ListDigestDue returns active links with digests enabled whose last digest was sent before cutoff
+func (r *GuardianLinkRepository) ListDigestDue(ctx context.Context, cutoff time.Time, limit int) ([]*GuardianLink, error) {
+	links, err := r.queryLinks(ctx, `SELECT `+guardianLinkColumns+guardianLinkFrom+`
+		WHERE l.status = 'ACTIVE' AND l.digest_enabled
+		  AND (l.last_digest_at IS NULL OR l.last_digest_at < $1)
+		ORDER BY l.last_digest_at NULLS FIRST
+		LIMIT $2`, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due guardian digests: %w", err)
+	}
+	return links, nil
+}
+
+// MarkDigestSent records when the last digest for a link was delivered
+func (r *GuardianLinkRepository) MarkDigestSent(ctx context.Context, id string, sentAt time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE guardian_links SET last_digest_at = $2 WHERE id = $1`, id, sentAt); err != nil {
+		return fmt.Errorf("failed to mark guardian digest sent: %w", err)
+	}
+	return nil
+}
+
+// ListStudentExamResults returns a student's submitted attempts, newest first, submitted at
+// or after since. It also returns the total number of matching attempts.
+func (r *GuardianLinkRepository) ListStudentExamResults(ctx context.Context, studentID string, since time.Time, limit, offset int) ([]*StudentExamResult, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM exam_attempts
+		WHERE user_id = $1 AND status = 'submitted' AND submitted_at >= $2
+	`, studentID, since).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count student exam results: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ea.id, ea.exam_id, e.title, COALESCE(e.subject, ''),
+		       COALESCE(ea.score, 0), COALESCE(ea.total_points, 0), COALESCE(ea.percentage, 0),
+		       COALESCE(ea.passed, false), ea.submitted_at
+		FROM exam_attempts ea
+		JOIN exams e ON e.id = ea.exam_id
+		WHERE ea.user_id = $1 AND ea.status = 'submitted' AND ea.submitted_at >= $2
+		ORDER BY ea.submitted_at DESC
+		LIMIT $3 OFFSET $4
+	`, studentID, since, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list student exam results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*StudentExamResult
+	for rows.Next() {
+		result := &StudentExamResult{}
+		if err := rows.Scan(
+			&result.AttemptID, &result.ExamID, &result.ExamTitle, &result.Subject,
+			&result.Score, &result.TotalPoints, &result.Percentage,
+			&result.Passed, &result.SubmittedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan student exam result: %w", err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate student exam results: %w", err)
+	}
+	return results, total, nil
+}
+
+func (r *GuardianLinkRepository) queryLinks(ctx context.Context, query string, args ...interface{}) ([]*GuardianLink, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*GuardianLink
+	for rows.Next() {
+		link := &GuardianLink{}
+		if err := rows.Scan(
+			&link.ID, &link.GuardianID, &link.StudentID, &link.Relationship, &link.Status, &link.DigestEnabled,
+			&link.CreatedAt, &link.RespondedAt, &link.RevokedAt, &link.LastDigestAt,
+			&link.GuardianEmail, &link.GuardianName, &link.StudentEmail, &link.StudentName,
+		); err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
@@ -103,6 +103,13 @@ type ExamFilters struct {
 	MaxDuration *int
 	MinPoints   *int
 	MaxPoints   *int
+
+	// Organisation filters
+	OrganisationID string // Only exams of this organisation
+	// ScopeToOrganisations hides exams of organisations not in VisibleOrganisations;
+	// exams without an organisation stay visible
+	ScopeToOrganisations bool
+	VisibleOrganisations []string
 }
 
 // OfficialExamFilters contains specific filters for official exams
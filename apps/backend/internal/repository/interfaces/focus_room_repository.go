@@ -67,7 +67,7 @@ type StudyAnalyticsRepository interface {
 // LeaderboardRepository defines the interface for leaderboard operations
 type LeaderboardRepository interface {
 	GetGlobalLeaderboard(ctx context.Context, period entity.LeaderboardPeriod, periodStart time.Time, limit int) ([]*entity.LeaderboardEntry, error)
-	GetClassLeaderboard(ctx context.Context, classID int, period entity.LeaderboardPeriod, periodStart time.Time, limit int) ([]*entity.LeaderboardEntry, error)
+	GetClassLeaderboard(ctx context.Context, classID string, period entity.LeaderboardPeriod, periodStart time.Time, limit int) ([]*entity.LeaderboardEntry, error)
 	GetUserRank(ctx context.Context, userID string, period entity.LeaderboardPeriod, periodStart time.Time) (int, error)
 	UpsertEntry(ctx context.Context, entry *entity.LeaderboardEntry) error
 	RefreshLeaderboard(ctx context.Context, period entity.LeaderboardPeriod, periodStart, periodEnd time.Time) error
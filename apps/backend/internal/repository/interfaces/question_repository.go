@@ -140,4 +140,7 @@ type QuestionCodeRepository interface {
 
 	// Validation
 	Exists(ctx context.Context, code string) (bool, error)
+
+	// Coverage
+	GetCoverageCounts(ctx context.Context) ([]entity.QuestionCoverageCount, error)
 }
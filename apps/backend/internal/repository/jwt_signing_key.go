@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// JWTSigningKey is an asymmetric key pair used to sign and verify JWTs
+type JWTSigningKey struct {
+	KID                 string
+	Algorithm           string
+	PrivateKeyEncrypted string
+	PublicKeyPEM        string
+	CreatedAt           time.Time
+	ActivatesAt         time.Time
+	ExpiresAt           time.Time
+}
+
+// JWTSigningKeyRepository stores the JWT keyring
+type JWTSigningKeyRepository struct {
+	db *sql.DB
+}
+
+// NewJWTSigningKeyRepository creates a new JWT signing key repository
+func NewJWTSigningKeyRepository(db *sql.DB) *JWTSigningKeyRepository {
+	return &JWTSigningKeyRepository{db: db}
+}
+
+// ListValidKeys returns keys that have not expired at now, newest first
+func (r *JWTSigningKeyRepository) ListValidKeys(ctx context.Context, now time.Time) ([]*JWTSigningKey, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT kid, algorithm, private_key_encrypted, public_key_pem, created_at, activates_at, expires_at
+		FROM jwt_signing_keys
+		WHERE expires_at > $1
+		ORDER BY created_at DESC
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list JWT signing keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*JWTSigningKey
+	for rows.Next() {
+		var key JWTSigningKey
+		if err := rows.Scan(&key.KID, &key.Algorithm, &key.PrivateKeyEncrypted, &key.PublicKeyPEM,
+			&key.CreatedAt, &key.ActivatesAt, &key.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan JWT signing key: %w", err)
+		}
+		keys = append(keys, &key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate JWT signing keys: %w", err)
+	}
+	return keys, nil
+}
+
+// CreateKeyIfNoneSince inserts the key unless another key for the same algorithm was
+// created after since. The advisory lock keeps instances that rotate at the same
+// time from each adding a key.
+func (r *JWTSigningKeyRepository) CreateKeyIfNoneSince(ctx context.Context, key *JWTSigningKey, since time.Time) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('jwt_signing_keys'))`); err != nil {
+		return false, fmt.Errorf("failed to lock JWT signing keys: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO jwt_signing_keys (kid, algorithm, private_key_encrypted, public_key_pem, created_at, activates_at, expires_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7
+		WHERE NOT EXISTS (SELECT 1 FROM jwt_signing_keys WHERE algorithm = $2 AND created_at > $8)
+	`, key.KID, key.Algorithm, key.PrivateKeyEncrypted, key.PublicKeyPEM,
+		key.CreatedAt, key.ActivatesAt, key.ExpiresAt, since)
+	if err != nil {
+		return false, fmt.Errorf("failed to create JWT signing key: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteExpiredKeys removes keys that expired before the given time
+func (r *JWTSigningKeyRepository) DeleteExpiredKeys(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM jwt_signing_keys WHERE expires_at <= $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired JWT signing keys: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -34,14 +34,14 @@ func (r *LeaderboardRepository) GetGlobalLeaderboard(ctx context.Context, period
 	return r.queryLeaderboard(ctx, query, string(period), periodStart, limit)
 }
 
-// GetClassLeaderboard retrieves the leaderboard for a specific class
-func (r *LeaderboardRepository) GetClassLeaderboard(ctx context.Context, classID int, period entity.LeaderboardPeriod, periodStart time.Time, limit int) ([]*entity.LeaderboardEntry, error) {
+// GetClassLeaderboard retrieves the leaderboard for the members of a class
+func (r *LeaderboardRepository) GetClassLeaderboard(ctx context.Context, classID string, period entity.LeaderboardPeriod, periodStart time.Time, limit int) ([]*entity.LeaderboardEntry, error) {
 	query := `
 		SELECT l.id, l.user_id, l.period, l.period_start, l.period_end,
 		       l.total_focus_time_seconds, l.rank, l.score, l.updated_at
 		FROM leaderboard l
-		JOIN users u ON l.user_id = u.id
-		WHERE l.period = $1 AND l.period_start = $2 AND u.class_id = $3
+		JOIN class_members cm ON cm.user_id = l.user_id AND cm.class_id = $3
+		WHERE l.period = $1 AND l.period_start = $2
 		ORDER BY l.score DESC
 		LIMIT $4
 	`
@@ -0,0 +1,512 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Collection visibilities
+const (
+	CollectionVisibilityPrivate = "private" // Owner only
+	CollectionVisibilityClass   = "class"   // Members of the collection's class
+	CollectionVisibilityPublic  = "public"  // Everyone
+)
+
+// Collection member kinds
+const (
+	CollectionItemLibrary     = "library_item" // Book, exam or video of the library
+	CollectionItemQuestionSet = "question_set" // Exam from the question bank used for practice
+)
+
+// LibraryCollection is an ordered study pack of library items and question sets
+type LibraryCollection struct {
+	ID           string
+	OwnerID      string
+	OwnerName    string
+	Title        string
+	Description  string
+	Visibility   string
+	ClassID      string // Set for class collections
+	CopiedFromID string
+	ItemCount    int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// LibraryCollectionSection is a titled group of members, in order
+type LibraryCollectionSection struct {
+	Title string
+	Items []LibraryCollectionItem
+}
+
+// LibraryCollectionItem is a member of a collection. The fields after Note are loaded
+// with the content so callers can check access to every member without more queries.
+type LibraryCollectionItem struct {
+	Kind   string // CollectionItemLibrary or CollectionItemQuestionSet
+	ItemID string
+	Note   string
+
+	Title          string
+	ItemType       string // book, exam or video for library items
+	Available      bool   // Approved and active item, or active exam
+	RequiredRole   string
+	RequiredLevel  sql.NullInt32
+	TargetRoles    []string
+	OrganisationID string
+}
+
+// LibraryCollectionProgress is a member a student completed
+type LibraryCollectionProgress struct {
+	UserID      string
+	Kind        string
+	ItemID      string
+	CompletedAt time.Time
+}
+
+// LibraryCollectionRepository stores collections, their content and student progress
+type LibraryCollectionRepository struct {
+	db *sql.DB
+}
+
+// NewLibraryCollectionRepository creates a new collection repository
+func NewLibraryCollectionRepository(db *sql.DB) *LibraryCollectionRepository {
+	return &LibraryCollectionRepository{db: db}
+}
+
+// collectionItemColumns loads a member with its access rules, from li (library_items) or
+// e (exams) joined with collectionItemJoins
+const collectionItemColumns = `
+	CASE WHEN li.id IS NOT NULL THEN 'library_item' ELSE 'question_set' END,
+	COALESCE(li.id, e.id::text),
+	COALESCE(li.name, e.title, ''),
+	COALESCE(li.type, ''),
+	COALESCE(li.is_active AND li.upload_status = 'approved', e.status = 'ACTIVE', FALSE),
+	COALESCE(bm.required_role, em.required_role, vm.required_role, 'GUEST'),
+	COALESCE(bm.required_level, em.required_level, vm.required_level),
+	COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY[]::text[]),
+	COALESCE(li.organisation_id, e.organisation_id, '')`
+
+const collectionItemJoins = `
+	LEFT JOIN book_metadata bm ON bm.library_item_id = li.id
+	LEFT JOIN exam_metadata em ON em.library_item_id = li.id
+	LEFT JOIN video_metadata vm ON vm.library_item_id = li.id`
+
+func collectionItemDest(item *LibraryCollectionItem) []interface{} {
+	return []interface{}{&item.Kind, &item.ItemID, &item.Title, &item.ItemType, &item.Available,
+		&item.RequiredRole, &item.RequiredLevel, pq.Array(&item.TargetRoles), &item.OrganisationID}
+}
+
+const collectionColumns = `
+	c.id, c.owner_id, TRIM(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')),
+	c.title, c.description, c.visibility, COALESCE(c.class_id, ''), COALESCE(c.copied_from_id, ''),
+	(SELECT COUNT(*) FROM library_collection_items ci WHERE ci.collection_id = c.id),
+	c.created_at, c.updated_at`
+
+// Create inserts a collection and fills in its ID and timestamps
+func (r *LibraryCollectionRepository) Create(ctx context.Context, c *LibraryCollection) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO library_collections (owner_id, title, description, visibility, class_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+		RETURNING id, created_at, updated_at`,
+		c.OwnerID, c.Title, c.Description, c.Visibility, c.ClassID,
+	).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("create collection: %w", err)
+	}
+	return nil
+}
+
+// Get returns a collection, or ErrNotFound
+func (r *LibraryCollectionRepository) Get(ctx context.Context, id string) (*LibraryCollection, error) {
+	collections, err := r.query(ctx, `
+		SELECT `+collectionColumns+`
+		FROM library_collections c
+		JOIN users u ON u.id = c.owner_id
+		WHERE c.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return nil, ErrNotFound
+	}
+	return collections[0], nil
+}
+
+// Update saves the title, description and visibility of a collection
+func (r *LibraryCollectionRepository) Update(ctx context.Context, c *LibraryCollection) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE library_collections
+		SET title = $2, description = $3, visibility = $4, class_id = NULLIF($5, ''), updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at`,
+		c.ID, c.Title, c.Description, c.Visibility, c.ClassID,
+	).Scan(&c.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("update collection: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a collection with its content and progress
+func (r *LibraryCollectionRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM library_collections WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete collection: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListForUser returns the user's own collections and those shared with their classes,
+// most recently updated first
+func (r *LibraryCollectionRepository) ListForUser(ctx context.Context, userID string, limit, offset int) ([]*LibraryCollection, error) {
+	return r.query(ctx, `
+		SELECT `+collectionColumns+`
+		FROM library_collections c
+		JOIN users u ON u.id = c.owner_id
+		WHERE c.owner_id = $1
+		   OR (c.visibility = 'class' AND c.class_id IN (
+		       SELECT class_id FROM class_members WHERE user_id = $1))
+		ORDER BY c.updated_at DESC, c.id
+		LIMIT $2 OFFSET $3`, userID, limit, offset)
+}
+
+// ListPublic returns public collections, most recently updated first
+func (r *LibraryCollectionRepository) ListPublic(ctx context.Context, limit, offset int) ([]*LibraryCollection, error) {
+	return r.query(ctx, `
+		SELECT `+collectionColumns+`
+		FROM library_collections c
+		JOIN users u ON u.id = c.owner_id
+		WHERE c.visibility = 'public'
+		ORDER BY c.updated_at DESC, c.id
+		LIMIT $1 OFFSET $2`, limit, offset)
+}
+
+func (r *LibraryCollectionRepository) query(ctx context.Context, query string, args ...interface{}) ([]*LibraryCollection, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list collections: %w", err)
+	}
+	defer rows.Close()
+
+	var collections []*LibraryCollection
+	for rows.Next() {
+		c := &LibraryCollection{}
+		if err := rows.Scan(&c.ID, &c.OwnerID, &c.OwnerName, &c.Title, &c.Description, &c.Visibility,
+			&c.ClassID, &c.CopiedFromID, &c.ItemCount, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan collection: %w", err)
+		}
+		collections = append(collections, c)
+	}
+	return collections, rows.Err()
+}
+
+// GetContent returns the sections of a collection with their members in order, with
+// the title and access rules of every member
+func (r *LibraryCollectionRepository) GetContent(ctx context.Context, id string) ([]LibraryCollectionSection, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT position, title FROM library_collection_sections
+		WHERE collection_id = $1 ORDER BY position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("list collection sections: %w", err)
+	}
+	var sections []LibraryCollectionSection
+	index := make(map[int]int)
+	for rows.Next() {
+		var position int
+		var section LibraryCollectionSection
+		if err := rows.Scan(&position, &section.Title); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan collection section: %w", err)
+		}
+		index[position] = len(sections)
+		sections = append(sections, section)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT ci.section_position, ci.note, `+collectionItemColumns+`
+		FROM library_collection_items ci
+		LEFT JOIN library_items li ON li.id = ci.library_item_id
+		LEFT JOIN exams e ON e.id = ci.exam_id
+		`+collectionItemJoins+`
+		WHERE ci.collection_id = $1
+		ORDER BY ci.section_position, ci.position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("list collection items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var position int
+		var item LibraryCollectionItem
+		dest := append([]interface{}{&position, &item.Note}, collectionItemDest(&item)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("scan collection item: %w", err)
+		}
+		if i, ok := index[position]; ok {
+			sections[i].Items = append(sections[i].Items, item)
+		}
+	}
+	return sections, rows.Err()
+}
+
+// DescribeItems loads the title and access rules of prospective members. Members whose
+// item does not exist are left out.
+func (r *LibraryCollectionRepository) DescribeItems(ctx context.Context, refs []LibraryCollectionItem) ([]LibraryCollectionItem, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	kinds := make([]string, 0, len(refs))
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		kinds = append(kinds, ref.Kind)
+		ids = append(ids, ref.ItemID)
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+collectionItemColumns+`
+		FROM UNNEST($1::text[], $2::text[]) AS ref(kind, item_id)
+		LEFT JOIN library_items li ON ref.kind = 'library_item' AND li.id = ref.item_id
+		LEFT JOIN exams e ON ref.kind = 'question_set' AND e.id::text = ref.item_id
+		`+collectionItemJoins+`
+		WHERE li.id IS NOT NULL OR e.id IS NOT NULL`, pq.Array(kinds), pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("describe collection items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []LibraryCollectionItem
+	for rows.Next() {
+		var item LibraryCollectionItem
+		if err := rows.Scan(collectionItemDest(&item)...); err != nil {
+			return nil, fmt.Errorf("scan collection item: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// ReplaceContent replaces the sections and members of a collection
+func (r *LibraryCollectionRepository) ReplaceContent(ctx context.Context, id string, sections []LibraryCollectionSection) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin replace collection content: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := touchCollection(ctx, tx, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM library_collection_sections WHERE collection_id = $1`, id); err != nil {
+		return fmt.Errorf("clear collection content: %w", err)
+	}
+	for s, section := range sections {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO library_collection_sections (collection_id, position, title)
+			VALUES ($1, $2, $3)`, id, s, section.Title); err != nil {
+			return fmt.Errorf("insert collection section: %w", err)
+		}
+		for p, item := range section.Items {
+			if err := insertCollectionItem(ctx, tx, id, s, p, item); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// AppendItem adds a member at the end of a section; a negative section appends to the
+// last one, creating an untitled section in an empty collection. Returns ErrNotFound
+// for a missing collection or section.
+func (r *LibraryCollectionRepository) AppendItem(ctx context.Context, id string, section int, item LibraryCollectionItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin append collection item: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := touchCollection(ctx, tx, id); err != nil {
+		return err
+	}
+	if section < 0 {
+		var last sql.NullInt64
+		if err := tx.QueryRowContext(ctx, `
+			SELECT MAX(position) FROM library_collection_sections WHERE collection_id = $1`, id,
+		).Scan(&last); err != nil {
+			return fmt.Errorf("find last collection section: %w", err)
+		}
+		if !last.Valid {
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO library_collection_sections (collection_id, position, title)
+				VALUES ($1, 0, '')`, id); err != nil {
+				return fmt.Errorf("insert collection section: %w", err)
+			}
+		}
+		section = int(last.Int64)
+	}
+
+	var exists bool
+	var next int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM library_collection_sections WHERE collection_id = $1 AND position = $2),
+		       COALESCE((SELECT MAX(position) + 1 FROM library_collection_items
+		                 WHERE collection_id = $1 AND section_position = $2), 0)`, id, section,
+	).Scan(&exists, &next); err != nil {
+		return fmt.Errorf("find collection section: %w", err)
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	if err := insertCollectionItem(ctx, tx, id, section, next, item); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Copy creates a private copy of a collection's content owned by ownerID
+func (r *LibraryCollectionRepository) Copy(ctx context.Context, sourceID, ownerID, title string) (*LibraryCollection, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin copy collection: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO library_collections (owner_id, title, description, visibility, copied_from_id)
+		SELECT $2, $3, description, 'private', id FROM library_collections WHERE id = $1
+		RETURNING id`, sourceID, ownerID, title,
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("copy collection: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO library_collection_sections (collection_id, position, title)
+		SELECT $2, position, title FROM library_collection_sections WHERE collection_id = $1`, sourceID, id); err != nil {
+		return nil, fmt.Errorf("copy collection sections: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO library_collection_items (collection_id, section_position, position, library_item_id, exam_id, note)
+		SELECT $2, section_position, position, library_item_id, exam_id, note
+		FROM library_collection_items WHERE collection_id = $1`, sourceID, id); err != nil {
+		return nil, fmt.Errorf("copy collection items: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit copy collection: %w", err)
+	}
+	return r.Get(ctx, id)
+}
+
+// SetProgress marks a member as done or not done for a user
+func (r *LibraryCollectionRepository) SetProgress(ctx context.Context, id, userID, kind, itemID string, done bool) error {
+	var err error
+	if done {
+		_, err = r.db.ExecContext(ctx, `
+			INSERT INTO library_collection_progress (collection_id, user_id, item_kind, item_id)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING`, id, userID, kind, itemID)
+	} else {
+		_, err = r.db.ExecContext(ctx, `
+			DELETE FROM library_collection_progress
+			WHERE collection_id = $1 AND user_id = $2 AND item_kind = $3 AND item_id = $4`,
+			id, userID, kind, itemID)
+	}
+	if err != nil {
+		return fmt.Errorf("set collection progress: %w", err)
+	}
+	return nil
+}
+
+// ListProgress returns the members the given users completed: those they marked as done
+// and question sets they submitted an attempt of
+func (r *LibraryCollectionRepository) ListProgress(ctx context.Context, id string, userIDs []string) ([]LibraryCollectionProgress, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id, item_kind, item_id, completed_at
+		FROM library_collection_progress
+		WHERE collection_id = $1 AND user_id = ANY($2)
+		UNION ALL
+		SELECT a.user_id, 'question_set', ci.exam_id::text, MIN(COALESCE(a.submitted_at, a.started_at))
+		FROM library_collection_items ci
+		JOIN exam_attempts a ON a.exam_id = ci.exam_id
+		WHERE ci.collection_id = $1 AND a.user_id = ANY($2) AND a.status IN ('submitted', 'graded')
+		GROUP BY a.user_id, ci.exam_id`, id, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("list collection progress: %w", err)
+	}
+	defer rows.Close()
+
+	var progress []LibraryCollectionProgress
+	for rows.Next() {
+		var p LibraryCollectionProgress
+		if err := rows.Scan(&p.UserID, &p.Kind, &p.ItemID, &p.CompletedAt); err != nil {
+			return nil, fmt.Errorf("scan collection progress: %w", err)
+		}
+		progress = append(progress, p)
+	}
+	return progress, rows.Err()
+}
+
+// ListProgressUsers returns the users who marked a member of the collection as done
+func (r *LibraryCollectionRepository) ListProgressUsers(ctx context.Context, id string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT DISTINCT user_id FROM library_collection_progress
+		WHERE collection_id = $1 ORDER BY user_id`, id)
+	if err != nil {
+		return nil, fmt.Errorf("list collection progress users: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("scan collection progress user: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+// touchCollection locks a collection for a content change and bumps its updated_at
+func touchCollection(ctx context.Context, tx *sql.Tx, id string) error {
+	res, err := tx.ExecContext(ctx, `UPDATE library_collections SET updated_at = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("lock collection: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func insertCollectionItem(ctx context.Context, tx *sql.Tx, id string, section, position int, item LibraryCollectionItem) error {
+	var libraryItemID, examID interface{}
+	if item.Kind == CollectionItemQuestionSet {
+		examID = item.ItemID
+	} else {
+		libraryItemID = item.ItemID
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO library_collection_items (collection_id, section_position, position, library_item_id, exam_id, note)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		id, section, position, libraryItemID, examID, item.Note); err != nil {
+		return fmt.Errorf("insert collection item: %w", err)
+	}
+	return nil
+}
@@ -33,6 +33,7 @@ type LibraryExamListFilters struct {
 	Search       string
 	SortBy       string
 	SortOrder    string
+	IDs          []string
 }
 
 // LibraryExamRepository exposes persistence operations for library exam resources.
@@ -63,6 +64,10 @@ func (r *libraryExamRepository) List(ctx context.Context, filters LibraryExamLis
 	args := []interface{}{}
 	conditions := []string{"li.type = 'exam'"}
 
+	if len(filters.IDs) > 0 {
+		args = append(args, pq.Array(filters.IDs))
+		conditions = append(conditions, fmt.Sprintf("li.id = ANY($%d)", len(args)))
+	}
 	if len(filters.Subjects) > 0 {
 		args = append(args, pq.Array(filters.Subjects))
 		conditions = append(conditions, fmt.Sprintf("em.subject = ANY($%d)", len(args)))
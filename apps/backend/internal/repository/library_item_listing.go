@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// libraryRoleRank mirrors the role hierarchy used for library access checks
+var libraryRoleRank = map[string]int{
+	"GUEST":   0,
+	"STUDENT": 1,
+	"TUTOR":   2,
+	"TEACHER": 3,
+	"ADMIN":   4,
+}
+
+// librarySortKey is a keyset sort option: the ordered expression and the type its
+// cursor value is cast back to
+type librarySortKey struct {
+	expr string
+	cast string
+}
+
+var librarySortKeys = map[string]librarySortKey{
+	"created_at":     {expr: "li.created_at", cast: "timestamptz"},
+	"download_count": {expr: "li.download_count", cast: "integer"},
+	"rating":         {expr: "li.average_rating", cast: "numeric"},
+	"title":          {expr: "LOWER(li.name)", cast: "text"},
+}
+
+// LibraryItemListFilters describes a listing over books, exams and videos. Exam and
+// video filters only narrow items of their own type, like the per-type listings.
+type LibraryItemListFilters struct {
+	Types        []string // book, exam, video; empty lists every type
+	OnlyActive   bool
+	Search       string
+	Subjects     []string // exams and videos
+	Grades       []string // exams and videos
+	Province     string
+	AcademicYear string
+	Semester     string
+	Difficulty   string
+	ExamType     string
+	VideoQuality string
+
+	// Items found by searching file text match Search even when their metadata does not
+	SearchItemIDs []string
+
+	// When set, only these items are listed, e.g. to check access to recommendations
+	ItemIDs []string
+
+	// Items the viewer may not open are left out, so totals match what is returned
+	ViewerRole  string
+	ViewerLevel int
+
+	// When ScopeOrganisations is set, only public items and items of these organisations are listed
+	ScopeOrganisations bool
+	OrganisationIDs    []string
+
+	SortBy    string // created_at (default), download_count, rating, title
+	SortOrder string // desc (default) or asc
+	Limit     int
+	Offset    int    // Ignored when Cursor is set
+	Cursor    string // NextCursor of the previous page
+}
+
+// LibraryItemRef identifies an item of a listing page
+type LibraryItemRef struct {
+	ID   string
+	Type string
+}
+
+// LibraryItemPage is one page of a library listing
+type LibraryItemPage struct {
+	Items      []LibraryItemRef
+	Total      int    // Items matching the filters, across all pages
+	NextCursor string // Empty on the last page
+}
+
+// libraryCursor is the decoded form of an opaque listing cursor; it carries the sort
+// so a cursor cannot be replayed against a different ordering
+type libraryCursor struct {
+	SortBy string `json:"s"`
+	Order  string `json:"o"`
+	Key    string `json:"k"`
+	ID     string `json:"id"`
+}
+
+func encodeLibraryCursor(c libraryCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeLibraryCursor(value string) (libraryCursor, error) {
+	var c libraryCursor
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return c, ErrInvalidInput
+	}
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == "" {
+		return c, ErrInvalidInput
+	}
+	return c, nil
+}
+
+// normalizeLibrarySort resolves the sort option; "name" is accepted for title
+func normalizeLibrarySort(sortBy, sortOrder string) (string, string) {
+	sortBy = strings.ToLower(strings.TrimSpace(sortBy))
+	if sortBy == "name" {
+		sortBy = "title"
+	}
+	if _, ok := librarySortKeys[sortBy]; !ok {
+		sortBy = "created_at"
+	}
+	if strings.EqualFold(strings.TrimSpace(sortOrder), "asc") {
+		return sortBy, "asc"
+	}
+	return sortBy, "desc"
+}
+
+// libraryListQuery holds the count and page statements of a listing
+type libraryListQuery struct {
+	countSQL  string
+	countArgs []interface{}
+	pageSQL   string
+	pageArgs  []interface{}
+	sortBy    string
+	order     string
+}
+
+// ListVisible returns one page of library items the viewer may open. Access rules,
+// organisation scoping and keyset pagination are all evaluated by the database, so
+// the cost of a page does not depend on how deep it is.
+func (r *libraryItemRepository) ListVisible(ctx context.Context, filters LibraryItemListFilters) (*LibraryItemPage, error) {
+	if filters.Limit <= 0 || filters.Limit > 100 {
+		filters.Limit = 20
+	}
+	if filters.Offset < 0 {
+		filters.Offset = 0
+	}
+	q, err := buildLibraryListQuery(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, q.countSQL, q.countArgs...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, q.pageSQL, q.pageArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &LibraryItemPage{Total: total, Items: make([]LibraryItemRef, 0, filters.Limit)}
+	var lastKey string
+	for rows.Next() {
+		var item LibraryItemRef
+		var key string
+		if err := rows.Scan(&item.ID, &item.Type, &key); err != nil {
+			return nil, err
+		}
+		if len(page.Items) == filters.Limit {
+			// The extra row only signals that another page exists
+			last := page.Items[len(page.Items)-1]
+			page.NextCursor = encodeLibraryCursor(libraryCursor{SortBy: q.sortBy, Order: q.order, Key: lastKey, ID: last.ID})
+			break
+		}
+		page.Items = append(page.Items, item)
+		lastKey = key
+	}
+	return page, rows.Err()
+}
+
+// buildLibraryListQuery builds the statements ListVisible runs for normalized filters.
+// The page statement reads limit+1 rows to detect whether another page exists.
+func buildLibraryListQuery(filters LibraryItemListFilters) (*libraryListQuery, error) {
+	sortBy, order := normalizeLibrarySort(filters.SortBy, filters.SortOrder)
+	sortKey := librarySortKeys[sortBy]
+
+	var cursor *libraryCursor
+	if filters.Cursor != "" {
+		c, err := decodeLibraryCursor(filters.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.SortBy != sortBy || c.Order != order {
+			return nil, ErrInvalidInput
+		}
+		cursor = &c
+	}
+
+	args := []interface{}{}
+	conditions := libraryListConditions(filters, &args)
+	fromClause := `
+		FROM library_items li
+		LEFT JOIN book_metadata bm ON bm.library_item_id = li.id AND li.type = 'book'
+		LEFT JOIN exam_metadata em ON em.library_item_id = li.id AND li.type = 'exam'
+		LEFT JOIN video_metadata vm ON vm.library_item_id = li.id AND li.type = 'video'
+		WHERE ` + strings.Join(conditions, " AND ")
+	q := &libraryListQuery{
+		countSQL:  "SELECT COUNT(*)" + fromClause,
+		countArgs: append([]interface{}(nil), args...),
+		sortBy:    sortBy,
+		order:     order,
+	}
+
+	direction, comparison := "DESC", "<"
+	if order == "asc" {
+		direction, comparison = "ASC", ">"
+	}
+
+	pageClause := ""
+	if cursor != nil {
+		args = append(args, cursor.Key, cursor.ID)
+		pageClause = fmt.Sprintf(" AND (%s, li.id) %s ($%d::%s, $%d)",
+			sortKey.expr, comparison, len(args)-1, sortKey.cast, len(args))
+	}
+	args = append(args, filters.Limit+1)
+	limitClause := fmt.Sprintf("LIMIT $%d", len(args))
+	if cursor == nil && filters.Offset > 0 {
+		args = append(args, filters.Offset)
+		limitClause += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	q.pageSQL = fmt.Sprintf(`SELECT li.id, li.type, (%s)::text%s%s
+		ORDER BY %s %s, li.id %s
+		%s`, sortKey.expr, fromClause, pageClause, sortKey.expr, direction, direction, limitClause)
+	q.pageArgs = args
+	return q, nil
+}
+
+// libraryListConditions builds the WHERE predicates shared by the count and page queries
+func libraryListConditions(filters LibraryItemListFilters, args *[]interface{}) []string {
+	arg := func(value interface{}) string {
+		*args = append(*args, value)
+		return fmt.Sprintf("$%d", len(*args))
+	}
+
+	conditions := []string{
+		// Items without their metadata row are not listable, as with the per-type listings
+		"(bm.library_item_id IS NOT NULL OR em.library_item_id IS NOT NULL OR vm.library_item_id IS NOT NULL)",
+	}
+	if len(filters.Types) > 0 {
+		conditions = append(conditions, "li.type = ANY("+arg(pq.Array(filters.Types))+")")
+	}
+	if filters.ItemIDs != nil {
+		conditions = append(conditions, "li.id = ANY("+arg(pq.Array(filters.ItemIDs))+")")
+	}
+	if filters.OnlyActive {
+		conditions = append(conditions, "li.is_active = TRUE")
+	}
+	if search := strings.TrimSpace(filters.Search); search != "" {
+		p := arg("%" + search + "%")
+		match := fmt.Sprintf("li.name ILIKE %s OR li.description ILIKE %s OR bm.author ILIKE %s OR bm.publisher ILIKE %s", p, p, p, p)
+		if len(filters.SearchItemIDs) > 0 {
+			match += " OR li.id = ANY(" + arg(pq.Array(filters.SearchItemIDs)) + ")"
+		}
+		conditions = append(conditions, "("+match+")")
+	}
+	if len(filters.Subjects) > 0 {
+		conditions = append(conditions, "(li.type = 'book' OR COALESCE(em.subject, vm.subject) = ANY("+arg(pq.Array(filters.Subjects))+"))")
+	}
+	if len(filters.Grades) > 0 {
+		conditions = append(conditions, "(li.type = 'book' OR COALESCE(em.grade, vm.grade) = ANY("+arg(pq.Array(filters.Grades))+"))")
+	}
+	examFilters := []struct{ column, value string }{
+		{"em.province", filters.Province},
+		{"em.academic_year", filters.AcademicYear},
+		{"em.semester", filters.Semester},
+		{"em.difficulty_level", filters.Difficulty},
+		{"em.exam_type", filters.ExamType},
+	}
+	for _, f := range examFilters {
+		if value := strings.TrimSpace(f.value); value != "" {
+			conditions = append(conditions, fmt.Sprintf("(li.type <> 'exam' OR %s = %s)", f.column, arg(value)))
+		}
+	}
+	if quality := strings.TrimSpace(filters.VideoQuality); quality != "" {
+		conditions = append(conditions, "(li.type <> 'video' OR vm.quality = "+arg(quality)+")")
+	}
+
+	conditions = append(conditions, libraryAccessConditions(filters.ViewerRole, filters.ViewerLevel, arg)...)
+
+	if filters.ScopeOrganisations {
+		conditions = append(conditions, "(li.organisation_id IS NULL OR li.organisation_id = ANY("+arg(pq.Array(filters.OrganisationIDs))+"))")
+	}
+	return conditions
+}
+
+// libraryAccessConditions expresses the library RBAC rules as predicates: the viewer
+// must rank at least the required role (missing or unknown roles count as GUEST), reach
+// the required level, and be targeted unless the item targets nobody or the viewer is ADMIN
+func libraryAccessConditions(role string, level int, arg func(interface{}) string) []string {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	requiredRole := "UPPER(TRIM(COALESCE(bm.required_role, em.required_role, vm.required_role, '')))"
+	targetRoles := "COALESCE(bm.target_roles, em.target_roles, vm.target_roles)"
+
+	rank := "CASE " + requiredRole
+	for _, r := range []string{"STUDENT", "TUTOR", "TEACHER", "ADMIN"} {
+		rank += fmt.Sprintf(" WHEN '%s' THEN %d", r, libraryRoleRank[r])
+	}
+	rank += " ELSE 0 END"
+
+	conditions := []string{
+		fmt.Sprintf("%s <= %s", rank, arg(libraryRoleRank[role])),
+		fmt.Sprintf("COALESCE(bm.required_level, em.required_level, vm.required_level, 0) <= %s", arg(level)),
+	}
+	if role != "ADMIN" {
+		conditions = append(conditions, fmt.Sprintf(
+			"(NOT EXISTS (SELECT 1 FROM unnest(%s) AS tr(role) WHERE TRIM(tr.role) <> '')"+
+				" OR EXISTS (SELECT 1 FROM unnest(%s) AS tr(role) WHERE UPPER(TRIM(tr.role)) IN (%s, 'ALL')))",
+			targetRoles, targetRoles, arg(role)))
+	}
+	return conditions
+}
@@ -0,0 +1,194 @@
+//go:build integration
+
+package repository
+
+// These tests run the library listing against PostgreSQL. Point TEST_DATABASE_URL at a
+// migrated database; the tables are copied into a scratch schema that is dropped again:
+//
+//	TEST_DATABASE_URL=postgres://... go test -tags integration -run ListVisible -bench ListVisible ./internal/repository/
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	listingSchema = "library_listing_test"
+	listingRows   = 20000
+)
+
+// openListingDB seeds listingRows books into a scratch schema with the keyset indexes of
+// migration 000054 and returns a connection whose search_path points at it
+func openListingDB(tb testing.TB) *sql.DB {
+	tb.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		tb.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	admin, err := sql.Open("postgres", dsn)
+	require.NoError(tb, err)
+	defer admin.Close()
+	setup := []string{
+		"DROP SCHEMA IF EXISTS " + listingSchema + " CASCADE",
+		"CREATE SCHEMA " + listingSchema,
+	}
+	for _, table := range []string{"library_items", "book_metadata", "exam_metadata", "video_metadata"} {
+		setup = append(setup, fmt.Sprintf("CREATE TABLE %s.%s (LIKE public.%s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)", listingSchema, table, table))
+	}
+	for _, stmt := range setup {
+		_, err := admin.Exec(stmt)
+		require.NoError(tb, err, stmt)
+	}
+
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+	db, err := sql.Open("postgres", dsn+separator+"search_path="+listingSchema)
+	require.NoError(tb, err)
+	tb.Cleanup(func() {
+		db.Close()
+		if admin, err := sql.Open("postgres", dsn); err == nil {
+			_, _ = admin.Exec("DROP SCHEMA IF EXISTS " + listingSchema + " CASCADE")
+			admin.Close()
+		}
+	})
+
+	migration, err := os.ReadFile("../database/migrations/000054_library_listing_keyset.up.sql")
+	require.NoError(tb, err)
+	seed := []string{
+		"ALTER TABLE library_items ADD PRIMARY KEY (id)",
+		"CREATE UNIQUE INDEX ON book_metadata(library_item_id)",
+		"CREATE INDEX ON exam_metadata(library_item_id)",
+		"CREATE INDEX ON video_metadata(library_item_id)",
+		string(migration),
+		fmt.Sprintf(`INSERT INTO library_items (id, name, type, download_count, average_rating, created_at)
+			SELECT format('item-%%s', lpad(g::text, 6, '0')), 'Sách ' || g, 'book', g %% 997, (g %% 500) / 100.0,
+				NOW() - g * INTERVAL '1 minute'
+			FROM generate_series(1, %d) g`, listingRows),
+		`INSERT INTO book_metadata (id, library_item_id, subject, grade, book_type)
+			SELECT 'bm-' || id, id, 'math', '10', 'textbook' FROM library_items`,
+		"ANALYZE",
+	}
+	for _, stmt := range seed {
+		_, err := db.Exec(stmt)
+		require.NoError(tb, err, stmt)
+	}
+	return db
+}
+
+// planNodes flattens an EXPLAIN (FORMAT JSON) plan
+func planNodes(node map[string]interface{}) []map[string]interface{} {
+	nodes := []map[string]interface{}{node}
+	children, _ := node["Plans"].([]interface{})
+	for _, child := range children {
+		if c, ok := child.(map[string]interface{}); ok {
+			nodes = append(nodes, planNodes(c)...)
+		}
+	}
+	return nodes
+}
+
+func TestLibraryItemRepository_ListVisible_KeysetUsesIndex(t *testing.T) {
+	db := openListingDB(t)
+	repo := NewLibraryItemRepository(db)
+	ctx := context.Background()
+
+	indexes := map[string]string{
+		"created_at":     "idx_library_items_keyset_created",
+		"download_count": "idx_library_items_keyset_downloads",
+		"rating":         "idx_library_items_keyset_rating",
+		"title":          "idx_library_items_keyset_title",
+	}
+	for sortBy, index := range indexes {
+		for _, order := range []string{"desc", "asc"} {
+			t.Run(sortBy+"_"+order, func(t *testing.T) {
+				filters := LibraryItemListFilters{ViewerRole: "STUDENT", SortBy: sortBy, SortOrder: order, Limit: 20}
+				first, err := repo.ListVisible(ctx, filters)
+				require.NoError(t, err)
+				require.Len(t, first.Items, 20)
+				require.NotEmpty(t, first.NextCursor)
+
+				// The cursor page continues exactly where the first page stopped
+				filters.Cursor = first.NextCursor
+				next, err := repo.ListVisible(ctx, filters)
+				require.NoError(t, err)
+				filters.Cursor, filters.Offset, filters.Limit = "", 0, 40
+				both, err := repo.ListVisible(ctx, filters)
+				require.NoError(t, err)
+				require.Equal(t, both.Items[20:], next.Items)
+
+				filters.Cursor, filters.Limit = first.NextCursor, 20
+				q, err := buildLibraryListQuery(filters)
+				require.NoError(t, err)
+				var raw string
+				require.NoError(t, db.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+q.pageSQL, q.pageArgs...).Scan(&raw))
+				var plan []struct {
+					Plan map[string]interface{} `json:"Plan"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(raw), &plan))
+				require.Len(t, plan, 1)
+
+				usesIndex := false
+				for _, node := range planNodes(plan[0].Plan) {
+					require.NotEqual(t, "Sort", node["Node Type"], "cursor page sorts instead of reading %s: %s", index, raw)
+					if node["Node Type"] == "Index Scan" && node["Index Name"] == index {
+						usesIndex = true
+					}
+				}
+				require.True(t, usesIndex, "cursor page does not scan %s: %s", index, raw)
+			})
+		}
+	}
+}
+
+// BenchmarkLibraryItemRepository_ListVisibleDepth fetches pages at increasing depths.
+// The keyset predicate seeks into the (sort key, id) index, so ns/op stays flat as the
+// page number grows.
+func BenchmarkLibraryItemRepository_ListVisibleDepth(b *testing.B) {
+	const limit = 20
+	db := openListingDB(b)
+	repo := NewLibraryItemRepository(db)
+	ctx := context.Background()
+
+	for _, depth := range []int{1, 50, 500} {
+		b.Run(fmt.Sprintf("page=%d", depth), func(b *testing.B) {
+			// The cursor of the last row of the previous page
+			cursor := ""
+			if depth > 1 {
+				var id, key string
+				err := db.QueryRowContext(ctx, `SELECT id, download_count::text FROM library_items
+					ORDER BY download_count DESC, id DESC OFFSET $1 LIMIT 1`, (depth-1)*limit-1).Scan(&id, &key)
+				if err != nil {
+					b.Fatal(err)
+				}
+				cursor = encodeLibraryCursor(libraryCursor{SortBy: "download_count", Order: "desc", Key: key, ID: id})
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				page, err := repo.ListVisible(ctx, LibraryItemListFilters{
+					ViewerRole: "STUDENT",
+					SortBy:     "download_count",
+					Limit:      limit,
+					Cursor:     cursor,
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(page.Items) != limit || page.NextCursor == "" {
+					b.Fatalf("unexpected page %+v", page)
+				}
+			}
+		})
+	}
+}
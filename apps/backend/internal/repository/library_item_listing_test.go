@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var listingColumns = []string{"id", "type", "text"}
+
+func TestLibraryItemRepository_ListVisible_FirstPage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLibraryItemRepository(db)
+	filters := LibraryItemListFilters{
+		Types:              []string{"book", "exam"},
+		Province:           "Hanoi",
+		ViewerRole:         "student",
+		ViewerLevel:        3,
+		ScopeOrganisations: true,
+		OrganisationIDs:    []string{"org-1"},
+		Limit:              2,
+	}
+
+	// type, province, role rank, level, target role, organisations
+	filterArgs := []driver.Value{pq.Array([]string{"book", "exam"}), "Hanoi", 1, 3, "STUDENT", pq.Array([]string{"org-1"})}
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM library_items li.*li.type = ANY\(\$1\).*\(li.type <> 'exam' OR em.province = \$2\).*<= \$3.*<= \$4.*IN \(\$5, 'ALL'\).*li.organisation_id = ANY\(\$6\)`).
+		WithArgs(filterArgs...).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	mock.ExpectQuery(`SELECT li.id, li.type, \(li.created_at\)::text\s+FROM library_items li.*ORDER BY li.created_at DESC, li.id DESC\s+LIMIT \$7$`).
+		WithArgs(append(filterArgs, 3)...).
+		WillReturnRows(sqlmock.NewRows(listingColumns).
+			AddRow("b-3", "book", "2026-03-03 10:00:00+00").
+			AddRow("e-2", "exam", "2026-03-02 10:00:00+00").
+			AddRow("b-1", "book", "2026-03-01 10:00:00+00"))
+
+	page, err := repo.ListVisible(context.Background(), filters)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Equal(t, 5, page.Total)
+	require.Equal(t, []LibraryItemRef{{ID: "b-3", Type: "book"}, {ID: "e-2", Type: "exam"}}, page.Items)
+	require.NotEmpty(t, page.NextCursor)
+
+	cursor, err := decodeLibraryCursor(page.NextCursor)
+	require.NoError(t, err)
+	require.Equal(t, libraryCursor{SortBy: "created_at", Order: "desc", Key: "2026-03-02 10:00:00+00", ID: "e-2"}, cursor)
+}
+
+func TestLibraryItemRepository_ListVisible_Cursor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLibraryItemRepository(db)
+	cursor := encodeLibraryCursor(libraryCursor{SortBy: "title", Order: "asc", Key: "algebra", ID: "v-9"})
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+		WithArgs(3, 0, "TEACHER").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (LOWER(li.name), li.id) > ($4::text, $5)
+		ORDER BY LOWER(li.name) ASC, li.id ASC
+		LIMIT $6`)).
+		WithArgs(3, 0, "TEACHER", "algebra", "v-9", 11).
+		WillReturnRows(sqlmock.NewRows(listingColumns).AddRow("b-2", "book", "biology"))
+
+	page, err := repo.ListVisible(context.Background(), LibraryItemListFilters{
+		ViewerRole: "TEACHER",
+		SortBy:     "name",
+		SortOrder:  "ASC",
+		Limit:      10,
+		Offset:     40, // ignored with a cursor
+		Cursor:     cursor,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 3, page.Total)
+	require.Equal(t, []LibraryItemRef{{ID: "b-2", Type: "book"}}, page.Items)
+	require.Empty(t, page.NextCursor)
+}
+
+func TestLibraryItemRepository_ListVisible_AdminAndOffset(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(expected, actual string) error {
+		if strings.Contains(actual, "unnest") {
+			return fmt.Errorf("admins are not filtered by target roles: %s", actual)
+		}
+		return sqlmock.QueryMatcherRegexp.Match(expected, actual)
+	})))
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLibraryItemRepository(db)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+		WithArgs(4, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`ORDER BY li.download_count DESC, li.id DESC\s+LIMIT \$3 OFFSET \$4`).
+		WithArgs(4, 0, 21, 20).
+		WillReturnRows(sqlmock.NewRows(listingColumns))
+
+	page, err := repo.ListVisible(context.Background(), LibraryItemListFilters{
+		ViewerRole: "ADMIN",
+		SortBy:     "download_count",
+		Offset:     20,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Empty(t, page.Items)
+	require.Empty(t, page.NextCursor)
+}
+
+func TestLibraryItemRepository_ListVisible_SearchIncludesContentMatches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLibraryItemRepository(db)
+
+	searchArgs := []driver.Value{"%hình học%", pq.Array([]string{"e-7"}), 4, 0}
+	mock.ExpectQuery(regexp.QuoteMeta(`bm.publisher ILIKE $1 OR li.id = ANY($2))`)).
+		WithArgs(searchArgs...).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`LIMIT \$5$`).
+		WithArgs(append(searchArgs, 21)...).
+		WillReturnRows(sqlmock.NewRows(listingColumns).AddRow("e-7", "exam", "2026-03-01 10:00:00+00"))
+
+	page, err := repo.ListVisible(context.Background(), LibraryItemListFilters{
+		Search:        " hình học ",
+		SearchItemIDs: []string{"e-7"},
+		ViewerRole:    "ADMIN",
+		Limit:         20,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []LibraryItemRef{{ID: "e-7", Type: "exam"}}, page.Items)
+}
+
+func TestLibraryItemRepository_ListVisible_RestrictsToItemIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLibraryItemRepository(db)
+
+	args := []driver.Value{pq.Array([]string{"b-1", "e-2"}), 4, 0}
+	mock.ExpectQuery(regexp.QuoteMeta(`li.id = ANY($1)`)).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`LIMIT \$4$`).
+		WithArgs(append(args, 101)...).
+		WillReturnRows(sqlmock.NewRows(listingColumns).AddRow("e-2", "exam", "2026-03-01 10:00:00+00"))
+
+	page, err := repo.ListVisible(context.Background(), LibraryItemListFilters{
+		ItemIDs:    []string{"b-1", "e-2"},
+		ViewerRole: "ADMIN",
+		Limit:      100,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []LibraryItemRef{{ID: "e-2", Type: "exam"}}, page.Items)
+}
+
+func TestLibraryItemRepository_ListVisible_InvalidCursor(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewLibraryItemRepository(db)
+	ctx := context.Background()
+
+	_, err = repo.ListVisible(ctx, LibraryItemListFilters{Cursor: "not a cursor"})
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	// A cursor only continues the ordering it was issued for
+	rating := encodeLibraryCursor(libraryCursor{SortBy: "rating", Order: "desc", Key: "4.50", ID: "b-1"})
+	_, err = repo.ListVisible(ctx, LibraryItemListFilters{SortBy: "created_at", Cursor: rating})
+	require.ErrorIs(t, err, ErrInvalidInput)
+	_, err = repo.ListVisible(ctx, LibraryItemListFilters{SortBy: "rating", SortOrder: "asc", Cursor: rating})
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
@@ -11,10 +11,11 @@ import (
 
 // LibraryItemAccess contains minimal information used for RBAC checks.
 type LibraryItemAccess struct {
-	ItemType      string
-	RequiredRole  string
-	RequiredLevel sql.NullInt32
-	TargetRoles   []string
+	ItemType       string
+	RequiredRole   string
+	RequiredLevel  sql.NullInt32
+	TargetRoles    []string
+	OrganisationID string // Empty when the item is visible outside any organisation
 }
 
 // LibraryItemRepository groups generic operations on library_items.
@@ -22,6 +23,7 @@ type LibraryItemRepository interface {
 	UpdateApproval(ctx context.Context, itemID, status string, reviewerID *string) error
 	UpdateRatingAggregate(ctx context.Context, itemID string, average float64, count int) error
 	GetAccessMetadata(ctx context.Context, itemID string) (LibraryItemAccess, error)
+	ListVisible(ctx context.Context, filters LibraryItemListFilters) (*LibraryItemPage, error)
 }
 
 type libraryItemRepository struct {
@@ -82,13 +84,14 @@ func (r *libraryItemRepository) GetAccessMetadata(ctx context.Context, itemID st
 		SELECT li.type,
 		       COALESCE(bm.required_role, em.required_role, vm.required_role, 'GUEST'),
 		       COALESCE(bm.required_level, em.required_level, vm.required_level),
-		       COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY['GUEST'])
+		       COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY['GUEST']),
+		       COALESCE(li.organisation_id, '')
 		FROM library_items li
 		LEFT JOIN book_metadata bm ON bm.library_item_id = li.id
 		LEFT JOIN exam_metadata em ON em.library_item_id = li.id
 		LEFT JOIN video_metadata vm ON vm.library_item_id = li.id
 		WHERE li.id = $1
-	`, itemID).Scan(&access.ItemType, &access.RequiredRole, &access.RequiredLevel, pqArrayScanner(&targetRoles))
+	`, itemID).Scan(&access.ItemType, &access.RequiredRole, &access.RequiredLevel, pqArrayScanner(&targetRoles), &access.OrganisationID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return LibraryItemAccess{}, ErrNotFound
@@ -106,7 +106,8 @@ func TestLibraryItemRepository_GetAccessMetadata(t *testing.T) {
 		SELECT li.type,
 		       COALESCE(bm.required_role, em.required_role, vm.required_role, 'GUEST'),
 		       COALESCE(bm.required_level, em.required_level, vm.required_level),
-		       COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY['GUEST'])
+		       COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY['GUEST']),
+		       COALESCE(li.organisation_id, '')
 		FROM library_items li
 		LEFT JOIN book_metadata bm ON bm.library_item_id = li.id
 		LEFT JOIN exam_metadata em ON em.library_item_id = li.id
@@ -114,8 +115,8 @@ func TestLibraryItemRepository_GetAccessMetadata(t *testing.T) {
 		WHERE li.id = $1
 	`)).
 		WithArgs("item-1").
-		WillReturnRows(sqlmock.NewRows([]string{"type", "required_role", "required_level", "target_roles"}).
-			AddRow("video", "STUDENT", int32(3), "{STUDENT,TUTOR}"))
+		WillReturnRows(sqlmock.NewRows([]string{"type", "required_role", "required_level", "target_roles", "organisation_id"}).
+			AddRow("video", "STUDENT", int32(3), "{STUDENT,TUTOR}", "org-1"))
 
 	access, err := repo.GetAccessMetadata(ctx, "item-1")
 	require.NoError(t, err)
@@ -124,6 +125,7 @@ func TestLibraryItemRepository_GetAccessMetadata(t *testing.T) {
 	require.True(t, access.RequiredLevel.Valid)
 	require.Equal(t, int32(3), access.RequiredLevel.Int32)
 	require.ElementsMatch(t, []string{"STUDENT", "TUTOR"}, access.TargetRoles)
+	require.Equal(t, "org-1", access.OrganisationID)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -139,7 +141,8 @@ func TestLibraryItemRepository_GetAccessMetadata_NotFound(t *testing.T) {
 		SELECT li.type,
 		       COALESCE(bm.required_role, em.required_role, vm.required_role, 'GUEST'),
 		       COALESCE(bm.required_level, em.required_level, vm.required_level),
-		       COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY['GUEST'])
+		       COALESCE(bm.target_roles, em.target_roles, vm.target_roles, ARRAY['GUEST']),
+		       COALESCE(li.organisation_id, '')
 		FROM library_items li
 		LEFT JOIN book_metadata bm ON bm.library_item_id = li.id
 		LEFT JOIN exam_metadata em ON em.library_item_id = li.id
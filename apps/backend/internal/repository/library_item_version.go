@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// LibraryItemFile is the file a library item serves
+type LibraryItemFile struct {
+	URL  string
+	ID   string // Blob store key of uploaded files
+	Size sql.NullInt64
+	Type string
+}
+
+// Empty reports whether the file has no location
+func (f LibraryItemFile) Empty() bool {
+	return f.URL == "" && f.ID == ""
+}
+
+// Same reports whether both files point at the same location
+func (f LibraryItemFile) Same(other LibraryItemFile) bool {
+	return f.URL == other.URL && f.ID == other.ID
+}
+
+// LibraryItemVersion is one file of a library item with its changelog and downloads
+type LibraryItemVersion struct {
+	ItemID        string
+	Version       int
+	File          LibraryItemFile
+	Changelog     string
+	RestoredFrom  int // Version whose file was restored; 0 for new files
+	CreatedBy     string
+	CreatedByName string
+	CreatedAt     time.Time
+
+	Downloads   int64 // Downloads while the version was current
+	Downloaders int64 // Distinct signed-in users among them
+}
+
+// NewLibraryItemVersion is a file about to become the current version of an item
+type NewLibraryItemVersion struct {
+	ItemID       string
+	File         LibraryItemFile
+	Changelog    string
+	RestoredFrom int
+	CreatedBy    string
+}
+
+// LibraryItemVersionRepository keeps the file history of library items
+type LibraryItemVersionRepository struct {
+	db *sql.DB
+}
+
+// NewLibraryItemVersionRepository creates a new library item version repository
+func NewLibraryItemVersionRepository(db *sql.DB) *LibraryItemVersionRepository {
+	return &LibraryItemVersionRepository{db: db}
+}
+
+const libraryItemVersionColumns = `
+	v.library_item_id, v.version, COALESCE(v.file_url, ''), COALESCE(v.file_id, ''), v.file_size,
+	COALESCE(v.file_type, ''), v.changelog, COALESCE(v.restored_from, 0), COALESCE(v.created_by, ''),
+	TRIM(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')), v.created_at`
+
+// ListVersions returns the versions of an item, newest first, with the downloads made
+// while each was current
+func (r *LibraryItemVersionRepository) ListVersions(ctx context.Context, itemID string) ([]LibraryItemVersion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+libraryItemVersionColumns+`, stats.downloads, stats.downloaders
+		FROM library_item_versions v
+		LEFT JOIN users u ON u.id = v.created_by
+		LEFT JOIN LATERAL (
+			SELECT created_at FROM library_item_versions n
+			WHERE n.library_item_id = v.library_item_id AND n.version > v.version
+			ORDER BY n.version LIMIT 1
+		) next ON TRUE
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS downloads, COUNT(DISTINCT d.user_id) AS downloaders
+			FROM download_history d
+			WHERE d.library_item_id = v.library_item_id
+			  AND d.downloaded_at >= v.created_at
+			  AND (next.created_at IS NULL OR d.downloaded_at < next.created_at)
+		) stats
+		WHERE v.library_item_id = $1
+		ORDER BY v.version DESC`, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("list library item versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []LibraryItemVersion
+	for rows.Next() {
+		var v LibraryItemVersion
+		dest := append(libraryItemVersionDest(&v), &v.Downloads, &v.Downloaders)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("scan library item version: %w", err)
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+// GetVersion returns one version of an item, or ErrNotFound
+func (r *LibraryItemVersionRepository) GetVersion(ctx context.Context, itemID string, version int) (*LibraryItemVersion, error) {
+	var v LibraryItemVersion
+	err := r.db.QueryRowContext(ctx, `
+		SELECT `+libraryItemVersionColumns+`
+		FROM library_item_versions v
+		LEFT JOIN users u ON u.id = v.created_by
+		WHERE v.library_item_id = $1 AND v.version = $2`, itemID, version,
+	).Scan(libraryItemVersionDest(&v)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get library item version: %w", err)
+	}
+	return &v, nil
+}
+
+// AddVersion makes a file the current file of an item and returns the new version with
+// the item name. An item without history first gets its current file as version 1.
+// Returns ErrNotFound for unknown items and ErrInvalidInput when the file is already
+// the current one.
+func (r *LibraryItemVersionRepository) AddVersion(ctx context.Context, in NewLibraryItemVersion) (*LibraryItemVersion, string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("begin add library item version: %w", err)
+	}
+	defer tx.Rollback()
+
+	var name string
+	var current LibraryItemFile
+	var uploadedBy sql.NullString
+	var createdAt time.Time
+	err = tx.QueryRowContext(ctx, `
+		SELECT name, COALESCE(file_url, ''), COALESCE(file_id, ''), file_size, COALESCE(file_type, ''),
+		       uploaded_by, created_at
+		FROM library_items WHERE id = $1
+		FOR UPDATE`, in.ItemID,
+	).Scan(&name, &current.URL, &current.ID, &current.Size, &current.Type, &uploadedBy, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", ErrNotFound
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("lock library item: %w", err)
+	}
+	if current.Same(in.File) {
+		return nil, "", fmt.Errorf("%w: file is already the current version", ErrInvalidInput)
+	}
+
+	var latest int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(version), 0) FROM library_item_versions WHERE library_item_id = $1`, in.ItemID,
+	).Scan(&latest); err != nil {
+		return nil, "", fmt.Errorf("find latest library item version: %w", err)
+	}
+	if latest == 0 && !current.Empty() {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO library_item_versions (library_item_id, version, file_url, file_id, file_size, file_type, created_by, created_at)
+			VALUES ($1, 1, NULLIF($2, ''), NULLIF($3, ''), $4, NULLIF($5, ''), $6, $7)`,
+			in.ItemID, current.URL, current.ID, current.Size, current.Type, uploadedBy, createdAt); err != nil {
+			return nil, "", fmt.Errorf("insert initial library item version: %w", err)
+		}
+		latest = 1
+	}
+
+	v := &LibraryItemVersion{
+		ItemID:       in.ItemID,
+		Version:      latest + 1,
+		File:         in.File,
+		Changelog:    in.Changelog,
+		RestoredFrom: in.RestoredFrom,
+		CreatedBy:    in.CreatedBy,
+	}
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO library_item_versions (library_item_id, version, file_url, file_id, file_size, file_type, changelog, restored_from, created_by)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, NULLIF($6, ''), $7, NULLIF($8, 0), NULLIF($9, ''))
+		RETURNING created_at`,
+		v.ItemID, v.Version, v.File.URL, v.File.ID, v.File.Size, v.File.Type, v.Changelog, v.RestoredFrom, v.CreatedBy,
+	).Scan(&v.CreatedAt); err != nil {
+		return nil, "", fmt.Errorf("insert library item version: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE library_items
+		SET file_url = NULLIF($2, ''), file_id = NULLIF($3, ''), file_size = $4, file_type = NULLIF($5, ''),
+		    file_sha256 = NULL, updated_at = $6
+		WHERE id = $1`,
+		v.ItemID, v.File.URL, v.File.ID, v.File.Size, v.File.Type, v.CreatedAt); err != nil {
+		return nil, "", fmt.Errorf("update library item file: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("commit library item version: %w", err)
+	}
+	return v, name, nil
+}
+
+// ListAudience returns the users who downloaded or bookmarked an item, except excludeUserID
+func (r *LibraryItemVersionRepository) ListAudience(ctx context.Context, itemID, excludeUserID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id FROM download_history WHERE library_item_id = $1 AND user_id IS NOT NULL
+		UNION
+		SELECT user_id FROM user_bookmarks WHERE library_item_id = $1
+		EXCEPT
+		SELECT $2::text`, itemID, excludeUserID)
+	if err != nil {
+		return nil, fmt.Errorf("list library item audience: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("scan library item audience: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
+
+func libraryItemVersionDest(v *LibraryItemVersion) []interface{} {
+	return []interface{}{&v.ItemID, &v.Version, &v.File.URL, &v.File.ID, &v.File.Size, &v.File.Type,
+		&v.Changelog, &v.RestoredFrom, &v.CreatedBy, &v.CreatedByName, &v.CreatedAt}
+}
@@ -0,0 +1,422 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Recommendation reasons
+const (
+	RecommendationReasonCoDownload  = "co_download"  // Similar items: downloaded by the same students
+	RecommendationReasonContent     = "content"      // Similar items: same subject, grade or tags
+	RecommendationReasonSimilarItem = "similar_item" // Personal: similar to an item the student used
+	RecommendationReasonWeakChapter = "weak_chapter" // Personal: covers a chapter the student struggles with
+)
+
+// recommendationInsertBatch is the number of rows written per INSERT
+const recommendationInsertBatch = 500
+
+// recommendableItem limits recommendations to items students can currently open
+const recommendableItem = `li.is_active = TRUE AND li.upload_status = 'approved'`
+
+// LibraryItemFeatures describes an item for content similarity and chapter matching
+type LibraryItemFeatures struct {
+	ID          string
+	Type        string
+	Title       string
+	Description string
+	Subject     string // Lower-cased
+	Grade       string
+	Tags        []string // Lower-cased
+	Downloads   int64
+}
+
+// LibraryItemPair scores how often two items are used by the same students
+type LibraryItemPair struct {
+	ItemID  string
+	OtherID string
+	Score   float64 // Cosine similarity of the items' student sets
+}
+
+// LibrarySimilarity is a precomputed similar item
+type LibrarySimilarity struct {
+	ItemID        string
+	SimilarItemID string
+	Score         float64
+	Reason        string
+}
+
+// LibraryInteraction is a download, bookmark or good rating of an item by a student
+type LibraryInteraction struct {
+	ItemID string
+	At     time.Time
+}
+
+// LibraryChapterResult is a student's graded answers in one chapter
+type LibraryChapterResult struct {
+	Code     string // Grade, subject and chapter characters of the question code
+	Answered int
+	Correct  int
+}
+
+// LibraryUserRecommendation is a precomputed personal recommendation
+type LibraryUserRecommendation struct {
+	ItemID       string
+	Score        float64
+	Reason       string
+	SourceItemID string
+	QuestionCode string
+	Explanation  string
+}
+
+// LibraryRecommendationRepository reads library usage and stores precomputed recommendations
+type LibraryRecommendationRepository struct {
+	db *sql.DB
+}
+
+// NewLibraryRecommendationRepository creates a new library recommendation repository
+func NewLibraryRecommendationRepository(db *sql.DB) *LibraryRecommendationRepository {
+	return &LibraryRecommendationRepository{db: db}
+}
+
+// ListItemFeatures returns every recommendable item with its subject, grade and tags
+func (r *LibraryRecommendationRepository) ListItemFeatures(ctx context.Context) ([]*LibraryItemFeatures, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT li.id, li.type, li.name, COALESCE(li.description, ''),
+		       LOWER(TRIM(COALESCE(bm.subject, em.subject, vm.subject, ''))),
+		       TRIM(COALESCE(bm.grade, em.grade, vm.grade, '')),
+		       COALESCE((
+		           SELECT array_agg(DISTINCT LOWER(t.name))
+		           FROM item_tags it JOIN tags t ON t.id = it.tag_id
+		           WHERE it.library_item_id = li.id
+		       ), '{}'),
+		       li.download_count
+		FROM library_items li
+		LEFT JOIN book_metadata bm ON bm.library_item_id = li.id AND li.type = 'book'
+		LEFT JOIN exam_metadata em ON em.library_item_id = li.id AND li.type = 'exam'
+		LEFT JOIN video_metadata vm ON vm.library_item_id = li.id AND li.type = 'video'
+		WHERE `+recommendableItem+`
+		ORDER BY li.id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item features: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*LibraryItemFeatures
+	for rows.Next() {
+		item := &LibraryItemFeatures{}
+		if err := rows.Scan(&item.ID, &item.Type, &item.Title, &item.Description, &item.Subject, &item.Grade,
+			pq.Array(&item.Tags), &item.Downloads); err != nil {
+			return nil, fmt.Errorf("failed to scan item features: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// ListCoDownloads returns, for each item, the perItem items most often used by the same
+// students since the given time. Pairs shared by fewer than minStudents are ignored.
+func (r *LibraryRecommendationRepository) ListCoDownloads(ctx context.Context, since time.Time, minStudents, perItem int) ([]LibraryItemPair, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH interactions AS (
+			SELECT user_id, library_item_id AS item_id FROM download_history
+			WHERE user_id IS NOT NULL AND downloaded_at >= $1
+			UNION
+			SELECT user_id, library_item_id FROM user_bookmarks WHERE created_at >= $1
+			UNION
+			SELECT user_id, library_item_id FROM item_ratings WHERE rating >= 4 AND created_at >= $1
+		),
+		item_students AS (
+			SELECT item_id, COUNT(*) AS students FROM interactions GROUP BY item_id
+		),
+		pairs AS (
+			SELECT a.item_id, b.item_id AS other_id, COUNT(*) AS together
+			FROM interactions a
+			JOIN interactions b ON b.user_id = a.user_id AND b.item_id <> a.item_id
+			GROUP BY a.item_id, b.item_id
+			HAVING COUNT(*) >= $2
+		),
+		ranked AS (
+			SELECT p.item_id, p.other_id,
+			       p.together / SQRT(ia.students::float8 * ib.students) AS score
+			FROM pairs p
+			JOIN item_students ia ON ia.item_id = p.item_id
+			JOIN item_students ib ON ib.item_id = p.other_id
+		)
+		SELECT item_id, other_id, score FROM (
+			SELECT ranked.*, ROW_NUMBER() OVER (PARTITION BY item_id ORDER BY score DESC, other_id) AS rank
+			FROM ranked
+		) top
+		WHERE rank <= $3
+	`, since, minStudents, perItem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list co-downloads: %w", err)
+	}
+	defer rows.Close()
+
+	var pairs []LibraryItemPair
+	for rows.Next() {
+		var p LibraryItemPair
+		if err := rows.Scan(&p.ItemID, &p.OtherID, &p.Score); err != nil {
+			return nil, fmt.Errorf("failed to scan co-download: %w", err)
+		}
+		pairs = append(pairs, p)
+	}
+	return pairs, rows.Err()
+}
+
+// ReplaceSimilarities swaps the whole similar-items table for a new computation
+func (r *LibraryRecommendationRepository) ReplaceSimilarities(ctx context.Context, similarities []LibrarySimilarity) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM library_item_similarities`); err != nil {
+		return fmt.Errorf("failed to clear similarities: %w", err)
+	}
+	for start := 0; start < len(similarities); start += recommendationInsertBatch {
+		end := start + recommendationInsertBatch
+		if end > len(similarities) {
+			end = len(similarities)
+		}
+		batch := similarities[start:end]
+		items := make([]string, len(batch))
+		others := make([]string, len(batch))
+		scores := make([]float64, len(batch))
+		reasons := make([]string, len(batch))
+		for i, s := range batch {
+			items[i], others[i], scores[i], reasons[i] = s.ItemID, s.SimilarItemID, s.Score, s.Reason
+		}
+		// Items deleted while the job ran are skipped rather than failing the insert
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO library_item_similarities (item_id, similar_item_id, score, reason)
+			SELECT s.item_id, s.similar_item_id, s.score, s.reason
+			FROM unnest($1::text[], $2::text[], $3::float8[], $4::text[]) AS s(item_id, similar_item_id, score, reason)
+			WHERE EXISTS (SELECT 1 FROM library_items WHERE id = s.item_id)
+			  AND EXISTS (SELECT 1 FROM library_items WHERE id = s.similar_item_id)
+		`, pq.Array(items), pq.Array(others), pq.Array(scores), pq.Array(reasons)); err != nil {
+			return fmt.Errorf("failed to insert similarities: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// ListSimilar returns the precomputed similar items of an item, best first
+func (r *LibraryRecommendationRepository) ListSimilar(ctx context.Context, itemID string, limit int) ([]LibrarySimilarity, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT s.item_id, s.similar_item_id, s.score, s.reason
+		FROM library_item_similarities s
+		JOIN library_items li ON li.id = s.similar_item_id
+		WHERE s.item_id = $1 AND `+recommendableItem+`
+		ORDER BY s.score DESC, s.similar_item_id
+		LIMIT $2
+	`, itemID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list similar items: %w", err)
+	}
+	defer rows.Close()
+
+	var similar []LibrarySimilarity
+	for rows.Next() {
+		var s LibrarySimilarity
+		if err := rows.Scan(&s.ItemID, &s.SimilarItemID, &s.Score, &s.Reason); err != nil {
+			return nil, fmt.Errorf("failed to scan similar item: %w", err)
+		}
+		similar = append(similar, s)
+	}
+	return similar, rows.Err()
+}
+
+// ListLearners returns, in ID order after afterID, users who used the library or took an
+// exam since the given time
+func (r *LibraryRecommendationRepository) ListLearners(ctx context.Context, since time.Time, afterID string, limit int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT u.id FROM users u
+		WHERE u.deleted_at IS NULL AND u.id > $2 AND (
+			EXISTS (SELECT 1 FROM download_history d WHERE d.user_id = u.id AND d.downloaded_at >= $1)
+			OR EXISTS (SELECT 1 FROM user_bookmarks b WHERE b.user_id = u.id AND b.created_at >= $1)
+			OR EXISTS (SELECT 1 FROM item_ratings ir WHERE ir.user_id = u.id AND ir.created_at >= $1)
+			OR EXISTS (SELECT 1 FROM exam_attempts a WHERE a.user_id = u.id AND a.submitted_at >= $1)
+		)
+		ORDER BY u.id
+		LIMIT $3
+	`, since, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list learners: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan learner: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListInteractions returns the items a user downloaded, bookmarked or rated 4 stars or
+// more, most recent first
+func (r *LibraryRecommendationRepository) ListInteractions(ctx context.Context, userID string, limit int) ([]LibraryInteraction, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT item_id, MAX(at) AS at FROM (
+			SELECT library_item_id AS item_id, downloaded_at AS at FROM download_history WHERE user_id = $1
+			UNION ALL
+			SELECT library_item_id, created_at FROM user_bookmarks WHERE user_id = $1
+			UNION ALL
+			SELECT library_item_id, created_at FROM item_ratings WHERE user_id = $1 AND rating >= 4
+		) used
+		GROUP BY item_id
+		ORDER BY at DESC, item_id
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interactions: %w", err)
+	}
+	defer rows.Close()
+
+	var interactions []LibraryInteraction
+	for rows.Next() {
+		var i LibraryInteraction
+		if err := rows.Scan(&i.ItemID, &i.At); err != nil {
+			return nil, fmt.Errorf("failed to scan interaction: %w", err)
+		}
+		interactions = append(interactions, i)
+	}
+	return interactions, rows.Err()
+}
+
+// ListChapterResults returns a user's graded answers since the given time, grouped by
+// the chapter of each question's code
+func (r *LibraryRecommendationRepository) ListChapterResults(ctx context.Context, userID string, since time.Time) ([]LibraryChapterResult, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT LEFT(q.question_code_id, 3) AS chapter,
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE ans.is_correct)
+		FROM exam_answers ans
+		JOIN exam_attempts a ON a.id = ans.attempt_id
+		JOIN question q ON q.id = ans.question_id
+		WHERE a.user_id = $1
+		  AND a.status IN ('submitted', 'graded')
+		  AND a.submitted_at >= $2
+		  AND ans.is_correct IS NOT NULL
+		GROUP BY LEFT(q.question_code_id, 3)
+		ORDER BY chapter
+	`, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chapter results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []LibraryChapterResult
+	for rows.Next() {
+		var c LibraryChapterResult
+		if err := rows.Scan(&c.Code, &c.Answered, &c.Correct); err != nil {
+			return nil, fmt.Errorf("failed to scan chapter result: %w", err)
+		}
+		results = append(results, c)
+	}
+	return results, rows.Err()
+}
+
+// ReplaceUserRecommendations swaps a user's recommendations for a new computation
+func (r *LibraryRecommendationRepository) ReplaceUserRecommendations(ctx context.Context, userID string, computedAt time.Time, recommendations []LibraryUserRecommendation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM library_user_recommendations WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to clear recommendations: %w", err)
+	}
+	if len(recommendations) > 0 {
+		items := make([]string, len(recommendations))
+		scores := make([]float64, len(recommendations))
+		reasons := make([]string, len(recommendations))
+		sources := make([]sql.NullString, len(recommendations))
+		codes := make([]sql.NullString, len(recommendations))
+		explanations := make([]string, len(recommendations))
+		for i, rec := range recommendations {
+			items[i], scores[i], reasons[i], explanations[i] = rec.ItemID, rec.Score, rec.Reason, rec.Explanation
+			sources[i] = sql.NullString{String: rec.SourceItemID, Valid: rec.SourceItemID != ""}
+			codes[i] = sql.NullString{String: rec.QuestionCode, Valid: rec.QuestionCode != ""}
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO library_user_recommendations
+				(user_id, library_item_id, score, reason, source_item_id, question_code, explanation, computed_at)
+			SELECT $1, r.item_id, r.score, r.reason, r.source_item_id, r.question_code, r.explanation, $8
+			FROM unnest($2::text[], $3::float8[], $4::text[], $5::text[], $6::text[], $7::text[])
+				AS r(item_id, score, reason, source_item_id, question_code, explanation)
+			WHERE EXISTS (SELECT 1 FROM library_items WHERE id = r.item_id)
+		`, userID, pq.Array(items), pq.Array(scores), pq.Array(reasons), pq.Array(sources), pq.Array(codes), pq.Array(explanations), computedAt); err != nil {
+			return fmt.Errorf("failed to insert recommendations: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// ListUserRecommendations returns a user's precomputed recommendations, best first
+func (r *LibraryRecommendationRepository) ListUserRecommendations(ctx context.Context, userID string, limit int) ([]LibraryUserRecommendation, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT r.library_item_id, r.score, r.reason, COALESCE(r.source_item_id, ''),
+		       COALESCE(r.question_code, ''), r.explanation
+		FROM library_user_recommendations r
+		JOIN library_items li ON li.id = r.library_item_id
+		WHERE r.user_id = $1 AND `+recommendableItem+`
+		ORDER BY r.score DESC, r.library_item_id
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recommendations: %w", err)
+	}
+	defer rows.Close()
+
+	var recommendations []LibraryUserRecommendation
+	for rows.Next() {
+		var rec LibraryUserRecommendation
+		if err := rows.Scan(&rec.ItemID, &rec.Score, &rec.Reason, &rec.SourceItemID, &rec.QuestionCode, &rec.Explanation); err != nil {
+			return nil, fmt.Errorf("failed to scan recommendation: %w", err)
+		}
+		recommendations = append(recommendations, rec)
+	}
+	return recommendations, rows.Err()
+}
+
+// DeleteUserRecommendationsBefore drops recommendations computed before cutoff, i.e. of
+// users the latest run skipped because they were no longer active
+func (r *LibraryRecommendationRepository) DeleteUserRecommendationsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM library_user_recommendations WHERE computed_at < $1`, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale recommendations: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// LastRunAt returns when the latest completed run started, or the zero time
+func (r *LibraryRecommendationRepository) LastRunAt(ctx context.Context) (time.Time, error) {
+	var startedAt sql.NullTime
+	if err := r.db.QueryRowContext(ctx, `SELECT MAX(started_at) FROM library_recommendation_runs`).Scan(&startedAt); err != nil {
+		return time.Time{}, fmt.Errorf("failed to read last recommendation run: %w", err)
+	}
+	return startedAt.Time, nil
+}
+
+// RecordRun records a completed run
+func (r *LibraryRecommendationRepository) RecordRun(ctx context.Context, startedAt time.Time, similarities, users int) error {
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO library_recommendation_runs (started_at, similarities, users) VALUES ($1, $2, $3)
+	`, startedAt, similarities, users); err != nil {
+		return fmt.Errorf("failed to record recommendation run: %w", err)
+	}
+	return nil
+}
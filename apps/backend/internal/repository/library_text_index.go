@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Text index statuses
+const (
+	TextIndexStatusIndexed = "indexed"
+	TextIndexStatusEmpty   = "empty" // The file has no extractable text, e.g. a scan
+	TextIndexStatusFailed  = "failed"
+)
+
+// libraryFileKey identifies the file an item currently points at
+const libraryFileKey = `COALESCE(li.file_id, '') || '|' || COALESCE(li.file_url, '')`
+
+// LibraryTextIndexCandidate is a book or exam whose file text is missing from the index
+// or was extracted from a file the item no longer uses
+type LibraryTextIndexCandidate struct {
+	ItemID   string
+	ItemType string
+	Title    string
+	FileID   string
+	FileURL  string
+	FileKey  string
+}
+
+// LibraryTextIndexRepository tracks which item files have their page text indexed
+type LibraryTextIndexRepository struct {
+	db *sql.DB
+}
+
+// NewLibraryTextIndexRepository creates a new library text index repository
+func NewLibraryTextIndexRepository(db *sql.DB) *LibraryTextIndexRepository {
+	return &LibraryTextIndexRepository{db: db}
+}
+
+// ListPending returns books and exams with a file that has not been indexed in its
+// current version, oldest change first. Failed files are not retried until they change.
+func (r *LibraryTextIndexRepository) ListPending(ctx context.Context, limit int) ([]*LibraryTextIndexCandidate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT li.id, li.type, li.name, COALESCE(li.file_id, ''), COALESCE(li.file_url, ''), `+libraryFileKey+`
+		FROM library_items li
+		LEFT JOIN library_text_index ti ON ti.library_item_id = li.id
+		WHERE li.type IN ('book', 'exam')
+		  AND (COALESCE(li.file_id, '') <> '' OR COALESCE(li.file_url, '') <> '')
+		  AND (ti.library_item_id IS NULL OR ti.file_key <> `+libraryFileKey+`)
+		ORDER BY li.updated_at, li.id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items to index: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*LibraryTextIndexCandidate
+	for rows.Next() {
+		c := &LibraryTextIndexCandidate{}
+		if err := rows.Scan(&c.ItemID, &c.ItemType, &c.Title, &c.FileID, &c.FileURL, &c.FileKey); err != nil {
+			return nil, fmt.Errorf("failed to scan item to index: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+// MarkIndexed records the outcome of indexing an item's file
+func (r *LibraryTextIndexRepository) MarkIndexed(ctx context.Context, itemID, fileKey, status string, pages int, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO library_text_index (library_item_id, file_key, status, page_count, error, indexed_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (library_item_id) DO UPDATE
+		SET file_key = EXCLUDED.file_key, status = EXCLUDED.status, page_count = EXCLUDED.page_count,
+		    error = EXCLUDED.error, indexed_at = EXCLUDED.indexed_at
+	`, itemID, fileKey, status, pages, reason)
+	if err != nil {
+		return fmt.Errorf("failed to record text index state: %w", err)
+	}
+	return nil
+}
+
+// ListRemoved returns indexed items that were deleted or no longer have a file
+func (r *LibraryTextIndexRepository) ListRemoved(ctx context.Context, limit int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ti.library_item_id
+		FROM library_text_index ti
+		LEFT JOIN library_items li ON li.id = ti.library_item_id
+		WHERE li.id IS NULL OR (COALESCE(li.file_id, '') = '' AND COALESCE(li.file_url, '') = '')
+		ORDER BY ti.library_item_id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list removed items: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan removed item: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Delete forgets an item once its pages are gone from the index
+func (r *LibraryTextIndexRepository) Delete(ctx context.Context, itemID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM library_text_index WHERE library_item_id = $1`, itemID); err != nil {
+		return fmt.Errorf("failed to delete text index state: %w", err)
+	}
+	return nil
+}
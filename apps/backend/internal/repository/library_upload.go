@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/util"
+)
+
+// Upload session statuses
+const (
+	UploadStatusUploading  = "uploading"
+	UploadStatusCompleted  = "completed"
+	UploadStatusFailed     = "failed"
+	UploadStatusTerminated = "terminated"
+	UploadStatusExpired    = "expired"
+)
+
+// ErrUploadOffsetMismatch is returned when a session moved on since it was read
+var ErrUploadOffsetMismatch = errors.New("upload offset mismatch")
+
+// LibraryUploadSession is a resumable upload of a library file
+type LibraryUploadSession struct {
+	ID             string
+	UserID         string
+	ItemType       string // book, exam or video
+	Filename       string
+	ContentType    string
+	Metadata       map[string]string // Item fields sent with the upload (title, subject, ...)
+	UploadLength   int64
+	UploadOffset   int64
+	Status         string
+	Error          string
+	ChecksumSHA256 string
+	FileID         string
+	LibraryItemID  string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// LibraryUploadUsage summarises a user's recent uploads for quota checks
+type LibraryUploadUsage struct {
+	ActiveSessions int   // Sessions still uploading
+	ReservedBytes  int64 // Declared length of sessions created in the window, except terminated ones
+}
+
+// LibraryUploadRepository stores upload sessions and creates the library items of
+// completed uploads
+type LibraryUploadRepository struct {
+	db *sql.DB
+}
+
+// NewLibraryUploadRepository creates a new library upload repository
+func NewLibraryUploadRepository(db *sql.DB) *LibraryUploadRepository {
+	return &LibraryUploadRepository{db: db}
+}
+
+const uploadSessionColumns = `id, user_id, item_type, filename, content_type, metadata, upload_length,
+	upload_offset, status, error, checksum_sha256, COALESCE(file_id, ''), COALESCE(library_item_id, ''),
+	expires_at, created_at, updated_at`
+
+// Create stores a new session and assigns its ID
+func (r *LibraryUploadRepository) Create(ctx context.Context, session *LibraryUploadSession) error {
+	metadata, err := json.Marshal(session.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encode upload metadata: %w", err)
+	}
+
+	session.ID = util.ULIDNow()
+	session.Status = UploadStatusUploading
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO library_upload_sessions (
+			id, user_id, item_type, filename, content_type, metadata, upload_length, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`, session.ID, session.UserID, session.ItemType, session.Filename, session.ContentType,
+		metadata, session.UploadLength, session.ExpiresAt).Scan(&session.CreatedAt, &session.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create upload session: %w", err)
+	}
+	return nil
+}
+
+// Get returns a session by ID, or ErrNotFound
+func (r *LibraryUploadRepository) Get(ctx context.Context, id string) (*LibraryUploadSession, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+uploadSessionColumns+` FROM library_upload_sessions WHERE id = $1`, id)
+	session, err := scanUploadSession(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return session, err
+}
+
+// UpdateOffset records appended bytes and extends the expiry. It fails with
+// ErrUploadOffsetMismatch when the stored offset is no longer from.
+func (r *LibraryUploadRepository) UpdateOffset(ctx context.Context, id string, from, to int64, expiresAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE library_upload_sessions
+		SET upload_offset = $3, expires_at = $4, updated_at = NOW()
+		WHERE id = $1 AND upload_offset = $2 AND status = 'uploading'
+	`, id, from, to, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to update upload offset: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrUploadOffsetMismatch
+	}
+	return nil
+}
+
+// SetStatus ends an uploading session as failed, terminated or expired
+func (r *LibraryUploadRepository) SetStatus(ctx context.Context, id, status, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE library_upload_sessions
+		SET status = $2, error = $3, updated_at = NOW()
+		WHERE id = $1 AND status = 'uploading'
+	`, id, status, reason)
+	if err != nil {
+		return fmt.Errorf("failed to update upload status: %w", err)
+	}
+	return nil
+}
+
+// Usage returns the user's active sessions and the bytes reserved since a given time
+func (r *LibraryUploadRepository) Usage(ctx context.Context, userID string, since time.Time) (*LibraryUploadUsage, error) {
+	usage := &LibraryUploadUsage{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE status = 'uploading'),
+			COALESCE(SUM(upload_length) FILTER (WHERE created_at >= $2 AND status <> 'terminated'), 0)
+		FROM library_upload_sessions
+		WHERE user_id = $1 AND (status = 'uploading' OR created_at >= $2)
+	`, userID, since).Scan(&usage.ActiveSessions, &usage.ReservedBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load upload usage: %w", err)
+	}
+	return usage, nil
+}
+
+// ListExpired returns uploading sessions whose expiry has passed
+func (r *LibraryUploadRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*LibraryUploadSession, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+uploadSessionColumns+`
+		FROM library_upload_sessions
+		WHERE status = 'uploading' AND expires_at < $1
+		ORDER BY expires_at
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired uploads: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*LibraryUploadSession
+	for rows.Next() {
+		session, err := scanUploadSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+// Complete creates the pending library item of a finished upload and marks the session
+// completed in one transaction. The item ID is stored on the session.
+func (r *LibraryUploadRepository) Complete(ctx context.Context, session *LibraryUploadSession) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	meta := session.Metadata
+	itemID := util.ULIDNow()
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO library_items (
+			id, name, description, type, category, file_id, file_size, file_type, file_sha256,
+			upload_status, is_active, uploaded_by, created_at, updated_at
+		) VALUES ($1, $2, NULLIF($3, ''), $4, NULLIF($5, ''), $6, $7, $8, NULLIF($9, ''), 'pending', true, $10, NOW(), NOW())
+	`, itemID, meta["title"], meta["description"], session.ItemType, meta["category"],
+		session.FileID, session.UploadLength, session.ContentType, session.ChecksumSHA256, session.UserID); err != nil {
+		return fmt.Errorf("insert library_items: %w", err)
+	}
+
+	switch session.ItemType {
+	case "book":
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO library_book_metadata (id, library_item_id, subject, grade, author, publisher)
+			VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''))
+		`, util.ULIDNow(), itemID, meta["subject"], meta["grade"], meta["author"], meta["publisher"])
+	case "exam":
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO exam_metadata (
+				id, library_item_id, subject, grade, province, school, academic_year, semester,
+				exam_duration, question_count, exam_type
+			) VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), $7, NULLIF($8, ''), $9, $10, $11)
+		`, util.ULIDNow(), itemID, meta["subject"], meta["grade"], meta["province"], meta["school"],
+			meta["academic_year"], meta["semester"], metadataInt(meta["exam_duration"]),
+			metadataInt(meta["question_count"]), meta["exam_type"])
+	case "video":
+		// Uploaded videos are served from file_id and have no YouTube source
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO video_metadata (
+				id, library_item_id, youtube_url, youtube_id, duration, instructor_name, subject, grade
+			) VALUES ($1, $2, '', '', $3, NULLIF($4, ''), $5, $6)
+		`, util.ULIDNow(), itemID, metadataInt(meta["duration"]), meta["instructor_name"],
+			meta["subject"], meta["grade"])
+	default:
+		err = ErrInvalidInput
+	}
+	if err != nil {
+		return fmt.Errorf("insert %s metadata: %w", session.ItemType, err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE library_upload_sessions
+		SET status = 'completed', file_id = $2, library_item_id = $3, checksum_sha256 = $4, updated_at = NOW()
+		WHERE id = $1 AND status = 'uploading'
+	`, session.ID, session.FileID, itemID, session.ChecksumSHA256)
+	if err != nil {
+		return fmt.Errorf("failed to complete upload session: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrUploadOffsetMismatch
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	session.Status = UploadStatusCompleted
+	session.LibraryItemID = itemID
+	return nil
+}
+
+// metadataInt converts an optional numeric metadata value for a nullable column
+func metadataInt(value string) sql.NullInt32 {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(n), Valid: true}
+}
+
+func scanUploadSession(row interface{ Scan(...interface{}) error }) (*LibraryUploadSession, error) {
+	session := &LibraryUploadSession{}
+	var metadata []byte
+	err := row.Scan(
+		&session.ID, &session.UserID, &session.ItemType, &session.Filename, &session.ContentType,
+		&metadata, &session.UploadLength, &session.UploadOffset, &session.Status, &session.Error,
+		&session.ChecksumSHA256, &session.FileID, &session.LibraryItemID,
+		&session.ExpiresAt, &session.CreatedAt, &session.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(metadata, &session.Metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode upload metadata: %w", err)
+	}
+	return session, nil
+}
@@ -30,6 +30,7 @@ type LibraryVideoListFilters struct {
 	Search     string
 	SortBy     string
 	SortOrder  string
+	IDs        []string
 }
 
 // LibraryVideoRepository provides persistence operations for library videos.
@@ -59,6 +60,10 @@ func (r *libraryVideoRepository) List(ctx context.Context, filters LibraryVideoL
 	args := []interface{}{}
 	conditions := []string{"li.type = 'video'"}
 
+	if len(filters.IDs) > 0 {
+		args = append(args, pq.Array(filters.IDs))
+		conditions = append(conditions, fmt.Sprintf("li.id = ANY($%d)", len(args)))
+	}
 	if len(filters.Subjects) > 0 {
 		args = append(args, pq.Array(filters.Subjects))
 		conditions = append(conditions, fmt.Sprintf("vm.subject = ANY($%d)", len(args)))
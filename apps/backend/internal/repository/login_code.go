@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// LoginCode is a passwordless login code together with its magic-link token
+type LoginCode struct {
+	ID                string
+	UserID            string
+	CodeHash          string
+	LinkTokenHash     string
+	DeviceFingerprint string
+	IPAddress         string
+	UserAgent         string
+	Attempts          int
+	ExpiresAt         time.Time
+	UsedAt            *time.Time
+	CreatedAt         time.Time
+}
+
+// LoginCodeRepository handles passwordless login codes
+type LoginCodeRepository struct {
+	db *sql.DB
+}
+
+// NewLoginCodeRepository creates a new login code repository
+func NewLoginCodeRepository(db *sql.DB) *LoginCodeRepository {
+	return &LoginCodeRepository{db: db}
+}
+
+const loginCodeColumns = `id, user_id, code_hash, link_token_hash, device_fingerprint, ip_address, user_agent,
+	attempts, expires_at, used_at, created_at`
+
+// Create stores a new code and invalidates the user's earlier unused codes, so only
+// the most recent email works
+func (r *LoginCodeRepository) Create(ctx context.Context, code *LoginCode) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE login_codes SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL
+	`, code.UserID); err != nil {
+		return fmt.Errorf("failed to invalidate login codes: %w", err)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO login_codes (user_id, code_hash, link_token_hash, device_fingerprint, ip_address, user_agent, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING id, created_at
+	`, code.UserID, code.CodeHash, code.LinkTokenHash, code.DeviceFingerprint, code.IPAddress, code.UserAgent,
+		code.ExpiresAt).Scan(&code.ID, &code.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create login code: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetLatestForUser returns the user's most recently issued code, or ErrNotFound
+func (r *LoginCodeRepository) GetLatestForUser(ctx context.Context, userID string) (*LoginCode, error) {
+	return r.getOne(ctx, `SELECT `+loginCodeColumns+` FROM login_codes
+		WHERE user_id = $1 ORDER BY created_at DESC LIMIT 1`, userID)
+}
+
+// GetByLinkTokenHash returns the code issued with a magic-link token, or ErrNotFound
+func (r *LoginCodeRepository) GetByLinkTokenHash(ctx context.Context, linkTokenHash string) (*LoginCode, error) {
+	return r.getOne(ctx, `SELECT `+loginCodeColumns+` FROM login_codes WHERE link_token_hash = $1`, linkTokenHash)
+}
+
+func (r *LoginCodeRepository) getOne(ctx context.Context, query string, arg string) (*LoginCode, error) {
+	var code LoginCode
+	var ipAddress, userAgent sql.NullString
+	var usedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
+		&code.ID, &code.UserID, &code.CodeHash, &code.LinkTokenHash, &code.DeviceFingerprint,
+		&ipAddress, &userAgent, &code.Attempts, &code.ExpiresAt, &usedAt, &code.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login code: %w", err)
+	}
+	code.IPAddress = ipAddress.String
+	code.UserAgent = userAgent.String
+	if usedAt.Valid {
+		code.UsedAt = &usedAt.Time
+	}
+	return &code, nil
+}
+
+// IncrementAttempts records a failed verification and returns the new attempt count
+func (r *LoginCodeRepository) IncrementAttempts(ctx context.Context, id string) (int, error) {
+	var attempts int
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE login_codes SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts
+	`, id).Scan(&attempts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update login code: %w", err)
+	}
+	return attempts, nil
+}
+
+// MarkUsed consumes a code. It returns false when the code was already used.
+func (r *LoginCodeRepository) MarkUsed(ctx context.Context, id string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE login_codes SET used_at = NOW() WHERE id = $1 AND used_at IS NULL
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark login code as used: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
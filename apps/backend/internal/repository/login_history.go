@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// LoginRecord is one past sign-in, taken from the session it created
+type LoginRecord struct {
+	DeviceFingerprint string
+	IPAddress         string
+	CreatedAt         time.Time
+}
+
+// LoginHistoryRepository reads a user's sign-in history for login risk scoring
+type LoginHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewLoginHistoryRepository creates a new login history repository
+func NewLoginHistoryRepository(db *sql.DB) *LoginHistoryRepository {
+	return &LoginHistoryRepository{db: db}
+}
+
+// RecentLogins returns up to limit sign-ins of the user since the given time, newest first.
+// Terminated and expired sessions are included: they still describe where the user signed in.
+func (r *LoginHistoryRepository) RecentLogins(ctx context.Context, userID string, since time.Time, limit int) ([]*LoginRecord, error) {
+	query := `
+		SELECT COALESCE(device_fingerprint, ''), COALESCE(ip_address, ''), created_at
+		FROM user_sessions
+		WHERE user_id = $1 AND created_at >= $2
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list login history: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*LoginRecord
+	for rows.Next() {
+		var rec LoginRecord
+		if err := rows.Scan(&rec.DeviceFingerprint, &rec.IPAddress, &rec.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan login history: %w", err)
+		}
+		records = append(records, &rec)
+	}
+	return records, rows.Err()
+}
@@ -258,3 +258,140 @@ func (r *MapCodeRepository) GetStorageInfo(ctx context.Context) (*entity.MapCode
 
 	return entity.NewMapCodeStorageInfo(count), nil
 }
+
+// ListQuestionCodeUsage returns every question code referenced by at least one question
+func (r *MapCodeRepository) ListQuestionCodeUsage(ctx context.Context) ([]entity.QuestionCodeUsage, error) {
+	query := `
+		SELECT
+			qc.code, qc.grade, qc.subject, qc.chapter, qc.level, qc.lesson,
+			COALESCE(qc.form, '') AS form, COUNT(q.id) AS question_count
+		FROM question_code qc
+		JOIN question q ON q.question_code_id = qc.code
+		GROUP BY qc.code, qc.grade, qc.subject, qc.chapter, qc.level, qc.lesson, qc.form
+		ORDER BY qc.code
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question code usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []entity.QuestionCodeUsage
+	for rows.Next() {
+		var u entity.QuestionCodeUsage
+		if err := rows.Scan(&u.Code, &u.Grade, &u.Subject, &u.Chapter, &u.Level, &u.Lesson, &u.Form, &u.QuestionCount); err != nil {
+			return nil, fmt.Errorf("failed to scan question code usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, rows.Err()
+}
+
+// ApplyCodeRemaps moves questions from old to new question codes in a single transaction.
+// Missing target codes are created from their components, and every mapping is recorded
+// in mapcode_code_remaps. QuestionsUpdated, ID, BatchID and CreatedAt are set on each remap.
+func (r *MapCodeRepository) ApplyCodeRemaps(ctx context.Context, remaps []*entity.MapCodeRemap) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	batchID := uuid.New().String()
+	now := time.Now()
+
+	for _, remap := range remaps {
+		// Create the target code if it does not exist yet
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO question_code (code, format, grade, subject, chapter, level, lesson, form, created_at, updated_at)
+			VALUES (
+				$1::varchar,
+				(CASE WHEN length($1::varchar) = 7 THEN 'ID6' ELSE 'ID5' END)::CodeFormat,
+				substr($1::varchar, 1, 1), substr($1::varchar, 2, 1), substr($1::varchar, 3, 1),
+				substr($1::varchar, 4, 1), substr($1::varchar, 5, 1), NULLIF(substr($1::varchar, 7, 1), ''),
+				$2, $2
+			)
+			ON CONFLICT (code) DO NOTHING
+		`, remap.NewCode, now)
+		if err != nil {
+			return fmt.Errorf("failed to ensure question code %s: %w", remap.NewCode, err)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE question SET question_code_id = $1, updated_at = $3
+			WHERE question_code_id = $2
+		`, remap.NewCode, remap.OldCode, now)
+		if err != nil {
+			return fmt.Errorf("failed to remap questions from %s to %s: %w", remap.OldCode, remap.NewCode, err)
+		}
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		remap.ID = uuid.New().String()
+		remap.BatchID = batchID
+		remap.QuestionsUpdated = updated
+		remap.CreatedAt = now
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO mapcode_code_remaps (
+				id, batch_id, version_id, old_code, new_code, questions_updated,
+				performed_by, reason, created_at
+			) VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, NULLIF($7, ''), NULLIF($8, ''), $9)
+		`, remap.ID, remap.BatchID, remap.VersionID, remap.OldCode, remap.NewCode, remap.QuestionsUpdated,
+			remap.PerformedBy, remap.Reason, remap.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to record remap %s -> %s: %w", remap.OldCode, remap.NewCode, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit remaps: %w", err)
+	}
+
+	return nil
+}
+
+// ListCodeRemaps returns the remap audit trail, newest first. When code is set only
+// remaps from or to that code are returned.
+func (r *MapCodeRepository) ListCodeRemaps(ctx context.Context, code string, limit, offset int) ([]*entity.MapCodeRemap, int, error) {
+	var total int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM mapcode_code_remaps
+		WHERE $1 = '' OR old_code = $1 OR new_code = $1
+	`, code).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count code remaps: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			id, batch_id, COALESCE(version_id::text, ''), old_code, new_code, questions_updated,
+			COALESCE(performed_by, ''), COALESCE(reason, ''), created_at
+		FROM mapcode_code_remaps
+		WHERE $1 = '' OR old_code = $1 OR new_code = $1
+		ORDER BY created_at DESC, old_code
+		LIMIT $2 OFFSET $3
+	`, code, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list code remaps: %w", err)
+	}
+	defer rows.Close()
+
+	var remaps []*entity.MapCodeRemap
+	for rows.Next() {
+		var remap entity.MapCodeRemap
+		if err := rows.Scan(
+			&remap.ID, &remap.BatchID, &remap.VersionID, &remap.OldCode, &remap.NewCode,
+			&remap.QuestionsUpdated, &remap.PerformedBy, &remap.Reason, &remap.CreatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan code remap: %w", err)
+		}
+		remaps = append(remaps, &remap)
+	}
+
+	return remaps, total, rows.Err()
+}
@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Moderation queue targets
+const (
+	ModerationTargetLibraryItem = "library_item" // Pending book, exam or video upload
+	ModerationTargetQuestion    = "question"     // Question with an open review round
+)
+
+// Moderation decisions
+const (
+	ModerationApproved = "approved"
+	ModerationRejected = "rejected"
+)
+
+// ModerationSubmission is a library item or question waiting in the moderation queue
+type ModerationSubmission struct {
+	TargetType    string
+	TargetID      string
+	ReviewID      string // Open review round of a question
+	ReviewerID    string // Assigned reviewer of a question
+	Title         string
+	Kind          string // book, exam, video or the question type
+	SubmitterID   string
+	SubmitterName string
+	SubmittedAt   time.Time
+}
+
+// ModerationQueueEntry is a submission with the facts the automatic checks look at
+type ModerationQueueEntry struct {
+	ModerationSubmission
+
+	File       LibraryItemFile // Library items only
+	YoutubeID  string          // Videos served from YouTube
+	Missing    []string        // Empty metadata fields
+	Duplicates []string        // Other items with the same file, or questions with the same content
+}
+
+// ModerationQueueFilter selects queue entries, oldest first
+type ModerationQueueFilter struct {
+	TargetType      string    // Empty for both
+	SubmittedBefore time.Time // Zero for any age
+	Limit           int
+	Offset          int
+}
+
+// ModerationQueueStats summarises the whole queue
+type ModerationQueueStats struct {
+	PendingItems     int
+	PendingQuestions int
+	Overdue          int
+	OldestAt         sql.NullTime
+}
+
+// ModerationDecision is an approve or reject decision taken from the queue
+type ModerationDecision struct {
+	TargetType  string
+	TargetID    string
+	Decision    string
+	ReasonCode  string
+	Reason      string
+	SubmitterID string
+	ReviewerID  string
+	SubmittedAt time.Time
+	DecidedAt   time.Time
+	WithinSLA   bool
+}
+
+// ModeratorWorkload is what one reviewer decided within a window
+type ModeratorWorkload struct {
+	ReviewerID      string
+	ReviewerName    string
+	Decisions       int
+	Approved        int
+	Rejected        int
+	OverSLA         int
+	AvgHandlingTime time.Duration // From submission to decision
+	LastDecisionAt  time.Time
+}
+
+// ModerationRepository reads the moderation queue and records decisions
+type ModerationRepository struct {
+	db *sql.DB
+}
+
+// NewModerationRepository creates a new moderation repository
+func NewModerationRepository(db *sql.DB) *ModerationRepository {
+	return &ModerationRepository{db: db}
+}
+
+// moderationQueue lists pending library items and questions in review with the columns
+// of ModerationQueueEntry
+const moderationQueue = `
+	WITH queue AS (
+		SELECT 'library_item' AS target_type, li.id AS target_id, '' AS review_id, '' AS reviewer_id,
+		       li.name AS title, li.type AS kind, COALESCE(li.uploaded_by, '') AS submitter_id,
+		       TRIM(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')) AS submitter_name,
+		       li.created_at AS submitted_at,
+		       COALESCE(li.file_url, '') AS file_url, COALESCE(li.file_id, '') AS file_id, li.file_size,
+		       COALESCE(li.file_type, '') AS file_type, COALESCE(vm.youtube_id, '') AS youtube_id,
+		       ARRAY_REMOVE(ARRAY[
+		           CASE WHEN COALESCE(TRIM(li.description), '') = '' THEN 'description' END,
+		           CASE WHEN COALESCE(TRIM(li.category), '') = '' THEN 'category' END,
+		           CASE WHEN COALESCE(bm.subject, em.subject, vm.subject, '') = '' THEN 'subject' END,
+		           CASE WHEN COALESCE(bm.grade, em.grade, vm.grade, '') = '' THEN 'grade' END
+		       ], NULL) AS missing,
+		       ARRAY(
+		           SELECT d.id FROM library_items d
+		           WHERE d.id <> li.id
+		             AND ((li.file_sha256 IS NOT NULL AND d.file_sha256 = li.file_sha256)
+		               OR (li.file_id IS NOT NULL AND li.file_id <> '' AND d.file_id = li.file_id)
+		               OR (li.file_url IS NOT NULL AND li.file_url <> '' AND d.file_url = li.file_url))
+		           ORDER BY d.created_at LIMIT 5
+		       ) AS duplicates
+		FROM library_items li
+		LEFT JOIN users u ON u.id = li.uploaded_by
+		LEFT JOIN library_book_metadata bm ON li.type = 'book' AND bm.library_item_id = li.id
+		LEFT JOIN exam_metadata em ON li.type = 'exam' AND em.library_item_id = li.id
+		LEFT JOIN video_metadata vm ON li.type = 'video' AND vm.library_item_id = li.id
+		WHERE li.upload_status = 'pending'
+
+		UNION ALL
+
+		SELECT 'question', q.id, qr.id::text, COALESCE(qr.reviewer_id, ''),
+		       LEFT(q.content, 200), q.type::text, qr.author_id,
+		       TRIM(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')),
+		       qr.submitted_at,
+		       '', '', NULL, '', '',
+		       ARRAY_REMOVE(ARRAY[
+		           CASE WHEN COALESCE(TRIM(q.solution), '') = '' THEN 'solution' END,
+		           CASE WHEN q.type <> 'ES' AND q.correct_answer IS NULL THEN 'correct_answer' END,
+		           CASE WHEN q.type IN ('MC', 'MA', 'TF') AND COALESCE(jsonb_array_length(
+		               CASE WHEN jsonb_typeof(q.answers) = 'array' THEN q.answers END), 0) = 0 THEN 'answers' END
+		       ], NULL),
+		       ARRAY(
+		           SELECT d.id FROM question d
+		           WHERE d.id <> q.id AND md5(d.content) = md5(q.content)
+		           ORDER BY d.created_at LIMIT 5
+		       )
+		FROM question_reviews qr
+		JOIN question q ON q.id = qr.question_id
+		LEFT JOIN users u ON u.id = qr.author_id
+		WHERE qr.status = 'IN_REVIEW'
+	)`
+
+// ListQueue returns a page of the moderation queue, oldest submissions first, with the
+// number of entries matching the filter
+func (r *ModerationRepository) ListQueue(ctx context.Context, filter ModerationQueueFilter) ([]ModerationQueueEntry, int, error) {
+	var before interface{}
+	if !filter.SubmittedBefore.IsZero() {
+		before = filter.SubmittedBefore
+	}
+	rows, err := r.db.QueryContext(ctx, moderationQueue+`
+		SELECT target_type, target_id, review_id, reviewer_id, title, kind, submitter_id, submitter_name,
+		       submitted_at, file_url, file_id, file_size, file_type, youtube_id, missing, duplicates,
+		       COUNT(*) OVER ()
+		FROM queue
+		WHERE ($1::text = '' OR target_type = $1)
+		  AND ($2::timestamptz IS NULL OR submitted_at < $2)
+		ORDER BY submitted_at, target_id
+		LIMIT $3 OFFSET $4`,
+		filter.TargetType, before, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list moderation queue: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []ModerationQueueEntry
+	total := 0
+	for rows.Next() {
+		var e ModerationQueueEntry
+		if err := rows.Scan(
+			&e.TargetType, &e.TargetID, &e.ReviewID, &e.ReviewerID, &e.Title, &e.Kind, &e.SubmitterID,
+			&e.SubmitterName, &e.SubmittedAt, &e.File.URL, &e.File.ID, &e.File.Size, &e.File.Type,
+			&e.YoutubeID, pq.Array(&e.Missing), pq.Array(&e.Duplicates), &total,
+		); err != nil {
+			return nil, 0, fmt.Errorf("scan moderation queue entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, total, rows.Err()
+}
+
+// QueueStats counts the whole queue; entries submitted before overdueBefore are overdue
+func (r *ModerationRepository) QueueStats(ctx context.Context, overdueBefore time.Time) (*ModerationQueueStats, error) {
+	var stats ModerationQueueStats
+	err := r.db.QueryRowContext(ctx, `
+		WITH queue AS (
+			SELECT 'library_item' AS target_type, created_at AS submitted_at
+			FROM library_items WHERE upload_status = 'pending'
+			UNION ALL
+			SELECT 'question', submitted_at FROM question_reviews WHERE status = 'IN_REVIEW'
+		)
+		SELECT COUNT(*) FILTER (WHERE target_type = 'library_item'),
+		       COUNT(*) FILTER (WHERE target_type = 'question'),
+		       COUNT(*) FILTER (WHERE submitted_at < $1),
+		       MIN(submitted_at)
+		FROM queue`, overdueBefore,
+	).Scan(&stats.PendingItems, &stats.PendingQuestions, &stats.Overdue, &stats.OldestAt)
+	if err != nil {
+		return nil, fmt.Errorf("count moderation queue: %w", err)
+	}
+	return &stats, nil
+}
+
+// PendingLibraryItem returns a library item waiting for approval, or ErrNotFound when
+// the item does not exist or already left the queue
+func (r *ModerationRepository) PendingLibraryItem(ctx context.Context, itemID string) (*ModerationSubmission, error) {
+	s := ModerationSubmission{TargetType: ModerationTargetLibraryItem, TargetID: itemID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT name, type, COALESCE(uploaded_by, ''), created_at
+		FROM library_items
+		WHERE id = $1 AND upload_status = 'pending'`, itemID,
+	).Scan(&s.Title, &s.Kind, &s.SubmitterID, &s.SubmittedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get pending library item: %w", err)
+	}
+	return &s, nil
+}
+
+// OpenQuestionReview returns the review round of a question waiting for a decision, or
+// ErrNotFound when the question has none
+func (r *ModerationRepository) OpenQuestionReview(ctx context.Context, questionID string) (*ModerationSubmission, error) {
+	s := ModerationSubmission{TargetType: ModerationTargetQuestion, TargetID: questionID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT qr.id::text, COALESCE(qr.reviewer_id, ''), LEFT(q.content, 200), q.type::text,
+		       qr.author_id, qr.submitted_at
+		FROM question_reviews qr
+		JOIN question q ON q.id = qr.question_id
+		WHERE qr.question_id = $1 AND qr.status = 'IN_REVIEW'`, questionID,
+	).Scan(&s.ReviewID, &s.ReviewerID, &s.Title, &s.Kind, &s.SubmitterID, &s.SubmittedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get open question review: %w", err)
+	}
+	return &s, nil
+}
+
+// DecideLibraryItem approves or rejects a pending library item and records the decision
+// in one transaction. Returns ErrNotFound when the item already left the queue.
+func (r *ModerationRepository) DecideLibraryItem(ctx context.Context, d ModerationDecision) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin library item decision: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE library_items
+		SET upload_status = $2, approved_by = NULLIF($3, ''), is_active = ($2 = 'approved'),
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND upload_status = 'pending'`,
+		d.TargetID, d.Decision, d.ReviewerID)
+	if err != nil {
+		return fmt.Errorf("update library item approval: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	if err := insertModerationDecision(ctx, tx, d); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit library item decision: %w", err)
+	}
+	return nil
+}
+
+// RecordDecision stores a decision taken through another workflow, such as question reviews
+func (r *ModerationRepository) RecordDecision(ctx context.Context, d ModerationDecision) error {
+	return insertModerationDecision(ctx, r.db, d)
+}
+
+// Workload returns the decisions of every reviewer since a time, busiest first
+func (r *ModerationRepository) Workload(ctx context.Context, since time.Time) ([]ModeratorWorkload, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT COALESCE(d.reviewer_id, ''),
+		       TRIM(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')),
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE d.decision = 'approved'),
+		       COUNT(*) FILTER (WHERE d.decision = 'rejected'),
+		       COUNT(*) FILTER (WHERE NOT d.within_sla),
+		       COALESCE(AVG(EXTRACT(EPOCH FROM d.decided_at - d.submitted_at)), 0),
+		       MAX(d.decided_at)
+		FROM moderation_decisions d
+		LEFT JOIN users u ON u.id = d.reviewer_id
+		WHERE d.decided_at >= $1
+		GROUP BY d.reviewer_id, u.first_name, u.last_name
+		ORDER BY COUNT(*) DESC, MAX(d.decided_at) DESC`, since)
+	if err != nil {
+		return nil, fmt.Errorf("list moderator workload: %w", err)
+	}
+	defer rows.Close()
+
+	var workload []ModeratorWorkload
+	for rows.Next() {
+		var w ModeratorWorkload
+		var avgSeconds float64
+		if err := rows.Scan(&w.ReviewerID, &w.ReviewerName, &w.Decisions, &w.Approved, &w.Rejected,
+			&w.OverSLA, &avgSeconds, &w.LastDecisionAt); err != nil {
+			return nil, fmt.Errorf("scan moderator workload: %w", err)
+		}
+		w.AvgHandlingTime = time.Duration(avgSeconds * float64(time.Second))
+		workload = append(workload, w)
+	}
+	return workload, rows.Err()
+}
+
+// moderationExecer is a *sql.DB or *sql.Tx
+type moderationExecer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func insertModerationDecision(ctx context.Context, db moderationExecer, d ModerationDecision) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO moderation_decisions (
+			target_type, target_id, decision, reason_code, reason, submitter_id, reviewer_id,
+			submitted_at, decided_at, within_sla
+		) VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), $8, $9, $10)`,
+		d.TargetType, d.TargetID, d.Decision, d.ReasonCode, d.Reason, d.SubmitterID, d.ReviewerID,
+		d.SubmittedAt, d.DecidedAt, d.WithinSLA)
+	if err != nil {
+		return fmt.Errorf("insert moderation decision: %w", err)
+	}
+	return nil
+}
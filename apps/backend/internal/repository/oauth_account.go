@@ -14,7 +14,8 @@ import (
 
 var (
 	// Validation regex patterns
-	ulidRegexOAuth = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+	ulidRegexOAuth    = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+	providerNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,31}$`)
 )
 
 // Validation helpers for OAuth account repository
@@ -42,14 +43,9 @@ func validateProvider(provider string) error {
 	if provider == "" {
 		return fmt.Errorf("provider cannot be empty")
 	}
-	// Valid providers: google, facebook, github
-	validProviders := map[string]bool{
-		"google":   true,
-		"facebook": true,
-		"github":   true,
-	}
-	if !validProviders[provider] {
-		return fmt.Errorf("invalid provider: must be google, facebook, or github")
+	// Built-in providers (google) and configured OIDC providers share one namespace
+	if !providerNameRegex.MatchString(provider) {
+		return fmt.Errorf("invalid provider: must be lowercase letters, digits, '-' or '_' (max 32)")
 	}
 	return nil
 }
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OIDCLoginState is an OIDC authorization request waiting for its callback
+type OIDCLoginState struct {
+	StateHash   string
+	Provider    string
+	Nonce       string
+	RedirectURI string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// OIDCLoginStateRepository handles the state and nonce of OIDC authorization requests
+type OIDCLoginStateRepository struct {
+	db *sql.DB
+}
+
+// NewOIDCLoginStateRepository creates a new OIDC login state repository
+func NewOIDCLoginStateRepository(db *sql.DB) *OIDCLoginStateRepository {
+	return &OIDCLoginStateRepository{db: db}
+}
+
+// Create stores a new state and removes expired ones
+func (r *OIDCLoginStateRepository) Create(ctx context.Context, state *OIDCLoginState) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM oidc_login_states WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("failed to delete expired OIDC login states: %w", err)
+	}
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO oidc_login_states (state_hash, provider, nonce, redirect_uri, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`, state.StateHash, state.Provider, state.Nonce, state.RedirectURI, state.ExpiresAt).Scan(&state.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create OIDC login state: %w", err)
+	}
+	return nil
+}
+
+// Consume deletes and returns an unexpired state, so each state is used at most once.
+// It returns ErrNotFound for unknown, used or expired states.
+func (r *OIDCLoginStateRepository) Consume(ctx context.Context, stateHash string) (*OIDCLoginState, error) {
+	var state OIDCLoginState
+	err := r.db.QueryRowContext(ctx, `
+		DELETE FROM oidc_login_states
+		WHERE state_hash = $1 AND expires_at > NOW()
+		RETURNING state_hash, provider, nonce, redirect_uri, expires_at, created_at
+	`, stateHash).Scan(&state.StateHash, &state.Provider, &state.Nonce, &state.RedirectURI, &state.ExpiresAt, &state.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume OIDC login state: %w", err)
+	}
+	return &state, nil
+}
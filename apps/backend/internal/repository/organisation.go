@@ -0,0 +1,582 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Organisation is a school or other tenant
+type Organisation struct {
+	ID         string
+	Name       string
+	Slug       string
+	CreatedBy  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time
+}
+
+// OrganisationMember is a user's membership of an organisation
+type OrganisationMember struct {
+	OrganisationID string
+	UserID         string
+	Role           string // OWNER, ADMIN, TEACHER or STUDENT
+	Email          string
+	FirstName      string
+	LastName       string
+	JoinedAt       time.Time
+}
+
+// Class is a teacher-owned class, optionally inside an organisation
+type Class struct {
+	ID              string
+	OrganisationID  string
+	Name            string
+	Subject         string
+	Grade           int
+	SchoolYear      string
+	OwnerID         string
+	JoinCode        string
+	JoinCodeEnabled bool
+	StudentCount    int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	ArchivedAt      *time.Time
+}
+
+// ClassMember is a roster entry
+type ClassMember struct {
+	ClassID   string
+	UserID    string
+	Role      string // TEACHER or STUDENT
+	Email     string
+	FirstName string
+	LastName  string
+	AddedBy   string
+	JoinedAt  time.Time
+}
+
+// ResourceOwnership is the owner and organisation of an exam or library item
+type ResourceOwnership struct {
+	OwnerID        string
+	OrganisationID string
+}
+
+// OrganisationRepository handles organisations, classes and their memberships
+type OrganisationRepository struct {
+	db *sql.DB
+}
+
+// NewOrganisationRepository creates a new organisation repository
+func NewOrganisationRepository(db *sql.DB) *OrganisationRepository {
+	return &OrganisationRepository{db: db}
+}
+
+// CreateOrganisation stores a new organisation with ownerID as its OWNER
+func (r *OrganisationRepository) CreateOrganisation(ctx context.Context, org *Organisation, ownerID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO organisations (name, slug, created_by) VALUES ($1, $2, NULLIF($3, ''))
+		RETURNING id, created_at, updated_at
+	`, org.Name, org.Slug, ownerID).Scan(&org.ID, &org.CreatedAt, &org.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create organisation: %w", err)
+	}
+	org.CreatedBy = ownerID
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO organisation_members (organisation_id, user_id, role) VALUES ($1, $2, 'OWNER')
+	`, org.ID, ownerID); err != nil {
+		return fmt.Errorf("failed to add organisation owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetOrganisation returns an organisation by ID, or ErrNotFound
+func (r *OrganisationRepository) GetOrganisation(ctx context.Context, id string) (*Organisation, error) {
+	orgs, err := r.queryOrganisations(ctx, `SELECT `+organisationColumns+` FROM organisations o WHERE o.id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organisation: %w", err)
+	}
+	if len(orgs) == 0 {
+		return nil, ErrNotFound
+	}
+	return orgs[0], nil
+}
+
+// ListUserOrganisations returns the active organisations userID belongs to
+func (r *OrganisationRepository) ListUserOrganisations(ctx context.Context, userID string) ([]*Organisation, error) {
+	orgs, err := r.queryOrganisations(ctx, `SELECT `+organisationColumns+` FROM organisations o
+		JOIN organisation_members m ON m.organisation_id = o.id
+		WHERE m.user_id = $1 AND o.archived_at IS NULL
+		ORDER BY o.name`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organisations: %w", err)
+	}
+	return orgs, nil
+}
+
+// ListUserOrganisationIDs returns the IDs of the active organisations userID belongs to
+func (r *OrganisationRepository) ListUserOrganisationIDs(ctx context.Context, userID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.organisation_id FROM organisation_members m
+		JOIN organisations o ON o.id = m.organisation_id
+		WHERE m.user_id = $1 AND o.archived_at IS NULL
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organisation IDs: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan organisation ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// GetOrganisationRole returns userID's role in the organisation, or ErrNotFound
+func (r *OrganisationRepository) GetOrganisationRole(ctx context.Context, orgID, userID string) (string, error) {
+	var role string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT role FROM organisation_members WHERE organisation_id = $1 AND user_id = $2
+	`, orgID, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get organisation role: %w", err)
+	}
+	return role, nil
+}
+
+// SetOrganisationMember adds a member or changes the role of an existing one
+func (r *OrganisationRepository) SetOrganisationMember(ctx context.Context, orgID, userID, role string) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO organisation_members (organisation_id, user_id, role) VALUES ($1, $2, $3)
+		ON CONFLICT (organisation_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`, orgID, userID, role)
+	if err != nil {
+		return fmt.Errorf("failed to set organisation member: %w", err)
+	}
+	return nil
+}
+
+// RemoveOrganisationMember removes a member and their class memberships in the organisation
+func (r *OrganisationRepository) RemoveOrganisationMember(ctx context.Context, orgID, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM organisation_members WHERE organisation_id = $1 AND user_id = $2
+	`, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organisation member: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM class_members
+		WHERE user_id = $2 AND class_id IN (SELECT id FROM classes WHERE organisation_id = $1)
+	`, orgID, userID); err != nil {
+		return fmt.Errorf("failed to remove class memberships: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListOrganisationMembers returns the members of an organisation
+func (r *OrganisationRepository) ListOrganisationMembers(ctx context.Context, orgID string) ([]*OrganisationMember, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.organisation_id, m.user_id, m.role, u.email,
+		       COALESCE(u.first_name, ''), COALESCE(u.last_name, ''), m.joined_at
+		FROM organisation_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.organisation_id = $1
+		ORDER BY m.role, u.last_name, u.first_name
+	`, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organisation members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*OrganisationMember
+	for rows.Next() {
+		m := &OrganisationMember{}
+		if err := rows.Scan(&m.OrganisationID, &m.UserID, &m.Role, &m.Email,
+			&m.FirstName, &m.LastName, &m.JoinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan organisation member: %w", err)
+		}
+		members = append(members, m)
+	}
+	return members, rows.Err()
+}
+
+// CreateClass stores a new class with its owner as the first TEACHER member.
+// Returns ErrDuplicateKey when the join code is already taken.
+func (r *OrganisationRepository) CreateClass(ctx context.Context, class *Class) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO classes (organisation_id, name, subject, grade, school_year, owner_id, join_code, join_code_enabled)
+		VALUES (NULLIF($1, ''), $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`, class.OrganisationID, class.Name, class.Subject, class.Grade, class.SchoolYear, class.OwnerID,
+		class.JoinCode, class.JoinCodeEnabled).Scan(&class.ID, &class.CreatedAt, &class.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create class: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO class_members (class_id, user_id, role, added_by) VALUES ($1, $2, 'TEACHER', $2)
+	`, class.ID, class.OwnerID); err != nil {
+		return fmt.Errorf("failed to add class owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+const classColumns = `c.id, COALESCE(c.organisation_id, ''), c.name, c.subject, c.grade, c.school_year,
+	COALESCE(c.owner_id, ''), c.join_code, c.join_code_enabled,
+	(SELECT COUNT(*) FROM class_members s WHERE s.class_id = c.id AND s.role = 'STUDENT'),
+	c.created_at, c.updated_at, c.archived_at`
+
+// GetClass returns a class by ID, or ErrNotFound
+func (r *OrganisationRepository) GetClass(ctx context.Context, id string) (*Class, error) {
+	classes, err := r.queryClasses(ctx, `SELECT `+classColumns+` FROM classes c WHERE c.id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get class: %w", err)
+	}
+	if len(classes) == 0 {
+		return nil, ErrNotFound
+	}
+	return classes[0], nil
+}
+
+// GetClassByJoinCode returns the class with an enabled join code, or ErrNotFound
+func (r *OrganisationRepository) GetClassByJoinCode(ctx context.Context, code string) (*Class, error) {
+	classes, err := r.queryClasses(ctx, `SELECT `+classColumns+` FROM classes c
+		WHERE c.join_code = $1 AND c.join_code_enabled AND c.archived_at IS NULL`, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get class by join code: %w", err)
+	}
+	if len(classes) == 0 {
+		return nil, ErrNotFound
+	}
+	return classes[0], nil
+}
+
+// ListUserClasses returns the active classes userID is a member of, optionally within one organisation
+func (r *OrganisationRepository) ListUserClasses(ctx context.Context, userID, orgID string) ([]*Class, error) {
+	classes, err := r.queryClasses(ctx, `SELECT `+classColumns+` FROM classes c
+		JOIN class_members m ON m.class_id = c.id
+		WHERE m.user_id = $1 AND c.archived_at IS NULL AND ($2::text = '' OR c.organisation_id = $2)
+		ORDER BY c.name`, userID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list classes: %w", err)
+	}
+	return classes, nil
+}
+
+// ListOrganisationClasses returns the active classes of an organisation
+func (r *OrganisationRepository) ListOrganisationClasses(ctx context.Context, orgID string) ([]*Class, error) {
+	classes, err := r.queryClasses(ctx, `SELECT `+classColumns+` FROM classes c
+		WHERE c.organisation_id = $1 AND c.archived_at IS NULL
+		ORDER BY c.name`, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organisation classes: %w", err)
+	}
+	return classes, nil
+}
+
+// UpdateJoinCode replaces a class's join code and whether it can be used.
+// Returns ErrDuplicateKey when the code is already taken.
+func (r *OrganisationRepository) UpdateJoinCode(ctx context.Context, classID, code string, enabled bool) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE classes SET join_code = $2, join_code_enabled = $3, updated_at = NOW() WHERE id = $1
+	`, classID, code, enabled)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to update join code: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetClassRole returns userID's role in the class, or ErrNotFound
+func (r *OrganisationRepository) GetClassRole(ctx context.Context, classID, userID string) (string, error) {
+	var role string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT role FROM class_members WHERE class_id = $1 AND user_id = $2
+	`, classID, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get class role: %w", err)
+	}
+	return role, nil
+}
+
+// AddClassMembers adds roster entries, skipping users already in the class.
+// When orgID is set each new member also becomes an organisation member with
+// orgRole unless they already belong to it. Returns the number of members added.
+func (r *OrganisationRepository) AddClassMembers(ctx context.Context, orgID, orgRole string, members []*ClassMember) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	added := 0
+	for _, m := range members {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO class_members (class_id, user_id, role, added_by) VALUES ($1, $2, $3, NULLIF($4, ''))
+			ON CONFLICT (class_id, user_id) DO NOTHING
+		`, m.ClassID, m.UserID, m.Role, m.AddedBy)
+		if err != nil {
+			return 0, fmt.Errorf("failed to add class member: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n > 0 {
+			added++
+		}
+		if orgID == "" {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO organisation_members (organisation_id, user_id, role) VALUES ($1, $2, $3)
+			ON CONFLICT (organisation_id, user_id) DO NOTHING
+		`, orgID, m.UserID, orgRole); err != nil {
+			return 0, fmt.Errorf("failed to add organisation member: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return added, nil
+}
+
+// RemoveClassMember removes a user from a class roster
+func (r *OrganisationRepository) RemoveClassMember(ctx context.Context, classID, userID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM class_members WHERE class_id = $1 AND user_id = $2`, classID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove class member: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListClassMembers returns the roster of a class, teachers first
+func (r *OrganisationRepository) ListClassMembers(ctx context.Context, classID string) ([]*ClassMember, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.class_id, m.user_id, m.role, u.email,
+		       COALESCE(u.first_name, ''), COALESCE(u.last_name, ''), COALESCE(m.added_by, ''), m.joined_at
+		FROM class_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.class_id = $1
+		ORDER BY m.role DESC, u.last_name, u.first_name
+	`, classID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list class members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*ClassMember
+	for rows.Next() {
+		m := &ClassMember{}
+		if err := rows.Scan(&m.ClassID, &m.UserID, &m.Role, &m.Email,
+			&m.FirstName, &m.LastName, &m.AddedBy, &m.JoinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan class member: %w", err)
+		}
+		members = append(members, m)
+	}
+	return members, rows.Err()
+}
+
+// FindUserIDsByEmail maps lower-cased emails to user IDs; unknown emails are absent
+func (r *OrganisationRepository) FindUserIDsByEmail(ctx context.Context, emails []string) (map[string]string, error) {
+	result := make(map[string]string, len(emails))
+	if len(emails) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.QueryContext(ctx, `SELECT id, LOWER(email) FROM users WHERE LOWER(email) = ANY($1)`, pq.Array(emails))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users by email: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, email string
+		if err := rows.Scan(&id, &email); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		result[email] = id
+	}
+	return result, rows.Err()
+}
+
+// GetExamOwnership returns the creator and organisation of an exam, or ErrNotFound
+func (r *OrganisationRepository) GetExamOwnership(ctx context.Context, examID string) (*ResourceOwnership, error) {
+	return r.getOwnership(ctx, `SELECT COALESCE(created_by::text, ''), COALESCE(organisation_id, '') FROM exams WHERE id::text = $1`, examID)
+}
+
+// GetLibraryItemOwnership returns the uploader and organisation of a library item, or ErrNotFound
+func (r *OrganisationRepository) GetLibraryItemOwnership(ctx context.Context, itemID string) (*ResourceOwnership, error) {
+	return r.getOwnership(ctx, `SELECT COALESCE(uploaded_by, ''), COALESCE(organisation_id, '') FROM library_items WHERE id = $1`, itemID)
+}
+
+// ListLibraryItemOrganisations maps the given library items that belong to an organisation to its ID
+func (r *OrganisationRepository) ListLibraryItemOrganisations(ctx context.Context, itemIDs []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(itemIDs) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, organisation_id FROM library_items
+		WHERE id = ANY($1) AND organisation_id IS NOT NULL
+	`, pq.Array(itemIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list library item organisations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, orgID string
+		if err := rows.Scan(&id, &orgID); err != nil {
+			return nil, fmt.Errorf("failed to scan library item organisation: %w", err)
+		}
+		result[id] = orgID
+	}
+	return result, rows.Err()
+}
+
+// SetExamOrganisation moves an exam into an organisation; an empty orgID makes it public
+func (r *OrganisationRepository) SetExamOrganisation(ctx context.Context, examID, orgID string) error {
+	return r.setOrganisation(ctx, `UPDATE exams SET organisation_id = NULLIF($2, ''), updated_at = NOW() WHERE id::text = $1`, examID, orgID)
+}
+
+// SetLibraryItemOrganisation moves a library item into an organisation; an empty orgID makes it public
+func (r *OrganisationRepository) SetLibraryItemOrganisation(ctx context.Context, itemID, orgID string) error {
+	return r.setOrganisation(ctx, `UPDATE library_items SET organisation_id = NULLIF($2, ''), updated_at = NOW() WHERE id = $1`, itemID, orgID)
+}
+
+func (r *OrganisationRepository) getOwnership(ctx context.Context, query, id string) (*ResourceOwnership, error) {
+	o := &ResourceOwnership{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&o.OwnerID, &o.OrganisationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource ownership: %w", err)
+	}
+	return o, nil
+}
+
+func (r *OrganisationRepository) setOrganisation(ctx context.Context, query, id, orgID string) error {
+	result, err := r.db.ExecContext(ctx, query, id, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to set resource organisation: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+const organisationColumns = `o.id, o.name, o.slug, COALESCE(o.created_by, ''), o.created_at, o.updated_at, o.archived_at`
+
+func (r *OrganisationRepository) queryOrganisations(ctx context.Context, query string, args ...interface{}) ([]*Organisation, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orgs []*Organisation
+	for rows.Next() {
+		o := &Organisation{}
+		var archivedAt sql.NullTime
+		if err := rows.Scan(&o.ID, &o.Name, &o.Slug, &o.CreatedBy, &o.CreatedAt, &o.UpdatedAt, &archivedAt); err != nil {
+			return nil, err
+		}
+		if archivedAt.Valid {
+			o.ArchivedAt = &archivedAt.Time
+		}
+		orgs = append(orgs, o)
+	}
+	return orgs, rows.Err()
+}
+
+func (r *OrganisationRepository) queryClasses(ctx context.Context, query string, args ...interface{}) ([]*Class, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var classes []*Class
+	for rows.Next() {
+		c := &Class{}
+		var archivedAt sql.NullTime
+		if err := rows.Scan(&c.ID, &c.OrganisationID, &c.Name, &c.Subject, &c.Grade, &c.SchoolYear,
+			&c.OwnerID, &c.JoinCode, &c.JoinCodeEnabled, &c.StudentCount,
+			&c.CreatedAt, &c.UpdatedAt, &archivedAt); err != nil {
+			return nil, err
+		}
+		if archivedAt.Valid {
+			c.ArchivedAt = &archivedAt.Time
+		}
+		classes = append(classes, c)
+	}
+	return classes, rows.Err()
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
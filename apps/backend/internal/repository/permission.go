@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Permission is a named permission checked by the RBAC evaluator
+type Permission struct {
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+// RolePermission assigns a permission to a role
+type RolePermission struct {
+	Role       string
+	Permission string
+}
+
+// MethodPermission is the permission a gRPC method requires
+type MethodPermission struct {
+	Method        string
+	Permission    string
+	MinLevel      int    // Minimum level for role-based access (0 means no requirement)
+	MaxLevel      int    // Maximum level for role-based access (0 means no limit)
+	ResourceType  string // Resource type matched by resource-scoped grants
+	ResourceField string // Request field holding the resource ID
+	UpdatedAt     time.Time
+}
+
+// PermissionGrant is a per-user grant, optionally scoped to one resource
+type PermissionGrant struct {
+	ID           string
+	UserID       string
+	Permission   string
+	ResourceType string
+	ResourceID   string
+	GrantedBy    string
+	Reason       string
+	ExpiresAt    *time.Time
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// PermissionRepository handles the RBAC tables
+type PermissionRepository struct {
+	db *sql.DB
+}
+
+// NewPermissionRepository creates a new permission repository
+func NewPermissionRepository(db *sql.DB) *PermissionRepository {
+	return &PermissionRepository{db: db}
+}
+
+// ListPermissions returns all named permissions
+func (r *PermissionRepository) ListPermissions(ctx context.Context) ([]*Permission, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT name, description, created_at FROM rbac_permissions ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	defer rows.Close()
+
+	var permissions []*Permission
+	for rows.Next() {
+		p := &Permission{}
+		if err := rows.Scan(&p.Name, &p.Description, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan permission: %w", err)
+		}
+		permissions = append(permissions, p)
+	}
+	return permissions, rows.Err()
+}
+
+// UpsertPermission creates a permission or updates its description
+func (r *PermissionRepository) UpsertPermission(ctx context.Context, permission *Permission) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO rbac_permissions (name, description) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description
+		RETURNING created_at
+	`, permission.Name, permission.Description).Scan(&permission.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert permission: %w", err)
+	}
+	return nil
+}
+
+// ListRolePermissions returns the permission sets of all roles
+func (r *PermissionRepository) ListRolePermissions(ctx context.Context) ([]RolePermission, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT role, permission FROM rbac_role_permissions ORDER BY role, permission`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role permissions: %w", err)
+	}
+	defer rows.Close()
+
+	var result []RolePermission
+	for rows.Next() {
+		var rp RolePermission
+		if err := rows.Scan(&rp.Role, &rp.Permission); err != nil {
+			return nil, fmt.Errorf("failed to scan role permission: %w", err)
+		}
+		result = append(result, rp)
+	}
+	return result, rows.Err()
+}
+
+// SetRolePermissions replaces the permission set of a role
+func (r *PermissionRepository) SetRolePermissions(ctx context.Context, role string, permissions []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM rbac_role_permissions WHERE role = $1`, role); err != nil {
+		return fmt.Errorf("failed to clear role permissions: %w", err)
+	}
+	if len(permissions) > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO rbac_role_permissions (role, permission)
+			SELECT $1, unnest($2::text[])
+		`, role, pq.Array(permissions)); err != nil {
+			return fmt.Errorf("failed to set role permissions: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListMethodPermissions returns the permission required by each protected method
+func (r *PermissionRepository) ListMethodPermissions(ctx context.Context) ([]*MethodPermission, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT method, permission, min_level, max_level, resource_type, resource_field, updated_at
+		FROM rbac_method_permissions ORDER BY method
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list method permissions: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*MethodPermission
+	for rows.Next() {
+		m := &MethodPermission{}
+		if err := rows.Scan(&m.Method, &m.Permission, &m.MinLevel, &m.MaxLevel,
+			&m.ResourceType, &m.ResourceField, &m.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan method permission: %w", err)
+		}
+		result = append(result, m)
+	}
+	return result, rows.Err()
+}
+
+// UpsertMethodPermission sets the permission a method requires
+func (r *PermissionRepository) UpsertMethodPermission(ctx context.Context, m *MethodPermission) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO rbac_method_permissions (method, permission, min_level, max_level, resource_type, resource_field, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (method) DO UPDATE SET
+			permission = EXCLUDED.permission,
+			min_level = EXCLUDED.min_level,
+			max_level = EXCLUDED.max_level,
+			resource_type = EXCLUDED.resource_type,
+			resource_field = EXCLUDED.resource_field,
+			updated_at = NOW()
+		RETURNING updated_at
+	`, m.Method, m.Permission, m.MinLevel, m.MaxLevel, m.ResourceType, m.ResourceField).Scan(&m.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert method permission: %w", err)
+	}
+	return nil
+}
+
+// DeleteMethodPermission removes a method's requirement, leaving it open to all authenticated users
+func (r *PermissionRepository) DeleteMethodPermission(ctx context.Context, method string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM rbac_method_permissions WHERE method = $1`, method)
+	if err != nil {
+		return fmt.Errorf("failed to delete method permission: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+const permissionGrantColumns = `id, user_id, permission, resource_type, resource_id, COALESCE(granted_by, ''), reason,
+	expires_at, revoked_at, created_at`
+
+// CreateGrant stores a new user grant
+func (r *PermissionRepository) CreateGrant(ctx context.Context, grant *PermissionGrant) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO rbac_user_grants (user_id, permission, resource_type, resource_id, granted_by, reason, expires_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7)
+		RETURNING id, created_at
+	`, grant.UserID, grant.Permission, grant.ResourceType, grant.ResourceID, grant.GrantedBy, grant.Reason,
+		grant.ExpiresAt).Scan(&grant.ID, &grant.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create permission grant: %w", err)
+	}
+	return nil
+}
+
+// RevokeGrant revokes an active grant and returns it, or ErrNotFound
+func (r *PermissionRepository) RevokeGrant(ctx context.Context, id string) (*PermissionGrant, error) {
+	grants, err := r.queryGrants(ctx, `
+		UPDATE rbac_user_grants SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING `+permissionGrantColumns, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke permission grant: %w", err)
+	}
+	if len(grants) == 0 {
+		return nil, ErrNotFound
+	}
+	return grants[0], nil
+}
+
+// ListActiveGrants returns the user's grants that are neither revoked nor expired at now
+func (r *PermissionRepository) ListActiveGrants(ctx context.Context, userID string, now time.Time) ([]*PermissionGrant, error) {
+	grants, err := r.queryGrants(ctx, `SELECT `+permissionGrantColumns+` FROM rbac_user_grants
+		WHERE user_id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY created_at`, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active grants: %w", err)
+	}
+	return grants, nil
+}
+
+// ListGrants returns all of the user's grants, newest first, including revoked and expired ones
+func (r *PermissionRepository) ListGrants(ctx context.Context, userID string) ([]*PermissionGrant, error) {
+	grants, err := r.queryGrants(ctx, `SELECT `+permissionGrantColumns+` FROM rbac_user_grants
+		WHERE user_id = $1 ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grants: %w", err)
+	}
+	return grants, nil
+}
+
+func (r *PermissionRepository) queryGrants(ctx context.Context, query string, args ...interface{}) ([]*PermissionGrant, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []*PermissionGrant
+	for rows.Next() {
+		g := &PermissionGrant{}
+		var expiresAt, revokedAt sql.NullTime
+		if err := rows.Scan(&g.ID, &g.UserID, &g.Permission, &g.ResourceType, &g.ResourceID, &g.GrantedBy,
+			&g.Reason, &expiresAt, &revokedAt, &g.CreatedAt); err != nil {
+			return nil, err
+		}
+		if expiresAt.Valid {
+			g.ExpiresAt = &expiresAt.Time
+		}
+		if revokedAt.Valid {
+			g.RevokedAt = &revokedAt.Time
+		}
+		grants = append(grants, g)
+	}
+	return grants, rows.Err()
+}
@@ -0,0 +1,434 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DataExportJob is a user's request for a copy of their personal data
+type DataExportJob struct {
+	ID          string
+	UserID      string
+	Status      string // PENDING, RUNNING, READY, FAILED or EXPIRED
+	FileSize    int64
+	Error       string
+	RequestedAt time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+
+	// Only loaded by GetExportArchive
+	Archive []byte
+}
+
+// AccountDeletionRequest is a scheduled erasure of a user account
+type AccountDeletionRequest struct {
+	ID           string
+	UserID       string
+	Status       string // PENDING, CANCELLED or COMPLETED
+	Reason       string
+	RequestedAt  time.Time
+	ScheduledFor time.Time
+	CancelledAt  *time.Time
+	CompletedAt  *time.Time
+}
+
+// ExportSection is one part of a personal data export, one JSON object per row
+type ExportSection struct {
+	Name string
+	Data json.RawMessage // JSON array
+}
+
+// exportSections lists what a personal data export contains. Each query selects one
+// jsonb value per row for user $1; secrets such as password hashes and session tokens
+// are removed before they leave the database.
+var exportSections = []struct {
+	name  string
+	query string
+}{
+	{"profile", `SELECT to_jsonb(u) - 'password_hash' FROM users u WHERE u.id = $1`},
+	{"preferences", `SELECT to_jsonb(p) FROM user_preferences p WHERE p.user_id = $1`},
+	{"sessions", `SELECT to_jsonb(s) - 'session_token' FROM user_sessions s WHERE s.user_id = $1 ORDER BY s.created_at`},
+	{"exam_attempts", `SELECT to_jsonb(a) || jsonb_build_object('exam_title', e.title)
+		FROM exam_attempts a JOIN exams e ON e.id = a.exam_id
+		WHERE a.user_id = $1 ORDER BY a.started_at`},
+	{"exam_answers", `SELECT to_jsonb(ans)
+		FROM exam_answers ans JOIN exam_attempts a ON a.id = ans.attempt_id
+		WHERE a.user_id = $1 ORDER BY a.started_at, ans.answered_at`},
+	{"exam_results", `SELECT to_jsonb(r)
+		FROM exam_results r JOIN exam_attempts a ON a.id = r.attempt_id
+		WHERE a.user_id = $1 ORDER BY r.created_at`},
+	{"focus_sessions", `SELECT to_jsonb(f) FROM focus_sessions f WHERE f.user_id = $1 ORDER BY f.started_at`},
+	{"tasks", `SELECT to_jsonb(t) FROM focus_tasks t WHERE t.user_id = $1 ORDER BY t.created_at`},
+	{"chat_messages", `SELECT to_jsonb(m) FROM room_chat_messages m WHERE m.user_id = $1 ORDER BY m.created_at`},
+	{"bookmarks", `SELECT to_jsonb(b) FROM user_bookmarks b WHERE b.user_id = $1 ORDER BY b.created_at`},
+	{"ratings", `SELECT to_jsonb(r) FROM item_ratings r WHERE r.user_id = $1 ORDER BY r.created_at`},
+	{"searches", `SELECT to_jsonb(s) FROM search_history s WHERE s.user_id = $1 ORDER BY s.created_at`},
+	{"collections", `SELECT to_jsonb(c) FROM library_collections c WHERE c.owner_id = $1 ORDER BY c.created_at`},
+	{"collection_progress", `SELECT to_jsonb(p) FROM library_collection_progress p WHERE p.user_id = $1 ORDER BY p.completed_at`},
+	{"moderation_decisions", `SELECT to_jsonb(d) - 'reviewer_id' FROM moderation_decisions d WHERE d.submitter_id = $1 ORDER BY d.decided_at`},
+	{"notifications", `SELECT to_jsonb(n) FROM notifications n WHERE n.user_id = $1 ORDER BY n.created_at`},
+}
+
+// erasureDeletes removes rows that exist only for the user. They run in order inside the
+// erasure transaction with the user ID as $1.
+var erasureDeletes = []string{
+	`DELETE FROM user_sessions WHERE user_id = $1`,
+	`DELETE FROM refresh_tokens WHERE user_id = $1`,
+	`DELETE FROM oauth_accounts WHERE user_id = $1`,
+	`DELETE FROM email_verification_tokens WHERE user_id = $1`,
+	`DELETE FROM password_reset_tokens WHERE user_id = $1`,
+	`DELETE FROM login_attempts WHERE user_id = $1 OR email = (SELECT email FROM users WHERE id = $1)`,
+	`DELETE FROM account_locks WHERE user_id = $1`,
+	`DELETE FROM user_two_factor WHERE user_id = $1`,
+	`DELETE FROM user_recovery_codes WHERE user_id = $1`,
+	`DELETE FROM two_factor_challenges WHERE user_id = $1`,
+	`DELETE FROM login_codes WHERE user_id = $1`,
+	`DELETE FROM token_metrics WHERE user_id = $1`,
+	`DELETE FROM notifications WHERE user_id = $1`,
+	`DELETE FROM user_preferences WHERE user_id = $1`,
+	`DELETE FROM resource_access WHERE user_id = $1`,
+	`DELETE FROM course_enrollments WHERE user_id = $1`,
+	`DELETE FROM rbac_user_grants WHERE user_id = $1`,
+	`DELETE FROM organisation_members WHERE user_id = $1`,
+	`DELETE FROM class_members WHERE user_id = $1`,
+	`DELETE FROM guardian_links WHERE guardian_id = $1 OR student_id = $1`,
+	`DELETE FROM question_reviewer_subjects WHERE reviewer_id = $1`,
+	`DELETE FROM exam_feedback WHERE user_id = $1`,
+	`DELETE FROM exam_sessions WHERE user_id = $1`,
+	`DELETE FROM exam_rate_limits WHERE user_id = $1`,
+	`DELETE FROM download_history WHERE user_id = $1`,
+	`DELETE FROM item_ratings WHERE user_id = $1`,
+	`DELETE FROM user_bookmarks WHERE user_id = $1`,
+	`DELETE FROM library_user_recommendations WHERE user_id = $1`,
+	`DELETE FROM search_history WHERE user_id = $1`,
+	`DELETE FROM library_collection_progress WHERE user_id = $1`,
+	`DELETE FROM library_collections WHERE owner_id = $1`,
+	`DELETE FROM library_upload_sessions WHERE user_id = $1`,
+	`DELETE FROM focus_rooms WHERE owner_user_id = $1`,
+	`DELETE FROM room_participants WHERE user_id = $1`,
+	`DELETE FROM room_chat_messages WHERE user_id = $1`,
+	`DELETE FROM focus_sessions WHERE user_id = $1`,
+	`DELETE FROM focus_tasks WHERE user_id = $1`,
+	`DELETE FROM user_streaks WHERE user_id = $1`,
+	`DELETE FROM study_analytics WHERE user_id = $1`,
+	`DELETE FROM leaderboard WHERE user_id = $1`,
+	`DELETE FROM user_achievements WHERE user_id = $1`,
+	`DELETE FROM data_export_jobs WHERE user_id = $1`,
+}
+
+// erasureUpdates anonymises rows the platform must keep: exam statistics stay attached
+// to the tombstone account and audit trails lose their network identifiers.
+var erasureUpdates = []string{
+	`UPDATE exam_attempts SET ip_address = NULL, user_agent = NULL, notes = NULL WHERE user_id = $1`,
+	`UPDATE question_feedback SET user_id = NULL WHERE user_id = $1`,
+	`UPDATE audit_logs SET ip_address = '', user_agent = NULL WHERE user_id = $1`,
+	`UPDATE security_events SET ip_address = NULL, user_agent = NULL WHERE user_id = $1`,
+	`UPDATE users SET
+		email = 'deleted+' || id || '@deleted.invalid',
+		password_hash = '',
+		first_name = '',
+		last_name = '',
+		google_id = NULL,
+		username = NULL,
+		avatar = NULL,
+		bio = NULL,
+		phone = NULL,
+		address = NULL,
+		school = NULL,
+		date_of_birth = NULL,
+		gender = NULL,
+		last_login_ip = NULL,
+		resource_path = NULL,
+		email_verified = false,
+		is_active = false,
+		status = 'DELETED',
+		deleted_at = NOW(),
+		updated_at = NOW()
+	WHERE id = $1`,
+}
+
+// PrivacyRepository handles personal data exports and account erasure
+type PrivacyRepository struct {
+	db *sql.DB
+}
+
+// NewPrivacyRepository creates a new privacy repository
+func NewPrivacyRepository(db *sql.DB) *PrivacyRepository {
+	return &PrivacyRepository{db: db}
+}
+
+const exportJobColumns = `id, user_id, status, file_size, COALESCE(error, ''), requested_at, started_at, completed_at, expires_at`
+
+// CreateExportJob queues a new export. ErrDuplicateKey is returned when the user already
+// has an export in progress.
+func (r *PrivacyRepository) CreateExportJob(ctx context.Context, userID string) (*DataExportJob, error) {
+	job, err := scanExportJob(r.db.QueryRowContext(ctx, `
+		INSERT INTO data_export_jobs (user_id) VALUES ($1)
+		RETURNING `+exportJobColumns, userID))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, fmt.Errorf("failed to create data export job: %w", err)
+	}
+	return job, nil
+}
+
+// ListExportJobs returns a user's exports, newest first
+func (r *PrivacyRepository) ListExportJobs(ctx context.Context, userID string) ([]*DataExportJob, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+exportJobColumns+` FROM data_export_jobs
+		WHERE user_id = $1
+		ORDER BY requested_at DESC
+		LIMIT 20
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data export jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*DataExportJob
+	for rows.Next() {
+		job, err := scanExportJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// GetExportArchive returns one of the user's exports together with its archive
+func (r *PrivacyRepository) GetExportArchive(ctx context.Context, userID, jobID string) (*DataExportJob, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+exportJobColumns+`, archive FROM data_export_jobs
+		WHERE id = $1 AND user_id = $2
+	`, jobID, userID)
+
+	job := &DataExportJob{}
+	err := row.Scan(&job.ID, &job.UserID, &job.Status, &job.FileSize, &job.Error,
+		&job.RequestedAt, &job.StartedAt, &job.CompletedAt, &job.ExpiresAt, &job.Archive)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data export job: %w", err)
+	}
+	return job, nil
+}
+
+// ClaimPendingExport marks the oldest PENDING export as RUNNING and returns it.
+// ErrNotFound is returned when no export is waiting.
+func (r *PrivacyRepository) ClaimPendingExport(ctx context.Context) (*DataExportJob, error) {
+	job, err := scanExportJob(r.db.QueryRowContext(ctx, `
+		UPDATE data_export_jobs SET status = 'RUNNING', started_at = NOW()
+		WHERE id = (
+			SELECT id FROM data_export_jobs
+			WHERE status = 'PENDING'
+			ORDER BY requested_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+exportJobColumns))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim data export job: %w", err)
+	}
+	return job, nil
+}
+
+// CompleteExport stores the finished archive and marks the export READY
+func (r *PrivacyRepository) CompleteExport(ctx context.Context, jobID string, archive []byte, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE data_export_jobs SET
+			status = 'READY', archive = $2, file_size = $3, completed_at = NOW(), expires_at = $4
+		WHERE id = $1
+	`, jobID, archive, len(archive), expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to complete data export job: %w", err)
+	}
+	return nil
+}
+
+// FailExport marks an export FAILED with the reason
+func (r *PrivacyRepository) FailExport(ctx context.Context, jobID, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE data_export_jobs SET status = 'FAILED', error = $2, completed_at = NOW()
+		WHERE id = $1
+	`, jobID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to mark data export job failed: %w", err)
+	}
+	return nil
+}
+
+// ExpireExports drops the archives of READY exports that expired before now and
+// returns how many were expired
+func (r *PrivacyRepository) ExpireExports(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE data_export_jobs SET status = 'EXPIRED', archive = NULL
+		WHERE status = 'READY' AND expires_at < $1
+	`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire data export jobs: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// ExportUserData collects every export section for a user
+func (r *PrivacyRepository) ExportUserData(ctx context.Context, userID string) ([]ExportSection, error) {
+	sections := make([]ExportSection, 0, len(exportSections))
+	for _, section := range exportSections {
+		var data []byte
+		err := r.db.QueryRowContext(ctx, `
+			SELECT COALESCE(jsonb_agg(row_data), '[]'::jsonb)
+			FROM (`+section.query+`) AS s(row_data)
+		`, userID).Scan(&data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", section.name, err)
+		}
+		sections = append(sections, ExportSection{Name: section.name, Data: data})
+	}
+	return sections, nil
+}
+
+const deletionColumns = `id, user_id, status, COALESCE(reason, ''), requested_at, scheduled_for, cancelled_at, completed_at`
+
+// CreateDeletionRequest schedules the erasure of a user's account. ErrDuplicateKey is
+// returned when a deletion is already pending.
+func (r *PrivacyRepository) CreateDeletionRequest(ctx context.Context, userID, reason string, scheduledFor time.Time) (*AccountDeletionRequest, error) {
+	req, err := scanDeletionRequest(r.db.QueryRowContext(ctx, `
+		INSERT INTO account_deletion_requests (user_id, reason, scheduled_for)
+		VALUES ($1, NULLIF($2, ''), $3)
+		RETURNING `+deletionColumns, userID, reason, scheduledFor))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, fmt.Errorf("failed to create account deletion request: %w", err)
+	}
+	return req, nil
+}
+
+// GetPendingDeletion returns the user's pending deletion request or ErrNotFound
+func (r *PrivacyRepository) GetPendingDeletion(ctx context.Context, userID string) (*AccountDeletionRequest, error) {
+	req, err := scanDeletionRequest(r.db.QueryRowContext(ctx, `
+		SELECT `+deletionColumns+` FROM account_deletion_requests
+		WHERE user_id = $1 AND status = 'PENDING'
+	`, userID))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account deletion request: %w", err)
+	}
+	return req, nil
+}
+
+// CancelDeletion cancels the user's pending deletion. It reports false when nothing was pending.
+func (r *PrivacyRepository) CancelDeletion(ctx context.Context, userID string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE account_deletion_requests SET status = 'CANCELLED', cancelled_at = NOW()
+		WHERE user_id = $1 AND status = 'PENDING'
+	`, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel account deletion: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ListDueDeletions returns pending deletions scheduled at or before now, oldest first
+func (r *PrivacyRepository) ListDueDeletions(ctx context.Context, now time.Time, limit int) ([]*AccountDeletionRequest, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+deletionColumns+` FROM account_deletion_requests
+		WHERE status = 'PENDING' AND scheduled_for <= $1
+		ORDER BY scheduled_for
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due account deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []*AccountDeletionRequest
+	for rows.Next() {
+		req, err := scanDeletionRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account deletion request: %w", err)
+		}
+		requests = append(requests, req)
+	}
+	return requests, rows.Err()
+}
+
+// EraseUser carries out a pending deletion in one transaction: rows that exist only for
+// the user are deleted, rows the platform must keep are anonymised and the users row
+// becomes a DELETED tombstone. It reports false when the request was no longer pending.
+func (r *PrivacyRepository) EraseUser(ctx context.Context, requestID, userID string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE account_deletion_requests SET status = 'COMPLETED', completed_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND status = 'PENDING'
+	`, requestID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to complete account deletion request: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return false, nil
+	}
+
+	for _, stmt := range erasureDeletes {
+		if _, err := tx.ExecContext(ctx, stmt, userID); err != nil {
+			return false, fmt.Errorf("failed to erase user data: %w", err)
+		}
+	}
+	for _, stmt := range erasureUpdates {
+		if _, err := tx.ExecContext(ctx, stmt, userID); err != nil {
+			return false, fmt.Errorf("failed to anonymise user data: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit account erasure: %w", err)
+	}
+	return true, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanExportJob(row rowScanner) (*DataExportJob, error) {
+	job := &DataExportJob{}
+	err := row.Scan(&job.ID, &job.UserID, &job.Status, &job.FileSize, &job.Error,
+		&job.RequestedAt, &job.StartedAt, &job.CompletedAt, &job.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func scanDeletionRequest(row rowScanner) (*AccountDeletionRequest, error) {
+	req := &AccountDeletionRequest{}
+	err := row.Scan(&req.ID, &req.UserID, &req.Status, &req.Reason,
+		&req.RequestedAt, &req.ScheduledFor, &req.CancelledAt, &req.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
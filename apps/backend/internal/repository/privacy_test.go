@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivacyRepository_EraseUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Resumable uploads keep the filename, metadata and checksum of the user's files
+	require.Contains(t, erasureDeletes, `DELETE FROM library_upload_sessions WHERE user_id = $1`)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE account_deletion_requests SET status = 'COMPLETED'`).
+		WithArgs("request-1", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, stmt := range append(append([]string(nil), erasureDeletes...), erasureUpdates...) {
+		mock.ExpectExec(regexp.QuoteMeta(stmt)).WithArgs("user-1").WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	erased, err := NewPrivacyRepository(db).EraseUser(context.Background(), "request-1", "user-1")
+	require.NoError(t, err)
+	require.True(t, erased)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrivacyRepository_EraseUser_NotPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE account_deletion_requests`).
+		WithArgs("request-1", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	erased, err := NewPrivacyRepository(db).EraseUser(context.Background(), "request-1", "user-1")
+	require.NoError(t, err)
+	require.False(t, erased)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -369,6 +369,42 @@ func (r *QuestionCodeRepository) scanQuestionCodes(rows *sql.Rows) ([]*entity.Qu
 	return codes, nil
 }
 
+// GetCoverageCounts counts non-archived questions per grade/subject/chapter/lesson/form,
+// split by question type and difficulty. The level component is aggregated away.
+func (r *QuestionCodeRepository) GetCoverageCounts(ctx context.Context) ([]entity.QuestionCoverageCount, error) {
+	query := `
+		SELECT
+			qc.grade, qc.subject, qc.chapter, qc.lesson, COALESCE(qc.form, '') AS form,
+			q.type, COALESCE(q.difficulty::text, '') AS difficulty,
+			COUNT(*) AS question_count
+		FROM question q
+		JOIN question_code qc ON q.question_code_id = qc.code
+		WHERE q.status <> 'ARCHIVED'
+		GROUP BY qc.grade, qc.subject, qc.chapter, qc.lesson, qc.form, q.type, q.difficulty
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coverage counts: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []entity.QuestionCoverageCount
+	for rows.Next() {
+		var c entity.QuestionCoverageCount
+		if err := rows.Scan(&c.Grade, &c.Subject, &c.Chapter, &c.Lesson, &c.Form, &c.Type, &c.Difficulty, &c.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan coverage count: %w", err)
+		}
+		counts = append(counts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate coverage counts: %w", err)
+	}
+
+	return counts, nil
+}
+
 // nullableStringToSQL converts pgtype.Text to sql.NullString
 func nullableStringToSQL(t pgtype.Text) sql.NullString {
 	if t.Status == pgtype.Present {
@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+// ReportQueueFilter narrows the triage queue
+type ReportQueueFilter struct {
+	Status   entity.ReportStatus   // empty = all active reports
+	Category entity.ReportCategory // empty = any category
+}
+
+// QuestionReportRepository handles student error reports stored in question_feedback
+type QuestionReportRepository interface {
+	// Reports
+	Create(ctx context.Context, report *entity.QuestionErrorReport) error
+	ListByQuestion(ctx context.Context, questionID string, activeOnly bool) ([]*entity.QuestionErrorReport, error)
+	CountCredibleActive(ctx context.Context, questionID string) (int, error)
+	UpdateActiveStatus(ctx context.Context, questionID string, status entity.ReportStatus, actorID string, note *string) (int, error)
+	ListQueue(ctx context.Context, filter ReportQueueFilter, limit, offset int) ([]*entity.ReportTriageItem, int, error)
+
+	// Credibility and re-grading
+	FindSubmittedAttempt(ctx context.Context, userID, questionID string) (string, error)
+	ListSubmittedAttemptIDs(ctx context.Context, questionID string) ([]string, error)
+
+	// Suspensions
+	GetSuspension(ctx context.Context, questionID string) (*entity.QuestionSuspension, error)
+	Suspend(ctx context.Context, suspension *entity.QuestionSuspension) (bool, error)
+	LiftSuspension(ctx context.Context, questionID string) error
+}
+
+type questionReportRepository struct {
+	db *sql.DB
+}
+
+// NewQuestionReportRepository creates a new question report repository
+func NewQuestionReportRepository(db *sql.DB) QuestionReportRepository {
+	return &questionReportRepository{db: db}
+}
+
+// Create inserts a REPORT feedback entry
+func (r *questionReportRepository) Create(ctx context.Context, report *entity.QuestionErrorReport) error {
+	if report.ID == "" {
+		report.ID = uuid.New().String()
+	}
+	if report.Status == "" {
+		report.Status = entity.ReportStatusOpen
+	}
+	report.CreatedAt = time.Now()
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO question_feedback (
+			id, question_id, user_id, feedback_type, content,
+			report_category, report_status, attempt_id, is_credible, created_at
+		) VALUES ($1, $2, $3, 'REPORT', $4, $5, $6, $7, $8, $9)
+	`,
+		report.ID, report.QuestionID, report.UserID, report.Content,
+		report.Category, report.Status, report.AttemptID, report.IsCredible, report.CreatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create question report: %w", err)
+	}
+	return nil
+}
+
+// ListByQuestion returns the reports filed against a question, newest first
+func (r *questionReportRepository) ListByQuestion(ctx context.Context, questionID string, activeOnly bool) ([]*entity.QuestionErrorReport, error) {
+	query := `
+		SELECT id, question_id, user_id, COALESCE(report_category, 'OTHER'), COALESCE(report_status, 'OPEN'),
+		       content, attempt_id::text, is_credible, resolved_by, resolved_at, resolution_note, created_at
+		FROM question_feedback
+		WHERE question_id = $1 AND feedback_type = 'REPORT'
+	`
+	if activeOnly {
+		query += ` AND report_status IN ('OPEN', 'ACKNOWLEDGED')`
+	}
+	query += ` ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question reports: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []*entity.QuestionErrorReport
+	for rows.Next() {
+		var rep entity.QuestionErrorReport
+		if err := rows.Scan(
+			&rep.ID, &rep.QuestionID, &rep.UserID, &rep.Category, &rep.Status,
+			&rep.Content, &rep.AttemptID, &rep.IsCredible, &rep.ResolvedBy, &rep.ResolvedAt,
+			&rep.ResolutionNote, &rep.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan question report: %w", err)
+		}
+		reports = append(reports, &rep)
+	}
+	return reports, rows.Err()
+}
+
+// CountCredibleActive counts active credible reports from distinct reporters
+func (r *questionReportRepository) CountCredibleActive(ctx context.Context, questionID string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT user_id)
+		FROM question_feedback
+		WHERE question_id = $1
+		  AND feedback_type = 'REPORT'
+		  AND report_status IN ('OPEN', 'ACKNOWLEDGED')
+		  AND is_credible = true
+	`, questionID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count credible reports: %w", err)
+	}
+	return count, nil
+}
+
+// UpdateActiveStatus moves every active report of a question to the given status
+func (r *questionReportRepository) UpdateActiveStatus(ctx context.Context, questionID string, status entity.ReportStatus, actorID string, note *string) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE question_feedback
+		SET report_status = $2,
+		    resolved_by = CASE WHEN $2 IN ('RESOLVED', 'DISMISSED') THEN $3 ELSE resolved_by END,
+		    resolved_at = CASE WHEN $2 IN ('RESOLVED', 'DISMISSED') THEN NOW() ELSE resolved_at END,
+		    resolution_note = COALESCE($4, resolution_note)
+		WHERE question_id = $1
+		  AND feedback_type = 'REPORT'
+		  AND report_status IN ('OPEN', 'ACKNOWLEDGED')
+	`, questionID, status, actorID, note)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update report status: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rows), nil
+}
+
+// ListQueue groups active reports per question, most credible first
+func (r *questionReportRepository) ListQueue(ctx context.Context, filter ReportQueueFilter, limit, offset int) ([]*entity.ReportTriageItem, int, error) {
+	where := `f.feedback_type = 'REPORT' AND f.report_status IN ('OPEN', 'ACKNOWLEDGED')`
+	args := []interface{}{}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where += fmt.Sprintf(` AND f.report_status = $%d`, len(args))
+	}
+	if filter.Category != "" {
+		args = append(args, filter.Category)
+		where += fmt.Sprintf(` AND f.report_category = $%d`, len(args))
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(DISTINCT f.question_id) FROM question_feedback f WHERE `+where, args...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count report queue: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT f.question_id,
+		       COALESCE(q.question_code_id, ''),
+		       LEFT(q.content, 200),
+		       BOOL_OR(f.report_status = 'OPEN'),
+		       COUNT(*),
+		       COUNT(DISTINCT f.user_id) FILTER (WHERE f.is_credible),
+		       COUNT(*) FILTER (WHERE f.report_category = 'WRONG_KEY'),
+		       COUNT(*) FILTER (WHERE f.report_category = 'TYPO'),
+		       COUNT(*) FILTER (WHERE f.report_category = 'AMBIGUOUS'),
+		       COUNT(*) FILTER (WHERE f.report_category = 'BROKEN_IMAGE'),
+		       COUNT(*) FILTER (WHERE f.report_category = 'OTHER'),
+		       s.question_id IS NOT NULL,
+		       MIN(f.created_at),
+		       MAX(f.created_at)
+		FROM question_feedback f
+		JOIN question q ON q.id = f.question_id
+		LEFT JOIN question_suspensions s ON s.question_id = f.question_id
+		WHERE %s
+		GROUP BY f.question_id, q.question_code_id, q.content, s.question_id
+		ORDER BY 6 DESC, 5 DESC, MIN(f.created_at) ASC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list report queue: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*entity.ReportTriageItem
+	for rows.Next() {
+		var (
+			item                                        entity.ReportTriageItem
+			hasOpen                                     bool
+			wrongKey, typo, ambiguous, brokenImg, other int
+		)
+		if err := rows.Scan(
+			&item.QuestionID, &item.QuestionCodeID, &item.ContentPreview, &hasOpen,
+			&item.ReportCount, &item.CredibleCount,
+			&wrongKey, &typo, &ambiguous, &brokenImg, &other,
+			&item.IsSuspended, &item.FirstReportedAt, &item.LastReportedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan report queue item: %w", err)
+		}
+		item.Status = entity.ReportStatusAcknowledged
+		if hasOpen {
+			item.Status = entity.ReportStatusOpen
+		}
+		item.CategoryCounts = map[entity.ReportCategory]int{}
+		for cat, n := range map[entity.ReportCategory]int{
+			entity.ReportCategoryWrongKey:    wrongKey,
+			entity.ReportCategoryTypo:        typo,
+			entity.ReportCategoryAmbiguous:   ambiguous,
+			entity.ReportCategoryBrokenImage: brokenImg,
+			entity.ReportCategoryOther:       other,
+		} {
+			if n > 0 {
+				item.CategoryCounts[cat] = n
+			}
+		}
+		items = append(items, &item)
+	}
+	return items, total, rows.Err()
+}
+
+// FindSubmittedAttempt returns the latest submitted attempt in which the user answered the question
+func (r *questionReportRepository) FindSubmittedAttempt(ctx context.Context, userID, questionID string) (string, error) {
+	var attemptID string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT ea.id::text
+		FROM exam_attempts ea
+		JOIN exam_answers ans ON ans.attempt_id = ea.id
+		WHERE ea.user_id = $1
+		  AND ans.question_id = $2
+		  AND ea.status IN ('submitted', 'graded')
+		ORDER BY ea.submitted_at DESC NULLS LAST
+		LIMIT 1
+	`, userID, questionID).Scan(&attemptID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find submitted attempt: %w", err)
+	}
+	return attemptID, nil
+}
+
+// ListSubmittedAttemptIDs returns submitted attempts of every exam that contains the question
+func (r *questionReportRepository) ListSubmittedAttemptIDs(ctx context.Context, questionID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ea.id::text
+		FROM exam_attempts ea
+		JOIN exam_questions eq ON eq.exam_id = ea.exam_id
+		WHERE eq.question_id = $1 AND ea.status = 'submitted'
+		ORDER BY ea.submitted_at ASC
+	`, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list affected attempts: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan attempt id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// GetSuspension returns the active suspension of a question
+func (r *questionReportRepository) GetSuspension(ctx context.Context, questionID string) (*entity.QuestionSuspension, error) {
+	var s entity.QuestionSuspension
+	err := r.db.QueryRowContext(ctx, `
+		SELECT question_id, reason, credible_reports, suspended_by, suspended_at
+		FROM question_suspensions
+		WHERE question_id = $1
+	`, questionID).Scan(&s.QuestionID, &s.Reason, &s.CredibleReports, &s.SuspendedBy, &s.SuspendedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question suspension: %w", err)
+	}
+	return &s, nil
+}
+
+// Suspend records a suspension. It reports false if the question was already suspended.
+func (r *questionReportRepository) Suspend(ctx context.Context, suspension *entity.QuestionSuspension) (bool, error) {
+	suspension.SuspendedAt = time.Now()
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO question_suspensions (question_id, reason, credible_reports, suspended_by, suspended_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (question_id) DO NOTHING
+	`, suspension.QuestionID, suspension.Reason, suspension.CredibleReports, suspension.SuspendedBy, suspension.SuspendedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to suspend question: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// LiftSuspension allows the question to be used in new exams again
+func (r *questionReportRepository) LiftSuspension(ctx context.Context, questionID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM question_suspensions WHERE question_id = $1`, questionID); err != nil {
+		return fmt.Errorf("failed to lift question suspension: %w", err)
+	}
+	return nil
+}
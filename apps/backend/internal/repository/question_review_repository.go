@@ -0,0 +1,464 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+// ReviewerCandidate is a reviewer eligible for a subject with their current open workload
+type ReviewerCandidate struct {
+	ReviewerID  string
+	OpenReviews int
+}
+
+// QuestionReviewRepository handles persistence for the question review workflow
+type QuestionReviewRepository interface {
+	// Review rounds
+	Create(ctx context.Context, review *entity.QuestionReview) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.QuestionReview, error)
+	GetOpenByQuestionID(ctx context.Context, questionID string) (*entity.QuestionReview, error)
+	ListByQuestionID(ctx context.Context, questionID string) ([]*entity.QuestionReview, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, status entity.QuestionReviewStatus, note *string) error
+	AssignReviewer(ctx context.Context, id uuid.UUID, reviewerID string) error
+	Resubmit(ctx context.Context, id uuid.UUID, versionNumber int32) error
+
+	// Reviewer assignment
+	SetReviewerSubjects(ctx context.Context, reviewerID string, subjects []*entity.ReviewerSubject) error
+	ListReviewerSubjects(ctx context.Context, reviewerID string) ([]*entity.ReviewerSubject, error)
+	FindReviewerCandidates(ctx context.Context, subject, grade string) ([]ReviewerCandidate, error)
+
+	// Dashboard
+	ListOutstanding(ctx context.Context, reviewerID string, limit, offset int) ([]*entity.OutstandingReview, int, error)
+	GetWorkload(ctx context.Context, reviewerID string, since time.Time) (*entity.ReviewerWorkload, error)
+
+	// Comments
+	CreateComment(ctx context.Context, comment *entity.QuestionReviewComment) error
+	GetComment(ctx context.Context, id uuid.UUID) (*entity.QuestionReviewComment, error)
+	ListComments(ctx context.Context, reviewID uuid.UUID) ([]*entity.QuestionReviewComment, error)
+	SetCommentResolved(ctx context.Context, id uuid.UUID, resolved bool) error
+}
+
+type questionReviewRepository struct {
+	db *sql.DB
+}
+
+// NewQuestionReviewRepository creates a new question review repository
+func NewQuestionReviewRepository(db *sql.DB) QuestionReviewRepository {
+	return &questionReviewRepository{db: db}
+}
+
+const questionReviewColumns = `
+	id, question_id, author_id, reviewer_id, status, version_number,
+	subject, grade, decision_note, submitted_at, decided_at, created_at, updated_at
+`
+
+func scanQuestionReview(row interface{ Scan(...interface{}) error }, review *entity.QuestionReview) error {
+	return row.Scan(
+		&review.ID,
+		&review.QuestionID,
+		&review.AuthorID,
+		&review.ReviewerID,
+		&review.Status,
+		&review.VersionNumber,
+		&review.Subject,
+		&review.Grade,
+		&review.DecisionNote,
+		&review.SubmittedAt,
+		&review.DecidedAt,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+}
+
+// Create inserts a new review round
+func (r *questionReviewRepository) Create(ctx context.Context, review *entity.QuestionReview) error {
+	if review.ID == uuid.Nil {
+		review.ID = uuid.New()
+	}
+	now := time.Now()
+	if review.SubmittedAt.IsZero() {
+		review.SubmittedAt = now
+	}
+	review.CreatedAt = now
+	review.UpdatedAt = now
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO question_reviews (
+			id, question_id, author_id, reviewer_id, status, version_number,
+			subject, grade, submitted_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`,
+		review.ID, review.QuestionID, review.AuthorID, review.ReviewerID, review.Status,
+		review.VersionNumber, review.Subject, review.Grade,
+		review.SubmittedAt, review.CreatedAt, review.UpdatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrDuplicateKey
+		}
+		return fmt.Errorf("failed to create question review: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a review round by ID
+func (r *questionReviewRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.QuestionReview, error) {
+	var review entity.QuestionReview
+	err := scanQuestionReview(r.db.QueryRowContext(ctx,
+		`SELECT `+questionReviewColumns+` FROM question_reviews WHERE id = $1`, id), &review)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question review: %w", err)
+	}
+	return &review, nil
+}
+
+// GetOpenByQuestionID returns the review round that has not been decided yet
+func (r *questionReviewRepository) GetOpenByQuestionID(ctx context.Context, questionID string) (*entity.QuestionReview, error) {
+	var review entity.QuestionReview
+	err := scanQuestionReview(r.db.QueryRowContext(ctx, `
+		SELECT `+questionReviewColumns+`
+		FROM question_reviews
+		WHERE question_id = $1 AND status IN ('IN_REVIEW', 'CHANGES_REQUESTED')
+	`, questionID), &review)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open question review: %w", err)
+	}
+	return &review, nil
+}
+
+// ListByQuestionID returns all review rounds of a question, newest first
+func (r *questionReviewRepository) ListByQuestionID(ctx context.Context, questionID string) ([]*entity.QuestionReview, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+questionReviewColumns+`
+		FROM question_reviews
+		WHERE question_id = $1
+		ORDER BY created_at DESC
+	`, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*entity.QuestionReview
+	for rows.Next() {
+		var review entity.QuestionReview
+		if err := scanQuestionReview(rows, &review); err != nil {
+			return nil, fmt.Errorf("failed to scan question review: %w", err)
+		}
+		reviews = append(reviews, &review)
+	}
+	return reviews, rows.Err()
+}
+
+// UpdateStatus records a review decision. Final states also stamp decided_at.
+func (r *questionReviewRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.QuestionReviewStatus, note *string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE question_reviews
+		SET status = $2,
+		    decision_note = COALESCE($3, decision_note),
+		    decided_at = CASE WHEN $2 IN ('APPROVED', 'REJECTED') THEN NOW() ELSE decided_at END,
+		    updated_at = NOW()
+		WHERE id = $1
+	`, id, status, note)
+	if err != nil {
+		return fmt.Errorf("failed to update question review status: %w", err)
+	}
+	return requireRowsAffected(result)
+}
+
+// AssignReviewer sets or replaces the reviewer of a review round
+func (r *questionReviewRepository) AssignReviewer(ctx context.Context, id uuid.UUID, reviewerID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE question_reviews
+		SET reviewer_id = $2, updated_at = NOW()
+		WHERE id = $1
+	`, id, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to assign reviewer: %w", err)
+	}
+	return requireRowsAffected(result)
+}
+
+// Resubmit moves a round with requested changes back to IN_REVIEW on a newer version
+func (r *questionReviewRepository) Resubmit(ctx context.Context, id uuid.UUID, versionNumber int32) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE question_reviews
+		SET status = 'IN_REVIEW', version_number = $2, submitted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'CHANGES_REQUESTED'
+	`, id, versionNumber)
+	if err != nil {
+		return fmt.Errorf("failed to resubmit question review: %w", err)
+	}
+	return requireRowsAffected(result)
+}
+
+// SetReviewerSubjects replaces the subjects a reviewer is responsible for
+func (r *questionReviewRepository) SetReviewerSubjects(ctx context.Context, reviewerID string, subjects []*entity.ReviewerSubject) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM question_reviewer_subjects WHERE reviewer_id = $1`, reviewerID); err != nil {
+		return fmt.Errorf("failed to clear reviewer subjects: %w", err)
+	}
+
+	for _, s := range subjects {
+		if s.ID == uuid.Nil {
+			s.ID = uuid.New()
+		}
+		s.ReviewerID = reviewerID
+		s.CreatedAt = time.Now()
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO question_reviewer_subjects (id, reviewer_id, subject, grade, is_active, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, s.ID, s.ReviewerID, s.Subject, s.Grade, s.IsActive, s.CreatedAt); err != nil {
+			return fmt.Errorf("failed to insert reviewer subject: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListReviewerSubjects returns the subjects assigned to a reviewer
+func (r *questionReviewRepository) ListReviewerSubjects(ctx context.Context, reviewerID string) ([]*entity.ReviewerSubject, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, reviewer_id, subject, grade, is_active, created_at
+		FROM question_reviewer_subjects
+		WHERE reviewer_id = $1
+		ORDER BY subject, grade NULLS FIRST
+	`, reviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reviewer subjects: %w", err)
+	}
+	defer rows.Close()
+
+	var subjects []*entity.ReviewerSubject
+	for rows.Next() {
+		var s entity.ReviewerSubject
+		if err := rows.Scan(&s.ID, &s.ReviewerID, &s.Subject, &s.Grade, &s.IsActive, &s.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer subject: %w", err)
+		}
+		subjects = append(subjects, &s)
+	}
+	return subjects, rows.Err()
+}
+
+// FindReviewerCandidates returns active reviewers for a subject/grade ordered by open workload.
+// Grade-specific assignments are preferred over subject-wide ones.
+func (r *questionReviewRepository) FindReviewerCandidates(ctx context.Context, subject, grade string) ([]ReviewerCandidate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT rs.reviewer_id, COUNT(qr.id) AS open_reviews
+		FROM question_reviewer_subjects rs
+		JOIN users u ON u.id = rs.reviewer_id AND u.status = 'ACTIVE'
+		LEFT JOIN question_reviews qr
+			ON qr.reviewer_id = rs.reviewer_id AND qr.status IN ('IN_REVIEW', 'CHANGES_REQUESTED')
+		WHERE rs.is_active = true
+		  AND rs.subject = $1
+		  AND (rs.grade IS NULL OR rs.grade = NULLIF($2, ''))
+		GROUP BY rs.reviewer_id, rs.grade
+		ORDER BY (rs.grade IS NULL), open_reviews ASC, rs.reviewer_id
+	`, strings.ToUpper(subject), strings.ToUpper(grade))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviewer candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []ReviewerCandidate
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var c ReviewerCandidate
+		if err := rows.Scan(&c.ReviewerID, &c.OpenReviews); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer candidate: %w", err)
+		}
+		if seen[c.ReviewerID] {
+			continue
+		}
+		seen[c.ReviewerID] = true
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+// ListOutstanding returns reviews waiting on the reviewer, oldest submission first
+func (r *questionReviewRepository) ListOutstanding(ctx context.Context, reviewerID string, limit, offset int) ([]*entity.OutstandingReview, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM question_reviews
+		WHERE reviewer_id = $1 AND status = 'IN_REVIEW'
+	`, reviewerID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count outstanding reviews: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT qr.id, qr.question_id, qr.author_id, qr.reviewer_id, qr.status, qr.version_number,
+		       qr.subject, qr.grade, qr.decision_note, qr.submitted_at, qr.decided_at,
+		       qr.created_at, qr.updated_at,
+		       q.question_code_id, q.type::text, LEFT(q.content, 200),
+		       (SELECT COUNT(*) FROM question_review_comments c
+		         WHERE c.review_id = qr.id AND c.is_resolved = false)
+		FROM question_reviews qr
+		JOIN question q ON q.id = qr.question_id
+		WHERE qr.reviewer_id = $1 AND qr.status = 'IN_REVIEW'
+		ORDER BY qr.submitted_at ASC
+		LIMIT $2 OFFSET $3
+	`, reviewerID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list outstanding reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*entity.OutstandingReview
+	for rows.Next() {
+		var item entity.OutstandingReview
+		rv := &item.Review
+		if err := rows.Scan(
+			&rv.ID, &rv.QuestionID, &rv.AuthorID, &rv.ReviewerID, &rv.Status, &rv.VersionNumber,
+			&rv.Subject, &rv.Grade, &rv.DecisionNote, &rv.SubmittedAt, &rv.DecidedAt,
+			&rv.CreatedAt, &rv.UpdatedAt,
+			&item.QuestionCodeID, &item.QuestionType, &item.ContentPreview, &item.UnresolvedComments,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan outstanding review: %w", err)
+		}
+		items = append(items, &item)
+	}
+	return items, total, rows.Err()
+}
+
+// GetWorkload aggregates review counts for a reviewer
+func (r *questionReviewRepository) GetWorkload(ctx context.Context, reviewerID string, since time.Time) (*entity.ReviewerWorkload, error) {
+	workload := &entity.ReviewerWorkload{ReviewerID: reviewerID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE status = 'IN_REVIEW'),
+			COUNT(*) FILTER (WHERE status = 'CHANGES_REQUESTED'),
+			COUNT(*) FILTER (WHERE status = 'APPROVED' AND decided_at >= $2),
+			COUNT(*) FILTER (WHERE status = 'REJECTED' AND decided_at >= $2)
+		FROM question_reviews
+		WHERE reviewer_id = $1
+	`, reviewerID, since).Scan(
+		&workload.InReview,
+		&workload.ChangesRequested,
+		&workload.ApprovedRecent,
+		&workload.RejectedRecent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer workload: %w", err)
+	}
+	return workload, nil
+}
+
+const questionReviewCommentColumns = `
+	id, review_id, question_id, version_number, parent_id, author_id,
+	field, line_start, line_end, body, is_resolved, created_at, updated_at
+`
+
+func scanQuestionReviewComment(row interface{ Scan(...interface{}) error }, c *entity.QuestionReviewComment) error {
+	return row.Scan(
+		&c.ID, &c.ReviewID, &c.QuestionID, &c.VersionNumber, &c.ParentID, &c.AuthorID,
+		&c.Field, &c.LineStart, &c.LineEnd, &c.Body, &c.IsResolved, &c.CreatedAt, &c.UpdatedAt,
+	)
+}
+
+// CreateComment inserts a review comment or reply
+func (r *questionReviewRepository) CreateComment(ctx context.Context, comment *entity.QuestionReviewComment) error {
+	if comment.ID == uuid.Nil {
+		comment.ID = uuid.New()
+	}
+	now := time.Now()
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO question_review_comments (`+questionReviewCommentColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`,
+		comment.ID, comment.ReviewID, comment.QuestionID, comment.VersionNumber, comment.ParentID,
+		comment.AuthorID, comment.Field, comment.LineStart, comment.LineEnd, comment.Body,
+		comment.IsResolved, comment.CreatedAt, comment.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create review comment: %w", err)
+	}
+	return nil
+}
+
+// GetComment retrieves a single review comment
+func (r *questionReviewRepository) GetComment(ctx context.Context, id uuid.UUID) (*entity.QuestionReviewComment, error) {
+	var comment entity.QuestionReviewComment
+	err := scanQuestionReviewComment(r.db.QueryRowContext(ctx,
+		`SELECT `+questionReviewCommentColumns+` FROM question_review_comments WHERE id = $1`, id), &comment)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review comment: %w", err)
+	}
+	return &comment, nil
+}
+
+// ListComments returns all comments of a review in creation order
+func (r *questionReviewRepository) ListComments(ctx context.Context, reviewID uuid.UUID) ([]*entity.QuestionReviewComment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+questionReviewCommentColumns+`
+		FROM question_review_comments
+		WHERE review_id = $1
+		ORDER BY created_at ASC
+	`, reviewID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*entity.QuestionReviewComment
+	for rows.Next() {
+		var comment entity.QuestionReviewComment
+		if err := scanQuestionReviewComment(rows, &comment); err != nil {
+			return nil, fmt.Errorf("failed to scan review comment: %w", err)
+		}
+		comments = append(comments, &comment)
+	}
+	return comments, rows.Err()
+}
+
+// SetCommentResolved marks a comment thread as resolved or reopens it
+func (r *questionReviewRepository) SetCommentResolved(ctx context.Context, id uuid.UUID, resolved bool) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE question_review_comments
+		SET is_resolved = $2, updated_at = NOW()
+		WHERE id = $1
+	`, id, resolved)
+	if err != nil {
+		return fmt.Errorf("failed to update review comment: %w", err)
+	}
+	return requireRowsAffected(result)
+}
+
+// requireRowsAffected converts an update that touched no rows into ErrNotFound
+func requireRowsAffected(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -56,6 +56,7 @@ type ResourceAccessRepository interface {
 	GetResourceAccesses(ctx context.Context, resourceType, resourceID string, limit int) ([]*ResourceAccess, error)
 	GetSuspiciousAccesses(ctx context.Context, minRiskScore int) ([]*ResourceAccess, error)
 	CountUserAccesses(ctx context.Context, userID string, since time.Time) (int, error)
+	CountUserActions(ctx context.Context, userID, action string, since time.Time) (int, error)
 	CalculateRiskScore(ctx context.Context, userID string) (int, error)
 	// Add aliases for admin service
 	GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*ResourceAccess, error)
@@ -438,6 +439,22 @@ func (r *resourceAccessRepository) CountUserAccesses(ctx context.Context, userID
 	return count, nil
 }
 
+// CountUserActions counts a user's valid accesses with the given action since a given time
+func (r *resourceAccessRepository) CountUserActions(ctx context.Context, userID, action string, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM resource_access
+		WHERE user_id = $1 AND action = $2 AND is_valid_access = true AND created_at >= $3`
+
+	var count int
+	err := r.db.QueryRowContext(ctx, query, userID, action, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count user actions: %w", err)
+	}
+
+	return count, nil
+}
+
 // CalculateRiskScore calculates overall risk score for a user
 func (r *resourceAccessRepository) CalculateRiskScore(ctx context.Context, userID string) (int, error) {
 	// Get recent accesses
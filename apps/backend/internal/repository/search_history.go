@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// SearchHistoryEntry is one logged search
+type SearchHistoryEntry struct {
+	Scope           string // library or question
+	UserID          string // Empty for anonymous searches
+	Query           string
+	NormalizedQuery string
+	Filters         []byte // JSON object of the applied filters
+	ResultCount     int
+}
+
+// SearchQueryStat aggregates the searches of one normalized query
+type SearchQueryStat struct {
+	Query          string
+	Searches       int64
+	Users          int64 // Distinct signed-in users
+	Clicks         int64 // Searches after which a result was opened
+	AverageResults float64
+	LastSearchedAt time.Time
+}
+
+// RecentSearch is a query a user searched recently
+type RecentSearch struct {
+	Query           string // As last typed
+	NormalizedQuery string
+	SearchedAt      time.Time
+}
+
+// SearchTrendFilter selects queries for trending lists
+type SearchTrendFilter struct {
+	Scope       string
+	Since       time.Time
+	Prefix      string // Normalized prefix; empty matches every query
+	MinUsers    int    // Distinct users a query needs to count as trending
+	WithResults bool   // Leave out queries that never returned anything
+	Limit       int
+}
+
+// SearchHistoryRepository stores library and question searches
+type SearchHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewSearchHistoryRepository creates a new search history repository
+func NewSearchHistoryRepository(db *sql.DB) *SearchHistoryRepository {
+	return &SearchHistoryRepository{db: db}
+}
+
+// Insert logs a search and returns its ID, used to attribute a later click
+func (r *SearchHistoryRepository) Insert(ctx context.Context, entry SearchHistoryEntry) (string, error) {
+	filters := entry.Filters
+	if len(filters) == 0 {
+		filters = []byte("{}")
+	}
+	var id string
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO search_history (scope, user_id, query, normalized_query, filters, result_count)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)
+		RETURNING id`,
+		entry.Scope, entry.UserID, entry.Query, entry.NormalizedQuery, string(filters), entry.ResultCount,
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("insert search history: %w", err)
+	}
+	return id, nil
+}
+
+// RecordClick stores the result opened after a search. Only the first click is kept, and
+// signed-in users may only mark their own searches. Returns ErrNotFound for unknown searches.
+func (r *SearchHistoryRepository) RecordClick(ctx context.Context, searchID, userID, itemID string, at time.Time) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE search_history
+		SET clicked_item_id = COALESCE(clicked_item_id, $3),
+		    clicked_at = COALESCE(clicked_at, $4)
+		WHERE id = $1 AND (user_id IS NULL OR user_id = $2)`,
+		searchID, userID, itemID, at,
+	)
+	if err != nil {
+		return fmt.Errorf("record search click: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListRecent returns a user's distinct recent queries, newest first
+func (r *SearchHistoryRepository) ListRecent(ctx context.Context, userID, scope, prefix string, limit int) ([]RecentSearch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT (ARRAY_AGG(query ORDER BY created_at DESC))[1], normalized_query, MAX(created_at)
+		FROM search_history
+		WHERE user_id = $1 AND scope = $2 AND normalized_query LIKE $3
+		GROUP BY normalized_query
+		ORDER BY MAX(created_at) DESC
+		LIMIT $4`,
+		userID, scope, likePrefix(prefix), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list recent searches: %w", err)
+	}
+	defer rows.Close()
+
+	var searches []RecentSearch
+	for rows.Next() {
+		var s RecentSearch
+		if err := rows.Scan(&s.Query, &s.NormalizedQuery, &s.SearchedAt); err != nil {
+			return nil, fmt.Errorf("scan recent search: %w", err)
+		}
+		searches = append(searches, s)
+	}
+	return searches, rows.Err()
+}
+
+// ListTrending returns the queries searched by the most users since filter.Since
+func (r *SearchHistoryRepository) ListTrending(ctx context.Context, filter SearchTrendFilter) ([]SearchQueryStat, error) {
+	having := "COUNT(DISTINCT user_id) >= $4"
+	if filter.WithResults {
+		having += " AND MAX(result_count) > 0"
+	}
+	return r.listStats(ctx, `
+		SELECT normalized_query, COUNT(*), COUNT(DISTINCT user_id), COUNT(clicked_item_id),
+		       AVG(result_count), MAX(created_at)
+		FROM search_history
+		WHERE scope = $1 AND created_at >= $2 AND normalized_query LIKE $3
+		GROUP BY normalized_query
+		HAVING `+having+`
+		ORDER BY COUNT(DISTINCT user_id) DESC, COUNT(*) DESC, normalized_query
+		LIMIT $5`,
+		filter.Scope, filter.Since, likePrefix(filter.Prefix), filter.MinUsers, filter.Limit,
+	)
+}
+
+// ListZeroResults returns queries that found nothing since the given time, most searched
+// first. Queries that also returned results in the window are left out.
+func (r *SearchHistoryRepository) ListZeroResults(ctx context.Context, scope string, since time.Time, limit int) ([]SearchQueryStat, error) {
+	return r.listStats(ctx, `
+		SELECT h.normalized_query, COUNT(*), COUNT(DISTINCT h.user_id), COUNT(h.clicked_item_id),
+		       0, MAX(h.created_at)
+		FROM search_history h
+		WHERE h.scope = $1 AND h.created_at >= $2 AND h.result_count = 0
+		  AND NOT EXISTS (
+		      SELECT 1 FROM search_history f
+		      WHERE f.scope = h.scope AND f.normalized_query = h.normalized_query
+		        AND f.created_at >= $2 AND f.result_count > 0
+		  )
+		GROUP BY h.normalized_query
+		ORDER BY COUNT(*) DESC, COUNT(DISTINCT h.user_id) DESC, h.normalized_query
+		LIMIT $3`,
+		scope, since, limit,
+	)
+}
+
+func (r *SearchHistoryRepository) listStats(ctx context.Context, query string, args ...interface{}) ([]SearchQueryStat, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list search stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []SearchQueryStat
+	for rows.Next() {
+		var s SearchQueryStat
+		if err := rows.Scan(&s.Query, &s.Searches, &s.Users, &s.Clicks, &s.AverageResults, &s.LastSearchedAt); err != nil {
+			return nil, fmt.Errorf("scan search stat: %w", err)
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+// DeleteUserHistory removes every search of a user
+func (r *SearchHistoryRepository) DeleteUserHistory(ctx context.Context, userID string) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM search_history WHERE user_id = $1`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("delete search history: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// DeleteBefore removes up to limit searches older than cutoff
+func (r *SearchHistoryRepository) DeleteBefore(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM search_history
+		WHERE id IN (SELECT id FROM search_history WHERE created_at < $1 LIMIT $2)`,
+		cutoff, limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete old search history: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// likePrefix turns a prefix into a LIKE pattern, escaping wildcards
+func likePrefix(prefix string) string {
+	escaped := make([]rune, 0, len(prefix)+1)
+	for _, r := range prefix {
+		if r == '%' || r == '_' || r == '\\' {
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, r)
+	}
+	return string(escaped) + "%"
+}
@@ -173,6 +173,10 @@ func (r *SecurityEventRepository) FindWithFilters(ctx context.Context, filters S
 		argIndex++
 	}
 
+	if filters.UnresolvedOnly {
+		whereClauses = append(whereClauses, "status <> 'RESOLVED'")
+	}
+
 	if filters.FromTimestamp > 0 {
 		whereClauses = append(whereClauses, fmt.Sprintf("created_at >= $%d", argIndex))
 		args = append(args, time.Unix(filters.FromTimestamp, 0))
@@ -268,13 +272,14 @@ func (r *SecurityEventRepository) UpdateStatus(ctx context.Context, id, status s
 
 // SecurityEventFilters represents filters for security event queries
 type SecurityEventFilters struct {
-	UserID        string
-	ThreatType    string
-	Status        string
-	FromTimestamp int64
-	ToTimestamp   int64
-	Limit         int
-	Offset        int
+	UserID         string
+	ThreatType     string
+	Status         string
+	UnresolvedOnly bool // Excludes RESOLVED events
+	FromTimestamp  int64
+	ToTimestamp    int64
+	Limit          int
+	Offset         int
 }
 
 // CreateSecurityResponse creates a new security response
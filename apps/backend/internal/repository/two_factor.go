@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Two-factor challenge purposes
+const (
+	TwoFactorChallengeLogin  = "LOGIN"
+	TwoFactorChallengeEnroll = "ENROLL"
+	TwoFactorChallengeEmail  = "EMAIL" // Risk step-up with a code sent by email
+)
+
+// UserTwoFactor is a user's TOTP factor. Enabled is false while enrollment is pending.
+type UserTwoFactor struct {
+	UserID          string
+	SecretEncrypted string
+	Enabled         bool
+	EnabledAt       *time.Time
+	LastUsedStep    int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// TwoFactorChallenge is a pending second login step
+type TwoFactorChallenge struct {
+	ID         string
+	UserID     string
+	TokenHash  string
+	Purpose    string
+	CodeHash   string // Only set for EMAIL challenges
+	IPAddress  string
+	UserAgent  string
+	Attempts   int
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// TwoFactorRepository handles TOTP factors, recovery codes and login challenges
+type TwoFactorRepository struct {
+	db *sql.DB
+}
+
+// NewTwoFactorRepository creates a new two-factor repository
+func NewTwoFactorRepository(db *sql.DB) *TwoFactorRepository {
+	return &TwoFactorRepository{db: db}
+}
+
+// GetFactor returns the user's factor, or ErrNotFound when none exists
+func (r *TwoFactorRepository) GetFactor(ctx context.Context, userID string) (*UserTwoFactor, error) {
+	query := `
+		SELECT user_id, secret_encrypted, enabled, enabled_at, last_used_step, created_at, updated_at
+		FROM user_two_factor
+		WHERE user_id = $1
+	`
+
+	var factor UserTwoFactor
+	var enabledAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&factor.UserID, &factor.SecretEncrypted, &factor.Enabled, &enabledAt,
+		&factor.LastUsedStep, &factor.CreatedAt, &factor.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+	if enabledAt.Valid {
+		factor.EnabledAt = &enabledAt.Time
+	}
+	return &factor, nil
+}
+
+// SavePendingFactor stores a new secret awaiting confirmation. An enabled factor is
+// never overwritten; it must be disabled first.
+func (r *TwoFactorRepository) SavePendingFactor(ctx context.Context, userID, secretEncrypted string) error {
+	query := `
+		INSERT INTO user_two_factor (user_id, secret_encrypted, enabled, last_used_step, created_at, updated_at)
+		VALUES ($1, $2, FALSE, 0, NOW(), NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret_encrypted = EXCLUDED.secret_encrypted, last_used_step = 0, updated_at = NOW()
+		WHERE user_two_factor.enabled = FALSE
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, secretEncrypted)
+	if err != nil {
+		return fmt.Errorf("failed to save two-factor secret: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrDuplicateKey
+	}
+	return nil
+}
+
+// EnableFactor enables a pending factor and replaces the user's recovery codes in one transaction
+func (r *TwoFactorRepository) EnableFactor(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE user_two_factor
+		SET enabled = TRUE, enabled_at = NOW(), last_used_step = $2, updated_at = NOW()
+		WHERE user_id = $1 AND enabled = FALSE
+	`, userID, step)
+	if err != nil {
+		return fmt.Errorf("failed to enable two-factor: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrNotFound
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// DeleteFactor removes the user's factor, recovery codes and open challenges
+func (r *TwoFactorRepository) DeleteFactor(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{
+		`DELETE FROM user_two_factor WHERE user_id = $1`,
+		`DELETE FROM user_recovery_codes WHERE user_id = $1`,
+		`DELETE FROM two_factor_challenges WHERE user_id = $1 AND consumed_at IS NULL`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("failed to delete two-factor data: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// AdvanceStep records the time step of an accepted code. It returns false when the
+// step is not newer than the last accepted one, i.e. the code was already used.
+func (r *TwoFactorRepository) AdvanceStep(ctx context.Context, userID string, step int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE user_two_factor
+		SET last_used_step = $2, updated_at = NOW()
+		WHERE user_id = $1 AND last_used_step < $2
+	`, userID, step)
+	if err != nil {
+		return false, fmt.Errorf("failed to record two-factor step: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+// ReplaceRecoveryCodes invalidates all previous recovery codes and stores new ones
+func (r *TwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID string, codeHashes []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO user_recovery_codes (user_id, code_hash, created_at)
+			VALUES ($1, $2, NOW())
+		`, userID, hash); err != nil {
+			return fmt.Errorf("failed to insert recovery code: %w", err)
+		}
+	}
+	return nil
+}
+
+// UseRecoveryCode marks an unused recovery code as used. It returns false when no
+// unused code matches.
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE user_recovery_codes
+		SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`, userID, codeHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+// CountUnusedRecoveryCodes returns how many recovery codes the user has left
+func (r *TwoFactorRepository) CountUnusedRecoveryCodes(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return count, nil
+}
+
+// CreateChallenge stores a new login challenge
+func (r *TwoFactorRepository) CreateChallenge(ctx context.Context, challenge *TwoFactorChallenge) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO two_factor_challenges (user_id, token_hash, purpose, code_hash, ip_address, user_agent, expires_at, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, $7, NOW())
+		RETURNING id, created_at
+	`, challenge.UserID, challenge.TokenHash, challenge.Purpose, challenge.CodeHash, challenge.IPAddress, challenge.UserAgent,
+		challenge.ExpiresAt).Scan(&challenge.ID, &challenge.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create two-factor challenge: %w", err)
+	}
+	return nil
+}
+
+// GetChallengeByTokenHash returns a challenge, or ErrNotFound
+func (r *TwoFactorRepository) GetChallengeByTokenHash(ctx context.Context, tokenHash string) (*TwoFactorChallenge, error) {
+	var challenge TwoFactorChallenge
+	var ipAddress, userAgent sql.NullString
+	var consumedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, user_id, token_hash, purpose, COALESCE(code_hash, ''), ip_address, user_agent, attempts, expires_at, consumed_at, created_at
+		FROM two_factor_challenges
+		WHERE token_hash = $1
+	`, tokenHash).Scan(
+		&challenge.ID, &challenge.UserID, &challenge.TokenHash, &challenge.Purpose, &challenge.CodeHash, &ipAddress, &userAgent,
+		&challenge.Attempts, &challenge.ExpiresAt, &consumedAt, &challenge.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor challenge: %w", err)
+	}
+	challenge.IPAddress = ipAddress.String
+	challenge.UserAgent = userAgent.String
+	if consumedAt.Valid {
+		challenge.ConsumedAt = &consumedAt.Time
+	}
+	return &challenge, nil
+}
+
+// IncrementChallengeAttempts records a failed verification and returns the new attempt count
+func (r *TwoFactorRepository) IncrementChallengeAttempts(ctx context.Context, id string) (int, error) {
+	var attempts int
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE two_factor_challenges SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts
+	`, id).Scan(&attempts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update two-factor challenge: %w", err)
+	}
+	return attempts, nil
+}
+
+// ConsumeChallenge marks a challenge as used. It returns false when it was already consumed.
+func (r *TwoFactorRepository) ConsumeChallenge(ctx context.Context, id string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE two_factor_challenges SET consumed_at = NOW() WHERE id = $1 AND consumed_at IS NULL
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume two-factor challenge: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
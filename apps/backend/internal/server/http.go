@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -14,15 +15,31 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"exam-bank-system/apps/backend/internal/service/auth"
+	"exam-bank-system/apps/backend/internal/service/library/upload"
 	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
 )
 
+// jwksPath is where token verifiers fetch the public signing keys
+const jwksPath = "/.well-known/jwks.json"
+
 // HTTPServer wraps the gRPC-Gateway server
 type HTTPServer struct {
 	httpPort   string
 	grpcPort   string
 	mux        *runtime.ServeMux
 	grpcServer *grpc.Server
+	keyRing    *auth.KeyRing
+
+	// Optional handler serving local blob storage links
+	blobMountPath string
+	blobHandler   http.Handler
+
+	downloadMountPath string
+	downloadHandler   http.Handler
+
+	uploadMountPath string
+	uploadHandler   http.Handler
 }
 
 // NewHTTPServer creates a new HTTP server with gRPC-Gateway
@@ -32,7 +49,7 @@ func NewHTTPServer(httpPort, grpcPort string, grpcServer *grpc.Server) *HTTPServ
 		// Pass auth header and IP-related headers to gRPC
 		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
 			switch strings.ToLower(key) {
-			case "authorization", "x-forwarded-for", "x-real-ip", "x-client-ip", "user-agent", "cookie":
+			case "authorization", "x-api-key", "x-forwarded-for", "x-real-ip", "x-client-ip", "user-agent", "cookie":
 				return key, true
 			default:
 				return runtime.DefaultHeaderMatcher(key)
@@ -47,6 +64,11 @@ func NewHTTPServer(httpPort, grpcPort string, grpcServer *grpc.Server) *HTTPServ
 				md.Set("authorization", auth)
 			}
 
+			// Service account API key (server-to-server, so not in the CORS allow list)
+			if apiKey := req.Header.Get("X-API-Key"); apiKey != "" {
+				md.Set("x-api-key", apiKey)
+			}
+
 			// IP address headers (for JWT token generation)
 			if xForwardedFor := req.Header.Get("X-Forwarded-For"); xForwardedFor != "" {
 				md.Set("x-forwarded-for", xForwardedFor)
@@ -105,6 +127,53 @@ func NewHTTPServer(httpPort, grpcPort string, grpcServer *grpc.Server) *HTTPServ
 	}
 }
 
+// SetKeyRing publishes the keyring's public keys at /.well-known/jwks.json
+func (s *HTTPServer) SetKeyRing(keyRing *auth.KeyRing) {
+	s.keyRing = keyRing
+}
+
+// SetBlobHandler serves the local blob store's links below mountPath
+func (s *HTTPServer) SetBlobHandler(mountPath string, handler http.Handler) {
+	s.blobMountPath = strings.TrimRight(mountPath, "/")
+	s.blobHandler = handler
+}
+
+// SetDownloadHandler serves signed library download links below mountPath
+func (s *HTTPServer) SetDownloadHandler(mountPath string, handler http.Handler) {
+	s.downloadMountPath = strings.TrimRight(mountPath, "/")
+	s.downloadHandler = handler
+}
+
+// SetUploadHandler serves the resumable (tus) library upload endpoint at mountPath
+func (s *HTTPServer) SetUploadHandler(mountPath string, handler http.Handler) {
+	s.uploadMountPath = strings.TrimRight(mountPath, "/")
+	s.uploadHandler = handler
+}
+
+// jwksHandler serves the JSON Web Key Set. Verifiers cache it briefly and
+// refetch on unknown kid, so rotated keys are picked up within minutes.
+func (s *HTTPServer) jwksHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.keyRing == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := json.Marshal(s.keyRing.JWKS())
+	if err != nil {
+		http.Error(w, "failed to encode key set", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
 // Start starts the HTTP server
 func (s *HTTPServer) Start() error {
 	ctx := context.Background()
@@ -122,7 +191,7 @@ func (s *HTTPServer) Start() error {
 	}
 
 	// Setup CORS
-	corsHandler := cors.New(cors.Options{
+	corsOptions := cors.Options{
 		AllowedOrigins: []string{
 			"http://localhost:3000",
 			"http://localhost:3001",
@@ -149,7 +218,18 @@ func (s *HTTPServer) Start() error {
 		},
 		AllowCredentials: true,
 		MaxAge:           86400, // 24 hours
-	})
+	}
+	corsHandler := cors.New(corsOptions)
+
+	// tus clients send and read their own protocol headers
+	uploadCORS := corsOptions
+	uploadCORS.AllowedMethods = append([]string{"HEAD"}, corsOptions.AllowedMethods...)
+	uploadCORS.AllowedHeaders = append(append([]string{}, corsOptions.AllowedHeaders...), upload.CORSAllowedHeaders...)
+	uploadCORS.ExposedHeaders = append(append([]string{}, corsOptions.ExposedHeaders...), upload.CORSExposedHeaders...)
+	var uploadHandler http.Handler
+	if s.uploadHandler != nil {
+		uploadHandler = cors.New(uploadCORS).Handler(s.uploadHandler)
+	}
 
 	// Create gRPC-Web wrapper for the gRPC server
 	// This allows frontend gRPC-Web clients to communicate with the backend
@@ -185,6 +265,7 @@ func (s *HTTPServer) Start() error {
 
 	// Wrap health handler with CORS
 	healthHandlerWithCORS := corsHandler.Handler(healthHandler)
+	jwksHandlerWithCORS := corsHandler.Handler(http.HandlerFunc(s.jwksHandler))
 
 	// Create a multiplexer that routes requests to either gRPC-Web or gRPC-Gateway
 	// gRPC-Web requests go to grpcWebWrapper
@@ -214,6 +295,30 @@ func (s *HTTPServer) Start() error {
 			return
 		}
 
+		// Public signing keys for token verification
+		if r.URL.Path == jwksPath {
+			jwksHandlerWithCORS.ServeHTTP(w, r)
+			return
+		}
+
+		// Presigned and public blob links of the local storage driver
+		if s.blobHandler != nil && strings.HasPrefix(r.URL.Path, s.blobMountPath+"/") {
+			s.blobHandler.ServeHTTP(w, r)
+			return
+		}
+
+		// Signed, watermarked library downloads
+		if s.downloadHandler != nil && strings.HasPrefix(r.URL.Path, s.downloadMountPath+"/") {
+			s.downloadHandler.ServeHTTP(w, r)
+			return
+		}
+
+		// Resumable library uploads
+		if uploadHandler != nil && (r.URL.Path == s.uploadMountPath || strings.HasPrefix(r.URL.Path, s.uploadMountPath+"/")) {
+			uploadHandler.ServeHTTP(w, r)
+			return
+		}
+
 		// Handle all other requests with combined handler (gRPC-Web + gRPC-Gateway)
 		fmt.Printf("DEBUG: *** FORWARDING TO COMBINED HANDLER *** - URL: %s, Method: %s\n", r.URL.Path, r.Method)
 		combinedHandler.ServeHTTP(w, r)
@@ -272,6 +377,26 @@ func (s *HTTPServer) registerServices(ctx context.Context, endpoint string, opts
 		return fmt.Errorf("failed to register QuestionFilterService: %w", err)
 	}
 
+	// Register QuestionReviewService
+	if err := v1.RegisterQuestionReviewServiceHandlerFromEndpoint(ctx, s.mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register QuestionReviewService: %w", err)
+	}
+
+	// Register QuestionReportService
+	if err := v1.RegisterQuestionReportServiceHandlerFromEndpoint(ctx, s.mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register QuestionReportService: %w", err)
+	}
+
+	// Register OrganisationService
+	if err := v1.RegisterOrganisationServiceHandlerFromEndpoint(ctx, s.mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register OrganisationService: %w", err)
+	}
+
+	// Register GuardianService
+	if err := v1.RegisterGuardianServiceHandlerFromEndpoint(ctx, s.mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register GuardianService: %w", err)
+	}
+
 	// Register ContactService
 	if err := v1.RegisterContactServiceHandlerFromEndpoint(ctx, s.mux, endpoint, opts); err != nil {
 		return fmt.Errorf("failed to register ContactService: %w", err)
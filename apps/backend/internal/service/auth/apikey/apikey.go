@@ -0,0 +1,491 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+var (
+	// ErrInvalidKey is returned for malformed, unknown or mismatching keys
+	ErrInvalidKey = errors.New("invalid API key")
+	// ErrKeyExpired is returned when a key is past its expiry
+	ErrKeyExpired = errors.New("API key has expired")
+	// ErrKeyRevoked is returned when a key or its service account has been revoked or disabled
+	ErrKeyRevoked = errors.New("API key has been revoked")
+	// ErrScopeDenied is returned when a key is not scoped for the called method
+	ErrScopeDenied = errors.New("API key is not allowed to call this method")
+	// ErrInvalidScope is returned for scopes that are not gRPC method patterns
+	ErrInvalidScope = errors.New("invalid API key scope")
+	// ErrInvalidName is returned for invalid service account or key names
+	ErrInvalidName = errors.New("invalid name")
+	// ErrInvalidRole is returned for roles service accounts cannot have
+	ErrInvalidRole = errors.New("invalid service account role")
+	// ErrInvalidTTL is returned when a key lifetime exceeds the maximum
+	ErrInvalidTTL = errors.New("invalid API key lifetime")
+	// ErrInvalidRateLimit is returned for rate limits outside the allowed range
+	ErrInvalidRateLimit = errors.New("invalid API key rate limit")
+	// ErrAccountExists is returned when a service account name is taken
+	ErrAccountExists = errors.New("service account already exists")
+	// ErrAccountNotFound is returned for unknown service accounts
+	ErrAccountNotFound = errors.New("service account not found")
+	// ErrAccountDisabled is returned when creating keys for a disabled account
+	ErrAccountDisabled = errors.New("service account is disabled")
+	// ErrKeyNotFound is returned for unknown or already revoked keys
+	ErrKeyNotFound = errors.New("API key not found")
+)
+
+const (
+	// KeyPrefix starts every API key so leaked keys are easy to recognise in scans
+	KeyPrefix = "nyk_"
+
+	// ServiceAccountEmailDomain is the reserved domain of service account users
+	ServiceAccountEmailDomain = "service-accounts.invalid"
+
+	prefixBytes = 4  // 8 hex characters identifying the key
+	secretBytes = 32 // 256-bit secret
+
+	// touchInterval throttles last-used writes per key
+	touchInterval = time.Minute
+)
+
+// managementMethods can never be called with an API key, whatever its scopes, so a
+// leaked key cannot mint further keys or keep its account alive
+var managementMethods = map[string]bool{
+	"/v1.AdminService/CreateServiceAccount":  true,
+	"/v1.AdminService/ListServiceAccounts":   true,
+	"/v1.AdminService/DisableServiceAccount": true,
+	"/v1.AdminService/CreateApiKey":          true,
+	"/v1.AdminService/ListApiKeys":           true,
+	"/v1.AdminService/RevokeApiKey":          true,
+}
+
+var (
+	// scopePattern matches "/v1.Service/Method" and "/v1.Service/*"
+	scopePattern = regexp.MustCompile(`^/[A-Za-z0-9_.]+/([A-Za-z0-9_]+|\*)$`)
+	namePattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,62}$`)
+)
+
+// Store is the subset of APIKeyRepository the service uses
+type Store interface {
+	CreateServiceAccount(ctx context.Context, account *repository.ServiceAccount) error
+	GetServiceAccount(ctx context.Context, id string) (*repository.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]*repository.ServiceAccount, error)
+	DisableServiceAccount(ctx context.Context, id string) error
+	CreateKey(ctx context.Context, key *repository.APIKey) error
+	ListKeys(ctx context.Context, serviceAccountID string) ([]*repository.APIKey, error)
+	GetKeyByPrefix(ctx context.Context, prefix string) (*repository.APIKeyCredential, error)
+	RevokeKey(ctx context.Context, serviceAccountID, keyID string) (string, error)
+	TouchKey(ctx context.Context, keyID, ipAddress string, at time.Time) error
+}
+
+// Config controls key lifetimes, rate limits and caching
+type Config struct {
+	DefaultTTL       time.Duration // Key lifetime when none is requested; default 90 days
+	MaxTTL           time.Duration // Longest allowed key lifetime; default 365 days
+	DefaultRateLimit int           // Requests per minute when none is requested; default 60
+	MaxRateLimit     int           // Highest allowed requests per minute; default 6000
+	CacheTTL         time.Duration // How long verified keys are cached; default 30 seconds
+}
+
+// Principal is the caller authenticated by an API key
+type Principal struct {
+	KeyID              string
+	KeyName            string
+	Prefix             string
+	ServiceAccountID   string
+	AccountName        string
+	Email              string
+	Role               string
+	Level              int
+	RateLimitPerMinute int
+}
+
+type cacheEntry struct {
+	cred      *repository.APIKeyCredential
+	fetchedAt time.Time
+}
+
+// Service manages service accounts and authenticates API keys
+//
+// Business Logic:
+//   - Keys look like nyk_<prefix>_<secret>; only the SHA-256 of the whole key is stored
+//   - A key works until it expires or is revoked, and only while its account is enabled
+//   - Scopes are exact gRPC methods or whole services; key management is never allowed
+//   - Verified keys are cached briefly; revocations on this instance take effect at once,
+//     other instances catch up within CacheTTL
+type Service struct {
+	store  Store
+	config Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	cache   map[string]cacheEntry
+	touched map[string]time.Time
+}
+
+// NewService creates an API key service
+func NewService(store Store, config Config) *Service {
+	if config.DefaultTTL <= 0 {
+		config.DefaultTTL = 90 * 24 * time.Hour
+	}
+	if config.MaxTTL <= 0 {
+		config.MaxTTL = 365 * 24 * time.Hour
+	}
+	if config.DefaultRateLimit <= 0 {
+		config.DefaultRateLimit = 60
+	}
+	if config.MaxRateLimit <= 0 {
+		config.MaxRateLimit = 6000
+	}
+	if config.CacheTTL <= 0 {
+		config.CacheTTL = 30 * time.Second
+	}
+	return &Service{
+		store:   store,
+		config:  config,
+		now:     time.Now,
+		cache:   make(map[string]cacheEntry),
+		touched: make(map[string]time.Time),
+	}
+}
+
+// CreateServiceAccount creates a service account acting with the given role and level
+func (s *Service) CreateServiceAccount(ctx context.Context, name, description, role string, level int, createdBy string) (*repository.ServiceAccount, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !namePattern.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if role == "" || role == "GUEST" {
+		return nil, ErrInvalidRole
+	}
+
+	account := &repository.ServiceAccount{
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		Email:       name + "@" + ServiceAccountEmailDomain,
+		Role:        role,
+		Level:       level,
+		CreatedBy:   createdBy,
+	}
+	if err := s.store.CreateServiceAccount(ctx, account); err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, ErrAccountExists
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+// ListServiceAccounts returns all service accounts
+func (s *Service) ListServiceAccounts(ctx context.Context) ([]*repository.ServiceAccount, error) {
+	return s.store.ListServiceAccounts(ctx)
+}
+
+// GetServiceAccount returns a service account by ID
+func (s *Service) GetServiceAccount(ctx context.Context, id string) (*repository.ServiceAccount, error) {
+	account, err := s.store.GetServiceAccount(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrAccountNotFound
+	}
+	return account, err
+}
+
+// DisableServiceAccount disables an account and revokes all of its keys
+func (s *Service) DisableServiceAccount(ctx context.Context, id string) error {
+	if err := s.store.DisableServiceAccount(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrAccountNotFound
+		}
+		return err
+	}
+
+	s.mu.Lock()
+	for prefix, entry := range s.cache {
+		if entry.cred.Key.ServiceAccountID == id {
+			delete(s.cache, prefix)
+		}
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// CreateKey issues a key for an account. The plaintext key is returned only here.
+// A zero ttl or rate limit selects the configured default.
+func (s *Service) CreateKey(ctx context.Context, accountID, name string, scopes []string, ttl time.Duration, ratePerMinute int, createdBy string) (*repository.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, "", ErrInvalidName
+	}
+	scopes, err := NormalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if ttl == 0 {
+		ttl = s.config.DefaultTTL
+	}
+	if ttl < 0 || ttl > s.config.MaxTTL {
+		return nil, "", ErrInvalidTTL
+	}
+	if ratePerMinute == 0 {
+		ratePerMinute = s.config.DefaultRateLimit
+	}
+	if ratePerMinute < 0 || ratePerMinute > s.config.MaxRateLimit {
+		return nil, "", ErrInvalidRateLimit
+	}
+
+	account, err := s.GetServiceAccount(ctx, accountID)
+	if err != nil {
+		return nil, "", err
+	}
+	if account.DisabledAt != nil {
+		return nil, "", ErrAccountDisabled
+	}
+
+	key := &repository.APIKey{
+		ServiceAccountID:   account.ID,
+		Name:               name,
+		Scopes:             scopes,
+		RateLimitPerMinute: ratePerMinute,
+		ExpiresAt:          s.now().Add(ttl),
+		CreatedBy:          createdBy,
+	}
+
+	// Prefixes are random; retry the rare collision with an existing key
+	for attempt := 0; ; attempt++ {
+		prefix, raw, err := generateKey()
+		if err != nil {
+			return nil, "", err
+		}
+		key.Prefix = prefix
+		key.KeyHash = hashKey(raw)
+
+		err = s.store.CreateKey(ctx, key)
+		if err == nil {
+			return key, raw, nil
+		}
+		if !errors.Is(err, repository.ErrDuplicateKey) || attempt == 2 {
+			return nil, "", err
+		}
+	}
+}
+
+// ListKeys returns the keys of an account without their secrets
+func (s *Service) ListKeys(ctx context.Context, accountID string) ([]*repository.APIKey, error) {
+	if _, err := s.GetServiceAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+	return s.store.ListKeys(ctx, accountID)
+}
+
+// RevokeKey revokes a key of an account
+func (s *Service) RevokeKey(ctx context.Context, accountID, keyID string) error {
+	prefix, err := s.store.RevokeKey(ctx, accountID, keyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrKeyNotFound
+		}
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.cache, prefix)
+	s.mu.Unlock()
+	return nil
+}
+
+// Authenticate verifies a key for a call to method from ipAddress
+func (s *Service) Authenticate(ctx context.Context, rawKey, method, ipAddress string) (*Principal, error) {
+	cred, err := s.verify(ctx, rawKey)
+	if err != nil {
+		return nil, err
+	}
+	key := cred.Key
+
+	if key.RevokedAt != nil || cred.AccountDisabledAt != nil || cred.AccountStatus != "ACTIVE" {
+		return nil, ErrKeyRevoked
+	}
+	if !s.now().Before(key.ExpiresAt) {
+		return nil, ErrKeyExpired
+	}
+	if !AllowsMethod(key.Scopes, method) {
+		return nil, ErrScopeDenied
+	}
+
+	s.touch(key.ID, ipAddress)
+
+	return &Principal{
+		KeyID:              key.ID,
+		KeyName:            key.Name,
+		Prefix:             key.Prefix,
+		ServiceAccountID:   key.ServiceAccountID,
+		AccountName:        cred.AccountName,
+		Email:              cred.Email,
+		Role:               cred.Role,
+		Level:              cred.Level,
+		RateLimitPerMinute: key.RateLimitPerMinute,
+	}, nil
+}
+
+// RateLimitFor returns the prefix and requests-per-minute limit of a valid key. It runs
+// before authentication, so it checks the secret but not expiry or scopes; callers
+// fall back to their usual limits when ok is false.
+func (s *Service) RateLimitFor(ctx context.Context, rawKey string) (prefix string, perMinute int, ok bool) {
+	cred, err := s.verify(ctx, rawKey)
+	if err != nil {
+		return "", 0, false
+	}
+	return cred.Key.Prefix, cred.Key.RateLimitPerMinute, true
+}
+
+// verify looks up a key by its prefix and checks the secret
+func (s *Service) verify(ctx context.Context, rawKey string) (*repository.APIKeyCredential, error) {
+	prefix, ok := parsePrefix(rawKey)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+
+	cred, err := s.lookup(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	expected := hashKey(rawKey)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(cred.Key.KeyHash)) != 1 {
+		return nil, ErrInvalidKey
+	}
+	return cred, nil
+}
+
+// lookup returns a key from the cache or the store. Unknown prefixes are not cached.
+func (s *Service) lookup(ctx context.Context, prefix string) (*repository.APIKeyCredential, error) {
+	now := s.now()
+	s.mu.Lock()
+	entry, ok := s.cache[prefix]
+	s.mu.Unlock()
+	if ok && now.Sub(entry.fetchedAt) < s.config.CacheTTL {
+		return entry.cred, nil
+	}
+
+	cred, err := s.store.GetKeyByPrefix(ctx, prefix)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cache[prefix] = cacheEntry{cred: cred, fetchedAt: now}
+	s.mu.Unlock()
+	return cred, nil
+}
+
+// touch records key use at most once per touchInterval, off the request path
+func (s *Service) touch(keyID, ipAddress string) {
+	now := s.now()
+	s.mu.Lock()
+	if last, ok := s.touched[keyID]; ok && now.Sub(last) < touchInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.touched[keyID] = now
+	s.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.store.TouchKey(ctx, keyID, ipAddress, now); err != nil {
+			log.Printf("[WARN] [APIKey] Failed to record use of key %s: %v", keyID, err)
+		}
+	}()
+}
+
+// NormalizeScopes validates, deduplicates and sorts scopes
+func NormalizeScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !scopePattern.MatchString(scope) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+		if managementMethods[scope] {
+			return nil, fmt.Errorf("%w: %s cannot be called with an API key", ErrInvalidScope, scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidScope)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// AllowsMethod reports whether scopes cover a full gRPC method name
+func AllowsMethod(scopes []string, method string) bool {
+	if managementMethods[method] {
+		return false
+	}
+	service := method
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		service = method[:i]
+	}
+	for _, scope := range scopes {
+		if scope == method || scope == service+"/*" {
+			return true
+		}
+	}
+	return false
+}
+
+// generateKey returns a new key and its prefix
+func generateKey() (prefix, raw string, err error) {
+	p := make([]byte, prefixBytes)
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(p); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	prefix = hex.EncodeToString(p)
+	return prefix, KeyPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// parsePrefix extracts the prefix of a well-formed key
+func parsePrefix(rawKey string) (string, bool) {
+	rest, ok := strings.CutPrefix(rawKey, KeyPrefix)
+	if !ok {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != 2*prefixBytes || secret == "" {
+		return "", false
+	}
+	if _, err := hex.DecodeString(prefix); err != nil {
+		return "", false
+	}
+	return prefix, true
+}
+
+func hashKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
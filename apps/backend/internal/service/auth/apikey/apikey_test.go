@@ -0,0 +1,274 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+type fakeStore struct {
+	mu       sync.Mutex
+	accounts map[string]*repository.ServiceAccount
+	keys     map[string]*repository.APIKey // by prefix
+	lookups  int
+	touches  int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{accounts: map[string]*repository.ServiceAccount{}, keys: map[string]*repository.APIKey{}}
+}
+
+func (f *fakeStore) CreateServiceAccount(ctx context.Context, account *repository.ServiceAccount) error {
+	for _, a := range f.accounts {
+		if a.Name == account.Name {
+			return repository.ErrDuplicateKey
+		}
+	}
+	account.ID = "sa_" + account.Name
+	f.accounts[account.ID] = account
+	return nil
+}
+
+func (f *fakeStore) GetServiceAccount(ctx context.Context, id string) (*repository.ServiceAccount, error) {
+	if a, ok := f.accounts[id]; ok {
+		return a, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (f *fakeStore) ListServiceAccounts(ctx context.Context) ([]*repository.ServiceAccount, error) {
+	var result []*repository.ServiceAccount
+	for _, a := range f.accounts {
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+func (f *fakeStore) DisableServiceAccount(ctx context.Context, id string) error {
+	a, ok := f.accounts[id]
+	if !ok || a.DisabledAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	a.DisabledAt = &now
+	return nil
+}
+
+func (f *fakeStore) CreateKey(ctx context.Context, key *repository.APIKey) error {
+	if _, ok := f.keys[key.Prefix]; ok {
+		return repository.ErrDuplicateKey
+	}
+	key.ID = "key_" + key.Prefix
+	f.keys[key.Prefix] = key
+	return nil
+}
+
+func (f *fakeStore) ListKeys(ctx context.Context, serviceAccountID string) ([]*repository.APIKey, error) {
+	var result []*repository.APIKey
+	for _, k := range f.keys {
+		if k.ServiceAccountID == serviceAccountID {
+			result = append(result, k)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeStore) GetKeyByPrefix(ctx context.Context, prefix string) (*repository.APIKeyCredential, error) {
+	f.lookups++
+	k, ok := f.keys[prefix]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	a := f.accounts[k.ServiceAccountID]
+	copied := *k
+	return &repository.APIKeyCredential{
+		Key:               &copied,
+		AccountName:       a.Name,
+		Email:             a.Email,
+		Role:              a.Role,
+		Level:             a.Level,
+		AccountStatus:     "ACTIVE",
+		AccountDisabledAt: a.DisabledAt,
+	}, nil
+}
+
+func (f *fakeStore) RevokeKey(ctx context.Context, serviceAccountID, keyID string) (string, error) {
+	for _, k := range f.keys {
+		if k.ID == keyID && k.ServiceAccountID == serviceAccountID && k.RevokedAt == nil {
+			now := time.Now()
+			k.RevokedAt = &now
+			return k.Prefix, nil
+		}
+	}
+	return "", repository.ErrNotFound
+}
+
+func (f *fakeStore) TouchKey(ctx context.Context, keyID, ipAddress string, at time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.touches++
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *fakeStore, *repository.ServiceAccount) {
+	t.Helper()
+	store := newFakeStore()
+	s := NewService(store, Config{})
+	account, err := s.CreateServiceAccount(context.Background(), "LMS-Sync", "Nightly LMS roster sync", "teacher", 5, "admin")
+	if err != nil {
+		t.Fatalf("CreateServiceAccount: %v", err)
+	}
+	return s, store, account
+}
+
+func TestCreateServiceAccountValidation(t *testing.T) {
+	s, _, account := newTestService(t)
+	if account.Name != "lms-sync" || account.Email != "lms-sync@"+ServiceAccountEmailDomain || account.Role != "TEACHER" {
+		t.Fatalf("unexpected account %+v", account)
+	}
+
+	ctx := context.Background()
+	if _, err := s.CreateServiceAccount(ctx, "lms-sync", "", "TEACHER", 5, "admin"); !errors.Is(err, ErrAccountExists) {
+		t.Fatalf("expected ErrAccountExists, got %v", err)
+	}
+	if _, err := s.CreateServiceAccount(ctx, "bad name!", "", "TEACHER", 5, "admin"); !errors.Is(err, ErrInvalidName) {
+		t.Fatalf("expected ErrInvalidName, got %v", err)
+	}
+	if _, err := s.CreateServiceAccount(ctx, "guest-bot", "", "GUEST", 0, "admin"); !errors.Is(err, ErrInvalidRole) {
+		t.Fatalf("expected ErrInvalidRole, got %v", err)
+	}
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	scopes, err := NormalizeScopes([]string{" /v1.ExamService/ListExams", "/v1.QuestionService/*", "/v1.ExamService/ListExams"})
+	if err != nil {
+		t.Fatalf("NormalizeScopes: %v", err)
+	}
+	if strings.Join(scopes, ",") != "/v1.ExamService/ListExams,/v1.QuestionService/*" {
+		t.Fatalf("unexpected scopes %v", scopes)
+	}
+
+	for _, bad := range [][]string{nil, {"*"}, {"/v1.ExamService"}, {"v1.ExamService/ListExams"}, {"/v1.AdminService/CreateApiKey"}} {
+		if _, err := NormalizeScopes(bad); !errors.Is(err, ErrInvalidScope) {
+			t.Fatalf("expected ErrInvalidScope for %v, got %v", bad, err)
+		}
+	}
+
+	if !AllowsMethod(scopes, "/v1.QuestionService/ImportQuestions") || !AllowsMethod(scopes, "/v1.ExamService/ListExams") {
+		t.Fatal("expected scoped methods to be allowed")
+	}
+	if AllowsMethod(scopes, "/v1.ExamService/DeleteExam") || AllowsMethod([]string{"/v1.AdminService/*"}, "/v1.AdminService/RevokeApiKey") {
+		t.Fatal("expected unscoped and management methods to be denied")
+	}
+}
+
+func TestAuthenticateKey(t *testing.T) {
+	s, store, account := newTestService(t)
+	ctx := context.Background()
+
+	key, raw, err := s.CreateKey(ctx, account.ID, "nightly import", []string{"/v1.QuestionService/*"}, 0, 0, "admin")
+	if err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	if !strings.HasPrefix(raw, KeyPrefix+key.Prefix+"_") || strings.Contains(key.KeyHash, raw) {
+		t.Fatalf("unexpected key %q / %+v", raw, key)
+	}
+	if key.RateLimitPerMinute != 60 || key.ExpiresAt.Sub(time.Now()) < 89*24*time.Hour {
+		t.Fatalf("expected default rate limit and lifetime, got %d %v", key.RateLimitPerMinute, key.ExpiresAt)
+	}
+
+	p, err := s.Authenticate(ctx, raw, "/v1.QuestionService/ImportQuestions", "10.0.0.5")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if p.ServiceAccountID != account.ID || p.Role != "TEACHER" || p.Level != 5 || p.KeyID != key.ID {
+		t.Fatalf("unexpected principal %+v", p)
+	}
+
+	// Cached: a second call does not hit the store
+	if _, err := s.Authenticate(ctx, raw, "/v1.QuestionService/ListQuestions", "10.0.0.5"); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if store.lookups != 1 {
+		t.Fatalf("expected one store lookup, got %d", store.lookups)
+	}
+
+	if _, err := s.Authenticate(ctx, raw, "/v1.ExamService/DeleteExam", ""); !errors.Is(err, ErrScopeDenied) {
+		t.Fatalf("expected ErrScopeDenied, got %v", err)
+	}
+	tampered := raw[:len(raw)-2] + "xx"
+	if _, err := s.Authenticate(ctx, tampered, "/v1.QuestionService/ListQuestions", ""); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey for a wrong secret, got %v", err)
+	}
+	if _, err := s.Authenticate(ctx, "Bearer abc", "/v1.QuestionService/ListQuestions", ""); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey for a malformed key, got %v", err)
+	}
+
+	if prefix, perMinute, ok := s.RateLimitFor(ctx, raw); !ok || prefix != key.Prefix || perMinute != 60 {
+		t.Fatalf("unexpected rate limit %s %d %v", prefix, perMinute, ok)
+	}
+	if _, _, ok := s.RateLimitFor(ctx, tampered); ok {
+		t.Fatal("expected no rate limit for a wrong secret")
+	}
+}
+
+func TestRevokedExpiredAndDisabledKeys(t *testing.T) {
+	s, _, account := newTestService(t)
+	ctx := context.Background()
+	method := "/v1.ExamService/ListExams"
+
+	key, raw, err := s.CreateKey(ctx, account.ID, "export", []string{method}, time.Hour, 10, "admin")
+	if err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	if _, err := s.Authenticate(ctx, raw, method, ""); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+
+	// Revocation drops the cached key immediately
+	if err := s.RevokeKey(ctx, account.ID, key.ID); err != nil {
+		t.Fatalf("RevokeKey: %v", err)
+	}
+	if _, err := s.Authenticate(ctx, raw, method, ""); !errors.Is(err, ErrKeyRevoked) {
+		t.Fatalf("expected ErrKeyRevoked, got %v", err)
+	}
+	if err := s.RevokeKey(ctx, account.ID, key.ID); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+
+	_, raw, err = s.CreateKey(ctx, account.ID, "short lived", []string{method}, time.Hour, 0, "admin")
+	if err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	s.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := s.Authenticate(ctx, raw, method, ""); !errors.Is(err, ErrKeyExpired) {
+		t.Fatalf("expected ErrKeyExpired, got %v", err)
+	}
+	s.now = time.Now
+
+	if _, _, err := s.CreateKey(ctx, account.ID, "too long", []string{method}, 400*24*time.Hour, 0, "admin"); !errors.Is(err, ErrInvalidTTL) {
+		t.Fatalf("expected ErrInvalidTTL, got %v", err)
+	}
+
+	_, raw, err = s.CreateKey(ctx, account.ID, "sync", []string{method}, 0, 0, "admin")
+	if err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	if _, err := s.Authenticate(ctx, raw, method, ""); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if err := s.DisableServiceAccount(ctx, account.ID); err != nil {
+		t.Fatalf("DisableServiceAccount: %v", err)
+	}
+	if _, err := s.Authenticate(ctx, raw, method, ""); !errors.Is(err, ErrKeyRevoked) {
+		t.Fatalf("expected ErrKeyRevoked after disabling the account, got %v", err)
+	}
+	if _, _, err := s.CreateKey(ctx, account.ID, "more", []string{method}, 0, 0, "admin"); !errors.Is(err, ErrAccountDisabled) {
+		t.Fatalf("expected ErrAccountDisabled, got %v", err)
+	}
+}
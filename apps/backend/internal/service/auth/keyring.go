@@ -0,0 +1,523 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// Supported asymmetric signing algorithms
+const (
+	SigningAlgorithmRS256 = "RS256"
+	SigningAlgorithmEdDSA = "EdDSA"
+)
+
+const rsaKeyBits = 2048
+
+// Keyring errors
+var (
+	// ErrUnknownSigningKey is returned when a token's kid is not in the keyring
+	ErrUnknownSigningKey = errors.New("token signed with unknown key")
+
+	// ErrNoSigningKey is returned when the keyring has no activated key to sign with
+	ErrNoSigningKey = errors.New("no active JWT signing key")
+
+	// ErrUnsupportedAlgorithm is returned for algorithms other than RS256 and EdDSA
+	ErrUnsupportedAlgorithm = errors.New("unsupported JWT signing algorithm")
+)
+
+// signingKeyStore persists the keyring, implemented by repository.JWTSigningKeyRepository
+type signingKeyStore interface {
+	ListValidKeys(ctx context.Context, now time.Time) ([]*repository.JWTSigningKey, error)
+	CreateKeyIfNoneSince(ctx context.Context, key *repository.JWTSigningKey, since time.Time) (bool, error)
+	DeleteExpiredKeys(ctx context.Context, before time.Time) (int64, error)
+}
+
+// KeyRingConfig controls key generation and rotation
+type KeyRingConfig struct {
+	Algorithm        string        // RS256 or EdDSA
+	RotationInterval time.Duration // Age at which a new signing key is created
+	VerificationTTL  time.Duration // How long a key keeps verifying after it stops signing
+	ActivationDelay  time.Duration // Time between publishing a key and signing with it
+	RefreshInterval  time.Duration // How often keys are reloaded from the store
+	EncryptionKey    string        // Encrypts private keys at rest
+}
+
+// SigningKey is a loaded key pair
+type SigningKey struct {
+	ID          string
+	Algorithm   string
+	PrivateKey  crypto.Signer
+	PublicKey   crypto.PublicKey
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+	ExpiresAt   time.Time
+}
+
+// KeyRing holds the asymmetric keys used to sign and verify JWTs. The newest
+// activated key signs; every unexpired key verifies, so rotation never
+// invalidates tokens that are still within their lifetime.
+type KeyRing struct {
+	store  signingKeyStore
+	config KeyRingConfig
+	cipher cipher.AEAD
+	logger *logrus.Logger
+	now    func() time.Time
+
+	mu       sync.RWMutex
+	keys     map[string]*SigningKey
+	ordered  []*SigningKey // Newest first
+	loadedAt time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewKeyRing creates a keyring. Call Load before signing.
+func NewKeyRing(store signingKeyStore, config KeyRingConfig, logger *logrus.Logger) (*KeyRing, error) {
+	if config.Algorithm != SigningAlgorithmRS256 && config.Algorithm != SigningAlgorithmEdDSA {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, config.Algorithm)
+	}
+	if config.EncryptionKey == "" {
+		return nil, errors.New("JWT key encryption key cannot be empty")
+	}
+	if config.RotationInterval <= 0 {
+		config.RotationInterval = 30 * 24 * time.Hour
+	}
+	if config.VerificationTTL <= 0 {
+		config.VerificationTTL = RefreshTokenExpiry
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = 5 * time.Minute
+	}
+	if config.ActivationDelay < 0 {
+		config.ActivationDelay = 0
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	key := sha256.Sum256([]byte(config.EncryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key cipher: %w", err)
+	}
+
+	return &KeyRing{
+		store:  store,
+		config: config,
+		cipher: aead,
+		logger: logger,
+		now:    time.Now,
+		keys:   make(map[string]*SigningKey),
+	}, nil
+}
+
+// Algorithm returns the configured signing algorithm
+func (k *KeyRing) Algorithm() string {
+	return k.config.Algorithm
+}
+
+// Load reads all valid keys from the store, creating the first key when none exists
+func (k *KeyRing) Load(ctx context.Context) error {
+	if err := k.reload(ctx); err != nil {
+		return err
+	}
+	if _, err := k.SigningKey(); err == nil {
+		return nil
+	}
+
+	// No usable key yet: the very first key is activated immediately
+	if _, err := k.createKey(ctx, 0); err != nil {
+		return err
+	}
+	if err := k.reload(ctx); err != nil {
+		return err
+	}
+	_, err := k.SigningKey()
+	return err
+}
+
+// Rotate creates a new signing key when the newest one is older than the rotation
+// interval, and removes expired keys. It reports whether a key was created.
+func (k *KeyRing) Rotate(ctx context.Context) (bool, error) {
+	now := k.now()
+
+	var created bool
+	if k.rotationDue(now) {
+		var err error
+		if created, err = k.createKey(ctx, k.config.ActivationDelay); err != nil {
+			return false, err
+		}
+	}
+	if created {
+		k.logger.WithFields(logrus.Fields{
+			"component": "KeyRing",
+			"algorithm": k.config.Algorithm,
+		}).Info("JWT signing key rotated")
+	}
+
+	if _, err := k.store.DeleteExpiredKeys(ctx, now); err != nil {
+		return created, err
+	}
+	return created, k.reload(ctx)
+}
+
+// Start reloads keys and rotates on a schedule until Stop is called
+func (k *KeyRing) Start() {
+	k.mu.Lock()
+	if k.stop != nil {
+		k.mu.Unlock()
+		return
+	}
+	k.stop = make(chan struct{})
+	stop := k.stop
+	k.mu.Unlock()
+
+	k.wg.Add(1)
+	go func() {
+		defer k.wg.Done()
+		ticker := time.NewTicker(k.config.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				if _, err := k.Rotate(ctx); err != nil {
+					k.logger.WithFields(logrus.Fields{
+						"component": "KeyRing",
+						"error":     err.Error(),
+					}).Error("JWT key rotation failed")
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop ends the rotation loop started by Start
+func (k *KeyRing) Stop() {
+	k.mu.Lock()
+	stop := k.stop
+	k.stop = nil
+	k.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		k.wg.Wait()
+	}
+}
+
+// SigningKey returns the newest activated key for the configured algorithm
+func (k *KeyRing) SigningKey() (*SigningKey, error) {
+	now := k.now()
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	for _, key := range k.ordered {
+		if key.Algorithm == k.config.Algorithm && !key.ActivatesAt.After(now) && key.ExpiresAt.After(now) {
+			return key, nil
+		}
+	}
+	return nil, ErrNoSigningKey
+}
+
+// Sign signs the claims with the current key and sets the kid header
+func (k *KeyRing) Sign(claims jwt.Claims) (string, error) {
+	key, err := k.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(signingMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
+}
+
+// Keyfunc resolves the verification key for a token from its kid header. Unknown
+// kids trigger a reload so keys created by another instance are picked up.
+func (k *KeyRing) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, ErrUnknownSigningKey
+	}
+
+	key := k.lookup(kid)
+	if key == nil && k.reloadDue() {
+		if err := k.reload(context.Background()); err != nil {
+			k.logger.WithFields(logrus.Fields{
+				"component": "KeyRing",
+				"error":     err.Error(),
+			}).Error("Failed to reload JWT signing keys")
+		}
+		key = k.lookup(kid)
+	}
+	if key == nil || !key.ExpiresAt.After(k.now()) {
+		return nil, ErrUnknownSigningKey
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrTokenInvalidMethod
+	}
+	return key.PublicKey, nil
+}
+
+// Keys returns the keys currently published for verification, newest first
+func (k *KeyRing) Keys() []*SigningKey {
+	now := k.now()
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	keys := make([]*SigningKey, 0, len(k.ordered))
+	for _, key := range k.ordered {
+		if key.ExpiresAt.After(now) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// rotationDue reports whether the newest key for the configured algorithm is
+// older than the rotation interval
+func (k *KeyRing) rotationDue(now time.Time) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	for _, key := range k.ordered {
+		if key.Algorithm == k.config.Algorithm {
+			return now.Sub(key.CreatedAt) >= k.config.RotationInterval
+		}
+	}
+	return true
+}
+
+func (k *KeyRing) lookup(kid string) *SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.keys[kid]
+}
+
+// reloadDue limits store reloads caused by unknown kids
+func (k *KeyRing) reloadDue() bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.now().Sub(k.loadedAt) >= 30*time.Second
+}
+
+func (k *KeyRing) reload(ctx context.Context) error {
+	records, err := k.store.ListValidKeys(ctx, k.now())
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*SigningKey, len(records))
+	ordered := make([]*SigningKey, 0, len(records))
+	for _, record := range records {
+		key, err := k.decodeKey(record)
+		if err != nil {
+			// Skip keys encrypted with a different key instead of failing every request
+			k.logger.WithFields(logrus.Fields{
+				"component": "KeyRing",
+				"kid":       record.KID,
+				"error":     err.Error(),
+			}).Error("Failed to load JWT signing key")
+			continue
+		}
+		keys[key.ID] = key
+		ordered = append(ordered, key)
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.ordered = ordered
+	k.loadedAt = k.now()
+	k.mu.Unlock()
+	return nil
+}
+
+// createKey generates and stores a key unless a newer one already exists
+func (k *KeyRing) createKey(ctx context.Context, activationDelay time.Duration) (bool, error) {
+	now := k.now()
+
+	private, err := generateSigningKey(k.config.Algorithm)
+	if err != nil {
+		return false, err
+	}
+	record, err := k.encodeKey(private, now, activationDelay)
+	if err != nil {
+		return false, err
+	}
+	return k.store.CreateKeyIfNoneSince(ctx, record, now.Add(-k.config.RotationInterval))
+}
+
+func (k *KeyRing) encodeKey(private crypto.Signer, now time.Time, activationDelay time.Duration) (*repository.JWTSigningKey, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %w", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(private.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	nonce := make([]byte, k.cipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := k.cipher.Seal(nonce, nonce, privateDER, nil)
+
+	kid := make([]byte, 12)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+
+	activatesAt := now.Add(activationDelay)
+	return &repository.JWTSigningKey{
+		KID:                 base64.RawURLEncoding.EncodeToString(kid),
+		Algorithm:           k.config.Algorithm,
+		PrivateKeyEncrypted: base64.StdEncoding.EncodeToString(sealed),
+		PublicKeyPEM:        string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		CreatedAt:           now,
+		ActivatesAt:         activatesAt,
+		// Signs for about one rotation interval (plus up to one refresh tick before
+		// the next key takes over), then keeps verifying for VerificationTTL
+		ExpiresAt: activatesAt.Add(k.config.RotationInterval + k.config.RefreshInterval + k.config.VerificationTTL),
+	}, nil
+}
+
+func (k *KeyRing) decodeKey(record *repository.JWTSigningKey) (*SigningKey, error) {
+	sealed, err := base64.StdEncoding.DecodeString(record.PrivateKeyEncrypted)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key encoding: %w", err)
+	}
+	if len(sealed) < k.cipher.NonceSize() {
+		return nil, errors.New("private key ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:k.cipher.NonceSize()], sealed[k.cipher.NonceSize():]
+	privateDER, err := k.cipher.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(privateDER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	private, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key cannot sign")
+	}
+	switch private.(type) {
+	case *rsa.PrivateKey:
+		if record.Algorithm != SigningAlgorithmRS256 {
+			return nil, fmt.Errorf("%w: RSA key stored as %s", ErrUnsupportedAlgorithm, record.Algorithm)
+		}
+	case ed25519.PrivateKey:
+		if record.Algorithm != SigningAlgorithmEdDSA {
+			return nil, fmt.Errorf("%w: Ed25519 key stored as %s", ErrUnsupportedAlgorithm, record.Algorithm)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedAlgorithm, private)
+	}
+
+	return &SigningKey{
+		ID:          record.KID,
+		Algorithm:   record.Algorithm,
+		PrivateKey:  private,
+		PublicKey:   private.Public(),
+		CreatedAt:   record.CreatedAt,
+		ActivatesAt: record.ActivatesAt,
+		ExpiresAt:   record.ExpiresAt,
+	}, nil
+}
+
+func generateSigningKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case SigningAlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate RSA key: %w", err)
+		}
+		return key, nil
+	case SigningAlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate Ed25519 key: %w", err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+func signingMethod(algorithm string) jwt.SigningMethod {
+	if algorithm == SigningAlgorithmEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+
+	// RSA
+	Modulus  string `json:"n,omitempty"`
+	Exponent string `json:"e,omitempty"`
+
+	// Ed25519 (RFC 8037)
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKSet is the document served at /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public verification keys
+func (k *KeyRing) JWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range k.Keys() {
+		jwk := JWK{KeyID: key.ID, Use: "sig", Algorithm: key.Algorithm}
+		switch public := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.Modulus = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryKeyStore is an in-memory signingKeyStore
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys []*repository.JWTSigningKey
+}
+
+func (m *memoryKeyStore) ListValidKeys(ctx context.Context, now time.Time) ([]*repository.JWTSigningKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []*repository.JWTSigningKey
+	for _, key := range m.keys {
+		if key.ExpiresAt.After(now) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+func (m *memoryKeyStore) CreateKeyIfNoneSince(ctx context.Context, key *repository.JWTSigningKey, since time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.keys {
+		if existing.Algorithm == key.Algorithm && existing.CreatedAt.After(since) {
+			return false, nil
+		}
+	}
+	m.keys = append(m.keys, key)
+	return true, nil
+}
+
+func (m *memoryKeyStore) DeleteExpiredKeys(ctx context.Context, before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var kept []*repository.JWTSigningKey
+	for _, key := range m.keys {
+		if key.ExpiresAt.After(before) {
+			kept = append(kept, key)
+		}
+	}
+	deleted := int64(len(m.keys) - len(kept))
+	m.keys = kept
+	return deleted, nil
+}
+
+func newTestKeyRing(t *testing.T, store *memoryKeyStore, algorithm string, clock *time.Time) *KeyRing {
+	t.Helper()
+	keyRing, err := NewKeyRing(store, KeyRingConfig{
+		Algorithm:        algorithm,
+		RotationInterval: 24 * time.Hour,
+		VerificationTTL:  48 * time.Hour,
+		ActivationDelay:  10 * time.Minute,
+		RefreshInterval:  5 * time.Minute,
+		EncryptionKey:    "test-key-encryption-key",
+	}, createTestLogger())
+	require.NoError(t, err)
+	keyRing.now = func() time.Time { return *clock }
+	require.NoError(t, keyRing.Load(context.Background()))
+	return keyRing
+}
+
+func TestKeyRing_SignAndVerify(t *testing.T) {
+	for _, algorithm := range []string{SigningAlgorithmRS256, SigningAlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			clock := time.Now()
+			keyRing := newTestKeyRing(t, &memoryKeyStore{}, algorithm, &clock)
+
+			tokenString, err := keyRing.Sign(jwt.MapClaims{"user_id": "student-123"})
+			require.NoError(t, err)
+
+			token, err := jwt.Parse(tokenString, keyRing.Keyfunc)
+			require.NoError(t, err)
+			assert.True(t, token.Valid)
+			assert.Equal(t, algorithm, token.Method.Alg())
+
+			signingKey, err := keyRing.SigningKey()
+			require.NoError(t, err)
+			assert.Equal(t, signingKey.ID, token.Header["kid"])
+
+			jwks := keyRing.JWKS()
+			require.Len(t, jwks.Keys, 1)
+			assert.Equal(t, signingKey.ID, jwks.Keys[0].KeyID)
+			assert.Equal(t, algorithm, jwks.Keys[0].Algorithm)
+			if algorithm == SigningAlgorithmRS256 {
+				assert.Equal(t, "RSA", jwks.Keys[0].KeyType)
+				assert.Equal(t, "AQAB", jwks.Keys[0].Exponent)
+			} else {
+				assert.Equal(t, "OKP", jwks.Keys[0].KeyType)
+				assert.Equal(t, "Ed25519", jwks.Keys[0].Curve)
+			}
+		})
+	}
+}
+
+func TestKeyRing_Rotation(t *testing.T) {
+	clock := time.Now()
+	store := &memoryKeyStore{}
+	keyRing := newTestKeyRing(t, store, SigningAlgorithmEdDSA, &clock)
+	ctx := context.Background()
+
+	oldToken, err := keyRing.Sign(jwt.MapClaims{"user_id": "student-123"})
+	require.NoError(t, err)
+	oldKey, err := keyRing.SigningKey()
+	require.NoError(t, err)
+
+	// Not due yet
+	created, err := keyRing.Rotate(ctx)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	// Due: the new key is published but the old key keeps signing until activation
+	clock = clock.Add(25 * time.Hour)
+	created, err = keyRing.Rotate(ctx)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Len(t, keyRing.JWKS().Keys, 2)
+
+	current, err := keyRing.SigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, oldKey.ID, current.ID)
+
+	// Another instance rotating at the same time must not add a third key
+	other := newTestKeyRing(t, store, SigningAlgorithmEdDSA, &clock)
+	created, err = other.Rotate(ctx)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Len(t, store.keys, 2)
+
+	clock = clock.Add(11 * time.Minute)
+	current, err = keyRing.SigningKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, oldKey.ID, current.ID)
+
+	// Tokens signed with the previous key still verify
+	_, err = jwt.Parse(oldToken, keyRing.Keyfunc)
+	assert.NoError(t, err)
+
+	// Once expired, the old key is removed and its tokens are rejected
+	clock = clock.Add(80 * time.Hour)
+	_, err = keyRing.Rotate(ctx)
+	require.NoError(t, err)
+	_, err = jwt.Parse(oldToken, keyRing.Keyfunc, jwt.WithoutClaimsValidation())
+	assert.ErrorIs(t, err, ErrUnknownSigningKey)
+}
+
+func TestKeyRing_PicksUpKeysFromOtherInstances(t *testing.T) {
+	clock := time.Now()
+	store := &memoryKeyStore{}
+	first := newTestKeyRing(t, store, SigningAlgorithmRS256, &clock)
+	second := newTestKeyRing(t, store, SigningAlgorithmRS256, &clock)
+
+	clock = clock.Add(25 * time.Hour)
+	_, err := first.Rotate(context.Background())
+	require.NoError(t, err)
+	clock = clock.Add(11 * time.Minute)
+
+	tokenString, err := first.Sign(jwt.MapClaims{"user_id": "student-123"})
+	require.NoError(t, err)
+
+	// second has not reloaded yet; the unknown kid triggers a reload
+	_, err = jwt.Parse(tokenString, second.Keyfunc)
+	assert.NoError(t, err)
+}
+
+func TestKeyRing_RejectsMismatchedAlgorithm(t *testing.T) {
+	clock := time.Now()
+	keyRing := newTestKeyRing(t, &memoryKeyStore{}, SigningAlgorithmRS256, &clock)
+	signingKey, err := keyRing.SigningKey()
+	require.NoError(t, err)
+
+	// A token claiming HS256 with the RSA key's kid must not be verified with that key
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"user_id": "student-123"})
+	token.Header["kid"] = signingKey.ID
+	tokenString, err := token.SignedString([]byte("attacker"))
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(tokenString, keyRing.Keyfunc)
+	assert.ErrorIs(t, err, ErrTokenInvalidMethod)
+}
+
+func TestNewKeyRing_Validation(t *testing.T) {
+	_, err := NewKeyRing(&memoryKeyStore{}, KeyRingConfig{Algorithm: "HS256", EncryptionKey: "k"}, nil)
+	assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	_, err = NewKeyRing(&memoryKeyStore{}, KeyRingConfig{Algorithm: SigningAlgorithmRS256}, nil)
+	assert.Error(t, err)
+}
+
+func TestUnifiedJWTService_WithKeyRing(t *testing.T) {
+	clock := time.Now()
+	keyRing := newTestKeyRing(t, &memoryKeyStore{}, SigningAlgorithmEdDSA, &clock)
+
+	legacy, err := NewUnifiedJWTService(testSecret, nil, createTestLogger())
+	require.NoError(t, err)
+	legacyToken, err := legacy.GenerateToken(testUser)
+	require.NoError(t, err)
+
+	service, err := NewUnifiedJWTService(testSecret, nil, createTestLogger())
+	require.NoError(t, err)
+	service.SetKeyRing(keyRing, true)
+
+	accessToken, err := service.GenerateToken(testUser)
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(accessToken, &UnifiedClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "EdDSA", parsed.Method.Alg())
+	assert.NotEmpty(t, parsed.Header["kid"])
+
+	claims, err := service.ValidateAccessToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "student-123", claims.UserID)
+
+	refreshToken, err := service.GenerateRefreshToken("student-123")
+	require.NoError(t, err)
+	userID, err := service.ValidateRefreshToken(refreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, "student-123", userID)
+
+	// Legacy HS256 tokens are accepted only while the transition flag is on
+	_, err = service.ValidateAccessToken(legacyToken)
+	assert.NoError(t, err)
+
+	service.SetKeyRing(keyRing, false)
+	_, err = service.ValidateAccessToken(legacyToken)
+	assert.Error(t, err)
+
+	// Without a keyring, asymmetric tokens are rejected
+	_, err = legacy.ValidateAccessToken(accessToken)
+	assert.Error(t, err)
+}
@@ -0,0 +1,285 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// ErrPermissionDenied is returned when neither the role nor a user grant allows the call
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrPolicyUnavailable is returned when the policy cannot be loaded; callers fail closed
+	ErrPolicyUnavailable = errors.New("permission policy unavailable")
+)
+
+// Roles without levels; level bounds never apply to them
+const (
+	roleAdmin = "ADMIN"
+	roleGuest = "GUEST"
+)
+
+// maxCachedUsers bounds the per-user grant cache
+const maxCachedUsers = 10000
+
+// Store is the subset of PermissionRepository the evaluator reads
+type Store interface {
+	ListRolePermissions(ctx context.Context) ([]repository.RolePermission, error)
+	ListMethodPermissions(ctx context.Context) ([]*repository.MethodPermission, error)
+	ListActiveGrants(ctx context.Context, userID string, now time.Time) ([]*repository.PermissionGrant, error)
+}
+
+// Config controls how long policy and grants are cached
+type Config struct {
+	PolicyTTL time.Duration // Role and method policy; default 1 minute
+	GrantTTL  time.Duration // Per-user grants; default 30 seconds
+}
+
+// Subject is the caller being authorized
+type Subject struct {
+	UserID string
+	Role   string
+	Level  int
+}
+
+// policy is an immutable snapshot of role permission sets and method rules
+type policy struct {
+	roles    map[string]map[string]bool
+	methods  map[string]*repository.MethodPermission
+	loadedAt time.Time
+}
+
+type grantEntry struct {
+	grants    []*repository.PermissionGrant
+	fetchedAt time.Time
+}
+
+// Evaluator answers permission checks from a cached copy of the RBAC tables
+//
+// Business Logic:
+//   - A method without a rule is open to every authenticated user
+//   - The caller's role must hold the method's permission and satisfy its level bounds
+//   - Otherwise an active user grant for the permission allows the call, ignoring levels;
+//     a resource-scoped grant only matches the resource ID read from the request
+type Evaluator struct {
+	store  Store
+	config Config
+	logger *logrus.Logger
+	now    func() time.Time
+
+	mu     sync.Mutex
+	policy *policy
+	grants map[string]grantEntry
+}
+
+// NewEvaluator creates a new evaluator; the policy is loaded on first use
+func NewEvaluator(store Store, config Config, logger *logrus.Logger) *Evaluator {
+	if config.PolicyTTL <= 0 {
+		config.PolicyTTL = time.Minute
+	}
+	if config.GrantTTL <= 0 {
+		config.GrantTTL = 30 * time.Second
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &Evaluator{
+		store:  store,
+		config: config,
+		logger: logger,
+		now:    time.Now,
+		grants: make(map[string]grantEntry),
+	}
+}
+
+// Authorize checks whether the subject may call method with req
+func (e *Evaluator) Authorize(ctx context.Context, subject Subject, method string, req interface{}) error {
+	p, err := e.loadPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	rule, ok := p.methods[method]
+	if !ok {
+		return nil
+	}
+
+	var denied error
+	if p.roles[subject.Role][rule.Permission] {
+		if denied = checkLevel(rule, subject); denied == nil {
+			return nil
+		}
+	} else {
+		denied = fmt.Errorf("%w: role %s is not allowed to access %s", ErrPermissionDenied, subject.Role, method)
+	}
+
+	// A grant lifts both the role and the level requirement
+	granted, err := e.hasGrant(ctx, subject.UserID, rule.Permission, rule.ResourceType, ResourceIDFromRequest(req, rule.ResourceField))
+	if err != nil {
+		return err
+	}
+	if granted {
+		return nil
+	}
+	return denied
+}
+
+// HasPermission reports whether the subject holds permission through its role or a grant.
+// An empty resourceType matches scoped grants of any type for resourceID.
+func (e *Evaluator) HasPermission(ctx context.Context, subject Subject, permission, resourceType, resourceID string) (bool, error) {
+	p, err := e.loadPolicy(ctx)
+	if err != nil {
+		return false, err
+	}
+	if p.roles[subject.Role][permission] {
+		return true, nil
+	}
+	return e.hasGrant(ctx, subject.UserID, permission, resourceType, resourceID)
+}
+
+// Invalidate drops the cached policy so the next check reloads it
+func (e *Evaluator) Invalidate() {
+	e.mu.Lock()
+	e.policy = nil
+	e.mu.Unlock()
+}
+
+// InvalidateUser drops the cached grants of one user
+func (e *Evaluator) InvalidateUser(userID string) {
+	e.mu.Lock()
+	delete(e.grants, userID)
+	e.mu.Unlock()
+}
+
+// loadPolicy returns the cached policy, reloading it once the TTL has passed.
+// A failed reload keeps serving the previous snapshot until the next TTL.
+func (e *Evaluator) loadPolicy(ctx context.Context) (*policy, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	if e.policy != nil && now.Sub(e.policy.loadedAt) < e.config.PolicyTTL {
+		return e.policy, nil
+	}
+
+	p, err := e.fetchPolicy(ctx)
+	if err != nil {
+		if e.policy == nil {
+			return nil, fmt.Errorf("%w: %v", ErrPolicyUnavailable, err)
+		}
+		e.logger.WithError(err).Warn("Failed to reload permission policy, serving cached copy")
+		e.policy.loadedAt = now
+		return e.policy, nil
+	}
+	p.loadedAt = now
+	e.policy = p
+	return p, nil
+}
+
+func (e *Evaluator) fetchPolicy(ctx context.Context) (*policy, error) {
+	rolePermissions, err := e.store.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := e.store.ListMethodPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &policy{
+		roles:   make(map[string]map[string]bool),
+		methods: make(map[string]*repository.MethodPermission, len(methods)),
+	}
+	for _, rp := range rolePermissions {
+		if p.roles[rp.Role] == nil {
+			p.roles[rp.Role] = make(map[string]bool)
+		}
+		p.roles[rp.Role][rp.Permission] = true
+	}
+	for _, m := range methods {
+		p.methods[m.Method] = m
+	}
+	return p, nil
+}
+
+func (e *Evaluator) hasGrant(ctx context.Context, userID, permission, resourceType, resourceID string) (bool, error) {
+	if userID == "" {
+		return false, nil
+	}
+	grants, err := e.userGrants(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	now := e.now()
+	for _, g := range grants {
+		if g.Permission != permission || (g.ExpiresAt != nil && !g.ExpiresAt.After(now)) {
+			continue
+		}
+		if g.ResourceType == "" {
+			return true, nil
+		}
+		if resourceID != "" && g.ResourceID == resourceID && (resourceType == "" || g.ResourceType == resourceType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (e *Evaluator) userGrants(ctx context.Context, userID string) ([]*repository.PermissionGrant, error) {
+	e.mu.Lock()
+	entry, ok := e.grants[userID]
+	e.mu.Unlock()
+
+	now := e.now()
+	if ok && now.Sub(entry.fetchedAt) < e.config.GrantTTL {
+		return entry.grants, nil
+	}
+
+	grants, err := e.store.ListActiveGrants(ctx, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPolicyUnavailable, err)
+	}
+
+	e.mu.Lock()
+	if len(e.grants) >= maxCachedUsers {
+		e.grants = make(map[string]grantEntry)
+	}
+	e.grants[userID] = grantEntry{grants: grants, fetchedAt: now}
+	e.mu.Unlock()
+	return grants, nil
+}
+
+func checkLevel(rule *repository.MethodPermission, subject Subject) error {
+	if subject.Role == roleAdmin || subject.Role == roleGuest {
+		return nil
+	}
+	if rule.MinLevel > 0 && subject.Level < rule.MinLevel {
+		return fmt.Errorf("%w: insufficient level: required %d, got %d", ErrPermissionDenied, rule.MinLevel, subject.Level)
+	}
+	if rule.MaxLevel > 0 && subject.Level > rule.MaxLevel {
+		return fmt.Errorf("%w: level too high: maximum %d, got %d", ErrPermissionDenied, rule.MaxLevel, subject.Level)
+	}
+	return nil
+}
+
+// ResourceIDFromRequest reads a string field from a protobuf request, or returns ""
+func ResourceIDFromRequest(req interface{}, field string) string {
+	msg, ok := req.(proto.Message)
+	if field == "" || !ok {
+		return ""
+	}
+	reflected := msg.ProtoReflect()
+	fd := reflected.Descriptor().Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return reflected.Get(fd).String()
+}
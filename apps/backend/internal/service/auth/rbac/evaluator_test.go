@@ -0,0 +1,190 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	v1 "exam-bank-system/apps/backend/pkg/proto/v1"
+)
+
+// memoryStore is an in-memory Store that counts reads
+type memoryStore struct {
+	roles       []repository.RolePermission
+	methods     []*repository.MethodPermission
+	grants      []*repository.PermissionGrant
+	fail        bool
+	policyReads int
+	grantReads  int
+}
+
+func (s *memoryStore) ListRolePermissions(ctx context.Context) ([]repository.RolePermission, error) {
+	if s.fail {
+		return nil, errors.New("database unavailable")
+	}
+	s.policyReads++
+	return s.roles, nil
+}
+
+func (s *memoryStore) ListMethodPermissions(ctx context.Context) ([]*repository.MethodPermission, error) {
+	if s.fail {
+		return nil, errors.New("database unavailable")
+	}
+	return s.methods, nil
+}
+
+func (s *memoryStore) ListActiveGrants(ctx context.Context, userID string, now time.Time) ([]*repository.PermissionGrant, error) {
+	if s.fail {
+		return nil, errors.New("database unavailable")
+	}
+	s.grantReads++
+	var result []*repository.PermissionGrant
+	for _, g := range s.grants {
+		if g.UserID == userID && g.RevokedAt == nil && (g.ExpiresAt == nil || g.ExpiresAt.After(now)) {
+			result = append(result, g)
+		}
+	}
+	return result, nil
+}
+
+const (
+	deleteExam = "/v1.ExamService/DeleteExam"
+	scheduling = "/v1.TutoringService/ScheduleTutoring"
+)
+
+func newTestEvaluator() (*Evaluator, *memoryStore, *time.Time) {
+	store := &memoryStore{
+		roles: []repository.RolePermission{
+			{Role: "ADMIN", Permission: "exam.manage"},
+			{Role: "TEACHER", Permission: "exam.manage"},
+			{Role: "TUTOR", Permission: "tutoring.schedule"},
+		},
+		methods: []*repository.MethodPermission{
+			{Method: deleteExam, Permission: "exam.manage", MinLevel: 5, ResourceType: "exam", ResourceField: "id"},
+			{Method: scheduling, Permission: "tutoring.schedule", MinLevel: 2},
+		},
+	}
+	clock := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	evaluator := NewEvaluator(store, Config{PolicyTTL: time.Minute, GrantTTL: 30 * time.Second}, nil)
+	evaluator.now = func() time.Time { return clock }
+	return evaluator, store, &clock
+}
+
+func TestAuthorize_RolesAndLevels(t *testing.T) {
+	evaluator, _, _ := newTestEvaluator()
+	ctx := context.Background()
+	req := &v1.DeleteExamRequest{Id: "exam-1"}
+
+	tests := []struct {
+		name    string
+		subject Subject
+		method  string
+		allowed bool
+	}{
+		{"teacher at required level", Subject{UserID: "t1", Role: "TEACHER", Level: 5}, deleteExam, true},
+		{"teacher below required level", Subject{UserID: "t2", Role: "TEACHER", Level: 4}, deleteExam, false},
+		{"admin skips level", Subject{UserID: "a1", Role: "ADMIN"}, deleteExam, true},
+		{"role without permission", Subject{UserID: "s1", Role: "STUDENT", Level: 9}, deleteExam, false},
+		{"admin without permission", Subject{UserID: "a1", Role: "ADMIN"}, scheduling, false},
+		{"method without rule", Subject{UserID: "s1", Role: "STUDENT", Level: 1}, "/v1.ExamService/ListExams", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := evaluator.Authorize(ctx, tt.subject, tt.method, req)
+			if tt.allowed && err != nil {
+				t.Errorf("expected access, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrPermissionDenied) {
+				t.Errorf("expected ErrPermissionDenied, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorize_Grants(t *testing.T) {
+	evaluator, store, clock := newTestEvaluator()
+	ctx := context.Background()
+	expires := clock.Add(time.Hour)
+	store.grants = []*repository.PermissionGrant{
+		// A tutor temporarily allowed to manage one exam
+		{ID: "g1", UserID: "tutor-1", Permission: "exam.manage", ResourceType: "exam", ResourceID: "exam-1", ExpiresAt: &expires},
+		// A level 1 tutor allowed to schedule regardless of level
+		{ID: "g2", UserID: "tutor-2", Permission: "tutoring.schedule"},
+	}
+	tutor1 := Subject{UserID: "tutor-1", Role: "TUTOR", Level: 3}
+
+	if err := evaluator.Authorize(ctx, tutor1, deleteExam, &v1.DeleteExamRequest{Id: "exam-1"}); err != nil {
+		t.Errorf("expected scoped grant to allow exam-1, got %v", err)
+	}
+	if err := evaluator.Authorize(ctx, tutor1, deleteExam, &v1.DeleteExamRequest{Id: "exam-2"}); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected scoped grant not to match exam-2, got %v", err)
+	}
+	if err := evaluator.Authorize(ctx, Subject{UserID: "tutor-2", Role: "TUTOR", Level: 1}, scheduling, nil); err != nil {
+		t.Errorf("expected grant to lift the level requirement, got %v", err)
+	}
+
+	ok, err := evaluator.HasPermission(ctx, tutor1, "exam.manage", "", "exam-1")
+	if err != nil || !ok {
+		t.Errorf("HasPermission = %v, %v; want true", ok, err)
+	}
+
+	*clock = clock.Add(2 * time.Hour)
+	if err := evaluator.Authorize(ctx, tutor1, deleteExam, &v1.DeleteExamRequest{Id: "exam-1"}); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected expired grant to be ignored, got %v", err)
+	}
+}
+
+func TestAuthorize_Caching(t *testing.T) {
+	evaluator, store, clock := newTestEvaluator()
+	ctx := context.Background()
+	tutor := Subject{UserID: "tutor-1", Role: "TUTOR", Level: 1}
+
+	for i := 0; i < 3; i++ {
+		_ = evaluator.Authorize(ctx, tutor, scheduling, nil)
+	}
+	if store.policyReads != 1 || store.grantReads != 1 {
+		t.Errorf("expected one policy and one grant read, got %d and %d", store.policyReads, store.grantReads)
+	}
+
+	// A new grant is visible once the user's cache entry is invalidated
+	store.grants = append(store.grants, &repository.PermissionGrant{ID: "g1", UserID: "tutor-1", Permission: "tutoring.schedule"})
+	if err := evaluator.Authorize(ctx, tutor, scheduling, nil); err == nil {
+		t.Error("expected cached grants to be served")
+	}
+	evaluator.InvalidateUser("tutor-1")
+	if err := evaluator.Authorize(ctx, tutor, scheduling, nil); err != nil {
+		t.Errorf("expected new grant after invalidation, got %v", err)
+	}
+
+	// A failed reload keeps the previous policy
+	store.fail = true
+	*clock = clock.Add(2 * time.Minute)
+	if err := evaluator.Authorize(ctx, Subject{Role: "TEACHER", Level: 5}, deleteExam, nil); err != nil {
+		t.Errorf("expected stale policy to be served, got %v", err)
+	}
+}
+
+func TestAuthorize_FailsClosed(t *testing.T) {
+	evaluator, store, _ := newTestEvaluator()
+	store.fail = true
+
+	err := evaluator.Authorize(context.Background(), Subject{Role: "ADMIN"}, deleteExam, nil)
+	if !errors.Is(err, ErrPolicyUnavailable) {
+		t.Errorf("expected ErrPolicyUnavailable, got %v", err)
+	}
+}
+
+func TestResourceIDFromRequest(t *testing.T) {
+	req := &v1.StartExamRequest{ExamId: "exam-9"}
+	if id := ResourceIDFromRequest(req, "exam_id"); id != "exam-9" {
+		t.Errorf("got %q, want exam-9", id)
+	}
+	if id := ResourceIDFromRequest(req, "missing"); id != "" {
+		t.Errorf("got %q for unknown field", id)
+	}
+	if id := ResourceIDFromRequest(nil, "exam_id"); id != "" {
+		t.Errorf("got %q for nil request", id)
+	}
+}
@@ -0,0 +1,163 @@
+package risk
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Location is the place an IP range is registered to
+type Location struct {
+	CountryCode string
+	City        string
+	Latitude    float64
+	Longitude   float64
+}
+
+// String returns "City, CC", or just the country code when the city is unknown
+func (l Location) String() string {
+	if l.City == "" {
+		return l.CountryCode
+	}
+	return l.City + ", " + l.CountryCode
+}
+
+type ipRange struct {
+	start, end uint32
+	location   Location
+}
+
+// GeoIPDatabase maps IPv4 ranges to locations. It is loaded once from a local CSV file
+// and never calls out to a lookup service.
+type GeoIPDatabase struct {
+	ranges []ipRange
+}
+
+// LoadGeoIPDatabase reads a GeoIP CSV file, see ParseGeoIPDatabase for the format
+func LoadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseGeoIPDatabase(f)
+}
+
+// ParseGeoIPDatabase reads rows of
+//
+//	start_ip,end_ip,country_code,city,latitude,longitude
+//
+// where the IPs are dotted IPv4 addresses or their integer form. Lines starting with
+// '#' are comments. Overlapping ranges are not supported.
+func ParseGeoIPDatabase(r io.Reader) (*GeoIPDatabase, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 6
+	reader.TrimLeadingSpace = true
+
+	db := &GeoIPDatabase{}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid geoip database: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		start, err := parseIPv4(row[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		end, err := parseIPv4(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if end < start {
+			return nil, fmt.Errorf("line %d: range ends before it starts", line)
+		}
+		lat, err := strconv.ParseFloat(row[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid latitude %q", line, row[4])
+		}
+		lon, err := strconv.ParseFloat(row[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude %q", line, row[5])
+		}
+
+		db.ranges = append(db.ranges, ipRange{
+			start: start,
+			end:   end,
+			location: Location{
+				CountryCode: strings.ToUpper(row[2]),
+				City:        row[3],
+				Latitude:    lat,
+				Longitude:   lon,
+			},
+		})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool { return db.ranges[i].start < db.ranges[j].start })
+	return db, nil
+}
+
+// Len returns the number of ranges in the database
+func (db *GeoIPDatabase) Len() int {
+	return len(db.ranges)
+}
+
+// Lookup returns the location of an IPv4 address. IPv6 and unknown addresses are not found.
+func (db *GeoIPDatabase) Lookup(ip string) (Location, bool) {
+	if db == nil {
+		return Location{}, false
+	}
+	parsed := net.ParseIP(strings.TrimSpace(ip)).To4()
+	if parsed == nil {
+		return Location{}, false
+	}
+	addr := binary.BigEndian.Uint32(parsed)
+
+	// First range starting after addr; the candidate is the one before it
+	i := sort.Search(len(db.ranges), func(i int) bool { return db.ranges[i].start > addr })
+	if i == 0 {
+		return Location{}, false
+	}
+	r := db.ranges[i-1]
+	if addr > r.end {
+		return Location{}, false
+	}
+	return r.location, true
+}
+
+func parseIPv4(value string) (uint32, error) {
+	value = strings.TrimSpace(value)
+	if n, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return uint32(n), nil
+	}
+	parsed := net.ParseIP(value).To4()
+	if parsed == nil {
+		return 0, fmt.Errorf("invalid IPv4 address %q", value)
+	}
+	return binary.BigEndian.Uint32(parsed), nil
+}
+
+// distanceKm is the great-circle distance between two locations
+func distanceKm(a, b Location) float64 {
+	const earthRadiusKm = 6371.0
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(b.Latitude - a.Latitude)
+	dLon := toRad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.Latitude))*math.Cos(toRad(b.Latitude))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
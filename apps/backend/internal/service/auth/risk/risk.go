@@ -0,0 +1,362 @@
+package risk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/util"
+	"github.com/jackc/pgtype"
+)
+
+// Login decisions
+const (
+	DecisionAllow  = "ALLOW"
+	DecisionStepUp = "STEP_UP"
+	DecisionDeny   = "DENY"
+)
+
+// Signals that contribute to the risk score
+const (
+	SignalNewDevice        = "NEW_DEVICE"
+	SignalSubnetChange     = "IP_SUBNET_CHANGE"
+	SignalImpossibleTravel = "IMPOSSIBLE_TRAVEL"
+	SignalUnusualHour      = "UNUSUAL_HOUR"
+	SignalRecentFailures   = "RECENT_FAILURES"
+)
+
+// Second factors that satisfy a step-up
+const (
+	StepUpTOTP      = "TOTP"
+	StepUpEmail     = "EMAIL"
+	StepUpLoginCode = "LOGIN_CODE" // Passwordless logins already proved access to the mailbox
+)
+
+// ThreatType is the security event threat type of recorded login decisions
+const ThreatType = "LOGIN_RISK"
+
+// minTravelDistanceKm ignores moves within the precision of a GeoIP city lookup
+const minTravelDistanceKm = 100
+
+// historyStore reads past sign-ins, implemented by repository.LoginHistoryRepository
+type historyStore interface {
+	RecentLogins(ctx context.Context, userID string, since time.Time, limit int) ([]*repository.LoginRecord, error)
+}
+
+// eventRecorder stores security events, implemented by repository.SecurityEventRepository
+type eventRecorder interface {
+	Create(ctx context.Context, event *entity.SecurityEvent) error
+}
+
+// Weights are the points each signal adds to the 0-100 risk score
+type Weights struct {
+	NewDevice        int // Fingerprint not seen in the history window; default 30
+	SubnetChange     int // Different /24 than the last login; default 15
+	ImpossibleTravel int // Faster than MaxTravelSpeedKmh since the last login; default 60
+	UnusualHour      int // No past login within an hour of this time of day; default 15
+	PerFailure       int // Per failed attempt since the last success; default 10
+	MaxFailures      int // Cap on the failure points; default 30
+}
+
+// Config controls the signal weights and decision thresholds
+type Config struct {
+	Weights           Weights
+	StepUpScore       int            // Scores at or above this require a second factor; default 40
+	DenyScore         int            // Scores at or above this are refused; default 85
+	HistoryWindow     time.Duration  // How far back device and hour history is read; default 90 days
+	HistoryLimit      int            // Max past logins read; default 200
+	MinHourSamples    int            // Past logins needed before time of day is judged; default 10
+	MaxTravelSpeedKmh float64        // Faster travel is impossible; default 900 (airliner)
+	Location          *time.Location // Time zone hours are compared in; default UTC
+}
+
+// LoginContext describes the sign-in being evaluated
+type LoginContext struct {
+	IPAddress         string
+	UserAgent         string
+	DeviceFingerprint string
+}
+
+// Signal is one reason a login scored as risky
+type Signal struct {
+	Name   string `json:"name"`
+	Score  int    `json:"score"`
+	Detail string `json:"detail"`
+}
+
+// Assessment is the outcome of evaluating a login
+type Assessment struct {
+	Decision string
+	Score    int
+	Signals  []Signal
+	Location string // Where the login came from, when the GeoIP database knows
+
+	// StepUpMethod is set by the caller to the second factor that satisfies a STEP_UP
+	// decision before it is recorded
+	StepUpMethod string
+}
+
+// Reasons returns the names of the signals that fired
+func (a *Assessment) Reasons() []string {
+	reasons := make([]string, 0, len(a.Signals))
+	for _, s := range a.Signals {
+		reasons = append(reasons, s.Name)
+	}
+	return reasons
+}
+
+// Evaluator scores logins from the user's sign-in history and decides whether to allow
+// them, challenge them with a second factor or refuse them
+//
+// Business Logic:
+//   - Signals: new device fingerprint, /24 subnet change since the last login,
+//     impossible travel between the GeoIP locations of the last and current IP,
+//     a time of day the user never signs in at, and failed attempts before this one
+//   - Scores are capped at 100; StepUpScore and DenyScore pick the decision
+//   - Missing history or GeoIP data disables the affected signal instead of failing
+type Evaluator struct {
+	history historyStore
+	events  eventRecorder
+	geo     *GeoIPDatabase
+	config  Config
+	now     func() time.Time
+}
+
+// NewEvaluator creates a new login risk evaluator. geo may be nil, which disables the
+// impossible travel signal.
+func NewEvaluator(history historyStore, events eventRecorder, geo *GeoIPDatabase, config Config) *Evaluator {
+	w := &config.Weights
+	if w.NewDevice <= 0 {
+		w.NewDevice = 30
+	}
+	if w.SubnetChange <= 0 {
+		w.SubnetChange = 15
+	}
+	if w.ImpossibleTravel <= 0 {
+		w.ImpossibleTravel = 60
+	}
+	if w.UnusualHour <= 0 {
+		w.UnusualHour = 15
+	}
+	if w.PerFailure <= 0 {
+		w.PerFailure = 10
+	}
+	if w.MaxFailures <= 0 {
+		w.MaxFailures = 30
+	}
+	if config.StepUpScore <= 0 {
+		config.StepUpScore = 40
+	}
+	if config.DenyScore <= 0 {
+		config.DenyScore = 85
+	}
+	if config.HistoryWindow <= 0 {
+		config.HistoryWindow = 90 * 24 * time.Hour
+	}
+	if config.HistoryLimit <= 0 {
+		config.HistoryLimit = 200
+	}
+	if config.MinHourSamples <= 0 {
+		config.MinHourSamples = 10
+	}
+	if config.MaxTravelSpeedKmh <= 0 {
+		config.MaxTravelSpeedKmh = 900
+	}
+	if config.Location == nil {
+		config.Location = time.UTC
+	}
+
+	return &Evaluator{
+		history: history,
+		events:  events,
+		geo:     geo,
+		config:  config,
+		now:     time.Now,
+	}
+}
+
+// Evaluate scores a login by a user whose password (or login code) has been verified.
+// It does not record the decision; call Record once the caller has acted on it.
+func (e *Evaluator) Evaluate(ctx context.Context, user *repository.User, login LoginContext) *Assessment {
+	now := e.now()
+	a := &Assessment{Decision: DecisionAllow}
+
+	history, err := e.history.RecentLogins(ctx, user.ID, now.Add(-e.config.HistoryWindow), e.config.HistoryLimit)
+	if err != nil {
+		log.Printf("[WARN] [LoginRisk] Failed to read login history for %s: %v", user.ID, err)
+		history = nil
+	}
+
+	current, located := e.geo.Lookup(login.IPAddress)
+	if located {
+		a.Location = current.String()
+	}
+
+	e.checkDevice(a, history, login)
+	e.checkSubnet(a, user, login)
+	if located {
+		e.checkTravel(a, user, current, now)
+	}
+	e.checkHour(a, history, now)
+	e.checkFailures(a, user)
+
+	if a.Score > 100 {
+		a.Score = 100
+	}
+	switch {
+	case a.Score >= e.config.DenyScore:
+		a.Decision = DecisionDeny
+	case a.Score >= e.config.StepUpScore:
+		a.Decision = DecisionStepUp
+	}
+	return a
+}
+
+// checkDevice flags a fingerprint that none of the past logins used. A user without
+// history is not flagged: every first login would otherwise be challenged.
+func (e *Evaluator) checkDevice(a *Assessment, history []*repository.LoginRecord, login LoginContext) {
+	if login.DeviceFingerprint == "" || len(history) == 0 {
+		return
+	}
+	for _, rec := range history {
+		if rec.DeviceFingerprint == login.DeviceFingerprint {
+			return
+		}
+	}
+	a.add(SignalNewDevice, e.config.Weights.NewDevice, fmt.Sprintf("device not seen in the last %d logins", len(history)))
+}
+
+// checkSubnet compares network fingerprints of the last and current IP
+func (e *Evaluator) checkSubnet(a *Assessment, user *repository.User, login LoginContext) {
+	if !knownIP(user.LastLoginIP) || !knownIP(login.IPAddress) {
+		return
+	}
+	previous := util.GenerateDeviceFingerprint(login.UserAgent, user.LastLoginIP, "")
+	current := util.GenerateDeviceFingerprint(login.UserAgent, login.IPAddress, "")
+	if util.DetectSuspiciousIPChange(previous, current) {
+		a.add(SignalSubnetChange, e.config.Weights.SubnetChange, fmt.Sprintf("network changed from %s", user.LastLoginIP))
+	}
+}
+
+// checkTravel flags a move between the last and current login location that is faster
+// than a plane could make it
+func (e *Evaluator) checkTravel(a *Assessment, user *repository.User, current Location, now time.Time) {
+	if user.LastLoginAt == nil || !knownIP(user.LastLoginIP) {
+		return
+	}
+	previous, ok := e.geo.Lookup(user.LastLoginIP)
+	if !ok {
+		return
+	}
+	distance := distanceKm(previous, current)
+	if distance < minTravelDistanceKm {
+		return
+	}
+	hours := now.Sub(*user.LastLoginAt).Hours()
+	if hours > 0 && distance/hours <= e.config.MaxTravelSpeedKmh {
+		return
+	}
+	a.add(SignalImpossibleTravel, e.config.Weights.ImpossibleTravel,
+		fmt.Sprintf("%s to %s: %.0f km in %s", previous, current, distance, now.Sub(*user.LastLoginAt).Round(time.Minute)))
+}
+
+// checkHour flags a login at a time of day with no past login within an hour of it
+func (e *Evaluator) checkHour(a *Assessment, history []*repository.LoginRecord, now time.Time) {
+	if len(history) < e.config.MinHourSamples {
+		return
+	}
+	hour := now.In(e.config.Location).Hour()
+	for _, rec := range history {
+		diff := hour - rec.CreatedAt.In(e.config.Location).Hour()
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff <= 1 || diff >= 23 {
+			return
+		}
+	}
+	a.add(SignalUnusualHour, e.config.Weights.UnusualHour, fmt.Sprintf("no previous login around %02d:00", hour))
+}
+
+// checkFailures adds points for failed attempts since the last successful login
+func (e *Evaluator) checkFailures(a *Assessment, user *repository.User) {
+	if user.LoginAttempts <= 0 {
+		return
+	}
+	score := user.LoginAttempts * e.config.Weights.PerFailure
+	if score > e.config.Weights.MaxFailures {
+		score = e.config.Weights.MaxFailures
+	}
+	a.add(SignalRecentFailures, score, fmt.Sprintf("%d failed attempts", user.LoginAttempts))
+}
+
+func (a *Assessment) add(name string, score int, detail string) {
+	a.Score += score
+	a.Signals = append(a.Signals, Signal{Name: name, Score: score, Detail: detail})
+}
+
+// Record stores the decision as a LOGIN_RISK security event. Allowed logins are stored
+// RESOLVED, challenged ones DETECTED and refused ones MITIGATED, so unresolved alerts
+// are the logins that still need a look. Failures are logged, never returned.
+func (e *Evaluator) Record(ctx context.Context, user *repository.User, login LoginContext, a *Assessment) {
+	if e.events == nil {
+		return
+	}
+
+	status := "RESOLVED"
+	description := fmt.Sprintf("Login allowed (risk %d)", a.Score)
+	switch a.Decision {
+	case DecisionStepUp:
+		status = "DETECTED"
+		description = fmt.Sprintf("Login challenged with %s (risk %d): %s", strings.ToLower(a.StepUpMethod), a.Score, strings.Join(a.Reasons(), ", "))
+	case DecisionDeny:
+		status = "MITIGATED"
+		description = fmt.Sprintf("Login denied (risk %d): %s", a.Score, strings.Join(a.Reasons(), ", "))
+	}
+
+	metadata := map[string]interface{}{
+		"decision": a.Decision,
+		"signals":  a.Signals,
+	}
+	if a.StepUpMethod != "" {
+		metadata["step_up_method"] = a.StepUpMethod
+	}
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		log.Printf("[WARN] [LoginRisk] Failed to encode risk metadata: %v", err)
+		return
+	}
+
+	event := &entity.SecurityEvent{
+		UserID:            text(user.ID),
+		ThreatType:        text(ThreatType),
+		RiskScore:         pgtype.Int4{Int: int32(a.Score), Status: pgtype.Present},
+		Status:            text(status),
+		Description:       text(description),
+		Metadata:          pgtype.JSONB{Bytes: raw, Status: pgtype.Present},
+		IPAddress:         text(login.IPAddress),
+		UserAgent:         text(login.UserAgent),
+		DeviceFingerprint: text(login.DeviceFingerprint),
+		Location:          text(a.Location),
+	}
+	if err := e.events.Create(ctx, event); err != nil {
+		log.Printf("[WARN] [LoginRisk] Failed to record login decision for %s: %v", user.ID, err)
+	}
+}
+
+// text converts a string to a nullable text column; empty strings are stored as NULL
+func text(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{Status: pgtype.Null}
+	}
+	return pgtype.Text{String: s, Status: pgtype.Present}
+}
+
+func knownIP(ip string) bool {
+	return ip != "" && ip != "unknown"
+}
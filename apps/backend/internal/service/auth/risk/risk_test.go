@@ -0,0 +1,183 @@
+package risk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+const testGeoIP = `# start,end,country,city,lat,lon
+14.160.0.0,14.191.255.255,VN,Ha Noi,21.0285,105.8542
+113.160.0.0,113.191.255.255,VN,Ho Chi Minh City,10.8231,106.6297
+1572339712,1572405247,US,New York,40.7128,-74.0060
+`
+
+type fakeHistory struct {
+	records []*repository.LoginRecord
+	err     error
+}
+
+func (f *fakeHistory) RecentLogins(ctx context.Context, userID string, since time.Time, limit int) ([]*repository.LoginRecord, error) {
+	return f.records, f.err
+}
+
+type fakeEvents struct{ events []*entity.SecurityEvent }
+
+func (f *fakeEvents) Create(ctx context.Context, event *entity.SecurityEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+// habitualHistory is a user who always signs in from one device in the morning
+func habitualHistory(now time.Time, fingerprint string) []*repository.LoginRecord {
+	var records []*repository.LoginRecord
+	for day := 1; day <= 12; day++ {
+		at := time.Date(now.Year(), now.Month(), now.Day()-day, 8, 30, 0, 0, time.UTC)
+		records = append(records, &repository.LoginRecord{DeviceFingerprint: fingerprint, IPAddress: "113.161.10.4", CreatedAt: at})
+	}
+	return records
+}
+
+func newTestEvaluator(t *testing.T, history *fakeHistory, now time.Time) (*Evaluator, *fakeEvents) {
+	t.Helper()
+	geo, err := ParseGeoIPDatabase(strings.NewReader(testGeoIP))
+	if err != nil {
+		t.Fatalf("ParseGeoIPDatabase: %v", err)
+	}
+	events := &fakeEvents{}
+	e := NewEvaluator(history, events, geo, Config{})
+	e.now = func() time.Time { return now }
+	return e, events
+}
+
+func TestGeoIPLookup(t *testing.T) {
+	geo, err := ParseGeoIPDatabase(strings.NewReader(testGeoIP))
+	if err != nil {
+		t.Fatalf("ParseGeoIPDatabase: %v", err)
+	}
+	if geo.Len() != 3 {
+		t.Fatalf("expected 3 ranges, got %d", geo.Len())
+	}
+
+	cases := map[string]string{
+		"14.160.0.0":      "Ha Noi, VN",
+		"113.191.255.255": "Ho Chi Minh City, VN",
+		"93.184.0.1":      "New York, US",
+	}
+	for ip, want := range cases {
+		loc, ok := geo.Lookup(ip)
+		if !ok || loc.String() != want {
+			t.Fatalf("Lookup(%s) = %v, %v; want %s", ip, loc, ok, want)
+		}
+	}
+	for _, ip := range []string{"10.0.0.1", "14.192.0.0", "::1", "unknown"} {
+		if _, ok := geo.Lookup(ip); ok {
+			t.Fatalf("expected %s not to be found", ip)
+		}
+	}
+
+	if _, err := ParseGeoIPDatabase(strings.NewReader("1.1.1.1,1.1.1.0,VN,,0,0\n")); err == nil {
+		t.Fatal("expected an inverted range to be rejected")
+	}
+}
+
+func TestFamiliarLoginIsAllowed(t *testing.T) {
+	now := time.Date(2026, 3, 20, 9, 0, 0, 0, time.UTC)
+	last := now.Add(-24 * time.Hour)
+	e, events := newTestEvaluator(t, &fakeHistory{records: habitualHistory(now, "fp_home")}, now)
+
+	user := &repository.User{ID: "alice", LastLoginIP: "113.161.10.9", LastLoginAt: &last}
+	login := LoginContext{IPAddress: "113.161.10.4", UserAgent: "Mozilla/5.0", DeviceFingerprint: "fp_home"}
+	a := e.Evaluate(context.Background(), user, login)
+	if a.Decision != DecisionAllow || a.Score != 0 {
+		t.Fatalf("expected a clean ALLOW, got %s %d %v", a.Decision, a.Score, a.Reasons())
+	}
+	if a.Location != "Ho Chi Minh City, VN" {
+		t.Fatalf("unexpected location %q", a.Location)
+	}
+
+	e.Record(context.Background(), user, login, a)
+	if len(events.events) != 1 || events.events[0].Status.String != "RESOLVED" || events.events[0].ThreatType.String != ThreatType {
+		t.Fatalf("expected one RESOLVED LOGIN_RISK event, got %+v", events.events)
+	}
+}
+
+func TestNewDeviceOnNewNetworkSteppedUp(t *testing.T) {
+	now := time.Date(2026, 3, 20, 9, 0, 0, 0, time.UTC)
+	last := now.Add(-24 * time.Hour)
+	e, events := newTestEvaluator(t, &fakeHistory{records: habitualHistory(now, "fp_home")}, now)
+
+	user := &repository.User{ID: "alice", LastLoginIP: "113.161.10.9", LastLoginAt: &last}
+	login := LoginContext{IPAddress: "14.160.2.2", UserAgent: "Mozilla/5.0", DeviceFingerprint: "fp_cafe"}
+	a := e.Evaluate(context.Background(), user, login)
+	if a.Decision != DecisionStepUp || a.Score != 45 {
+		t.Fatalf("expected STEP_UP with 45, got %s %d %v", a.Decision, a.Score, a.Reasons())
+	}
+
+	a.StepUpMethod = "EMAIL"
+	e.Record(context.Background(), user, login, a)
+	event := events.events[0]
+	if event.Status.String != "DETECTED" || event.RiskScore.Int != 45 {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	var meta struct {
+		Decision     string   `json:"decision"`
+		StepUpMethod string   `json:"step_up_method"`
+		Signals      []Signal `json:"signals"`
+	}
+	if err := json.Unmarshal(event.Metadata.Bytes, &meta); err != nil {
+		t.Fatalf("metadata is not JSON: %v", err)
+	}
+	if meta.Decision != DecisionStepUp || meta.StepUpMethod != "EMAIL" || len(meta.Signals) != 2 {
+		t.Fatalf("unexpected metadata %+v", meta)
+	}
+}
+
+func TestImpossibleTravelWithFailuresDenied(t *testing.T) {
+	now := time.Date(2026, 3, 20, 9, 0, 0, 0, time.UTC)
+	last := now.Add(-2 * time.Hour)
+	e, _ := newTestEvaluator(t, &fakeHistory{records: habitualHistory(now, "fp_home")}, now)
+
+	// Ho Chi Minh City to New York in two hours, after two wrong passwords
+	user := &repository.User{ID: "alice", LastLoginIP: "113.161.10.9", LastLoginAt: &last, LoginAttempts: 2}
+	a := e.Evaluate(context.Background(), user, LoginContext{IPAddress: "93.184.0.1", UserAgent: "curl/8.0", DeviceFingerprint: "fp_unknown"})
+	if a.Decision != DecisionDeny || a.Score != 100 {
+		t.Fatalf("expected DENY capped at 100, got %s %d %v", a.Decision, a.Score, a.Reasons())
+	}
+	reasons := strings.Join(a.Reasons(), ",")
+	for _, want := range []string{SignalNewDevice, SignalSubnetChange, SignalImpossibleTravel, SignalRecentFailures} {
+		if !strings.Contains(reasons, want) {
+			t.Fatalf("expected %s in %s", want, reasons)
+		}
+	}
+}
+
+func TestUnusualHourNeedsHistory(t *testing.T) {
+	night := time.Date(2026, 3, 20, 3, 0, 0, 0, time.UTC)
+	user := &repository.User{ID: "alice"}
+	login := LoginContext{IPAddress: "113.161.10.4", DeviceFingerprint: "fp_home"}
+
+	e, _ := newTestEvaluator(t, &fakeHistory{records: habitualHistory(night, "fp_home")}, night)
+	a := e.Evaluate(context.Background(), user, login)
+	if len(a.Signals) != 1 || a.Signals[0].Name != SignalUnusualHour {
+		t.Fatalf("expected only UNUSUAL_HOUR, got %v", a.Reasons())
+	}
+
+	// Too little history to know the user's habits
+	e, _ = newTestEvaluator(t, &fakeHistory{records: habitualHistory(night, "fp_home")[:3]}, night)
+	if a := e.Evaluate(context.Background(), user, login); len(a.Signals) != 0 {
+		t.Fatalf("expected no signals with short history, got %v", a.Reasons())
+	}
+
+	// History errors fail open
+	e, _ = newTestEvaluator(t, &fakeHistory{err: errors.New("db down")}, night)
+	if a := e.Evaluate(context.Background(), user, login); a.Decision != DecisionAllow {
+		t.Fatalf("expected ALLOW when history is unavailable, got %s", a.Decision)
+	}
+}
@@ -29,6 +29,11 @@ type UnifiedJWTService struct {
 	issuer           string
 	refreshTokenRepo *repository.RefreshTokenRepository
 	logger           *logrus.Logger
+
+	// Asymmetric signing (optional). When set, tokens are signed with the keyring and
+	// carry a kid header; HS256 tokens are only accepted while acceptLegacyHS256 is on.
+	keyRing           *KeyRing
+	acceptLegacyHS256 bool
 }
 
 // UnifiedClaims represents unified JWT token claims structure
@@ -126,6 +131,63 @@ func NewUnifiedJWTService(
 	return service, nil
 }
 
+// SetKeyRing switches token signing to the asymmetric keyring
+//
+// Parameters:
+//   - keyRing: Loaded keyring used to sign and verify tokens
+//   - acceptLegacyHS256: Keep accepting HS256 tokens issued before the switch
+//     (disable once they have all expired)
+func (s *UnifiedJWTService) SetKeyRing(keyRing *KeyRing, acceptLegacyHS256 bool) {
+	s.keyRing = keyRing
+	s.acceptLegacyHS256 = acceptLegacyHS256
+
+	s.logger.WithFields(logrus.Fields{
+		"component":           "UnifiedJWTService",
+		"algorithm":           keyRing.Algorithm(),
+		"accept_legacy_hs256": acceptLegacyHS256,
+	}).Info("JWT keyring enabled")
+}
+
+// Keyfunc resolves the verification key for a token. Tokens with a kid header are
+// verified through the keyring; HS256 tokens use the shared secret when allowed.
+// Exposed so other verifiers (e.g. the WebSocket authenticator) share the same keys.
+func (s *UnifiedJWTService) Keyfunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if s.keyRing != nil && !s.acceptLegacyHS256 {
+			return nil, ErrTokenInvalidMethod
+		}
+		return []byte(s.secret), nil
+	}
+	if s.keyRing == nil {
+		return nil, ErrTokenInvalidMethod
+	}
+	return s.keyRing.Keyfunc(token)
+}
+
+// keyfuncFor wraps Keyfunc with logging for the given operation
+func (s *UnifiedJWTService) keyfuncFor(operation string) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		key, err := s.Keyfunc(token)
+		if err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"operation":      operation,
+				"signing_method": token.Header["alg"],
+				"kid":            token.Header["kid"],
+				"error":          err.Error(),
+			}).Error("Invalid signing key")
+		}
+		return key, err
+	}
+}
+
+// signToken signs claims with the keyring when configured, otherwise with HS256
+func (s *UnifiedJWTService) signToken(claims jwt.Claims) (string, error) {
+	if s.keyRing != nil {
+		return s.keyRing.Sign(claims)
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.secret))
+}
+
 // GenerateToken generates a JWT token for a user (legacy AuthService compatibility)
 //
 // Note: Method nÃ y maintain backward compatibility vá»›i AuthService.generateToken()
@@ -151,7 +213,7 @@ func (s *UnifiedJWTService) GenerateToken(user *entity.User) (string, error) {
 // Business Logic:
 // - Validate all input parameters (userID, email, role, level)
 // - Create JWT claims vá»›i user information
-// - Sign token vá»›i HS256 algorithm
+// - Sign token vá»›i keyring (RS256/EdDSA) hoáº·c HS256 algorithm
 // - Token expires after AccessTokenExpiry (15 minutes)
 //
 // Security:
@@ -224,8 +286,7 @@ func (s *UnifiedJWTService) GenerateAccessToken(userID, email, role string, leve
 	}
 
 	// Sign token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.secret))
+	tokenString, err := s.signToken(claims)
 	if err != nil {
 		s.logger.WithFields(logrus.Fields{
 			"operation": "GenerateAccessToken",
@@ -283,8 +344,7 @@ func (s *UnifiedJWTService) GenerateRefreshToken(userID string) (string, error)
 	}
 
 	// Sign token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.secret))
+	tokenString, err := s.signToken(claims)
 	if err != nil {
 		s.logger.WithFields(logrus.Fields{
 			"operation": "GenerateRefreshToken",
@@ -324,7 +384,7 @@ func (s *UnifiedJWTService) ValidateToken(tokenString string) (*UnifiedClaims, e
 // - Parse JWT token vá»›i UnifiedClaims structure
 // - Verify signature vá»›i secret key
 // - Check token expiration
-// - Validate signing method (chá»‰ accept HS256)
+// - Validate signing method (keyring kid hoáº·c legacy HS256)
 //
 // Security:
 // - Constant-time signature comparison
@@ -348,17 +408,7 @@ func (s *UnifiedJWTService) ValidateAccessToken(tokenString string) (*UnifiedCla
 	}
 
 	// Parse token vá»›i claims
-	token, err := jwt.ParseWithClaims(tokenString, &UnifiedClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Validate signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			s.logger.WithFields(logrus.Fields{
-				"operation":      "ValidateAccessToken",
-				"signing_method": token.Header["alg"],
-			}).Error("Invalid signing method")
-			return nil, ErrTokenInvalidMethod
-		}
-		return []byte(s.secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &UnifiedClaims{}, s.keyfuncFor("ValidateAccessToken"))
 
 	if err != nil {
 		// Check if token expired
@@ -424,17 +474,7 @@ func (s *UnifiedJWTService) ValidateRefreshToken(tokenString string) (string, er
 	}
 
 	// Parse token vá»›i claims
-	token, err := jwt.ParseWithClaims(tokenString, &RefreshTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Validate signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			s.logger.WithFields(logrus.Fields{
-				"operation":      "ValidateRefreshToken",
-				"signing_method": token.Header["alg"],
-			}).Error("Invalid signing method")
-			return nil, ErrTokenInvalidMethod
-		}
-		return []byte(s.secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &RefreshTokenClaims{}, s.keyfuncFor("ValidateRefreshToken"))
 
 	if err != nil {
 		// Check if token expired
@@ -0,0 +1,444 @@
+package mapcode_mgmt
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+var (
+	// ErrCoverageUnavailable is returned when no question count source is configured
+	ErrCoverageUnavailable = errors.New("coverage counts are not available")
+	// ErrCoverageNoHierarchy is returned when the MapCode file has no dash-based hierarchy
+	ErrCoverageNoHierarchy = errors.New("mapcode version has no hierarchy to report on")
+)
+
+// coverageCountSource provides question counts per hierarchy leaf
+type coverageCountSource interface {
+	GetCoverageCounts(ctx context.Context) ([]entity.QuestionCoverageCount, error)
+}
+
+// Hierarchy depth names, from the root down
+const (
+	CoverageDepthGrade   = "grade"
+	CoverageDepthSubject = "subject"
+	CoverageDepthChapter = "chapter"
+	CoverageDepthLesson  = "lesson"
+	CoverageDepthForm    = "form"
+)
+
+var coverageDepths = []string{
+	CoverageDepthGrade,
+	CoverageDepthSubject,
+	CoverageDepthChapter,
+	CoverageDepthLesson,
+	CoverageDepthForm,
+}
+
+// CoverageStatus classifies a node against its target
+type CoverageStatus string
+
+const (
+	CoverageStatusOK    CoverageStatus = "OK"
+	CoverageStatusThin  CoverageStatus = "THIN"
+	CoverageStatusEmpty CoverageStatus = "EMPTY"
+)
+
+// CoverageTargets holds the minimum number of questions expected per node.
+// Grades and subjects have no target and are only flagged when empty.
+type CoverageTargets struct {
+	Chapter int64 `json:"chapter"`
+	Lesson  int64 `json:"lesson"`
+	Form    int64 `json:"form"`
+}
+
+// DefaultCoverageTargets are used for every target left at zero
+var DefaultCoverageTargets = CoverageTargets{
+	Chapter: 50,
+	Lesson:  20,
+	Form:    5,
+}
+
+// withDefaults fills unset targets from DefaultCoverageTargets
+func (t CoverageTargets) withDefaults() CoverageTargets {
+	if t.Chapter <= 0 {
+		t.Chapter = DefaultCoverageTargets.Chapter
+	}
+	if t.Lesson <= 0 {
+		t.Lesson = DefaultCoverageTargets.Lesson
+	}
+	if t.Form <= 0 {
+		t.Form = DefaultCoverageTargets.Form
+	}
+	return t
+}
+
+// forDepth returns the target for a hierarchy depth
+func (t CoverageTargets) forDepth(depth string) int64 {
+	switch depth {
+	case CoverageDepthChapter:
+		return t.Chapter
+	case CoverageDepthLesson:
+		return t.Lesson
+	case CoverageDepthForm:
+		return t.Form
+	default:
+		return 0
+	}
+}
+
+// CoverageNode holds question counts for one node of the MapCode hierarchy
+type CoverageNode struct {
+	Depth        string           `json:"depth"`
+	Code         string           `json:"code"` // Code pattern, "*" stands for any level (e.g. "0P1*2-3")
+	Name         string           `json:"name"`
+	Total        int64            `json:"total"`
+	ByType       map[string]int64 `json:"by_type"`
+	ByDifficulty map[string]int64 `json:"by_difficulty"`
+	Target       int64            `json:"target"`
+	Status       CoverageStatus   `json:"status"`
+	Children     []*CoverageNode  `json:"children,omitempty"`
+}
+
+// CoverageReport is the coverage of a MapCode version by existing questions
+type CoverageReport struct {
+	VersionID         string          `json:"version_id"`
+	Version           string          `json:"version"`
+	Targets           CoverageTargets `json:"targets"`
+	Grades            []*CoverageNode `json:"grades"`
+	TotalQuestions    int64           `json:"total_questions"`
+	UnmappedQuestions int64           `json:"unmapped_questions"` // Questions whose code is not in the hierarchy
+	NodeCount         int             `json:"node_count"`
+	EmptyCount        int             `json:"empty_count"`
+	ThinCount         int             `json:"thin_count"`
+	GeneratedAt       time.Time       `json:"generated_at"`
+}
+
+// SetCoverageSource configures where question counts for coverage reports come from
+func (m *MapCodeMgmt) SetCoverageSource(source coverageCountSource) {
+	m.coverageSource = source
+}
+
+// GetCoverageReport walks the MapCode hierarchy of a version (active version when empty)
+// and reports question counts by type and difficulty for every node
+func (m *MapCodeMgmt) GetCoverageReport(ctx context.Context, versionID string, targets CoverageTargets) (*CoverageReport, error) {
+	if m.coverageSource == nil {
+		return nil, ErrCoverageUnavailable
+	}
+
+	version, config, err := m.loadVersionConfig(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Tree) == 0 {
+		return nil, ErrCoverageNoHierarchy
+	}
+
+	counts, err := m.coverageSource.GetCoverageCounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question counts: %w", err)
+	}
+
+	report := buildCoverageReport(config.Tree, counts, targets.withDefaults())
+	report.VersionID = version.ID.String
+	report.Version = version.Version.String
+	return report, nil
+}
+
+// ExportCoverageReport exports the coverage report of a version as Markdown or CSV
+func (m *MapCodeMgmt) ExportCoverageReport(ctx context.Context, versionID string, format string, targets CoverageTargets) (string, string, error) {
+	report, err := m.GetCoverageReport(ctx, versionID, targets)
+	if err != nil {
+		return "", "", err
+	}
+
+	var content string
+	var filename string
+
+	switch strings.ToLower(format) {
+	case "markdown", "md":
+		content = coverageToMarkdown(report)
+		filename = fmt.Sprintf("MapCode-%s-coverage.md", report.Version)
+	case "csv":
+		content, err = coverageToCSV(report)
+		filename = fmt.Sprintf("MapCode-%s-coverage.csv", report.Version)
+	default:
+		return "", "", fmt.Errorf("unsupported format: %s (supported: markdown, csv)", format)
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return content, filename, nil
+}
+
+// coverageTally accumulates counts for one node
+type coverageTally struct {
+	total        int64
+	byType       map[string]int64
+	byDifficulty map[string]int64
+}
+
+func (t *coverageTally) add(typ, difficulty string, count int64) {
+	t.total += count
+	if typ != "" {
+		t.byType[typ] += count
+	}
+	if difficulty != "" {
+		t.byDifficulty[difficulty] += count
+	}
+}
+
+// buildCoverageReport aggregates leaf counts up the hierarchy tree
+func buildCoverageReport(tree []*entity.MapCodeNode, counts []entity.QuestionCoverageCount, targets CoverageTargets) *CoverageReport {
+	// Index counts by path; codes without a form count towards their lesson
+	tallies := make(map[string]*coverageTally)
+	report := &CoverageReport{
+		Targets:     targets,
+		GeneratedAt: time.Now(),
+	}
+	for _, c := range counts {
+		path := []string{c.Grade, c.Subject, c.Chapter, c.Lesson}
+		if c.Form != "" {
+			path = append(path, c.Form)
+		}
+		key := strings.Join(path, "/")
+		tally, ok := tallies[key]
+		if !ok {
+			tally = &coverageTally{byType: make(map[string]int64), byDifficulty: make(map[string]int64)}
+			tallies[key] = tally
+		}
+		tally.add(c.Type, c.Difficulty, c.Count)
+		report.TotalQuestions += c.Count
+	}
+
+	var mapped int64
+	for _, grade := range tree {
+		node := buildCoverageNode(grade, 0, nil, tallies, targets, &mapped)
+		report.Grades = append(report.Grades, node)
+	}
+	report.UnmappedQuestions = report.TotalQuestions - mapped
+
+	walkCoverage(report.Grades, func(node *CoverageNode) {
+		report.NodeCount++
+		switch node.Status {
+		case CoverageStatusEmpty:
+			report.EmptyCount++
+		case CoverageStatusThin:
+			report.ThinCount++
+		}
+	})
+
+	return report
+}
+
+// buildCoverageNode builds a coverage node and its children, summing counts bottom-up
+func buildCoverageNode(src *entity.MapCodeNode, depth int, path []string, tallies map[string]*coverageTally, targets CoverageTargets, mapped *int64) *CoverageNode {
+	path = append(append([]string(nil), path...), src.Key)
+	node := &CoverageNode{
+		Depth:        coverageDepths[depth],
+		Code:         coveragePattern(path),
+		Name:         src.Name,
+		ByType:       make(map[string]int64),
+		ByDifficulty: make(map[string]int64),
+	}
+	node.Target = targets.forDepth(node.Depth)
+
+	// Only lessons and forms match question codes directly
+	if depth >= 3 {
+		if tally, ok := tallies[strings.Join(path, "/")]; ok {
+			node.mergeTally(tally.total, tally.byType, tally.byDifficulty)
+			*mapped += tally.total
+		}
+	}
+
+	if depth+1 < len(coverageDepths) {
+		for _, child := range src.Children {
+			childNode := buildCoverageNode(child, depth+1, path, tallies, targets, mapped)
+			node.mergeTally(childNode.Total, childNode.ByType, childNode.ByDifficulty)
+			node.Children = append(node.Children, childNode)
+		}
+	}
+
+	switch {
+	case node.Total == 0:
+		node.Status = CoverageStatusEmpty
+	case node.Total < node.Target:
+		node.Status = CoverageStatusThin
+	default:
+		node.Status = CoverageStatusOK
+	}
+
+	return node
+}
+
+func (n *CoverageNode) mergeTally(total int64, byType, byDifficulty map[string]int64) {
+	n.Total += total
+	for k, v := range byType {
+		n.ByType[k] += v
+	}
+	for k, v := range byDifficulty {
+		n.ByDifficulty[k] += v
+	}
+}
+
+// coveragePattern renders a node path in question code layout:
+// [Grade][Subject][Chapter][Level][Lesson]-[Form], with "*" for the level
+func coveragePattern(path []string) string {
+	var sb strings.Builder
+	for i, part := range path {
+		switch i {
+		case 3:
+			sb.WriteString("*")
+		case 4:
+			sb.WriteString("-")
+		}
+		sb.WriteString(part)
+	}
+	return sb.String()
+}
+
+// walkCoverage visits nodes depth-first in hierarchy order
+func walkCoverage(nodes []*CoverageNode, visit func(*CoverageNode)) {
+	for _, node := range nodes {
+		visit(node)
+		walkCoverage(node.Children, visit)
+	}
+}
+
+// coverageToMarkdown renders the report with a gap list followed by the full tree
+func coverageToMarkdown(report *CoverageReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("# MapCode Coverage Report\n\n")
+	sb.WriteString(fmt.Sprintf("**Version**: %s\n", report.Version))
+	sb.WriteString(fmt.Sprintf("**Generated**: %s\n", report.GeneratedAt.Format(time.RFC3339)))
+	sb.WriteString(fmt.Sprintf("**Targets**: chapter %d, lesson %d, form %d\n\n",
+		report.Targets.Chapter, report.Targets.Lesson, report.Targets.Form))
+	sb.WriteString("---\n\n")
+
+	// Summary
+	sb.WriteString("## Summary\n\n")
+	sb.WriteString(fmt.Sprintf("- Questions: %d (%d outside the hierarchy)\n", report.TotalQuestions, report.UnmappedQuestions))
+	sb.WriteString(fmt.Sprintf("- Nodes: %d\n", report.NodeCount))
+	sb.WriteString(fmt.Sprintf("- Empty nodes: %d\n", report.EmptyCount))
+	sb.WriteString(fmt.Sprintf("- Thin nodes: %d\n\n", report.ThinCount))
+
+	// Gaps at the levels content is written for
+	sb.WriteString("## Gaps\n\n")
+	sb.WriteString("| Code | Name | Questions | Target | Status |\n")
+	sb.WriteString("|------|------|-----------|--------|--------|\n")
+	walkCoverage(report.Grades, func(node *CoverageNode) {
+		if node.Target == 0 || node.Status == CoverageStatusOK {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("| `%s` | %s | %d | %d | %s |\n",
+			node.Code, node.Name, node.Total, node.Target, node.Status))
+	})
+	sb.WriteString("\n")
+
+	// Full tree
+	sb.WriteString("## Hierarchy\n\n")
+	sb.WriteString("| Code | Name | Questions | By type | By difficulty | Status |\n")
+	sb.WriteString("|------|------|-----------|---------|---------------|--------|\n")
+	walkCoverage(report.Grades, func(node *CoverageNode) {
+		indent := strings.Repeat("&nbsp;&nbsp;", indexOfDepth(node.Depth))
+		sb.WriteString(fmt.Sprintf("| `%s` | %s%s | %d | %s | %s | %s |\n",
+			node.Code, indent, node.Name, node.Total,
+			formatBreakdown(node.ByType), formatBreakdown(node.ByDifficulty), node.Status))
+	})
+	sb.WriteString("\n")
+
+	// Footer
+	sb.WriteString("---\n\n")
+	sb.WriteString("*Exported from NyNus Exam Bank System*\n")
+
+	return sb.String()
+}
+
+// coverageToCSV renders one row per node with a column per question type and difficulty
+func coverageToCSV(report *CoverageReport) (string, error) {
+	types := make(map[string]string)
+	difficulties := make(map[string]string)
+	walkCoverage(report.Grades, func(node *CoverageNode) {
+		for k := range node.ByType {
+			types[k] = k
+		}
+		for k := range node.ByDifficulty {
+			difficulties[k] = k
+		}
+	})
+	typeKeys := getSortedKeys(types)
+	difficultyKeys := getSortedKeys(difficulties)
+
+	var sb strings.Builder
+	writer := csv.NewWriter(&sb)
+
+	// Header
+	header := []string{"Depth", "Code", "Name", "Questions", "Target", "Status"}
+	for _, k := range typeKeys {
+		header = append(header, "Type "+k)
+	}
+	for _, k := range difficultyKeys {
+		header = append(header, "Difficulty "+k)
+	}
+	writer.Write(header)
+
+	walkCoverage(report.Grades, func(node *CoverageNode) {
+		row := []string{
+			node.Depth,
+			node.Code,
+			node.Name,
+			strconv.FormatInt(node.Total, 10),
+			strconv.FormatInt(node.Target, 10),
+			string(node.Status),
+		}
+		for _, k := range typeKeys {
+			row = append(row, strconv.FormatInt(node.ByType[k], 10))
+		}
+		for _, k := range difficultyKeys {
+			row = append(row, strconv.FormatInt(node.ByDifficulty[k], 10))
+		}
+		writer.Write(row)
+	})
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return sb.String(), nil
+}
+
+// formatBreakdown renders counts as "A: 1, B: 2" in key order
+func formatBreakdown(counts map[string]int64) string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %d", k, counts[k]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func indexOfDepth(depth string) int {
+	for i, d := range coverageDepths {
+		if d == depth {
+			return i
+		}
+	}
+	return 0
+}
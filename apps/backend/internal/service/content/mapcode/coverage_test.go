@@ -0,0 +1,175 @@
+package mapcode_mgmt
+
+import (
+	"strings"
+	"testing"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+const coverageTestMapCode = `[N] Nhận biết
+[H] Thông Hiểu
+-[0] Lớp 10
+----[P] 10-NGÂN HÀNG CHÍNH
+-------[1] Mệnh đề và tập hợp
+----------[1] Mệnh đề
+-------------[1] Xác định mệnh đề
+-------------[2] Tính đúng-sai của mệnh đề
+----------[2] Tập hợp
+-------------[1] Tập hợp và phần tử
+-------[2] Bất phương trình
+----------[1] Bất phương trình bậc nhất hai ẩn
+-------------[1] Miền nghiệm
+`
+
+func TestParseDashBasedFormat_BuildsTree(t *testing.T) {
+	m := &MapCodeMgmt{}
+	config, err := m.parseMapCodeContent(coverageTestMapCode)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(config.Tree) != 1 || config.Tree[0].Key != "0" {
+		t.Fatalf("expected a single grade root, got %+v", config.Tree)
+	}
+	chapters := config.Tree[0].Children[0].Children
+	if len(chapters) != 2 {
+		t.Fatalf("expected 2 chapters, got %d", len(chapters))
+	}
+	// Lesson keys repeat across chapters; the tree keeps them apart
+	if got := chapters[1].Children[0].Name; got != "Bất phương trình bậc nhất hai ẩn" {
+		t.Errorf("expected lesson under chapter 2, got %q", got)
+	}
+	if got := len(chapters[0].Children[0].Children); got != 2 {
+		t.Errorf("expected 2 forms under lesson 1, got %d", got)
+	}
+	if got := config.Lessons["1"]; got != "Mệnh đề" {
+		t.Errorf("flat lesson map should keep first occurrence, got %q", got)
+	}
+}
+
+func TestChapterInfo_UsesTree(t *testing.T) {
+	m := &MapCodeMgmt{}
+	config, err := m.parseMapCodeContent(coverageTestMapCode + `-[1] Lớp 11
+----[P] 11-NGÂN HÀNG CHÍNH
+-------[2] Hàm số lượng giác
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Chapter 2 exists in both grades; the flat map only knows grade 10's
+	info := chapterInfo("1P2V1", config)
+	if info.Grade != "Lớp 11" || info.Chapter != "Hàm số lượng giác" {
+		t.Errorf("expected grade 11 chapter, got %+v", info)
+	}
+	if info := chapterInfo("0P9V1", config); info.Grade != "Lớp 10" || info.Chapter != "" {
+		t.Errorf("expected unknown chapter to be empty, got %+v", info)
+	}
+}
+
+func TestBuildCoverageReport(t *testing.T) {
+	m := &MapCodeMgmt{}
+	config, err := m.parseMapCodeContent(coverageTestMapCode)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	counts := []entity.QuestionCoverageCount{
+		{Grade: "0", Subject: "P", Chapter: "1", Lesson: "1", Form: "1", Type: "MC", Difficulty: "EASY", Count: 4},
+		{Grade: "0", Subject: "P", Chapter: "1", Lesson: "1", Form: "1", Type: "TF", Difficulty: "HARD", Count: 2},
+		{Grade: "0", Subject: "P", Chapter: "1", Lesson: "1", Form: "2", Type: "MC", Difficulty: "EASY", Count: 1},
+		// ID5 code without a form counts towards its lesson
+		{Grade: "0", Subject: "P", Chapter: "1", Lesson: "2", Type: "SA", Difficulty: "MEDIUM", Count: 3},
+		// Code not present in the hierarchy
+		{Grade: "9", Subject: "X", Chapter: "1", Lesson: "1", Form: "1", Type: "MC", Difficulty: "EASY", Count: 5},
+	}
+
+	report := buildCoverageReport(config.Tree, counts, CoverageTargets{Chapter: 20, Lesson: 5, Form: 3})
+
+	if report.TotalQuestions != 15 || report.UnmappedQuestions != 5 {
+		t.Fatalf("expected 15 questions with 5 unmapped, got %d/%d", report.TotalQuestions, report.UnmappedQuestions)
+	}
+
+	nodes := make(map[string]*CoverageNode)
+	walkCoverage(report.Grades, func(node *CoverageNode) {
+		nodes[node.Code] = node
+	})
+
+	tests := []struct {
+		code   string
+		total  int64
+		status CoverageStatus
+	}{
+		{"0", 10, CoverageStatusOK},
+		{"0P1", 10, CoverageStatusThin},
+		{"0P1*1", 7, CoverageStatusOK},
+		{"0P1*1-1", 6, CoverageStatusOK},
+		{"0P1*1-2", 1, CoverageStatusThin},
+		{"0P1*2", 3, CoverageStatusThin},
+		{"0P1*2-1", 0, CoverageStatusEmpty},
+		{"0P2", 0, CoverageStatusEmpty},
+	}
+	for _, tt := range tests {
+		node, ok := nodes[tt.code]
+		if !ok {
+			t.Errorf("node %s missing from report", tt.code)
+			continue
+		}
+		if node.Total != tt.total || node.Status != tt.status {
+			t.Errorf("node %s: got total=%d status=%s, want %d %s", tt.code, node.Total, node.Status, tt.total, tt.status)
+		}
+	}
+
+	chapter := nodes["0P1"]
+	if chapter.ByType["MC"] != 5 || chapter.ByType["TF"] != 2 || chapter.ByType["SA"] != 3 {
+		t.Errorf("unexpected chapter type breakdown: %v", chapter.ByType)
+	}
+	if chapter.ByDifficulty["EASY"] != 5 || chapter.ByDifficulty["MEDIUM"] != 3 {
+		t.Errorf("unexpected chapter difficulty breakdown: %v", chapter.ByDifficulty)
+	}
+
+	if report.NodeCount != len(nodes) {
+		t.Errorf("expected node count %d, got %d", len(nodes), report.NodeCount)
+	}
+	if report.EmptyCount != 4 || report.ThinCount != 3 {
+		t.Errorf("expected 4 empty and 3 thin nodes, got %d/%d", report.EmptyCount, report.ThinCount)
+	}
+}
+
+func TestCoverageTargetsDefaults(t *testing.T) {
+	targets := CoverageTargets{Lesson: 8}.withDefaults()
+	if targets.Lesson != 8 || targets.Chapter != DefaultCoverageTargets.Chapter || targets.Form != DefaultCoverageTargets.Form {
+		t.Errorf("unexpected targets: %+v", targets)
+	}
+}
+
+func TestCoverageToCSV(t *testing.T) {
+	report := &CoverageReport{
+		Grades: []*CoverageNode{{
+			Depth:        CoverageDepthGrade,
+			Code:         "0",
+			Name:         "Lớp 10",
+			Total:        3,
+			ByType:       map[string]int64{"TF": 1, "MC": 2},
+			ByDifficulty: map[string]int64{"EASY": 3},
+			Status:       CoverageStatusOK,
+		}},
+	}
+
+	content, err := coverageToCSV(report)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got %d lines", len(lines))
+	}
+	if lines[0] != "Depth,Code,Name,Questions,Target,Status,Type MC,Type TF,Difficulty EASY" {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	if lines[1] != "grade,0,Lớp 10,3,0,OK,2,1,3" {
+		t.Errorf("unexpected row: %s", lines[1])
+	}
+}
@@ -0,0 +1,291 @@
+package mapcode_mgmt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+// CoverageDepthLevel names the difficulty level component in diffs; levels are
+// shared by the whole hierarchy rather than being a node in it
+const CoverageDepthLevel = "level"
+
+// MapCodeNodeChange describes one node that differs between two versions
+type MapCodeNodeChange struct {
+	Depth   string `json:"depth"`
+	Code    string `json:"code"` // Code pattern, "*" stands for any level
+	OldName string `json:"old_name,omitempty"`
+	NewName string `json:"new_name,omitempty"`
+}
+
+// VersionDiff is the structural difference between two MapCode versions
+type VersionDiff struct {
+	FromVersionID string              `json:"from_version_id"`
+	FromVersion   string              `json:"from_version"`
+	ToVersionID   string              `json:"to_version_id"`
+	ToVersion     string              `json:"to_version"`
+	Added         []MapCodeNodeChange `json:"added"`
+	Removed       []MapCodeNodeChange `json:"removed"`
+	Renamed       []MapCodeNodeChange `json:"renamed"`
+}
+
+// CodeImpact describes how a question code in use is affected by a version
+type CodeImpact struct {
+	Code          string `json:"code"`
+	QuestionCount int64  `json:"question_count"`
+	Reason        string `json:"reason"`
+}
+
+// ActivationImpact is the dry-run report of activating a version
+type ActivationImpact struct {
+	VersionID           string       `json:"version_id"`
+	Version             string       `json:"version"`
+	Diff                *VersionDiff `json:"diff,omitempty"` // Against the active version, nil when none is active
+	CodesChecked        int          `json:"codes_checked"`
+	UnresolvedCodes     []CodeImpact `json:"unresolved_codes"` // Codes that no longer resolve
+	UnresolvedQuestions int64        `json:"unresolved_questions"`
+	RenamedCodes        []CodeImpact `json:"renamed_codes"` // Codes that resolve to a renamed node
+	RenamedQuestions    int64        `json:"renamed_questions"`
+}
+
+// DiffVersions compares two versions node by node. An empty fromVersionID means the active version.
+func (m *MapCodeMgmt) DiffVersions(ctx context.Context, fromVersionID, toVersionID string) (*VersionDiff, error) {
+	fromVersion, fromConfig, err := m.loadVersionConfig(ctx, fromVersionID)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, toConfig, err := m.loadVersionConfig(ctx, toVersionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(fromConfig.Tree) == 0 || len(toConfig.Tree) == 0 {
+		return nil, ErrCoverageNoHierarchy
+	}
+
+	diff := diffConfigs(fromConfig, toConfig)
+	diff.FromVersionID = fromVersion.ID.String
+	diff.FromVersion = fromVersion.Version.String
+	diff.ToVersionID = toVersion.ID.String
+	diff.ToVersion = toVersion.Version.String
+	return diff, nil
+}
+
+// PreviewActivation reports which existing questions would be affected if the
+// version were activated, without changing anything
+func (m *MapCodeMgmt) PreviewActivation(ctx context.Context, versionID string) (*ActivationImpact, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	version, config, err := m.loadVersionConfig(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Tree) == 0 {
+		return nil, ErrCoverageNoHierarchy
+	}
+
+	// Diff against the active version when there is one
+	var activeConfig *entity.MapCodeConfig
+	var diff *VersionDiff
+	if active, err := m.GetActiveVersion(ctx); err == nil && active.ID.String != version.ID.String {
+		if cfg, err := m.getOrLoadConfig(ctx, active); err == nil && len(cfg.Tree) > 0 {
+			activeConfig = cfg
+			diff = diffConfigs(cfg, config)
+			diff.FromVersionID = active.ID.String
+			diff.FromVersion = active.Version.String
+			diff.ToVersionID = version.ID.String
+			diff.ToVersion = version.Version.String
+		}
+	}
+
+	usage, err := m.mapCodeRepo.ListQuestionCodeUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list question codes: %w", err)
+	}
+
+	impact := assessActivationImpact(activeConfig, config, usage)
+	impact.VersionID = version.ID.String
+	impact.Version = version.Version.String
+	impact.Diff = diff
+	return impact, nil
+}
+
+// loadVersionConfig loads a version and its parsed config; empty ID means the active version
+func (m *MapCodeMgmt) loadVersionConfig(ctx context.Context, versionID string) (*entity.MapCodeVersion, *entity.MapCodeConfig, error) {
+	var version *entity.MapCodeVersion
+	var err error
+	if versionID != "" {
+		version, err = m.mapCodeRepo.GetVersionByID(ctx, versionID)
+	} else {
+		version, err = m.GetActiveVersion(ctx)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get version: %w", err)
+	}
+
+	config, err := m.getOrLoadConfig(ctx, version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	return version, config, nil
+}
+
+// diffConfigs compares the hierarchy trees and levels of two configs.
+// Nodes are matched by their key path, so a node moved to another parent shows
+// up as removed and added.
+func diffConfigs(from, to *entity.MapCodeConfig) *VersionDiff {
+	diff := &VersionDiff{
+		Added:   []MapCodeNodeChange{},
+		Removed: []MapCodeNodeChange{},
+		Renamed: []MapCodeNodeChange{},
+	}
+
+	fromNodes := flattenTree(from.Tree)
+	toNodes := flattenTree(to.Tree)
+
+	for _, code := range sortedNodeKeys(fromNodes) {
+		old := fromNodes[code]
+		current, ok := toNodes[code]
+		switch {
+		case !ok:
+			diff.Removed = append(diff.Removed, MapCodeNodeChange{Depth: old.depth, Code: code, OldName: old.name})
+		case current.name != old.name:
+			diff.Renamed = append(diff.Renamed, MapCodeNodeChange{Depth: old.depth, Code: code, OldName: old.name, NewName: current.name})
+		}
+	}
+	for _, code := range sortedNodeKeys(toNodes) {
+		if _, ok := fromNodes[code]; !ok {
+			node := toNodes[code]
+			diff.Added = append(diff.Added, MapCodeNodeChange{Depth: node.depth, Code: code, NewName: node.name})
+		}
+	}
+
+	// Levels are a flat shared list
+	for _, key := range getSortedKeys(from.Levels) {
+		newName, ok := to.Levels[key]
+		switch {
+		case !ok:
+			diff.Removed = append(diff.Removed, MapCodeNodeChange{Depth: CoverageDepthLevel, Code: key, OldName: from.Levels[key]})
+		case newName != from.Levels[key]:
+			diff.Renamed = append(diff.Renamed, MapCodeNodeChange{Depth: CoverageDepthLevel, Code: key, OldName: from.Levels[key], NewName: newName})
+		}
+	}
+	for _, key := range getSortedKeys(to.Levels) {
+		if _, ok := from.Levels[key]; !ok {
+			diff.Added = append(diff.Added, MapCodeNodeChange{Depth: CoverageDepthLevel, Code: key, NewName: to.Levels[key]})
+		}
+	}
+
+	return diff
+}
+
+// flatNode is a tree node indexed by its code pattern
+type flatNode struct {
+	depth string
+	name  string
+}
+
+// flattenTree indexes every tree node by its code pattern
+func flattenTree(tree []*entity.MapCodeNode) map[string]flatNode {
+	nodes := make(map[string]flatNode)
+	var walk func(children []*entity.MapCodeNode, depth int, path []string)
+	walk = func(children []*entity.MapCodeNode, depth int, path []string) {
+		if depth >= len(coverageDepths) {
+			return
+		}
+		for _, child := range children {
+			childPath := append(append([]string(nil), path...), child.Key)
+			code := coveragePattern(childPath)
+			// Keep the first occurrence, like the flat maps
+			if _, exists := nodes[code]; !exists {
+				nodes[code] = flatNode{depth: coverageDepths[depth], name: child.Name}
+			}
+			walk(child.Children, depth+1, childPath)
+		}
+	}
+	walk(tree, 0, nil)
+	return nodes
+}
+
+func sortedNodeKeys(nodes map[string]flatNode) []string {
+	keys := make([]string, 0, len(nodes))
+	for k := range nodes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// assessActivationImpact checks every code in use against the target config.
+// active may be nil, in which case renamed nodes cannot be detected.
+func assessActivationImpact(active, target *entity.MapCodeConfig, usage []entity.QuestionCodeUsage) *ActivationImpact {
+	impact := &ActivationImpact{
+		UnresolvedCodes: []CodeImpact{},
+		RenamedCodes:    []CodeImpact{},
+	}
+
+	targetNodes := flattenTree(target.Tree)
+	var activeNodes map[string]flatNode
+	if active != nil {
+		activeNodes = flattenTree(active.Tree)
+	}
+
+	for _, u := range usage {
+		impact.CodesChecked++
+
+		if reason := unresolvedReason(targetNodes, target.Levels, u); reason != "" {
+			impact.UnresolvedCodes = append(impact.UnresolvedCodes, CodeImpact{Code: u.Code, QuestionCount: u.QuestionCount, Reason: reason})
+			impact.UnresolvedQuestions += u.QuestionCount
+			continue
+		}
+
+		if activeNodes == nil {
+			continue
+		}
+		var renames []string
+		for _, code := range codeAncestors(u) {
+			old, ok := activeNodes[code]
+			if ok && old.name != targetNodes[code].name {
+				renames = append(renames, fmt.Sprintf("%s %s renamed from %q to %q", old.depth, code, old.name, targetNodes[code].name))
+			}
+		}
+		if len(renames) > 0 {
+			impact.RenamedCodes = append(impact.RenamedCodes, CodeImpact{Code: u.Code, QuestionCount: u.QuestionCount, Reason: strings.Join(renames, "; ")})
+			impact.RenamedQuestions += u.QuestionCount
+		}
+	}
+
+	return impact
+}
+
+// unresolvedReason explains why a code does not resolve in the given nodes, or returns ""
+func unresolvedReason(nodes map[string]flatNode, levels map[string]string, u entity.QuestionCodeUsage) string {
+	for i, code := range codeAncestors(u) {
+		if _, ok := nodes[code]; !ok {
+			return fmt.Sprintf("%s %s not in version", coverageDepths[i], code)
+		}
+	}
+	if _, ok := levels[u.Level]; !ok {
+		return fmt.Sprintf("level %s not in version", u.Level)
+	}
+	return ""
+}
+
+// codeAncestors returns the code patterns of every hierarchy node a code belongs to, root first
+func codeAncestors(u entity.QuestionCodeUsage) []string {
+	path := []string{u.Grade, u.Subject, u.Chapter, u.Lesson}
+	if u.Form != "" {
+		path = append(path, u.Form)
+	}
+
+	codes := make([]string, 0, len(path))
+	for i := range path {
+		codes = append(codes, coveragePattern(path[:i+1]))
+	}
+	return codes
+}
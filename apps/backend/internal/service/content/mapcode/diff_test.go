@@ -0,0 +1,146 @@
+package mapcode_mgmt
+
+import (
+	"errors"
+	"testing"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+const diffTestNewMapCode = `[N] Nhận biết
+[V] Vận dụng
+-[0] Lớp 10
+----[P] 10-NGÂN HÀNG CHÍNH
+-------[1] Mệnh đề, tập hợp
+----------[1] Mệnh đề
+-------------[1] Xác định mệnh đề
+-------------[2] Tính đúng-sai của mệnh đề
+-------------[3] Phủ định mệnh đề
+-------[2] Bất phương trình
+----------[1] Bất phương trình bậc nhất hai ẩn
+-------------[1] Miền nghiệm
+`
+
+func parseTestConfig(t *testing.T, content string) *entity.MapCodeConfig {
+	t.Helper()
+	m := &MapCodeMgmt{}
+	config, err := m.parseMapCodeContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return config
+}
+
+func changeCodes(changes []MapCodeNodeChange) map[string]MapCodeNodeChange {
+	out := make(map[string]MapCodeNodeChange, len(changes))
+	for _, c := range changes {
+		out[c.Depth+":"+c.Code] = c
+	}
+	return out
+}
+
+func TestDiffConfigs(t *testing.T) {
+	diff := diffConfigs(parseTestConfig(t, coverageTestMapCode), parseTestConfig(t, diffTestNewMapCode))
+
+	added := changeCodes(diff.Added)
+	removed := changeCodes(diff.Removed)
+	renamed := changeCodes(diff.Renamed)
+
+	if _, ok := added["form:0P1*1-3"]; !ok || len(added) != 2 {
+		t.Errorf("expected form 0P1*1-3 and level V added, got %v", diff.Added)
+	}
+	if _, ok := added["level:V"]; !ok {
+		t.Errorf("expected level V added, got %v", diff.Added)
+	}
+
+	// Lesson 0P1*2 and its form disappear, as does level H
+	for _, key := range []string{"lesson:0P1*2", "form:0P1*2-1", "level:H"} {
+		if _, ok := removed[key]; !ok {
+			t.Errorf("expected %s removed, got %v", key, diff.Removed)
+		}
+	}
+	if len(removed) != 3 {
+		t.Errorf("expected 3 removals, got %v", diff.Removed)
+	}
+
+	change, ok := renamed["chapter:0P1"]
+	if !ok || change.OldName != "Mệnh đề và tập hợp" || change.NewName != "Mệnh đề, tập hợp" {
+		t.Errorf("expected chapter 0P1 rename, got %v", diff.Renamed)
+	}
+	if len(renamed) != 1 {
+		t.Errorf("expected 1 rename, got %v", diff.Renamed)
+	}
+}
+
+func TestAssessActivationImpact(t *testing.T) {
+	active := parseTestConfig(t, coverageTestMapCode)
+	target := parseTestConfig(t, diffTestNewMapCode)
+
+	usage := []entity.QuestionCodeUsage{
+		splitUsage("0P1N1-1", 4), // still resolves, under a renamed chapter
+		splitUsage("0P1N2-1", 3), // lesson removed
+		splitUsage("0P2H1-1", 2), // level removed
+		splitUsage("0P2N1", 1),   // resolves unchanged
+	}
+
+	impact := assessActivationImpact(active, target, usage)
+
+	if impact.CodesChecked != 4 {
+		t.Errorf("expected 4 codes checked, got %d", impact.CodesChecked)
+	}
+	if impact.UnresolvedQuestions != 5 || len(impact.UnresolvedCodes) != 2 {
+		t.Fatalf("expected 2 unresolved codes with 5 questions, got %+v", impact.UnresolvedCodes)
+	}
+	if got := impact.UnresolvedCodes[0].Reason; got != "lesson 0P1*2 not in version" {
+		t.Errorf("unexpected reason: %s", got)
+	}
+	if got := impact.UnresolvedCodes[1].Reason; got != "level H not in version" {
+		t.Errorf("unexpected reason: %s", got)
+	}
+	if impact.RenamedQuestions != 4 || len(impact.RenamedCodes) != 1 || impact.RenamedCodes[0].Code != "0P1N1-1" {
+		t.Errorf("expected 0P1N1-1 reported as renamed, got %+v", impact.RenamedCodes)
+	}
+}
+
+func TestValidateCodeMappings(t *testing.T) {
+	config := parseTestConfig(t, diffTestNewMapCode)
+
+	if err := validateCodeMappings(config, []CodeMapping{
+		{OldCode: "0P1N2-1", NewCode: "0P1N1-3"},
+		{OldCode: "0P2H1-1", NewCode: "0P2V1-1"},
+	}); err != nil {
+		t.Fatalf("expected valid mappings, got %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		mappings []CodeMapping
+	}{
+		{"empty", nil},
+		{"malformed", []CodeMapping{{OldCode: "0P1", NewCode: "0P1N1-1"}}},
+		{"identity", []CodeMapping{{OldCode: "0P1N1-1", NewCode: "0P1N1-1"}}},
+		{"duplicate source", []CodeMapping{
+			{OldCode: "0P1N2-1", NewCode: "0P1N1-1"},
+			{OldCode: "0P1N2-1", NewCode: "0P1N1-2"},
+		}},
+		{"chained", []CodeMapping{
+			{OldCode: "0P1N2-1", NewCode: "0P1N1-1"},
+			{OldCode: "0P1N1-1", NewCode: "0P1N1-2"},
+		}},
+		{"unresolved node", []CodeMapping{{OldCode: "0P1N1-1", NewCode: "0P1N2-1"}}},
+		{"unresolved level", []CodeMapping{{OldCode: "0P1N1-1", NewCode: "0P1H1-1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCodeMappings(config, tt.mappings); !errors.Is(err, ErrRemapInvalidInput) {
+				t.Errorf("expected ErrRemapInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func splitUsage(code string, count int64) entity.QuestionCodeUsage {
+	u := splitQuestionCode(code)
+	u.QuestionCount = count
+	return u
+}
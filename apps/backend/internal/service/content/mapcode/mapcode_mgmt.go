@@ -41,6 +41,9 @@ type MapCodeMgmt struct {
 	// Event broadcasting
 	eventListeners []chan *entity.MapCodeVersionEvent
 	eventsLock     sync.RWMutex
+
+	// Question counts for coverage reports
+	coverageSource coverageCountSource
 }
 
 // NewMapCodeMgmt creates a new MapCodeMgmt instance
@@ -406,6 +409,63 @@ func (m *MapCodeMgmt) GetHierarchyNavigation(ctx context.Context, questionCode s
 	return m.buildHierarchyNavigation(questionCode, config)
 }
 
+// ChapterInfo names the grade, subject and chapter of a question code; parts missing
+// from the MapCode are empty
+type ChapterInfo struct {
+	Grade   string
+	Subject string
+	Chapter string
+}
+
+// GetChapter names the grade, subject and chapter of a question code in the active version
+func (m *MapCodeMgmt) GetChapter(ctx context.Context, questionCode string) (*ChapterInfo, error) {
+	if len(questionCode) < 3 {
+		return nil, fmt.Errorf("invalid question code format")
+	}
+
+	activeVersion, err := m.GetActiveVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no active version found: %w", err)
+	}
+	config, err := m.getOrLoadConfig(ctx, activeVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load MapCode config: %w", err)
+	}
+	return chapterInfo(questionCode, config), nil
+}
+
+// chapterInfo resolves the first three parts of a code. Chapter keys repeat across
+// grades and subjects, so the tree is walked when the version has one.
+func chapterInfo(questionCode string, config *entity.MapCodeConfig) *ChapterInfo {
+	keys := []string{questionCode[0:1], questionCode[1:2], questionCode[2:3]}
+	if len(config.Tree) == 0 {
+		return &ChapterInfo{
+			Grade:   config.Grades[keys[0]],
+			Subject: config.Subjects[keys[1]],
+			Chapter: config.Chapters[keys[2]],
+		}
+	}
+
+	info := &ChapterInfo{}
+	names := []*string{&info.Grade, &info.Subject, &info.Chapter}
+	nodes := config.Tree
+	for i, key := range keys {
+		var found *entity.MapCodeNode
+		for _, node := range nodes {
+			if node.Key == key {
+				found = node
+				break
+			}
+		}
+		if found == nil {
+			break
+		}
+		*names[i] = found.Name
+		nodes = found.Children
+	}
+	return info
+}
+
 // HierarchyNavigation represents navigation structure
 type HierarchyNavigation struct {
 	QuestionCode string          `json:"question_code"`
@@ -506,6 +566,10 @@ func (m *MapCodeMgmt) parseDashBasedFormat(content string) (*entity.MapCodeConfi
 
 	lines := strings.Split(content, "\n")
 
+	// Current ancestors while walking the hierarchy, indexed by depth
+	// (0 grade, 1 subject, 2 chapter, 3 lesson)
+	var ancestors [4]*entity.MapCodeNode
+
 	for _, line := range lines {
 		trimmedLine := strings.TrimSpace(line)
 
@@ -540,12 +604,43 @@ func (m *MapCodeMgmt) parseDashBasedFormat(content string) (*entity.MapCodeConfi
 			m.parseDashLine(line, 10, config.Lessons)
 		case 13: // -------------[X] Dạng (Form)
 			m.parseDashLine(line, 13, config.Forms)
+		default:
+			continue
 		}
+
+		m.appendTreeNode(config, &ancestors, line, dashCount/3)
 	}
 
 	return config, nil
 }
 
+// appendTreeNode attaches a dash-based line to the hierarchy tree at the given depth.
+// Lines whose parent is missing (malformed files) are left out of the tree.
+func (m *MapCodeMgmt) appendTreeNode(config *entity.MapCodeConfig, ancestors *[4]*entity.MapCodeNode, line string, depth int) {
+	key, value, ok := parseDashEntry(line)
+	if !ok {
+		return
+	}
+	node := &entity.MapCodeNode{Key: key, Name: value}
+
+	if depth == 0 {
+		config.Tree = append(config.Tree, node)
+	} else {
+		parent := ancestors[depth-1]
+		if parent == nil {
+			return
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	if depth < len(ancestors) {
+		ancestors[depth] = node
+		for i := depth + 1; i < len(ancestors); i++ {
+			ancestors[i] = nil
+		}
+	}
+}
+
 // countLeadingDashes counts the number of leading dashes in a line
 func (m *MapCodeMgmt) countLeadingDashes(line string) int {
 	count := 0
@@ -561,25 +656,34 @@ func (m *MapCodeMgmt) countLeadingDashes(line string) int {
 
 // parseDashLine parses a single dash-based line and extracts key-value
 func (m *MapCodeMgmt) parseDashLine(line string, expectedDashes int, targetMap map[string]string) {
+	key, value, ok := parseDashEntry(line)
+	if !ok {
+		return
+	}
+
+	// Store in map if not already exists (keep first occurrence)
+	if _, exists := targetMap[key]; !exists {
+		targetMap[key] = value
+	}
+}
+
+// parseDashEntry extracts the key and description from a "---[X] Description" line
+func parseDashEntry(line string) (string, string, bool) {
 	// Remove leading dashes
 	content := strings.TrimLeft(line, "-")
 	content = strings.TrimSpace(content)
 
 	// Parse pattern: [X] Description
-	pattern := regexp.MustCompile(`^\[([^\]]+)\]\s*(.+)$`)
-	matches := pattern.FindStringSubmatch(content)
-
-	if len(matches) == 3 {
-		key := strings.TrimSpace(matches[1])
-		value := strings.TrimSpace(matches[2])
-
-		// Store in map if not already exists (keep first occurrence)
-		if _, exists := targetMap[key]; !exists {
-			targetMap[key] = value
-		}
+	matches := dashEntryPattern.FindStringSubmatch(content)
+	if len(matches) != 3 {
+		return "", "", false
 	}
+
+	return strings.TrimSpace(matches[1]), strings.TrimSpace(matches[2]), true
 }
 
+var dashEntryPattern = regexp.MustCompile(`^\[([^\]]+)\]\s*(.+)$`)
+
 // parseSection parses a specific section of MapCode content
 func (m *MapCodeMgmt) parseSection(content, sectionName string, targetMap map[string]string) error {
 	// Find section start
@@ -0,0 +1,154 @@
+package mapcode_mgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"exam-bank-system/apps/backend/internal/entity"
+)
+
+// ErrRemapInvalidInput is returned when a code mapping cannot be applied
+var ErrRemapInvalidInput = errors.New("invalid code remap")
+
+var questionCodePattern = regexp.MustCompile(`^[0-9A-Z]{5}(-[0-9A-Z])?$`)
+
+// CodeMapping maps an old question code to its replacement
+type CodeMapping struct {
+	OldCode string `json:"old_code"`
+	NewCode string `json:"new_code"`
+}
+
+// RemapResult is the outcome of a remap, or its preview when DryRun is set
+type RemapResult struct {
+	BatchID          string                 `json:"batch_id,omitempty"`
+	VersionID        string                 `json:"version_id"`
+	DryRun           bool                   `json:"dry_run"`
+	Remaps           []*entity.MapCodeRemap `json:"remaps"`
+	QuestionsUpdated int64                  `json:"questions_updated"`
+}
+
+// RemapQuestionCodes moves questions from old codes to new codes that resolve in the
+// given version (active version when empty). All mappings are applied in one transaction
+// and recorded in the remap audit trail. With dryRun only the affected question counts
+// are reported.
+func (m *MapCodeMgmt) RemapQuestionCodes(ctx context.Context, versionID string, mappings []CodeMapping, performedBy, reason string, dryRun bool) (*RemapResult, error) {
+	version, config, err := m.loadVersionConfig(ctx, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.Tree) == 0 {
+		return nil, ErrCoverageNoHierarchy
+	}
+
+	if err := validateCodeMappings(config, mappings); err != nil {
+		return nil, err
+	}
+
+	remaps := make([]*entity.MapCodeRemap, 0, len(mappings))
+	for _, mapping := range mappings {
+		remaps = append(remaps, &entity.MapCodeRemap{
+			VersionID:   version.ID.String,
+			OldCode:     mapping.OldCode,
+			NewCode:     mapping.NewCode,
+			PerformedBy: performedBy,
+			Reason:      reason,
+		})
+	}
+
+	result := &RemapResult{
+		VersionID: version.ID.String,
+		DryRun:    dryRun,
+		Remaps:    remaps,
+	}
+
+	if dryRun {
+		usage, err := m.mapCodeRepo.ListQuestionCodeUsage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list question codes: %w", err)
+		}
+		counts := make(map[string]int64, len(usage))
+		for _, u := range usage {
+			counts[u.Code] = u.QuestionCount
+		}
+		for _, remap := range remaps {
+			remap.QuestionsUpdated = counts[remap.OldCode]
+			result.QuestionsUpdated += remap.QuestionsUpdated
+		}
+		return result, nil
+	}
+
+	if err := m.mapCodeRepo.ApplyCodeRemaps(ctx, remaps); err != nil {
+		return nil, fmt.Errorf("failed to apply code remaps: %w", err)
+	}
+
+	for _, remap := range remaps {
+		result.QuestionsUpdated += remap.QuestionsUpdated
+	}
+	if len(remaps) > 0 {
+		result.BatchID = remaps[0].BatchID
+	}
+
+	return result, nil
+}
+
+// ListCodeRemaps returns the remap audit trail, optionally for a single code
+func (m *MapCodeMgmt) ListCodeRemaps(ctx context.Context, code string, limit, offset int) ([]*entity.MapCodeRemap, int, error) {
+	return m.mapCodeRepo.ListCodeRemaps(ctx, code, limit, offset)
+}
+
+// validateCodeMappings rejects malformed, duplicate or chained mappings and new codes
+// that do not resolve in the target config
+func validateCodeMappings(config *entity.MapCodeConfig, mappings []CodeMapping) error {
+	if len(mappings) == 0 {
+		return fmt.Errorf("%w: at least one mapping is required", ErrRemapInvalidInput)
+	}
+
+	nodes := flattenTree(config.Tree)
+	olds := make(map[string]bool, len(mappings))
+	for _, mapping := range mappings {
+		if !questionCodePattern.MatchString(mapping.OldCode) {
+			return fmt.Errorf("%w: malformed old code %q", ErrRemapInvalidInput, mapping.OldCode)
+		}
+		if !questionCodePattern.MatchString(mapping.NewCode) {
+			return fmt.Errorf("%w: malformed new code %q", ErrRemapInvalidInput, mapping.NewCode)
+		}
+		if mapping.OldCode == mapping.NewCode {
+			return fmt.Errorf("%w: %s maps to itself", ErrRemapInvalidInput, mapping.OldCode)
+		}
+		if olds[mapping.OldCode] {
+			return fmt.Errorf("%w: %s is mapped more than once", ErrRemapInvalidInput, mapping.OldCode)
+		}
+		olds[mapping.OldCode] = true
+
+		if reason := unresolvedReason(nodes, config.Levels, splitQuestionCode(mapping.NewCode)); reason != "" {
+			return fmt.Errorf("%w: new code %s does not resolve: %s", ErrRemapInvalidInput, mapping.NewCode, reason)
+		}
+	}
+
+	// A code that is both moved and a target would make the result order-dependent
+	for _, mapping := range mappings {
+		if olds[mapping.NewCode] {
+			return fmt.Errorf("%w: %s is both a source and a target", ErrRemapInvalidInput, mapping.NewCode)
+		}
+	}
+
+	return nil
+}
+
+// splitQuestionCode splits a well-formed ID5/ID6 code into its components
+func splitQuestionCode(code string) entity.QuestionCodeUsage {
+	u := entity.QuestionCodeUsage{
+		Code:    code,
+		Grade:   code[0:1],
+		Subject: code[1:2],
+		Chapter: code[2:3],
+		Level:   code[3:4],
+		Lesson:  code[4:5],
+	}
+	if len(code) == 7 {
+		u.Form = code[6:7]
+	}
+	return u
+}
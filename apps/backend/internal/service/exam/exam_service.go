@@ -2,6 +2,7 @@ package exam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"exam-bank-system/apps/backend/internal/entity"
@@ -29,12 +30,21 @@ type questionRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.Question, error)
 }
 
+// questionSuspensionChecker reports whether a question is withheld from new exams.
+type questionSuspensionChecker interface {
+	IsSuspended(ctx context.Context, questionID string) (bool, error)
+}
+
+// ErrQuestionSuspended is returned when adding a question that is suspended pending error report triage.
+var ErrQuestionSuspended = errors.New("question is suspended pending review of error reports")
+
 // ExamService provides business logic for exam management
 // Following QuestionService pattern for consistency
 type ExamService struct {
-	examRepo     examRepository
-	questionRepo questionRepository
-	logger       *logrus.Logger
+	examRepo          examRepository
+	questionRepo      questionRepository
+	suspensionChecker questionSuspensionChecker
+	logger            *logrus.Logger
 }
 
 // NewExamService creates a new exam management service
@@ -53,6 +63,11 @@ func NewExamService(
 	}
 }
 
+// SetSuspensionChecker enables the suspension check when adding questions to exams
+func (m *ExamService) SetSuspensionChecker(checker questionSuspensionChecker) {
+	m.suspensionChecker = checker
+}
+
 // CreateExam creates a new exam with business logic validation
 func (m *ExamService) CreateExam(ctx context.Context, exam *entity.Exam) error {
 	m.logger.WithFields(logrus.Fields{
@@ -294,6 +309,17 @@ func (m *ExamService) AddQuestionToExam(ctx context.Context, examID, questionID
 		return fmt.Errorf("cannot modify archived exam")
 	}
 
+	// Suspended questions cannot be added to new exams
+	if m.suspensionChecker != nil {
+		suspended, err := m.suspensionChecker.IsSuspended(ctx, questionID)
+		if err != nil {
+			return fmt.Errorf("failed to check question suspension: %w", err)
+		}
+		if suspended {
+			return ErrQuestionSuspended
+		}
+	}
+
 	// Create ExamQuestion entity
 	examQuestion := &entity.ExamQuestion{
 		ExamID:      examID,
@@ -240,6 +240,34 @@ func TestAddQuestionToExam_Success(t *testing.T) {
 	examRepo.AssertExpectations(t)
 }
 
+type stubSuspensionChecker struct {
+	suspended map[string]bool
+}
+
+func (s stubSuspensionChecker) IsSuspended(ctx context.Context, questionID string) (bool, error) {
+	return s.suspended[questionID], nil
+}
+
+func TestAddQuestionToExam_SuspendedQuestion(t *testing.T) {
+	ctx := context.Background()
+	examRepo := &mockExamRepository{}
+	examID := "exam-123"
+
+	examRepo.On("GetByID", ctx, examID).Return(&entity.Exam{
+		ID:     examID,
+		Status: entity.ExamStatusPending,
+	}, nil).Once()
+
+	service := NewExamService(examRepo, nil, logrus.New())
+	service.SetSuspensionChecker(stubSuspensionChecker{suspended: map[string]bool{"question-bad": true}})
+
+	err := service.AddQuestionToExam(ctx, examID, "question-bad", 5)
+
+	require.ErrorIs(t, err, ErrQuestionSuspended)
+	examRepo.AssertNotCalled(t, "AddQuestion", mock.Anything, mock.Anything)
+	examRepo.AssertExpectations(t)
+}
+
 func TestDeleteExam_RepositoryError(t *testing.T) {
 	ctx := context.Background()
 	examRepo := &mockExamRepository{}
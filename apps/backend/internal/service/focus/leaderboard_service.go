@@ -44,7 +44,7 @@ func (s *LeaderboardService) GetLeaderboard(ctx context.Context, leaderboardType
 }
 
 // GetClassLeaderboard retrieves the leaderboard for a specific class
-func (s *LeaderboardService) GetClassLeaderboard(ctx context.Context, classID int, period entity.LeaderboardPeriod, limit int) ([]*entity.LeaderboardEntry, error) {
+func (s *LeaderboardService) GetClassLeaderboard(ctx context.Context, classID string, period entity.LeaderboardPeriod, limit int) ([]*entity.LeaderboardEntry, error) {
 	periodStart := calculatePeriodStart(period)
 
 	entries, err := s.leaderboardRepo.GetClassLeaderboard(ctx, classID, period, periodStart, limit)
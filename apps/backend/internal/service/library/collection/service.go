@@ -0,0 +1,552 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+// Errors returned by the collection service
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidInput     = errors.New("invalid input")
+)
+
+// Defaults applied by NewService
+const (
+	DefaultMaxSections = 50
+	DefaultMaxItems    = 200
+)
+
+// Scopes of List
+const (
+	ScopeMine   = "mine"   // Own collections and those shared with the caller's classes
+	ScopePublic = "public" // Public collections of every owner
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 5000
+	maxNoteLength        = 1000
+
+	// platformAdmin is the users.role that bypasses ownership checks
+	platformAdmin = "ADMIN"
+	// classTeacher is the class_members.role that may share with and follow a class
+	classTeacher = "TEACHER"
+)
+
+// publishRoles are the platform roles that may make a collection public
+var publishRoles = map[string]bool{"TUTOR": true, "TEACHER": true, "ADMIN": true}
+
+// Store persists collections, implemented by repository.LibraryCollectionRepository
+type Store interface {
+	Create(ctx context.Context, c *repository.LibraryCollection) error
+	Get(ctx context.Context, id string) (*repository.LibraryCollection, error)
+	Update(ctx context.Context, c *repository.LibraryCollection) error
+	Delete(ctx context.Context, id string) error
+	ListForUser(ctx context.Context, userID string, limit, offset int) ([]*repository.LibraryCollection, error)
+	ListPublic(ctx context.Context, limit, offset int) ([]*repository.LibraryCollection, error)
+	GetContent(ctx context.Context, id string) ([]repository.LibraryCollectionSection, error)
+	DescribeItems(ctx context.Context, refs []repository.LibraryCollectionItem) ([]repository.LibraryCollectionItem, error)
+	ReplaceContent(ctx context.Context, id string, sections []repository.LibraryCollectionSection) error
+	AppendItem(ctx context.Context, id string, section int, item repository.LibraryCollectionItem) error
+	Copy(ctx context.Context, sourceID, ownerID, title string) (*repository.LibraryCollection, error)
+	SetProgress(ctx context.Context, id, userID, kind, itemID string, done bool) error
+	ListProgress(ctx context.Context, id string, userIDs []string) ([]repository.LibraryCollectionProgress, error)
+	ListProgressUsers(ctx context.Context, id string) ([]string, error)
+}
+
+// Classes reads class rosters, implemented by repository.OrganisationRepository
+type Classes interface {
+	GetClassRole(ctx context.Context, classID, userID string) (string, error)
+	ListClassMembers(ctx context.Context, classID string) ([]*repository.ClassMember, error)
+}
+
+// Config limits the size of a collection
+type Config struct {
+	MaxSections int
+	MaxItems    int // Members across all sections
+}
+
+// Actor is the authenticated caller
+type Actor struct {
+	UserID string
+	Role   string // Platform role from users.role
+}
+
+// Input is the editable metadata of a collection
+type Input struct {
+	Title       string
+	Description string
+	Visibility  string
+	ClassID     string // Required for class visibility
+}
+
+// StudentProgress is what one student completed in a collection
+type StudentProgress struct {
+	UserID    string
+	Name      string
+	Email     string
+	Completed []repository.LibraryCollectionProgress // Members still in the collection
+	Total     int
+}
+
+// Service manages library collections: ordered study packs of books, exams, videos and
+// question sets that can be shared with a class or published
+type Service struct {
+	store   Store
+	classes Classes
+	config  Config
+}
+
+// NewService creates a new collection service
+func NewService(store Store, classes Classes, config Config) *Service {
+	if config.MaxSections <= 0 {
+		config.MaxSections = DefaultMaxSections
+	}
+	if config.MaxItems <= 0 {
+		config.MaxItems = DefaultMaxItems
+	}
+	return &Service{store: store, classes: classes, config: config}
+}
+
+// Create creates an empty collection owned by the actor
+func (s *Service) Create(ctx context.Context, actor Actor, in Input) (*repository.LibraryCollection, error) {
+	if actor.UserID == "" {
+		return nil, ErrPermissionDenied
+	}
+	c := &repository.LibraryCollection{OwnerID: actor.UserID}
+	if err := s.apply(ctx, actor, c, in); err != nil {
+		return nil, err
+	}
+	if err := s.store.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get returns a collection the actor may view with its content
+func (s *Service) Get(ctx context.Context, actor Actor, id string) (*repository.LibraryCollection, []repository.LibraryCollectionSection, error) {
+	c, err := s.viewable(ctx, actor, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	sections, err := s.store.GetContent(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, sections, nil
+}
+
+// List returns the actor's collections, or public ones
+func (s *Service) List(ctx context.Context, actor Actor, scope string, limit, offset int) ([]*repository.LibraryCollection, error) {
+	switch scope {
+	case "", ScopeMine:
+		if actor.UserID == "" {
+			return nil, nil
+		}
+		return s.store.ListForUser(ctx, actor.UserID, limit, offset)
+	case ScopePublic:
+		return s.store.ListPublic(ctx, limit, offset)
+	default:
+		return nil, fmt.Errorf("%w: scope must be %s or %s", ErrInvalidInput, ScopeMine, ScopePublic)
+	}
+}
+
+// Update changes the metadata of a collection the actor owns
+func (s *Service) Update(ctx context.Context, actor Actor, id string, in Input) (*repository.LibraryCollection, error) {
+	c, err := s.editable(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apply(ctx, actor, c, in); err != nil {
+		return nil, err
+	}
+	if err := s.store.Update(ctx, c); err != nil {
+		return nil, translateNotFound(err)
+	}
+	return c, nil
+}
+
+// Delete removes a collection the actor owns
+func (s *Service) Delete(ctx context.Context, actor Actor, id string) error {
+	if _, err := s.editable(ctx, actor, id); err != nil {
+		return err
+	}
+	return translateNotFound(s.store.Delete(ctx, id))
+}
+
+// SetContent replaces the sections and members of a collection the actor owns. The
+// caller checks that the actor may open every member.
+func (s *Service) SetContent(ctx context.Context, actor Actor, id string, sections []repository.LibraryCollectionSection) error {
+	if _, err := s.editable(ctx, actor, id); err != nil {
+		return err
+	}
+	if len(sections) > s.config.MaxSections {
+		return fmt.Errorf("%w: at most %d sections", ErrInvalidInput, s.config.MaxSections)
+	}
+	seen := make(map[string]bool)
+	for i := range sections {
+		sections[i].Title = strings.TrimSpace(sections[i].Title)
+		if utf8.RuneCountInString(sections[i].Title) > maxTitleLength {
+			return fmt.Errorf("%w: section title is too long", ErrInvalidInput)
+		}
+		for j := range sections[i].Items {
+			item := &sections[i].Items[j]
+			if err := normalizeItem(item); err != nil {
+				return err
+			}
+			key := item.Kind + ":" + item.ItemID
+			if seen[key] {
+				return fmt.Errorf("%w: %s %s is listed twice", ErrInvalidInput, item.Kind, item.ItemID)
+			}
+			seen[key] = true
+		}
+	}
+	if len(seen) > s.config.MaxItems {
+		return fmt.Errorf("%w: at most %d items", ErrInvalidInput, s.config.MaxItems)
+	}
+	return translateNotFound(s.store.ReplaceContent(ctx, id, sections))
+}
+
+// AddItem appends a member to a section of a collection the actor owns; a negative
+// section appends to the last one. The caller checks that the actor may open the member.
+func (s *Service) AddItem(ctx context.Context, actor Actor, id string, section int, item repository.LibraryCollectionItem) error {
+	if _, err := s.editable(ctx, actor, id); err != nil {
+		return err
+	}
+	if err := normalizeItem(&item); err != nil {
+		return err
+	}
+	sections, err := s.store.GetContent(ctx, id)
+	if err != nil {
+		return err
+	}
+	count := 0
+	for _, sec := range sections {
+		for _, existing := range sec.Items {
+			if existing.Kind == item.Kind && existing.ItemID == item.ItemID {
+				return fmt.Errorf("%w: %s %s is already in the collection", ErrInvalidInput, item.Kind, item.ItemID)
+			}
+			count++
+		}
+	}
+	if count >= s.config.MaxItems {
+		return fmt.Errorf("%w: at most %d items", ErrInvalidInput, s.config.MaxItems)
+	}
+	if err := s.store.AppendItem(ctx, id, section, item); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("%w: section %d does not exist", ErrInvalidInput, section)
+		}
+		return err
+	}
+	return nil
+}
+
+// Describe loads the title and access rules of items about to be added, keyed by
+// ProgressKey; unknown items are missing from the map
+func (s *Service) Describe(ctx context.Context, refs []repository.LibraryCollectionItem) (map[string]repository.LibraryCollectionItem, error) {
+	items, err := s.store.DescribeItems(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+	described := make(map[string]repository.LibraryCollectionItem, len(items))
+	for _, item := range items {
+		described[ProgressKey(item.Kind, item.ItemID)] = item
+	}
+	return described, nil
+}
+
+// Copy adds a private copy of a collection the actor may view to their collections
+func (s *Service) Copy(ctx context.Context, actor Actor, id, title string) (*repository.LibraryCollection, error) {
+	if actor.UserID == "" {
+		return nil, ErrPermissionDenied
+	}
+	source, err := s.viewable(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = source.Title
+	}
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return nil, fmt.Errorf("%w: title is too long", ErrInvalidInput)
+	}
+	c, err := s.store.Copy(ctx, id, actor.UserID, title)
+	return c, translateNotFound(err)
+}
+
+// SetProgress marks a member of a collection the actor may view as done or not done
+func (s *Service) SetProgress(ctx context.Context, actor Actor, id, kind, itemID string, done bool) error {
+	if actor.UserID == "" {
+		return ErrPermissionDenied
+	}
+	if _, err := s.viewable(ctx, actor, id); err != nil {
+		return err
+	}
+	sections, err := s.store.GetContent(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !contains(sections, kind, itemID) {
+		return fmt.Errorf("%w: %s %s is not in the collection", ErrInvalidInput, kind, itemID)
+	}
+	return s.store.SetProgress(ctx, id, actor.UserID, kind, itemID, done)
+}
+
+// Completed returns the members of a collection the user completed, keyed by kind and ID
+func (s *Service) Completed(ctx context.Context, id, userID string) (map[string]time.Time, error) {
+	completed := make(map[string]time.Time)
+	if userID == "" {
+		return completed, nil
+	}
+	progress, err := s.store.ListProgress(ctx, id, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range progress {
+		key := ProgressKey(p.Kind, p.ItemID)
+		if at, ok := completed[key]; !ok || p.CompletedAt.Before(at) {
+			completed[key] = p.CompletedAt
+		}
+	}
+	return completed, nil
+}
+
+// Progress returns per-student progress. The owner, class teachers and admins see every
+// student of the class, or everyone who tracked progress when there is no class; other
+// viewers see only themselves.
+func (s *Service) Progress(ctx context.Context, actor Actor, id string) ([]StudentProgress, error) {
+	c, err := s.viewable(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := s.store.GetContent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var students []StudentProgress
+	if s.canManage(ctx, actor, c) {
+		students, err = s.progressStudents(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+	} else if actor.UserID != "" {
+		students = []StudentProgress{{UserID: actor.UserID}}
+	}
+	if len(students) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]string, 0, len(students))
+	index := make(map[string]int, len(students))
+	for i, student := range students {
+		userIDs = append(userIDs, student.UserID)
+		index[student.UserID] = i
+	}
+	progress, err := s.store.ListProgress(ctx, id, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, sec := range sections {
+		total += len(sec.Items)
+	}
+	seen := make(map[string]bool, len(progress))
+	for _, p := range progress {
+		i, ok := index[p.UserID]
+		key := p.UserID + "|" + ProgressKey(p.Kind, p.ItemID)
+		if !ok || seen[key] || !contains(sections, p.Kind, p.ItemID) {
+			continue
+		}
+		seen[key] = true
+		students[i].Completed = append(students[i].Completed, p)
+	}
+	for i := range students {
+		students[i].Total = total
+		sort.Slice(students[i].Completed, func(a, b int) bool {
+			return students[i].Completed[a].CompletedAt.Before(students[i].Completed[b].CompletedAt)
+		})
+	}
+	return students, nil
+}
+
+// CanEdit reports whether the actor may change the collection
+func (s *Service) CanEdit(actor Actor, c *repository.LibraryCollection) bool {
+	return actor.Role == platformAdmin || (actor.UserID != "" && actor.UserID == c.OwnerID)
+}
+
+// ProgressKey identifies a member in the map returned by Completed
+func ProgressKey(kind, itemID string) string {
+	return kind + ":" + itemID
+}
+
+// progressStudents lists the students a manager follows
+func (s *Service) progressStudents(ctx context.Context, c *repository.LibraryCollection) ([]StudentProgress, error) {
+	if c.Visibility == repository.CollectionVisibilityClass && c.ClassID != "" {
+		members, err := s.classes.ListClassMembers(ctx, c.ClassID)
+		if err != nil {
+			return nil, err
+		}
+		var students []StudentProgress
+		for _, m := range members {
+			if m.Role == classTeacher {
+				continue
+			}
+			students = append(students, StudentProgress{
+				UserID: m.UserID,
+				Name:   strings.TrimSpace(m.FirstName + " " + m.LastName),
+				Email:  m.Email,
+			})
+		}
+		return students, nil
+	}
+
+	userIDs, err := s.store.ListProgressUsers(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	students := make([]StudentProgress, 0, len(userIDs))
+	for _, userID := range userIDs {
+		students = append(students, StudentProgress{UserID: userID})
+	}
+	return students, nil
+}
+
+// apply validates in and copies it onto c
+func (s *Service) apply(ctx context.Context, actor Actor, c *repository.LibraryCollection, in Input) error {
+	title := strings.TrimSpace(in.Title)
+	if title == "" || utf8.RuneCountInString(title) > maxTitleLength {
+		return fmt.Errorf("%w: title is required and at most %d characters", ErrInvalidInput, maxTitleLength)
+	}
+	description := strings.TrimSpace(in.Description)
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return fmt.Errorf("%w: description is too long", ErrInvalidInput)
+	}
+
+	visibility := strings.ToLower(strings.TrimSpace(in.Visibility))
+	classID := strings.TrimSpace(in.ClassID)
+	switch visibility {
+	case "", repository.CollectionVisibilityPrivate:
+		visibility = repository.CollectionVisibilityPrivate
+		classID = ""
+	case repository.CollectionVisibilityClass:
+		if classID == "" {
+			return fmt.Errorf("%w: class id is required for class collections", ErrInvalidInput)
+		}
+		if classID != c.ClassID || c.Visibility != repository.CollectionVisibilityClass {
+			if actor.Role != platformAdmin && s.classRole(ctx, classID, actor.UserID) != classTeacher {
+				return fmt.Errorf("%w: only teachers of the class can share with it", ErrPermissionDenied)
+			}
+		}
+	case repository.CollectionVisibilityPublic:
+		if c.Visibility != repository.CollectionVisibilityPublic && !publishRoles[actor.Role] {
+			return fmt.Errorf("%w: only teachers can publish collections", ErrPermissionDenied)
+		}
+		classID = ""
+	default:
+		return fmt.Errorf("%w: visibility must be private, class or public", ErrInvalidInput)
+	}
+
+	c.Title = title
+	c.Description = description
+	c.Visibility = visibility
+	c.ClassID = classID
+	return nil
+}
+
+// viewable loads a collection the actor may view
+func (s *Service) viewable(ctx context.Context, actor Actor, id string) (*repository.LibraryCollection, error) {
+	c, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, translateNotFound(err)
+	}
+	switch {
+	case s.CanEdit(actor, c), c.Visibility == repository.CollectionVisibilityPublic:
+		return c, nil
+	case c.Visibility == repository.CollectionVisibilityClass && c.ClassID != "":
+		if s.classRole(ctx, c.ClassID, actor.UserID) != "" {
+			return c, nil
+		}
+	}
+	// Private collections are not revealed to other users
+	return nil, ErrNotFound
+}
+
+// editable loads a collection the actor may change
+func (s *Service) editable(ctx context.Context, actor Actor, id string) (*repository.LibraryCollection, error) {
+	c, err := s.viewable(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+	if !s.CanEdit(actor, c) {
+		return nil, ErrPermissionDenied
+	}
+	return c, nil
+}
+
+// canManage reports whether the actor may follow the progress of every student
+func (s *Service) canManage(ctx context.Context, actor Actor, c *repository.LibraryCollection) bool {
+	if s.CanEdit(actor, c) {
+		return true
+	}
+	return c.Visibility == repository.CollectionVisibilityClass && c.ClassID != "" &&
+		s.classRole(ctx, c.ClassID, actor.UserID) == classTeacher
+}
+
+// classRole returns the user's role in the class, or "" when they are not a member
+func (s *Service) classRole(ctx context.Context, classID, userID string) string {
+	if userID == "" || s.classes == nil {
+		return ""
+	}
+	role, err := s.classes.GetClassRole(ctx, classID, userID)
+	if err != nil {
+		return ""
+	}
+	return role
+}
+
+func normalizeItem(item *repository.LibraryCollectionItem) error {
+	item.Kind = strings.TrimSpace(item.Kind)
+	item.ItemID = strings.TrimSpace(item.ItemID)
+	item.Note = strings.TrimSpace(item.Note)
+	if item.Kind != repository.CollectionItemLibrary && item.Kind != repository.CollectionItemQuestionSet {
+		return fmt.Errorf("%w: item kind must be %s or %s", ErrInvalidInput,
+			repository.CollectionItemLibrary, repository.CollectionItemQuestionSet)
+	}
+	if item.ItemID == "" {
+		return fmt.Errorf("%w: item id is required", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(item.Note) > maxNoteLength {
+		return fmt.Errorf("%w: note is too long", ErrInvalidInput)
+	}
+	return nil
+}
+
+func contains(sections []repository.LibraryCollectionSection, kind, itemID string) bool {
+	for _, sec := range sections {
+		for _, item := range sec.Items {
+			if item.Kind == kind && item.ItemID == itemID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func translateNotFound(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,365 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+// memoryStore keeps collections in maps keyed like the database tables
+type memoryStore struct {
+	collections map[string]*repository.LibraryCollection
+	content     map[string][]repository.LibraryCollectionSection
+	progress    map[string][]repository.LibraryCollectionProgress // collection -> rows
+	roster      map[string]map[string]string                      // class -> user -> role
+	nextID      int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		collections: make(map[string]*repository.LibraryCollection),
+		content:     make(map[string][]repository.LibraryCollectionSection),
+		progress:    make(map[string][]repository.LibraryCollectionProgress),
+		roster:      make(map[string]map[string]string),
+	}
+}
+
+func (m *memoryStore) Create(ctx context.Context, c *repository.LibraryCollection) error {
+	m.nextID++
+	c.ID = fmt.Sprintf("col-%d", m.nextID)
+	stored := *c
+	m.collections[c.ID] = &stored
+	return nil
+}
+
+func (m *memoryStore) Get(ctx context.Context, id string) (*repository.LibraryCollection, error) {
+	c, ok := m.collections[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (m *memoryStore) Update(ctx context.Context, c *repository.LibraryCollection) error {
+	if _, ok := m.collections[c.ID]; !ok {
+		return repository.ErrNotFound
+	}
+	stored := *c
+	m.collections[c.ID] = &stored
+	return nil
+}
+
+func (m *memoryStore) Delete(ctx context.Context, id string) error {
+	delete(m.collections, id)
+	delete(m.content, id)
+	delete(m.progress, id)
+	return nil
+}
+
+func (m *memoryStore) ListForUser(ctx context.Context, userID string, limit, offset int) ([]*repository.LibraryCollection, error) {
+	var out []*repository.LibraryCollection
+	for _, c := range m.collections {
+		if c.OwnerID == userID || (c.Visibility == repository.CollectionVisibilityClass && m.roster[c.ClassID][userID] != "") {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryStore) ListPublic(ctx context.Context, limit, offset int) ([]*repository.LibraryCollection, error) {
+	var out []*repository.LibraryCollection
+	for _, c := range m.collections {
+		if c.Visibility == repository.CollectionVisibilityPublic {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryStore) GetContent(ctx context.Context, id string) ([]repository.LibraryCollectionSection, error) {
+	return m.content[id], nil
+}
+
+func (m *memoryStore) DescribeItems(ctx context.Context, refs []repository.LibraryCollectionItem) ([]repository.LibraryCollectionItem, error) {
+	return refs, nil
+}
+
+func (m *memoryStore) ReplaceContent(ctx context.Context, id string, sections []repository.LibraryCollectionSection) error {
+	m.content[id] = sections
+	return nil
+}
+
+func (m *memoryStore) AppendItem(ctx context.Context, id string, section int, item repository.LibraryCollectionItem) error {
+	sections := m.content[id]
+	if section < 0 {
+		if len(sections) == 0 {
+			sections = append(sections, repository.LibraryCollectionSection{})
+		}
+		section = len(sections) - 1
+	}
+	if section >= len(sections) {
+		return repository.ErrNotFound
+	}
+	sections[section].Items = append(sections[section].Items, item)
+	m.content[id] = sections
+	return nil
+}
+
+func (m *memoryStore) Copy(ctx context.Context, sourceID, ownerID, title string) (*repository.LibraryCollection, error) {
+	source, ok := m.collections[sourceID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	c := &repository.LibraryCollection{
+		OwnerID:      ownerID,
+		Title:        title,
+		Description:  source.Description,
+		Visibility:   repository.CollectionVisibilityPrivate,
+		CopiedFromID: sourceID,
+	}
+	_ = m.Create(ctx, c)
+	m.content[c.ID] = append([]repository.LibraryCollectionSection(nil), m.content[sourceID]...)
+	return c, nil
+}
+
+func (m *memoryStore) SetProgress(ctx context.Context, id, userID, kind, itemID string, done bool) error {
+	rows := m.progress[id][:0:0]
+	for _, p := range m.progress[id] {
+		if p.UserID != userID || p.Kind != kind || p.ItemID != itemID {
+			rows = append(rows, p)
+		}
+	}
+	if done {
+		rows = append(rows, repository.LibraryCollectionProgress{UserID: userID, Kind: kind, ItemID: itemID, CompletedAt: time.Now()})
+	}
+	m.progress[id] = rows
+	return nil
+}
+
+func (m *memoryStore) ListProgress(ctx context.Context, id string, userIDs []string) ([]repository.LibraryCollectionProgress, error) {
+	wanted := make(map[string]bool, len(userIDs))
+	for _, u := range userIDs {
+		wanted[u] = true
+	}
+	var out []repository.LibraryCollectionProgress
+	for _, p := range m.progress[id] {
+		if wanted[p.UserID] {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryStore) ListProgressUsers(ctx context.Context, id string) ([]string, error) {
+	seen := make(map[string]bool)
+	var out []string
+	for _, p := range m.progress[id] {
+		if !seen[p.UserID] {
+			seen[p.UserID] = true
+			out = append(out, p.UserID)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryStore) GetClassRole(ctx context.Context, classID, userID string) (string, error) {
+	if role, ok := m.roster[classID][userID]; ok {
+		return role, nil
+	}
+	return "", repository.ErrNotFound
+}
+
+func (m *memoryStore) ListClassMembers(ctx context.Context, classID string) ([]*repository.ClassMember, error) {
+	var members []*repository.ClassMember
+	for userID, role := range m.roster[classID] {
+		members = append(members, &repository.ClassMember{ClassID: classID, UserID: userID, Role: role})
+	}
+	return members, nil
+}
+
+var (
+	teacher = Actor{UserID: "teacher", Role: "TEACHER"}
+	student = Actor{UserID: "student", Role: "STUDENT"}
+	other   = Actor{UserID: "other", Role: "STUDENT"}
+	admin   = Actor{UserID: "admin", Role: "ADMIN"}
+)
+
+func newTestService() (*Service, *memoryStore) {
+	store := newMemoryStore()
+	store.roster["class-1"] = map[string]string{"teacher": "TEACHER", "student": "STUDENT"}
+	return NewService(store, store, Config{MaxItems: 3}), store
+}
+
+func libraryItem(id string) repository.LibraryCollectionItem {
+	return repository.LibraryCollectionItem{Kind: repository.CollectionItemLibrary, ItemID: id}
+}
+
+func TestCreate_VisibilityRules(t *testing.T) {
+	service, _ := newTestService()
+	ctx := context.Background()
+
+	if _, err := service.Create(ctx, student, Input{Title: "Ôn thi", Visibility: "public"}); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("student publishing: got %v, want ErrPermissionDenied", err)
+	}
+	if _, err := service.Create(ctx, student, Input{Title: "Ôn thi", Visibility: "class", ClassID: "class-1"}); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("student sharing with class: got %v, want ErrPermissionDenied", err)
+	}
+	if _, err := service.Create(ctx, teacher, Input{Title: "Ôn thi", Visibility: "class"}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("class without id: got %v, want ErrInvalidInput", err)
+	}
+	if _, err := service.Create(ctx, teacher, Input{Title: " ", Visibility: "private"}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("empty title: got %v, want ErrInvalidInput", err)
+	}
+
+	c, err := service.Create(ctx, teacher, Input{Title: "Ôn thi", Visibility: "class", ClassID: "class-1"})
+	if err != nil {
+		t.Fatalf("teacher sharing with class: %v", err)
+	}
+	if c.OwnerID != "teacher" || c.ClassID != "class-1" {
+		t.Fatalf("unexpected collection %+v", c)
+	}
+
+	private, err := service.Create(ctx, student, Input{Title: "Của tôi", ClassID: "class-1"})
+	if err != nil {
+		t.Fatalf("private collection: %v", err)
+	}
+	if private.Visibility != repository.CollectionVisibilityPrivate || private.ClassID != "" {
+		t.Fatalf("private collection keeps class: %+v", private)
+	}
+}
+
+func TestGet_Visibility(t *testing.T) {
+	service, _ := newTestService()
+	ctx := context.Background()
+
+	private, _ := service.Create(ctx, student, Input{Title: "Private"})
+	shared, _ := service.Create(ctx, teacher, Input{Title: "Class", Visibility: "class", ClassID: "class-1"})
+	public, _ := service.Create(ctx, teacher, Input{Title: "Public", Visibility: "public"})
+
+	if _, _, err := service.Get(ctx, other, private.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("private for other user: got %v, want ErrNotFound", err)
+	}
+	if _, _, err := service.Get(ctx, admin, private.ID); err != nil {
+		t.Fatalf("private for admin: %v", err)
+	}
+	if _, _, err := service.Get(ctx, student, shared.ID); err != nil {
+		t.Fatalf("class collection for class member: %v", err)
+	}
+	if _, _, err := service.Get(ctx, other, shared.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("class collection for outsider: got %v, want ErrNotFound", err)
+	}
+	if _, _, err := service.Get(ctx, Actor{}, public.ID); err != nil {
+		t.Fatalf("public collection for guest: %v", err)
+	}
+	if err := service.Delete(ctx, student, shared.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("class member deleting: got %v, want ErrPermissionDenied", err)
+	}
+}
+
+func TestSetContent_ValidatesMembers(t *testing.T) {
+	service, _ := newTestService()
+	ctx := context.Background()
+	c, _ := service.Create(ctx, teacher, Input{Title: "Pack"})
+
+	dup := []repository.LibraryCollectionSection{
+		{Title: "Chương 1", Items: []repository.LibraryCollectionItem{libraryItem("a")}},
+		{Title: "Chương 2", Items: []repository.LibraryCollectionItem{libraryItem("a")}},
+	}
+	if err := service.SetContent(ctx, teacher, c.ID, dup); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("duplicate member: got %v, want ErrInvalidInput", err)
+	}
+	tooMany := []repository.LibraryCollectionSection{{Items: []repository.LibraryCollectionItem{
+		libraryItem("a"), libraryItem("b"), libraryItem("c"), libraryItem("d"),
+	}}}
+	if err := service.SetContent(ctx, teacher, c.ID, tooMany); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("too many members: got %v, want ErrInvalidInput", err)
+	}
+	badKind := []repository.LibraryCollectionSection{{Items: []repository.LibraryCollectionItem{{Kind: "video", ItemID: "a"}}}}
+	if err := service.SetContent(ctx, teacher, c.ID, badKind); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("unknown kind: got %v, want ErrInvalidInput", err)
+	}
+
+	if err := service.AddItem(ctx, teacher, c.ID, -1, libraryItem("a")); err != nil {
+		t.Fatalf("add to empty collection: %v", err)
+	}
+	if err := service.AddItem(ctx, teacher, c.ID, -1, libraryItem("a")); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("add duplicate: got %v, want ErrInvalidInput", err)
+	}
+	if err := service.AddItem(ctx, teacher, c.ID, 5, libraryItem("b")); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("add to missing section: got %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestCopy_MakesPrivateCopy(t *testing.T) {
+	service, store := newTestService()
+	ctx := context.Background()
+	public, _ := service.Create(ctx, teacher, Input{Title: "Public", Visibility: "public"})
+	_ = service.SetContent(ctx, teacher, public.ID, []repository.LibraryCollectionSection{
+		{Items: []repository.LibraryCollectionItem{libraryItem("a")}},
+	})
+
+	copied, err := service.Copy(ctx, other, public.ID, "")
+	if err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if copied.OwnerID != "other" || copied.Visibility != repository.CollectionVisibilityPrivate ||
+		copied.CopiedFromID != public.ID || copied.Title != "Public" {
+		t.Fatalf("unexpected copy %+v", copied)
+	}
+	if len(store.content[copied.ID]) != 1 {
+		t.Fatalf("copy has %d sections, want 1", len(store.content[copied.ID]))
+	}
+
+	private, _ := service.Create(ctx, student, Input{Title: "Private"})
+	if _, err := service.Copy(ctx, other, private.ID, ""); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("copy private collection: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestProgress_ClassTeacherSeesStudents(t *testing.T) {
+	service, _ := newTestService()
+	ctx := context.Background()
+	c, _ := service.Create(ctx, teacher, Input{Title: "Class", Visibility: "class", ClassID: "class-1"})
+	_ = service.SetContent(ctx, teacher, c.ID, []repository.LibraryCollectionSection{
+		{Items: []repository.LibraryCollectionItem{libraryItem("a"), libraryItem("b")}},
+	})
+
+	if err := service.SetProgress(ctx, student, c.ID, repository.CollectionItemLibrary, "x", true); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("progress on non-member: got %v, want ErrInvalidInput", err)
+	}
+	if err := service.SetProgress(ctx, student, c.ID, repository.CollectionItemLibrary, "a", true); err != nil {
+		t.Fatalf("set progress: %v", err)
+	}
+
+	students, err := service.Progress(ctx, teacher, c.ID)
+	if err != nil {
+		t.Fatalf("progress: %v", err)
+	}
+	if len(students) != 1 || students[0].UserID != "student" {
+		t.Fatalf("teacher sees %+v, want only the class student", students)
+	}
+	if len(students[0].Completed) != 1 || students[0].Total != 2 {
+		t.Fatalf("student progress %d/%d, want 1/2", len(students[0].Completed), students[0].Total)
+	}
+
+	completed, err := service.Completed(ctx, c.ID, "student")
+	if err != nil {
+		t.Fatalf("completed: %v", err)
+	}
+	if _, ok := completed[ProgressKey(repository.CollectionItemLibrary, "a")]; !ok || len(completed) != 1 {
+		t.Fatalf("completed = %v, want item a", completed)
+	}
+
+	own, err := service.Progress(ctx, student, c.ID)
+	if err != nil {
+		t.Fatalf("own progress: %v", err)
+	}
+	if len(own) != 1 || own[0].UserID != "student" {
+		t.Fatalf("student sees %+v, want only themselves", own)
+	}
+}
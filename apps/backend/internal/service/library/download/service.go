@@ -0,0 +1,326 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/pdf"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/storage"
+	"exam-bank-system/apps/backend/internal/validation"
+)
+
+// DefaultLinkTTL is how long a signed download link stays valid
+const DefaultLinkTTL = 5 * time.Minute
+
+var (
+	ErrInvalidLink     = errors.New("invalid or expired download link")
+	ErrUnsupportedItem = errors.New("item type has no downloadable file")
+	ErrFileUnavailable = errors.New("item has no downloadable file")
+	ErrFileTooLarge    = errors.New("file is too large to watermark")
+	ErrInvalidGrant    = errors.New("download grant requires item, type and user")
+)
+
+// BookGetter loads library books
+type BookGetter interface {
+	GetBook(ctx context.Context, id string) (*entity.Book, error)
+}
+
+// ExamGetter loads library exams
+type ExamGetter interface {
+	GetByID(ctx context.Context, id string) (*entity.LibraryExam, error)
+}
+
+// UserGetter loads the downloader named in the watermark
+type UserGetter interface {
+	GetByID(ctx context.Context, id string) (*repository.User, error)
+}
+
+// Config controls link signing and file delivery
+type Config struct {
+	BaseURL     string        // Absolute URL the handler is mounted at, e.g. http://localhost:8080/downloads
+	SigningKey  string        // HMAC key; a random key is used when empty
+	LinkTTL     time.Duration // Lifetime of signed links
+	MaxFileSize int64         // Largest file read into memory for stamping
+}
+
+// Grant names what a signed link downloads and for whom
+type Grant struct {
+	ItemID   string `json:"item"`
+	ItemType string `json:"type"`
+	UserID   string `json:"user"`
+}
+
+// token is the signed link payload; file locations and personal data stay server side
+type token struct {
+	Grant
+	Expires int64 `json:"exp"`
+}
+
+// Service issues short-lived signed download links for library books and exams and
+// serves them, stamping every PDF page with the downloader's name, email and time
+type Service struct {
+	cfg       Config
+	baseURL   string
+	mountPath string
+	key       []byte
+
+	books  BookGetter
+	exams  ExamGetter
+	users  UserGetter
+	blobs  storage.BlobStore // Optional; library/ file IDs are read from it
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewService validates the configuration; blobs may be nil
+func NewService(cfg Config, books BookGetter, exams ExamGetter, users UserGetter, blobs storage.BlobStore) (*Service, error) {
+	base, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil || base.Scheme == "" || base.Host == "" || base.Path == "" {
+		return nil, fmt.Errorf("download base URL must be absolute and include a path, got %q", cfg.BaseURL)
+	}
+	if cfg.LinkTTL <= 0 {
+		cfg.LinkTTL = DefaultLinkTTL
+	}
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = validation.MaxPDFSize
+	}
+
+	key := []byte(cfg.SigningKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		log.Printf("[WARN] [Download] No download signing key configured; links will not survive a restart")
+	}
+
+	return &Service{
+		cfg:       cfg,
+		baseURL:   base.String(),
+		mountPath: base.Path,
+		key:       key,
+		books:     books,
+		exams:     exams,
+		users:     users,
+		blobs:     blobs,
+		client:    &http.Client{Timeout: 60 * time.Second},
+		now:       time.Now,
+	}, nil
+}
+
+// MountPath is the URL path the HTTP handler must be mounted at
+func (s *Service) MountPath() string {
+	return s.mountPath
+}
+
+// SignedURL returns a link that downloads the item for the user until it expires
+func (s *Service) SignedURL(grant Grant) (string, time.Time, error) {
+	grant.ItemType = strings.ToLower(grant.ItemType)
+	if grant.ItemID == "" || grant.UserID == "" || grant.ItemType == "" {
+		return "", time.Time{}, ErrInvalidGrant
+	}
+	if grant.ItemType != "book" && grant.ItemType != "exam" {
+		return "", time.Time{}, ErrUnsupportedItem
+	}
+
+	expires := s.now().Add(s.cfg.LinkTTL).Truncate(time.Second)
+	payload, err := json.Marshal(token{Grant: grant, Expires: expires.Unix()})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return s.baseURL + "/" + encoded + "." + s.sign(encoded), expires, nil
+}
+
+// Verify checks the signature and expiry of a link token
+func (s *Service) Verify(raw string) (Grant, error) {
+	encoded, signature, ok := strings.Cut(raw, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.sign(encoded))) {
+		return Grant{}, ErrInvalidLink
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Grant{}, ErrInvalidLink
+	}
+	var t token
+	if err := json.Unmarshal(payload, &t); err != nil || s.now().Unix() > t.Expires {
+		return Grant{}, ErrInvalidLink
+	}
+	return t.Grant, nil
+}
+
+func (s *Service) sign(encoded string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHTTP serves signed links. PDFs are watermarked per request and never served
+// unstamped; other files are passed through unchanged.
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	raw, ok := strings.CutPrefix(r.URL.Path, s.mountPath+"/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	grant, err := s.Verify(raw)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	ctx := r.Context()
+	user, err := s.users.GetByID(ctx, grant.UserID)
+	if err != nil || user == nil {
+		http.Error(w, ErrInvalidLink.Error(), http.StatusForbidden)
+		return
+	}
+	title, content, err := s.fetch(ctx, grant)
+	switch {
+	case errors.Is(err, repository.ErrNotFound), errors.Is(err, ErrFileUnavailable), errors.Is(err, storage.ErrBlobNotFound):
+		http.NotFound(w, r)
+		return
+	case errors.Is(err, ErrFileTooLarge):
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	case err != nil:
+		log.Printf("[WARN] [Download] Failed to fetch %s %s: %v", grant.ItemType, grant.ItemID, err)
+		http.Error(w, "failed to fetch file", http.StatusBadGateway)
+		return
+	}
+
+	contentType := http.DetectContentType(content)
+	filename := safeFilename(title)
+	if isPDF(content) {
+		content, err = pdf.Stamp(content, watermarkFor(user, s.now()))
+		if err != nil {
+			log.Printf("[WARN] [Download] Failed to watermark %s %s: %v", grant.ItemType, grant.ItemID, err)
+			http.Error(w, "failed to prepare download", http.StatusInternalServerError)
+			return
+		}
+		contentType = "application/pdf"
+		filename += ".pdf"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+}
+
+// fetch loads the item's file from the blob store or its external URL
+func (s *Service) fetch(ctx context.Context, grant Grant) (string, []byte, error) {
+	var title, fileID, fileURL string
+	switch grant.ItemType {
+	case "book":
+		book, err := s.books.GetBook(ctx, grant.ItemID)
+		if err != nil {
+			return "", nil, err
+		}
+		title, fileID, fileURL = book.Title, book.FileID.String, book.FileURL.String
+	case "exam":
+		exam, err := s.exams.GetByID(ctx, grant.ItemID)
+		if err != nil {
+			return "", nil, err
+		}
+		title, fileID, fileURL = exam.Title, exam.FileID.String, exam.FileURL.String
+	default:
+		return "", nil, ErrUnsupportedItem
+	}
+
+	var body io.ReadCloser
+	switch {
+	case s.blobs != nil && strings.HasPrefix(fileID, storage.LibraryPrefix):
+		content, _, err := s.blobs.Get(ctx, fileID)
+		if err != nil {
+			return "", nil, err
+		}
+		body = content
+	case strings.HasPrefix(fileURL, "https://") || strings.HasPrefix(fileURL, "http://"):
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+		if err != nil {
+			return "", nil, err
+		}
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return "", nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return "", nil, fmt.Errorf("file URL returned %s", resp.Status)
+		}
+		body = resp.Body
+	default:
+		return "", nil, ErrFileUnavailable
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(body, s.cfg.MaxFileSize+1))
+	if err != nil {
+		return "", nil, err
+	}
+	if int64(len(content)) > s.cfg.MaxFileSize {
+		return "", nil, ErrFileTooLarge
+	}
+	return title, content, nil
+}
+
+func watermarkFor(user *repository.User, at time.Time) pdf.Watermark {
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+	if name == "" {
+		name = user.Username
+	}
+	return pdf.Watermark{
+		Footer: fmt.Sprintf("Downloaded by %s <%s> on %s. For personal use only.",
+			name, user.Email, at.UTC().Format("2006-01-02 15:04 UTC")),
+		Diagonal: user.Email,
+	}
+}
+
+func isPDF(content []byte) bool {
+	head := content
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	return bytes.Contains(head, []byte("%PDF-"))
+}
+
+// safeFilename keeps a readable download name without path or header metacharacters
+func safeFilename(title string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20, r == 0x7f, strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(title))
+	if len([]rune(name)) > 120 {
+		name = string([]rune(name)[:120])
+	}
+	if name == "" {
+		name = "download"
+	}
+	return name
+}
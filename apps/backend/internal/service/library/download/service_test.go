@@ -0,0 +1,184 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/pdf"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBooks map[string]*entity.Book
+
+func (f fakeBooks) GetBook(ctx context.Context, id string) (*entity.Book, error) {
+	if book, ok := f[id]; ok {
+		return book, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+type fakeExams map[string]*entity.LibraryExam
+
+func (f fakeExams) GetByID(ctx context.Context, id string) (*entity.LibraryExam, error) {
+	if exam, ok := f[id]; ok {
+		return exam, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+type fakeUsers map[string]*repository.User
+
+func (f fakeUsers) GetByID(ctx context.Context, id string) (*repository.User, error) {
+	if user, ok := f[id]; ok {
+		return user, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func minimalPDF() []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents 4 0 R >>",
+		"<< /Length 17 >>\nstream\n0 0 m 10 10 l S\n\nendstream",
+	}
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, body := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, body)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n\r\n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func newTestService(t *testing.T) (*Service, storage.BlobStore) {
+	blobs, err := storage.NewLocalBlobStore(t.TempDir(), "http://localhost:8080/blobs", "blob-key")
+	require.NoError(t, err)
+	ctx := context.Background()
+	_, err = blobs.Put(ctx, "library/book/2026/10/algebra.pdf", bytes.NewReader(minimalPDF()), -1, "application/pdf")
+	require.NoError(t, err)
+	_, err = blobs.Put(ctx, "library/exam/2026/10/answers.zip", strings.NewReader("PK\x03\x04 archive"), -1, "application/zip")
+	require.NoError(t, err)
+
+	books := fakeBooks{"book-1": {Title: "Đại số 10", FileID: sql.NullString{String: "library/book/2026/10/algebra.pdf", Valid: true}}}
+	exams := fakeExams{
+		"exam-1": {Title: "Answers", FileID: sql.NullString{String: "library/exam/2026/10/answers.zip", Valid: true}},
+		"exam-2": {Title: "Missing"},
+	}
+	users := fakeUsers{"user-1": {ID: "user-1", FirstName: "Trần", LastName: "An", Email: "an@example.com"}}
+
+	svc, err := NewService(Config{BaseURL: "http://localhost:8080/downloads/", SigningKey: "test-key"}, books, exams, users, blobs)
+	require.NoError(t, err)
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC) }
+	return svc, blobs
+}
+
+func get(svc *Service, link string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	svc.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, strings.TrimPrefix(link, "http://localhost:8080"), nil))
+	return rec
+}
+
+func TestNewService_RequiresMountPath(t *testing.T) {
+	_, err := NewService(Config{BaseURL: "http://localhost:8080"}, fakeBooks{}, fakeExams{}, fakeUsers{}, nil)
+	assert.Error(t, err)
+
+	svc, err := NewService(Config{BaseURL: "http://localhost:8080/downloads/"}, fakeBooks{}, fakeExams{}, fakeUsers{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/downloads", svc.MountPath())
+}
+
+func TestSignedURL_RoundTrip(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	link, expires, err := svc.SignedURL(Grant{ItemID: "book-1", ItemType: "BOOK", UserID: "user-1"})
+	require.NoError(t, err)
+	assert.Equal(t, svc.now().Add(DefaultLinkTTL), expires)
+	assert.NotContains(t, link, "library/")
+
+	raw := strings.TrimPrefix(link, "http://localhost:8080/downloads/")
+	grant, err := svc.Verify(raw)
+	require.NoError(t, err)
+	assert.Equal(t, Grant{ItemID: "book-1", ItemType: "book", UserID: "user-1"}, grant)
+
+	// Tampered payload
+	_, err = svc.Verify("x" + raw)
+	assert.ErrorIs(t, err, ErrInvalidLink)
+
+	// Expired
+	svc.now = func() time.Time { return expires.Add(time.Second) }
+	_, err = svc.Verify(raw)
+	assert.ErrorIs(t, err, ErrInvalidLink)
+
+	_, _, err = svc.SignedURL(Grant{ItemID: "video-1", ItemType: "video", UserID: "user-1"})
+	assert.ErrorIs(t, err, ErrUnsupportedItem)
+	_, _, err = svc.SignedURL(Grant{ItemID: "book-1", ItemType: "book"})
+	assert.ErrorIs(t, err, ErrInvalidGrant)
+}
+
+func TestServeHTTP_StampsPDF(t *testing.T) {
+	svc, _ := newTestService(t)
+	link, _, err := svc.SignedURL(Grant{ItemID: "book-1", ItemType: "book", UserID: "user-1"})
+	require.NoError(t, err)
+
+	rec := get(svc, link)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "filename*=utf-8''")
+
+	doc, err := pdf.Parse(rec.Body.Bytes())
+	require.NoError(t, err)
+	pages, err := doc.Pages()
+	require.NoError(t, err)
+	assert.Len(t, pages, 1)
+
+	// Content streams are written uncompressed, so the stamp is visible in the bytes
+	body := rec.Body.String()
+	assert.Contains(t, body, "(Downloaded by Tran An <an@example.com> on 2026-10-18 09:30 UTC. For personal use only.) Tj")
+	assert.Contains(t, body, "(an@example.com) Tj")
+}
+
+func TestServeHTTP_Rejections(t *testing.T) {
+	svc, _ := newTestService(t)
+
+	link, _, err := svc.SignedURL(Grant{ItemID: "exam-1", ItemType: "exam", UserID: "user-1"})
+	require.NoError(t, err)
+	rec := get(svc, link)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "PK\x03\x04 archive", rec.Body.String())
+
+	link, _, err = svc.SignedURL(Grant{ItemID: "exam-2", ItemType: "exam", UserID: "user-1"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, get(svc, link).Code)
+
+	link, _, err = svc.SignedURL(Grant{ItemID: "book-1", ItemType: "book", UserID: "someone-else"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, get(svc, link).Code)
+
+	assert.Equal(t, http.StatusForbidden, get(svc, "http://localhost:8080/downloads/forged.token").Code)
+
+	rec = httptest.NewRecorder()
+	svc.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/downloads/x", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
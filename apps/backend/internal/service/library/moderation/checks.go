@@ -0,0 +1,96 @@
+package moderation
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/validation"
+)
+
+// Automatic check codes
+const (
+	CheckFile      = "file"      // The item has a file of an allowed type and size
+	CheckDuplicate = "duplicate" // No other item has the same file, or question the same content
+	CheckMetadata  = "metadata"  // Description, category, subject and grade (solution and answers for questions) are set
+)
+
+// Check is the outcome of one automatic check; failed checks are hints for the reviewer
+// and never block a decision
+type Check struct {
+	Code   string
+	Passed bool
+	Detail string
+}
+
+var fileValidator = validation.NewFileValidator()
+
+func runChecks(e repository.ModerationQueueEntry) []Check {
+	var checks []Check
+	if e.TargetType == repository.ModerationTargetLibraryItem {
+		checks = append(checks, checkFile(e))
+	}
+
+	duplicate := Check{Code: CheckDuplicate, Passed: len(e.Duplicates) == 0}
+	if !duplicate.Passed {
+		what := "same file as"
+		if e.TargetType == repository.ModerationTargetQuestion {
+			what = "same content as"
+		}
+		duplicate.Detail = what + " " + strings.Join(e.Duplicates, ", ")
+	}
+	metadata := Check{Code: CheckMetadata, Passed: len(e.Missing) == 0}
+	if !metadata.Passed {
+		metadata.Detail = "missing " + strings.Join(e.Missing, ", ")
+	}
+	return append(checks, duplicate, metadata)
+}
+
+// checkFile validates the extension and size of a library item's file with the limits
+// of new uploads
+func checkFile(e repository.ModerationQueueEntry) Check {
+	check := Check{Code: CheckFile}
+	if e.Kind == "video" && e.YoutubeID != "" {
+		check.Passed = true
+		check.Detail = "YouTube video " + e.YoutubeID
+		return check
+	}
+	if e.File.Empty() {
+		check.Detail = "no file attached"
+		return check
+	}
+
+	fileType := validation.FileTypePDF
+	if e.Kind == "video" {
+		fileType = validation.FileTypeVideo
+	}
+	if err := fileValidator.ValidateExtension(fileExtension(e.File), fileType); err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	if !e.File.Size.Valid {
+		check.Passed = true
+		check.Detail = "file size unknown"
+		return check
+	}
+	if err := fileValidator.ValidateSize(e.File.Size.Int64, fileType); err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	check.Passed = true
+	check.Detail = fmt.Sprintf("%d bytes", e.File.Size.Int64)
+	return check
+}
+
+// fileExtension takes the extension from the blob key, or from the URL path without query
+func fileExtension(file repository.LibraryItemFile) string {
+	if file.ID != "" {
+		return path.Ext(file.ID)
+	}
+	if u, err := url.Parse(file.URL); err == nil {
+		return path.Ext(u.Path)
+	}
+	return path.Ext(file.URL)
+}
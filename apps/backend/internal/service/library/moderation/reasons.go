@@ -0,0 +1,38 @@
+package moderation
+
+// Reason template codes
+const (
+	ReasonDuplicate       = "duplicate"
+	ReasonInvalidFile     = "invalid_file"
+	ReasonMissingMetadata = "missing_metadata"
+	ReasonLowQuality      = "low_quality"
+	ReasonCopyright       = "copyright"
+	ReasonWrongCategory   = "wrong_category"
+	ReasonOther           = "other" // Needs a note
+)
+
+// ReasonTemplate is a standard rejection reason; Message is sent to the submitter
+type ReasonTemplate struct {
+	Code    string
+	Title   string
+	Message string
+}
+
+var reasonTemplates = []ReasonTemplate{
+	{ReasonDuplicate, "Trùng lặp", "Nội dung trùng với tài liệu hoặc câu hỏi đã có."},
+	{ReasonInvalidFile, "Tệp không hợp lệ", "Tệp bị lỗi, sai định dạng hoặc vượt quá dung lượng cho phép."},
+	{ReasonMissingMetadata, "Thiếu thông tin", "Vui lòng bổ sung mô tả, danh mục, môn học, lớp hoặc lời giải."},
+	{ReasonLowQuality, "Chất lượng chưa đạt", "Nội dung chưa đạt yêu cầu chất lượng (mờ, thiếu trang hoặc có lỗi)."},
+	{ReasonCopyright, "Vi phạm bản quyền", "Nội dung có dấu hiệu vi phạm bản quyền."},
+	{ReasonWrongCategory, "Sai phân loại", "Nội dung được xếp sai danh mục, môn học hoặc lớp."},
+	{ReasonOther, "Lý do khác", ""},
+}
+
+func findReason(code string) (ReasonTemplate, bool) {
+	for _, t := range reasonTemplates {
+		if t.Code == code {
+			return t, true
+		}
+	}
+	return ReasonTemplate{}, false
+}
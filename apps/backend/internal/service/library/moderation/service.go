@@ -0,0 +1,393 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+	"exam-bank-system/apps/backend/internal/service/question"
+)
+
+// Errors returned by the moderation service
+var (
+	ErrNotFound     = errors.New("not in the moderation queue")
+	ErrInvalidInput = errors.New("invalid input")
+)
+
+// Defaults used when Config fields are zero
+const (
+	DefaultSLA            = 48 * time.Hour
+	DefaultWorkloadWindow = 30 * 24 * time.Hour
+	DefaultMaxBulk        = 100
+)
+
+const maxNoteLength = 1000
+
+// Store reads the queue and records decisions, implemented by repository.ModerationRepository
+type Store interface {
+	ListQueue(ctx context.Context, filter repository.ModerationQueueFilter) ([]repository.ModerationQueueEntry, int, error)
+	QueueStats(ctx context.Context, overdueBefore time.Time) (*repository.ModerationQueueStats, error)
+	PendingLibraryItem(ctx context.Context, itemID string) (*repository.ModerationSubmission, error)
+	OpenQuestionReview(ctx context.Context, questionID string) (*repository.ModerationSubmission, error)
+	DecideLibraryItem(ctx context.Context, d repository.ModerationDecision) error
+	RecordDecision(ctx context.Context, d repository.ModerationDecision) error
+	Workload(ctx context.Context, since time.Time) ([]repository.ModeratorWorkload, error)
+}
+
+// questionReviewer decides question review rounds, implemented by question.ReviewService
+type questionReviewer interface {
+	SubmitDecision(ctx context.Context, actor question.ReviewActor, reviewID uuid.UUID, decision entity.QuestionReviewStatus, note string) (*entity.QuestionReview, error)
+}
+
+// notifier delivers in-app notifications, implemented by notification.NotificationService
+type notifier interface {
+	CreateNotification(
+		ctx context.Context,
+		userID string,
+		notifType notification.NotificationType,
+		title string,
+		message string,
+		data *notification.NotificationData,
+		expiresIn *time.Duration,
+	) error
+}
+
+// Config holds the SLA and limits of the moderation queue
+type Config struct {
+	SLA            time.Duration // Submissions waiting longer are overdue
+	WorkloadWindow time.Duration // Decisions counted in reviewer workload
+	MaxBulk        int           // Targets per Decide call
+}
+
+// Target is a library item or question in the queue
+type Target struct {
+	Type string // repository.ModerationTargetLibraryItem or ModerationTargetQuestion
+	ID   string
+}
+
+// Decision approves or rejects one or more targets. Rejections need a reason template;
+// the note is appended to the template text and required for ReasonOther.
+type Decision struct {
+	Targets    []Target
+	Approve    bool
+	ReasonCode string
+	Note       string
+}
+
+// Result is the outcome of a decision for one target; bulk decisions continue past
+// failed targets
+type Result struct {
+	Target Target
+	Err    error
+}
+
+// Entry is a queue entry with its age, SLA status and automatic checks
+type Entry struct {
+	repository.ModerationQueueEntry
+
+	Age     time.Duration
+	DueAt   time.Time
+	Overdue bool
+	Checks  []Check
+}
+
+// Queue is a page of the moderation queue
+type Queue struct {
+	Entries []Entry
+	Total   int
+	Stats   repository.ModerationQueueStats
+	SLA     time.Duration
+}
+
+// QueueFilter selects a page of the queue
+type QueueFilter struct {
+	TargetType  string // Empty for library items and questions
+	OverdueOnly bool
+	Limit       int
+	Offset      int
+}
+
+// Service runs the moderation queue of pending library uploads and contributed questions:
+// automatic checks, SLA tracking, bulk approve/reject with reason templates and reviewer
+// workload. Library uploaders are notified here; question authors by the review workflow.
+type Service struct {
+	store     Store
+	questions questionReviewer // Optional
+	notifier  notifier         // Optional
+	config    Config
+	now       func() time.Time
+}
+
+// NewService creates a new moderation service; questions and notifier may be nil
+func NewService(store Store, questions questionReviewer, notifier notifier, config Config) *Service {
+	if config.SLA <= 0 {
+		config.SLA = DefaultSLA
+	}
+	if config.WorkloadWindow <= 0 {
+		config.WorkloadWindow = DefaultWorkloadWindow
+	}
+	if config.MaxBulk <= 0 {
+		config.MaxBulk = DefaultMaxBulk
+	}
+	return &Service{store: store, questions: questions, notifier: notifier, config: config, now: time.Now}
+}
+
+// Queue returns a page of pending submissions, oldest first, with the automatic checks
+func (s *Service) Queue(ctx context.Context, filter QueueFilter) (*Queue, error) {
+	switch filter.TargetType {
+	case "", repository.ModerationTargetLibraryItem, repository.ModerationTargetQuestion:
+	default:
+		return nil, fmt.Errorf("%w: unknown target type %q", ErrInvalidInput, filter.TargetType)
+	}
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 50
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	now := s.now()
+	overdueBefore := now.Add(-s.config.SLA)
+	repoFilter := repository.ModerationQueueFilter{
+		TargetType: filter.TargetType,
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}
+	if filter.OverdueOnly {
+		repoFilter.SubmittedBefore = overdueBefore
+	}
+	entries, total, err := s.store.ListQueue(ctx, repoFilter)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.store.QueueStats(ctx, overdueBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &Queue{Entries: make([]Entry, 0, len(entries)), Total: total, Stats: *stats, SLA: s.config.SLA}
+	for _, e := range entries {
+		due := e.SubmittedAt.Add(s.config.SLA)
+		queue.Entries = append(queue.Entries, Entry{
+			ModerationQueueEntry: e,
+			Age:                  now.Sub(e.SubmittedAt),
+			DueAt:                due,
+			Overdue:              now.After(due),
+			Checks:               runChecks(e),
+		})
+	}
+	return queue, nil
+}
+
+// Decide approves or rejects targets as reviewerID. Invalid input fails the whole call;
+// targets that left the queue or fail individually are reported in their Result.
+func (s *Service) Decide(ctx context.Context, reviewerID string, in Decision) ([]Result, error) {
+	targets, err := s.validTargets(in.Targets)
+	if err != nil {
+		return nil, err
+	}
+	reasonCode, reason, err := reasonFor(in)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := repository.ModerationRejected
+	if in.Approve {
+		decision = repository.ModerationApproved
+	}
+	results := make([]Result, 0, len(targets))
+	for _, target := range targets {
+		d := repository.ModerationDecision{
+			TargetType: target.Type,
+			TargetID:   target.ID,
+			Decision:   decision,
+			ReasonCode: reasonCode,
+			Reason:     reason,
+			ReviewerID: reviewerID,
+		}
+		var err error
+		if target.Type == repository.ModerationTargetLibraryItem {
+			err = s.decideLibraryItem(ctx, d)
+		} else {
+			err = s.decideQuestion(ctx, d)
+		}
+		results = append(results, Result{Target: target, Err: err})
+	}
+	return results, nil
+}
+
+// Reasons returns the reason templates offered when rejecting
+func (s *Service) Reasons() []ReasonTemplate {
+	return append([]ReasonTemplate(nil), reasonTemplates...)
+}
+
+// Workload returns the decisions of every reviewer within the workload window, and the
+// start of the window
+func (s *Service) Workload(ctx context.Context) ([]repository.ModeratorWorkload, time.Time, error) {
+	since := s.now().Add(-s.config.WorkloadWindow)
+	workload, err := s.store.Workload(ctx, since)
+	return workload, since, err
+}
+
+// SLA is the time a submission may wait before it is overdue
+func (s *Service) SLA() time.Duration {
+	return s.config.SLA
+}
+
+func (s *Service) validTargets(targets []Target) ([]Target, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("%w: at least one target is required", ErrInvalidInput)
+	}
+	if len(targets) > s.config.MaxBulk {
+		return nil, fmt.Errorf("%w: at most %d targets per decision", ErrInvalidInput, s.config.MaxBulk)
+	}
+	seen := make(map[Target]bool, len(targets))
+	valid := make([]Target, 0, len(targets))
+	for _, t := range targets {
+		t.ID = strings.TrimSpace(t.ID)
+		if t.ID == "" {
+			return nil, fmt.Errorf("%w: target id is required", ErrInvalidInput)
+		}
+		switch t.Type {
+		case repository.ModerationTargetLibraryItem:
+		case repository.ModerationTargetQuestion:
+			if s.questions == nil {
+				return nil, fmt.Errorf("%w: question reviews are not available", ErrInvalidInput)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown target type %q", ErrInvalidInput, t.Type)
+		}
+		if !seen[t] {
+			seen[t] = true
+			valid = append(valid, t)
+		}
+	}
+	return valid, nil
+}
+
+func (s *Service) decideLibraryItem(ctx context.Context, d repository.ModerationDecision) error {
+	sub, err := s.store.PendingLibraryItem(ctx, d.TargetID)
+	if err != nil {
+		return translateError(err)
+	}
+	s.stamp(&d, sub)
+	if err := s.store.DecideLibraryItem(ctx, d); err != nil {
+		return translateError(err)
+	}
+	s.notifyUploader(ctx, sub, d)
+	return nil
+}
+
+// decideQuestion closes the open review round through the review workflow, which moves the
+// question out of PENDING and notifies its author, then records the decision
+func (s *Service) decideQuestion(ctx context.Context, d repository.ModerationDecision) error {
+	sub, err := s.store.OpenQuestionReview(ctx, d.TargetID)
+	if err != nil {
+		return translateError(err)
+	}
+	reviewID, err := uuid.Parse(sub.ReviewID)
+	if err != nil {
+		return fmt.Errorf("parse review id %q: %w", sub.ReviewID, err)
+	}
+	status := entity.ReviewStatusRejected
+	if d.Decision == repository.ModerationApproved {
+		status = entity.ReviewStatusApproved
+	}
+	actor := question.ReviewActor{UserID: d.ReviewerID, IsAdmin: true}
+	if _, err := s.questions.SubmitDecision(ctx, actor, reviewID, status, d.Reason); err != nil {
+		if errors.Is(err, question.ErrReviewInvalidTransition) || errors.Is(err, question.ErrReviewNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	s.stamp(&d, sub)
+	if err := s.store.RecordDecision(ctx, d); err != nil {
+		log.Printf("[WARN] [Moderation] Failed to record decision on question %s: %v", d.TargetID, err)
+	}
+	return nil
+}
+
+// stamp fills the submission fields and SLA status of a decision
+func (s *Service) stamp(d *repository.ModerationDecision, sub *repository.ModerationSubmission) {
+	d.SubmitterID = sub.SubmitterID
+	d.SubmittedAt = sub.SubmittedAt
+	d.DecidedAt = s.now()
+	d.WithinSLA = d.DecidedAt.Sub(sub.SubmittedAt) <= s.config.SLA
+}
+
+// notifyUploader tells the uploader of a library item about the decision. Failures are
+// logged and never undo the decision.
+func (s *Service) notifyUploader(ctx context.Context, sub *repository.ModerationSubmission, d repository.ModerationDecision) {
+	if s.notifier == nil || sub.SubmitterID == "" || sub.SubmitterID == d.ReviewerID {
+		return
+	}
+	title := "Tài liệu đã được duyệt"
+	message := fmt.Sprintf("\"%s\" đã được duyệt và hiển thị trong thư viện.", sub.Title)
+	priority := notification.PriorityMedium
+	if d.Decision == repository.ModerationRejected {
+		title = "Tài liệu bị từ chối"
+		message = fmt.Sprintf("\"%s\" bị từ chối: %s", sub.Title, d.Reason)
+		priority = notification.PriorityHigh
+	}
+	data := &notification.NotificationData{
+		Priority:   priority,
+		ActionURL:  "/library?item=" + d.TargetID,
+		ActionText: "Xem tài liệu",
+		Metadata: map[string]interface{}{
+			"library_item_id": d.TargetID,
+			"decision":        d.Decision,
+			"reason_code":     d.ReasonCode,
+		},
+	}
+	if err := s.notifier.CreateNotification(ctx, sub.SubmitterID, notification.TypeModeration, title, message, data, nil); err != nil {
+		log.Printf("[WARN] [Moderation] Failed to notify %s about item %s: %v", sub.SubmitterID, d.TargetID, err)
+	}
+}
+
+// reasonFor validates the reason of a decision and returns its code and text
+func reasonFor(in Decision) (string, string, error) {
+	note := strings.TrimSpace(in.Note)
+	if utf8.RuneCountInString(note) > maxNoteLength {
+		return "", "", fmt.Errorf("%w: note is at most %d characters", ErrInvalidInput, maxNoteLength)
+	}
+	code := strings.TrimSpace(in.ReasonCode)
+	if code == "" {
+		if !in.Approve {
+			return "", "", fmt.Errorf("%w: a reason is required to reject", ErrInvalidInput)
+		}
+		return "", note, nil
+	}
+	if in.Approve {
+		return "", "", fmt.Errorf("%w: reason templates only apply to rejections", ErrInvalidInput)
+	}
+
+	template, ok := findReason(code)
+	if !ok {
+		return "", "", fmt.Errorf("%w: unknown reason %q", ErrInvalidInput, code)
+	}
+	if code == ReasonOther && note == "" {
+		return "", "", fmt.Errorf("%w: a note is required for reason %q", ErrInvalidInput, code)
+	}
+	reason := template.Message
+	if note != "" {
+		reason = strings.TrimSpace(reason + " " + note)
+	}
+	return code, reason, nil
+}
+
+func translateError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,270 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+	"exam-bank-system/apps/backend/internal/service/question"
+)
+
+var testNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// memoryStore holds pending library items and open question reviews by ID
+type memoryStore struct {
+	entries   []repository.ModerationQueueEntry
+	pending   map[string]*repository.ModerationSubmission
+	reviews   map[string]*repository.ModerationSubmission
+	decisions []repository.ModerationDecision
+}
+
+func (m *memoryStore) ListQueue(ctx context.Context, filter repository.ModerationQueueFilter) ([]repository.ModerationQueueEntry, int, error) {
+	var out []repository.ModerationQueueEntry
+	for _, e := range m.entries {
+		if filter.SubmittedBefore.IsZero() || e.SubmittedAt.Before(filter.SubmittedBefore) {
+			out = append(out, e)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (m *memoryStore) QueueStats(ctx context.Context, overdueBefore time.Time) (*repository.ModerationQueueStats, error) {
+	return &repository.ModerationQueueStats{PendingItems: len(m.pending), PendingQuestions: len(m.reviews)}, nil
+}
+
+func (m *memoryStore) PendingLibraryItem(ctx context.Context, itemID string) (*repository.ModerationSubmission, error) {
+	if s, ok := m.pending[itemID]; ok {
+		return s, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) OpenQuestionReview(ctx context.Context, questionID string) (*repository.ModerationSubmission, error) {
+	if s, ok := m.reviews[questionID]; ok {
+		return s, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) DecideLibraryItem(ctx context.Context, d repository.ModerationDecision) error {
+	if _, ok := m.pending[d.TargetID]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(m.pending, d.TargetID)
+	m.decisions = append(m.decisions, d)
+	return nil
+}
+
+func (m *memoryStore) RecordDecision(ctx context.Context, d repository.ModerationDecision) error {
+	m.decisions = append(m.decisions, d)
+	return nil
+}
+
+func (m *memoryStore) Workload(ctx context.Context, since time.Time) ([]repository.ModeratorWorkload, error) {
+	return nil, nil
+}
+
+type submittedDecision struct {
+	actor    question.ReviewActor
+	reviewID uuid.UUID
+	decision entity.QuestionReviewStatus
+	note     string
+}
+
+type recordingReviewer struct {
+	decisions []submittedDecision
+}
+
+func (r *recordingReviewer) SubmitDecision(ctx context.Context, actor question.ReviewActor, reviewID uuid.UUID, decision entity.QuestionReviewStatus, note string) (*entity.QuestionReview, error) {
+	r.decisions = append(r.decisions, submittedDecision{actor: actor, reviewID: reviewID, decision: decision, note: note})
+	return &entity.QuestionReview{ID: reviewID, Status: decision}, nil
+}
+
+type recordingNotifier struct {
+	users    []string
+	messages []string
+}
+
+func (n *recordingNotifier) CreateNotification(ctx context.Context, userID string, notifType notification.NotificationType, title, message string, data *notification.NotificationData, expiresIn *time.Duration) error {
+	n.users = append(n.users, userID)
+	n.messages = append(n.messages, message)
+	return nil
+}
+
+func newTestService(store *memoryStore) (*Service, *recordingReviewer, *recordingNotifier) {
+	reviewer := &recordingReviewer{}
+	notifier := &recordingNotifier{}
+	service := NewService(store, reviewer, notifier, Config{SLA: 48 * time.Hour, MaxBulk: 3})
+	service.now = func() time.Time { return testNow }
+	return service, reviewer, notifier
+}
+
+func findCheck(checks []Check, code string) Check {
+	for _, c := range checks {
+		if c.Code == code {
+			return c
+		}
+	}
+	return Check{}
+}
+
+func TestQueue_RunsChecksAndTracksSLA(t *testing.T) {
+	store := &memoryStore{entries: []repository.ModerationQueueEntry{
+		{
+			ModerationSubmission: repository.ModerationSubmission{
+				TargetType: repository.ModerationTargetLibraryItem, TargetID: "old", Kind: "book",
+				SubmittedAt: testNow.Add(-72 * time.Hour),
+			},
+			File:       repository.LibraryItemFile{ID: "library/de-thi.docx", Size: sql.NullInt64{Int64: 1024, Valid: true}},
+			Missing:    []string{"description", "grade"},
+			Duplicates: []string{"item-7"},
+		},
+		{
+			ModerationSubmission: repository.ModerationSubmission{
+				TargetType: repository.ModerationTargetLibraryItem, TargetID: "fresh", Kind: "video",
+				SubmittedAt: testNow.Add(-2 * time.Hour),
+			},
+			YoutubeID: "dQw4w9WgXcQ",
+		},
+		{
+			ModerationSubmission: repository.ModerationSubmission{
+				TargetType: repository.ModerationTargetQuestion, TargetID: "q-1", Kind: "MC",
+				SubmittedAt: testNow.Add(-10 * time.Hour),
+			},
+			Duplicates: []string{"q-0"},
+		},
+	}}
+	service, _, _ := newTestService(store)
+
+	queue, err := service.Queue(context.Background(), QueueFilter{})
+	if err != nil {
+		t.Fatalf("queue: %v", err)
+	}
+	if len(queue.Entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(queue.Entries))
+	}
+
+	old := queue.Entries[0]
+	if !old.Overdue || old.Age != 72*time.Hour || !old.DueAt.Equal(testNow.Add(-24*time.Hour)) {
+		t.Fatalf("old entry SLA: overdue=%v age=%v due=%v", old.Overdue, old.Age, old.DueAt)
+	}
+	if c := findCheck(old.Checks, CheckFile); c.Passed || !strings.Contains(c.Detail, ".docx") {
+		t.Fatalf("docx book should fail the file check: %+v", c)
+	}
+	if c := findCheck(old.Checks, CheckDuplicate); c.Passed || c.Detail != "same file as item-7" {
+		t.Fatalf("duplicate check: %+v", c)
+	}
+	if c := findCheck(old.Checks, CheckMetadata); c.Passed || c.Detail != "missing description, grade" {
+		t.Fatalf("metadata check: %+v", c)
+	}
+
+	fresh := queue.Entries[1]
+	if fresh.Overdue {
+		t.Fatalf("fresh entry is overdue")
+	}
+	for _, c := range fresh.Checks {
+		if !c.Passed {
+			t.Fatalf("YouTube video failed check %+v", c)
+		}
+	}
+
+	q := queue.Entries[2]
+	if len(q.Checks) != 2 || findCheck(q.Checks, CheckFile).Code != "" {
+		t.Fatalf("questions have no file check: %+v", q.Checks)
+	}
+	if c := findCheck(q.Checks, CheckDuplicate); c.Detail != "same content as q-0" {
+		t.Fatalf("question duplicate check: %+v", c)
+	}
+
+	overdue, err := service.Queue(context.Background(), QueueFilter{OverdueOnly: true})
+	if err != nil {
+		t.Fatalf("overdue queue: %v", err)
+	}
+	if len(overdue.Entries) != 1 || overdue.Entries[0].TargetID != "old" {
+		t.Fatalf("overdue filter returned %d entries", len(overdue.Entries))
+	}
+}
+
+func TestDecide_ValidatesReasonAndTargets(t *testing.T) {
+	service, _, _ := newTestService(&memoryStore{})
+	ctx := context.Background()
+	item := []Target{{Type: repository.ModerationTargetLibraryItem, ID: "item-1"}}
+
+	cases := map[string]Decision{
+		"reject without reason":  {Targets: item},
+		"unknown reason":         {Targets: item, ReasonCode: "spam"},
+		"other without note":     {Targets: item, ReasonCode: ReasonOther},
+		"approve with reason":    {Targets: item, Approve: true, ReasonCode: ReasonDuplicate},
+		"no targets":             {Approve: true},
+		"unknown target type":    {Targets: []Target{{Type: "video", ID: "v"}}, Approve: true},
+		"more than bulk maximum": {Targets: []Target{{"library_item", "a"}, {"library_item", "b"}, {"library_item", "c"}, {"library_item", "d"}}, Approve: true},
+	}
+	for name, in := range cases {
+		if _, err := service.Decide(ctx, "admin", in); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("%s: got %v, want ErrInvalidInput", name, err)
+		}
+	}
+}
+
+func TestDecide_BulkRejectsItemsAndQuestions(t *testing.T) {
+	reviewID := uuid.New()
+	store := &memoryStore{
+		pending: map[string]*repository.ModerationSubmission{
+			"item-1": {TargetType: repository.ModerationTargetLibraryItem, TargetID: "item-1", Title: "Đề thi thử",
+				SubmitterID: "teacher-1", SubmittedAt: testNow.Add(-24 * time.Hour)},
+		},
+		reviews: map[string]*repository.ModerationSubmission{
+			"q-1": {TargetType: repository.ModerationTargetQuestion, TargetID: "q-1", ReviewID: reviewID.String(),
+				SubmitterID: "teacher-2", SubmittedAt: testNow.Add(-60 * time.Hour)},
+		},
+	}
+	service, reviewer, notifier := newTestService(store)
+
+	results, err := service.Decide(context.Background(), "admin", Decision{
+		Targets: []Target{
+			{Type: repository.ModerationTargetLibraryItem, ID: "item-1"},
+			{Type: repository.ModerationTargetQuestion, ID: "q-1"},
+			{Type: repository.ModerationTargetLibraryItem, ID: "gone"},
+		},
+		ReasonCode: ReasonDuplicate,
+		Note:       "Trùng với đề năm 2024",
+	})
+	if err != nil {
+		t.Fatalf("decide: %v", err)
+	}
+	if results[0].Err != nil || results[1].Err != nil || !errors.Is(results[2].Err, ErrNotFound) {
+		t.Fatalf("results: %+v", results)
+	}
+
+	if len(store.decisions) != 2 {
+		t.Fatalf("recorded %d decisions, want 2", len(store.decisions))
+	}
+	for _, d := range store.decisions {
+		if d.Decision != repository.ModerationRejected || d.ReasonCode != ReasonDuplicate || !strings.HasSuffix(d.Reason, "Trùng với đề năm 2024") {
+			t.Fatalf("unexpected decision %+v", d)
+		}
+	}
+	if !store.decisions[0].WithinSLA || store.decisions[1].WithinSLA {
+		t.Fatalf("SLA: item within=%v, question within=%v", store.decisions[0].WithinSLA, store.decisions[1].WithinSLA)
+	}
+
+	if len(reviewer.decisions) != 1 {
+		t.Fatalf("review decisions = %d, want 1", len(reviewer.decisions))
+	}
+	if rd := reviewer.decisions[0]; rd.reviewID != reviewID || rd.decision != entity.ReviewStatusRejected || !rd.actor.IsAdmin || rd.note == "" {
+		t.Fatalf("unexpected review decision %+v", rd)
+	}
+
+	// Question authors are notified by the review workflow
+	if len(notifier.users) != 1 || notifier.users[0] != "teacher-1" || !strings.Contains(notifier.messages[0], "Trùng với đề năm 2024") {
+		t.Fatalf("notifications: %v %v", notifier.users, notifier.messages)
+	}
+}
@@ -68,6 +68,10 @@ func (m *mockLibraryItemRepository) UpdateApproval(ctx context.Context, itemID,
 	return nil
 }
 
+func (m *mockLibraryItemRepository) ListVisible(ctx context.Context, filters repository.LibraryItemListFilters) (*repository.LibraryItemPage, error) {
+	return &repository.LibraryItemPage{}, nil
+}
+
 func TestNewService(t *testing.T) {
 	ratingRepo := &mockRatingRepository{}
 	itemRepo := &mockLibraryItemRepository{}
@@ -0,0 +1,515 @@
+package recommend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"unicode"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	mapcode_mgmt "exam-bank-system/apps/backend/internal/service/content/mapcode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Scoring weights and thresholds
+const (
+	// Share of a similarity score taken from co-downloads; the rest is content
+	coDownloadWeight = 0.6
+	contentWeight    = 0.4
+
+	// Parts of content similarity
+	subjectWeight = 0.45
+	gradeWeight   = 0.25
+	tagWeight     = 0.3
+
+	// Recent items a student used that seed similar-item recommendations
+	seedItems = 10
+	// Items loaded to exclude what the student already has
+	maxInteractions = 500
+
+	// A chapter is weak below this accuracy once enough questions were answered
+	weakAccuracy        = 0.6
+	minChapterAnswers   = 5
+	maxWeakChapters     = 3
+	itemsPerWeakChapter = 10
+	// Share of a chapter name's word pairs an item must mention to cover the chapter
+	minChapterMatch = 0.5
+	// Weak chapter recommendations outrank similar items of comparable strength
+	weakChapterBoost = 2.0
+)
+
+// RunStats summarises a nightly run
+type RunStats struct {
+	Similarities int
+	Users        int
+	Removed      int64 // Recommendations of students no longer active
+}
+
+// Run rebuilds similar items from co-downloads and content, then the recommendations of
+// every student active within the lookback window
+func (s *Service) Run(ctx context.Context) (*RunStats, error) {
+	startedAt := s.now()
+	since := startedAt.Add(-s.cfg.Lookback)
+
+	items, err := s.store.ListItemFeatures(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := s.store.ListCoDownloads(ctx, since, s.cfg.MinCoDownloads, s.cfg.SimilarPerItem)
+	if err != nil {
+		return nil, err
+	}
+	similar := buildSimilarities(items, pairs, s.cfg.SimilarPerItem)
+
+	var rows []repository.LibrarySimilarity
+	for _, list := range similar {
+		rows = append(rows, list...)
+	}
+	if err := s.store.ReplaceSimilarities(ctx, rows); err != nil {
+		return nil, err
+	}
+	stats := &RunStats{Similarities: len(rows)}
+
+	c := newCatalog(items, similar)
+	after := ""
+	for {
+		users, err := s.store.ListLearners(ctx, since, after, s.cfg.UserBatchSize)
+		if err != nil {
+			return stats, err
+		}
+		for _, userID := range users {
+			recommendations, err := s.recommendFor(ctx, c, userID)
+			if err != nil {
+				return stats, fmt.Errorf("failed to recommend for user %s: %w", userID, err)
+			}
+			if err := s.store.ReplaceUserRecommendations(ctx, userID, startedAt, recommendations); err != nil {
+				return stats, err
+			}
+			stats.Users++
+		}
+		if len(users) < s.cfg.UserBatchSize {
+			break
+		}
+		after = users[len(users)-1]
+	}
+
+	if stats.Removed, err = s.store.DeleteUserRecommendationsBefore(ctx, startedAt); err != nil {
+		return stats, err
+	}
+	return stats, s.store.RecordRun(ctx, startedAt, stats.Similarities, stats.Users)
+}
+
+// catalog holds the recommendable items of a run
+type catalog struct {
+	items    map[string]*repository.LibraryItemFeatures
+	similar  map[string][]repository.LibrarySimilarity
+	grades   map[string][]*repository.LibraryItemFeatures // By grade number
+	bigrams  map[string]map[string]bool                   // Word pairs of each item's title, description and tags
+	chapters map[string]*mapcode_mgmt.ChapterInfo         // Resolved chapter names, nil when unknown
+}
+
+func newCatalog(items []*repository.LibraryItemFeatures, similar map[string][]repository.LibrarySimilarity) *catalog {
+	c := &catalog{
+		items:    make(map[string]*repository.LibraryItemFeatures, len(items)),
+		similar:  similar,
+		grades:   make(map[string][]*repository.LibraryItemFeatures),
+		bigrams:  make(map[string]map[string]bool, len(items)),
+		chapters: make(map[string]*mapcode_mgmt.ChapterInfo),
+	}
+	for _, item := range items {
+		c.items[item.ID] = item
+		if grade := gradeNumber(item.Grade); grade != "" {
+			c.grades[grade] = append(c.grades[grade], item)
+		}
+	}
+	return c
+}
+
+// itemBigrams returns the word pairs an item mentions, computed on first use
+func (c *catalog) itemBigrams(item *repository.LibraryItemFeatures) map[string]bool {
+	if set, ok := c.bigrams[item.ID]; ok {
+		return set
+	}
+	set := make(map[string]bool)
+	for _, text := range append([]string{item.Title, item.Description}, item.Tags...) {
+		words := foldWords(text)
+		for i := 0; i+1 < len(words); i++ {
+			set[words[i]+" "+words[i+1]] = true
+		}
+		for _, w := range words {
+			set[w] = true
+		}
+	}
+	c.bigrams[item.ID] = set
+	return set
+}
+
+// candidate is a personal recommendation being scored
+type candidate struct {
+	score float64
+	best  float64 // Strongest single contribution, which gives the explanation
+	rec   repository.LibraryUserRecommendation
+}
+
+// recommendFor ranks items for one student: items similar to what they used recently,
+// and items covering the chapters they answer worst
+func (s *Service) recommendFor(ctx context.Context, c *catalog, userID string) ([]repository.LibraryUserRecommendation, error) {
+	interactions, err := s.store.ListInteractions(ctx, userID, maxInteractions)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool, len(interactions))
+	for _, i := range interactions {
+		used[i.ItemID] = true
+	}
+
+	candidates := make(map[string]*candidate)
+	add := func(itemID string, score float64, rec repository.LibraryUserRecommendation) {
+		if used[itemID] || c.items[itemID] == nil {
+			return
+		}
+		cand := candidates[itemID]
+		if cand == nil {
+			cand = &candidate{}
+			candidates[itemID] = cand
+		}
+		cand.score += score
+		if score > cand.best {
+			cand.best = score
+			rec.ItemID = itemID
+			cand.rec = rec
+		}
+	}
+
+	since := s.now().Add(-s.cfg.Lookback)
+	seeds := 0
+	for _, interaction := range interactions {
+		if seeds == seedItems || interaction.At.Before(since) {
+			break
+		}
+		seed := c.items[interaction.ItemID]
+		if seed == nil {
+			continue
+		}
+		// The most recent items count most
+		weight := 1 / (1 + 0.25*float64(seeds))
+		seeds++
+		for _, sim := range c.similar[seed.ID] {
+			add(sim.SimilarItemID, weight*sim.Score, repository.LibraryUserRecommendation{
+				Reason:       repository.RecommendationReasonSimilarItem,
+				SourceItemID: seed.ID,
+				Explanation:  similarExplanation(sim.Reason, seed.Title),
+			})
+		}
+	}
+
+	if s.chapters != nil {
+		results, err := s.store.ListChapterResults(ctx, userID, since)
+		if err != nil {
+			return nil, err
+		}
+		for _, weak := range weakChapters(results) {
+			info := s.chapterInfo(ctx, c, weak.Code)
+			if info == nil || info.Chapter == "" {
+				continue
+			}
+			weakness := 1 - float64(weak.Correct)/float64(weak.Answered)
+			rec := repository.LibraryUserRecommendation{
+				Reason:       repository.RecommendationReasonWeakChapter,
+				QuestionCode: weak.Code,
+				Explanation:  fmt.Sprintf("Vì bạn còn gặp khó ở Chương %s: %s (đúng %d/%d câu)", weak.Code[2:], info.Chapter, weak.Correct, weak.Answered),
+			}
+			for _, match := range c.chapterItems(weak.Code, info) {
+				add(match.itemID, weakChapterBoost*weakness*match.score, rec)
+			}
+		}
+	}
+
+	ranked := make([]*candidate, 0, len(candidates))
+	for _, cand := range candidates {
+		cand.rec.Score = cand.score
+		ranked = append(ranked, cand)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].rec.ItemID < ranked[j].rec.ItemID
+	})
+	if len(ranked) > s.cfg.PerUser {
+		ranked = ranked[:s.cfg.PerUser]
+	}
+	recommendations := make([]repository.LibraryUserRecommendation, 0, len(ranked))
+	for _, cand := range ranked {
+		recommendations = append(recommendations, cand.rec)
+	}
+	return recommendations, nil
+}
+
+// chapterInfo names a chapter once per run; lookup failures leave the chapter unused
+func (s *Service) chapterInfo(ctx context.Context, c *catalog, code string) *mapcode_mgmt.ChapterInfo {
+	if info, ok := c.chapters[code]; ok {
+		return info
+	}
+	info, err := s.chapters.GetChapter(ctx, code)
+	if err != nil {
+		log.Printf("[WARN] [Recommend] Failed to name chapter %s: %v", code, err)
+		info = nil
+	}
+	c.chapters[code] = info
+	return info
+}
+
+// chapterMatch is an item covering a weak chapter
+type chapterMatch struct {
+	itemID string
+	score  float64
+}
+
+// chapterItems returns the items of the chapter's grade that mention most of the
+// chapter name, best match first
+func (c *catalog) chapterItems(code string, info *mapcode_mgmt.ChapterInfo) []chapterMatch {
+	grade := gradeNumber(info.Grade)
+	if grade == "" {
+		grade = codeGrade(code[0])
+	}
+	want := chapterTerms(info.Chapter)
+	if len(want) == 0 {
+		return nil
+	}
+
+	var matches []chapterMatch
+	for _, item := range c.grades[grade] {
+		have := c.itemBigrams(item)
+		found := 0
+		for _, term := range want {
+			if have[term] {
+				found++
+			}
+		}
+		score := float64(found) / float64(len(want))
+		if score >= minChapterMatch {
+			matches = append(matches, chapterMatch{itemID: item.ID, score: score})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return c.items[matches[i].itemID].Downloads > c.items[matches[j].itemID].Downloads
+	})
+	if len(matches) > itemsPerWeakChapter {
+		matches = matches[:itemsPerWeakChapter]
+	}
+	return matches
+}
+
+// weakChapters returns the chapters answered often enough and below the accuracy
+// threshold, weakest first
+func weakChapters(results []repository.LibraryChapterResult) []repository.LibraryChapterResult {
+	var weak []repository.LibraryChapterResult
+	for _, r := range results {
+		if len(r.Code) < 3 || r.Answered < minChapterAnswers {
+			continue
+		}
+		if float64(r.Correct)/float64(r.Answered) < weakAccuracy {
+			weak = append(weak, r)
+		}
+	}
+	sort.Slice(weak, func(i, j int) bool {
+		ai := float64(weak[i].Correct) / float64(weak[i].Answered)
+		aj := float64(weak[j].Correct) / float64(weak[j].Answered)
+		if ai != aj {
+			return ai < aj
+		}
+		return weak[i].Answered > weak[j].Answered
+	})
+	if len(weak) > maxWeakChapters {
+		weak = weak[:maxWeakChapters]
+	}
+	return weak
+}
+
+// buildSimilarities combines co-download and content similarity and keeps the best
+// perItem similar items of every item
+func buildSimilarities(items []*repository.LibraryItemFeatures, pairs []repository.LibraryItemPair, perItem int) map[string][]repository.LibrarySimilarity {
+	byID := make(map[string]*repository.LibraryItemFeatures, len(items))
+	bySubject := make(map[string][]*repository.LibraryItemFeatures)
+	byTag := make(map[string][]*repository.LibraryItemFeatures)
+	for _, item := range items {
+		byID[item.ID] = item
+		if item.Subject != "" {
+			bySubject[item.Subject] = append(bySubject[item.Subject], item)
+		}
+		for _, tag := range item.Tags {
+			byTag[tag] = append(byTag[tag], item)
+		}
+	}
+
+	type signal struct{ coDownload, content float64 }
+	signals := make(map[string]map[string]*signal, len(items))
+	get := func(a, b string) *signal {
+		if signals[a] == nil {
+			signals[a] = make(map[string]*signal)
+		}
+		if signals[a][b] == nil {
+			signals[a][b] = &signal{}
+		}
+		return signals[a][b]
+	}
+
+	for _, p := range pairs {
+		if byID[p.ItemID] != nil && byID[p.OtherID] != nil {
+			get(p.ItemID, p.OtherID).coDownload = p.Score
+		}
+	}
+	for _, item := range items {
+		// Only items sharing the subject or a tag can be similar by content
+		seen := map[string]bool{item.ID: true}
+		others := append([]*repository.LibraryItemFeatures(nil), bySubject[item.Subject]...)
+		for _, tag := range item.Tags {
+			others = append(others, byTag[tag]...)
+		}
+		for _, other := range others {
+			if seen[other.ID] {
+				continue
+			}
+			seen[other.ID] = true
+			if score := contentSimilarity(item, other); score > 0 {
+				get(item.ID, other.ID).content = score
+			}
+		}
+	}
+
+	similar := make(map[string][]repository.LibrarySimilarity, len(signals))
+	for itemID, others := range signals {
+		list := make([]repository.LibrarySimilarity, 0, len(others))
+		for otherID, sig := range others {
+			co, content := coDownloadWeight*sig.coDownload, contentWeight*sig.content
+			reason := repository.RecommendationReasonContent
+			if co >= content {
+				reason = repository.RecommendationReasonCoDownload
+			}
+			list = append(list, repository.LibrarySimilarity{ItemID: itemID, SimilarItemID: otherID, Score: co + content, Reason: reason})
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Score != list[j].Score {
+				return list[i].Score > list[j].Score
+			}
+			return list[i].SimilarItemID < list[j].SimilarItemID
+		})
+		if len(list) > perItem {
+			list = list[:perItem]
+		}
+		similar[itemID] = list
+	}
+	return similar
+}
+
+// contentSimilarity scores two items by subject, grade and tag overlap; items that share
+// neither the subject nor a tag score 0
+func contentSimilarity(a, b *repository.LibraryItemFeatures) float64 {
+	sameSubject := a.Subject != "" && a.Subject == b.Subject
+	shared := 0
+	tags := make(map[string]bool, len(a.Tags))
+	for _, t := range a.Tags {
+		tags[t] = true
+	}
+	union := len(tags)
+	for _, t := range b.Tags {
+		if tags[t] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	if !sameSubject && shared == 0 {
+		return 0
+	}
+
+	score := 0.0
+	if sameSubject {
+		score += subjectWeight
+	}
+	if grade := gradeNumber(a.Grade); grade != "" && grade == gradeNumber(b.Grade) {
+		score += gradeWeight
+	}
+	if union > 0 {
+		score += tagWeight * float64(shared) / float64(union)
+	}
+	return score
+}
+
+func similarExplanation(reason, seedTitle string) string {
+	if reason == repository.RecommendationReasonCoDownload {
+		return fmt.Sprintf("Học sinh tải “%s” cũng thường dùng tài liệu này", seedTitle)
+	}
+	return fmt.Sprintf("Cùng chủ đề với “%s”", seedTitle)
+}
+
+// gradeNumber extracts the grade from values such as "12", "Lớp 12" or "lop12"
+func gradeNumber(grade string) string {
+	start := strings.IndexFunc(grade, unicode.IsDigit)
+	if start < 0 {
+		return ""
+	}
+	end := strings.IndexFunc(grade[start:], func(r rune) bool { return !unicode.IsDigit(r) })
+	if end < 0 {
+		return grade[start:]
+	}
+	return grade[start : start+end]
+}
+
+// codeGrade maps the grade character of a question code when the MapCode has no name
+// for it: 0, 1 and 2 are grades 10 to 12
+func codeGrade(c byte) string {
+	if c >= '0' && c <= '2' {
+		return "1" + string(c)
+	}
+	return string(c)
+}
+
+// chapterStopwords carry no topic in chapter names
+var chapterStopwords = map[string]bool{"va": true, "cua": true, "cac": true, "trong": true, "voi": true, "chuong": true}
+
+// chapterTerms returns the word pairs of a chapter name that do not involve stopwords,
+// or its single word
+func chapterTerms(name string) []string {
+	words := foldWords(name)
+	var terms []string
+	for i := 0; i+1 < len(words); i++ {
+		if !chapterStopwords[words[i]] && !chapterStopwords[words[i+1]] {
+			terms = append(terms, words[i]+" "+words[i+1])
+		}
+	}
+	if len(terms) == 0 {
+		for _, w := range words {
+			if !chapterStopwords[w] {
+				terms = append(terms, w)
+			}
+		}
+	}
+	return terms
+}
+
+// foldWords lower-cases text, drops Vietnamese diacritics so titles typed without them
+// still match, and splits it into words
+func foldWords(text string) []string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(text)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r == 'đ':
+			b.WriteRune('d')
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
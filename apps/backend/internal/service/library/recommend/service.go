@@ -0,0 +1,163 @@
+package recommend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	mapcode_mgmt "exam-bank-system/apps/backend/internal/service/content/mapcode"
+)
+
+// Defaults applied by NewService
+const (
+	DefaultRunHour        = 2
+	DefaultCheckInterval  = 15 * time.Minute
+	DefaultLookback       = 180 * 24 * time.Hour
+	DefaultSimilarPerItem = 20
+	DefaultPerUser        = 30
+	DefaultMinCoDownloads = 2
+	DefaultUserBatchSize  = 200
+)
+
+// ReasonPopular marks the fallback for students without personal recommendations
+const ReasonPopular = "popular"
+
+// Explanations shown when the reason is not tied to a particular item or chapter
+const (
+	PopularExplanation    = "Được nhiều học sinh tải nhất"
+	CoDownloadExplanation = "Thường được tải cùng tài liệu này"
+	ContentExplanation    = "Cùng môn học, khối lớp hoặc chủ đề"
+)
+
+// Store reads library usage and keeps recommendations, implemented by
+// repository.LibraryRecommendationRepository
+type Store interface {
+	ListItemFeatures(ctx context.Context) ([]*repository.LibraryItemFeatures, error)
+	ListCoDownloads(ctx context.Context, since time.Time, minStudents, perItem int) ([]repository.LibraryItemPair, error)
+	ReplaceSimilarities(ctx context.Context, similarities []repository.LibrarySimilarity) error
+	ListSimilar(ctx context.Context, itemID string, limit int) ([]repository.LibrarySimilarity, error)
+	ListLearners(ctx context.Context, since time.Time, afterID string, limit int) ([]string, error)
+	ListInteractions(ctx context.Context, userID string, limit int) ([]repository.LibraryInteraction, error)
+	ListChapterResults(ctx context.Context, userID string, since time.Time) ([]repository.LibraryChapterResult, error)
+	ReplaceUserRecommendations(ctx context.Context, userID string, computedAt time.Time, recommendations []repository.LibraryUserRecommendation) error
+	ListUserRecommendations(ctx context.Context, userID string, limit int) ([]repository.LibraryUserRecommendation, error)
+	DeleteUserRecommendationsBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	LastRunAt(ctx context.Context) (time.Time, error)
+	RecordRun(ctx context.Context, startedAt time.Time, similarities, users int) error
+}
+
+// ChapterNames names the chapter of a question code, implemented by mapcode_mgmt.MapCodeMgmt
+type ChapterNames interface {
+	GetChapter(ctx context.Context, questionCode string) (*mapcode_mgmt.ChapterInfo, error)
+}
+
+// Config controls the nightly computation
+type Config struct {
+	RunHour        int           // Local hour from which the nightly run is due
+	CheckInterval  time.Duration // How often the worker checks whether a run is due
+	Lookback       time.Duration // Usage and exam results older than this are ignored
+	SimilarPerItem int           // Similar items kept per item
+	PerUser        int           // Recommendations kept per student
+	MinCoDownloads int           // Students two items must share to count as co-downloaded
+	UserBatchSize  int           // Students loaded per query during a run
+}
+
+// Recommendation is a recommended item with the reason it was picked
+type Recommendation struct {
+	ItemID       string
+	Score        float64
+	Reason       string
+	SourceItemID string // Item the recommendation is derived from, if any
+	QuestionCode string // Chapter prefix of a weak_chapter recommendation
+	Explanation  string
+}
+
+// Service precomputes similar items and personal recommendations every night and serves
+// them. Items are ranked here only; callers still filter them by what the viewer may open.
+type Service struct {
+	cfg      Config
+	store    Store
+	chapters ChapterNames // Optional; without it weak chapters are not used
+	now      func() time.Time
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewService applies defaults
+func NewService(cfg Config, store Store) *Service {
+	if cfg.RunHour < 0 || cfg.RunHour > 23 {
+		cfg.RunHour = DefaultRunHour
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = DefaultCheckInterval
+	}
+	if cfg.Lookback <= 0 {
+		cfg.Lookback = DefaultLookback
+	}
+	if cfg.SimilarPerItem <= 0 {
+		cfg.SimilarPerItem = DefaultSimilarPerItem
+	}
+	if cfg.PerUser <= 0 {
+		cfg.PerUser = DefaultPerUser
+	}
+	if cfg.MinCoDownloads <= 0 {
+		cfg.MinCoDownloads = DefaultMinCoDownloads
+	}
+	if cfg.UserBatchSize <= 0 {
+		cfg.UserBatchSize = DefaultUserBatchSize
+	}
+	return &Service{cfg: cfg, store: store, now: time.Now}
+}
+
+// SetChapterNames enables recommendations for the chapters a student struggles with
+func (s *Service) SetChapterNames(chapters ChapterNames) {
+	s.chapters = chapters
+}
+
+// Recommendations returns a student's precomputed recommendations, best first. Students
+// the last run did not cover get none; callers fall back to popular items.
+func (s *Service) Recommendations(ctx context.Context, userID string, limit int) ([]Recommendation, error) {
+	rows, err := s.store.ListUserRecommendations(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	recommendations := make([]Recommendation, 0, len(rows))
+	for _, row := range rows {
+		recommendations = append(recommendations, Recommendation{
+			ItemID:       row.ItemID,
+			Score:        row.Score,
+			Reason:       row.Reason,
+			SourceItemID: row.SourceItemID,
+			QuestionCode: row.QuestionCode,
+			Explanation:  row.Explanation,
+		})
+	}
+	return recommendations, nil
+}
+
+// Similar returns the precomputed similar items of an item, best first. Items added
+// since the last run have none yet.
+func (s *Service) Similar(ctx context.Context, itemID string, limit int) ([]Recommendation, error) {
+	rows, err := s.store.ListSimilar(ctx, itemID, limit)
+	if err != nil {
+		return nil, err
+	}
+	recommendations := make([]Recommendation, 0, len(rows))
+	for _, row := range rows {
+		explanation := ContentExplanation
+		if row.Reason == repository.RecommendationReasonCoDownload {
+			explanation = CoDownloadExplanation
+		}
+		recommendations = append(recommendations, Recommendation{
+			ItemID:       row.SimilarItemID,
+			Score:        row.Score,
+			Reason:       row.Reason,
+			SourceItemID: itemID,
+			Explanation:  explanation,
+		})
+	}
+	return recommendations, nil
+}
@@ -0,0 +1,250 @@
+package recommend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	mapcode_mgmt "exam-bank-system/apps/backend/internal/service/content/mapcode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	items        []*repository.LibraryItemFeatures
+	pairs        []repository.LibraryItemPair
+	learners     []string
+	interactions map[string][]repository.LibraryInteraction
+	chapters     map[string][]repository.LibraryChapterResult
+	lastRun      time.Time
+
+	similarities []repository.LibrarySimilarity
+	saved        map[string][]repository.LibraryUserRecommendation
+	savedAt      time.Time
+	cutoff       time.Time
+	runs         int
+}
+
+func (f *fakeStore) ListItemFeatures(ctx context.Context) ([]*repository.LibraryItemFeatures, error) {
+	return f.items, nil
+}
+
+func (f *fakeStore) ListCoDownloads(ctx context.Context, since time.Time, minStudents, perItem int) ([]repository.LibraryItemPair, error) {
+	return f.pairs, nil
+}
+
+func (f *fakeStore) ReplaceSimilarities(ctx context.Context, similarities []repository.LibrarySimilarity) error {
+	f.similarities = similarities
+	return nil
+}
+
+func (f *fakeStore) ListSimilar(ctx context.Context, itemID string, limit int) ([]repository.LibrarySimilarity, error) {
+	var out []repository.LibrarySimilarity
+	for _, s := range f.similarities {
+		if s.ItemID == itemID && len(out) < limit {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeStore) ListLearners(ctx context.Context, since time.Time, afterID string, limit int) ([]string, error) {
+	var out []string
+	for _, id := range f.learners {
+		if id > afterID && len(out) < limit {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeStore) ListInteractions(ctx context.Context, userID string, limit int) ([]repository.LibraryInteraction, error) {
+	return f.interactions[userID], nil
+}
+
+func (f *fakeStore) ListChapterResults(ctx context.Context, userID string, since time.Time) ([]repository.LibraryChapterResult, error) {
+	return f.chapters[userID], nil
+}
+
+func (f *fakeStore) ReplaceUserRecommendations(ctx context.Context, userID string, computedAt time.Time, recommendations []repository.LibraryUserRecommendation) error {
+	f.saved[userID] = recommendations
+	f.savedAt = computedAt
+	return nil
+}
+
+func (f *fakeStore) ListUserRecommendations(ctx context.Context, userID string, limit int) ([]repository.LibraryUserRecommendation, error) {
+	return f.saved[userID], nil
+}
+
+func (f *fakeStore) DeleteUserRecommendationsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	f.cutoff = cutoff
+	return 0, nil
+}
+
+func (f *fakeStore) LastRunAt(ctx context.Context) (time.Time, error) {
+	return f.lastRun, nil
+}
+
+func (f *fakeStore) RecordRun(ctx context.Context, startedAt time.Time, similarities, users int) error {
+	f.runs++
+	f.lastRun = startedAt
+	return nil
+}
+
+type fakeChapters map[string]*mapcode_mgmt.ChapterInfo
+
+func (f fakeChapters) GetChapter(ctx context.Context, code string) (*mapcode_mgmt.ChapterInfo, error) {
+	if info, ok := f[code]; ok {
+		return info, nil
+	}
+	return nil, errors.New("unknown code")
+}
+
+var now = time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)
+
+func catalogItems() []*repository.LibraryItemFeatures {
+	return []*repository.LibraryItemFeatures{
+		{ID: "exam-a", Type: "exam", Title: "Đề kiểm tra Hàm số", Subject: "toán", Grade: "10", Tags: []string{"hàm số"}},
+		{ID: "exam-b", Type: "exam", Title: "Đề thi giữa kỳ", Subject: "toán", Grade: "10", Tags: []string{"hàm số", "giữa kỳ"}},
+		{ID: "book-c", Type: "book", Title: "Chuyên đề hàm số bậc hai và đồ thị", Subject: "toán", Grade: "Lớp 10", Downloads: 50},
+		{ID: "book-d", Type: "book", Title: "Ham so bac hai - do thi", Subject: "toán", Grade: "10", Downloads: 10},
+		{ID: "video-e", Type: "video", Title: "Văn nghị luận", Subject: "ngữ văn", Grade: "10"},
+	}
+}
+
+func newTestService(store *fakeStore) *Service {
+	svc := NewService(Config{}, store)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestBuildSimilarities(t *testing.T) {
+	items := catalogItems()
+	pairs := []repository.LibraryItemPair{
+		{ItemID: "exam-a", OtherID: "video-e", Score: 0.9},
+		{ItemID: "exam-a", OtherID: "missing", Score: 1},
+	}
+
+	similar := buildSimilarities(items, pairs, 3)
+
+	list := similar["exam-a"]
+	require.Len(t, list, 3)
+	// Co-downloads weigh more than content
+	assert.Equal(t, "video-e", list[0].SimilarItemID, "different subject and no shared tag, but co-downloaded")
+	assert.Equal(t, repository.RecommendationReasonCoDownload, list[0].Reason)
+	assert.InDelta(t, coDownloadWeight*0.9, list[0].Score, 1e-9)
+	// Same subject, grade and a shared tag
+	assert.Equal(t, "exam-b", list[1].SimilarItemID)
+	assert.Equal(t, repository.RecommendationReasonContent, list[1].Reason)
+	assert.InDelta(t, contentWeight*(subjectWeight+gradeWeight+tagWeight/2), list[1].Score, 1e-9)
+
+	// Different subject and no tags in common
+	for _, s := range similar["video-e"] {
+		t.Errorf("unexpected similar item %+v", s)
+	}
+}
+
+func TestRun_RecommendsSimilarAndWeakChapterItems(t *testing.T) {
+	store := &fakeStore{
+		items:    catalogItems(),
+		learners: []string{"student-1", "student-2"},
+		interactions: map[string][]repository.LibraryInteraction{
+			"student-1": {{ItemID: "exam-a", At: now.Add(-24 * time.Hour)}},
+		},
+		chapters: map[string][]repository.LibraryChapterResult{
+			"student-1": {
+				{Code: "0P3", Answered: 10, Correct: 3},
+				{Code: "0P1", Answered: 10, Correct: 9}, // Not weak
+				{Code: "0P4", Answered: 2, Correct: 0},  // Too few answers
+			},
+		},
+		saved: map[string][]repository.LibraryUserRecommendation{},
+	}
+	svc := newTestService(store)
+	svc.SetChapterNames(fakeChapters{"0P3": {Grade: "Lớp 10", Chapter: "Hàm số bậc hai và đồ thị"}})
+
+	stats, err := svc.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Users)
+	assert.Equal(t, 1, store.runs)
+	assert.Equal(t, now, store.cutoff)
+	assert.Equal(t, now, store.savedAt)
+
+	recs := store.saved["student-1"]
+	byItem := map[string]repository.LibraryUserRecommendation{}
+	for _, rec := range recs {
+		byItem[rec.ItemID] = rec
+	}
+	// Items the student already used are never recommended
+	assert.NotContains(t, byItem, "exam-a")
+
+	// Both books cover the weak chapter; the one with diacritics stripped still matches
+	for _, id := range []string{"book-c", "book-d"} {
+		rec, ok := byItem[id]
+		require.True(t, ok, id)
+		assert.Equal(t, repository.RecommendationReasonWeakChapter, rec.Reason)
+		assert.Equal(t, "0P3", rec.QuestionCode)
+		assert.Equal(t, "Vì bạn còn gặp khó ở Chương 3: Hàm số bậc hai và đồ thị (đúng 3/10 câu)", rec.Explanation)
+	}
+	assert.Equal(t, "book-c", recs[0].ItemID)
+
+	rec := byItem["exam-b"]
+	assert.Equal(t, repository.RecommendationReasonSimilarItem, rec.Reason)
+	assert.Equal(t, "exam-a", rec.SourceItemID)
+	assert.Equal(t, "Cùng chủ đề với “Đề kiểm tra Hàm số”", rec.Explanation)
+
+	// Students without usage or weak chapters get an empty list, replacing older rows
+	assert.Empty(t, store.saved["student-2"])
+	assert.Contains(t, store.saved, "student-2")
+}
+
+func TestSimilar_Explanations(t *testing.T) {
+	store := &fakeStore{similarities: []repository.LibrarySimilarity{
+		{ItemID: "a", SimilarItemID: "b", Score: 0.8, Reason: repository.RecommendationReasonCoDownload},
+		{ItemID: "a", SimilarItemID: "c", Score: 0.4, Reason: repository.RecommendationReasonContent},
+	}}
+	svc := newTestService(store)
+
+	recs, err := svc.Similar(context.Background(), "a", 10)
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+	assert.Equal(t, CoDownloadExplanation, recs[0].Explanation)
+	assert.Equal(t, ContentExplanation, recs[1].Explanation)
+	assert.Equal(t, "a", recs[1].SourceItemID)
+}
+
+func TestRunDue(t *testing.T) {
+	store := &fakeStore{}
+	svc := newTestService(store)
+	svc.cfg.RunHour = 2
+
+	due, err := svc.runDue(context.Background())
+	require.NoError(t, err)
+	assert.True(t, due, "never ran")
+
+	store.lastRun = now.Add(-30 * time.Minute)
+	due, _ = svc.runDue(context.Background())
+	assert.False(t, due, "already ran tonight")
+
+	store.lastRun = now.Add(-2 * time.Hour)
+	due, _ = svc.runDue(context.Background())
+	assert.True(t, due, "last run was before tonight's run hour")
+
+	svc.now = func() time.Time { return now.Add(-2 * time.Hour) }
+	due, _ = svc.runDue(context.Background())
+	assert.False(t, due, "before the run hour")
+}
+
+func TestGradeNumberAndChapterTerms(t *testing.T) {
+	assert.Equal(t, "12", gradeNumber("Lớp 12"))
+	assert.Equal(t, "10", gradeNumber("lop10a"))
+	assert.Equal(t, "", gradeNumber("THPT"))
+	assert.Equal(t, "11", codeGrade('1'))
+	assert.Equal(t, "9", codeGrade('9'))
+
+	assert.Equal(t, []string{"ham so", "so bac", "bac hai", "do thi"}, chapterTerms("Hàm số bậc hai và đồ thị"))
+	assert.Equal(t, []string{"thong ke"}, chapterTerms("Thống kê"))
+}
@@ -0,0 +1,84 @@
+package recommend
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runDue reports whether the nightly run is due: it is past the run hour and no run has
+// started since the run hour of the current day
+func (s *Service) runDue(ctx context.Context) (bool, error) {
+	now := s.now()
+	if now.Hour() < s.cfg.RunHour {
+		return false, nil
+	}
+	last, err := s.store.LastRunAt(ctx)
+	if err != nil {
+		return false, err
+	}
+	runStart := time.Date(now.Year(), now.Month(), now.Day(), s.cfg.RunHour, 0, 0, 0, now.Location())
+	return last.Before(runStart), nil
+}
+
+// runOnce performs one pass of the background worker
+func (s *Service) runOnce(ctx context.Context) {
+	due, err := s.runDue(ctx)
+	if err != nil {
+		log.Printf("[ERROR] [Recommend] Failed to check the last run: %v", err)
+		return
+	}
+	if !due {
+		return
+	}
+	stats, err := s.Run(ctx)
+	if err != nil {
+		log.Printf("[ERROR] [Recommend] Nightly run failed: %v", err)
+		return
+	}
+	log.Printf("[INFO] [Recommend] Computed %d similar items and recommendations for %d students (%d stale removed)",
+		stats.Similarities, stats.Users, stats.Removed)
+}
+
+// Start runs the nightly computation once a day after the run hour until Stop is called
+func (s *Service) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+				s.runOnce(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop ends the worker loop started by Start
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		s.wg.Wait()
+	}
+}
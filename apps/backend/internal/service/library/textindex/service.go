@@ -0,0 +1,243 @@
+package textindex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/opensearch"
+	"exam-bank-system/apps/backend/internal/pdf"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/storage"
+	"exam-bank-system/apps/backend/internal/validation"
+)
+
+// Defaults applied by NewService
+const (
+	DefaultInterval  = 5 * time.Minute
+	DefaultBatchSize = 20
+	DefaultMaxPages  = 2000
+
+	// maxReasonLength bounds the failure reason stored per item
+	maxReasonLength = 500
+)
+
+// Errors recorded for files that cannot be indexed
+var (
+	ErrFileUnavailable = errors.New("item has no readable file")
+	ErrFileTooLarge    = errors.New("file is too large to index")
+	ErrNotPDF          = errors.New("file is not a PDF")
+)
+
+// Store tracks indexed files, implemented by repository.LibraryTextIndexRepository
+type Store interface {
+	ListPending(ctx context.Context, limit int) ([]*repository.LibraryTextIndexCandidate, error)
+	MarkIndexed(ctx context.Context, itemID, fileKey, status string, pages int, reason string) error
+	ListRemoved(ctx context.Context, limit int) ([]string, error)
+	Delete(ctx context.Context, itemID string) error
+}
+
+// PageIndex stores page text for search, implemented by opensearch.LibraryPageRepository
+type PageIndex interface {
+	EnsureIndex(ctx context.Context) error
+	ReplaceItemPages(ctx context.Context, itemID string, pages []opensearch.LibraryPage) error
+	DeleteItem(ctx context.Context, itemID string) error
+}
+
+// Config controls the indexing worker
+type Config struct {
+	Interval    time.Duration // How often the worker looks for new or changed files
+	BatchSize   int           // Items indexed per pass
+	MaxFileSize int64         // Largest file read into memory for extraction
+	MaxPages    int           // Pages indexed per file; the rest is ignored
+}
+
+// Service extracts the text of library book and exam PDFs page by page and keeps the
+// OpenSearch pages index in step with the file each item points at
+type Service struct {
+	cfg    Config
+	store  Store
+	index  PageIndex
+	blobs  storage.BlobStore // Optional; library/ file IDs are read from it
+	client *http.Client
+
+	indexReady bool
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewService applies defaults; blobs may be nil when files are only linked by URL
+func NewService(cfg Config, store Store, index PageIndex, blobs storage.BlobStore) *Service {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = validation.MaxPDFSize
+	}
+	if cfg.MaxPages <= 0 {
+		cfg.MaxPages = DefaultMaxPages
+	}
+	return &Service{
+		cfg:    cfg,
+		store:  store,
+		index:  index,
+		blobs:  blobs,
+		client: &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// IndexPending indexes one batch of items whose file is new or changed and returns how
+// many were processed. Files that cannot be read or parsed are recorded as failed and
+// retried only once the item's file changes; index errors abort the pass.
+func (s *Service) IndexPending(ctx context.Context) (int, error) {
+	if !s.indexReady {
+		if err := s.index.EnsureIndex(ctx); err != nil {
+			return 0, fmt.Errorf("failed to create library pages index: %w", err)
+		}
+		s.indexReady = true
+	}
+
+	candidates, err := s.store.ListPending(ctx, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i, candidate := range candidates {
+		if err := s.indexItem(ctx, candidate); err != nil {
+			return i, err
+		}
+	}
+	return len(candidates), nil
+}
+
+// indexItem replaces the indexed pages of one item
+func (s *Service) indexItem(ctx context.Context, candidate *repository.LibraryTextIndexCandidate) error {
+	texts, err := s.extract(ctx, candidate)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Old pages stay searchable only while they match the item's file
+		if err := s.index.DeleteItem(ctx, candidate.ItemID); err != nil {
+			return err
+		}
+		return s.store.MarkIndexed(ctx, candidate.ItemID, candidate.FileKey, repository.TextIndexStatusFailed, 0, truncate(err.Error()))
+	}
+
+	if len(texts) > s.cfg.MaxPages {
+		texts = texts[:s.cfg.MaxPages]
+	}
+	pages := make([]opensearch.LibraryPage, 0, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			continue
+		}
+		pages = append(pages, opensearch.LibraryPage{
+			ItemID:   candidate.ItemID,
+			ItemType: candidate.ItemType,
+			Page:     i + 1,
+			Text:     text,
+		})
+	}
+
+	if len(pages) == 0 {
+		// Scanned files have no text layer; keep them out of the index until they change
+		if err := s.index.DeleteItem(ctx, candidate.ItemID); err != nil {
+			return err
+		}
+		return s.store.MarkIndexed(ctx, candidate.ItemID, candidate.FileKey, repository.TextIndexStatusEmpty, len(texts), "")
+	}
+	if err := s.index.ReplaceItemPages(ctx, candidate.ItemID, pages); err != nil {
+		return err
+	}
+	return s.store.MarkIndexed(ctx, candidate.ItemID, candidate.FileKey, repository.TextIndexStatusIndexed, len(texts), "")
+}
+
+// RemoveDeleted drops the pages of items that were deleted or lost their file
+func (s *Service) RemoveDeleted(ctx context.Context) (int, error) {
+	ids, err := s.store.ListRemoved(ctx, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i, id := range ids {
+		if err := s.index.DeleteItem(ctx, id); err != nil {
+			return i, err
+		}
+		if err := s.store.Delete(ctx, id); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
+// extract loads the item's file and returns the text of each page
+func (s *Service) extract(ctx context.Context, candidate *repository.LibraryTextIndexCandidate) ([]string, error) {
+	content, err := s.fetch(ctx, candidate.FileID, candidate.FileURL)
+	if err != nil {
+		return nil, err
+	}
+	head := content
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	if !bytes.Contains(head, []byte("%PDF-")) {
+		return nil, ErrNotPDF
+	}
+	return pdf.ExtractText(content)
+}
+
+// fetch reads the file from the blob store or its external URL
+func (s *Service) fetch(ctx context.Context, fileID, fileURL string) ([]byte, error) {
+	var body io.ReadCloser
+	switch {
+	case s.blobs != nil && strings.HasPrefix(fileID, storage.LibraryPrefix):
+		content, _, err := s.blobs.Get(ctx, fileID)
+		if err != nil {
+			return nil, err
+		}
+		body = content
+	case strings.HasPrefix(fileURL, "https://") || strings.HasPrefix(fileURL, "http://"):
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("file URL returned %s", resp.Status)
+		}
+		body = resp.Body
+	default:
+		return nil, ErrFileUnavailable
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(body, s.cfg.MaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > s.cfg.MaxFileSize {
+		return nil, ErrFileTooLarge
+	}
+	return content, nil
+}
+
+func truncate(reason string) string {
+	if len(reason) > maxReasonLength {
+		return reason[:maxReasonLength]
+	}
+	return reason
+}
@@ -0,0 +1,187 @@
+package textindex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"exam-bank-system/apps/backend/internal/opensearch"
+	"exam-bank-system/apps/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mark struct {
+	fileKey, status, reason string
+	pages                   int
+}
+
+type fakeStore struct {
+	pending []*repository.LibraryTextIndexCandidate
+	removed []string
+	marks   map[string]mark
+	deleted []string
+}
+
+func (f *fakeStore) ListPending(ctx context.Context, limit int) ([]*repository.LibraryTextIndexCandidate, error) {
+	return f.pending, nil
+}
+
+func (f *fakeStore) MarkIndexed(ctx context.Context, itemID, fileKey, status string, pages int, reason string) error {
+	f.marks[itemID] = mark{fileKey: fileKey, status: status, reason: reason, pages: pages}
+	return nil
+}
+
+func (f *fakeStore) ListRemoved(ctx context.Context, limit int) ([]string, error) {
+	return f.removed, nil
+}
+
+func (f *fakeStore) Delete(ctx context.Context, itemID string) error {
+	f.deleted = append(f.deleted, itemID)
+	return nil
+}
+
+type fakeIndex struct {
+	ensured  int
+	pages    map[string][]opensearch.LibraryPage
+	deleted  []string
+	failWith error
+}
+
+func (f *fakeIndex) EnsureIndex(ctx context.Context) error {
+	f.ensured++
+	return nil
+}
+
+func (f *fakeIndex) ReplaceItemPages(ctx context.Context, itemID string, pages []opensearch.LibraryPage) error {
+	if f.failWith != nil {
+		return f.failWith
+	}
+	f.pages[itemID] = pages
+	return nil
+}
+
+func (f *fakeIndex) DeleteItem(ctx context.Context, itemID string) error {
+	f.deleted = append(f.deleted, itemID)
+	return nil
+}
+
+// examPDF has a page of text, a blank page and another page of text
+func examPDF() []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 4 0 R 5 0 R] /Count 3 /Resources << /Font << /F1 6 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 7 0 R >>",
+		"<< /Type /Page /Parent 2 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 8 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Length 41 >>\nstream\nBT /F1 12 Tf (Cau 1. Hinh hoc khong gian) Tj ET\nendstream",
+		"<< /Length 32 >>\nstream\nBT /F1 12 Tf (Cau 2. Tich phan) Tj ET\nendstream",
+	}
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, body := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, body)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n\r\n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func newHarness(t *testing.T) (*Service, *fakeStore, *fakeIndex, string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/exam.pdf":
+			w.Write(examPDF())
+		case "/notes.txt":
+			w.Write([]byte("plain text"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	store := &fakeStore{marks: map[string]mark{}}
+	index := &fakeIndex{pages: map[string][]opensearch.LibraryPage{}}
+	return NewService(Config{}, store, index, nil), store, index, server.URL
+}
+
+func TestIndexPending_IndexesPagesWithText(t *testing.T) {
+	svc, store, index, base := newHarness(t)
+	store.pending = []*repository.LibraryTextIndexCandidate{
+		{ItemID: "exam-1", ItemType: "exam", FileURL: base + "/exam.pdf", FileKey: "|" + base + "/exam.pdf"},
+	}
+
+	n, err := svc.IndexPending(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, index.ensured)
+
+	pages := index.pages["exam-1"]
+	require.Len(t, pages, 2)
+	assert.Equal(t, opensearch.LibraryPage{ItemID: "exam-1", ItemType: "exam", Page: 1, Text: "Cau 1. Hinh hoc khong gian"}, pages[0])
+	// The blank second page is skipped but numbering follows the file
+	assert.Equal(t, 3, pages[1].Page)
+	assert.Equal(t, mark{fileKey: "|" + base + "/exam.pdf", status: repository.TextIndexStatusIndexed, pages: 3}, store.marks["exam-1"])
+
+	// The index is only created once
+	_, err = svc.IndexPending(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, index.ensured)
+}
+
+func TestIndexPending_RecordsUnreadableFiles(t *testing.T) {
+	svc, store, index, base := newHarness(t)
+	store.pending = []*repository.LibraryTextIndexCandidate{
+		{ItemID: "book-1", ItemType: "book", FileURL: base + "/notes.txt", FileKey: "a"},
+		{ItemID: "book-2", ItemType: "book", FileURL: base + "/missing.pdf", FileKey: "b"},
+		{ItemID: "book-3", ItemType: "book", FileID: "library/x.pdf", FileKey: "c"},
+	}
+
+	n, err := svc.IndexPending(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, ErrNotPDF.Error(), store.marks["book-1"].reason)
+	assert.Contains(t, store.marks["book-2"].reason, "404")
+	assert.Equal(t, ErrFileUnavailable.Error(), store.marks["book-3"].reason)
+	for _, id := range []string{"book-1", "book-2", "book-3"} {
+		assert.Equal(t, repository.TextIndexStatusFailed, store.marks[id].status)
+	}
+	// Pages of a previous file are dropped
+	assert.Equal(t, []string{"book-1", "book-2", "book-3"}, index.deleted)
+}
+
+func TestIndexPending_IndexErrorsLeaveItemPending(t *testing.T) {
+	svc, store, index, base := newHarness(t)
+	index.failWith = errors.New("cluster unavailable")
+	store.pending = []*repository.LibraryTextIndexCandidate{
+		{ItemID: "exam-1", ItemType: "exam", FileURL: base + "/exam.pdf", FileKey: "k"},
+	}
+
+	n, err := svc.IndexPending(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 0, n)
+	assert.Empty(t, store.marks)
+}
+
+func TestRemoveDeleted(t *testing.T) {
+	svc, store, index, _ := newHarness(t)
+	store.removed = []string{"gone-1", "gone-2"}
+
+	n, err := svc.RemoveDeleted(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"gone-1", "gone-2"}, index.deleted)
+	assert.Equal(t, []string{"gone-1", "gone-2"}, store.deleted)
+}
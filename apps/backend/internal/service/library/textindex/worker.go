@@ -0,0 +1,64 @@
+package textindex
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runOnce performs one pass of the background worker
+func (s *Service) runOnce(ctx context.Context) {
+	if n, err := s.IndexPending(ctx); err != nil {
+		log.Printf("[ERROR] [TextIndex] Failed to index library files: %v", err)
+	} else if n > 0 {
+		log.Printf("[INFO] [TextIndex] Indexed %d library files", n)
+	}
+	if n, err := s.RemoveDeleted(ctx); err != nil {
+		log.Printf("[ERROR] [TextIndex] Failed to remove deleted library files: %v", err)
+	} else if n > 0 {
+		log.Printf("[INFO] [TextIndex] Removed %d library files from the index", n)
+	}
+}
+
+// Start indexes new and changed library files on a schedule until Stop is called
+func (s *Service) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+				s.runOnce(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop ends the worker loop started by Start
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		s.wg.Wait()
+	}
+}
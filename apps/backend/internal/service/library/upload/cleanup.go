@@ -0,0 +1,60 @@
+package upload
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runOnce performs one pass of the background worker
+func (s *Service) runOnce(ctx context.Context) {
+	if n, err := s.ExpireSessions(ctx); err != nil {
+		log.Printf("[ERROR] [Upload] Failed to expire abandoned uploads: %v", err)
+	} else if n > 0 {
+		log.Printf("[INFO] [Upload] Expired %d abandoned uploads", n)
+	}
+}
+
+// Start expires abandoned uploads and frees their staged bytes on a schedule until
+// Stop is called
+func (s *Service) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+				s.runOnce(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop ends the worker loop started by Start
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		s.wg.Wait()
+	}
+}
@@ -0,0 +1,561 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/storage"
+	"exam-bank-system/apps/backend/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// Defaults applied by NewService
+const (
+	DefaultMaxActiveSessions = 5
+	DefaultMaxBytesPerDay    = 10 * 1024 * 1024 * 1024 // 10 GB
+	DefaultSessionTTL        = 24 * time.Hour
+	DefaultCheckInterval     = 15 * time.Minute
+
+	expireBatchSize = 100
+)
+
+// errStagingLost means the staged bytes are gone and the upload cannot be resumed
+var errStagingLost = errors.New("staging data lost")
+
+// Errors returned by the upload service
+var (
+	ErrNotFound         = errors.New("upload not found")
+	ErrGone             = errors.New("upload is no longer available")
+	ErrInvalidMetadata  = errors.New("invalid upload metadata")
+	ErrTooLarge         = errors.New("upload exceeds the allowed size")
+	ErrTooManyUploads   = errors.New("too many uploads in progress")
+	ErrQuotaExceeded    = errors.New("daily upload quota exceeded")
+	ErrOffsetMismatch   = errors.New("upload offset does not match")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	ErrBusy             = errors.New("upload is being written by another request")
+	ErrRejected         = errors.New("uploaded file was rejected")
+)
+
+// Store persists upload sessions, implemented by repository.LibraryUploadRepository
+type Store interface {
+	Create(ctx context.Context, session *repository.LibraryUploadSession) error
+	Get(ctx context.Context, id string) (*repository.LibraryUploadSession, error)
+	UpdateOffset(ctx context.Context, id string, from, to int64, expiresAt time.Time) error
+	SetStatus(ctx context.Context, id, status, reason string) error
+	Usage(ctx context.Context, userID string, since time.Time) (*repository.LibraryUploadUsage, error)
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*repository.LibraryUploadSession, error)
+	Complete(ctx context.Context, session *repository.LibraryUploadSession) error
+}
+
+// Config controls staging, quotas and session lifetime
+type Config struct {
+	BaseURL           string        // Absolute URL the tus handler is mounted at, e.g. http://localhost:8080/uploads
+	StagingDir        string        // Directory holding partial uploads
+	MaxActiveSessions int           // Unfinished uploads per user
+	MaxBytesPerDay    int64         // Bytes a user may declare over a rolling 24 hours
+	SessionTTL        time.Duration // Idle time after which an unfinished upload expires
+	CheckInterval     time.Duration // How often the worker expires abandoned uploads
+}
+
+// metadataKeys lists the Upload-Metadata keys kept on a session; anything else is dropped
+var metadataKeys = []string{
+	"filename", "filetype", "type", "title", "description", "category", "subject", "grade",
+	"author", "publisher", "province", "school", "academic_year", "semester", "exam_type",
+	"exam_duration", "question_count", "duration", "instructor_name", "sha256",
+}
+
+// requiredMetadata lists the item fields each type needs before bytes are accepted
+var requiredMetadata = map[string][]string{
+	"book":  {"title"},
+	"exam":  {"title", "subject", "grade", "academic_year", "exam_type"},
+	"video": {"title", "subject", "grade"},
+}
+
+// Service accepts resumable library uploads. Bytes are staged on local disk; once the
+// declared length has arrived the file is validated, moved to the blob store and a
+// PENDING library item is created for approval.
+type Service struct {
+	cfg       Config
+	baseURL   string
+	mountPath string
+
+	store     Store
+	blobs     storage.BlobStore
+	validator *validation.FileValidator
+	tokens    TokenValidator
+	now       func() time.Time
+
+	locks sync.Map // Session ID -> *uploadLock serialising writes
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewService validates the configuration and creates the staging directory
+func NewService(cfg Config, store Store, blobs storage.BlobStore, validator *validation.FileValidator, tokens TokenValidator) (*Service, error) {
+	base, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil || base.Scheme == "" || base.Host == "" || base.Path == "" {
+		return nil, fmt.Errorf("upload base URL must be absolute and include a path, got %q", cfg.BaseURL)
+	}
+	if blobs == nil {
+		return nil, errors.New("resumable uploads require blob storage")
+	}
+	if cfg.StagingDir == "" {
+		return nil, errors.New("upload staging directory is required")
+	}
+	if err := os.MkdirAll(cfg.StagingDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create upload staging directory: %w", err)
+	}
+	if cfg.MaxActiveSessions <= 0 {
+		cfg.MaxActiveSessions = DefaultMaxActiveSessions
+	}
+	if cfg.MaxBytesPerDay <= 0 {
+		cfg.MaxBytesPerDay = DefaultMaxBytesPerDay
+	}
+	if cfg.SessionTTL <= 0 {
+		cfg.SessionTTL = DefaultSessionTTL
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = DefaultCheckInterval
+	}
+	if validator == nil {
+		validator = validation.NewFileValidator()
+	}
+
+	return &Service{
+		cfg:       cfg,
+		baseURL:   base.String(),
+		mountPath: base.Path,
+		store:     store,
+		blobs:     blobs,
+		validator: validator,
+		tokens:    tokens,
+		now:       time.Now,
+	}, nil
+}
+
+// MountPath is the URL path the HTTP handler must be mounted at
+func (s *Service) MountPath() string {
+	return s.mountPath
+}
+
+// Create opens an upload of length bytes for the user after checking the metadata,
+// the file name and size against the item type, and the user's quota
+func (s *Service) Create(ctx context.Context, userID string, length int64, metadata map[string]string) (*repository.LibraryUploadSession, error) {
+	meta := make(map[string]string, len(metadataKeys))
+	for _, key := range metadataKeys {
+		if value := strings.TrimSpace(metadata[key]); value != "" {
+			meta[key] = value
+		}
+	}
+	itemType := strings.ToLower(meta["type"])
+	required, ok := requiredMetadata[itemType]
+	if !ok {
+		return nil, fmt.Errorf("%w: type must be book, exam or video", ErrInvalidMetadata)
+	}
+	for _, key := range required {
+		if meta[key] == "" {
+			return nil, fmt.Errorf("%w: %s is required", ErrInvalidMetadata, key)
+		}
+	}
+	if sum := meta["sha256"]; sum != "" {
+		if decoded, err := hex.DecodeString(sum); err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("%w: sha256 must be a hex encoded SHA-256 digest", ErrInvalidMetadata)
+		}
+		meta["sha256"] = strings.ToLower(sum)
+	}
+
+	filename := meta["filename"]
+	contentType := contentTypeFor(filename, meta["filetype"])
+	err := s.validator.ValidateFile(validation.FileInfo{Filename: filename, Size: length, MimeType: contentType}, fileTypeFor(itemType))
+	var fieldErr validation.FileValidationError
+	if errors.As(err, &fieldErr) && fieldErr.Field == "size" && length > 0 {
+		return nil, fmt.Errorf("%w: %v", ErrTooLarge, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+
+	now := s.now()
+	usage, err := s.store.Usage(ctx, userID, now.Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if usage.ActiveSessions >= s.cfg.MaxActiveSessions {
+		return nil, ErrTooManyUploads
+	}
+	if usage.ReservedBytes+length > s.cfg.MaxBytesPerDay {
+		return nil, ErrQuotaExceeded
+	}
+
+	session := &repository.LibraryUploadSession{
+		UserID:       userID,
+		ItemType:     itemType,
+		Filename:     s.validator.SanitizeFilename(filename),
+		ContentType:  contentType,
+		Metadata:     meta,
+		UploadLength: length,
+		ExpiresAt:    now.Add(s.cfg.SessionTTL),
+	}
+	if err := s.store.Create(ctx, session); err != nil {
+		return nil, err
+	}
+	staged, err := os.OpenFile(s.stagingPath(session.ID), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		_ = s.store.SetStatus(ctx, session.ID, repository.UploadStatusFailed, "staging unavailable")
+		return nil, fmt.Errorf("failed to create staging file: %w", err)
+	}
+	staged.Close()
+	return session, nil
+}
+
+// Get returns one of the user's uploads. Other users' uploads are reported as not found.
+func (s *Service) Get(ctx context.Context, userID, id string) (*repository.LibraryUploadSession, error) {
+	session, err := s.store.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) || (err == nil && session.UserID != userID) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch session.Status {
+	case repository.UploadStatusCompleted:
+		return session, nil
+	case repository.UploadStatusUploading:
+		if s.now().After(session.ExpiresAt) {
+			return nil, ErrGone
+		}
+		return session, nil
+	default:
+		return nil, ErrGone
+	}
+}
+
+// Checksum is a digest the client sent with a chunk
+type Checksum struct {
+	Algorithm string // sha1, sha256 or md5
+	Sum       []byte
+}
+
+// Append writes a chunk at offset. Bytes received before a dropped connection are kept
+// unless the chunk carries a checksum, in which case the whole chunk is discarded on any
+// error. When the final byte arrives the upload is completed before Append returns.
+func (s *Service) Append(ctx context.Context, userID, id string, offset int64, body io.Reader, checksum *Checksum) (*repository.LibraryUploadSession, error) {
+	lock := s.lock(id)
+	if !lock.TryLock() {
+		return nil, ErrBusy
+	}
+	defer s.unlock(id, lock)
+
+	session, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != repository.UploadStatusUploading || offset != session.UploadOffset {
+		return session, ErrOffsetMismatch
+	}
+
+	var digest hash.Hash
+	if checksum != nil {
+		if digest = newDigest(checksum.Algorithm); digest == nil {
+			return nil, fmt.Errorf("%w: unsupported checksum algorithm %q", ErrInvalidMetadata, checksum.Algorithm)
+		}
+	}
+
+	written, copyErr := s.writeChunk(session, body, digest)
+	if errors.Is(copyErr, errStagingLost) {
+		return nil, s.fail(ctx, session, "staging data lost", copyErr)
+	}
+	if copyErr == nil && digest != nil && !bytes.Equal(digest.Sum(nil), checksum.Sum) {
+		copyErr = ErrChecksumMismatch
+	}
+	if copyErr != nil && (digest != nil || errors.Is(copyErr, ErrTooLarge)) {
+		written = 0
+	}
+	if err := os.Truncate(s.stagingPath(id), offset+written); err != nil {
+		return nil, s.fail(ctx, session, "staging data lost", err)
+	}
+	if written > 0 {
+		expiresAt := s.now().Add(s.cfg.SessionTTL)
+		if err := s.store.UpdateOffset(ctx, id, offset, offset+written, expiresAt); err != nil {
+			if errors.Is(err, repository.ErrUploadOffsetMismatch) {
+				return nil, ErrOffsetMismatch
+			}
+			return nil, err
+		}
+		session.UploadOffset = offset + written
+		session.ExpiresAt = expiresAt
+	}
+	if copyErr != nil {
+		return session, copyErr
+	}
+
+	if session.UploadOffset == session.UploadLength {
+		if err := s.complete(ctx, session); err != nil {
+			return session, err
+		}
+	}
+	return session, nil
+}
+
+// writeChunk appends body to the staging file, never past the declared length
+func (s *Service) writeChunk(session *repository.LibraryUploadSession, body io.Reader, digest hash.Hash) (int64, error) {
+	staged, err := os.OpenFile(s.stagingPath(session.ID), os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errStagingLost, err)
+	}
+	defer staged.Close()
+
+	info, err := staged.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() < session.UploadOffset {
+		// Another replica staged the earlier chunks, or the staging directory was wiped
+		return 0, fmt.Errorf("%w: have %d of %d bytes", errStagingLost, info.Size(), session.UploadOffset)
+	}
+	if _, err := staged.Seek(session.UploadOffset, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	var w io.Writer = staged
+	if digest != nil {
+		w = io.MultiWriter(staged, digest)
+	}
+	remaining := session.UploadLength - session.UploadOffset
+	written, err := io.Copy(w, io.LimitReader(body, remaining))
+	if err == nil && written == remaining {
+		var probe [1]byte
+		if n, _ := body.Read(probe[:]); n > 0 {
+			err = ErrTooLarge
+		}
+	}
+	if syncErr := staged.Sync(); err == nil {
+		err = syncErr
+	}
+	return written, err
+}
+
+// complete validates the staged file, moves it to the blob store and creates the item
+func (s *Service) complete(ctx context.Context, session *repository.LibraryUploadSession) error {
+	path := s.stagingPath(session.ID)
+	staged, err := os.Open(path)
+	if err != nil {
+		return s.fail(ctx, session, "staging data lost", err)
+	}
+	defer staged.Close()
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(staged, head)
+	if reason := s.checkContent(session, head[:n]); reason != "" {
+		return s.reject(ctx, session, reason)
+	}
+
+	digest := sha256.New()
+	if _, err := staged.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.Copy(digest, staged); err != nil {
+		return err
+	}
+	sum := hex.EncodeToString(digest.Sum(nil))
+	if expected := session.Metadata["sha256"]; expected != "" && expected != sum {
+		return s.reject(ctx, session, "sha256 of the uploaded file does not match the declared checksum")
+	}
+
+	key := fmt.Sprintf("%s%s/%s/%s-%s", storage.LibraryPrefix, session.ItemType,
+		s.now().UTC().Format("2006/01"), uuid.New().String(), session.Filename)
+	if _, err := staged.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := s.blobs.Put(ctx, key, staged, session.UploadLength, session.ContentType); err != nil {
+		return fmt.Errorf("failed to store upload: %w", err)
+	}
+
+	session.FileID = key
+	session.ChecksumSHA256 = sum
+	if err := s.store.Complete(ctx, session); err != nil {
+		if delErr := s.blobs.Delete(ctx, key); delErr != nil {
+			log.Printf("[WARN] [Upload] Failed to remove orphaned blob %s: %v", key, delErr)
+		}
+		return fmt.Errorf("failed to create library item: %w", err)
+	}
+
+	staged.Close()
+	s.discard(session.ID)
+	log.Printf("[INFO] [Upload] Upload %s completed as %s item %s", session.ID, session.ItemType, session.LibraryItemID)
+	return nil
+}
+
+// checkContent sniffs the first bytes of a finished upload and returns why it is
+// unacceptable, or an empty string
+func (s *Service) checkContent(session *repository.LibraryUploadSession, head []byte) string {
+	fileType := fileTypeFor(session.ItemType)
+	if err := s.validator.ValidateFile(validation.FileInfo{
+		Filename: session.Filename,
+		Size:     session.UploadLength,
+		MimeType: session.ContentType,
+	}, fileType); err != nil {
+		return err.Error()
+	}
+
+	sniffed := http.DetectContentType(head)
+	switch fileType {
+	case validation.FileTypePDF:
+		if !bytes.HasPrefix(head, []byte("%PDF-")) {
+			return "file content is not a PDF document"
+		}
+	case validation.FileTypeVideo:
+		// Some containers (QuickTime, Matroska variants) are not recognised by the sniffer
+		if !strings.HasPrefix(sniffed, "video/") && sniffed != "application/octet-stream" {
+			return fmt.Sprintf("file content looks like %s, not a video", sniffed)
+		}
+	}
+	return ""
+}
+
+// Terminate cancels an unfinished upload and frees its staged bytes
+func (s *Service) Terminate(ctx context.Context, userID, id string) error {
+	lock := s.lock(id)
+	if !lock.TryLock() {
+		return ErrBusy
+	}
+	defer s.unlock(id, lock)
+
+	session, err := s.Get(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if session.Status != repository.UploadStatusUploading {
+		return ErrGone
+	}
+	if err := s.store.SetStatus(ctx, id, repository.UploadStatusTerminated, ""); err != nil {
+		return err
+	}
+	s.discard(id)
+	return nil
+}
+
+// ExpireSessions ends uploads that stayed idle past their expiry and returns how many
+// were expired
+func (s *Service) ExpireSessions(ctx context.Context) (int, error) {
+	sessions, err := s.store.ListExpired(ctx, s.now(), expireBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, session := range sessions {
+		lock := s.lock(session.ID)
+		if !lock.TryLock() {
+			continue // A chunk is arriving right now
+		}
+		err := s.store.SetStatus(ctx, session.ID, repository.UploadStatusExpired, "upload expired")
+		if err == nil {
+			s.discard(session.ID)
+			expired++
+		} else {
+			log.Printf("[WARN] [Upload] Failed to expire upload %s: %v", session.ID, err)
+		}
+		s.unlock(session.ID, lock)
+	}
+	return expired, nil
+}
+
+// reject fails an upload whose content did not pass validation
+func (s *Service) reject(ctx context.Context, session *repository.LibraryUploadSession, reason string) error {
+	if err := s.store.SetStatus(ctx, session.ID, repository.UploadStatusFailed, reason); err != nil {
+		return err
+	}
+	s.discard(session.ID)
+	session.Status = repository.UploadStatusFailed
+	session.Error = reason
+	return fmt.Errorf("%w: %s", ErrRejected, reason)
+}
+
+// fail ends an upload that can no longer be resumed
+func (s *Service) fail(ctx context.Context, session *repository.LibraryUploadSession, reason string, cause error) error {
+	log.Printf("[WARN] [Upload] Upload %s failed: %s: %v", session.ID, reason, cause)
+	if err := s.store.SetStatus(ctx, session.ID, repository.UploadStatusFailed, reason); err != nil {
+		return err
+	}
+	s.discard(session.ID)
+	return ErrGone
+}
+
+// discard removes the staged bytes of an ended upload. It is called with the upload's
+// lock held; the lock entry itself is removed by unlock.
+func (s *Service) discard(id string) {
+	if lock, ok := s.locks.Load(id); ok {
+		lock.(*uploadLock).discarded = true
+	}
+	if err := os.Remove(s.stagingPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("[WARN] [Upload] Failed to remove staged upload %s: %v", id, err)
+	}
+}
+
+// uploadLock serialises writes to one upload. discarded is set under the lock once the
+// upload has ended.
+type uploadLock struct {
+	sync.Mutex
+	discarded bool
+}
+
+func (s *Service) lock(id string) *uploadLock {
+	lock, _ := s.locks.LoadOrStore(id, &uploadLock{})
+	return lock.(*uploadLock)
+}
+
+// unlock releases the lock and forgets it when the upload was discarded. Deleting the
+// entry while it is held would let another request lock a fresh mutex and run alongside
+// the holder; a request that still locks the old one only finds the upload gone.
+func (s *Service) unlock(id string, lock *uploadLock) {
+	discarded := lock.discarded
+	lock.Unlock()
+	if discarded {
+		s.locks.CompareAndDelete(id, lock)
+	}
+}
+
+// stagingPath maps a session ID to its staging file. IDs are ULIDs, so the base name
+// can never leave the staging directory.
+func (s *Service) stagingPath(id string) string {
+	return filepath.Join(s.cfg.StagingDir, filepath.Base(id)+".part")
+}
+
+func fileTypeFor(itemType string) validation.FileType {
+	if itemType == "video" {
+		return validation.FileTypeVideo
+	}
+	return validation.FileTypePDF
+}
+
+// contentTypeFor prefers the client's declared type and falls back to the extension
+func contentTypeFor(filename, declared string) string {
+	if mediaType, _, err := mime.ParseMediaType(declared); err == nil {
+		return mediaType
+	}
+	if byExt := mime.TypeByExtension(strings.ToLower(filepath.Ext(filename))); byExt != "" {
+		mediaType, _, _ := mime.ParseMediaType(byExt)
+		return mediaType
+	}
+	return ""
+}
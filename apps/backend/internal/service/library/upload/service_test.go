@@ -0,0 +1,403 @@
+package upload
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth"
+	"exam-bank-system/apps/backend/internal/service/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore keeps sessions in memory and mimics the repository's guarded updates
+type fakeStore struct {
+	mu       sync.Mutex
+	sessions map[string]*repository.LibraryUploadSession
+	next     int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{sessions: make(map[string]*repository.LibraryUploadSession)}
+}
+
+func (f *fakeStore) Create(ctx context.Context, session *repository.LibraryUploadSession) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next++
+	session.ID = "01UPLOAD" + strconv.Itoa(f.next)
+	session.Status = repository.UploadStatusUploading
+	session.CreatedAt = time.Now()
+	copied := *session
+	f.sessions[session.ID] = &copied
+	return nil
+}
+
+func (f *fakeStore) Get(ctx context.Context, id string) (*repository.LibraryUploadSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	session, ok := f.sessions[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *session
+	return &copied, nil
+}
+
+func (f *fakeStore) UpdateOffset(ctx context.Context, id string, from, to int64, expiresAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	session := f.sessions[id]
+	if session.UploadOffset != from || session.Status != repository.UploadStatusUploading {
+		return repository.ErrUploadOffsetMismatch
+	}
+	session.UploadOffset, session.ExpiresAt = to, expiresAt
+	return nil
+}
+
+func (f *fakeStore) SetStatus(ctx context.Context, id, status, reason string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if session := f.sessions[id]; session.Status == repository.UploadStatusUploading {
+		session.Status, session.Error = status, reason
+	}
+	return nil
+}
+
+func (f *fakeStore) Usage(ctx context.Context, userID string, since time.Time) (*repository.LibraryUploadUsage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	usage := &repository.LibraryUploadUsage{}
+	for _, session := range f.sessions {
+		if session.UserID != userID {
+			continue
+		}
+		if session.Status == repository.UploadStatusUploading {
+			usage.ActiveSessions++
+		}
+		if session.Status != repository.UploadStatusTerminated {
+			usage.ReservedBytes += session.UploadLength
+		}
+	}
+	return usage, nil
+}
+
+func (f *fakeStore) ListExpired(ctx context.Context, now time.Time, limit int) ([]*repository.LibraryUploadSession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var expired []*repository.LibraryUploadSession
+	for _, session := range f.sessions {
+		if session.Status == repository.UploadStatusUploading && session.ExpiresAt.Before(now) {
+			copied := *session
+			expired = append(expired, &copied)
+		}
+	}
+	return expired, nil
+}
+
+func (f *fakeStore) Complete(ctx context.Context, session *repository.LibraryUploadSession) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored := f.sessions[session.ID]
+	stored.Status = repository.UploadStatusCompleted
+	stored.FileID, stored.ChecksumSHA256 = session.FileID, session.ChecksumSHA256
+	stored.LibraryItemID = "item-" + session.ID
+	session.Status, session.LibraryItemID = stored.Status, stored.LibraryItemID
+	return nil
+}
+
+type fakeTokens map[string]*auth.UnifiedClaims
+
+func (f fakeTokens) ValidateAccessToken(token string) (*auth.UnifiedClaims, error) {
+	if claims, ok := f[token]; ok {
+		return claims, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+type harness struct {
+	svc   *Service
+	store *fakeStore
+	blobs storage.BlobStore
+	now   time.Time
+}
+
+func newHarness(t *testing.T) *harness {
+	blobs, err := storage.NewLocalBlobStore(t.TempDir(), "http://localhost:8080/blobs", "blob-key")
+	require.NoError(t, err)
+	store := newFakeStore()
+	tokens := fakeTokens{
+		"teacher":  {UserID: "teacher-1", Role: "TEACHER"},
+		"teacher2": {UserID: "teacher-2", Role: "TEACHER"},
+		"student":  {UserID: "student-1", Role: "STUDENT"},
+	}
+	svc, err := NewService(Config{
+		BaseURL:           "http://localhost:8080/uploads",
+		StagingDir:        t.TempDir(),
+		MaxActiveSessions: 2,
+	}, store, blobs, nil, tokens)
+	require.NoError(t, err)
+
+	h := &harness{svc: svc, store: store, blobs: blobs, now: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)}
+	svc.now = func() time.Time { return h.now }
+	return h
+}
+
+func (h *harness) do(method, path, token string, headers map[string]string, body io.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, body)
+	req.Header.Set("Tus-Resumable", "1.0.0")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.svc.ServeHTTP(rec, req)
+	return rec
+}
+
+func encodeMetadata(pairs ...string) string {
+	var parts []string
+	for i := 0; i < len(pairs); i += 2 {
+		parts = append(parts, pairs[i]+" "+base64.StdEncoding.EncodeToString([]byte(pairs[i+1])))
+	}
+	return strings.Join(parts, ",")
+}
+
+// create opens an upload and returns its path below the mount point
+func (h *harness) create(t *testing.T, token string, length int, metadata string) string {
+	rec := h.do(http.MethodPost, "/uploads", token, map[string]string{
+		"Upload-Length":   strconv.Itoa(length),
+		"Upload-Metadata": metadata,
+	}, nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	location := rec.Header().Get("Location")
+	require.True(t, strings.HasPrefix(location, "http://localhost:8080/uploads/"), location)
+	return strings.TrimPrefix(location, "http://localhost:8080")
+}
+
+func (h *harness) patch(path, token string, offset int, body io.Reader, checksum string) *httptest.ResponseRecorder {
+	headers := map[string]string{
+		"Content-Type":  "application/offset+octet-stream",
+		"Upload-Offset": strconv.Itoa(offset),
+	}
+	if checksum != "" {
+		headers["Upload-Checksum"] = checksum
+	}
+	return h.do(http.MethodPatch, path, token, headers, body)
+}
+
+// brokenReader delivers some bytes and then fails like a dropped connection
+type brokenReader struct {
+	data []byte
+}
+
+func (b *brokenReader) Read(p []byte) (int, error) {
+	if len(b.data) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, b.data)
+	b.data = b.data[n:]
+	return n, nil
+}
+
+func bookPDF() []byte {
+	return []byte("%PDF-1.4\n" + strings.Repeat("lecture notes ", 200) + "\n%%EOF\n")
+}
+
+func TestOptions_AdvertisesExtensions(t *testing.T) {
+	h := newHarness(t)
+	rec := h.do(http.MethodOptions, "/uploads", "", nil, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "1.0.0", rec.Header().Get("Tus-Version"))
+	assert.Equal(t, "creation,expiration,checksum,termination", rec.Header().Get("Tus-Extension"))
+	assert.Equal(t, "sha1,sha256,md5", rec.Header().Get("Tus-Checksum-Algorithm"))
+	assert.NotEmpty(t, rec.Header().Get("Tus-Max-Size"))
+}
+
+func TestUpload_ResumesAndCreatesPendingItem(t *testing.T) {
+	h := newHarness(t)
+	content := bookPDF()
+	whole := sha256.Sum256(content)
+	path := h.create(t, "teacher", len(content), encodeMetadata(
+		"filename", "Đại số 10.pdf", "filetype", "application/pdf", "type", "book",
+		"title", "Đại số 10", "sha256", hex.EncodeToString(whole[:]), "ignored", "x"))
+
+	// The connection drops after 1000 bytes; the received bytes are kept
+	rec := h.patch(path, "teacher", 0, &brokenReader{data: content[:1000]}, "")
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	rec = h.do(http.MethodHead, path, "teacher", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1000", rec.Header().Get("Upload-Offset"))
+	assert.Equal(t, strconv.Itoa(len(content)), rec.Header().Get("Upload-Length"))
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	assert.NotEmpty(t, rec.Header().Get("Upload-Expires"))
+
+	// A stale offset is refused
+	rec = h.patch(path, "teacher", 0, strings.NewReader("x"), "")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// A corrupted chunk is discarded
+	rest := content[1000:]
+	digest := sha1.Sum(rest)
+	checksum := "sha1 " + base64.StdEncoding.EncodeToString(digest[:])
+	corrupted := append([]byte{'X'}, rest[1:]...)
+	rec = h.patch(path, "teacher", 1000, strings.NewReader(string(corrupted)), checksum)
+	assert.Equal(t, 460, rec.Code)
+	assert.Equal(t, "1000", rec.Header().Get("Upload-Offset"))
+
+	rec = h.patch(path, "teacher", 1000, strings.NewReader(string(rest)), checksum)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Equal(t, strconv.Itoa(len(content)), rec.Header().Get("Upload-Offset"))
+	id := strings.TrimPrefix(path, "/uploads/")
+	assert.Equal(t, "item-"+id, rec.Header().Get(ItemHeader))
+
+	session := h.store.sessions[id]
+	assert.Equal(t, repository.UploadStatusCompleted, session.Status)
+	assert.Equal(t, hex.EncodeToString(whole[:]), session.ChecksumSHA256)
+	assert.True(t, strings.HasPrefix(session.FileID, "library/book/2026/10/"), session.FileID)
+	assert.NotContains(t, session.Metadata, "ignored")
+
+	stored, info, err := h.blobs.Get(context.Background(), session.FileID)
+	require.NoError(t, err)
+	defer stored.Close()
+	data, _ := io.ReadAll(stored)
+	assert.Equal(t, content, data)
+	assert.Equal(t, "application/pdf", info.ContentType)
+
+	_, err = os.Stat(h.svc.stagingPath(id))
+	assert.True(t, os.IsNotExist(err))
+
+	// Completed uploads still report their item
+	rec = h.do(http.MethodHead, path, "teacher", nil, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "item-"+id, rec.Header().Get(ItemHeader))
+}
+
+func TestUpload_RejectsContentOnCompletion(t *testing.T) {
+	h := newHarness(t)
+	content := []byte("<html>not a pdf</html>")
+	path := h.create(t, "teacher", len(content), encodeMetadata("filename", "exam.pdf", "type", "exam",
+		"title", "Đề thi thử", "subject", "Toán", "grade", "12", "academic_year", "2026-2027", "exam_type", "mock"))
+
+	rec := h.patch(path, "teacher", 0, strings.NewReader(string(content)), "")
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, rec.Body.String(), "not a PDF")
+
+	id := strings.TrimPrefix(path, "/uploads/")
+	assert.Equal(t, repository.UploadStatusFailed, h.store.sessions[id].Status)
+	assert.Equal(t, http.StatusGone, h.do(http.MethodHead, path, "teacher", nil, nil).Code)
+}
+
+func TestUpload_CreateRejections(t *testing.T) {
+	h := newHarness(t)
+	video := encodeMetadata("filename", "lecture.mp4", "type", "video", "title", "Bài giảng", "subject", "Lý", "grade", "11")
+
+	rec := h.do(http.MethodPost, "/uploads", "student", map[string]string{"Upload-Length": "10", "Upload-Metadata": video}, nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = h.do(http.MethodPost, "/uploads", "", map[string]string{"Upload-Length": "10", "Upload-Metadata": video}, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodPost, "/uploads", nil)
+	req.Header.Set("Authorization", "Bearer teacher")
+	rec = httptest.NewRecorder()
+	h.svc.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	// Type rules are checked before any byte is accepted
+	cases := map[string]int{
+		encodeMetadata("filename", "lecture.exe", "type", "video", "title", "x", "subject", "Lý", "grade", "11"): http.StatusBadRequest,
+		encodeMetadata("filename", "notes.pdf", "type", "exam", "title", "x"):                                    http.StatusBadRequest,
+		encodeMetadata("filename", "notes.pdf", "type", "poster", "title", "x"):                                  http.StatusBadRequest,
+		encodeMetadata("filename", "notes.pdf", "type", "book", "title", "x", "sha256", "abc"):                   http.StatusBadRequest,
+		"filename not-base64!": http.StatusBadRequest,
+		encodeMetadata("filename", "big.pdf", "type", "book", "title", "x"): http.StatusRequestEntityTooLarge,
+	}
+	for metadata, code := range cases {
+		length := "10"
+		if strings.Contains(metadata, base64.StdEncoding.EncodeToString([]byte("big.pdf"))) {
+			length = strconv.Itoa(60 * 1024 * 1024)
+		}
+		rec = h.do(http.MethodPost, "/uploads", "teacher", map[string]string{"Upload-Length": length, "Upload-Metadata": metadata}, nil)
+		assert.Equal(t, code, rec.Code, metadata)
+	}
+
+	// Videos may be large, but only a few uploads may be open at once
+	h.create(t, "teacher", 2*1024*1024*1024, video)
+	path := h.create(t, "teacher", 10, video)
+	rec = h.do(http.MethodPost, "/uploads", "teacher", map[string]string{"Upload-Length": "10", "Upload-Metadata": video}, nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	// Other users cannot see or cancel the upload
+	assert.Equal(t, http.StatusNotFound, h.do(http.MethodHead, path, "teacher2", nil, nil).Code)
+	assert.Equal(t, http.StatusNotFound, h.do(http.MethodDelete, path, "teacher2", nil, nil).Code)
+
+	assert.Equal(t, http.StatusNoContent, h.do(http.MethodDelete, path, "teacher", nil, nil).Code)
+	assert.Equal(t, http.StatusGone, h.do(http.MethodHead, path, "teacher", nil, nil).Code)
+	h.create(t, "teacher", 10, video)
+}
+
+func TestExpireSessions_RemovesStagedBytes(t *testing.T) {
+	h := newHarness(t)
+	path := h.create(t, "teacher", 100, encodeMetadata("filename", "book.pdf", "type", "book", "title", "Sách"))
+	id := strings.TrimPrefix(path, "/uploads/")
+	require.Equal(t, http.StatusNoContent, h.patch(path, "teacher", 0, strings.NewReader("%PDF-1.4"), "").Code)
+
+	h.now = h.now.Add(DefaultSessionTTL - time.Minute)
+	n, err := h.svc.ExpireSessions(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	h.now = h.now.Add(2 * time.Minute)
+	assert.Equal(t, http.StatusGone, h.do(http.MethodHead, path, "teacher", nil, nil).Code)
+	n, err = h.svc.ExpireSessions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, repository.UploadStatusExpired, h.store.sessions[id].Status)
+	_, err = os.Stat(h.svc.stagingPath(id))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDiscard_KeepsLockUntilReleased(t *testing.T) {
+	h := newHarness(t)
+	path := h.create(t, "teacher", 100, encodeMetadata("filename", "book.pdf", "type", "book", "title", "Sách"))
+	id := strings.TrimPrefix(path, "/uploads/")
+
+	lock := h.svc.lock(id)
+	require.True(t, lock.TryLock())
+	h.svc.discard(id)
+
+	// Until the holder releases the lock, other requests still find it held
+	_, err := h.svc.Append(context.Background(), "teacher-id", id, 0, strings.NewReader("%PDF-1.4"), nil)
+	assert.ErrorIs(t, err, ErrBusy)
+	assert.ErrorIs(t, h.svc.Terminate(context.Background(), "teacher-id", id), ErrBusy)
+
+	h.svc.unlock(id, lock)
+	_, ok := h.svc.locks.Load(id)
+	assert.False(t, ok)
+
+	// Terminating an upload forgets its lock once the request is done
+	path = h.create(t, "teacher", 100, encodeMetadata("filename", "book.pdf", "type", "book", "title", "Sách"))
+	id = strings.TrimPrefix(path, "/uploads/")
+	require.Equal(t, http.StatusNoContent, h.do(http.MethodDelete, path, "teacher", nil, nil).Code)
+	_, ok = h.svc.locks.Load(id)
+	assert.False(t, ok)
+}
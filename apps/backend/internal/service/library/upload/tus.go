@@ -0,0 +1,281 @@
+package upload
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/auth"
+	"exam-bank-system/apps/backend/internal/validation"
+)
+
+// tus 1.0 protocol constants
+const (
+	tusVersion     = "1.0.0"
+	tusExtensions  = "creation,expiration,checksum,termination"
+	tusChecksums   = "sha1,sha256,md5"
+	offsetOctetStr = "application/offset+octet-stream"
+
+	// statusChecksumMismatch is the tus checksum extension's "Checksum Mismatch" status
+	statusChecksumMismatch = 460
+
+	// ItemHeader carries the ID of the library item created by a completed upload
+	ItemHeader = "Library-Item-Id"
+)
+
+// CORSAllowedHeaders and CORSExposedHeaders list the headers browser tus clients send
+// and read, for the HTTP server's CORS policy
+var (
+	CORSAllowedHeaders = []string{"Tus-Resumable", "Upload-Length", "Upload-Offset", "Upload-Metadata", "Upload-Checksum", "X-HTTP-Method-Override"}
+	CORSExposedHeaders = []string{"Location", "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size", "Tus-Checksum-Algorithm", "Upload-Offset", "Upload-Length", "Upload-Expires", ItemHeader}
+)
+
+// TokenValidator verifies access tokens, implemented by auth.UnifiedJWTService
+type TokenValidator interface {
+	ValidateAccessToken(tokenString string) (*auth.UnifiedClaims, error)
+}
+
+// uploaderRoles may add files to the library, matching CreateItem's TEACHER minimum
+var uploaderRoles = map[string]bool{"TEACHER": true, "ADMIN": true}
+
+// ServeHTTP implements the tus 1.0 core protocol with the creation, expiration,
+// checksum and termination extensions
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+
+	method := r.Method
+	if override := r.Header.Get("X-HTTP-Method-Override"); override != "" && method == http.MethodPost {
+		method = strings.ToUpper(override)
+	}
+	if method == http.MethodOptions {
+		w.Header().Set("Tus-Version", tusVersion)
+		w.Header().Set("Tus-Extension", tusExtensions)
+		w.Header().Set("Tus-Max-Size", strconv.FormatInt(validation.MaxVideoSize, 10))
+		w.Header().Set("Tus-Checksum-Algorithm", tusChecksums)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Header.Get("Tus-Resumable") != tusVersion {
+		w.Header().Set("Tus-Version", tusVersion)
+		http.Error(w, "unsupported tus version", http.StatusPreconditionFailed)
+		return
+	}
+
+	rest, ok := strings.CutPrefix(r.URL.Path, s.mountPath)
+	id := strings.Trim(rest, "/")
+	if !ok || (rest != "" && rest[0] != '/') || strings.Contains(id, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	hasID := id != ""
+
+	userID, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	switch {
+	case method == http.MethodPost && !hasID:
+		s.handleCreate(w, r, userID)
+	case method == http.MethodHead && hasID:
+		s.handleHead(w, r, userID, id)
+	case method == http.MethodPatch && hasID:
+		s.handlePatch(w, r, userID, id)
+	case method == http.MethodDelete && hasID:
+		if err := s.Terminate(r.Context(), userID, id); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.Header().Set("Allow", "OPTIONS, POST, HEAD, PATCH, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// authenticate resolves the bearer token to a teacher or admin
+func (s *Service) authenticate(w http.ResponseWriter, r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || s.tokens == nil {
+		http.Error(w, "authentication required", http.StatusUnauthorized)
+		return "", false
+	}
+	claims, err := s.tokens.ValidateAccessToken(strings.TrimSpace(token))
+	if err != nil || claims.UserID == "" {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return "", false
+	}
+	if !uploaderRoles[claims.Role] {
+		http.Error(w, "only teachers and admins can upload library files", http.StatusForbidden)
+		return "", false
+	}
+	return claims.UserID, true
+}
+
+func (s *Service) handleCreate(w http.ResponseWriter, r *http.Request, userID string) {
+	if r.Header.Get("Upload-Defer-Length") != "" {
+		http.Error(w, "Upload-Defer-Length is not supported", http.StatusBadRequest)
+		return
+	}
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		http.Error(w, "Upload-Length must be a positive integer", http.StatusBadRequest)
+		return
+	}
+	if length > validation.MaxVideoSize {
+		http.Error(w, ErrTooLarge.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	metadata, err := parseMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session, err := s.Create(r.Context(), userID, length, metadata)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Location", s.baseURL+"/"+session.ID)
+	w.Header().Set("Upload-Expires", session.ExpiresAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Service) handleHead(w http.ResponseWriter, r *http.Request, userID, id string) {
+	session, err := s.Get(r.Context(), userID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeSessionHeaders(w, session)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Service) handlePatch(w http.ResponseWriter, r *http.Request, userID, id string) {
+	if r.Header.Get("Content-Type") != offsetOctetStr {
+		http.Error(w, "Content-Type must be "+offsetOctetStr, http.StatusUnsupportedMediaType)
+		return
+	}
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		http.Error(w, "Upload-Offset must be a non-negative integer", http.StatusBadRequest)
+		return
+	}
+	checksum, err := parseChecksum(r.Header.Get("Upload-Checksum"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session, err := s.Append(r.Context(), userID, id, offset, r.Body, checksum)
+	if session != nil && !errors.Is(err, ErrRejected) {
+		writeSessionHeaders(w, session)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeSessionHeaders(w http.ResponseWriter, session *repository.LibraryUploadSession) {
+	w.Header().Set("Upload-Offset", strconv.FormatInt(session.UploadOffset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(session.UploadLength, 10))
+	if session.Status == repository.UploadStatusUploading {
+		w.Header().Set("Upload-Expires", session.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	if session.LibraryItemID != "" {
+		w.Header().Set(ItemHeader, session.LibraryItemID)
+	}
+}
+
+// writeError maps service errors to tus status codes
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrNotFound):
+		code = http.StatusNotFound
+	case errors.Is(err, ErrGone):
+		code = http.StatusGone
+	case errors.Is(err, ErrInvalidMetadata):
+		code = http.StatusBadRequest
+	case errors.Is(err, ErrTooLarge):
+		code = http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrTooManyUploads), errors.Is(err, ErrQuotaExceeded):
+		code = http.StatusTooManyRequests
+	case errors.Is(err, ErrOffsetMismatch):
+		code = http.StatusConflict
+	case errors.Is(err, ErrBusy):
+		code = http.StatusLocked
+	case errors.Is(err, ErrChecksumMismatch):
+		code = statusChecksumMismatch
+	case errors.Is(err, ErrRejected):
+		code = http.StatusUnprocessableEntity
+	}
+	if code == http.StatusInternalServerError {
+		log.Printf("[ERROR] [Upload] Request failed: %v", err)
+		http.Error(w, "upload failed", code)
+		return
+	}
+	http.Error(w, err.Error(), code)
+}
+
+// parseMetadata decodes the comma-separated "key base64(value)" pairs of Upload-Metadata
+func parseMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, fmt.Errorf("%w: empty key", ErrInvalidMetadata)
+		}
+		value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s is not base64 encoded", ErrInvalidMetadata, key)
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+// parseChecksum decodes an "algorithm base64(digest)" Upload-Checksum header
+func parseChecksum(header string) (*Checksum, error) {
+	if header == "" {
+		return nil, nil
+	}
+	algorithm, encoded, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || newDigest(algorithm) == nil {
+		return nil, fmt.Errorf("unsupported Upload-Checksum, expected one of %s", tusChecksums)
+	}
+	sum, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, errors.New("Upload-Checksum digest is not base64 encoded")
+	}
+	return &Checksum{Algorithm: algorithm, Sum: sum}, nil
+}
+
+// newDigest returns a hash for a tus checksum algorithm, or nil when unsupported
+func newDigest(algorithm string) hash.Hash {
+	switch algorithm {
+	case "sha1":
+		return sha1.New()
+	case "sha256":
+		return sha256.New()
+	case "md5":
+		return md5.New()
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package versioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+)
+
+// Errors returned by the versioning service
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidInput = errors.New("invalid input")
+)
+
+const maxChangelogLength = 2000
+
+// Store keeps the file history of library items, implemented by
+// repository.LibraryItemVersionRepository
+type Store interface {
+	ListVersions(ctx context.Context, itemID string) ([]repository.LibraryItemVersion, error)
+	GetVersion(ctx context.Context, itemID string, version int) (*repository.LibraryItemVersion, error)
+	AddVersion(ctx context.Context, in repository.NewLibraryItemVersion) (*repository.LibraryItemVersion, string, error)
+	ListAudience(ctx context.Context, itemID, excludeUserID string) ([]string, error)
+}
+
+// notifier delivers in-app notifications, implemented by notification.NotificationService
+type notifier interface {
+	CreateNotification(
+		ctx context.Context,
+		userID string,
+		notifType notification.NotificationType,
+		title string,
+		message string,
+		data *notification.NotificationData,
+		expiresIn *time.Duration,
+	) error
+}
+
+// Service versions the files of library items: replacing a file keeps the old one for
+// rollback, records a changelog note and tells downloaders and bookmarkers
+type Service struct {
+	store    Store
+	notifier notifier // Optional
+}
+
+// NewService creates a new versioning service; notifier may be nil
+func NewService(store Store, notifier notifier) *Service {
+	return &Service{store: store, notifier: notifier}
+}
+
+// Versions returns the file history of an item, newest first, with per-version downloads
+func (s *Service) Versions(ctx context.Context, itemID string) ([]repository.LibraryItemVersion, error) {
+	return s.store.ListVersions(ctx, itemID)
+}
+
+// Replace makes file the current file of an item. The changelog is required: it is
+// shown in the history and sent to everyone who downloaded or bookmarked the item.
+func (s *Service) Replace(ctx context.Context, actorID, itemID string, file repository.LibraryItemFile, changelog string) (*repository.LibraryItemVersion, error) {
+	file.URL = strings.TrimSpace(file.URL)
+	file.ID = strings.TrimSpace(file.ID)
+	if file.Empty() {
+		return nil, fmt.Errorf("%w: file url or file id is required", ErrInvalidInput)
+	}
+	changelog = strings.TrimSpace(changelog)
+	if changelog == "" {
+		return nil, fmt.Errorf("%w: changelog is required", ErrInvalidInput)
+	}
+	return s.add(ctx, repository.NewLibraryItemVersion{
+		ItemID:    itemID,
+		File:      file,
+		Changelog: changelog,
+		CreatedBy: actorID,
+	})
+}
+
+// Rollback serves the file of an older version again. It is recorded as a new version so
+// the history stays linear and downloads keep their version.
+func (s *Service) Rollback(ctx context.Context, actorID, itemID string, version int, note string) (*repository.LibraryItemVersion, error) {
+	if version <= 0 {
+		return nil, fmt.Errorf("%w: version must be positive", ErrInvalidInput)
+	}
+	target, err := s.store.GetVersion(ctx, itemID, version)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if target.File.Empty() {
+		return nil, fmt.Errorf("%w: version %d has no file", ErrInvalidInput, version)
+	}
+
+	changelog := fmt.Sprintf("Khôi phục phiên bản %d", version)
+	if note = strings.TrimSpace(note); note != "" {
+		changelog += ": " + note
+	}
+	return s.add(ctx, repository.NewLibraryItemVersion{
+		ItemID:       itemID,
+		File:         target.File,
+		Changelog:    changelog,
+		RestoredFrom: version,
+		CreatedBy:    actorID,
+	})
+}
+
+func (s *Service) add(ctx context.Context, in repository.NewLibraryItemVersion) (*repository.LibraryItemVersion, error) {
+	if utf8.RuneCountInString(in.Changelog) > maxChangelogLength {
+		return nil, fmt.Errorf("%w: changelog is at most %d characters", ErrInvalidInput, maxChangelogLength)
+	}
+	v, name, err := s.store.AddVersion(ctx, in)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	s.notifyAudience(ctx, name, v)
+	return v, nil
+}
+
+// notifyAudience tells downloaders and bookmarkers about a new version. Failures are
+// logged and never undo the version.
+func (s *Service) notifyAudience(ctx context.Context, name string, v *repository.LibraryItemVersion) {
+	if s.notifier == nil {
+		return
+	}
+	userIDs, err := s.store.ListAudience(ctx, v.ItemID, v.CreatedBy)
+	if err != nil {
+		log.Printf("[WARN] [Versioning] Failed to list audience of item %s: %v", v.ItemID, err)
+		return
+	}
+
+	message := fmt.Sprintf("\"%s\" đã được cập nhật lên phiên bản %d: %s", name, v.Version, v.Changelog)
+	data := &notification.NotificationData{
+		Priority:   notification.PriorityMedium,
+		ActionURL:  "/library?item=" + v.ItemID,
+		ActionText: "Xem tài liệu",
+		Metadata:   map[string]interface{}{"library_item_id": v.ItemID, "version": v.Version},
+	}
+	failed := 0
+	for _, userID := range userIDs {
+		if err := s.notifier.CreateNotification(ctx, userID, notification.TypeLibraryUpdate, "Tài liệu đã được cập nhật", message, data, nil); err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		log.Printf("[WARN] [Versioning] Failed to notify %d of %d users about item %s version %d", failed, len(userIDs), v.ItemID, v.Version)
+	}
+}
+
+func translateError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, repository.ErrInvalidInput):
+		// AddVersion only rejects a file that is already current
+		return fmt.Errorf("%w: file is already the current version", ErrInvalidInput)
+	}
+	return err
+}
@@ -0,0 +1,162 @@
+package versioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+)
+
+// memoryStore keeps the versions of one item in order
+type memoryStore struct {
+	name     string
+	current  repository.LibraryItemFile
+	versions []repository.LibraryItemVersion
+	audience []string
+}
+
+func (m *memoryStore) ListVersions(ctx context.Context, itemID string) ([]repository.LibraryItemVersion, error) {
+	return m.versions, nil
+}
+
+func (m *memoryStore) GetVersion(ctx context.Context, itemID string, version int) (*repository.LibraryItemVersion, error) {
+	for _, v := range m.versions {
+		if v.Version == version {
+			return &v, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) AddVersion(ctx context.Context, in repository.NewLibraryItemVersion) (*repository.LibraryItemVersion, string, error) {
+	if in.ItemID != "item-1" {
+		return nil, "", repository.ErrNotFound
+	}
+	if m.current.Same(in.File) {
+		return nil, "", fmt.Errorf("%w: file is already the current version", repository.ErrInvalidInput)
+	}
+	v := repository.LibraryItemVersion{
+		ItemID:       in.ItemID,
+		Version:      len(m.versions) + 1,
+		File:         in.File,
+		Changelog:    in.Changelog,
+		RestoredFrom: in.RestoredFrom,
+		CreatedBy:    in.CreatedBy,
+		CreatedAt:    time.Now(),
+	}
+	m.versions = append(m.versions, v)
+	m.current = in.File
+	return &v, m.name, nil
+}
+
+func (m *memoryStore) ListAudience(ctx context.Context, itemID, excludeUserID string) ([]string, error) {
+	var out []string
+	for _, u := range m.audience {
+		if u != excludeUserID {
+			out = append(out, u)
+		}
+	}
+	return out, nil
+}
+
+type sentNotification struct {
+	userID    string
+	notifType notification.NotificationType
+	message   string
+}
+
+type recordingNotifier struct {
+	sent []sentNotification
+}
+
+func (n *recordingNotifier) CreateNotification(ctx context.Context, userID string, notifType notification.NotificationType, title, message string, data *notification.NotificationData, expiresIn *time.Duration) error {
+	n.sent = append(n.sent, sentNotification{userID: userID, notifType: notifType, message: message})
+	return nil
+}
+
+func newTestService() (*Service, *memoryStore, *recordingNotifier) {
+	v1 := repository.LibraryItemFile{ID: "library/v1.pdf"}
+	store := &memoryStore{
+		name:     "Đề thi thử 2025",
+		current:  v1,
+		versions: []repository.LibraryItemVersion{{ItemID: "item-1", Version: 1, File: v1}},
+		audience: []string{"student-1", "teacher", "student-2"},
+	}
+	notifier := &recordingNotifier{}
+	return NewService(store, notifier), store, notifier
+}
+
+func TestReplace_RequiresChangelogAndNewFile(t *testing.T) {
+	service, _, _ := newTestService()
+	ctx := context.Background()
+
+	if _, err := service.Replace(ctx, "teacher", "item-1", repository.LibraryItemFile{ID: "library/v2.pdf"}, "  "); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("missing changelog: got %v, want ErrInvalidInput", err)
+	}
+	if _, err := service.Replace(ctx, "teacher", "item-1", repository.LibraryItemFile{}, "Sửa lỗi"); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("missing file: got %v, want ErrInvalidInput", err)
+	}
+	if _, err := service.Replace(ctx, "teacher", "item-1", repository.LibraryItemFile{ID: "library/v1.pdf"}, "Sửa lỗi"); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("unchanged file: got %v, want ErrInvalidInput", err)
+	}
+	if _, err := service.Replace(ctx, "teacher", "missing", repository.LibraryItemFile{ID: "library/v2.pdf"}, "Sửa lỗi"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("unknown item: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestReplace_NotifiesAudienceExceptEditor(t *testing.T) {
+	service, _, notifier := newTestService()
+
+	v, err := service.Replace(context.Background(), "teacher", "item-1", repository.LibraryItemFile{ID: "library/v2.pdf"}, "Sửa lỗi đánh máy câu 3")
+	if err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	if v.Version != 2 {
+		t.Fatalf("version = %d, want 2", v.Version)
+	}
+	if len(notifier.sent) != 2 {
+		t.Fatalf("sent %d notifications, want 2", len(notifier.sent))
+	}
+	for _, n := range notifier.sent {
+		if n.userID == "teacher" {
+			t.Fatalf("editor was notified")
+		}
+		if n.notifType != notification.TypeLibraryUpdate || !strings.Contains(n.message, "Sửa lỗi đánh máy câu 3") {
+			t.Fatalf("unexpected notification %+v", n)
+		}
+	}
+}
+
+func TestRollback_AddsVersionWithOldFile(t *testing.T) {
+	service, store, _ := newTestService()
+	ctx := context.Background()
+	if _, err := service.Replace(ctx, "teacher", "item-1", repository.LibraryItemFile{ID: "library/v2.pdf"}, "Bản mới"); err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+
+	v, err := service.Rollback(ctx, "admin", "item-1", 1, "bản mới bị lỗi font")
+	if err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if v.Version != 3 || v.RestoredFrom != 1 || v.File.ID != "library/v1.pdf" {
+		t.Fatalf("unexpected rollback version %+v", v)
+	}
+	if v.Changelog != "Khôi phục phiên bản 1: bản mới bị lỗi font" {
+		t.Fatalf("changelog = %q", v.Changelog)
+	}
+	if store.current.ID != "library/v1.pdf" {
+		t.Fatalf("current file = %q, want v1", store.current.ID)
+	}
+
+	if _, err := service.Rollback(ctx, "admin", "item-1", 3, ""); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("rollback to current: got %v, want ErrInvalidInput", err)
+	}
+	if _, err := service.Rollback(ctx, "admin", "item-1", 9, ""); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("rollback to unknown version: got %v, want ErrNotFound", err)
+	}
+}
@@ -25,6 +25,11 @@ const (
 	TypePasswordChange   NotificationType = "PASSWORD_CHANGE"
 	TypeSessionExpired   NotificationType = "SESSION_EXPIRED"
 	TypeEnrollmentUpdate NotificationType = "ENROLLMENT_UPDATE"
+	TypeQuestionReview   NotificationType = "QUESTION_REVIEW"
+	TypeGuardianLink     NotificationType = "GUARDIAN_LINK"
+	TypeProgressDigest   NotificationType = "PROGRESS_DIGEST"
+	TypeLibraryUpdate    NotificationType = "LIBRARY_ITEM_UPDATE"
+	TypeModeration       NotificationType = "LIBRARY_MODERATION"
 )
 
 // NotificationPriority represents notification priority
@@ -0,0 +1,649 @@
+package organisation
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Errors returned by the organisation service
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidInput     = errors.New("invalid input")
+	ErrSlugTaken        = errors.New("organisation slug is already taken")
+	ErrInvalidJoinCode  = errors.New("invalid or disabled join code")
+)
+
+// Organisation roles
+const (
+	RoleOwner   = "OWNER"
+	RoleAdmin   = "ADMIN"
+	RoleTeacher = "TEACHER"
+	RoleStudent = "STUDENT"
+)
+
+// platformAdmin is the users.role that bypasses membership checks
+const platformAdmin = "ADMIN"
+
+// joinCodeAlphabet leaves out characters that are easily confused (0/O, 1/I/L)
+const joinCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,62}$`)
+
+// store is the persistence used by the service, implemented by repository.OrganisationRepository
+type store interface {
+	CreateOrganisation(ctx context.Context, org *repository.Organisation, ownerID string) error
+	GetOrganisation(ctx context.Context, id string) (*repository.Organisation, error)
+	ListUserOrganisations(ctx context.Context, userID string) ([]*repository.Organisation, error)
+	ListUserOrganisationIDs(ctx context.Context, userID string) ([]string, error)
+	GetOrganisationRole(ctx context.Context, orgID, userID string) (string, error)
+	SetOrganisationMember(ctx context.Context, orgID, userID, role string) error
+	RemoveOrganisationMember(ctx context.Context, orgID, userID string) error
+	ListOrganisationMembers(ctx context.Context, orgID string) ([]*repository.OrganisationMember, error)
+	CreateClass(ctx context.Context, class *repository.Class) error
+	GetClass(ctx context.Context, id string) (*repository.Class, error)
+	GetClassByJoinCode(ctx context.Context, code string) (*repository.Class, error)
+	ListUserClasses(ctx context.Context, userID, orgID string) ([]*repository.Class, error)
+	ListOrganisationClasses(ctx context.Context, orgID string) ([]*repository.Class, error)
+	UpdateJoinCode(ctx context.Context, classID, code string, enabled bool) error
+	GetClassRole(ctx context.Context, classID, userID string) (string, error)
+	AddClassMembers(ctx context.Context, orgID, orgRole string, members []*repository.ClassMember) (int, error)
+	RemoveClassMember(ctx context.Context, classID, userID string) error
+	ListClassMembers(ctx context.Context, classID string) ([]*repository.ClassMember, error)
+	FindUserIDsByEmail(ctx context.Context, emails []string) (map[string]string, error)
+	GetExamOwnership(ctx context.Context, examID string) (*repository.ResourceOwnership, error)
+	GetLibraryItemOwnership(ctx context.Context, itemID string) (*repository.ResourceOwnership, error)
+	ListLibraryItemOrganisations(ctx context.Context, itemIDs []string) (map[string]string, error)
+	SetExamOrganisation(ctx context.Context, examID, orgID string) error
+	SetLibraryItemOrganisation(ctx context.Context, itemID, orgID string) error
+}
+
+// Config controls join codes and roster imports
+type Config struct {
+	JoinCodeLength int // Default 8
+	MaxRosterRows  int // Maximum data rows in one CSV import; default 1000
+}
+
+// Actor is the authenticated caller
+type Actor struct {
+	UserID string
+	Role   string // Platform role from users.role
+}
+
+// ClassInput holds the fields of a new class
+type ClassInput struct {
+	OrganisationID string
+	Name           string
+	Subject        string
+	Grade          int
+	SchoolYear     string
+}
+
+// Service manages organisations, classes and rosters
+//
+// Business Logic:
+//   - The creator of an organisation becomes its OWNER; OWNER and ADMIN manage members
+//   - Organisation staff (OWNER, ADMIN, TEACHER) create classes in it and manage their rosters
+//   - Class teachers manage their own class; students join with the class join code
+//   - Platform ADMINs bypass membership checks
+type Service struct {
+	store  store
+	config Config
+}
+
+// NewService creates a new organisation service
+func NewService(store store, config Config) *Service {
+	if config.JoinCodeLength <= 0 {
+		config.JoinCodeLength = 8
+	}
+	if config.MaxRosterRows <= 0 {
+		config.MaxRosterRows = 1000
+	}
+	return &Service{store: store, config: config}
+}
+
+// CreateOrganisation creates an organisation owned by the actor. An empty slug is derived from the name.
+func (s *Service) CreateOrganisation(ctx context.Context, actor Actor, name, slug string) (*repository.Organisation, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		slug = slugify(name)
+	}
+	if !slugPattern.MatchString(slug) {
+		return nil, fmt.Errorf("%w: slug must be 2-63 lowercase letters, digits or hyphens", ErrInvalidInput)
+	}
+
+	org := &repository.Organisation{Name: name, Slug: slug}
+	if err := s.store.CreateOrganisation(ctx, org, actor.UserID); err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+	return org, nil
+}
+
+// GetOrganisation returns an organisation the actor belongs to
+func (s *Service) GetOrganisation(ctx context.Context, actor Actor, orgID string) (*repository.Organisation, string, error) {
+	role, err := s.organisationRole(ctx, actor, orgID)
+	if err != nil {
+		return nil, "", err
+	}
+	if role == "" {
+		return nil, "", ErrPermissionDenied
+	}
+	org, err := s.store.GetOrganisation(ctx, orgID)
+	if err != nil {
+		return nil, "", translateNotFound(err)
+	}
+	return org, role, nil
+}
+
+// ListOrganisations returns the organisations the actor belongs to
+func (s *Service) ListOrganisations(ctx context.Context, actor Actor) ([]*repository.Organisation, error) {
+	return s.store.ListUserOrganisations(ctx, actor.UserID)
+}
+
+// ListMembers returns the members of an organisation; students cannot list members
+func (s *Service) ListMembers(ctx context.Context, actor Actor, orgID string) ([]*repository.OrganisationMember, error) {
+	if err := s.requireOrganisationRole(ctx, actor, orgID, RoleOwner, RoleAdmin, RoleTeacher); err != nil {
+		return nil, err
+	}
+	return s.store.ListOrganisationMembers(ctx, orgID)
+}
+
+// SetMember adds a user to an organisation or changes their role.
+// Only an OWNER can grant OWNER, and nobody can change their own role.
+func (s *Service) SetMember(ctx context.Context, actor Actor, orgID, userID, role string) error {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if userID == "" || !validOrganisationRole(role) {
+		return fmt.Errorf("%w: user and a valid role are required", ErrInvalidInput)
+	}
+	if userID == actor.UserID && actor.Role != platformAdmin {
+		return fmt.Errorf("%w: cannot change your own role", ErrPermissionDenied)
+	}
+	actorRole, err := s.organisationRole(ctx, actor, orgID)
+	if err != nil {
+		return err
+	}
+	if !managesMembers(actorRole) || (role == RoleOwner && actorRole != RoleOwner) {
+		return ErrPermissionDenied
+	}
+	if current, err := s.store.GetOrganisationRole(ctx, orgID, userID); err == nil && current == RoleOwner && actorRole != RoleOwner {
+		return fmt.Errorf("%w: only an owner can change another owner", ErrPermissionDenied)
+	}
+	return s.store.SetOrganisationMember(ctx, orgID, userID, role)
+}
+
+// RemoveMember removes a user from an organisation and its classes. Members may remove themselves
+// unless they are an OWNER.
+func (s *Service) RemoveMember(ctx context.Context, actor Actor, orgID, userID string) error {
+	target, err := s.store.GetOrganisationRole(ctx, orgID, userID)
+	if err != nil {
+		return translateNotFound(err)
+	}
+	actorRole, err := s.organisationRole(ctx, actor, orgID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case target == RoleOwner && actorRole != RoleOwner:
+		return fmt.Errorf("%w: only an owner can remove an owner", ErrPermissionDenied)
+	case userID == actor.UserID && target == RoleOwner && actor.Role != platformAdmin:
+		return fmt.Errorf("%w: owners cannot remove themselves", ErrPermissionDenied)
+	case userID != actor.UserID && !managesMembers(actorRole):
+		return ErrPermissionDenied
+	}
+	return translateNotFound(s.store.RemoveOrganisationMember(ctx, orgID, userID))
+}
+
+// CreateClass creates a class owned by the actor, inside an organisation when OrganisationID is set
+func (s *Service) CreateClass(ctx context.Context, actor Actor, input ClassInput) (*repository.Class, error) {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" {
+		return nil, fmt.Errorf("%w: class name is required", ErrInvalidInput)
+	}
+	if input.Grade < 0 || input.Grade > 12 {
+		return nil, fmt.Errorf("%w: grade must be between 0 and 12", ErrInvalidInput)
+	}
+	if input.OrganisationID != "" {
+		if err := s.requireOrganisationRole(ctx, actor, input.OrganisationID, RoleOwner, RoleAdmin, RoleTeacher); err != nil {
+			return nil, err
+		}
+	}
+
+	class := &repository.Class{
+		OrganisationID:  input.OrganisationID,
+		Name:            input.Name,
+		Subject:         strings.TrimSpace(input.Subject),
+		Grade:           input.Grade,
+		SchoolYear:      strings.TrimSpace(input.SchoolYear),
+		OwnerID:         actor.UserID,
+		JoinCodeEnabled: true,
+	}
+	err := s.withUniqueJoinCode(func(code string) error {
+		class.JoinCode = code
+		return s.store.CreateClass(ctx, class)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return class, nil
+}
+
+// GetClass returns a class visible to the actor. The join code is only included for class managers.
+func (s *Service) GetClass(ctx context.Context, actor Actor, classID string) (*repository.Class, error) {
+	class, err := s.store.GetClass(ctx, classID)
+	if err != nil {
+		return nil, translateNotFound(err)
+	}
+	manages, err := s.managesClass(ctx, actor, class)
+	if err != nil {
+		return nil, err
+	}
+	if manages {
+		return class, nil
+	}
+	if _, err := s.store.GetClassRole(ctx, classID, actor.UserID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrPermissionDenied
+		}
+		return nil, err
+	}
+	return withoutJoinCode(class), nil
+}
+
+// ListClasses returns the actor's classes. Organisation staff listing an organisation see all of its classes.
+func (s *Service) ListClasses(ctx context.Context, actor Actor, orgID string) ([]*repository.Class, error) {
+	if orgID != "" {
+		role, err := s.organisationRole(ctx, actor, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if isStaff(role) {
+			return s.store.ListOrganisationClasses(ctx, orgID)
+		}
+	}
+	classes, err := s.store.ListUserClasses(ctx, actor.UserID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i, class := range classes {
+		if class.OwnerID != actor.UserID {
+			if role, _ := s.store.GetClassRole(ctx, class.ID, actor.UserID); role != RoleTeacher {
+				classes[i] = withoutJoinCode(class)
+			}
+		}
+	}
+	return classes, nil
+}
+
+// ResetJoinCode issues a new join code for a class, or disables joining by code
+func (s *Service) ResetJoinCode(ctx context.Context, actor Actor, classID string, enabled bool) (*repository.Class, error) {
+	class, err := s.manageableClass(ctx, actor, classID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.withUniqueJoinCode(func(code string) error {
+		class.JoinCode = code
+		return s.store.UpdateJoinCode(ctx, classID, code, enabled)
+	})
+	if err != nil {
+		return nil, translateNotFound(err)
+	}
+	class.JoinCodeEnabled = enabled
+	return class, nil
+}
+
+// JoinClass adds the actor to the class with the given join code as a STUDENT.
+// Joining a class inside an organisation also makes the actor a member of it.
+func (s *Service) JoinClass(ctx context.Context, actor Actor, code string) (*repository.Class, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, ErrInvalidJoinCode
+	}
+	class, err := s.store.GetClassByJoinCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidJoinCode
+		}
+		return nil, err
+	}
+	member := &repository.ClassMember{ClassID: class.ID, UserID: actor.UserID, Role: RoleStudent, AddedBy: actor.UserID}
+	if _, err := s.store.AddClassMembers(ctx, class.OrganisationID, RoleStudent, []*repository.ClassMember{member}); err != nil {
+		return nil, err
+	}
+	return withoutJoinCode(class), nil
+}
+
+// GetRoster returns the members of a class the actor manages
+func (s *Service) GetRoster(ctx context.Context, actor Actor, classID string) ([]*repository.ClassMember, error) {
+	if _, err := s.manageableClass(ctx, actor, classID); err != nil {
+		return nil, err
+	}
+	return s.store.ListClassMembers(ctx, classID)
+}
+
+// AddClassMember adds a user to a class. Co-teachers of an organisation class must be organisation staff.
+func (s *Service) AddClassMember(ctx context.Context, actor Actor, classID, userID, role string) error {
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if userID == "" || (role != RoleTeacher && role != RoleStudent) {
+		return fmt.Errorf("%w: user and a role of TEACHER or STUDENT are required", ErrInvalidInput)
+	}
+	class, err := s.manageableClass(ctx, actor, classID)
+	if err != nil {
+		return err
+	}
+	if role == RoleTeacher && class.OrganisationID != "" {
+		orgRole, err := s.store.GetOrganisationRole(ctx, class.OrganisationID, userID)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		if !isStaff(orgRole) {
+			return fmt.Errorf("%w: co-teachers must be organisation staff", ErrInvalidInput)
+		}
+	}
+	member := &repository.ClassMember{ClassID: classID, UserID: userID, Role: role, AddedBy: actor.UserID}
+	_, err = s.store.AddClassMembers(ctx, class.OrganisationID, RoleStudent, []*repository.ClassMember{member})
+	return err
+}
+
+// RemoveClassMember removes a user from a class. Students may leave a class themselves;
+// the class owner cannot be removed.
+func (s *Service) RemoveClassMember(ctx context.Context, actor Actor, classID, userID string) error {
+	class, err := s.store.GetClass(ctx, classID)
+	if err != nil {
+		return translateNotFound(err)
+	}
+	if userID == class.OwnerID {
+		return fmt.Errorf("%w: the class owner cannot be removed", ErrInvalidInput)
+	}
+	if userID != actor.UserID {
+		manages, err := s.managesClass(ctx, actor, class)
+		if err != nil {
+			return err
+		}
+		if !manages {
+			return ErrPermissionDenied
+		}
+	}
+	return translateNotFound(s.store.RemoveClassMember(ctx, classID, userID))
+}
+
+// VisibleOrganisations returns the organisations whose resources the actor may see.
+// scoped is false for platform ADMINs, who see everything.
+func (s *Service) VisibleOrganisations(ctx context.Context, actor Actor) (orgIDs []string, scoped bool, err error) {
+	if actor.Role == platformAdmin {
+		return nil, false, nil
+	}
+	orgIDs, err = s.store.ListUserOrganisationIDs(ctx, actor.UserID)
+	if err != nil {
+		return nil, true, err
+	}
+	return orgIDs, true, nil
+}
+
+// RequireStaff returns ErrPermissionDenied unless the actor is OWNER, ADMIN or TEACHER of the organisation
+func (s *Service) RequireStaff(ctx context.Context, actor Actor, orgID string) error {
+	return s.requireOrganisationRole(ctx, actor, orgID, RoleOwner, RoleAdmin, RoleTeacher)
+}
+
+// CanAccess reports whether the actor may see a resource owned by orgID; public resources have no organisation
+func (s *Service) CanAccess(ctx context.Context, actor Actor, orgID string) (bool, error) {
+	if orgID == "" {
+		return true, nil
+	}
+	role, err := s.organisationRole(ctx, actor, orgID)
+	return role != "", err
+}
+
+// CanAccessExam reports whether the actor may see the exam
+func (s *Service) CanAccessExam(ctx context.Context, actor Actor, examID string) (bool, error) {
+	ownership, err := s.store.GetExamOwnership(ctx, examID)
+	if err != nil {
+		return false, translateNotFound(err)
+	}
+	return s.CanAccess(ctx, actor, ownership.OrganisationID)
+}
+
+// CanAccessLibraryItem reports whether the actor may see the library item
+func (s *Service) CanAccessLibraryItem(ctx context.Context, actor Actor, itemID string) (bool, error) {
+	ownership, err := s.store.GetLibraryItemOwnership(ctx, itemID)
+	if err != nil {
+		return false, translateNotFound(err)
+	}
+	return s.CanAccess(ctx, actor, ownership.OrganisationID)
+}
+
+// HiddenLibraryItems returns the subset of itemIDs that belong to organisations the actor is not a member of
+func (s *Service) HiddenLibraryItems(ctx context.Context, actor Actor, itemIDs []string) (map[string]bool, error) {
+	hidden := make(map[string]bool)
+	visible, scoped, err := s.VisibleOrganisations(ctx, actor)
+	if err != nil || !scoped {
+		return hidden, err
+	}
+	owners, err := s.store.ListLibraryItemOrganisations(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	member := make(map[string]bool, len(visible))
+	for _, id := range visible {
+		member[id] = true
+	}
+	for itemID, orgID := range owners {
+		if !member[orgID] {
+			hidden[itemID] = true
+		}
+	}
+	return hidden, nil
+}
+
+// AssignExam moves an exam into an organisation, or makes it public when orgID is empty
+func (s *Service) AssignExam(ctx context.Context, actor Actor, examID, orgID string) error {
+	ownership, err := s.store.GetExamOwnership(ctx, examID)
+	if err != nil {
+		return translateNotFound(err)
+	}
+	if err := s.canReassign(ctx, actor, ownership, orgID); err != nil {
+		return err
+	}
+	return translateNotFound(s.store.SetExamOrganisation(ctx, examID, orgID))
+}
+
+// AssignLibraryItem moves a library item into an organisation, or makes it public when orgID is empty
+func (s *Service) AssignLibraryItem(ctx context.Context, actor Actor, itemID, orgID string) error {
+	ownership, err := s.store.GetLibraryItemOwnership(ctx, itemID)
+	if err != nil {
+		return translateNotFound(err)
+	}
+	if err := s.canReassign(ctx, actor, ownership, orgID); err != nil {
+		return err
+	}
+	return translateNotFound(s.store.SetLibraryItemOrganisation(ctx, itemID, orgID))
+}
+
+// canReassign requires the actor to own the resource or be staff of its current organisation,
+// and to be staff of the target organisation
+func (s *Service) canReassign(ctx context.Context, actor Actor, ownership *repository.ResourceOwnership, orgID string) error {
+	if actor.Role == platformAdmin {
+		return nil
+	}
+	if ownership.OwnerID != actor.UserID {
+		if ownership.OrganisationID == "" {
+			return ErrPermissionDenied
+		}
+		if err := s.requireOrganisationRole(ctx, actor, ownership.OrganisationID, RoleOwner, RoleAdmin, RoleTeacher); err != nil {
+			return err
+		}
+	}
+	if orgID == "" {
+		return nil
+	}
+	return s.requireOrganisationRole(ctx, actor, orgID, RoleOwner, RoleAdmin, RoleTeacher)
+}
+
+// organisationRole returns the actor's role in the organisation, or "" when not a member.
+// Platform ADMINs are treated as OWNER.
+func (s *Service) organisationRole(ctx context.Context, actor Actor, orgID string) (string, error) {
+	if orgID == "" {
+		return "", fmt.Errorf("%w: organisation is required", ErrInvalidInput)
+	}
+	if actor.Role == platformAdmin {
+		if _, err := s.store.GetOrganisation(ctx, orgID); err != nil {
+			return "", translateNotFound(err)
+		}
+		return RoleOwner, nil
+	}
+	role, err := s.store.GetOrganisationRole(ctx, orgID, actor.UserID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", nil
+	}
+	return role, err
+}
+
+func (s *Service) requireOrganisationRole(ctx context.Context, actor Actor, orgID string, allowed ...string) error {
+	role, err := s.organisationRole(ctx, actor, orgID)
+	if err != nil {
+		return err
+	}
+	for _, r := range allowed {
+		if role == r {
+			return nil
+		}
+	}
+	return ErrPermissionDenied
+}
+
+// managesClass reports whether the actor is a teacher of the class or staff of its organisation
+func (s *Service) managesClass(ctx context.Context, actor Actor, class *repository.Class) (bool, error) {
+	if actor.Role == platformAdmin || class.OwnerID == actor.UserID {
+		return true, nil
+	}
+	role, err := s.store.GetClassRole(ctx, class.ID, actor.UserID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return false, err
+	}
+	if role == RoleTeacher {
+		return true, nil
+	}
+	if class.OrganisationID == "" {
+		return false, nil
+	}
+	orgRole, err := s.organisationRole(ctx, actor, class.OrganisationID)
+	if err != nil {
+		return false, err
+	}
+	return orgRole == RoleOwner || orgRole == RoleAdmin, nil
+}
+
+func (s *Service) manageableClass(ctx context.Context, actor Actor, classID string) (*repository.Class, error) {
+	class, err := s.store.GetClass(ctx, classID)
+	if err != nil {
+		return nil, translateNotFound(err)
+	}
+	manages, err := s.managesClass(ctx, actor, class)
+	if err != nil {
+		return nil, err
+	}
+	if !manages {
+		return nil, ErrPermissionDenied
+	}
+	return class, nil
+}
+
+// withUniqueJoinCode calls write with fresh codes until one does not collide
+func (s *Service) withUniqueJoinCode(write func(code string) error) error {
+	const attempts = 5
+	for i := 0; i < attempts; i++ {
+		code, err := generateJoinCode(s.config.JoinCodeLength)
+		if err != nil {
+			return err
+		}
+		err = write(code)
+		if !errors.Is(err, repository.ErrDuplicateKey) {
+			return err
+		}
+	}
+	return fmt.Errorf("failed to generate a unique join code after %d attempts", attempts)
+}
+
+func generateJoinCode(length int) (string, error) {
+	max := big.NewInt(int64(len(joinCodeAlphabet)))
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate join code: %w", err)
+		}
+		code[i] = joinCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+func withoutJoinCode(class *repository.Class) *repository.Class {
+	copied := *class
+	copied.JoinCode = ""
+	return &copied
+}
+
+// slugify turns a name into a slug, folding Vietnamese diacritics ("Lê Quý Đôn" -> "le-quy-don")
+func slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range norm.NFD.String(strings.ToLower(name)) {
+		if r == 'đ' {
+			r = 'd'
+		}
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			b.WriteRune(r)
+			hyphen = false
+		case b.Len() > 0 && !hyphen:
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if len(slug) > 63 {
+		slug = strings.TrimSuffix(slug[:63], "-")
+	}
+	return slug
+}
+
+func validOrganisationRole(role string) bool {
+	switch role {
+	case RoleOwner, RoleAdmin, RoleTeacher, RoleStudent:
+		return true
+	}
+	return false
+}
+
+func managesMembers(role string) bool {
+	return role == RoleOwner || role == RoleAdmin
+}
+
+func isStaff(role string) bool {
+	return role == RoleOwner || role == RoleAdmin || role == RoleTeacher
+}
+
+func translateNotFound(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,429 @@
+package organisation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+// memoryStore is an in-memory store keyed like the database tables
+type memoryStore struct {
+	orgs       map[string]*repository.Organisation
+	orgMembers map[string]map[string]string // org -> user -> role
+	classes    map[string]*repository.Class
+	roster     map[string]map[string]string // class -> user -> role
+	users      map[string]string            // email -> user ID
+	exams      map[string]*repository.ResourceOwnership
+	nextID     int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		orgs:       make(map[string]*repository.Organisation),
+		orgMembers: make(map[string]map[string]string),
+		classes:    make(map[string]*repository.Class),
+		roster:     make(map[string]map[string]string),
+		users:      make(map[string]string),
+		exams:      make(map[string]*repository.ResourceOwnership),
+	}
+}
+
+func (m *memoryStore) id(prefix string) string {
+	m.nextID++
+	return fmt.Sprintf("%s-%d", prefix, m.nextID)
+}
+
+func (m *memoryStore) CreateOrganisation(ctx context.Context, org *repository.Organisation, ownerID string) error {
+	for _, o := range m.orgs {
+		if o.Slug == org.Slug {
+			return repository.ErrDuplicateKey
+		}
+	}
+	org.ID = m.id("org")
+	org.CreatedBy = ownerID
+	m.orgs[org.ID] = org
+	m.orgMembers[org.ID] = map[string]string{ownerID: RoleOwner}
+	return nil
+}
+
+func (m *memoryStore) GetOrganisation(ctx context.Context, id string) (*repository.Organisation, error) {
+	if org, ok := m.orgs[id]; ok {
+		return org, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) ListUserOrganisations(ctx context.Context, userID string) ([]*repository.Organisation, error) {
+	var result []*repository.Organisation
+	for id, members := range m.orgMembers {
+		if members[userID] != "" {
+			result = append(result, m.orgs[id])
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) ListUserOrganisationIDs(ctx context.Context, userID string) ([]string, error) {
+	ids := []string{}
+	for id, members := range m.orgMembers {
+		if members[userID] != "" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (m *memoryStore) GetOrganisationRole(ctx context.Context, orgID, userID string) (string, error) {
+	if role := m.orgMembers[orgID][userID]; role != "" {
+		return role, nil
+	}
+	return "", repository.ErrNotFound
+}
+
+func (m *memoryStore) SetOrganisationMember(ctx context.Context, orgID, userID, role string) error {
+	m.orgMembers[orgID][userID] = role
+	return nil
+}
+
+func (m *memoryStore) RemoveOrganisationMember(ctx context.Context, orgID, userID string) error {
+	if m.orgMembers[orgID][userID] == "" {
+		return repository.ErrNotFound
+	}
+	delete(m.orgMembers[orgID], userID)
+	for id, class := range m.classes {
+		if class.OrganisationID == orgID {
+			delete(m.roster[id], userID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryStore) ListOrganisationMembers(ctx context.Context, orgID string) ([]*repository.OrganisationMember, error) {
+	var result []*repository.OrganisationMember
+	for userID, role := range m.orgMembers[orgID] {
+		result = append(result, &repository.OrganisationMember{OrganisationID: orgID, UserID: userID, Role: role})
+	}
+	return result, nil
+}
+
+func (m *memoryStore) CreateClass(ctx context.Context, class *repository.Class) error {
+	for _, c := range m.classes {
+		if c.JoinCode == class.JoinCode {
+			return repository.ErrDuplicateKey
+		}
+	}
+	class.ID = m.id("class")
+	copied := *class
+	m.classes[class.ID] = &copied
+	m.roster[class.ID] = map[string]string{class.OwnerID: RoleTeacher}
+	return nil
+}
+
+func (m *memoryStore) GetClass(ctx context.Context, id string) (*repository.Class, error) {
+	if class, ok := m.classes[id]; ok {
+		copied := *class
+		return &copied, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) GetClassByJoinCode(ctx context.Context, code string) (*repository.Class, error) {
+	for _, class := range m.classes {
+		if class.JoinCode == code && class.JoinCodeEnabled {
+			copied := *class
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) ListUserClasses(ctx context.Context, userID, orgID string) ([]*repository.Class, error) {
+	var result []*repository.Class
+	for id, members := range m.roster {
+		if members[userID] != "" && (orgID == "" || m.classes[id].OrganisationID == orgID) {
+			copied := *m.classes[id]
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) ListOrganisationClasses(ctx context.Context, orgID string) ([]*repository.Class, error) {
+	var result []*repository.Class
+	for _, class := range m.classes {
+		if class.OrganisationID == orgID {
+			copied := *class
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) UpdateJoinCode(ctx context.Context, classID, code string, enabled bool) error {
+	class, ok := m.classes[classID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	class.JoinCode = code
+	class.JoinCodeEnabled = enabled
+	return nil
+}
+
+func (m *memoryStore) GetClassRole(ctx context.Context, classID, userID string) (string, error) {
+	if role := m.roster[classID][userID]; role != "" {
+		return role, nil
+	}
+	return "", repository.ErrNotFound
+}
+
+func (m *memoryStore) AddClassMembers(ctx context.Context, orgID, orgRole string, members []*repository.ClassMember) (int, error) {
+	added := 0
+	for _, member := range members {
+		if m.roster[member.ClassID][member.UserID] == "" {
+			m.roster[member.ClassID][member.UserID] = member.Role
+			added++
+		}
+		if orgID != "" && m.orgMembers[orgID][member.UserID] == "" {
+			m.orgMembers[orgID][member.UserID] = orgRole
+		}
+	}
+	return added, nil
+}
+
+func (m *memoryStore) RemoveClassMember(ctx context.Context, classID, userID string) error {
+	if m.roster[classID][userID] == "" {
+		return repository.ErrNotFound
+	}
+	delete(m.roster[classID], userID)
+	return nil
+}
+
+func (m *memoryStore) ListClassMembers(ctx context.Context, classID string) ([]*repository.ClassMember, error) {
+	var result []*repository.ClassMember
+	for userID, role := range m.roster[classID] {
+		result = append(result, &repository.ClassMember{ClassID: classID, UserID: userID, Role: role})
+	}
+	return result, nil
+}
+
+func (m *memoryStore) FindUserIDsByEmail(ctx context.Context, emails []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, email := range emails {
+		if id, ok := m.users[email]; ok {
+			result[email] = id
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) GetExamOwnership(ctx context.Context, examID string) (*repository.ResourceOwnership, error) {
+	if o, ok := m.exams[examID]; ok {
+		copied := *o
+		return &copied, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) GetLibraryItemOwnership(ctx context.Context, itemID string) (*repository.ResourceOwnership, error) {
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) ListLibraryItemOrganisations(ctx context.Context, itemIDs []string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func (m *memoryStore) SetExamOrganisation(ctx context.Context, examID, orgID string) error {
+	m.exams[examID].OrganisationID = orgID
+	return nil
+}
+
+func (m *memoryStore) SetLibraryItemOrganisation(ctx context.Context, itemID, orgID string) error {
+	return repository.ErrNotFound
+}
+
+var (
+	principal = Actor{UserID: "principal", Role: "TEACHER"}
+	teacher   = Actor{UserID: "teacher", Role: "TEACHER"}
+	student   = Actor{UserID: "student", Role: "STUDENT"}
+	outsider  = Actor{UserID: "outsider", Role: "STUDENT"}
+	admin     = Actor{UserID: "admin", Role: "ADMIN"}
+)
+
+// newSchool creates an organisation owned by principal with teacher as TEACHER and one class owned by teacher
+func newSchool(t *testing.T) (*Service, *memoryStore, *repository.Organisation, *repository.Class) {
+	t.Helper()
+	store := newMemoryStore()
+	service := NewService(store, Config{})
+	ctx := context.Background()
+
+	org, err := service.CreateOrganisation(ctx, principal, "Trường THPT Lê Quý Đôn", "")
+	if err != nil {
+		t.Fatalf("CreateOrganisation: %v", err)
+	}
+	if err := service.SetMember(ctx, principal, org.ID, teacher.UserID, "teacher"); err != nil {
+		t.Fatalf("SetMember: %v", err)
+	}
+	class, err := service.CreateClass(ctx, teacher, ClassInput{OrganisationID: org.ID, Name: "10A1", Subject: "Toán", Grade: 10})
+	if err != nil {
+		t.Fatalf("CreateClass: %v", err)
+	}
+	return service, store, org, class
+}
+
+func TestCreateOrganisation(t *testing.T) {
+	service, _, org, _ := newSchool(t)
+	ctx := context.Background()
+
+	if org.Slug != "truong-thpt-le-quy-don" {
+		t.Errorf("slug = %q", org.Slug)
+	}
+	if _, err := service.CreateOrganisation(ctx, teacher, "Other", org.Slug); !errors.Is(err, ErrSlugTaken) {
+		t.Errorf("expected ErrSlugTaken, got %v", err)
+	}
+	if _, err := service.CreateOrganisation(ctx, teacher, "Bad", "Not A Slug!"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestMemberManagement(t *testing.T) {
+	service, _, org, _ := newSchool(t)
+	ctx := context.Background()
+
+	if err := service.SetMember(ctx, teacher, org.ID, outsider.UserID, RoleStudent); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("teacher adding members: expected ErrPermissionDenied, got %v", err)
+	}
+	if err := service.SetMember(ctx, principal, org.ID, principal.UserID, RoleTeacher); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("owner demoting themselves: expected ErrPermissionDenied, got %v", err)
+	}
+	if err := service.RemoveMember(ctx, principal, org.ID, principal.UserID); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("owner leaving: expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := service.ListMembers(ctx, outsider, org.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("outsider listing members: expected ErrPermissionDenied, got %v", err)
+	}
+	if members, err := service.ListMembers(ctx, admin, org.ID); err != nil || len(members) != 2 {
+		t.Errorf("admin listing members = %d, %v", len(members), err)
+	}
+}
+
+func TestJoinClass(t *testing.T) {
+	service, store, org, class := newSchool(t)
+	ctx := context.Background()
+
+	if len(class.JoinCode) != 8 {
+		t.Fatalf("join code %q should have 8 characters", class.JoinCode)
+	}
+	joined, err := service.JoinClass(ctx, student, " "+class.JoinCode+" ")
+	if err != nil {
+		t.Fatalf("JoinClass: %v", err)
+	}
+	if joined.JoinCode != "" {
+		t.Error("join code should be hidden from students")
+	}
+	if store.roster[class.ID][student.UserID] != RoleStudent || store.orgMembers[org.ID][student.UserID] != RoleStudent {
+		t.Error("student should be on the roster and in the organisation")
+	}
+
+	// Students see the class but not the roster
+	if _, err := service.GetClass(ctx, student, class.ID); err != nil {
+		t.Errorf("GetClass as student: %v", err)
+	}
+	if _, err := service.GetRoster(ctx, student, class.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("GetRoster as student: expected ErrPermissionDenied, got %v", err)
+	}
+
+	// A reset code invalidates the old one
+	reset, err := service.ResetJoinCode(ctx, teacher, class.ID, true)
+	if err != nil {
+		t.Fatalf("ResetJoinCode: %v", err)
+	}
+	if reset.JoinCode == class.JoinCode {
+		t.Error("expected a new join code")
+	}
+	if _, err := service.JoinClass(ctx, outsider, class.JoinCode); !errors.Is(err, ErrInvalidJoinCode) {
+		t.Errorf("old join code: expected ErrInvalidJoinCode, got %v", err)
+	}
+}
+
+func TestImportRoster(t *testing.T) {
+	service, store, org, class := newSchool(t)
+	ctx := context.Background()
+	store.users["an@example.com"] = "user-an"
+	store.users["binh@example.com"] = "user-binh"
+	store.roster[class.ID]["user-binh"] = RoleStudent
+
+	csv := "\xef\xbb\xbfFirst_Name,Email,Last_Name\n" +
+		"An,AN@example.com,Nguyen\n" +
+		"Binh,binh@example.com,Tran\n" +
+		",,\n" +
+		"Chi,chi@example.com,Le\n" +
+		"Dup,an@example.com,Nguyen\n" +
+		"Bad,not-an-email,X\n"
+
+	result, err := service.ImportRoster(ctx, teacher, class.ID, []byte(csv))
+	if err != nil {
+		t.Fatalf("ImportRoster: %v", err)
+	}
+	if result.Added != 1 || result.AlreadyMember != 1 {
+		t.Errorf("added %d, already member %d; want 1 and 1", result.Added, result.AlreadyMember)
+	}
+	wantLines := []int{5, 6, 7}
+	if len(result.Errors) != len(wantLines) {
+		t.Fatalf("errors = %+v", result.Errors)
+	}
+	for i, line := range wantLines {
+		if result.Errors[i].Line != line {
+			t.Errorf("error %d on line %d, want %d", i, result.Errors[i].Line, line)
+		}
+	}
+	if store.orgMembers[org.ID]["user-an"] != RoleStudent {
+		t.Error("imported student should join the organisation")
+	}
+
+	if _, err := service.ImportRoster(ctx, teacher, class.ID, []byte("name\nAn\n")); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("missing email column: expected ErrInvalidInput, got %v", err)
+	}
+	if _, err := service.ImportRoster(ctx, student, class.ID, []byte(csv)); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("student import: expected ErrPermissionDenied, got %v", err)
+	}
+}
+
+func TestScoping(t *testing.T) {
+	service, store, org, _ := newSchool(t)
+	ctx := context.Background()
+	store.exams["exam-1"] = &repository.ResourceOwnership{OwnerID: teacher.UserID}
+	store.exams["exam-2"] = &repository.ResourceOwnership{OwnerID: outsider.UserID}
+
+	if err := service.AssignExam(ctx, teacher, "exam-1", org.ID); err != nil {
+		t.Fatalf("AssignExam: %v", err)
+	}
+	if err := service.AssignExam(ctx, teacher, "exam-2", org.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("assigning another user's exam: expected ErrPermissionDenied, got %v", err)
+	}
+	if err := service.AssignExam(ctx, outsider, "exam-2", org.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("assigning to a foreign organisation: expected ErrPermissionDenied, got %v", err)
+	}
+
+	if ok, _ := service.CanAccessExam(ctx, outsider, "exam-1"); ok {
+		t.Error("outsider should not see an organisation exam")
+	}
+	if ok, _ := service.CanAccessExam(ctx, teacher, "exam-1"); !ok {
+		t.Error("organisation teacher should see the exam")
+	}
+	if ok, _ := service.CanAccessExam(ctx, outsider, "exam-2"); !ok {
+		t.Error("public exam should be visible")
+	}
+
+	ids, scoped, err := service.VisibleOrganisations(ctx, teacher)
+	if err != nil || !scoped || len(ids) != 1 || ids[0] != org.ID {
+		t.Errorf("VisibleOrganisations(teacher) = %v, %v, %v", ids, scoped, err)
+	}
+	if _, scoped, _ := service.VisibleOrganisations(ctx, admin); scoped {
+		t.Error("admins should not be scoped")
+	}
+}
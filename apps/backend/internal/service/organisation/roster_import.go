@@ -0,0 +1,137 @@
+package organisation
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+// RowError describes a CSV row that was not imported
+type RowError struct {
+	Line    int
+	Email   string
+	Message string
+}
+
+// ImportResult summarises a roster import
+type ImportResult struct {
+	Added         int
+	AlreadyMember int
+	Errors        []RowError
+}
+
+// ImportRoster adds the students listed in a CSV file to a class.
+//
+// The file needs a header row with an "email" column; other columns such as
+// first_name and last_name are ignored. Only existing accounts are added:
+// unknown, malformed and duplicate emails are reported per row and the rest of
+// the file is still imported.
+func (s *Service) ImportRoster(ctx context.Context, actor Actor, classID string, data []byte) (*ImportResult, error) {
+	class, err := s.manageableClass(ctx, actor, classID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: roster file has no header row", ErrInvalidInput)
+	}
+	emailColumn := -1
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), "email") {
+			emailColumn = i
+			break
+		}
+	}
+	if emailColumn < 0 {
+		return nil, fmt.Errorf("%w: roster file needs an email column", ErrInvalidInput)
+	}
+
+	type row struct {
+		line  int
+		email string
+	}
+	result := &ImportResult{}
+	var rows []row
+	seen := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(rows)+len(result.Errors) >= s.config.MaxRosterRows {
+			return nil, fmt.Errorf("%w: roster file has more than %d rows", ErrInvalidInput, s.config.MaxRosterRows)
+		}
+
+		var email string
+		if emailColumn < len(record) {
+			email = strings.ToLower(strings.TrimSpace(record[emailColumn]))
+		}
+		switch {
+		case email == "" && isBlank(record):
+			continue
+		case !strings.Contains(email, "@"):
+			result.Errors = append(result.Errors, RowError{Line: line, Email: email, Message: "invalid email"})
+		case seen[email] > 0:
+			result.Errors = append(result.Errors, RowError{Line: line, Email: email,
+				Message: fmt.Sprintf("duplicate of line %d", seen[email])})
+		default:
+			seen[email] = line
+			rows = append(rows, row{line: line, email: email})
+		}
+	}
+
+	emails := make([]string, len(rows))
+	for i, r := range rows {
+		emails[i] = r.email
+	}
+	userIDs, err := s.store.FindUserIDsByEmail(ctx, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*repository.ClassMember
+	for _, r := range rows {
+		userID, ok := userIDs[r.email]
+		if !ok {
+			result.Errors = append(result.Errors, RowError{Line: r.line, Email: r.email, Message: "no account with this email"})
+			continue
+		}
+		members = append(members, &repository.ClassMember{ClassID: class.ID, UserID: userID, Role: RoleStudent, AddedBy: actor.UserID})
+	}
+
+	if len(members) > 0 {
+		added, err := s.store.AddClassMembers(ctx, class.OrganisationID, RoleStudent, members)
+		if err != nil {
+			return nil, err
+		}
+		result.Added = added
+		result.AlreadyMember = len(members) - added
+	}
+	sort.Slice(result.Errors, func(i, j int) bool { return result.Errors[i].Line < result.Errors[j].Line })
+	return result, nil
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
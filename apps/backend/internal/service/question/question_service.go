@@ -25,7 +25,11 @@ type QuestionService struct {
 	questionImageRepo interfaces.QuestionImageRepository
 	imageProcessor    *image_processing.ImageProcessingService
 	imageWorkerPool   *image_processing.WorkerPool
+	renderer          *latex.Renderer
 	logger            *logrus.Logger
+
+	// Optional uploader; without it processed images stay on local disk
+	imageUploader *image_processing.ImageUploader
 }
 
 // NewQuestionService creates a new question management service
@@ -41,6 +45,7 @@ func NewQuestionService(
 		questionCodeRepo:  questionCodeRepo,
 		questionImageRepo: questionImageRepo,
 		imageProcessor:    imageProcessor,
+		renderer:          latex.NewRenderer(),
 		logger:            logger,
 	}
 
@@ -53,6 +58,11 @@ func NewQuestionService(
 	return service
 }
 
+// SetImageUploader moves processed question images to the blob store
+func (m *QuestionService) SetImageUploader(uploader *image_processing.ImageUploader) {
+	m.imageUploader = uploader
+}
+
 // CreateQuestion creates a new question
 func (m *QuestionService) CreateQuestion(ctx context.Context, question *entity.Question) error {
 	// Validate question code exists
@@ -428,6 +438,20 @@ func (m *QuestionService) submitImageJob(ctx context.Context, questionID string,
 
 	// Update QuestionImage with result
 	questionImage.ImagePath = pgtype.Text{String: result.OutputPath, Status: pgtype.Present}
+	if m.imageUploader != nil {
+		// The key keeps the output name so the renderer can still match the image
+		key := fmt.Sprintf("%s%s/%s.webp", image_processing.QuestionImagePrefix, questionID, job.OutputName)
+		upload, err := m.imageUploader.UploadImageAs(ctx, result.OutputPath, key)
+		if err != nil {
+			m.logger.WithError(err).Error("Failed to upload processed image")
+			questionImage.Status = pgtype.Text{String: "FAILED", Status: pgtype.Present}
+			m.questionImageRepo.Update(ctx, questionImage)
+			return
+		}
+		questionImage.ImagePath = pgtype.Text{String: upload.FileID, Status: pgtype.Present}
+		questionImage.DriveURL = pgtype.Text{String: upload.WebViewLink, Status: pgtype.Present}
+		questionImage.DriveFileID = pgtype.Text{String: upload.FileID, Status: pgtype.Present}
+	}
 	questionImage.Status = pgtype.Text{String: "UPLOADED", Status: pgtype.Present}
 
 	if err := m.questionImageRepo.Update(ctx, questionImage); err != nil {
@@ -437,7 +461,7 @@ func (m *QuestionService) submitImageJob(ctx context.Context, questionID string,
 	m.logger.WithFields(logrus.Fields{
 		"question_id": questionID,
 		"image_id":    questionImage.ID.String,
-		"output_path": result.OutputPath,
+		"image_path":  questionImage.ImagePath.String,
 	}).Info("Image processed successfully")
 }
 
@@ -519,6 +543,9 @@ func (m *QuestionService) ImportQuestions(ctx context.Context, req *ImportQuesti
 		if req.UpsertMode && question.ID.Status == pgtype.Present {
 			existing, err := m.questionRepo.GetByID(ctx, question.ID.String)
 			if err == nil && existing != nil {
+				if req.Status != "" {
+					question.Status = existing.Status
+				}
 				// Update existing
 				if err := m.questionRepo.Update(ctx, question); err != nil {
 					result.ErrorCount++
@@ -535,6 +562,12 @@ func (m *QuestionService) ImportQuestions(ctx context.Context, req *ImportQuesti
 		}
 
 		// Create new question
+		if req.Status != "" {
+			question.Status = util.StringToPgText(req.Status)
+		}
+		if req.Creator != "" {
+			question.Creator = util.StringToPgText(req.Creator)
+		}
 		if err := m.CreateQuestion(ctx, question); err != nil {
 			result.ErrorCount++
 			result.Errors = append(result.Errors, ImportError{
@@ -544,6 +577,7 @@ func (m *QuestionService) ImportQuestions(ctx context.Context, req *ImportQuesti
 			})
 		} else {
 			result.CreatedCount++
+			result.CreatedIDs = append(result.CreatedIDs, question.ID.String)
 		}
 	}
 
@@ -763,6 +797,8 @@ type FilterStatistics struct {
 type ImportQuestionsRequest struct {
 	CsvDataBase64 string
 	UpsertMode    bool
+	Status        string // When set, created rows get this status and updated rows keep theirs
+	Creator       string // When set, created rows are credited to this user instead of the creator column
 }
 
 // ImportQuestionsResult contains import results
@@ -773,6 +809,7 @@ type ImportQuestionsResult struct {
 	ErrorCount     int32
 	Errors         []ImportError
 	Summary        string
+	CreatedIDs     []string
 }
 
 // ImportError contains import error details
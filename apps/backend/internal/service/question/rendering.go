@@ -0,0 +1,47 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/latex"
+)
+
+// Renderer returns the shared LaTeX renderer so that other flows (exam PDF, email)
+// reuse its per-version cache
+func (m *QuestionService) Renderer() *latex.Renderer {
+	return m.renderer
+}
+
+// RenderQuestion renders a question to HTML with its uploaded images resolved
+func (m *QuestionService) RenderQuestion(ctx context.Context, question *entity.Question) (*latex.RenderedQuestion, error) {
+	images, err := m.questionImageRepo.GetByQuestionID(ctx, question.ID.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question images: %w", err)
+	}
+	return m.renderer.RenderQuestion(question, images), nil
+}
+
+// RenderQuestionsByIDs renders several questions at once, keyed by question ID.
+// Unknown IDs are left out of the result.
+func (m *QuestionService) RenderQuestionsByIDs(ctx context.Context, ids []string) (map[string]*latex.RenderedQuestion, error) {
+	rendered := make(map[string]*latex.RenderedQuestion, len(ids))
+	if len(ids) == 0 {
+		return rendered, nil
+	}
+
+	questions, err := m.questionRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questions: %w", err)
+	}
+	images, err := m.questionImageRepo.GetByQuestionIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get question images: %w", err)
+	}
+
+	for _, q := range questions {
+		rendered[q.ID.String] = m.renderer.RenderQuestion(q, images[q.ID.String])
+	}
+	return rendered, nil
+}
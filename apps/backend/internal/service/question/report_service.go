@@ -0,0 +1,288 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/exam/scoring"
+)
+
+// Error report triage errors
+var (
+	ErrReportInvalidInput = errors.New("invalid error report")
+	ErrReportDuplicate    = errors.New("you already have an open report for this question")
+	ErrReportNothingOpen  = errors.New("question has no open reports")
+)
+
+// DefaultReportSuspendThreshold is the number of credible reports that suspends a question
+const DefaultReportSuspendThreshold = 3
+
+// reportQuestionLookup is the subset of the question repository used for report triage
+type reportQuestionLookup interface {
+	GetByID(ctx context.Context, id string) (*entity.Question, error)
+}
+
+// attemptRegrader re-grades submitted exam attempts
+type attemptRegrader interface {
+	ReGradeExam(ctx context.Context, attemptID string) (*scoring.ExamGradingResult, error)
+}
+
+// ReportInput describes a student's error report
+type ReportInput struct {
+	QuestionID string
+	Category   entity.ReportCategory
+	Content    string
+	AttemptID  string
+}
+
+// ReportResolution is the outcome of resolving a question's reports
+type ReportResolution struct {
+	ResolvedReports  int
+	RegradedAttempts int
+	FailedAttempts   []string
+}
+
+// ReportService triages student error reports on questions
+type ReportService struct {
+	reportRepo       repository.QuestionReportRepository
+	questionRepo     reportQuestionLookup
+	grader           attemptRegrader
+	suspendThreshold int
+}
+
+// NewReportService creates a new error report service.
+// A non-positive threshold falls back to DefaultReportSuspendThreshold.
+func NewReportService(
+	reportRepo repository.QuestionReportRepository,
+	questionRepo reportQuestionLookup,
+	grader attemptRegrader,
+	suspendThreshold int,
+) *ReportService {
+	if suspendThreshold <= 0 {
+		suspendThreshold = DefaultReportSuspendThreshold
+	}
+	return &ReportService{
+		reportRepo:       reportRepo,
+		questionRepo:     questionRepo,
+		grader:           grader,
+		suspendThreshold: suspendThreshold,
+	}
+}
+
+// SubmitReport files an error report. Reports from students who answered the
+// question in a submitted attempt are credible; enough credible reports
+// suspend the question from new exams.
+func (s *ReportService) SubmitReport(ctx context.Context, reporterID string, input ReportInput) (*entity.QuestionErrorReport, error) {
+	questionID := strings.TrimSpace(input.QuestionID)
+	if questionID == "" || reporterID == "" {
+		return nil, ErrReportInvalidInput
+	}
+	if input.Category == "" {
+		input.Category = entity.ReportCategoryOther
+	}
+	if !input.Category.IsValid() {
+		return nil, fmt.Errorf("%w: unknown category %q", ErrReportInvalidInput, input.Category)
+	}
+	content := strings.TrimSpace(input.Content)
+	if input.Category == entity.ReportCategoryOther && content == "" {
+		return nil, fmt.Errorf("%w: a description is required for category OTHER", ErrReportInvalidInput)
+	}
+
+	if _, err := s.questionRepo.GetByID(ctx, questionID); err != nil {
+		return nil, fmt.Errorf("question not found: %w", err)
+	}
+
+	report := &entity.QuestionErrorReport{
+		QuestionID: questionID,
+		UserID:     &reporterID,
+		Category:   input.Category,
+		Status:     entity.ReportStatusOpen,
+	}
+	if content != "" {
+		report.Content = &content
+	}
+
+	attemptID, err := s.reportRepo.FindSubmittedAttempt(ctx, reporterID, questionID)
+	switch {
+	case err == nil:
+		report.IsCredible = true
+		report.AttemptID = &attemptID
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, err
+	}
+	if report.AttemptID == nil && input.AttemptID != "" {
+		report.AttemptID = &input.AttemptID
+	}
+
+	if err := s.reportRepo.Create(ctx, report); err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, ErrReportDuplicate
+		}
+		return nil, err
+	}
+
+	if report.IsCredible {
+		if err := s.checkSuspension(ctx, questionID); err != nil {
+			// The report is stored; suspension is re-evaluated on the next report
+			log.Printf("[WARN] Failed to evaluate suspension for question %s: %v", questionID, err)
+		}
+	}
+
+	return report, nil
+}
+
+// ListQueue returns questions with active reports grouped for triage
+func (s *ReportService) ListQueue(ctx context.Context, filter repository.ReportQueueFilter, limit, offset int) ([]*entity.ReportTriageItem, int, error) {
+	if filter.Status != "" && !filter.Status.IsActive() {
+		return nil, 0, fmt.Errorf("%w: queue only contains OPEN or ACKNOWLEDGED reports", ErrReportInvalidInput)
+	}
+	if filter.Category != "" && !filter.Category.IsValid() {
+		return nil, 0, fmt.Errorf("%w: unknown category %q", ErrReportInvalidInput, filter.Category)
+	}
+	return s.reportRepo.ListQueue(ctx, filter, limit, offset)
+}
+
+// ListReports returns the reports filed against a question
+func (s *ReportService) ListReports(ctx context.Context, questionID string, activeOnly bool) ([]*entity.QuestionErrorReport, error) {
+	if strings.TrimSpace(questionID) == "" {
+		return nil, ErrReportInvalidInput
+	}
+	return s.reportRepo.ListByQuestion(ctx, questionID, activeOnly)
+}
+
+// Acknowledge marks the open reports of a question as being worked on
+func (s *ReportService) Acknowledge(ctx context.Context, actorID, questionID string) (int, error) {
+	n, err := s.reportRepo.UpdateActiveStatus(ctx, questionID, entity.ReportStatusAcknowledged, actorID, nil)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, ErrReportNothingOpen
+	}
+	return n, nil
+}
+
+// Dismiss closes the reports of a question without changes and lifts any suspension
+func (s *ReportService) Dismiss(ctx context.Context, actorID, questionID, note string) (int, error) {
+	n, err := s.closeReports(ctx, actorID, questionID, entity.ReportStatusDismissed, note)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.reportRepo.LiftSuspension(ctx, questionID); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+// Resolve closes the reports of a question after it has been fixed and lifts
+// any suspension. When the answer key was corrected, every submitted attempt of
+// an exam containing the question is re-graded.
+func (s *ReportService) Resolve(ctx context.Context, actorID, questionID, note string, answerKeyFixed bool) (*ReportResolution, error) {
+	n, err := s.closeReports(ctx, actorID, questionID, entity.ReportStatusResolved, note)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reportRepo.LiftSuspension(ctx, questionID); err != nil {
+		return nil, err
+	}
+
+	resolution := &ReportResolution{ResolvedReports: n}
+	if !answerKeyFixed {
+		return resolution, nil
+	}
+
+	attemptIDs, err := s.reportRepo.ListSubmittedAttemptIDs(ctx, questionID)
+	if err != nil {
+		return resolution, err
+	}
+	for _, attemptID := range attemptIDs {
+		if _, err := s.grader.ReGradeExam(ctx, attemptID); err != nil {
+			log.Printf("[WARN] Failed to re-grade attempt %s after answer key fix on %s: %v", attemptID, questionID, err)
+			resolution.FailedAttempts = append(resolution.FailedAttempts, attemptID)
+			continue
+		}
+		resolution.RegradedAttempts++
+	}
+	return resolution, nil
+}
+
+// Suspend manually withholds a question from new exams
+func (s *ReportService) Suspend(ctx context.Context, actorID, questionID, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if questionID == "" || reason == "" {
+		return ErrReportInvalidInput
+	}
+	credible, err := s.reportRepo.CountCredibleActive(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	_, err = s.reportRepo.Suspend(ctx, &entity.QuestionSuspension{
+		QuestionID:      questionID,
+		Reason:          reason,
+		CredibleReports: credible,
+		SuspendedBy:     &actorID,
+	})
+	return err
+}
+
+// LiftSuspension allows a question to be added to new exams again
+func (s *ReportService) LiftSuspension(ctx context.Context, questionID string) error {
+	return s.reportRepo.LiftSuspension(ctx, questionID)
+}
+
+// IsSuspended reports whether a question is withheld from new exams
+func (s *ReportService) IsSuspended(ctx context.Context, questionID string) (bool, error) {
+	_, err := s.reportRepo.GetSuspension(ctx, questionID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *ReportService) closeReports(ctx context.Context, actorID, questionID string, status entity.ReportStatus, note string) (int, error) {
+	if strings.TrimSpace(questionID) == "" {
+		return 0, ErrReportInvalidInput
+	}
+	var notePtr *string
+	if note = strings.TrimSpace(note); note != "" {
+		notePtr = &note
+	}
+	n, err := s.reportRepo.UpdateActiveStatus(ctx, questionID, status, actorID, notePtr)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, ErrReportNothingOpen
+	}
+	return n, nil
+}
+
+func (s *ReportService) checkSuspension(ctx context.Context, questionID string) error {
+	credible, err := s.reportRepo.CountCredibleActive(ctx, questionID)
+	if err != nil {
+		return err
+	}
+	if credible < s.suspendThreshold {
+		return nil
+	}
+	suspended, err := s.reportRepo.Suspend(ctx, &entity.QuestionSuspension{
+		QuestionID:      questionID,
+		Reason:          fmt.Sprintf("Automatically suspended after %d credible error reports", credible),
+		CredibleReports: credible,
+	})
+	if err != nil {
+		return err
+	}
+	if suspended {
+		log.Printf("[INFO] Question %s suspended from new exams after %d credible reports", questionID, credible)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/exam/scoring"
+)
+
+// mockReportRepository implements repository.QuestionReportRepository for testing.
+type mockReportRepository struct {
+	reports     []*entity.QuestionErrorReport
+	answered    map[string]string // userID -> attemptID
+	attempts    []string
+	suspensions map[string]*entity.QuestionSuspension
+}
+
+func newMockReportRepository() *mockReportRepository {
+	return &mockReportRepository{
+		answered:    make(map[string]string),
+		suspensions: make(map[string]*entity.QuestionSuspension),
+	}
+}
+
+func (m *mockReportRepository) Create(ctx context.Context, report *entity.QuestionErrorReport) error {
+	for _, r := range m.reports {
+		if r.QuestionID == report.QuestionID && *r.UserID == *report.UserID && r.Status.IsActive() {
+			return repository.ErrDuplicateKey
+		}
+	}
+	m.reports = append(m.reports, report)
+	return nil
+}
+
+func (m *mockReportRepository) ListByQuestion(ctx context.Context, questionID string, activeOnly bool) ([]*entity.QuestionErrorReport, error) {
+	var out []*entity.QuestionErrorReport
+	for _, r := range m.reports {
+		if r.QuestionID == questionID && (!activeOnly || r.Status.IsActive()) {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockReportRepository) CountCredibleActive(ctx context.Context, questionID string) (int, error) {
+	count := 0
+	for _, r := range m.reports {
+		if r.QuestionID == questionID && r.IsCredible && r.Status.IsActive() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockReportRepository) UpdateActiveStatus(ctx context.Context, questionID string, status entity.ReportStatus, actorID string, note *string) (int, error) {
+	n := 0
+	for _, r := range m.reports {
+		if r.QuestionID == questionID && r.Status.IsActive() {
+			r.Status = status
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *mockReportRepository) ListQueue(ctx context.Context, filter repository.ReportQueueFilter, limit, offset int) ([]*entity.ReportTriageItem, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockReportRepository) FindSubmittedAttempt(ctx context.Context, userID, questionID string) (string, error) {
+	if id, ok := m.answered[userID]; ok {
+		return id, nil
+	}
+	return "", repository.ErrNotFound
+}
+
+func (m *mockReportRepository) ListSubmittedAttemptIDs(ctx context.Context, questionID string) ([]string, error) {
+	return m.attempts, nil
+}
+
+func (m *mockReportRepository) GetSuspension(ctx context.Context, questionID string) (*entity.QuestionSuspension, error) {
+	if s, ok := m.suspensions[questionID]; ok {
+		return s, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *mockReportRepository) Suspend(ctx context.Context, suspension *entity.QuestionSuspension) (bool, error) {
+	if _, ok := m.suspensions[suspension.QuestionID]; ok {
+		return false, nil
+	}
+	m.suspensions[suspension.QuestionID] = suspension
+	return true, nil
+}
+
+func (m *mockReportRepository) LiftSuspension(ctx context.Context, questionID string) error {
+	delete(m.suspensions, questionID)
+	return nil
+}
+
+type stubQuestionLookup struct{}
+
+func (stubQuestionLookup) GetByID(ctx context.Context, id string) (*entity.Question, error) {
+	if id == "missing" {
+		return nil, errors.New("not found")
+	}
+	return &entity.Question{}, nil
+}
+
+// mockRegrader records re-graded attempts and fails for the configured ones.
+type mockRegrader struct {
+	regraded []string
+	failFor  map[string]bool
+}
+
+func (m *mockRegrader) ReGradeExam(ctx context.Context, attemptID string) (*scoring.ExamGradingResult, error) {
+	if m.failFor[attemptID] {
+		return nil, errors.New("attempt not submitted")
+	}
+	m.regraded = append(m.regraded, attemptID)
+	return &scoring.ExamGradingResult{AttemptID: attemptID}, nil
+}
+
+func TestReportService_SubmitReportCredibility(t *testing.T) {
+	repo := newMockReportRepository()
+	repo.answered["student-1"] = "attempt-1"
+	svc := NewReportService(repo, stubQuestionLookup{}, &mockRegrader{}, 3)
+	ctx := context.Background()
+
+	credible, err := svc.SubmitReport(ctx, "student-1", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryWrongKey})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !credible.IsCredible || credible.AttemptID == nil || *credible.AttemptID != "attempt-1" {
+		t.Errorf("expected credible report linked to attempt-1, got %+v", credible)
+	}
+
+	other, err := svc.SubmitReport(ctx, "student-2", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryTypo})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if other.IsCredible {
+		t.Errorf("expected report without a submitted attempt to be non-credible")
+	}
+
+	if _, err := svc.SubmitReport(ctx, "student-1", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryTypo}); !errors.Is(err, ErrReportDuplicate) {
+		t.Errorf("expected ErrReportDuplicate, got %v", err)
+	}
+}
+
+func TestReportService_SubmitReportValidation(t *testing.T) {
+	svc := NewReportService(newMockReportRepository(), stubQuestionLookup{}, &mockRegrader{}, 0)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		input ReportInput
+	}{
+		{"missing question", ReportInput{Category: entity.ReportCategoryTypo}},
+		{"unknown category", ReportInput{QuestionID: "q-1", Category: "SPAM"}},
+		{"other without description", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryOther}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.SubmitReport(ctx, "student-1", tt.input); !errors.Is(err, ErrReportInvalidInput) {
+				t.Errorf("expected ErrReportInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReportService_AutoSuspendAfterThreshold(t *testing.T) {
+	repo := newMockReportRepository()
+	for _, u := range []string{"s1", "s2", "s3"} {
+		repo.answered[u] = "attempt-" + u
+	}
+	svc := NewReportService(repo, stubQuestionLookup{}, &mockRegrader{}, 3)
+	ctx := context.Background()
+
+	// Non-credible reports never count towards suspension
+	if _, err := svc.SubmitReport(ctx, "visitor", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryWrongKey}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, u := range []string{"s1", "s2", "s3"} {
+		if _, err := svc.SubmitReport(ctx, u, ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryWrongKey}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		suspended, _ := svc.IsSuspended(ctx, "q-1")
+		if want := i == 2; suspended != want {
+			t.Fatalf("after %d credible reports: suspended=%v, want %v", i+1, suspended, want)
+		}
+	}
+
+	if got := repo.suspensions["q-1"]; got.SuspendedBy != nil || got.CredibleReports != 3 {
+		t.Errorf("expected automatic suspension with 3 credible reports, got %+v", got)
+	}
+}
+
+func TestReportService_ResolveWithAnswerKeyFixRegrades(t *testing.T) {
+	repo := newMockReportRepository()
+	repo.answered["s1"] = "attempt-1"
+	repo.attempts = []string{"attempt-1", "attempt-2", "attempt-3"}
+	grader := &mockRegrader{failFor: map[string]bool{"attempt-3": true}}
+	svc := NewReportService(repo, stubQuestionLookup{}, grader, 1)
+	ctx := context.Background()
+
+	if _, err := svc.SubmitReport(ctx, "s1", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryWrongKey}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if suspended, _ := svc.IsSuspended(ctx, "q-1"); !suspended {
+		t.Fatalf("expected question to be suspended")
+	}
+
+	resolution, err := svc.Resolve(ctx, "teacher-1", "q-1", "Key corrected to B", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resolution.ResolvedReports != 1 || resolution.RegradedAttempts != 2 {
+		t.Errorf("expected 1 resolved report and 2 regraded attempts, got %+v", resolution)
+	}
+	if len(resolution.FailedAttempts) != 1 || resolution.FailedAttempts[0] != "attempt-3" {
+		t.Errorf("expected attempt-3 to be reported as failed, got %v", resolution.FailedAttempts)
+	}
+	if suspended, _ := svc.IsSuspended(ctx, "q-1"); suspended {
+		t.Errorf("expected suspension to be lifted after resolution")
+	}
+
+	if _, err := svc.Resolve(ctx, "teacher-1", "q-1", "", false); !errors.Is(err, ErrReportNothingOpen) {
+		t.Errorf("expected ErrReportNothingOpen on second resolve, got %v", err)
+	}
+}
+
+func TestReportService_ResolveWithoutKeyFixSkipsRegrade(t *testing.T) {
+	repo := newMockReportRepository()
+	repo.attempts = []string{"attempt-1"}
+	grader := &mockRegrader{}
+	svc := NewReportService(repo, stubQuestionLookup{}, grader, 3)
+	ctx := context.Background()
+
+	if _, err := svc.SubmitReport(ctx, "s1", ReportInput{QuestionID: "q-1", Category: entity.ReportCategoryTypo}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Resolve(ctx, "teacher-1", "q-1", "Fixed typo", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(grader.regraded) != 0 {
+		t.Errorf("expected no re-grading, got %v", grader.regraded)
+	}
+}
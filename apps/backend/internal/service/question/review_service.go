@@ -0,0 +1,533 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+)
+
+// Review workflow errors
+var (
+	ErrReviewNotFound          = errors.New("review not found")
+	ErrReviewAlreadyOpen       = errors.New("question already has an open review")
+	ErrReviewInvalidTransition = errors.New("invalid review status transition")
+	ErrReviewPermissionDenied  = errors.New("not allowed to act on this review")
+	ErrReviewInvalidInput      = errors.New("invalid review input")
+)
+
+// dashboardWindow is how far back recent decisions are counted on the reviewer dashboard
+const dashboardWindow = 30 * 24 * time.Hour
+
+// reviewQuestionStore is the subset of the question repository used by the review workflow
+type reviewQuestionStore interface {
+	GetByID(ctx context.Context, id string) (*entity.Question, error)
+	UpdateStatus(ctx context.Context, id string, status string) error
+}
+
+// reviewCodeLookup resolves the QuestionCode used to route a question to reviewers
+type reviewCodeLookup interface {
+	GetByCode(ctx context.Context, code string) (*entity.QuestionCode, error)
+}
+
+// reviewVersionSource provides the version a review round is pinned to
+type reviewVersionSource interface {
+	GetLatestVersionNumber(ctx context.Context, questionID uuid.UUID) (int32, error)
+}
+
+// ReviewNotifier delivers review updates to authors and reviewers
+type ReviewNotifier interface {
+	CreateNotification(
+		ctx context.Context,
+		userID string,
+		notifType notification.NotificationType,
+		title string,
+		message string,
+		data *notification.NotificationData,
+		expiresIn *time.Duration,
+	) error
+}
+
+// ReviewActor identifies the user performing a review action
+type ReviewActor struct {
+	UserID  string
+	IsAdmin bool
+}
+
+// CommentInput describes a new inline review comment or reply
+type CommentInput struct {
+	QuestionID string
+	ParentID   *uuid.UUID
+	Field      *string
+	LineStart  *int32
+	LineEnd    *int32
+	Body       string
+}
+
+// ReviewerDashboard is the outstanding work and recent activity of a reviewer
+type ReviewerDashboard struct {
+	Workload *entity.ReviewerWorkload
+	Items    []*entity.OutstandingReview
+	Total    int
+}
+
+// ReviewService drives questions from PENDING to ACTIVE through reviewer approval
+type ReviewService struct {
+	reviewRepo       repository.QuestionReviewRepository
+	questionRepo     reviewQuestionStore
+	questionCodeRepo reviewCodeLookup
+	versionRepo      reviewVersionSource
+	notifier         ReviewNotifier
+}
+
+// NewReviewService creates a new review service
+func NewReviewService(
+	reviewRepo repository.QuestionReviewRepository,
+	questionRepo reviewQuestionStore,
+	questionCodeRepo reviewCodeLookup,
+	versionRepo reviewVersionSource,
+	notifier ReviewNotifier,
+) *ReviewService {
+	return &ReviewService{
+		reviewRepo:       reviewRepo,
+		questionRepo:     questionRepo,
+		questionCodeRepo: questionCodeRepo,
+		versionRepo:      versionRepo,
+		notifier:         notifier,
+	}
+}
+
+// SubmitForReview opens a review round for a question, or resubmits a round
+// that had changes requested. Only the question's creator or an admin may submit.
+// The question is held in PENDING until a decision.
+func (s *ReviewService) SubmitForReview(ctx context.Context, actor ReviewActor, questionID string) (*entity.QuestionReview, error) {
+	questionID = strings.TrimSpace(questionID)
+	if questionID == "" || actor.UserID == "" {
+		return nil, ErrReviewInvalidInput
+	}
+
+	q, err := s.questionRepo.GetByID(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("question not found: %w", err)
+	}
+	if q.Status.String == string(entity.QuestionStatusArchived) {
+		return nil, fmt.Errorf("%w: archived questions cannot be reviewed", ErrReviewInvalidTransition)
+	}
+	// Only the author submits a question; it would otherwise be taken out of circulation
+	// and its decisions sent to someone else
+	if q.Creator.String != actor.UserID && !actor.IsAdmin {
+		return nil, ErrReviewPermissionDenied
+	}
+
+	version := s.latestVersion(ctx, questionID)
+
+	open, err := s.reviewRepo.GetOpenByQuestionID(ctx, questionID)
+	switch {
+	case err == nil:
+		if open.Status != entity.ReviewStatusChangesRequested {
+			return nil, ErrReviewAlreadyOpen
+		}
+		if open.AuthorID != actor.UserID && !actor.IsAdmin {
+			return nil, ErrReviewPermissionDenied
+		}
+		if err := s.reviewRepo.Resubmit(ctx, open.ID, version); err != nil {
+			return nil, fmt.Errorf("failed to resubmit review: %w", err)
+		}
+		open.Status = entity.ReviewStatusInReview
+		open.VersionNumber = version
+		if open.ReviewerID != nil {
+			s.notify(ctx, *open.ReviewerID, "Question resubmitted for review",
+				fmt.Sprintf("Question %s was updated and is ready for another review.", questionID), open)
+		}
+		return open, nil
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, fmt.Errorf("failed to check open review: %w", err)
+	}
+
+	review := &entity.QuestionReview{
+		QuestionID:    questionID,
+		AuthorID:      actor.UserID,
+		Status:        entity.ReviewStatusInReview,
+		VersionNumber: version,
+	}
+
+	if q.QuestionCodeID.Status == pgtype.Present && q.QuestionCodeID.String != "" {
+		if code, err := s.questionCodeRepo.GetByCode(ctx, q.QuestionCodeID.String); err == nil {
+			if code.Subject.Status == pgtype.Present {
+				subject := code.Subject.String
+				review.Subject = &subject
+			}
+			if code.Grade.Status == pgtype.Present {
+				grade := code.Grade.String
+				review.Grade = &grade
+			}
+		}
+	}
+
+	if review.Subject != nil {
+		grade := ""
+		if review.Grade != nil {
+			grade = *review.Grade
+		}
+		candidates, err := s.reviewRepo.FindReviewerCandidates(ctx, *review.Subject, grade)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find reviewers: %w", err)
+		}
+		for _, c := range candidates {
+			// Authors never review their own questions
+			if c.ReviewerID != actor.UserID {
+				reviewerID := c.ReviewerID
+				review.ReviewerID = &reviewerID
+				break
+			}
+		}
+	}
+
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, ErrReviewAlreadyOpen
+		}
+		return nil, err
+	}
+
+	if q.Status.String != string(entity.QuestionStatusPending) {
+		if err := s.questionRepo.UpdateStatus(ctx, questionID, string(entity.QuestionStatusPending)); err != nil {
+			return nil, fmt.Errorf("failed to mark question pending: %w", err)
+		}
+	}
+
+	if review.ReviewerID != nil {
+		s.notify(ctx, *review.ReviewerID, "New question to review",
+			fmt.Sprintf("Question %s has been assigned to you for review.", questionID), review)
+	}
+
+	return review, nil
+}
+
+// AssignReviewer sets the reviewer of an open review round
+func (s *ReviewService) AssignReviewer(ctx context.Context, reviewID uuid.UUID, reviewerID string) (*entity.QuestionReview, error) {
+	reviewerID = strings.TrimSpace(reviewerID)
+	if reviewerID == "" {
+		return nil, ErrReviewInvalidInput
+	}
+
+	review, err := s.getReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !review.Status.IsOpen() {
+		return nil, ErrReviewInvalidTransition
+	}
+	if review.AuthorID == reviewerID {
+		return nil, fmt.Errorf("%w: author cannot review own question", ErrReviewInvalidInput)
+	}
+
+	if err := s.reviewRepo.AssignReviewer(ctx, reviewID, reviewerID); err != nil {
+		return nil, fmt.Errorf("failed to assign reviewer: %w", err)
+	}
+	review.ReviewerID = &reviewerID
+
+	s.notify(ctx, reviewerID, "New question to review",
+		fmt.Sprintf("Question %s has been assigned to you for review.", review.QuestionID), review)
+
+	return review, nil
+}
+
+// SubmitDecision records the reviewer's decision and updates the question status.
+// APPROVED activates the question, REJECTED deactivates it and CHANGES_REQUESTED
+// keeps it pending until the author resubmits.
+func (s *ReviewService) SubmitDecision(
+	ctx context.Context,
+	actor ReviewActor,
+	reviewID uuid.UUID,
+	decision entity.QuestionReviewStatus,
+	note string,
+) (*entity.QuestionReview, error) {
+	if decision == entity.ReviewStatusInReview || decision == "" {
+		return nil, ErrReviewInvalidTransition
+	}
+	note = strings.TrimSpace(note)
+	if decision != entity.ReviewStatusApproved && note == "" {
+		return nil, fmt.Errorf("%w: a note is required when requesting changes or rejecting", ErrReviewInvalidInput)
+	}
+
+	review, err := s.getReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.IsAdmin && (review.ReviewerID == nil || *review.ReviewerID != actor.UserID) {
+		return nil, ErrReviewPermissionDenied
+	}
+	if review.Status != entity.ReviewStatusInReview {
+		return nil, ErrReviewInvalidTransition
+	}
+
+	var notePtr *string
+	if note != "" {
+		notePtr = &note
+	}
+	if err := s.reviewRepo.UpdateStatus(ctx, reviewID, decision, notePtr); err != nil {
+		return nil, fmt.Errorf("failed to update review: %w", err)
+	}
+
+	var questionStatus entity.QuestionStatus
+	switch decision {
+	case entity.ReviewStatusApproved:
+		questionStatus = entity.QuestionStatusActive
+	case entity.ReviewStatusRejected:
+		questionStatus = entity.QuestionStatusInactive
+	}
+	if questionStatus != "" {
+		if err := s.questionRepo.UpdateStatus(ctx, review.QuestionID, string(questionStatus)); err != nil {
+			return nil, fmt.Errorf("failed to update question status: %w", err)
+		}
+	}
+
+	review.Status = decision
+	review.DecisionNote = notePtr
+
+	title, message := decisionMessage(review.QuestionID, decision)
+	s.notify(ctx, review.AuthorID, title, message, review)
+
+	return review, nil
+}
+
+// GetReview returns a review round if the actor is a participant or an admin
+func (s *ReviewService) GetReview(ctx context.Context, actor ReviewActor, reviewID uuid.UUID) (*entity.QuestionReview, error) {
+	review, err := s.getReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !canParticipate(actor, review) {
+		return nil, ErrReviewPermissionDenied
+	}
+	return review, nil
+}
+
+// ListQuestionReviews returns the review history of a question
+func (s *ReviewService) ListQuestionReviews(ctx context.Context, questionID string) ([]*entity.QuestionReview, error) {
+	if strings.TrimSpace(questionID) == "" {
+		return nil, ErrReviewInvalidInput
+	}
+	return s.reviewRepo.ListByQuestionID(ctx, questionID)
+}
+
+// AddComment adds an inline comment or a reply on the reviewed version
+func (s *ReviewService) AddComment(ctx context.Context, actor ReviewActor, reviewID uuid.UUID, input CommentInput) (*entity.QuestionReviewComment, error) {
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, fmt.Errorf("%w: comment body is required", ErrReviewInvalidInput)
+	}
+	if input.LineStart != nil && input.LineEnd != nil && *input.LineEnd < *input.LineStart {
+		return nil, fmt.Errorf("%w: line_end must not precede line_start", ErrReviewInvalidInput)
+	}
+
+	review, err := s.getReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !canParticipate(actor, review) {
+		return nil, ErrReviewPermissionDenied
+	}
+
+	comment := &entity.QuestionReviewComment{
+		ReviewID:      review.ID,
+		QuestionID:    review.QuestionID,
+		VersionNumber: review.VersionNumber,
+		AuthorID:      actor.UserID,
+		Field:         input.Field,
+		LineStart:     input.LineStart,
+		LineEnd:       input.LineEnd,
+		Body:          body,
+	}
+
+	if input.ParentID != nil {
+		parent, err := s.reviewRepo.GetComment(ctx, *input.ParentID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, fmt.Errorf("%w: parent comment not found", ErrReviewInvalidInput)
+			}
+			return nil, err
+		}
+		if parent.ReviewID != review.ID {
+			return nil, fmt.Errorf("%w: parent comment belongs to another review", ErrReviewInvalidInput)
+		}
+		// Replies stay anchored to the thread's version and location
+		comment.ParentID = &parent.ID
+		comment.VersionNumber = parent.VersionNumber
+		comment.Field = parent.Field
+		comment.LineStart = parent.LineStart
+		comment.LineEnd = parent.LineEnd
+	}
+
+	if err := s.reviewRepo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	recipient := review.AuthorID
+	if actor.UserID == review.AuthorID && review.ReviewerID != nil {
+		recipient = *review.ReviewerID
+	}
+	if recipient != actor.UserID {
+		s.notify(ctx, recipient, "New review comment",
+			fmt.Sprintf("A new comment was added on question %s.", review.QuestionID), review)
+	}
+
+	return comment, nil
+}
+
+// ListComments returns all comments of a review in creation order
+func (s *ReviewService) ListComments(ctx context.Context, actor ReviewActor, reviewID uuid.UUID) ([]*entity.QuestionReviewComment, error) {
+	review, err := s.getReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !canParticipate(actor, review) {
+		return nil, ErrReviewPermissionDenied
+	}
+	return s.reviewRepo.ListComments(ctx, reviewID)
+}
+
+// ResolveComment marks a comment thread as resolved or reopens it
+func (s *ReviewService) ResolveComment(ctx context.Context, actor ReviewActor, commentID uuid.UUID, resolved bool) error {
+	comment, err := s.reviewRepo.GetComment(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrReviewNotFound
+		}
+		return err
+	}
+	review, err := s.getReview(ctx, comment.ReviewID)
+	if err != nil {
+		return err
+	}
+	if !canParticipate(actor, review) {
+		return ErrReviewPermissionDenied
+	}
+	return s.reviewRepo.SetCommentResolved(ctx, commentID, resolved)
+}
+
+// GetReviewerDashboard lists outstanding reviews and recent activity of a reviewer
+func (s *ReviewService) GetReviewerDashboard(ctx context.Context, reviewerID string, limit, offset int) (*ReviewerDashboard, error) {
+	if strings.TrimSpace(reviewerID) == "" {
+		return nil, ErrReviewInvalidInput
+	}
+
+	workload, err := s.reviewRepo.GetWorkload(ctx, reviewerID, time.Now().Add(-dashboardWindow))
+	if err != nil {
+		return nil, err
+	}
+	items, total, err := s.reviewRepo.ListOutstanding(ctx, reviewerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReviewerDashboard{
+		Workload: workload,
+		Items:    items,
+		Total:    total,
+	}, nil
+}
+
+// SetReviewerSubjects replaces the subjects a reviewer is assigned to
+func (s *ReviewService) SetReviewerSubjects(ctx context.Context, reviewerID string, subjects []*entity.ReviewerSubject) error {
+	if strings.TrimSpace(reviewerID) == "" {
+		return ErrReviewInvalidInput
+	}
+	for _, subj := range subjects {
+		subj.Subject = strings.ToUpper(strings.TrimSpace(subj.Subject))
+		if len(subj.Subject) != 1 {
+			return fmt.Errorf("%w: subject must be a single QuestionCode character", ErrReviewInvalidInput)
+		}
+		if subj.Grade != nil {
+			grade := strings.ToUpper(strings.TrimSpace(*subj.Grade))
+			if grade == "" {
+				subj.Grade = nil
+			} else if len(grade) != 1 {
+				return fmt.Errorf("%w: grade must be a single QuestionCode character", ErrReviewInvalidInput)
+			} else {
+				subj.Grade = &grade
+			}
+		}
+	}
+	return s.reviewRepo.SetReviewerSubjects(ctx, reviewerID, subjects)
+}
+
+// ListReviewerSubjects returns the subjects a reviewer is assigned to
+func (s *ReviewService) ListReviewerSubjects(ctx context.Context, reviewerID string) ([]*entity.ReviewerSubject, error) {
+	return s.reviewRepo.ListReviewerSubjects(ctx, reviewerID)
+}
+
+func (s *ReviewService) getReview(ctx context.Context, reviewID uuid.UUID) (*entity.QuestionReview, error) {
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+func (s *ReviewService) latestVersion(ctx context.Context, questionID string) int32 {
+	if s.versionRepo == nil {
+		return 0
+	}
+	id, err := uuid.Parse(questionID)
+	if err != nil {
+		return 0
+	}
+	version, err := s.versionRepo.GetLatestVersionNumber(ctx, id)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// notify sends a review notification; delivery failures never block the workflow
+func (s *ReviewService) notify(ctx context.Context, userID, title, message string, review *entity.QuestionReview) {
+	if s.notifier == nil || userID == "" {
+		return
+	}
+	data := &notification.NotificationData{
+		Priority:   notification.PriorityMedium,
+		ActionURL:  fmt.Sprintf("/questions/%s/review", review.QuestionID),
+		ActionText: "Open review",
+		Metadata: map[string]interface{}{
+			"review_id":      review.ID.String(),
+			"question_id":    review.QuestionID,
+			"review_status":  string(review.Status),
+			"version_number": review.VersionNumber,
+		},
+	}
+	if err := s.notifier.CreateNotification(ctx, userID, notification.TypeQuestionReview, title, message, data, nil); err != nil {
+		log.Printf("[WARN] Failed to send review notification to %s: %v", userID, err)
+	}
+}
+
+func canParticipate(actor ReviewActor, review *entity.QuestionReview) bool {
+	if actor.IsAdmin || actor.UserID == review.AuthorID {
+		return true
+	}
+	return review.ReviewerID != nil && *review.ReviewerID == actor.UserID
+}
+
+func decisionMessage(questionID string, decision entity.QuestionReviewStatus) (string, string) {
+	switch decision {
+	case entity.ReviewStatusApproved:
+		return "Question approved", fmt.Sprintf("Your question %s was approved and is now active.", questionID)
+	case entity.ReviewStatusRejected:
+		return "Question rejected", fmt.Sprintf("Your question %s was rejected. See the reviewer's note for details.", questionID)
+	default:
+		return "Changes requested", fmt.Sprintf("The reviewer requested changes on question %s.", questionID)
+	}
+}
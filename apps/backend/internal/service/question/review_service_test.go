@@ -0,0 +1,428 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+)
+
+// mockReviewRepository implements repository.QuestionReviewRepository for testing.
+type mockReviewRepository struct {
+	reviews    map[uuid.UUID]*entity.QuestionReview
+	comments   map[uuid.UUID]*entity.QuestionReviewComment
+	candidates []repository.ReviewerCandidate
+}
+
+func newMockReviewRepository() *mockReviewRepository {
+	return &mockReviewRepository{
+		reviews:  make(map[uuid.UUID]*entity.QuestionReview),
+		comments: make(map[uuid.UUID]*entity.QuestionReviewComment),
+	}
+}
+
+func (m *mockReviewRepository) Create(ctx context.Context, review *entity.QuestionReview) error {
+	if review.ID == uuid.Nil {
+		review.ID = uuid.New()
+	}
+	copied := *review
+	m.reviews[review.ID] = &copied
+	return nil
+}
+
+func (m *mockReviewRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.QuestionReview, error) {
+	if r, ok := m.reviews[id]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *mockReviewRepository) GetOpenByQuestionID(ctx context.Context, questionID string) (*entity.QuestionReview, error) {
+	for _, r := range m.reviews {
+		if r.QuestionID == questionID && r.Status.IsOpen() {
+			copied := *r
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *mockReviewRepository) ListByQuestionID(ctx context.Context, questionID string) ([]*entity.QuestionReview, error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.QuestionReviewStatus, note *string) error {
+	r, ok := m.reviews[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	r.Status = status
+	r.DecisionNote = note
+	return nil
+}
+
+func (m *mockReviewRepository) AssignReviewer(ctx context.Context, id uuid.UUID, reviewerID string) error {
+	r, ok := m.reviews[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	r.ReviewerID = &reviewerID
+	return nil
+}
+
+func (m *mockReviewRepository) Resubmit(ctx context.Context, id uuid.UUID, versionNumber int32) error {
+	r, ok := m.reviews[id]
+	if !ok || r.Status != entity.ReviewStatusChangesRequested {
+		return repository.ErrNotFound
+	}
+	r.Status = entity.ReviewStatusInReview
+	r.VersionNumber = versionNumber
+	return nil
+}
+
+func (m *mockReviewRepository) SetReviewerSubjects(ctx context.Context, reviewerID string, subjects []*entity.ReviewerSubject) error {
+	return nil
+}
+
+func (m *mockReviewRepository) ListReviewerSubjects(ctx context.Context, reviewerID string) ([]*entity.ReviewerSubject, error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepository) FindReviewerCandidates(ctx context.Context, subject, grade string) ([]repository.ReviewerCandidate, error) {
+	return m.candidates, nil
+}
+
+func (m *mockReviewRepository) ListOutstanding(ctx context.Context, reviewerID string, limit, offset int) ([]*entity.OutstandingReview, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockReviewRepository) GetWorkload(ctx context.Context, reviewerID string, since time.Time) (*entity.ReviewerWorkload, error) {
+	return &entity.ReviewerWorkload{ReviewerID: reviewerID}, nil
+}
+
+func (m *mockReviewRepository) CreateComment(ctx context.Context, comment *entity.QuestionReviewComment) error {
+	if comment.ID == uuid.Nil {
+		comment.ID = uuid.New()
+	}
+	copied := *comment
+	m.comments[comment.ID] = &copied
+	return nil
+}
+
+func (m *mockReviewRepository) GetComment(ctx context.Context, id uuid.UUID) (*entity.QuestionReviewComment, error) {
+	if c, ok := m.comments[id]; ok {
+		copied := *c
+		return &copied, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *mockReviewRepository) ListComments(ctx context.Context, reviewID uuid.UUID) ([]*entity.QuestionReviewComment, error) {
+	return nil, nil
+}
+
+func (m *mockReviewRepository) SetCommentResolved(ctx context.Context, id uuid.UUID, resolved bool) error {
+	c, ok := m.comments[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	c.IsResolved = resolved
+	return nil
+}
+
+// mockReviewQuestionStore records question status changes.
+type mockReviewQuestionStore struct {
+	questions map[string]*entity.Question
+}
+
+func (m *mockReviewQuestionStore) GetByID(ctx context.Context, id string) (*entity.Question, error) {
+	if q, ok := m.questions[id]; ok {
+		return q, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockReviewQuestionStore) UpdateStatus(ctx context.Context, id string, status string) error {
+	q, ok := m.questions[id]
+	if !ok {
+		return errors.New("not found")
+	}
+	return q.Status.Set(status)
+}
+
+type mockReviewCodeLookup struct{}
+
+func (mockReviewCodeLookup) GetByCode(ctx context.Context, code string) (*entity.QuestionCode, error) {
+	qc := &entity.QuestionCode{}
+	_ = qc.Code.Set(code)
+	_ = qc.Grade.Set(code[0:1])
+	_ = qc.Subject.Set(code[1:2])
+	return qc, nil
+}
+
+type mockReviewVersionSource struct {
+	version int32
+}
+
+func (m *mockReviewVersionSource) GetLatestVersionNumber(ctx context.Context, questionID uuid.UUID) (int32, error) {
+	return m.version, nil
+}
+
+type sentNotification struct {
+	userID string
+	title  string
+}
+
+type mockReviewNotifier struct {
+	sent []sentNotification
+}
+
+func (m *mockReviewNotifier) CreateNotification(
+	ctx context.Context,
+	userID string,
+	notifType notification.NotificationType,
+	title string,
+	message string,
+	data *notification.NotificationData,
+	expiresIn *time.Duration,
+) error {
+	if notifType != notification.TypeQuestionReview {
+		return errors.New("unexpected notification type")
+	}
+	m.sent = append(m.sent, sentNotification{userID: userID, title: title})
+	return nil
+}
+
+type reviewFixture struct {
+	svc       *ReviewService
+	repo      *mockReviewRepository
+	questions *mockReviewQuestionStore
+	versions  *mockReviewVersionSource
+	notifier  *mockReviewNotifier
+}
+
+func newReviewFixture(questionID string) *reviewFixture {
+	q := &entity.Question{}
+	_ = q.ID.Set(questionID)
+	_ = q.Status.Set(string(entity.QuestionStatusActive))
+	_ = q.QuestionCodeID.Set("0P1V1-1")
+	_ = q.Creator.Set("author-1")
+
+	f := &reviewFixture{
+		repo:      newMockReviewRepository(),
+		questions: &mockReviewQuestionStore{questions: map[string]*entity.Question{questionID: q}},
+		versions:  &mockReviewVersionSource{version: 3},
+		notifier:  &mockReviewNotifier{},
+	}
+	f.svc = NewReviewService(f.repo, f.questions, mockReviewCodeLookup{}, f.versions, f.notifier)
+	return f
+}
+
+func TestReviewService_SubmitForReview(t *testing.T) {
+	questionID := uuid.New().String()
+	f := newReviewFixture(questionID)
+	f.repo.candidates = []repository.ReviewerCandidate{
+		{ReviewerID: "author-1", OpenReviews: 0},
+		{ReviewerID: "reviewer-1", OpenReviews: 2},
+	}
+
+	// Another teacher cannot take the question out of circulation
+	if _, err := f.svc.SubmitForReview(context.Background(), ReviewActor{UserID: "teacher-2"}, questionID); !errors.Is(err, ErrReviewPermissionDenied) {
+		t.Fatalf("expected ErrReviewPermissionDenied for a non-author, got %v", err)
+	}
+	if got := f.questions.questions[questionID].Status.String; got != string(entity.QuestionStatusActive) {
+		t.Fatalf("expected question to stay ACTIVE after a rejected submit, got %s", got)
+	}
+
+	review, err := f.svc.SubmitForReview(context.Background(), ReviewActor{UserID: "author-1"}, questionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if review.ReviewerID == nil || *review.ReviewerID != "reviewer-1" {
+		t.Fatalf("expected reviewer-1 to be assigned (author skipped), got %v", review.ReviewerID)
+	}
+	if review.Subject == nil || *review.Subject != "P" || review.Grade == nil || *review.Grade != "0" {
+		t.Errorf("expected subject/grade from question code, got %v/%v", review.Subject, review.Grade)
+	}
+	if review.VersionNumber != 3 {
+		t.Errorf("expected version 3, got %d", review.VersionNumber)
+	}
+	if got := f.questions.questions[questionID].Status.String; got != string(entity.QuestionStatusPending) {
+		t.Errorf("expected question to be PENDING, got %s", got)
+	}
+	if len(f.notifier.sent) != 1 || f.notifier.sent[0].userID != "reviewer-1" {
+		t.Errorf("expected reviewer to be notified, got %+v", f.notifier.sent)
+	}
+
+	if _, err := f.svc.SubmitForReview(context.Background(), ReviewActor{UserID: "author-1"}, questionID); !errors.Is(err, ErrReviewAlreadyOpen) {
+		t.Errorf("expected ErrReviewAlreadyOpen, got %v", err)
+	}
+}
+
+func TestReviewService_SubmitDecision(t *testing.T) {
+	tests := []struct {
+		name           string
+		decision       entity.QuestionReviewStatus
+		note           string
+		wantQuestion   entity.QuestionStatus
+		wantNotifTitle string
+	}{
+		{"approve", entity.ReviewStatusApproved, "", entity.QuestionStatusActive, "Question approved"},
+		{"reject", entity.ReviewStatusRejected, "duplicate", entity.QuestionStatusInactive, "Question rejected"},
+		{"request changes", entity.ReviewStatusChangesRequested, "fix the answer key", entity.QuestionStatusPending, "Changes requested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			questionID := uuid.New().String()
+			f := newReviewFixture(questionID)
+			f.repo.candidates = []repository.ReviewerCandidate{{ReviewerID: "reviewer-1"}}
+
+			review, err := f.svc.SubmitForReview(context.Background(), ReviewActor{UserID: "author-1"}, questionID)
+			if err != nil {
+				t.Fatalf("submit: %v", err)
+			}
+
+			if _, err := f.svc.SubmitDecision(context.Background(), ReviewActor{UserID: "someone-else"}, review.ID, tt.decision, tt.note); !errors.Is(err, ErrReviewPermissionDenied) {
+				t.Fatalf("expected permission denied for non-reviewer, got %v", err)
+			}
+
+			decided, err := f.svc.SubmitDecision(context.Background(), ReviewActor{UserID: "reviewer-1"}, review.ID, tt.decision, tt.note)
+			if err != nil {
+				t.Fatalf("decision: %v", err)
+			}
+			if decided.Status != tt.decision {
+				t.Errorf("expected status %s, got %s", tt.decision, decided.Status)
+			}
+			if got := f.questions.questions[questionID].Status.String; got != string(tt.wantQuestion) {
+				t.Errorf("expected question status %s, got %s", tt.wantQuestion, got)
+			}
+			last := f.notifier.sent[len(f.notifier.sent)-1]
+			if last.userID != "author-1" || last.title != tt.wantNotifTitle {
+				t.Errorf("expected author notification %q, got %+v", tt.wantNotifTitle, last)
+			}
+		})
+	}
+}
+
+func TestReviewService_SubmitDecisionRequiresNote(t *testing.T) {
+	questionID := uuid.New().String()
+	f := newReviewFixture(questionID)
+	f.repo.candidates = []repository.ReviewerCandidate{{ReviewerID: "reviewer-1"}}
+
+	review, err := f.svc.SubmitForReview(context.Background(), ReviewActor{UserID: "author-1"}, questionID)
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+
+	_, err = f.svc.SubmitDecision(context.Background(), ReviewActor{UserID: "reviewer-1"}, review.ID, entity.ReviewStatusRejected, "  ")
+	if !errors.Is(err, ErrReviewInvalidInput) {
+		t.Errorf("expected ErrReviewInvalidInput, got %v", err)
+	}
+}
+
+func TestReviewService_ResubmitAfterChangesRequested(t *testing.T) {
+	questionID := uuid.New().String()
+	f := newReviewFixture(questionID)
+	f.repo.candidates = []repository.ReviewerCandidate{{ReviewerID: "reviewer-1"}}
+	ctx := context.Background()
+
+	review, err := f.svc.SubmitForReview(ctx, ReviewActor{UserID: "author-1"}, questionID)
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	if _, err := f.svc.SubmitDecision(ctx, ReviewActor{UserID: "reviewer-1"}, review.ID, entity.ReviewStatusChangesRequested, "typo"); err != nil {
+		t.Fatalf("decision: %v", err)
+	}
+
+	f.versions.version = 4
+	resubmitted, err := f.svc.SubmitForReview(ctx, ReviewActor{UserID: "author-1"}, questionID)
+	if err != nil {
+		t.Fatalf("resubmit: %v", err)
+	}
+	if resubmitted.ID != review.ID {
+		t.Errorf("expected the same review round to be reused")
+	}
+	if resubmitted.Status != entity.ReviewStatusInReview || resubmitted.VersionNumber != 4 {
+		t.Errorf("expected IN_REVIEW on version 4, got %s on %d", resubmitted.Status, resubmitted.VersionNumber)
+	}
+}
+
+func TestReviewService_AddCommentThreads(t *testing.T) {
+	questionID := uuid.New().String()
+	f := newReviewFixture(questionID)
+	f.repo.candidates = []repository.ReviewerCandidate{{ReviewerID: "reviewer-1"}}
+	ctx := context.Background()
+
+	review, err := f.svc.SubmitForReview(ctx, ReviewActor{UserID: "author-1"}, questionID)
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+
+	field := "content"
+	line := int32(2)
+	root, err := f.svc.AddComment(ctx, ReviewActor{UserID: "reviewer-1"}, review.ID, CommentInput{
+		Field: &field, LineStart: &line, LineEnd: &line, Body: "Sign error here",
+	})
+	if err != nil {
+		t.Fatalf("root comment: %v", err)
+	}
+	if root.VersionNumber != 3 {
+		t.Errorf("expected comment pinned to version 3, got %d", root.VersionNumber)
+	}
+
+	reply, err := f.svc.AddComment(ctx, ReviewActor{UserID: "author-1"}, review.ID, CommentInput{
+		ParentID: &root.ID, Body: "Fixed",
+	})
+	if err != nil {
+		t.Fatalf("reply: %v", err)
+	}
+	if reply.ParentID == nil || *reply.ParentID != root.ID {
+		t.Errorf("expected reply to reference root comment")
+	}
+	if reply.Field == nil || *reply.Field != field || reply.LineStart == nil || *reply.LineStart != line {
+		t.Errorf("expected reply to inherit the thread anchor")
+	}
+
+	last := f.notifier.sent[len(f.notifier.sent)-1]
+	if last.userID != "reviewer-1" {
+		t.Errorf("expected reviewer to be notified of author reply, got %s", last.userID)
+	}
+
+	if _, err := f.svc.AddComment(ctx, ReviewActor{UserID: "outsider"}, review.ID, CommentInput{Body: "hi"}); !errors.Is(err, ErrReviewPermissionDenied) {
+		t.Errorf("expected ErrReviewPermissionDenied for outsider, got %v", err)
+	}
+
+	if err := f.svc.ResolveComment(ctx, ReviewActor{UserID: "reviewer-1"}, root.ID, true); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if !f.repo.comments[root.ID].IsResolved {
+		t.Errorf("expected comment to be resolved")
+	}
+}
+
+func TestReviewService_SetReviewerSubjectsValidates(t *testing.T) {
+	f := newReviewFixture(uuid.New().String())
+	grade := " 1 "
+
+	subjects := []*entity.ReviewerSubject{{Subject: "p", Grade: &grade, IsActive: true}}
+	if err := f.svc.SetReviewerSubjects(context.Background(), "reviewer-1", subjects); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if subjects[0].Subject != "P" || *subjects[0].Grade != "1" {
+		t.Errorf("expected normalised subject/grade, got %s/%s", subjects[0].Subject, *subjects[0].Grade)
+	}
+
+	bad := []*entity.ReviewerSubject{{Subject: "PHY"}}
+	if err := f.svc.SetReviewerSubjects(context.Background(), "reviewer-1", bad); !errors.Is(err, ErrReviewInvalidInput) {
+		t.Errorf("expected ErrReviewInvalidInput, got %v", err)
+	}
+}
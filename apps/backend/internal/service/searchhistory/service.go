@@ -0,0 +1,236 @@
+package searchhistory
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Search scopes
+const (
+	ScopeLibrary  = "library"
+	ScopeQuestion = "question"
+)
+
+// Suggestion types added on top of the catalog suggestions
+const (
+	SuggestionRecent   = "recent"
+	SuggestionTrending = "trending"
+)
+
+// Defaults applied by NewService
+const (
+	DefaultRetention        = 180 * 24 * time.Hour
+	DefaultTrendingWindow   = 7 * 24 * time.Hour
+	DefaultMinTrendingUsers = 3
+	DefaultCleanupInterval  = 6 * time.Hour
+	DefaultDeleteBatch      = 5000
+)
+
+// MaxQueryLength bounds the stored query, in characters
+const MaxQueryLength = 200
+
+// Store keeps searches, implemented by repository.SearchHistoryRepository
+type Store interface {
+	Insert(ctx context.Context, entry repository.SearchHistoryEntry) (string, error)
+	RecordClick(ctx context.Context, searchID, userID, itemID string, at time.Time) error
+	ListRecent(ctx context.Context, userID, scope, prefix string, limit int) ([]repository.RecentSearch, error)
+	ListTrending(ctx context.Context, filter repository.SearchTrendFilter) ([]repository.SearchQueryStat, error)
+	ListZeroResults(ctx context.Context, scope string, since time.Time, limit int) ([]repository.SearchQueryStat, error)
+	DeleteUserHistory(ctx context.Context, userID string) (int64, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time, limit int) (int64, error)
+}
+
+// Config controls trending lists and retention
+type Config struct {
+	Retention        time.Duration // Searches older than this are deleted
+	TrendingWindow   time.Duration // Window of trending suggestions
+	MinTrendingUsers int           // Distinct users a query needs to be suggested as trending
+	CleanupInterval  time.Duration // How often old searches are deleted
+	DeleteBatch      int           // Rows deleted per statement
+}
+
+// Entry is a search to log
+type Entry struct {
+	Scope       string
+	UserID      string      // Empty for anonymous searches
+	Query       string      // As typed
+	Filters     interface{} // Marshalled to JSON; nil for none
+	ResultCount int
+}
+
+// Suggestion is a past query offered while typing
+type Suggestion struct {
+	Text  string
+	Type  string // recent or trending
+	Count int64  // Users who searched a trending query; 0 for recent ones
+}
+
+// Service logs library and question searches and turns them into suggestions, trending
+// and zero-result reports. Old searches are deleted by the Start/Stop worker.
+type Service struct {
+	cfg   Config
+	store Store
+	now   func() time.Time
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewService applies defaults
+func NewService(cfg Config, store Store) *Service {
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultRetention
+	}
+	if cfg.TrendingWindow <= 0 {
+		cfg.TrendingWindow = DefaultTrendingWindow
+	}
+	if cfg.MinTrendingUsers <= 0 {
+		cfg.MinTrendingUsers = DefaultMinTrendingUsers
+	}
+	if cfg.CleanupInterval <= 0 {
+		cfg.CleanupInterval = DefaultCleanupInterval
+	}
+	if cfg.DeleteBatch <= 0 {
+		cfg.DeleteBatch = DefaultDeleteBatch
+	}
+	return &Service{cfg: cfg, store: store, now: time.Now}
+}
+
+// Normalize lower-cases a query, composes Vietnamese diacritics and collapses whitespace
+// so different spellings of a query are grouped together
+func Normalize(query string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(norm.NFC.String(query)), " "))
+	return truncate(normalized)
+}
+
+// Log stores a search and returns its ID for RecordClick. Blank queries are not logged
+// and return an empty ID.
+func (s *Service) Log(ctx context.Context, entry Entry) (string, error) {
+	normalized := Normalize(entry.Query)
+	if normalized == "" || !ValidScope(entry.Scope) {
+		return "", nil
+	}
+	var filters []byte
+	if entry.Filters != nil {
+		var err error
+		if filters, err = json.Marshal(entry.Filters); err != nil {
+			return "", err
+		}
+	}
+	return s.store.Insert(ctx, repository.SearchHistoryEntry{
+		Scope:           entry.Scope,
+		UserID:          strings.TrimSpace(entry.UserID),
+		Query:           truncate(strings.TrimSpace(entry.Query)),
+		NormalizedQuery: normalized,
+		Filters:         filters,
+		ResultCount:     entry.ResultCount,
+	})
+}
+
+// RecordClick stores the result the searcher opened. Returns repository.ErrNotFound when
+// the search does not exist or belongs to another user.
+func (s *Service) RecordClick(ctx context.Context, searchID, userID, itemID string) error {
+	return s.store.RecordClick(ctx, searchID, strings.TrimSpace(userID), itemID, s.now())
+}
+
+// Suggestions returns the user's recent queries starting with prefix, followed by
+// trending queries that returned results. Anonymous users only get trending queries.
+func (s *Service) Suggestions(ctx context.Context, scope, userID, prefix string, limit int) ([]Suggestion, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	prefix = Normalize(prefix)
+	seen := make(map[string]bool)
+	var suggestions []Suggestion
+
+	if userID = strings.TrimSpace(userID); userID != "" {
+		recent, err := s.store.ListRecent(ctx, userID, scope, prefix, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range recent {
+			// A query equal to what was typed is not worth suggesting
+			if r.NormalizedQuery == prefix {
+				continue
+			}
+			seen[r.NormalizedQuery] = true
+			suggestions = append(suggestions, Suggestion{Text: r.Query, Type: SuggestionRecent})
+		}
+		// Leave room for trending queries
+		if len(suggestions) > limit/2 && prefix == "" {
+			suggestions = suggestions[:limit/2]
+		}
+	}
+
+	trending, err := s.store.ListTrending(ctx, repository.SearchTrendFilter{
+		Scope:       scope,
+		Since:       s.now().Add(-s.cfg.TrendingWindow),
+		Prefix:      prefix,
+		MinUsers:    s.cfg.MinTrendingUsers,
+		WithResults: true,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range trending {
+		if len(suggestions) >= limit {
+			break
+		}
+		if seen[t.Query] || t.Query == prefix {
+			continue
+		}
+		suggestions = append(suggestions, Suggestion{Text: t.Query, Type: SuggestionTrending, Count: t.Users})
+	}
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// Recent returns a user's distinct recent queries, newest first
+func (s *Service) Recent(ctx context.Context, userID, scope string, limit int) ([]repository.RecentSearch, error) {
+	return s.store.ListRecent(ctx, userID, scope, "", limit)
+}
+
+// Trending returns the queries searched by the most users within window, with how often
+// their results were opened
+func (s *Service) Trending(ctx context.Context, scope string, window time.Duration, limit int) ([]repository.SearchQueryStat, error) {
+	return s.store.ListTrending(ctx, repository.SearchTrendFilter{
+		Scope:    scope,
+		Since:    s.now().Add(-window),
+		MinUsers: 1,
+		Limit:    limit,
+	})
+}
+
+// ZeroResults returns the queries that found nothing within window, for content planning
+func (s *Service) ZeroResults(ctx context.Context, scope string, window time.Duration, limit int) ([]repository.SearchQueryStat, error) {
+	return s.store.ListZeroResults(ctx, scope, s.now().Add(-window), limit)
+}
+
+// ClearHistory deletes every search of a user, e.g. from the recent searches menu
+func (s *Service) ClearHistory(ctx context.Context, userID string) (int64, error) {
+	return s.store.DeleteUserHistory(ctx, userID)
+}
+
+// ValidScope reports whether scope is a known search scope
+func ValidScope(scope string) bool {
+	return scope == ScopeLibrary || scope == ScopeQuestion
+}
+
+func truncate(query string) string {
+	if runes := []rune(query); len(runes) > MaxQueryLength {
+		return strings.TrimSpace(string(runes[:MaxQueryLength]))
+	}
+	return query
+}
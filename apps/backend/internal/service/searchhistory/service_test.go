@@ -0,0 +1,176 @@
+package searchhistory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	inserted []repository.SearchHistoryEntry
+	recent   []repository.RecentSearch
+	trending []repository.SearchQueryStat
+	filters  []repository.SearchTrendFilter
+
+	deleteCalls int
+	remaining   int64
+	cutoff      time.Time
+}
+
+func (f *fakeStore) Insert(ctx context.Context, entry repository.SearchHistoryEntry) (string, error) {
+	f.inserted = append(f.inserted, entry)
+	return "search-1", nil
+}
+
+func (f *fakeStore) RecordClick(ctx context.Context, searchID, userID, itemID string, at time.Time) error {
+	return nil
+}
+
+func (f *fakeStore) ListRecent(ctx context.Context, userID, scope, prefix string, limit int) ([]repository.RecentSearch, error) {
+	if len(f.recent) > limit {
+		return f.recent[:limit], nil
+	}
+	return f.recent, nil
+}
+
+func (f *fakeStore) ListTrending(ctx context.Context, filter repository.SearchTrendFilter) ([]repository.SearchQueryStat, error) {
+	f.filters = append(f.filters, filter)
+	return f.trending, nil
+}
+
+func (f *fakeStore) ListZeroResults(ctx context.Context, scope string, since time.Time, limit int) ([]repository.SearchQueryStat, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) DeleteUserHistory(ctx context.Context, userID string) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeStore) DeleteBefore(ctx context.Context, cutoff time.Time, limit int) (int64, error) {
+	f.deleteCalls++
+	f.cutoff = cutoff
+	n := f.remaining
+	if n > int64(limit) {
+		n = int64(limit)
+	}
+	f.remaining -= n
+	return n, nil
+}
+
+var now = time.Date(2026, 5, 4, 10, 0, 0, 0, time.UTC)
+
+func newTestService(store *fakeStore, cfg Config) *Service {
+	svc := NewService(cfg, store)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestNormalize(t *testing.T) {
+	// "Hàm số" typed with combining marks
+	decomposed := "  Ha\u0300m   so\u0302\u0301 \tBẬC hai "
+	assert.Equal(t, "hàm số bậc hai", Normalize(decomposed))
+	assert.Equal(t, "", Normalize(" \n "))
+
+	long := Normalize(string(make([]rune, MaxQueryLength+50)))
+	assert.LessOrEqual(t, len([]rune(long)), MaxQueryLength)
+}
+
+func TestLog(t *testing.T) {
+	store := &fakeStore{}
+	svc := newTestService(store, Config{})
+
+	id, err := svc.Log(context.Background(), Entry{
+		Scope:       ScopeLibrary,
+		UserID:      "user-1",
+		Query:       "  Đề thi THPT ",
+		Filters:     map[string][]string{"types": {"exam"}},
+		ResultCount: 0,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "search-1", id)
+	require.Len(t, store.inserted, 1)
+	assert.Equal(t, "Đề thi THPT", store.inserted[0].Query)
+	assert.Equal(t, "đề thi thpt", store.inserted[0].NormalizedQuery)
+	assert.JSONEq(t, `{"types":["exam"]}`, string(store.inserted[0].Filters))
+
+	// Blank queries and unknown scopes are not logged
+	id, err = svc.Log(context.Background(), Entry{Scope: ScopeLibrary, Query: "   "})
+	require.NoError(t, err)
+	assert.Empty(t, id)
+	_, err = svc.Log(context.Background(), Entry{Scope: "forum", Query: "toán"})
+	require.NoError(t, err)
+	assert.Len(t, store.inserted, 1)
+}
+
+func TestSuggestions_RecentThenTrending(t *testing.T) {
+	store := &fakeStore{
+		recent: []repository.RecentSearch{
+			{Query: "Hàm số mũ", NormalizedQuery: "hàm số mũ"},
+			{Query: "hàm số", NormalizedQuery: "hàm số"},
+		},
+		trending: []repository.SearchQueryStat{
+			{Query: "hàm số mũ", Users: 9}, // Already a recent search
+			{Query: "hàm số bậc hai", Users: 5},
+			{Query: "hàm số lượng giác", Users: 4},
+		},
+	}
+	svc := newTestService(store, Config{MinTrendingUsers: 2})
+
+	suggestions, err := svc.Suggestions(context.Background(), ScopeLibrary, "user-1", "Hàm  SỐ", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []Suggestion{
+		{Text: "Hàm số mũ", Type: SuggestionRecent},
+		{Text: "hàm số bậc hai", Type: SuggestionTrending, Count: 5},
+		{Text: "hàm số lượng giác", Type: SuggestionTrending, Count: 4},
+	}, suggestions, "the query equal to the prefix is skipped")
+
+	require.Len(t, store.filters, 1)
+	filter := store.filters[0]
+	assert.Equal(t, "hàm số", filter.Prefix)
+	assert.Equal(t, 2, filter.MinUsers)
+	assert.True(t, filter.WithResults)
+	assert.Equal(t, now.Add(-DefaultTrendingWindow), filter.Since)
+}
+
+func TestSuggestions_EmptyPrefixKeepsRoomForTrending(t *testing.T) {
+	store := &fakeStore{
+		recent: []repository.RecentSearch{
+			{Query: "a", NormalizedQuery: "a"},
+			{Query: "b", NormalizedQuery: "b"},
+			{Query: "c", NormalizedQuery: "c"},
+			{Query: "d", NormalizedQuery: "d"},
+		},
+		trending: []repository.SearchQueryStat{{Query: "x", Users: 3}, {Query: "y", Users: 3}},
+	}
+	svc := newTestService(store, Config{})
+
+	suggestions, err := svc.Suggestions(context.Background(), ScopeLibrary, "user-1", "", 4)
+	require.NoError(t, err)
+	var texts []string
+	for _, s := range suggestions {
+		texts = append(texts, s.Text)
+	}
+	assert.Equal(t, []string{"a", "b", "x", "y"}, texts)
+
+	// Anonymous users only get trending queries
+	suggestions, err = svc.Suggestions(context.Background(), ScopeLibrary, "", "", 4)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, SuggestionTrending, suggestions[0].Type)
+}
+
+func TestCleanup_DeletesInBatches(t *testing.T) {
+	store := &fakeStore{remaining: 25}
+	svc := newTestService(store, Config{Retention: 30 * 24 * time.Hour, DeleteBatch: 10})
+
+	removed, err := svc.Cleanup(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), removed)
+	assert.Equal(t, 3, store.deleteCalls)
+	assert.Equal(t, now.Add(-30*24*time.Hour), store.cutoff)
+}
@@ -0,0 +1,80 @@
+package searchhistory
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Cleanup deletes searches older than the retention period in batches and returns how
+// many were removed
+func (s *Service) Cleanup(ctx context.Context) (int64, error) {
+	cutoff := s.now().Add(-s.cfg.Retention)
+	var total int64
+	for {
+		n, err := s.store.DeleteBefore(ctx, cutoff, s.cfg.DeleteBatch)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < int64(s.cfg.DeleteBatch) {
+			return total, nil
+		}
+	}
+}
+
+// runOnce performs one pass of the background worker
+func (s *Service) runOnce(ctx context.Context) {
+	removed, err := s.Cleanup(ctx)
+	if err != nil {
+		log.Printf("[ERROR] [SearchHistory] Failed to delete old searches: %v", err)
+		return
+	}
+	if removed > 0 {
+		log.Printf("[INFO] [SearchHistory] Deleted %d searches past retention", removed)
+	}
+}
+
+// Start deletes old searches now and then every CleanupInterval until Stop is called
+func (s *Service) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.cfg.CleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+			s.runOnce(ctx)
+			cancel()
+
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop ends the worker loop started by Start
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		s.wg.Wait()
+	}
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// PublicPrefix marks keys that may be served without a signed link, e.g. question
+// images embedded in rendered content
+const PublicPrefix = "public/"
+
+// LibraryPrefix is the key prefix of uploaded library files. They are private and only
+// reachable through presigned or signed download links.
+const LibraryPrefix = "library/"
+
+// MaxPresignTTL is the longest lifetime of a presigned link; S3 refuses longer ones
+const MaxPresignTTL = 7 * 24 * time.Hour
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrInvalidKey   = errors.New("invalid blob key")
+	ErrInvalidTTL   = errors.New("presign ttl must be between one second and seven days")
+)
+
+// BlobInfo describes a stored object
+type BlobInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+// BlobStore stores opaque objects under slash-separated keys such as
+// "library/book/2026/10/<uuid>-algebra.pdf"
+type BlobStore interface {
+	// Put stores the content under key, replacing any existing object; size may be -1 when unknown
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) (*BlobInfo, error)
+	// Get opens the object; the caller closes the reader
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	// Delete removes the object; deleting a missing key is not an error
+	Delete(ctx context.Context, key string) error
+	// Stat returns the object metadata or ErrBlobNotFound
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	// PresignGet returns a link that downloads the object without credentials until ttl elapses
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error)
+	// PublicURL returns a stable link for keys under PublicPrefix, or false when the
+	// store has no public endpoint
+	PublicURL(key string) (string, bool)
+}
+
+// BlobStoreConfig selects and configures the driver
+type BlobStoreConfig struct {
+	Driver string // "local" (default) or "s3"
+
+	// Local driver
+	LocalRoot    string // Directory holding the objects
+	LocalBaseURL string // Absolute URL LocalBlobStore is mounted at, e.g. http://localhost:8080/blobs
+	SigningKey   string // HMAC key of local presigned links
+
+	// S3-compatible driver (AWS S3, MinIO, ...)
+	S3 S3Config
+
+	// Optional base URL that serves PublicPrefix keys, e.g. a CDN or a public-read bucket policy
+	PublicBaseURL string
+}
+
+// NewBlobStore creates the configured driver
+func NewBlobStore(config BlobStoreConfig) (BlobStore, error) {
+	switch strings.ToLower(strings.TrimSpace(config.Driver)) {
+	case "", "local":
+		store, err := NewLocalBlobStore(config.LocalRoot, config.LocalBaseURL, config.SigningKey)
+		if err != nil {
+			return nil, err
+		}
+		if config.PublicBaseURL != "" {
+			store.publicBaseURL = config.PublicBaseURL
+		}
+		return store, nil
+	case "s3":
+		s3 := config.S3
+		if s3.PublicBaseURL == "" {
+			s3.PublicBaseURL = config.PublicBaseURL
+		}
+		store, err := NewS3BlobStore(s3)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", config.Driver)
+	}
+}
+
+// ValidateKey rejects keys that are empty, absolute, contain empty, "." or ".."
+// segments or backslashes, so a key can never escape the store's root
+func ValidateKey(key string) error {
+	if key == "" || len(key) > 1024 || strings.ContainsAny(key, "\\\x00") {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+func validatePresignTTL(ttl time.Duration) error {
+	if ttl < time.Second || ttl > MaxPresignTTL {
+		return ErrInvalidTTL
+	}
+	return nil
+}
+
+func publicURL(baseURL, key string) (string, bool) {
+	if baseURL == "" || !strings.HasPrefix(key, PublicPrefix) || ValidateKey(key) != nil {
+		return "", false
+	}
+	return strings.TrimRight(baseURL, "/") + "/" + escapeKeyPath(key), true
+}
+
+// escapeKeyPath percent-encodes every byte except unreserved characters and '/',
+// which is the encoding S3 signs and a safe path for the local handler
+func escapeKeyPath(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c == '/' || isUnreserved(c) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func isUnreserved(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalBlobStore keeps objects as files below a root directory. It is meant for
+// development and tests; presigned links point at its own HTTP handler, which must be
+// mounted at the path of the base URL. Content types are derived from the key extension.
+type LocalBlobStore struct {
+	root          string
+	baseURL       string
+	mountPath     string
+	publicBaseURL string
+	signingKey    []byte
+	now           func() time.Time
+}
+
+// NewLocalBlobStore creates the root directory if needed. Without a signing key a random
+// one is used, so links stop working when the process restarts.
+func NewLocalBlobStore(root, baseURL, signingKey string) (*LocalBlobStore, error) {
+	if root == "" {
+		return nil, fmt.Errorf("local storage root is required")
+	}
+	base, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil || base.Scheme == "" || base.Host == "" || base.Path == "" {
+		return nil, fmt.Errorf("local storage base URL must be absolute and include a path, got %q", baseURL)
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage root: %w", err)
+	}
+
+	key := []byte(signingKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		log.Printf("[WARN] [BlobStore] No storage signing key configured; presigned links will not survive a restart")
+	}
+
+	return &LocalBlobStore{
+		root:          root,
+		baseURL:       base.String(),
+		mountPath:     base.Path,
+		publicBaseURL: base.String(),
+		signingKey:    key,
+		now:           time.Now,
+	}, nil
+}
+
+// MountPath is the URL path the HTTP handler must be mounted at
+func (s *LocalBlobStore) MountPath() string {
+	return s.mountPath
+}
+
+// Put writes the object to a temporary file and renames it into place, so readers
+// never observe a partial object
+func (s *LocalBlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) (*BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	target := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, contextReader{ctx: ctx, r: content})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write blob: %w", err)
+	}
+	if size >= 0 && written != size {
+		return nil, fmt.Errorf("failed to write blob: expected %d bytes, got %d", size, written)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return nil, fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		info.ContentType = contentType
+	}
+	return info, nil
+}
+
+// Get opens the object file
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := os.Open(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return file, info, nil
+}
+
+// Delete removes the object file
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+// Stat reads the file metadata
+func (s *LocalBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) || (err == nil && fi.IsDir()) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat blob: %w", err)
+	}
+	return &BlobInfo{
+		Key:          key,
+		Size:         fi.Size(),
+		ContentType:  contentTypeForKey(key),
+		ETag:         fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size()),
+		LastModified: fi.ModTime(),
+	}, nil
+}
+
+// PresignGet returns a link to the store's HTTP handler signed with the signing key
+func (s *LocalBlobStore) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	if err := validatePresignTTL(ttl); err != nil {
+		return "", err
+	}
+	expires := strconv.FormatInt(s.now().Add(ttl).Unix(), 10)
+	query := url.Values{"expires": {expires}, "signature": {s.sign(key, expires)}}
+	return s.baseURL + "/" + escapeKeyPath(key) + "?" + query.Encode(), nil
+}
+
+// PublicURL links PublicPrefix keys through the store's HTTP handler
+func (s *LocalBlobStore) PublicURL(key string) (string, bool) {
+	return publicURL(s.publicBaseURL, key)
+}
+
+// ServeHTTP serves GET and HEAD for public keys and valid presigned links
+func (s *LocalBlobStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, s.mountPath+"/")
+	if !ok || ValidateKey(key) != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if !strings.HasPrefix(key, PublicPrefix) && !s.validSignature(key, r.URL.Query()) {
+		http.Error(w, "invalid or expired link", http.StatusForbidden)
+		return
+	}
+
+	content, info, err := s.Get(r.Context(), key)
+	if errors.Is(err, ErrBlobNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "failed to read blob", http.StatusInternalServerError)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", info.ContentType)
+	w.Header().Set("ETag", info.ETag)
+	http.ServeContent(w, r, path.Base(key), info.LastModified, content.(io.ReadSeeker))
+}
+
+func (s *LocalBlobStore) validSignature(key string, query url.Values) bool {
+	expires := query.Get("expires")
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > unix {
+		return false
+	}
+	return hmac.Equal([]byte(query.Get("signature")), []byte(s.sign(key, expires)))
+}
+
+func (s *LocalBlobStore) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalBlobStore) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+func contentTypeForKey(key string) string {
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// contextReader stops a copy once the context is cancelled
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
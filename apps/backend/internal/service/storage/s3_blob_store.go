@@ -0,0 +1,317 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3EmptyPayload    = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	s3TimeFormat      = "20060102T150405Z"
+)
+
+// S3Config configures an S3-compatible endpoint
+type S3Config struct {
+	Endpoint        string // e.g. https://s3.eu-west-1.amazonaws.com or http://localhost:9000
+	Region          string // Defaults to us-east-1, which MinIO accepts
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	UsePathStyle    bool   // Required by MinIO and most self-hosted servers
+	PublicBaseURL   string // Optional base URL serving PublicPrefix keys
+}
+
+// S3BlobStore talks to an S3-compatible API with Signature Version 4 over plain
+// net/http, so it works against AWS S3 and a local MinIO alike
+type S3BlobStore struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3BlobStore validates the configuration
+func NewS3BlobStore(config S3Config) (*S3BlobStore, error) {
+	if config.Bucket == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("S3 bucket and credentials are required")
+	}
+	endpoint, err := url.Parse(strings.TrimRight(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("S3 endpoint must be an absolute URL, got %q", config.Endpoint)
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	return &S3BlobStore{
+		config:   config,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+		now:      time.Now,
+	}, nil
+}
+
+// Put uploads the object with a single PUT; the payload is sent unsigned so it can be streamed
+func (s *S3BlobStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) (*BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		// S3 needs the length up front
+		buffered, err := io.ReadAll(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read blob: %w", err)
+		}
+		content, size = bytes.NewReader(buffered), int64(len(buffered))
+	}
+	if contentType == "" {
+		contentType = contentTypeForKey(key)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), content)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	resp, err := s.do(req, s3UnsignedPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return &BlobInfo{
+		Key:          key,
+		Size:         size,
+		ContentType:  contentType,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: s.now(),
+	}, nil
+}
+
+// Get downloads the object
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.do(req, s3EmptyPayload)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Body, blobInfoFromHeader(key, resp), nil
+}
+
+// Delete removes the object; S3 answers 204 for missing keys too
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, s3EmptyPayload)
+	if err == ErrBlobNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Stat issues a HEAD request
+func (s *S3BlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, s3EmptyPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return blobInfoFromHeader(key, resp), nil
+}
+
+// PresignGet builds a query-signed GET link
+func (s *S3BlobStore) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	if err := validatePresignTTL(ttl); err != nil {
+		return "", err
+	}
+
+	now := s.now().UTC()
+	u := s.objectURL(key)
+	query := url.Values{
+		"X-Amz-Algorithm":     {s3Algorithm},
+		"X-Amz-Credential":    {s.config.AccessKeyID + "/" + s.scope(now)},
+		"X-Amz-Date":          {now.Format(s3TimeFormat)},
+		"X-Amz-Expires":       {strconv.FormatInt(int64(ttl/time.Second), 10)},
+		"X-Amz-SignedHeaders": {"host"},
+	}
+	canonical := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedPayload,
+	}, "\n")
+	u.RawQuery = canonicalQuery(query) + "&X-Amz-Signature=" + s.signature(now, canonical)
+	return u.String(), nil
+}
+
+// PublicURL links PublicPrefix keys through the configured public base URL
+func (s *S3BlobStore) PublicURL(key string) (string, bool) {
+	return publicURL(s.config.PublicBaseURL, key)
+}
+
+func (s *S3BlobStore) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.config.UsePathStyle {
+		u.Path = u.Path + "/" + s.config.Bucket + "/" + key
+		u.RawPath = u.Path[:len(u.Path)-len(key)] + escapeKeyPath(key)
+	} else {
+		u.Host = s.config.Bucket + "." + u.Host
+		u.Path = u.Path + "/" + key
+		u.RawPath = u.Path[:len(u.Path)-len(key)] + escapeKeyPath(key)
+	}
+	return &u
+}
+
+// do signs the request with the Authorization header and maps error responses
+func (s *S3BlobStore) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	now := s.now().UTC()
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if req.Header.Get("Content-Type") != "" {
+		signedHeaders = []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	}
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		headers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.config.AccessKeyID, s.scope(now), strings.Join(signedHeaders, ";"), s.signature(now, canonical)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("S3 %s failed: %w", req.Method, err)
+	}
+	if resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrBlobNotFound
+	}
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if xml.Unmarshal(body, &s3Err) != nil || s3Err.Code == "" {
+		s3Err.Code = resp.Status
+	}
+	return nil, fmt.Errorf("S3 %s failed: %s %s", req.Method, s3Err.Code, s3Err.Message)
+}
+
+func (s *S3BlobStore) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.config.Region + "/s3/aws4_request"
+}
+
+// signature derives the SigV4 signing key and signs the canonical request
+func (s *S3BlobStore) signature(now time.Time, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		now.Format(s3TimeFormat),
+		s.scope(now),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery sorts and strictly percent-encodes query parameters
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, escapeQueryComponent(k)+"="+escapeQueryComponent(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func escapeQueryComponent(value string) string {
+	return strings.ReplaceAll(escapeKeyPath(value), "/", "%2F")
+}
+
+func blobInfoFromHeader(key string, resp *http.Response) *BlobInfo {
+	info := &BlobInfo{
+		Key:         key,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		ETag:        resp.Header.Get("ETag"),
+	}
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.LastModified = modified
+	}
+	return info
+}
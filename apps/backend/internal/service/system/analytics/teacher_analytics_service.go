@@ -149,57 +149,64 @@ func (s *TeacherAnalyticsService) GetTeacherDashboard(ctx context.Context, teach
 	return data, nil
 }
 
-// GetTeacherStudents returns list of students for a teacher
-func (s *TeacherAnalyticsService) GetTeacherStudents(ctx context.Context, teacherID string, page, pageSize int32) ([]*StudentData, int32, error) {
+// teacherRosterCTE selects the students of the non-archived classes a teacher
+// teaches, optionally narrowed to one class ($2) or one organisation ($3)
+const teacherRosterCTE = `
+	WITH roster AS (
+		SELECT DISTINCT cm.user_id
+		FROM class_members cm
+		JOIN classes c ON c.id = cm.class_id AND c.archived_at IS NULL
+		JOIN class_members t ON t.class_id = c.id AND t.user_id = $1 AND t.role = 'TEACHER'
+		WHERE cm.role = 'STUDENT'
+		  AND ($2::text = '' OR c.id = $2)
+		  AND ($3::text = '' OR c.organisation_id = $3)
+	)
+`
+
+// GetTeacherStudents returns the students on the rosters of the teacher's classes.
+// classID and organisationID are optional filters.
+func (s *TeacherAnalyticsService) GetTeacherStudents(ctx context.Context, teacherID, classID, organisationID string, page, pageSize int32) ([]*StudentData, int32, error) {
 	s.logger.WithFields(logrus.Fields{
-		"teacher_id": teacherID,
-		"page":       page,
-		"page_size":  pageSize,
+		"teacher_id":      teacherID,
+		"class_id":        classID,
+		"organisation_id": organisationID,
+		"page":            page,
+		"page_size":       pageSize,
 	}).Info("Getting teacher students")
 
 	offset := (page - 1) * pageSize
 
 	// Get total count
 	var total int32
-	countQuery := `
-		SELECT COUNT(DISTINCT u.id)
-		FROM users u
-		INNER JOIN course_enrollments ce ON u.id = ce.user_id
-		WHERE u.role = 'STUDENT'
-		  AND ce.course_id IN (
-			SELECT DISTINCT course_id 
-			FROM course_enrollments 
-			WHERE user_id = $1
-		  )
+	countQuery := teacherRosterCTE + `
+		SELECT COUNT(*)
+		FROM roster r
+		JOIN users u ON u.id = r.user_id
 	`
-	err := s.db.QueryRowContext(ctx, countQuery, teacherID).Scan(&total)
+	err := s.db.QueryRowContext(ctx, countQuery, teacherID, classID, organisationID).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count students: %w", err)
 	}
 
 	// Get students with details
-	query := `
+	query := teacherRosterCTE + `
 		SELECT 
 			u.id, u.email, u.first_name, u.last_name,
-			COUNT(DISTINCT ce.course_id) as total_courses,
-			COUNT(DISTINCT ea.id) as total_exam_results,
+			(SELECT COUNT(*) FROM course_enrollments ce WHERE ce.user_id = u.id) as total_courses,
+			COUNT(ea.id) as total_exam_results,
 			COALESCE(AVG(ea.percentage), 0) as average_score,
-			COALESCE(AVG(ce.progress), 0) as completion_rate,
+			COALESCE((SELECT AVG(ce.progress) FROM course_enrollments ce WHERE ce.user_id = u.id), 0) as completion_rate,
 			u.status,
 			MAX(ea.submitted_at) as last_activity
-		FROM users u
-		LEFT JOIN course_enrollments ce ON u.id = ce.user_id
+		FROM roster r
+		JOIN users u ON u.id = r.user_id
 		LEFT JOIN exam_attempts ea ON u.id = ea.user_id
-		WHERE u.role = 'STUDENT'
-		  AND ce.course_id IN (
-			SELECT DISTINCT course_id FROM course_enrollments WHERE user_id = $1
-		  )
 		GROUP BY u.id, u.email, u.first_name, u.last_name, u.status
 		ORDER BY last_activity DESC NULLS LAST
-		LIMIT $2 OFFSET $3
+		LIMIT $4 OFFSET $5
 	`
 
-	rows, err := s.db.QueryContext(ctx, query, teacherID, pageSize, offset)
+	rows, err := s.db.QueryContext(ctx, query, teacherID, classID, organisationID, pageSize, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query students: %w", err)
 	}
@@ -240,21 +247,24 @@ func (s *TeacherAnalyticsService) GetTeacherStudents(ctx context.Context, teache
 	return students, total, nil
 }
 
-// GetTeacherExams returns list of exams created by teacher
-func (s *TeacherAnalyticsService) GetTeacherExams(ctx context.Context, teacherID string, status string, page, pageSize int32) ([]*ExamData, int32, error) {
+// GetTeacherExams returns list of exams created by teacher, optionally limited to one organisation
+func (s *TeacherAnalyticsService) GetTeacherExams(ctx context.Context, teacherID, status, organisationID string, page, pageSize int32) ([]*ExamData, int32, error) {
 	s.logger.WithFields(logrus.Fields{
-		"teacher_id": teacherID,
-		"status":     status,
-		"page":       page,
-		"page_size":  pageSize,
+		"teacher_id":      teacherID,
+		"status":          status,
+		"organisation_id": organisationID,
+		"page":            page,
+		"page_size":       pageSize,
 	}).Info("Getting teacher exams")
 
 	offset := (page - 1) * pageSize
 
-	// Build status filter
+	// Build status filter; the status is bound as a parameter, never formatted into the SQL
+	args := []interface{}{teacherID, organisationID}
 	statusFilter := ""
 	if status != "" && status != "all" {
-		statusFilter = fmt.Sprintf("AND e.status = '%s'", status)
+		args = append(args, status)
+		statusFilter = fmt.Sprintf("AND e.status = $%d", len(args))
 	}
 
 	// Get total count
@@ -262,9 +272,9 @@ func (s *TeacherAnalyticsService) GetTeacherExams(ctx context.Context, teacherID
 	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM exams e
-		WHERE e.created_by = $1 %s
+		WHERE e.created_by = $1 AND ($2::text = '' OR e.organisation_id = $2) %s
 	`, statusFilter)
-	err := s.db.QueryRowContext(ctx, countQuery, teacherID).Scan(&total)
+	err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count exams: %w", err)
 	}
@@ -281,13 +291,13 @@ func (s *TeacherAnalyticsService) GetTeacherExams(ctx context.Context, teacherID
 			e.created_at, e.published_at
 		FROM exams e
 		LEFT JOIN exam_attempts ea ON e.id = ea.exam_id AND ea.status = 'submitted'
-		WHERE e.created_by = $1 %s
+		WHERE e.created_by = $1 AND ($2::text = '' OR e.organisation_id = $2) %s
 		GROUP BY e.id
 		ORDER BY e.created_at DESC
-		LIMIT $2 OFFSET $3
-	`, statusFilter)
+		LIMIT $%d OFFSET $%d
+	`, statusFilter, len(args)+1, len(args)+2)
 
-	rows, err := s.db.QueryContext(ctx, query, teacherID, pageSize, offset)
+	rows, err := s.db.QueryContext(ctx, query, append(args, pageSize, offset)...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query exams: %w", err)
 	}
@@ -350,14 +360,8 @@ func (s *TeacherAnalyticsService) getTotalExams(ctx context.Context, teacherID s
 
 func (s *TeacherAnalyticsService) getTotalStudents(ctx context.Context, teacherID string) (int, error) {
 	var count int
-	query := `
-		SELECT COUNT(DISTINCT user_id)
-		FROM course_enrollments
-		WHERE course_id IN (
-			SELECT DISTINCT course_id FROM course_enrollments WHERE user_id = $1
-		)
-	`
-	err := s.db.QueryRowContext(ctx, query, teacherID).Scan(&count)
+	query := teacherRosterCTE + `SELECT COUNT(*) FROM roster`
+	err := s.db.QueryRowContext(ctx, query, teacherID, "", "").Scan(&count)
 	return count, err
 }
 
@@ -382,14 +386,12 @@ func (s *TeacherAnalyticsService) getPerformanceMetrics(ctx context.Context, tea
 
 func (s *TeacherAnalyticsService) getCompletionRate(ctx context.Context, teacherID string) (float64, error) {
 	var completionRate float64
-	query := `
-		SELECT COALESCE(AVG(progress), 0)
-		FROM course_enrollments
-		WHERE course_id IN (
-			SELECT DISTINCT course_id FROM course_enrollments WHERE user_id = $1
-		)
+	query := teacherRosterCTE + `
+		SELECT COALESCE(AVG(ce.progress), 0)
+		FROM course_enrollments ce
+		JOIN roster r ON r.user_id = ce.user_id
 	`
-	err := s.db.QueryRowContext(ctx, query, teacherID).Scan(&completionRate)
+	err := s.db.QueryRowContext(ctx, query, teacherID, "", "").Scan(&completionRate)
 	return completionRate, err
 }
 
@@ -0,0 +1,55 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestGetTeacherExams_BindsStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	status := "published' OR '1'='1"
+	mock.ExpectQuery(`WHERE e.created_by = \$1 AND .* AND e.status = \$3`).
+		WithArgs("teacher-1", "", status).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`AND e.status = \$3\s+GROUP BY e.id\s+ORDER BY e.created_at DESC\s+LIMIT \$4 OFFSET \$5`).
+		WithArgs("teacher-1", "", status, int32(10), int32(10)).
+		WillReturnRows(sqlmock.NewRows(nil))
+
+	service := NewTeacherAnalyticsService(db, nil, nil, nil)
+	if _, _, err := service.GetTeacherExams(context.Background(), "teacher-1", status, "", 2, 10); err != nil {
+		t.Fatalf("GetTeacherExams: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetTeacherExams_AllStatuses(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+		WithArgs("teacher-1", "org-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`LIMIT \$3 OFFSET \$4`).
+		WithArgs("teacher-1", "org-1", int32(20), int32(0)).
+		WillReturnRows(sqlmock.NewRows(nil))
+
+	service := NewTeacherAnalyticsService(db, nil, nil, nil)
+	if _, _, err := service.GetTeacherExams(context.Background(), "teacher-1", "all", "org-1", 1, 20); err != nil {
+		t.Fatalf("GetTeacherExams: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
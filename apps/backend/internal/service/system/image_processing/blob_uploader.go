@@ -0,0 +1,102 @@
+package image_processing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"exam-bank-system/apps/backend/internal/service/storage"
+)
+
+// QuestionImagePrefix is the key prefix of uploaded question images; it lives under
+// storage.PublicPrefix so rendered content can embed stable links
+const QuestionImagePrefix = storage.PublicPrefix + "questions/"
+
+// UploadResult represents the result of an image upload to the blob store
+type UploadResult struct {
+	FileID         string    // Blob key
+	WebViewLink    string    // Public URL of the image
+	WebContentLink string    // Same as WebViewLink
+	ThumbnailLink  string    // Same as WebViewLink; thumbnails are scaled by the client
+	UploadedAt     time.Time // Upload timestamp
+}
+
+// ImageUploader stores processed question images in the configured blob store
+type ImageUploader struct {
+	store  storage.BlobStore
+	logger *logrus.Logger
+}
+
+// NewImageUploader creates a new image uploader
+func NewImageUploader(store storage.BlobStore, logger *logrus.Logger) (*ImageUploader, error) {
+	if store == nil {
+		return nil, fmt.Errorf("blob store not configured")
+	}
+	return &ImageUploader{store: store, logger: logger}, nil
+}
+
+// UploadImage uploads a local WebP image and returns its public links
+func (u *ImageUploader) UploadImage(ctx context.Context, localPath string, questionCode string, imageType string) (*UploadResult, error) {
+	// Validate inputs
+	if questionCode == "" {
+		return nil, fmt.Errorf("question code is required")
+	}
+	if imageType == "" {
+		return nil, fmt.Errorf("image type is required")
+	}
+	key := fmt.Sprintf("%s%s/%s_%d.webp", QuestionImagePrefix, questionCode, imageType, time.Now().UnixNano())
+	return u.UploadImageAs(ctx, localPath, key)
+}
+
+// UploadImageAs uploads a local WebP image under the given key, which must start
+// with storage.PublicPrefix
+func (u *ImageUploader) UploadImageAs(ctx context.Context, localPath string, key string) (*UploadResult, error) {
+	if localPath == "" {
+		return nil, fmt.Errorf("local path is required")
+	}
+	if err := storage.ValidateKey(key); err != nil {
+		return nil, fmt.Errorf("invalid image key %q: %w", key, err)
+	}
+	link, ok := u.store.PublicURL(key)
+	if !ok {
+		return nil, fmt.Errorf("blob store has no public URL for %q", key)
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return nil, fmt.Errorf("file not found: %w", err)
+	}
+	defer file.Close()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat image: %w", err)
+	}
+
+	u.logger.WithFields(logrus.Fields{
+		"local_path": localPath,
+		"key":        key,
+	}).Info("Uploading question image")
+
+	if _, err := u.store.Put(ctx, key, file, fileInfo.Size(), "image/webp"); err != nil {
+		return nil, fmt.Errorf("upload failed: %w", err)
+	}
+
+	return &UploadResult{
+		FileID:         key,
+		WebViewLink:    link,
+		WebContentLink: link,
+		ThumbnailLink:  link,
+		UploadedAt:     time.Now(),
+	}, nil
+}
+
+// DeleteImage deletes an uploaded image
+func (u *ImageUploader) DeleteImage(ctx context.Context, key string) error {
+	if err := u.store.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete image: %w", err)
+	}
+	return nil
+}
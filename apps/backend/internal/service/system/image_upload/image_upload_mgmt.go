@@ -21,7 +21,7 @@ import (
 type ImageUploadMgmt struct {
 	imageRepo       *repository.QuestionImageRepository
 	uploadErrorRepo *repository.ImageUploadErrorRepository
-	uploader        *image_processing.CloudinaryUploader
+	uploader        *image_processing.ImageUploader
 	processor       *image_processing.ImageProcessingService
 	retryPolicy     *entity.ImageUploadRetryPolicy
 	cleanupPolicy   *entity.ImageCacheCleanupPolicy
@@ -32,7 +32,7 @@ type ImageUploadMgmt struct {
 func NewImageUploadMgmt(
 	imageRepo *repository.QuestionImageRepository,
 	uploadErrorRepo *repository.ImageUploadErrorRepository,
-	uploader *image_processing.CloudinaryUploader,
+	uploader *image_processing.ImageUploader,
 	processor *image_processing.ImageProcessingService,
 	logger *logrus.Logger,
 ) *ImageUploadMgmt {
@@ -102,7 +102,7 @@ func (m *ImageUploadMgmt) UploadImageWithErrorHandling(ctx context.Context, ques
 	// Update image status to uploading
 	m.updateImageStatus(ctx, imageID, entity.ImageStatusUploading)
 
-	// Upload to Google Drive with retry
+	// Upload to the blob store with retry
 	uploadResult, err := m.uploadWithRetry(ctx, imageID, localPath, questionID, string(imageType))
 	if err != nil {
 		uploadError := m.createUploadError(imageID, m.classifyUploadError(err), entity.ImageUploadErrorSeverityError,
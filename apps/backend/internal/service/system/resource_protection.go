@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,12 +11,25 @@ import (
 	"exam-bank-system/apps/backend/pkg/proto/common"
 )
 
+var (
+	// ErrAccessBlocked is returned while the user is blocked
+	ErrAccessBlocked = errors.New("access blocked")
+	// ErrDownloadLimitExceeded is returned once the user reached the daily download quota
+	ErrDownloadLimitExceeded = errors.New("daily download limit exceeded")
+)
+
+// DefaultMaxDownloadsPerDay is the download quota per user over a rolling 24 hours
+const DefaultMaxDownloadsPerDay = 20
+
 // ResourceProtectionService handles resource access protection and auto-blocking
 type ResourceProtectionService struct {
 	resourceRepo    repository.ResourceAccessRepository
 	userRepo        repository.IUserRepository
 	auditLogRepo    repository.AuditLogRepository
 	notificationSvc *notification.NotificationService
+
+	maxDownloadsPerDay int
+	now                func() time.Time
 }
 
 // ResourceAccessAttempt represents an attempt to access a resource
@@ -92,7 +106,32 @@ func NewResourceProtectionService(
 		userRepo:        userRepo,
 		auditLogRepo:    auditLogRepo,
 		notificationSvc: notificationSvc,
+
+		maxDownloadsPerDay: DefaultMaxDownloadsPerDay,
+		now:                time.Now,
+	}
+}
+
+// SetDailyDownloadLimit changes the per-user download quota; zero or less disables it
+func (s *ResourceProtectionService) SetDailyDownloadLimit(limit int) {
+	s.maxDownloadsPerDay = limit
+}
+
+// ValidateDownload enforces the daily download quota, then validates and tracks the
+// attempt as a DOWNLOAD access. Only valid downloads count towards the quota.
+func (s *ResourceProtectionService) ValidateDownload(ctx context.Context, attempt *ResourceAccessAttempt) error {
+	attempt.Action = "DOWNLOAD"
+	if s.maxDownloadsPerDay > 0 {
+		count, err := s.resourceRepo.CountUserActions(ctx, attempt.UserID, attempt.Action, s.now().Add(-24*time.Hour))
+		if err != nil {
+			return fmt.Errorf("failed to count downloads: %w", err)
+		}
+		if count >= s.maxDownloadsPerDay {
+			s.logResourceAccess(ctx, attempt, false, ErrDownloadLimitExceeded.Error())
+			return ErrDownloadLimitExceeded
+		}
 	}
+	return s.ValidateAndTrackAccess(ctx, attempt)
 }
 
 // ValidateAndTrackAccess validates resource access and tracks it
@@ -106,7 +145,7 @@ func (s *ResourceProtectionService) ValidateAndTrackAccess(ctx context.Context,
 		s.createSecurityAlert(ctx, attempt.UserID, "Access Blocked",
 			fmt.Sprintf("Your access was blocked due to: %s. Block expires at: %v", reason, until))
 
-		return fmt.Errorf("access blocked: %s until %v", reason, until)
+		return fmt.Errorf("%w: %s until %v", ErrAccessBlocked, reason, until)
 	}
 
 	// Calculate risk factors
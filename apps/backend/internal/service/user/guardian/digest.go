@@ -0,0 +1,141 @@
+package guardian
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+	"exam-bank-system/apps/backend/internal/services/email"
+)
+
+// digestExamLimit caps the exam results listed in one digest
+const digestExamLimit = 10
+
+// SendDueDigests delivers the weekly digest for every active link whose last digest is
+// older than the digest interval. It returns the number of digests sent.
+func (s *Service) SendDueDigests(ctx context.Context) (int, error) {
+	now := s.now()
+	due, err := s.links.ListDigestDue(ctx, now.Add(-s.config.DigestInterval), s.config.DigestBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, link := range due {
+		if err := s.sendDigest(ctx, link, now); err != nil {
+			log.Printf("[WARN] [Guardian] Failed to send digest for link %s: %v", link.ID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (s *Service) sendDigest(ctx context.Context, link *repository.GuardianLink, now time.Time) error {
+	digest, err := s.buildDigest(ctx, link, now)
+	if err != nil {
+		return err
+	}
+
+	studentName := displayName(link.StudentName, link.StudentEmail)
+	message := fmt.Sprintf("%s: %d phút tập trung, %d bài thi trong 7 ngày qua", studentName, digest.FocusMinutes, digest.ExamsTaken)
+	s.notify(ctx, link.GuardianID, notification.TypeProgressDigest, "Báo cáo học tập hàng tuần", message, "/guardian", link.ID)
+
+	if s.mailer != nil && link.GuardianEmail != "" {
+		if err := s.mailer.SendGuardianDigestEmail(link.GuardianEmail, *digest); err != nil {
+			return fmt.Errorf("failed to email digest: %w", err)
+		}
+	}
+	return s.links.MarkDigestSent(ctx, link.ID, now)
+}
+
+// buildDigest summarises the seven days before now
+func (s *Service) buildDigest(ctx context.Context, link *repository.GuardianLink, now time.Time) (*email.GuardianDigest, error) {
+	periodStart := now.AddDate(0, 0, -7)
+	digest := &email.GuardianDigest{
+		GuardianName: displayName(link.GuardianName, link.GuardianEmail),
+		StudentName:  displayName(link.StudentName, link.StudentEmail),
+		PeriodStart:  periodStart,
+		PeriodEnd:    now,
+	}
+
+	weekly, err := s.focus.GetWeeklyStats(ctx, link.StudentID, time.Date(periodStart.Year(), periodStart.Month(), periodStart.Day(), 0, 0, 0, 0, periodStart.Location()))
+	if err != nil {
+		return nil, err
+	}
+	digest.FocusMinutes = weekly.TotalFocusTimeSeconds / 60
+
+	if streak, err := s.streaks.GetStreak(ctx, link.StudentID); err == nil && streak != nil {
+		digest.CurrentStreak = streak.CurrentStreak
+	}
+
+	results, total, err := s.links.ListStudentExamResults(ctx, link.StudentID, periodStart, digestExamLimit, 0)
+	if err != nil {
+		return nil, err
+	}
+	digest.ExamsTaken = total
+	var sum float64
+	for _, result := range results {
+		sum += result.Percentage
+		digest.Exams = append(digest.Exams, email.GuardianDigestExam{
+			Title:       result.ExamTitle,
+			Percentage:  result.Percentage,
+			Passed:      result.Passed,
+			SubmittedAt: result.SubmittedAt,
+		})
+	}
+	if len(results) > 0 {
+		digest.AverageScore = sum / float64(len(results))
+	}
+	return digest, nil
+}
+
+// Start sends due digests on a schedule until Stop is called
+func (s *Service) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.config.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+				if sent, err := s.SendDueDigests(ctx); err != nil {
+					log.Printf("[ERROR] [Guardian] Digest run failed: %v", err)
+				} else if sent > 0 {
+					log.Printf("[INFO] [Guardian] Sent %d weekly digests", sent)
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop ends the digest loop started by Start
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		s.wg.Wait()
+	}
+}
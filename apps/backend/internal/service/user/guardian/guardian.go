@@ -0,0 +1,316 @@
+package guardian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+	"exam-bank-system/apps/backend/internal/services/email"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+)
+
+// Errors returned by the guardian service
+var (
+	ErrNotFound         = errors.New("guardian link not found")
+	ErrPermissionDenied = errors.New("no active guardian link for this student")
+	ErrInvalidInput     = errors.New("invalid guardian request")
+	ErrAlreadyLinked    = errors.New("a guardian link for this student already exists")
+)
+
+// Link statuses
+const (
+	StatusPending  = "PENDING"
+	StatusActive   = "ACTIVE"
+	StatusDeclined = "DECLINED"
+	StatusRevoked  = "REVOKED"
+)
+
+var relationships = map[string]bool{"PARENT": true, "GUARDIAN": true, "OTHER": true}
+
+// linkStore is the persistence used by the service, implemented by repository.GuardianLinkRepository
+type linkStore interface {
+	Create(ctx context.Context, link *repository.GuardianLink) error
+	GetByID(ctx context.Context, id string) (*repository.GuardianLink, error)
+	ListForGuardian(ctx context.Context, guardianID string) ([]*repository.GuardianLink, error)
+	ListForStudent(ctx context.Context, studentID string) ([]*repository.GuardianLink, error)
+	UpdateStatus(ctx context.Context, id, from, to string) (bool, error)
+	SetDigestEnabled(ctx context.Context, id string, enabled bool) error
+	HasActiveLink(ctx context.Context, guardianID, studentID string) (bool, error)
+	ListDigestDue(ctx context.Context, cutoff time.Time, limit int) ([]*repository.GuardianLink, error)
+	MarkDigestSent(ctx context.Context, id string, sentAt time.Time) error
+	ListStudentExamResults(ctx context.Context, studentID string, since time.Time, limit, offset int) ([]*repository.StudentExamResult, int, error)
+}
+
+// userLookup resolves users, implemented by repository.IUserRepository
+type userLookup interface {
+	GetByEmail(ctx context.Context, email string) (*repository.User, error)
+}
+
+// focusStats reads focus-time statistics, implemented by focus.AnalyticsService
+type focusStats interface {
+	GetWeeklyStats(ctx context.Context, userID string, weekStart time.Time) (*entity.WeeklyAnalytics, error)
+}
+
+// streakReader reads study streaks, implemented by focus.StreakService
+type streakReader interface {
+	GetStreak(ctx context.Context, userID string) (*entity.UserStreak, error)
+}
+
+// notifier delivers in-app notifications, implemented by notification.NotificationService
+type notifier interface {
+	CreateNotification(
+		ctx context.Context,
+		userID string,
+		notifType notification.NotificationType,
+		title string,
+		message string,
+		data *notification.NotificationData,
+		expiresIn *time.Duration,
+	) error
+}
+
+// mailer sends the weekly digest, implemented by email.EmailService
+type mailer interface {
+	SendGuardianDigestEmail(toEmail string, digest email.GuardianDigest) error
+}
+
+// Config controls weekly digest delivery
+type Config struct {
+	DigestInterval  time.Duration // Time between two digests for the same link
+	CheckInterval   time.Duration // How often the digest loop looks for due links
+	DigestBatchSize int
+}
+
+// Service manages guardian links and serves read-only progress data to linked guardians
+type Service struct {
+	links    linkStore
+	users    userLookup
+	focus    focusStats
+	streaks  streakReader
+	notifier notifier
+	mailer   mailer
+	config   Config
+	now      func() time.Time
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewService creates a new guardian service
+func NewService(links linkStore, users userLookup, focus focusStats, streaks streakReader, notifier notifier, mailer mailer, config Config) *Service {
+	if config.DigestInterval <= 0 {
+		config.DigestInterval = 7 * 24 * time.Hour
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = time.Hour
+	}
+	if config.DigestBatchSize <= 0 {
+		config.DigestBatchSize = 100
+	}
+
+	return &Service{
+		links:    links,
+		users:    users,
+		focus:    focus,
+		streaks:  streaks,
+		notifier: notifier,
+		mailer:   mailer,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+// RequestLink asks the student with studentEmail to give guardianID access to their progress.
+// The link stays PENDING until the student confirms it.
+func (s *Service) RequestLink(ctx context.Context, guardianID, studentEmail, relationship string) (*repository.GuardianLink, error) {
+	relationship = strings.ToUpper(strings.TrimSpace(relationship))
+	if relationship == "" {
+		relationship = "PARENT"
+	}
+	if !relationships[relationship] {
+		return nil, fmt.Errorf("%w: unknown relationship %q", ErrInvalidInput, relationship)
+	}
+
+	student, err := s.users.GetByEmail(ctx, strings.TrimSpace(studentEmail))
+	if err != nil || student == nil {
+		return nil, fmt.Errorf("%w: no student account with this email", ErrNotFound)
+	}
+	if student.ID == guardianID {
+		return nil, fmt.Errorf("%w: you cannot be your own guardian", ErrInvalidInput)
+	}
+	if student.Role != common.UserRole_USER_ROLE_STUDENT {
+		return nil, fmt.Errorf("%w: guardians can only follow student accounts", ErrInvalidInput)
+	}
+
+	link := &repository.GuardianLink{GuardianID: guardianID, StudentID: student.ID, Relationship: relationship}
+	if err := s.links.Create(ctx, link); err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, ErrAlreadyLinked
+		}
+		return nil, err
+	}
+
+	created, err := s.links.GetByID(ctx, link.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(ctx, created.StudentID, notification.TypeGuardianLink, "Yêu cầu theo dõi tiến độ",
+		fmt.Sprintf("%s muốn theo dõi tiến độ học tập của bạn", displayName(created.GuardianName, created.GuardianEmail)),
+		"/settings/guardians", created.ID)
+	return created, nil
+}
+
+// Respond lets the student accept or decline a pending link
+func (s *Service) Respond(ctx context.Context, studentID, linkID string, accept bool) (*repository.GuardianLink, error) {
+	link, err := s.getLink(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link.StudentID != studentID {
+		return nil, ErrNotFound
+	}
+
+	to := StatusDeclined
+	if accept {
+		to = StatusActive
+	}
+	ok, err := s.links.UpdateStatus(ctx, linkID, StatusPending, to)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: link is no longer pending", ErrInvalidInput)
+	}
+
+	if accept {
+		s.notify(ctx, link.GuardianID, notification.TypeGuardianLink, "Yêu cầu đã được chấp nhận",
+			fmt.Sprintf("%s đã cho phép bạn theo dõi tiến độ học tập", displayName(link.StudentName, link.StudentEmail)),
+			"/guardian", link.ID)
+	}
+	return s.getLink(ctx, linkID)
+}
+
+// Revoke ends a pending or active link. Both the student and the guardian may end it.
+func (s *Service) Revoke(ctx context.Context, userID, linkID string) error {
+	link, err := s.getLink(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if link.StudentID != userID && link.GuardianID != userID {
+		return ErrNotFound
+	}
+	if link.Status != StatusPending && link.Status != StatusActive {
+		return fmt.Errorf("%w: link is already closed", ErrInvalidInput)
+	}
+
+	ok, err := s.links.UpdateStatus(ctx, linkID, link.Status, StatusRevoked)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: link changed, please retry", ErrInvalidInput)
+	}
+
+	if userID == link.StudentID && link.Status == StatusActive {
+		s.notify(ctx, link.GuardianID, notification.TypeGuardianLink, "Quyền theo dõi đã bị thu hồi",
+			fmt.Sprintf("%s đã thu hồi quyền theo dõi tiến độ học tập", displayName(link.StudentName, link.StudentEmail)),
+			"/guardian", link.ID)
+	}
+	return nil
+}
+
+// SetDigest turns the weekly digest for one of the guardian's links on or off
+func (s *Service) SetDigest(ctx context.Context, guardianID, linkID string, enabled bool) error {
+	link, err := s.getLink(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if link.GuardianID != guardianID {
+		return ErrNotFound
+	}
+	return s.links.SetDigestEnabled(ctx, linkID, enabled)
+}
+
+// ListStudents returns the guardian's pending and active links
+func (s *Service) ListStudents(ctx context.Context, guardianID string) ([]*repository.GuardianLink, error) {
+	return s.links.ListForGuardian(ctx, guardianID)
+}
+
+// ListGuardians returns the student's pending and active links
+func (s *Service) ListGuardians(ctx context.Context, studentID string) ([]*repository.GuardianLink, error) {
+	return s.links.ListForStudent(ctx, studentID)
+}
+
+// ExamResults returns a linked student's submitted exam results, newest first
+func (s *Service) ExamResults(ctx context.Context, guardianID, studentID string, since time.Time, limit, offset int) ([]*repository.StudentExamResult, int, error) {
+	if err := s.authorise(ctx, guardianID, studentID); err != nil {
+		return nil, 0, err
+	}
+	return s.links.ListStudentExamResults(ctx, studentID, since, limit, offset)
+}
+
+// FocusStats returns a linked student's focus time for the week starting at weekStart and their current streak
+func (s *Service) FocusStats(ctx context.Context, guardianID, studentID string, weekStart time.Time) (*entity.WeeklyAnalytics, *entity.UserStreak, error) {
+	if err := s.authorise(ctx, guardianID, studentID); err != nil {
+		return nil, nil, err
+	}
+	weekly, err := s.focus.GetWeeklyStats(ctx, studentID, weekStart)
+	if err != nil {
+		return nil, nil, err
+	}
+	streak, err := s.streaks.GetStreak(ctx, studentID)
+	if err != nil {
+		log.Printf("[WARN] [Guardian] Failed to load streak for student %s: %v", studentID, err)
+		streak = &entity.UserStreak{UserID: studentID}
+	}
+	return weekly, streak, nil
+}
+
+func (s *Service) authorise(ctx context.Context, guardianID, studentID string) error {
+	ok, err := s.links.HasActiveLink(ctx, guardianID, studentID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func (s *Service) getLink(ctx context.Context, linkID string) (*repository.GuardianLink, error) {
+	link, err := s.links.GetByID(ctx, linkID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	return link, err
+}
+
+// notify sends a guardian notification; delivery failures never block the workflow
+func (s *Service) notify(ctx context.Context, userID string, notifType notification.NotificationType, title, message, actionURL, linkID string) {
+	if s.notifier == nil {
+		return
+	}
+	data := &notification.NotificationData{
+		Priority:  notification.PriorityMedium,
+		ActionURL: actionURL,
+		Metadata:  map[string]interface{}{"guardian_link_id": linkID},
+	}
+	if err := s.notifier.CreateNotification(ctx, userID, notifType, title, message, data, nil); err != nil {
+		log.Printf("[WARN] [Guardian] Failed to send notification to %s: %v", userID, err)
+	}
+}
+
+func displayName(name, email string) string {
+	if name != "" {
+		return name
+	}
+	return email
+}
@@ -0,0 +1,339 @@
+package guardian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/entity"
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+	"exam-bank-system/apps/backend/internal/services/email"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+)
+
+type memoryLinks struct {
+	links   map[string]*repository.GuardianLink
+	results map[string][]*repository.StudentExamResult
+	nextID  int
+}
+
+func (m *memoryLinks) Create(ctx context.Context, link *repository.GuardianLink) error {
+	for _, existing := range m.links {
+		if existing.GuardianID == link.GuardianID && existing.StudentID == link.StudentID &&
+			(existing.Status == StatusPending || existing.Status == StatusActive) {
+			return repository.ErrDuplicateKey
+		}
+	}
+	m.nextID++
+	copied := *link
+	copied.ID = fmt.Sprintf("link-%d", m.nextID)
+	copied.Status = StatusPending
+	copied.DigestEnabled = true
+	copied.GuardianEmail = copied.GuardianID + "@example.com"
+	copied.StudentEmail = copied.StudentID + "@example.com"
+	m.links[copied.ID] = &copied
+	link.ID = copied.ID
+	return nil
+}
+
+func (m *memoryLinks) GetByID(ctx context.Context, id string) (*repository.GuardianLink, error) {
+	link, ok := m.links[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *link
+	return &copied, nil
+}
+
+func (m *memoryLinks) ListForGuardian(ctx context.Context, guardianID string) ([]*repository.GuardianLink, error) {
+	var result []*repository.GuardianLink
+	for _, link := range m.links {
+		if link.GuardianID == guardianID {
+			result = append(result, link)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryLinks) ListForStudent(ctx context.Context, studentID string) ([]*repository.GuardianLink, error) {
+	var result []*repository.GuardianLink
+	for _, link := range m.links {
+		if link.StudentID == studentID {
+			result = append(result, link)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryLinks) UpdateStatus(ctx context.Context, id, from, to string) (bool, error) {
+	link, ok := m.links[id]
+	if !ok || link.Status != from {
+		return false, nil
+	}
+	link.Status = to
+	return true, nil
+}
+
+func (m *memoryLinks) SetDigestEnabled(ctx context.Context, id string, enabled bool) error {
+	m.links[id].DigestEnabled = enabled
+	return nil
+}
+
+func (m *memoryLinks) HasActiveLink(ctx context.Context, guardianID, studentID string) (bool, error) {
+	for _, link := range m.links {
+		if link.GuardianID == guardianID && link.StudentID == studentID && link.Status == StatusActive {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memoryLinks) ListDigestDue(ctx context.Context, cutoff time.Time, limit int) ([]*repository.GuardianLink, error) {
+	var result []*repository.GuardianLink
+	for _, link := range m.links {
+		if link.Status == StatusActive && link.DigestEnabled && (link.LastDigestAt == nil || link.LastDigestAt.Before(cutoff)) {
+			result = append(result, link)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryLinks) MarkDigestSent(ctx context.Context, id string, sentAt time.Time) error {
+	m.links[id].LastDigestAt = &sentAt
+	return nil
+}
+
+func (m *memoryLinks) ListStudentExamResults(ctx context.Context, studentID string, since time.Time, limit, offset int) ([]*repository.StudentExamResult, int, error) {
+	var matching []*repository.StudentExamResult
+	for _, result := range m.results[studentID] {
+		if !result.SubmittedAt.Before(since) {
+			matching = append(matching, result)
+		}
+	}
+	total := len(matching)
+	if offset > len(matching) {
+		offset = len(matching)
+	}
+	matching = matching[offset:]
+	if len(matching) > limit {
+		matching = matching[:limit]
+	}
+	return matching, total, nil
+}
+
+type fakeUsers map[string]*repository.User
+
+func (f fakeUsers) GetByEmail(ctx context.Context, email string) (*repository.User, error) {
+	if user, ok := f[email]; ok {
+		return user, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+type fakeFocus struct{}
+
+func (fakeFocus) GetWeeklyStats(ctx context.Context, userID string, weekStart time.Time) (*entity.WeeklyAnalytics, error) {
+	return &entity.WeeklyAnalytics{WeekStart: weekStart, TotalFocusTimeSeconds: 5400}, nil
+}
+
+type fakeStreaks struct{}
+
+func (fakeStreaks) GetStreak(ctx context.Context, userID string) (*entity.UserStreak, error) {
+	return &entity.UserStreak{UserID: userID, CurrentStreak: 4}, nil
+}
+
+type sentNotification struct {
+	userID    string
+	notifType notification.NotificationType
+}
+
+type fakeNotifier struct{ sent []sentNotification }
+
+func (f *fakeNotifier) CreateNotification(ctx context.Context, userID string, notifType notification.NotificationType, title, message string, data *notification.NotificationData, expiresIn *time.Duration) error {
+	f.sent = append(f.sent, sentNotification{userID: userID, notifType: notifType})
+	return nil
+}
+
+type sentDigest struct {
+	to     string
+	digest email.GuardianDigest
+}
+
+type fakeMailer struct{ sent []sentDigest }
+
+func (f *fakeMailer) SendGuardianDigestEmail(toEmail string, digest email.GuardianDigest) error {
+	f.sent = append(f.sent, sentDigest{to: toEmail, digest: digest})
+	return nil
+}
+
+func newTestService() (*Service, *memoryLinks, *fakeNotifier, *fakeMailer) {
+	links := &memoryLinks{links: make(map[string]*repository.GuardianLink), results: make(map[string][]*repository.StudentExamResult)}
+	users := fakeUsers{
+		"student@example.com": {ID: "student", Email: "student@example.com", Role: common.UserRole_USER_ROLE_STUDENT},
+		"teacher@example.com": {ID: "teacher", Email: "teacher@example.com", Role: common.UserRole_USER_ROLE_TEACHER},
+	}
+	notifier := &fakeNotifier{}
+	mailer := &fakeMailer{}
+	s := NewService(links, users, fakeFocus{}, fakeStreaks{}, notifier, mailer, Config{})
+	return s, links, notifier, mailer
+}
+
+func TestLinkRequiresStudentConfirmation(t *testing.T) {
+	s, _, notifier, _ := newTestService()
+	ctx := context.Background()
+
+	link, err := s.RequestLink(ctx, "parent", "student@example.com", "")
+	if err != nil {
+		t.Fatalf("RequestLink: %v", err)
+	}
+	if link.Status != StatusPending || link.Relationship != "PARENT" {
+		t.Fatalf("got status %s relationship %s, want PENDING PARENT", link.Status, link.Relationship)
+	}
+	if len(notifier.sent) != 1 || notifier.sent[0].userID != "student" {
+		t.Fatalf("expected the student to be notified, got %+v", notifier.sent)
+	}
+	if _, err := s.RequestLink(ctx, "parent", "student@example.com", "PARENT"); !errors.Is(err, ErrAlreadyLinked) {
+		t.Errorf("expected duplicate request to fail, got %v", err)
+	}
+
+	if _, _, err := s.ExamResults(ctx, "parent", "student", time.Time{}, 20, 0); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected pending link to deny access, got %v", err)
+	}
+	if _, err := s.Respond(ctx, "parent", link.ID, true); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected guardian to be unable to confirm their own request, got %v", err)
+	}
+
+	confirmed, err := s.Respond(ctx, "student", link.ID, true)
+	if err != nil {
+		t.Fatalf("Respond: %v", err)
+	}
+	if confirmed.Status != StatusActive {
+		t.Fatalf("got status %s, want ACTIVE", confirmed.Status)
+	}
+	if _, _, err := s.ExamResults(ctx, "parent", "student", time.Time{}, 20, 0); err != nil {
+		t.Errorf("expected active link to allow access, got %v", err)
+	}
+	weekly, streak, err := s.FocusStats(ctx, "parent", "student", time.Now())
+	if err != nil {
+		t.Fatalf("FocusStats: %v", err)
+	}
+	if weekly.TotalFocusTimeSeconds != 5400 || streak.CurrentStreak != 4 {
+		t.Errorf("unexpected focus stats %+v %+v", weekly, streak)
+	}
+	if _, _, err := s.FocusStats(ctx, "stranger", "student", time.Now()); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected unlinked user to be denied, got %v", err)
+	}
+}
+
+func TestRequestLinkValidation(t *testing.T) {
+	s, _, _, _ := newTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		guardianID   string
+		email        string
+		relationship string
+		want         error
+	}{
+		{"unknown email", "parent", "nobody@example.com", "", ErrNotFound},
+		{"not a student", "parent", "teacher@example.com", "", ErrInvalidInput},
+		{"self", "student", "student@example.com", "", ErrInvalidInput},
+		{"bad relationship", "parent", "student@example.com", "NEIGHBOUR", ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.RequestLink(ctx, tt.guardianID, tt.email, tt.relationship); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestStudentCanRevoke(t *testing.T) {
+	s, _, notifier, _ := newTestService()
+	ctx := context.Background()
+
+	link, _ := s.RequestLink(ctx, "parent", "student@example.com", "GUARDIAN")
+	if _, err := s.Respond(ctx, "student", link.ID, true); err != nil {
+		t.Fatalf("Respond: %v", err)
+	}
+	if err := s.Revoke(ctx, "stranger", link.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected stranger to be unable to revoke, got %v", err)
+	}
+	if err := s.Revoke(ctx, "student", link.ID); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+	if _, _, err := s.ExamResults(ctx, "parent", "student", time.Time{}, 20, 0); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected revoked link to deny access, got %v", err)
+	}
+	last := notifier.sent[len(notifier.sent)-1]
+	if last.userID != "parent" || last.notifType != notification.TypeGuardianLink {
+		t.Errorf("expected guardian to be told about the revocation, got %+v", last)
+	}
+	if err := s.Revoke(ctx, "student", link.ID); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected second revoke to fail, got %v", err)
+	}
+
+	// A new request is possible once the old link is closed
+	if _, err := s.RequestLink(ctx, "parent", "student@example.com", ""); err != nil {
+		t.Errorf("expected new request after revocation, got %v", err)
+	}
+}
+
+func TestSendDueDigests(t *testing.T) {
+	s, links, notifier, mailer := newTestService()
+	ctx := context.Background()
+	now := time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	link, _ := s.RequestLink(ctx, "parent", "student@example.com", "")
+	if _, err := s.Respond(ctx, "student", link.ID, true); err != nil {
+		t.Fatalf("Respond: %v", err)
+	}
+	links.results["student"] = []*repository.StudentExamResult{
+		{ExamTitle: "Đề kiểm tra 15 phút", Percentage: 80, Passed: true, SubmittedAt: now.AddDate(0, 0, -1)},
+		{ExamTitle: "Đề thi giữa kỳ", Percentage: 60, Passed: true, SubmittedAt: now.AddDate(0, 0, -3)},
+		{ExamTitle: "Last month", Percentage: 10, SubmittedAt: now.AddDate(0, -1, 0)},
+	}
+
+	sent, err := s.SendDueDigests(ctx)
+	if err != nil {
+		t.Fatalf("SendDueDigests: %v", err)
+	}
+	if sent != 1 || len(mailer.sent) != 1 {
+		t.Fatalf("expected one digest, sent %d, mailed %d", sent, len(mailer.sent))
+	}
+	digest := mailer.sent[0].digest
+	if mailer.sent[0].to != "parent@example.com" {
+		t.Errorf("digest sent to %s", mailer.sent[0].to)
+	}
+	if digest.ExamsTaken != 2 || digest.AverageScore != 70 || digest.FocusMinutes != 90 || digest.CurrentStreak != 4 {
+		t.Errorf("unexpected digest %+v", digest)
+	}
+	last := notifier.sent[len(notifier.sent)-1]
+	if last.userID != "parent" || last.notifType != notification.TypeProgressDigest {
+		t.Errorf("expected an in-app digest for the guardian, got %+v", last)
+	}
+
+	// Not due again until a week has passed
+	now = now.Add(24 * time.Hour)
+	if sent, _ := s.SendDueDigests(ctx); sent != 0 {
+		t.Errorf("expected no digest a day later, sent %d", sent)
+	}
+	now = now.Add(7 * 24 * time.Hour)
+	if sent, _ := s.SendDueDigests(ctx); sent != 1 {
+		t.Errorf("expected a digest a week later, sent %d", sent)
+	}
+
+	if err := s.SetDigest(ctx, "parent", link.ID, false); err != nil {
+		t.Fatalf("SetDigest: %v", err)
+	}
+	now = now.Add(8 * 24 * time.Hour)
+	if sent, _ := s.SendDueDigests(ctx); sent != 0 {
+		t.Errorf("expected no digest once disabled, sent %d", sent)
+	}
+}
@@ -2,8 +2,6 @@ package oauth
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"time"
@@ -29,12 +27,19 @@ type OAuthService struct {
 	userRepo         repository.IUserRepository
 	oauthAccountRepo repository.OAuthAccountRepository
 	sessionRepo      repository.SessionRepository
-	googleClient     *GoogleClient
+	googleClient     GoogleTokenVerifier
+	oidcProviders    *ProviderRegistry
+	oidcStates       oidcStateStore
 	jwtService       auth.IJWTService // REFACTORED: Use IJWTService interface
 	sessionService   SessionService   // Session management vá»›i 24h sliding window
 	logger           *logrus.Logger   // Structured logging
 }
 
+// GoogleTokenVerifier validates Google ID tokens, implemented by GoogleClient
+type GoogleTokenVerifier interface {
+	VerifyIDToken(ctx context.Context, idToken string) (*GoogleUserInfo, error)
+}
+
 // SessionService interface for session management
 //
 // Business Logic:
@@ -90,6 +95,11 @@ func NewOAuthService(
 	}
 }
 
+// SetGoogleTokenVerifier replaces the Google ID token verifier
+func (s *OAuthService) SetGoogleTokenVerifier(verifier GoogleTokenVerifier) {
+	s.googleClient = verifier
+}
+
 // GooglePayload represents the Google ID token payload
 type GooglePayload struct {
 	Email         string `json:"email"`
@@ -101,51 +111,31 @@ type GooglePayload struct {
 	FamilyName    string `json:"family_name"`
 }
 
-// GoogleLogin handles Google OAuth authentication vá»›i validation vÃ  logging
+// ResolveGoogleUser verifies a Google ID token and returns the user it belongs to
 //
 // Business Logic:
-// - Validate Google ID token
-// - Táº¡o user má»›i náº¿u chÆ°a tá»“n táº¡i
-// - Update Google ID vÃ  avatar náº¿u cáº§n
-// - Generate JWT tokens vÃ  session tokens
-// - Track login activity
-//
-// Parameters:
-//   - ctx: Context vá»›i timeout vÃ  cancellation
-//   - idToken: Google ID token tá»« frontend
-//   - ipAddress: IP address cá»§a user (for security tracking)
-//
-// Returns:
-//   - *pb.LoginResponse: Response vá»›i access_token, refresh_token, session_token, user info
-//   - error: gRPC error vá»›i appropriate code
-func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddress string) (*pb.LoginResponse, error) {
-	// Validate input parameters
+// - Look up the user by Google ID, then by email
+// - Create a new user if none matches
+// - Update Google ID and avatar if needed
+// - Session, token issuance and second-factor checks are left to the caller so they match Login
+func (s *OAuthService) ResolveGoogleUser(ctx context.Context, idToken string) (*repository.User, error) {
 	if idToken == "" {
 		s.logger.Error("Empty ID token provided")
 		return nil, status.Errorf(codes.InvalidArgument, "ID token cannot be empty")
 	}
-	if ipAddress == "" {
-		s.logger.Warn("Empty IP address provided, using default")
-		ipAddress = "unknown"
-	}
-
-	s.logger.WithFields(logrus.Fields{
-		"operation":  "GoogleLogin",
-		"ip_address": ipAddress,
-	}).Info("Google OAuth login attempt")
 
 	// Validate Google ID token
 	payload, err := s.verifyGoogleIDToken(ctx, idToken)
 	if err != nil {
 		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
+			"operation": "ResolveGoogleUser",
 			"error":     err.Error(),
 		}).Error("Invalid Google ID token")
 		return nil, status.Errorf(codes.Unauthenticated, "invalid Google ID token: %v", err)
 	}
 
 	s.logger.WithFields(logrus.Fields{
-		"operation": "GoogleLogin",
+		"operation": "ResolveGoogleUser",
 		"email":     payload.Email,
 		"google_id": payload.Sub,
 	}).Debug("Google ID token validated successfully")
@@ -154,7 +144,7 @@ func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddres
 	user, err := s.userRepo.GetByGoogleID(ctx, payload.Sub)
 	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
 		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
+			"operation": "ResolveGoogleUser",
 			"google_id": payload.Sub,
 			"error":     err.Error(),
 		}).Error("Failed to check user by Google ID")
@@ -166,7 +156,7 @@ func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddres
 		user, err = s.userRepo.GetByEmail(ctx, payload.Email)
 		if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
 			s.logger.WithFields(logrus.Fields{
-				"operation": "GoogleLogin",
+				"operation": "ResolveGoogleUser",
 				"email":     payload.Email,
 				"error":     err.Error(),
 			}).Error("Failed to check user by email")
@@ -177,14 +167,14 @@ func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddres
 	// Create new user if doesn't exist
 	if user == nil {
 		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
+			"operation": "ResolveGoogleUser",
 			"email":     payload.Email,
 		}).Info("Creating new user from Google account")
 
 		user, err = s.createUserFromGoogle(ctx, payload)
 		if err != nil {
 			s.logger.WithFields(logrus.Fields{
-				"operation": "GoogleLogin",
+				"operation": "ResolveGoogleUser",
 				"email":     payload.Email,
 				"error":     err.Error(),
 			}).Error("Failed to create user from Google")
@@ -196,7 +186,7 @@ func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddres
 			user.GoogleID = payload.Sub
 			if err := s.userRepo.UpdateGoogleID(ctx, user.ID, payload.Sub); err != nil {
 				s.logger.WithFields(logrus.Fields{
-					"operation": "GoogleLogin",
+					"operation": "ResolveGoogleUser",
 					"user_id":   user.ID,
 					"google_id": payload.Sub,
 					"error":     err.Error(),
@@ -211,7 +201,7 @@ func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddres
 			if err := s.userRepo.UpdateAvatar(ctx, user.ID, payload.Picture); err != nil {
 				// Log error but don't fail login
 				s.logger.WithFields(logrus.Fields{
-					"operation": "GoogleLogin",
+					"operation": "ResolveGoogleUser",
 					"user_id":   user.ID,
 					"error":     err.Error(),
 				}).Warn("Failed to update avatar")
@@ -219,167 +209,17 @@ func (s *OAuthService) GoogleLogin(ctx context.Context, idToken string, ipAddres
 		}
 	}
 
-	// Check if user is active
-	if user.Status != "ACTIVE" {
-		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
-			"user_id":   user.ID,
-			"status":    user.Status,
-		}).Warn("User account is not active")
-		return nil, status.Errorf(codes.PermissionDenied, "user account is %s", user.Status)
-	}
-
 	// Create or update OAuth account record
 	if err := s.upsertOAuthAccount(ctx, user.ID, payload); err != nil {
 		// Log error but don't fail login
 		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
+			"operation": "ResolveGoogleUser",
 			"user_id":   user.ID,
 			"error":     err.Error(),
 		}).Warn("Failed to upsert OAuth account")
 	}
 
-	// Create session using SessionService (handles 24h sliding window and 3-device limit)
-	var sessionToken string
-	if s.sessionService != nil {
-		// Generate session token
-		sessionToken = s.generateSessionToken()
-
-		// Get user agent from context if available
-		userAgent := "Google OAuth Client"
-		deviceFingerprint := fmt.Sprintf("google-oauth-%s", ipAddress)
-
-		s.logger.WithFields(logrus.Fields{
-			"operation":          "GoogleLogin",
-			"user_id":            user.ID,
-			"device_fingerprint": deviceFingerprint,
-		}).Debug("Creating session with SessionService")
-
-		// Create session with 24h sliding window
-		_, err = s.sessionService.CreateSession(ctx, user.ID, sessionToken, ipAddress, userAgent, deviceFingerprint)
-		if err != nil {
-			// Log error but don't fail login
-			s.logger.WithFields(logrus.Fields{
-				"operation": "GoogleLogin",
-				"user_id":   user.ID,
-				"error":     err.Error(),
-			}).Warn("Failed to create session")
-			sessionToken = "" // Continue without session token
-		}
-	} else {
-		// Fallback to direct session creation if SessionService not available
-		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
-			"user_id":   user.ID,
-		}).Debug("SessionService not available, using direct session creation")
-
-		// Check concurrent sessions
-		activeSessions, err := s.sessionRepo.GetActiveSessions(ctx, user.ID)
-		if err != nil {
-			s.logger.WithFields(logrus.Fields{
-				"operation": "GoogleLogin",
-				"user_id":   user.ID,
-				"error":     err.Error(),
-			}).Error("Failed to check active sessions")
-			return nil, status.Errorf(codes.Internal, "failed to check sessions: %v", err)
-		}
-
-		// If exceeded max sessions, terminate oldest
-		maxSessions := 3
-		if user.MaxConcurrentSessions > 0 {
-			maxSessions = user.MaxConcurrentSessions
-		}
-
-		if len(activeSessions) >= maxSessions {
-			// Terminate oldest session
-			oldestSession := activeSessions[0]
-			for _, session := range activeSessions {
-				if session.CreatedAt.Before(oldestSession.CreatedAt) {
-					oldestSession = session
-				}
-			}
-			if err := s.sessionRepo.TerminateSession(ctx, oldestSession.ID); err != nil {
-				s.logger.WithFields(logrus.Fields{
-					"operation":  "GoogleLogin",
-					"user_id":    user.ID,
-					"session_id": oldestSession.ID,
-					"error":      err.Error(),
-				}).Warn("Failed to terminate old session")
-			}
-		}
-
-		// Create new session with 24h sliding window
-		sessionToken = s.generateSessionToken()
-		session := &repository.Session{
-			UserID:       user.ID,
-			SessionToken: sessionToken,
-			IPAddress:    ipAddress,
-			IsActive:     true,
-			ExpiresAt:    time.Now().Add(24 * time.Hour), // 24 hours sliding window
-		}
-
-		if err := s.sessionRepo.CreateSession(ctx, session); err != nil {
-			s.logger.WithFields(logrus.Fields{
-				"operation": "GoogleLogin",
-				"user_id":   user.ID,
-				"error":     err.Error(),
-			}).Error("Failed to create session")
-			return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
-		}
-	}
-
-	// Generate JWT tokens with full user details using IJWTService
-	s.logger.WithFields(logrus.Fields{
-		"operation": "GoogleLogin",
-		"user_id":   user.ID,
-		"email":     user.Email,
-		"role":      string(user.Role),
-		"level":     user.Level,
-	}).Debug("Generating JWT tokens")
-
-	accessToken, err := s.jwtService.GenerateAccessToken(user.ID, user.Email, string(user.Role), user.Level)
-	if err != nil {
-		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
-			"user_id":   user.ID,
-			"error":     err.Error(),
-		}).Error("Failed to generate access token")
-		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
-	}
-
-	refreshToken, err := s.jwtService.GenerateRefreshToken(user.ID)
-	if err != nil {
-		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
-			"user_id":   user.ID,
-			"error":     err.Error(),
-		}).Error("Failed to generate refresh token")
-		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
-	}
-
-	// Update last login
-	if err := s.userRepo.UpdateLastLogin(ctx, user.ID, ipAddress); err != nil {
-		// Log error but don't fail login
-		s.logger.WithFields(logrus.Fields{
-			"operation": "GoogleLogin",
-			"user_id":   user.ID,
-			"error":     err.Error(),
-		}).Warn("Failed to update last login")
-	}
-
-	// Convert to proto user
-	protoUser := s.userToProto(user)
-
-	return &pb.LoginResponse{
-		Response: &common.Response{
-			Success: true,
-			Message: "Login successful",
-		},
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		SessionToken: sessionToken, // Include session token for stateful session management
-		User:         protoUser,
-	}, nil
+	return user, nil
 }
 
 // verifyGoogleIDToken validates Google ID token
@@ -449,13 +289,6 @@ func (s *OAuthService) upsertOAuthAccount(ctx context.Context, userID string, pa
 	return s.oauthAccountRepo.Upsert(ctx, account)
 }
 
-// generateSessionToken generates a secure random session token
-func (s *OAuthService) generateSessionToken() string {
-	b := make([]byte, 32)
-	rand.Read(b)
-	return base64.URLEncoding.EncodeToString(b)
-}
-
 // userToProto converts repository user to proto user
 func (s *OAuthService) userToProto(user *repository.User) *pb.User {
 	return &pb.User{
@@ -0,0 +1,397 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// oidcStateTTL is how long the user has to finish signing in at the provider
+const oidcStateTTL = 10 * time.Minute
+
+// OIDCCredentials carries either an ID token or an authorization code to redeem, and
+// the state StartOIDCLogin issued for the authorization request
+type OIDCCredentials struct {
+	Provider     string
+	IDToken      string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	State        string
+}
+
+// OIDCAuthorization is an authorization request started by StartOIDCLogin
+type OIDCAuthorization struct {
+	URL       string
+	State     string
+	ExpiresAt time.Time
+}
+
+// oidcStateStore keeps the state and nonce of authorization requests until the callback,
+// implemented by repository.OIDCLoginStateRepository
+type oidcStateStore interface {
+	Create(ctx context.Context, state *repository.OIDCLoginState) error
+	Consume(ctx context.Context, stateHash string) (*repository.OIDCLoginState, error)
+}
+
+// SetOIDCProviders enables OIDCLogin for the configured providers
+func (s *OAuthService) SetOIDCProviders(registry *ProviderRegistry) {
+	s.oidcProviders = registry
+}
+
+// SetOIDCStateStore sets where the state and nonce of authorization requests are kept
+func (s *OAuthService) SetOIDCStateStore(store oidcStateStore) {
+	s.oidcStates = store
+}
+
+// StartOIDCLogin stores a new state and nonce and returns the provider's authorization
+// URL carrying them. OIDCLogin and LinkOIDCAccount consume the state and require the
+// ID token to carry the nonce.
+func (s *OAuthService) StartOIDCLogin(ctx context.Context, providerName, redirectURI string) (*OIDCAuthorization, error) {
+	provider, err := s.oidcProviders.Get(providerName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown provider %q", providerName)
+	}
+	if redirectURI == "" {
+		return nil, status.Error(codes.InvalidArgument, "redirect_uri is required")
+	}
+	if s.oidcStates == nil {
+		return nil, status.Error(codes.Unavailable, "OIDC login state storage is not configured")
+	}
+
+	state, err := randomOIDCValue()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	nonce, err := randomOIDCValue()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	authURL, err := provider.AuthorizationURL(ctx, redirectURI, state, nonce)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to load provider configuration: %v", err)
+	}
+
+	expiresAt := time.Now().Add(oidcStateTTL)
+	if err := s.oidcStates.Create(ctx, &repository.OIDCLoginState{
+		StateHash:   hashOIDCState(state),
+		Provider:    provider.Name(),
+		Nonce:       nonce,
+		RedirectURI: redirectURI,
+		ExpiresAt:   expiresAt,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store login state: %v", err)
+	}
+	return &OIDCAuthorization{URL: authURL, State: state, ExpiresAt: expiresAt}, nil
+}
+
+// OIDCProviders returns the configured OIDC providers
+func (s *OAuthService) OIDCProviders() []*OIDCProvider {
+	return s.oidcProviders.Providers()
+}
+
+// ResolveOIDCUser verifies OIDC credentials and returns the user they belong to
+//
+// Business Logic:
+// - Existing link in oauth_accounts wins
+// - Otherwise a verified email links the identity to the matching user
+// - Otherwise a new user is created with the role mapped from the role claim
+// - Session and token issuance is left to the caller so it matches Login
+func (s *OAuthService) ResolveOIDCUser(ctx context.Context, creds OIDCCredentials) (*repository.User, error) {
+	provider, identity, err := s.verifyOIDCCredentials(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	logFields := logrus.Fields{
+		"operation": "OIDCLogin",
+		"provider":  identity.Provider,
+		"subject":   identity.Subject,
+	}
+
+	// Existing link
+	account, err := s.oauthAccountRepo.GetByProviderAccountID(ctx, identity.Provider, identity.Subject)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		s.logger.WithFields(logFields).WithError(err).Error("Failed to look up OAuth account")
+		return nil, status.Errorf(codes.Internal, "failed to check linked account: %v", err)
+	}
+	if account != nil {
+		user, err := s.userRepo.GetByID(ctx, account.UserID)
+		if err != nil {
+			s.logger.WithFields(logFields).WithError(err).Error("Linked user not found")
+			return nil, status.Errorf(codes.Internal, "failed to load linked user: %v", err)
+		}
+		s.refreshOIDCProfile(ctx, user, identity)
+		return user, nil
+	}
+
+	if identity.Email == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s did not provide an email address", provider.Config().DisplayName)
+	}
+
+	// Link by email
+	user, err := s.userRepo.GetByEmail(ctx, identity.Email)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		s.logger.WithFields(logFields).WithError(err).Error("Failed to check user by email")
+		return nil, status.Errorf(codes.Internal, "failed to check user by email: %v", err)
+	}
+	if user != nil {
+		// Without a verified email anyone controlling the IdP account could take over the user
+		if !identity.EmailVerified {
+			s.logger.WithFields(logFields).Warn("Refusing to link unverified email to existing user")
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"email is not verified by %s; sign in with your password and link the account instead", provider.Config().DisplayName)
+		}
+	} else {
+		s.logger.WithFields(logFields).Info("Creating new user from OIDC identity")
+		user, err = s.createUserFromOIDC(ctx, provider, identity)
+		if err != nil {
+			s.logger.WithFields(logFields).WithError(err).Error("Failed to create user from OIDC identity")
+			return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		}
+	}
+
+	if err := s.linkOIDCIdentity(ctx, user.ID, identity); err != nil {
+		s.logger.WithFields(logFields).WithError(err).Error("Failed to link OIDC identity")
+		return nil, status.Errorf(codes.Internal, "failed to link account: %v", err)
+	}
+	s.refreshOIDCProfile(ctx, user, identity)
+	return user, nil
+}
+
+// LinkOIDCAccount links an OIDC identity to an authenticated user
+func (s *OAuthService) LinkOIDCAccount(ctx context.Context, userID string, creds OIDCCredentials) ([]*repository.OAuthAccount, error) {
+	_, identity, err := s.verifyOIDCCredentials(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.oauthAccountRepo.GetByProviderAccountID(ctx, identity.Provider, identity.Subject)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to check linked account: %v", err)
+	}
+	if account != nil && account.UserID != userID {
+		return nil, status.Errorf(codes.AlreadyExists, "this %s account is linked to another user", identity.Provider)
+	}
+
+	if account == nil {
+		if err := s.linkOIDCIdentity(ctx, userID, identity); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to link account: %v", err)
+		}
+		s.logger.WithFields(logrus.Fields{
+			"operation": "LinkOIDCAccount",
+			"user_id":   userID,
+			"provider":  identity.Provider,
+		}).Info("OIDC account linked")
+	}
+
+	return s.LinkedAccounts(ctx, userID)
+}
+
+// UnlinkOIDCAccount removes a provider link, keeping at least one way to sign in
+func (s *OAuthService) UnlinkOIDCAccount(ctx context.Context, userID, provider string) ([]*repository.OAuthAccount, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	accounts, err := s.LinkedAccounts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *repository.OAuthAccount
+	for _, account := range accounts {
+		if account.Provider == provider {
+			target = account
+			break
+		}
+	}
+	if target == nil {
+		return nil, status.Errorf(codes.NotFound, "no %s account is linked", provider)
+	}
+	if user.PasswordHash == "" && len(accounts) == 1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "set a password or link another account before unlinking %s", provider)
+	}
+
+	if err := s.oauthAccountRepo.Delete(ctx, target.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlink account: %v", err)
+	}
+	if provider == "google" && user.GoogleID != "" {
+		if err := s.userRepo.UpdateGoogleID(ctx, user.ID, ""); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to clear Google ID: %v", err)
+		}
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"operation": "UnlinkOIDCAccount",
+		"user_id":   userID,
+		"provider":  provider,
+	}).Info("OIDC account unlinked")
+
+	return s.LinkedAccounts(ctx, userID)
+}
+
+// LinkedAccounts lists the external accounts linked to a user
+func (s *OAuthService) LinkedAccounts(ctx context.Context, userID string) ([]*repository.OAuthAccount, error) {
+	accounts, err := s.oauthAccountRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list linked accounts: %v", err)
+	}
+	return accounts, nil
+}
+
+// verifyOIDCCredentials redeems an authorization code if needed and verifies the ID token
+func (s *OAuthService) verifyOIDCCredentials(ctx context.Context, creds OIDCCredentials) (*OIDCProvider, *OIDCIdentity, error) {
+	provider, err := s.oidcProviders.Get(creds.Provider)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unknown provider %q", creds.Provider)
+	}
+
+	nonce, err := s.consumeOIDCState(ctx, provider, creds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idToken := creds.IDToken
+	if idToken == "" {
+		if creds.Code == "" || creds.RedirectURI == "" {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "id_token or code with redirect_uri is required")
+		}
+		idToken, err = provider.ExchangeCode(ctx, creds.Code, creds.RedirectURI, creds.CodeVerifier)
+		if err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"operation": "OIDCExchangeCode",
+				"provider":  creds.Provider,
+				"error":     err.Error(),
+			}).Warn("Authorization code exchange failed")
+			return nil, nil, status.Errorf(codes.Unauthenticated, "failed to redeem authorization code")
+		}
+	}
+
+	identity, err := provider.VerifyIDToken(ctx, idToken, nonce)
+	if err != nil {
+		s.logger.WithFields(logrus.Fields{
+			"operation": "OIDCVerifyIDToken",
+			"provider":  creds.Provider,
+			"error":     err.Error(),
+		}).Warn("Invalid OIDC ID token")
+		if errors.Is(err, ErrInvalidIDToken) {
+			return nil, nil, status.Errorf(codes.Unauthenticated, "invalid ID token")
+		}
+		return nil, nil, status.Errorf(codes.Unavailable, "failed to verify ID token: %v", err)
+	}
+	return provider, identity, nil
+}
+
+// consumeOIDCState uses up the state of the authorization request and returns the nonce
+// its ID token must carry
+func (s *OAuthService) consumeOIDCState(ctx context.Context, provider *OIDCProvider, creds OIDCCredentials) (string, error) {
+	if creds.State == "" {
+		return "", status.Error(codes.InvalidArgument, "state is required")
+	}
+	if s.oidcStates == nil {
+		return "", status.Error(codes.Unavailable, "OIDC login state storage is not configured")
+	}
+
+	pending, err := s.oidcStates.Consume(ctx, hashOIDCState(creds.State))
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", status.Error(codes.Unauthenticated, "login state is unknown, used or expired")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to load login state: %v", err)
+	}
+	if pending.Provider != provider.Name() {
+		return "", status.Error(codes.Unauthenticated, "login state was issued for another provider")
+	}
+	if creds.IDToken == "" && creds.RedirectURI != pending.RedirectURI {
+		return "", status.Error(codes.InvalidArgument, "redirect_uri does not match the authorization request")
+	}
+	return pending.Nonce, nil
+}
+
+// linkOIDCIdentity records the identity in oauth_accounts
+func (s *OAuthService) linkOIDCIdentity(ctx context.Context, userID string, identity *OIDCIdentity) error {
+	return s.oauthAccountRepo.Upsert(ctx, &repository.OAuthAccount{
+		UserID:            userID,
+		Provider:          identity.Provider,
+		ProviderAccountID: identity.Subject,
+		Type:              "oidc",
+	})
+}
+
+// refreshOIDCProfile fills in the avatar from the provider when the user has none
+func (s *OAuthService) refreshOIDCProfile(ctx context.Context, user *repository.User, identity *OIDCIdentity) {
+	if identity.Picture == "" || user.Avatar != "" {
+		return
+	}
+	if err := s.userRepo.UpdateAvatar(ctx, user.ID, identity.Picture); err != nil {
+		s.logger.WithFields(logrus.Fields{
+			"operation": "OIDCLogin",
+			"user_id":   user.ID,
+			"error":     err.Error(),
+		}).Warn("Failed to update avatar")
+		return
+	}
+	user.Avatar = identity.Picture
+}
+
+// createUserFromOIDC creates a new user from an OIDC identity
+func (s *OAuthService) createUserFromOIDC(ctx context.Context, provider *OIDCProvider, identity *OIDCIdentity) (*repository.User, error) {
+	username := identity.Email
+	if at := strings.Index(username, "@"); at > 0 {
+		username = username[:at]
+	}
+	if existing, _ := s.userRepo.GetByUsername(ctx, username); existing != nil {
+		username = fmt.Sprintf("%s_%d", username, time.Now().Unix()%1000)
+	}
+
+	firstName, lastName := identity.GivenName, identity.FamilyName
+	if firstName == "" && lastName == "" && identity.Name != "" {
+		parts := strings.Fields(identity.Name)
+		firstName = parts[0]
+		lastName = strings.Join(parts[1:], " ")
+	}
+
+	user := &repository.User{
+		Email:         identity.Email,
+		Username:      username,
+		FirstName:     firstName,
+		LastName:      lastName,
+		Avatar:        identity.Picture,
+		Role:          provider.MapRole(identity),
+		Status:        "ACTIVE",
+		IsActive:      true,
+		EmailVerified: identity.EmailVerified,
+		PasswordHash:  "", // No password for OIDC users
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func randomOIDCValue() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate login state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashOIDCState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
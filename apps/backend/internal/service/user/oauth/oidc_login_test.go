@@ -0,0 +1,130 @@
+package oauth
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// memoryStates is an in-memory oidcStateStore; Consume removes the state like the repository
+type memoryStates map[string]*repository.OIDCLoginState
+
+func (m memoryStates) Create(ctx context.Context, state *repository.OIDCLoginState) error {
+	m[state.StateHash] = state
+	return nil
+}
+
+func (m memoryStates) Consume(ctx context.Context, stateHash string) (*repository.OIDCLoginState, error) {
+	state, ok := m[stateHash]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	delete(m, stateHash)
+	return state, nil
+}
+
+func newStateTestService(t *testing.T, idp *testIdP) (*OAuthService, memoryStates) {
+	t.Helper()
+	provider := newTestProvider(t, idp, OIDCProviderConfig{})
+	service := NewOAuthService(nil, nil, nil, nil, nil, "", "", "", nil)
+	service.SetOIDCProviders(&ProviderRegistry{
+		providers: map[string]*OIDCProvider{provider.Name(): provider},
+		order:     []string{provider.Name()},
+	})
+	states := memoryStates{}
+	service.SetOIDCStateStore(states)
+	return service, states
+}
+
+// startLogin starts an authorization request and returns its state and nonce
+func startLogin(t *testing.T, service *OAuthService) (string, string) {
+	t.Helper()
+	auth, err := service.StartOIDCLogin(context.Background(), "keycloak", "http://localhost:3000/callback")
+	if err != nil {
+		t.Fatalf("StartOIDCLogin: %v", err)
+	}
+	parsed, err := url.Parse(auth.URL)
+	if err != nil {
+		t.Fatalf("invalid authorization URL: %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("state") != auth.State || query.Get("nonce") == "" {
+		t.Fatalf("authorization URL %s does not carry the state and nonce", auth.URL)
+	}
+	return auth.State, query.Get("nonce")
+}
+
+func TestOIDCLogin_NonceBoundToState(t *testing.T) {
+	idp := newTestIdP(t)
+	service, states := newStateTestService(t, idp)
+	ctx := context.Background()
+
+	state, nonce := startLogin(t, service)
+	if _, ok := states[hashOIDCState(state)]; !ok || len(states) != 1 {
+		t.Fatal("state was not stored hashed")
+	}
+	idToken := idp.sign(t, jwt.MapClaims{"nonce": nonce})
+	creds := OIDCCredentials{Provider: "keycloak", IDToken: idToken, State: state}
+	if _, identity, err := service.verifyOIDCCredentials(ctx, creds); err != nil || identity.Subject != "subject-123" {
+		t.Fatalf("verifyOIDCCredentials: %v", err)
+	}
+
+	// The state is used up, so the same token cannot be replayed
+	if _, _, err := service.verifyOIDCCredentials(ctx, creds); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected replay to be rejected, got %v", err)
+	}
+
+	// The code flow redeems the code with the redirect URI of the authorization request
+	state, nonce = startLogin(t, service)
+	idp.tokenCodes["code-1"] = idp.sign(t, jwt.MapClaims{"nonce": nonce})
+	creds = OIDCCredentials{Provider: "keycloak", Code: "code-1", RedirectURI: "http://localhost:3000/callback", State: state}
+	if _, _, err := service.verifyOIDCCredentials(ctx, creds); err != nil {
+		t.Fatalf("verifyOIDCCredentials with code: %v", err)
+	}
+}
+
+func TestOIDCLogin_StateRejections(t *testing.T) {
+	idp := newTestIdP(t)
+	service, _ := newStateTestService(t, idp)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		creds func() OIDCCredentials
+		code  codes.Code
+	}{
+		{"missing state", func() OIDCCredentials {
+			_, nonce := startLogin(t, service)
+			return OIDCCredentials{Provider: "keycloak", IDToken: idp.sign(t, jwt.MapClaims{"nonce": nonce})}
+		}, codes.InvalidArgument},
+		{"unknown state", func() OIDCCredentials {
+			_, nonce := startLogin(t, service)
+			return OIDCCredentials{Provider: "keycloak", IDToken: idp.sign(t, jwt.MapClaims{"nonce": nonce}), State: "forged"}
+		}, codes.Unauthenticated},
+		{"nonce of another request", func() OIDCCredentials {
+			state, _ := startLogin(t, service)
+			_, nonce := startLogin(t, service)
+			return OIDCCredentials{Provider: "keycloak", IDToken: idp.sign(t, jwt.MapClaims{"nonce": nonce}), State: state}
+		}, codes.Unauthenticated},
+		{"token without nonce", func() OIDCCredentials {
+			state, _ := startLogin(t, service)
+			return OIDCCredentials{Provider: "keycloak", IDToken: idp.sign(t, jwt.MapClaims{"nonce": nil}), State: state}
+		}, codes.Unauthenticated},
+		{"different redirect URI", func() OIDCCredentials {
+			state, _ := startLogin(t, service)
+			return OIDCCredentials{Provider: "keycloak", Code: "code-1", RedirectURI: "https://evil.example.com/cb", State: state}
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := service.verifyOIDCCredentials(ctx, tt.creds()); status.Code(err) != tt.code {
+				t.Errorf("expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,656 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors returned by OIDC providers
+var (
+	ErrUnknownProvider = errors.New("unknown OIDC provider")
+	ErrInvalidIDToken  = errors.New("invalid OIDC ID token")
+)
+
+const (
+	// defaultMetadataTTL is how long discovery documents and JWKS are cached
+	defaultMetadataTTL = time.Hour
+	// minKeyRefreshInterval limits JWKS refetches triggered by unknown key IDs
+	minKeyRefreshInterval = 30 * time.Second
+)
+
+// supportedIDTokenAlgorithms are the signing algorithms accepted for ID tokens
+var supportedIDTokenAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// ClaimMapping names the ID token claims that carry profile fields.
+// Each value may list several comma-separated claims; the first non-empty one wins.
+// Dotted names address nested claims, e.g. "realm_access.roles" for Keycloak.
+type ClaimMapping struct {
+	Email         string
+	EmailVerified string
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Avatar        string
+	Role          string
+}
+
+// OIDCProviderConfig configures one OpenID Connect identity provider
+type OIDCProviderConfig struct {
+	Name         string // Provider key used in RPCs and oauth_accounts.provider, e.g. "microsoft"
+	DisplayName  string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Claims       ClaimMapping
+
+	// RoleMapping maps role claim values to user roles; only applied when a user is created
+	RoleMapping map[string]common.UserRole
+
+	// AssumeEmailVerified treats emails as verified when the provider does not send
+	// an email_verified claim (e.g. Microsoft Entra ID)
+	AssumeEmailVerified bool
+}
+
+// DiscoveryDocument is the subset of the OIDC discovery document we use
+type DiscoveryDocument struct {
+	Issuer                           string   `json:"issuer"`
+	AuthorizationEndpoint            string   `json:"authorization_endpoint"`
+	TokenEndpoint                    string   `json:"token_endpoint"`
+	UserinfoEndpoint                 string   `json:"userinfo_endpoint"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// OIDCIdentity is the verified identity carried by an ID token
+type OIDCIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Picture       string
+	Roles         []string
+}
+
+// OIDCProvider verifies ID tokens for one provider, caching its discovery document and keys
+type OIDCProvider struct {
+	config     OIDCProviderConfig
+	httpClient *http.Client
+	logger     *logrus.Logger
+	cacheTTL   time.Duration
+	now        func() time.Time
+
+	mu                sync.Mutex
+	discovery         *DiscoveryDocument
+	discoveryLoadedAt time.Time
+	keys              map[string]crypto.PublicKey
+	keysLoadedAt      time.Time
+}
+
+// NewOIDCProvider creates a provider from configuration
+func NewOIDCProvider(config OIDCProviderConfig, httpClient *http.Client, logger *logrus.Logger) (*OIDCProvider, error) {
+	if config.Name == "" || config.IssuerURL == "" || config.ClientID == "" {
+		return nil, fmt.Errorf("OIDC provider requires name, issuer URL and client ID")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	if config.DisplayName == "" {
+		config.DisplayName = config.Name
+	}
+	config.Claims = config.Claims.withDefaults()
+
+	return &OIDCProvider{
+		config:     config,
+		httpClient: httpClient,
+		logger:     logger,
+		cacheTTL:   defaultMetadataTTL,
+		now:        time.Now,
+	}, nil
+}
+
+// withDefaults fills unset claim names with the standard OIDC claims
+func (m ClaimMapping) withDefaults() ClaimMapping {
+	if m.Email == "" {
+		m.Email = "email"
+	}
+	if m.EmailVerified == "" {
+		m.EmailVerified = "email_verified"
+	}
+	if m.Name == "" {
+		m.Name = "name"
+	}
+	if m.GivenName == "" {
+		m.GivenName = "given_name"
+	}
+	if m.FamilyName == "" {
+		m.FamilyName = "family_name"
+	}
+	if m.Avatar == "" {
+		m.Avatar = "picture"
+	}
+	return m
+}
+
+// Name returns the provider key
+func (p *OIDCProvider) Name() string {
+	return p.config.Name
+}
+
+// Config returns the provider configuration
+func (p *OIDCProvider) Config() OIDCProviderConfig {
+	return p.config
+}
+
+// Discovery returns the provider's discovery document, fetching it when the cache is stale
+func (p *OIDCProvider) Discovery(ctx context.Context) (*DiscoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.discoveryLocked(ctx)
+}
+
+func (p *OIDCProvider) discoveryLocked(ctx context.Context) (*DiscoveryDocument, error) {
+	if p.discovery != nil && p.now().Sub(p.discoveryLoadedAt) < p.cacheTTL {
+		return p.discovery, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(p.config.IssuerURL, "/") + "/.well-known/openid-configuration"
+	var doc DiscoveryDocument
+	if err := p.getJSON(ctx, discoveryURL, &doc); err != nil {
+		if p.discovery != nil {
+			// Keep serving the stale document while the provider is unreachable
+			p.logger.WithFields(logrus.Fields{
+				"operation": "OIDCDiscovery",
+				"provider":  p.config.Name,
+				"error":     err.Error(),
+			}).Warn("Failed to refresh discovery document, using cached copy")
+			return p.discovery, nil
+		}
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+	if doc.Issuer == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document for %s is missing issuer or jwks_uri", p.config.Name)
+	}
+
+	p.discovery = &doc
+	p.discoveryLoadedAt = p.now()
+	return p.discovery, nil
+}
+
+// AuthorizationURL builds the authorization request URL for the frontend redirect
+func (p *OIDCProvider) AuthorizationURL(ctx context.Context, redirectURI, state, nonce string) (string, error) {
+	doc, err := p.Discovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Set("client_id", p.config.ClientID)
+	params.Set("response_type", "code")
+	params.Set("scope", strings.Join(p.config.Scopes, " "))
+	params.Set("redirect_uri", redirectURI)
+	if state != "" {
+		params.Set("state", state)
+	}
+	if nonce != "" {
+		params.Set("nonce", nonce)
+	}
+	return doc.AuthorizationEndpoint + "?" + params.Encode(), nil
+}
+
+// ExchangeCode redeems an authorization code and returns the ID token
+func (p *OIDCProvider) ExchangeCode(ctx context.Context, code, redirectURI, codeVerifier string) (string, error) {
+	doc, err := p.Discovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("client_id", p.config.ClientID)
+	if p.config.ClientSecret != "" {
+		form.Set("client_secret", p.config.ClientSecret)
+	}
+	if codeVerifier != "" {
+		form.Set("code_verifier", codeVerifier)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return "", fmt.Errorf("token exchange failed: %s", string(body))
+	}
+
+	var tokenResp TokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if tokenResp.IDToken == "" {
+		return "", fmt.Errorf("token response did not include an ID token")
+	}
+	return tokenResp.IDToken, nil
+}
+
+// VerifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID
+// token and maps its claims to an identity. The nonce is required.
+func (p *OIDCProvider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*OIDCIdentity, error) {
+	doc, err := p.Discovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.verificationKey(ctx, kid)
+	},
+		jwt.WithValidMethods(p.allowedAlgorithms(doc)),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	// Multi-tenant issuers such as Microsoft's "common" endpoint publish a
+	// templated issuer that has to be completed with the token's tenant ID
+	expectedIssuer := doc.Issuer
+	if strings.Contains(expectedIssuer, "{tenantid}") {
+		tenantID, _ := claims["tid"].(string)
+		if tenantID == "" {
+			return nil, fmt.Errorf("%w: missing tenant ID", ErrInvalidIDToken)
+		}
+		expectedIssuer = strings.ReplaceAll(expectedIssuer, "{tenantid}", tenantID)
+	}
+	if issuer, _ := claims["iss"].(string); issuer != expectedIssuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidIDToken, issuer)
+	}
+
+	// The nonce ties the token to the authorization request it was issued for
+	if nonce == "" {
+		return nil, fmt.Errorf("%w: no expected nonce", ErrInvalidIDToken)
+	}
+	tokenNonce, _ := claims["nonce"].(string)
+	if tokenNonce == "" {
+		return nil, fmt.Errorf("%w: missing nonce", ErrInvalidIDToken)
+	}
+	if subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	identity := p.mapClaims(claims)
+	if identity.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+	return identity, nil
+}
+
+// allowedAlgorithms narrows the accepted algorithms to those the provider advertises
+func (p *OIDCProvider) allowedAlgorithms(doc *DiscoveryDocument) []string {
+	if len(doc.IDTokenSigningAlgValuesSupported) == 0 {
+		return supportedIDTokenAlgorithms
+	}
+	var allowed []string
+	for _, alg := range doc.IDTokenSigningAlgValuesSupported {
+		for _, supported := range supportedIDTokenAlgorithms {
+			if alg == supported {
+				allowed = append(allowed, alg)
+			}
+		}
+	}
+	return allowed
+}
+
+// mapClaims applies the provider's claim mapping
+func (p *OIDCProvider) mapClaims(claims jwt.MapClaims) *OIDCIdentity {
+	mapping := p.config.Claims
+	identity := &OIDCIdentity{
+		Provider:   p.config.Name,
+		Email:      strings.ToLower(firstClaim(claims, mapping.Email)),
+		Name:       firstClaim(claims, mapping.Name),
+		GivenName:  firstClaim(claims, mapping.GivenName),
+		FamilyName: firstClaim(claims, mapping.FamilyName),
+		Picture:    firstClaim(claims, mapping.Avatar),
+	}
+	identity.Subject, _ = claims["sub"].(string)
+
+	verified, present := claimBool(claims, mapping.EmailVerified)
+	identity.EmailVerified = verified || (!present && p.config.AssumeEmailVerified && identity.Email != "")
+
+	if mapping.Role != "" {
+		for _, name := range splitClaimNames(mapping.Role) {
+			identity.Roles = append(identity.Roles, claimStrings(claims, name)...)
+		}
+	}
+	return identity
+}
+
+// MapRole returns the role for a new user, falling back to student
+func (p *OIDCProvider) MapRole(identity *OIDCIdentity) common.UserRole {
+	for _, role := range identity.Roles {
+		if mapped, ok := p.config.RoleMapping[role]; ok {
+			return mapped
+		}
+	}
+	return common.UserRole_USER_ROLE_STUDENT
+}
+
+// verificationKey returns the JWKS key for kid, refetching the key set when the
+// kid is unknown so provider key rotation is picked up without a restart
+func (p *OIDCProvider) verificationKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stale := p.keys == nil || p.now().Sub(p.keysLoadedAt) >= p.cacheTTL
+	if !stale {
+		if key, ok := p.lookupKeyLocked(kid); ok {
+			return key, nil
+		}
+		if p.now().Sub(p.keysLoadedAt) < minKeyRefreshInterval {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+	}
+
+	if err := p.loadKeysLocked(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := p.lookupKeyLocked(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (p *OIDCProvider) lookupKeyLocked(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *OIDCProvider) loadKeysLocked(ctx context.Context) error {
+	doc, err := p.discoveryLocked(ctx)
+	if err != nil {
+		return err
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, doc.JWKSURI, &set); err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			p.logger.WithFields(logrus.Fields{
+				"operation": "OIDCLoadKeys",
+				"provider":  p.config.Name,
+				"kid":       jwk.KeyID,
+				"error":     err.Error(),
+			}).Warn("Skipping unsupported JWKS key")
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	p.keys = keys
+	p.keysLoadedAt = p.now()
+	return nil
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, rawURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, rawURL)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+// jsonWebKey is a public key from a JWKS document
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+// splitClaimNames splits a comma-separated list of claim names
+func splitClaimNames(names string) []string {
+	var result []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// lookupClaim resolves a dotted claim path
+func lookupClaim(claims map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = claims
+	for _, part := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// firstClaim returns the first non-empty string among the named claims
+func firstClaim(claims jwt.MapClaims, names string) string {
+	for _, name := range splitClaimNames(names) {
+		if value, ok := lookupClaim(claims, name); ok {
+			if s, ok := value.(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// claimBool reads a boolean claim; some providers send "true"/"false" strings
+func claimBool(claims jwt.MapClaims, names string) (value bool, present bool) {
+	for _, name := range splitClaimNames(names) {
+		raw, ok := lookupClaim(claims, name)
+		if !ok {
+			continue
+		}
+		switch v := raw.(type) {
+		case bool:
+			return v, true
+		case string:
+			return strings.EqualFold(v, "true"), true
+		}
+	}
+	return false, false
+}
+
+// claimStrings reads a claim that may be a single string or a list of strings
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	raw, ok := lookupClaim(claims, name)
+	if !ok {
+		return nil
+	}
+	switch v := raw.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// ProviderRegistry holds the configured OIDC providers
+type ProviderRegistry struct {
+	providers map[string]*OIDCProvider
+	order     []string
+}
+
+// NewProviderRegistry creates providers for every configuration entry
+func NewProviderRegistry(configs []OIDCProviderConfig, httpClient *http.Client, logger *logrus.Logger) (*ProviderRegistry, error) {
+	registry := &ProviderRegistry{providers: make(map[string]*OIDCProvider)}
+	for _, config := range configs {
+		if config.Name == "google" {
+			return nil, fmt.Errorf("OIDC provider name %q is reserved for GoogleLogin", config.Name)
+		}
+		if _, exists := registry.providers[config.Name]; exists {
+			return nil, fmt.Errorf("duplicate OIDC provider %q", config.Name)
+		}
+		provider, err := NewOIDCProvider(config, httpClient, logger)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OIDC provider %q: %w", config.Name, err)
+		}
+		registry.providers[config.Name] = provider
+		registry.order = append(registry.order, config.Name)
+	}
+	return registry, nil
+}
+
+// Get returns the named provider
+func (r *ProviderRegistry) Get(name string) (*OIDCProvider, error) {
+	if r == nil {
+		return nil, ErrUnknownProvider
+	}
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return provider, nil
+}
+
+// Providers returns the providers in configuration order
+func (r *ProviderRegistry) Providers() []*OIDCProvider {
+	if r == nil {
+		return nil
+	}
+	providers := make([]*OIDCProvider, 0, len(r.order))
+	for _, name := range r.order {
+		providers = append(providers, r.providers[name])
+	}
+	return providers
+}
@@ -0,0 +1,296 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testIdP is a local stand-in OIDC server serving discovery, JWKS and a token endpoint
+type testIdP struct {
+	server     *httptest.Server
+	issuer     string // Advertised issuer; defaults to the server URL
+	key        *rsa.PrivateKey
+	kid        string
+	jwksHits   int32
+	tokenCodes map[string]string // authorization code -> ID token
+}
+
+func newTestIdP(t *testing.T) *testIdP {
+	t.Helper()
+	idp := &testIdP{key: newRSAKey(t), kid: "key-1", tokenCodes: make(map[string]string)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := idp.issuer
+		if issuer == "" {
+			issuer = idp.server.URL
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                issuer,
+			"authorization_endpoint":                idp.server.URL + "/authorize",
+			"token_endpoint":                        idp.server.URL + "/token",
+			"jwks_uri":                              idp.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&idp.jwksHits, 1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": idp.kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		idToken, ok := idp.tokenCodes[r.Form.Get("code")]
+		if !ok || r.Form.Get("client_id") != "nynus-web" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idToken, "token_type": "Bearer"})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+func (idp *testIdP) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	now := time.Now()
+	base := jwt.MapClaims{
+		"iss":   idp.server.URL,
+		"aud":   "nynus-web",
+		"sub":   "subject-123",
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": "nonce-1",
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(base, k)
+			continue
+		}
+		base[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, base)
+	token.Header["kid"] = idp.kid
+	signed, err := token.SignedString(idp.key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func newTestProvider(t *testing.T, idp *testIdP, config OIDCProviderConfig) *OIDCProvider {
+	t.Helper()
+	config.Name = "keycloak"
+	config.IssuerURL = idp.server.URL
+	config.ClientID = "nynus-web"
+	provider, err := NewOIDCProvider(config, idp.server.Client(), nil)
+	if err != nil {
+		t.Fatalf("NewOIDCProvider: %v", err)
+	}
+	return provider
+}
+
+func TestVerifyIDToken_MapsClaims(t *testing.T) {
+	idp := newTestIdP(t)
+	provider := newTestProvider(t, idp, OIDCProviderConfig{
+		Claims: ClaimMapping{Email: "email,preferred_username", Role: "realm_access.roles"},
+		RoleMapping: map[string]common.UserRole{
+			"teacher": common.UserRole_USER_ROLE_TEACHER,
+		},
+	})
+
+	identity, err := provider.VerifyIDToken(context.Background(), idp.sign(t, jwt.MapClaims{
+		"preferred_username": "Teacher@School.edu.vn",
+		"email_verified":     true,
+		"name":               "Tran Thi B",
+		"realm_access":       map[string]interface{}{"roles": []string{"offline_access", "teacher"}},
+		"nonce":              "nonce-1",
+	}), "nonce-1")
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+
+	if identity.Subject != "subject-123" || identity.Provider != "keycloak" {
+		t.Errorf("unexpected identity: %+v", identity)
+	}
+	if identity.Email != "teacher@school.edu.vn" || !identity.EmailVerified {
+		t.Errorf("expected fallback email claim, got %q verified=%v", identity.Email, identity.EmailVerified)
+	}
+	if role := provider.MapRole(identity); role != common.UserRole_USER_ROLE_TEACHER {
+		t.Errorf("got role %v, want teacher", role)
+	}
+}
+
+func TestVerifyIDToken_Rejections(t *testing.T) {
+	idp := newTestIdP(t)
+	provider := newTestProvider(t, idp, OIDCProviderConfig{})
+	otherKey := newRSAKey(t)
+
+	forged := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": idp.server.URL, "aud": "nynus-web", "sub": "subject-123",
+		"iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix(), "nonce": "nonce-1",
+	})
+	forged.Header["kid"] = idp.kid
+	forgedToken, _ := forged.SignedString(otherKey)
+
+	tests := []struct {
+		name  string
+		token string
+		nonce string
+	}{
+		{"wrong audience", idp.sign(t, jwt.MapClaims{"aud": "another-app"}), "nonce-1"},
+		{"wrong issuer", idp.sign(t, jwt.MapClaims{"iss": "https://evil.example.com"}), "nonce-1"},
+		{"expired", idp.sign(t, jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}), "nonce-1"},
+		{"missing expiry", idp.sign(t, jwt.MapClaims{"exp": nil}), "nonce-1"},
+		{"nonce mismatch", idp.sign(t, jwt.MapClaims{"nonce": "other"}), "nonce-1"},
+		{"bad signature", forgedToken, "nonce-1"},
+		{"missing nonce", idp.sign(t, jwt.MapClaims{"nonce": nil}), "nonce-1"},
+		{"no expected nonce", idp.sign(t, nil), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := provider.VerifyIDToken(context.Background(), tt.token, tt.nonce)
+			if !errors.Is(err, ErrInvalidIDToken) {
+				t.Errorf("expected ErrInvalidIDToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyIDToken_KeyRotation(t *testing.T) {
+	idp := newTestIdP(t)
+	provider := newTestProvider(t, idp, OIDCProviderConfig{})
+	clock := time.Now()
+	provider.now = func() time.Time { return clock }
+	ctx := context.Background()
+
+	if _, err := provider.VerifyIDToken(ctx, idp.sign(t, nil), "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if _, err := provider.VerifyIDToken(ctx, idp.sign(t, nil), "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if hits := atomic.LoadInt32(&idp.jwksHits); hits != 1 {
+		t.Errorf("expected JWKS to be cached, fetched %d times", hits)
+	}
+
+	// The IdP rotates its key; the unknown kid triggers one refetch
+	idp.key = newRSAKey(t)
+	idp.kid = "key-2"
+	clock = clock.Add(time.Minute)
+	if _, err := provider.VerifyIDToken(ctx, idp.sign(t, nil), "nonce-1"); err != nil {
+		t.Fatalf("VerifyIDToken after rotation: %v", err)
+	}
+	if hits := atomic.LoadInt32(&idp.jwksHits); hits != 2 {
+		t.Errorf("expected one refetch after rotation, fetched %d times", hits)
+	}
+}
+
+func TestVerifyIDToken_TenantIssuer(t *testing.T) {
+	idp := newTestIdP(t)
+	provider := newTestProvider(t, idp, OIDCProviderConfig{AssumeEmailVerified: true})
+	idp.issuer = "https://login.example.com/{tenantid}/v2.0"
+
+	identity, err := provider.VerifyIDToken(context.Background(), idp.sign(t, jwt.MapClaims{
+		"iss":   "https://login.example.com/tenant-a/v2.0",
+		"tid":   "tenant-a",
+		"email": "student@school.edu.vn",
+	}), "nonce-1")
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if !identity.EmailVerified {
+		t.Error("expected email to be treated as verified")
+	}
+
+	_, err = provider.VerifyIDToken(context.Background(), idp.sign(t, jwt.MapClaims{
+		"iss": "https://login.example.com/tenant-a/v2.0",
+		"tid": "tenant-b",
+	}), "nonce-1")
+	if !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("expected tenant mismatch to be rejected, got %v", err)
+	}
+}
+
+func TestExchangeCode(t *testing.T) {
+	idp := newTestIdP(t)
+	provider := newTestProvider(t, idp, OIDCProviderConfig{})
+	idp.tokenCodes["code-1"] = idp.sign(t, nil)
+
+	idToken, err := provider.ExchangeCode(context.Background(), "code-1", "http://localhost:3000/callback", "")
+	if err != nil {
+		t.Fatalf("ExchangeCode: %v", err)
+	}
+	if _, err := provider.VerifyIDToken(context.Background(), idToken, "nonce-1"); err != nil {
+		t.Errorf("VerifyIDToken: %v", err)
+	}
+
+	if _, err := provider.ExchangeCode(context.Background(), "unknown", "http://localhost:3000/callback", ""); err == nil {
+		t.Error("expected unknown code to fail")
+	}
+
+	authURL, err := provider.AuthorizationURL(context.Background(), "http://localhost:3000/callback", "state-1", "nonce-1")
+	if err != nil {
+		t.Fatalf("AuthorizationURL: %v", err)
+	}
+	if !strings.HasPrefix(authURL, idp.server.URL+"/authorize?") || !strings.Contains(authURL, "nonce=nonce-1") {
+		t.Errorf("unexpected authorization URL: %s", authURL)
+	}
+}
+
+func TestProviderRegistry(t *testing.T) {
+	registry, err := NewProviderRegistry([]OIDCProviderConfig{
+		{Name: "microsoft", IssuerURL: "https://login.microsoftonline.com/common/v2.0", ClientID: "a"},
+		{Name: "keycloak", IssuerURL: "https://sso.school.edu.vn/realms/main", ClientID: "b"},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewProviderRegistry: %v", err)
+	}
+	if providers := registry.Providers(); len(providers) != 2 || providers[0].Name() != "microsoft" {
+		t.Errorf("expected providers in configuration order, got %d", len(providers))
+	}
+	if _, err := registry.Get("github"); !errors.Is(err, ErrUnknownProvider) {
+		t.Errorf("expected ErrUnknownProvider, got %v", err)
+	}
+
+	for _, configs := range [][]OIDCProviderConfig{
+		{{Name: "google", IssuerURL: "https://accounts.google.com", ClientID: "a"}},
+		{{Name: "dup", IssuerURL: "https://a", ClientID: "a"}, {Name: "dup", IssuerURL: "https://b", ClientID: "b"}},
+		{{Name: "missing-client", IssuerURL: "https://a"}},
+	} {
+		if _, err := NewProviderRegistry(configs, nil, nil); err == nil {
+			t.Errorf("expected configuration %+v to be rejected", configs)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package passwordless
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+// Errors returned by the passwordless service
+var (
+	ErrDisabled       = errors.New("passwordless login is disabled")
+	ErrInvalidCode    = errors.New("invalid or expired login code")
+	ErrDeviceMismatch = errors.New("login code was requested from a different device")
+)
+
+// codeStore is the persistence used by the service, implemented by repository.LoginCodeRepository
+type codeStore interface {
+	Create(ctx context.Context, code *repository.LoginCode) error
+	GetLatestForUser(ctx context.Context, userID string) (*repository.LoginCode, error)
+	GetByLinkTokenHash(ctx context.Context, linkTokenHash string) (*repository.LoginCode, error)
+	IncrementAttempts(ctx context.Context, id string) (int, error)
+	MarkUsed(ctx context.Context, id string) (bool, error)
+}
+
+// userLookup resolves users, implemented by repository.IUserRepository
+type userLookup interface {
+	GetByEmail(ctx context.Context, email string) (*repository.User, error)
+	GetByID(ctx context.Context, id string) (*repository.User, error)
+}
+
+// mailer sends the login email, implemented by email.EmailService
+type mailer interface {
+	SendLoginCodeEmail(toEmail, userName, code, linkToken string, ttl time.Duration) error
+}
+
+// Config controls code lifetime and throttling
+type Config struct {
+	Enabled        bool
+	CodeTTL        time.Duration
+	MaxAttempts    int
+	ResendCooldown time.Duration // Minimum time between two emails to the same user
+	HashKey        string        // HMAC key for stored code hashes
+}
+
+// ClientInfo identifies the requesting device
+type ClientInfo struct {
+	IPAddress         string
+	UserAgent         string
+	DeviceFingerprint string
+}
+
+// PasswordlessService issues and verifies email login codes and magic links
+type PasswordlessService struct {
+	store  codeStore
+	users  userLookup
+	mailer mailer
+	config Config
+	now    func() time.Time
+}
+
+// NewPasswordlessService creates a new passwordless login service
+func NewPasswordlessService(store codeStore, users userLookup, mailer mailer, config Config) *PasswordlessService {
+	if config.CodeTTL <= 0 {
+		config.CodeTTL = 10 * time.Minute
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.ResendCooldown <= 0 {
+		config.ResendCooldown = time.Minute
+	}
+
+	return &PasswordlessService{
+		store:  store,
+		users:  users,
+		mailer: mailer,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Enabled reports whether passwordless login is turned on
+func (s *PasswordlessService) Enabled() bool {
+	return s.config.Enabled
+}
+
+// CodeTTL returns how long an issued code stays valid
+func (s *PasswordlessService) CodeTTL() time.Duration {
+	return s.config.CodeTTL
+}
+
+// RequestLoginCode emails a code and magic link to the user. Unknown, inactive or
+// throttled addresses are silently ignored so the response never reveals whether
+// an account exists.
+func (s *PasswordlessService) RequestLoginCode(ctx context.Context, email string, client ClientInfo) error {
+	if !s.config.Enabled {
+		return ErrDisabled
+	}
+
+	user, err := s.users.GetByEmail(ctx, strings.TrimSpace(email))
+	if err != nil || user == nil {
+		log.Printf("[Passwordless] Login code requested for unknown email: %s", email)
+		return nil
+	}
+	if !user.IsActive {
+		log.Printf("[Passwordless] Login code requested for inactive user %s", user.ID)
+		return nil
+	}
+
+	latest, err := s.store.GetLatestForUser(ctx, user.ID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+	if latest != nil && s.now().Sub(latest.CreatedAt) < s.config.ResendCooldown {
+		log.Printf("[Passwordless] Login code for user %s throttled", user.ID)
+		return nil
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		return err
+	}
+	linkToken, err := generateLinkToken()
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.Create(ctx, &repository.LoginCode{
+		UserID:            user.ID,
+		CodeHash:          s.hash(code),
+		LinkTokenHash:     s.hash(linkToken),
+		DeviceFingerprint: client.DeviceFingerprint,
+		IPAddress:         client.IPAddress,
+		UserAgent:         client.UserAgent,
+		ExpiresAt:         s.now().Add(s.config.CodeTTL),
+	}); err != nil {
+		return err
+	}
+
+	if s.mailer != nil {
+		userName := strings.TrimSpace(user.FirstName + " " + user.LastName)
+		if err := s.mailer.SendLoginCodeEmail(user.Email, userName, code, linkToken, s.config.CodeTTL); err != nil {
+			return fmt.Errorf("failed to send login code email: %w", err)
+		}
+	}
+	return nil
+}
+
+// VerifyLoginCode checks the 6-digit code most recently sent to email and returns
+// the user it logs in
+func (s *PasswordlessService) VerifyLoginCode(ctx context.Context, email, code string, client ClientInfo) (*repository.User, error) {
+	if !s.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	user, err := s.users.GetByEmail(ctx, strings.TrimSpace(email))
+	if err != nil || user == nil {
+		return nil, ErrInvalidCode
+	}
+
+	loginCode, err := s.store.GetLatestForUser(ctx, user.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidCode
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUsable(ctx, loginCode, client); err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal([]byte(s.hash(strings.TrimSpace(code))), []byte(loginCode.CodeHash)) {
+		if _, err := s.store.IncrementAttempts(ctx, loginCode.ID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCode
+	}
+
+	if err := s.consume(ctx, loginCode); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// VerifyLoginLink checks a magic-link token and returns the user it logs in
+func (s *PasswordlessService) VerifyLoginLink(ctx context.Context, linkToken string, client ClientInfo) (*repository.User, error) {
+	if !s.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	loginCode, err := s.store.GetByLinkTokenHash(ctx, s.hash(linkToken))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrInvalidCode
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUsable(ctx, loginCode, client); err != nil {
+		return nil, err
+	}
+	if err := s.consume(ctx, loginCode); err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, loginCode.UserID)
+	if err != nil {
+		return nil, ErrInvalidCode
+	}
+	return user, nil
+}
+
+// checkUsable rejects used, expired and exhausted codes, and codes requested from another device
+func (s *PasswordlessService) checkUsable(ctx context.Context, loginCode *repository.LoginCode, client ClientInfo) error {
+	if loginCode.UsedAt != nil || !s.now().Before(loginCode.ExpiresAt) || loginCode.Attempts >= s.config.MaxAttempts {
+		return ErrInvalidCode
+	}
+	if !hmac.Equal([]byte(loginCode.DeviceFingerprint), []byte(client.DeviceFingerprint)) {
+		// Counts as a failed attempt so a stolen code cannot be retried indefinitely elsewhere
+		if _, err := s.store.IncrementAttempts(ctx, loginCode.ID); err != nil {
+			return err
+		}
+		return ErrDeviceMismatch
+	}
+	return nil
+}
+
+func (s *PasswordlessService) consume(ctx context.Context, loginCode *repository.LoginCode) error {
+	used, err := s.store.MarkUsed(ctx, loginCode.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+// hash returns the stored form of a code or link token
+func (s *PasswordlessService) hash(value string) string {
+	mac := hmac.New(sha256.New, []byte(s.config.HashKey))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// generateCode returns a uniformly random 6-digit code
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate login code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// generateLinkToken returns a URL-safe magic-link token
+func generateLinkToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate login link: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
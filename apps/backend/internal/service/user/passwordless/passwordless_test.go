@@ -0,0 +1,261 @@
+package passwordless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+var testDevice = ClientInfo{IPAddress: "203.0.113.7", UserAgent: "test-agent", DeviceFingerprint: "device-a"}
+
+func TestCodeLoginIsSingleUse(t *testing.T) {
+	s, store, mail, _ := newTestService()
+	ctx := context.Background()
+
+	if err := s.RequestLoginCode(ctx, "student@example.com", testDevice); err != nil {
+		t.Fatalf("RequestLoginCode: %v", err)
+	}
+	if len(mail.sent) != 1 || len(mail.sent[0].code) != 6 {
+		t.Fatalf("expected one email with a 6-digit code, got %+v", mail.sent)
+	}
+	for _, code := range store.codes {
+		if code.CodeHash == mail.sent[0].code || code.LinkTokenHash == mail.sent[0].linkToken {
+			t.Fatal("code stored in plain text")
+		}
+	}
+
+	user, err := s.VerifyLoginCode(ctx, "student@example.com", mail.sent[0].code, testDevice)
+	if err != nil {
+		t.Fatalf("VerifyLoginCode: %v", err)
+	}
+	if user.ID != "user-1" {
+		t.Errorf("got user %s, want user-1", user.ID)
+	}
+
+	if _, err := s.VerifyLoginCode(ctx, "student@example.com", mail.sent[0].code, testDevice); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected reused code to be rejected, got %v", err)
+	}
+	if _, err := s.VerifyLoginLink(ctx, mail.sent[0].linkToken, testDevice); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected link of a used code to be rejected, got %v", err)
+	}
+}
+
+func TestMagicLinkLogin(t *testing.T) {
+	s, _, mail, _ := newTestService()
+	ctx := context.Background()
+
+	if err := s.RequestLoginCode(ctx, "student@example.com", testDevice); err != nil {
+		t.Fatalf("RequestLoginCode: %v", err)
+	}
+
+	otherDevice := testDevice
+	otherDevice.DeviceFingerprint = "device-b"
+	if _, err := s.VerifyLoginLink(ctx, mail.sent[0].linkToken, otherDevice); !errors.Is(err, ErrDeviceMismatch) {
+		t.Errorf("expected device mismatch, got %v", err)
+	}
+
+	user, err := s.VerifyLoginLink(ctx, mail.sent[0].linkToken, testDevice)
+	if err != nil {
+		t.Fatalf("VerifyLoginLink: %v", err)
+	}
+	if user.ID != "user-1" {
+		t.Errorf("got user %s, want user-1", user.ID)
+	}
+}
+
+func TestCodeExpiryAndAttemptLimit(t *testing.T) {
+	s, _, mail, clock := newTestService()
+	ctx := context.Background()
+
+	if err := s.RequestLoginCode(ctx, "student@example.com", testDevice); err != nil {
+		t.Fatalf("RequestLoginCode: %v", err)
+	}
+	code := mail.sent[0].code
+	wrong := fmt.Sprintf("%06d", (atoi(code)+1)%1000000)
+
+	for i := 0; i < 3; i++ {
+		if _, err := s.VerifyLoginCode(ctx, "student@example.com", wrong, testDevice); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("attempt %d: expected ErrInvalidCode, got %v", i, err)
+		}
+	}
+	// The correct code no longer works once the attempt budget is spent
+	if _, err := s.VerifyLoginCode(ctx, "student@example.com", code, testDevice); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected exhausted code to be rejected, got %v", err)
+	}
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	if err := s.RequestLoginCode(ctx, "student@example.com", testDevice); err != nil {
+		t.Fatalf("RequestLoginCode: %v", err)
+	}
+	clock.now = clock.now.Add(6 * time.Minute)
+	if _, err := s.VerifyLoginCode(ctx, "student@example.com", mail.sent[1].code, testDevice); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected expired code to be rejected, got %v", err)
+	}
+}
+
+func TestRequestDoesNotRevealAccounts(t *testing.T) {
+	s, _, mail, clock := newTestService()
+	ctx := context.Background()
+
+	for _, email := range []string{"nobody@example.com", "inactive@example.com"} {
+		if err := s.RequestLoginCode(ctx, email, testDevice); err != nil {
+			t.Errorf("%s: expected silent success, got %v", email, err)
+		}
+	}
+	if len(mail.sent) != 0 {
+		t.Fatalf("expected no email, got %d", len(mail.sent))
+	}
+
+	// A second request inside the cooldown is accepted but not sent
+	_ = s.RequestLoginCode(ctx, "student@example.com", testDevice)
+	_ = s.RequestLoginCode(ctx, "student@example.com", testDevice)
+	if len(mail.sent) != 1 {
+		t.Errorf("expected cooldown to suppress the second email, got %d", len(mail.sent))
+	}
+
+	// A new code invalidates the previous one
+	clock.now = clock.now.Add(2 * time.Minute)
+	_ = s.RequestLoginCode(ctx, "student@example.com", testDevice)
+	if _, err := s.VerifyLoginLink(ctx, mail.sent[0].linkToken, testDevice); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected superseded link to be rejected, got %v", err)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	s := NewPasswordlessService(newMemoryStore(), &memoryUsers{}, &memoryMailer{}, Config{})
+	if err := s.RequestLoginCode(context.Background(), "student@example.com", testDevice); !errors.Is(err, ErrDisabled) {
+		t.Errorf("expected ErrDisabled, got %v", err)
+	}
+}
+
+func atoi(s string) int {
+	var n int
+	fmt.Sscanf(s, "%d", &n)
+	return n
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func newTestService() (*PasswordlessService, *memoryStore, *memoryMailer, *testClock) {
+	clock := &testClock{now: time.Unix(1700000000, 0)}
+	store := newMemoryStore()
+	store.now = func() time.Time { return clock.now }
+	mail := &memoryMailer{}
+	users := &memoryUsers{users: []*repository.User{
+		{ID: "user-1", Email: "student@example.com", FirstName: "An", LastName: "Nguyen", IsActive: true},
+		{ID: "user-2", Email: "inactive@example.com", IsActive: false},
+	}}
+	s := NewPasswordlessService(store, users, mail, Config{
+		Enabled:        true,
+		CodeTTL:        5 * time.Minute,
+		MaxAttempts:    3,
+		ResendCooldown: time.Minute,
+		HashKey:        "test-key",
+	})
+	s.now = func() time.Time { return clock.now }
+	return s, store, mail, clock
+}
+
+type memoryStore struct {
+	codes []*repository.LoginCode
+	now   func() time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{now: time.Now}
+}
+
+func (m *memoryStore) Create(_ context.Context, code *repository.LoginCode) error {
+	now := m.now()
+	for _, existing := range m.codes {
+		if existing.UserID == code.UserID && existing.UsedAt == nil {
+			existing.UsedAt = &now
+		}
+	}
+	code.ID = fmt.Sprintf("code-%d", len(m.codes)+1)
+	code.CreatedAt = now
+	m.codes = append(m.codes, code)
+	return nil
+}
+
+func (m *memoryStore) GetLatestForUser(_ context.Context, userID string) (*repository.LoginCode, error) {
+	for i := len(m.codes) - 1; i >= 0; i-- {
+		if m.codes[i].UserID == userID {
+			copied := *m.codes[i]
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) GetByLinkTokenHash(_ context.Context, linkTokenHash string) (*repository.LoginCode, error) {
+	for _, code := range m.codes {
+		if code.LinkTokenHash == linkTokenHash {
+			copied := *code
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) IncrementAttempts(_ context.Context, id string) (int, error) {
+	for _, code := range m.codes {
+		if code.ID == id {
+			code.Attempts++
+			return code.Attempts, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+
+func (m *memoryStore) MarkUsed(_ context.Context, id string) (bool, error) {
+	for _, code := range m.codes {
+		if code.ID == id && code.UsedAt == nil {
+			now := m.now()
+			code.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type memoryUsers struct {
+	users []*repository.User
+}
+
+func (m *memoryUsers) GetByEmail(_ context.Context, email string) (*repository.User, error) {
+	for _, user := range m.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryUsers) GetByID(_ context.Context, id string) (*repository.User, error) {
+	for _, user := range m.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+type sentEmail struct {
+	to, code, linkToken string
+}
+
+type memoryMailer struct {
+	sent []sentEmail
+}
+
+func (m *memoryMailer) SendLoginCodeEmail(toEmail, userName, code, linkToken string, ttl time.Duration) error {
+	m.sent = append(m.sent, sentEmail{to: toEmail, code: code, linkToken: linkToken})
+	return nil
+}
@@ -0,0 +1,93 @@
+package privacy
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// ProcessDueDeletions erases the accounts whose grace period has ended and returns how
+// many were erased
+func (s *Service) ProcessDueDeletions(ctx context.Context) (int, error) {
+	due, err := s.store.ListDueDeletions(ctx, s.now(), s.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	erased := 0
+	for _, req := range due {
+		ok, err := s.store.EraseUser(ctx, req.ID, req.UserID)
+		if err != nil {
+			log.Printf("[WARN] [Privacy] Failed to erase account %s: %v", req.UserID, err)
+			continue
+		}
+		if ok {
+			erased++
+		}
+	}
+	return erased, nil
+}
+
+// runOnce performs one pass of the background worker
+func (s *Service) runOnce(ctx context.Context) {
+	if n, err := s.store.ExpireExports(ctx, s.now()); err != nil {
+		log.Printf("[ERROR] [Privacy] Failed to expire data exports: %v", err)
+	} else if n > 0 {
+		log.Printf("[INFO] [Privacy] Expired %d data exports", n)
+	}
+
+	if n, err := s.ProcessPendingExports(ctx); err != nil {
+		log.Printf("[ERROR] [Privacy] Data export run failed: %v", err)
+	} else if n > 0 {
+		log.Printf("[INFO] [Privacy] Completed %d data exports", n)
+	}
+
+	if n, err := s.ProcessDueDeletions(ctx); err != nil {
+		log.Printf("[ERROR] [Privacy] Account deletion run failed: %v", err)
+	} else if n > 0 {
+		log.Printf("[INFO] [Privacy] Erased %d accounts", n)
+	}
+}
+
+// Start processes exports and due deletions on a schedule until Stop is called
+func (s *Service) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.config.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+				s.runOnce(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop ends the worker loop started by Start
+func (s *Service) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		s.wg.Wait()
+	}
+}
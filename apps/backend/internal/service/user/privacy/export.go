@@ -0,0 +1,124 @@
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+const exportReadme = `Personal data export
+====================
+
+This archive contains the personal data held about your account.
+Each JSON file is one category and holds one object per record:
+
+  profile.json         Account details (password hash removed)
+  preferences.json     Notification, privacy and display settings
+  sessions.json        Sign-in sessions (session tokens removed)
+  exam_attempts.json   Exam attempts and scores
+  exam_answers.json    Answers given in those attempts
+  exam_results.json    Per-attempt result summaries
+  focus_sessions.json  Focus room study sessions
+  tasks.json           Study tasks
+  chat_messages.json   Messages sent in focus rooms
+  bookmarks.json       Bookmarked library items
+  ratings.json         Library ratings and reviews
+  notifications.json   Notifications sent to you
+
+Generated: %s
+Account:   %s
+`
+
+// ProcessPendingExports builds the archives of up to BatchSize queued exports and
+// returns how many completed
+func (s *Service) ProcessPendingExports(ctx context.Context) (int, error) {
+	done := 0
+	for i := 0; i < s.config.BatchSize; i++ {
+		job, err := s.store.ClaimPendingExport(ctx)
+		if errors.Is(err, repository.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return done, err
+		}
+
+		if err := s.runExport(ctx, job); err != nil {
+			log.Printf("[WARN] [Privacy] Data export %s failed: %v", job.ID, err)
+			if failErr := s.store.FailExport(ctx, job.ID, err.Error()); failErr != nil {
+				log.Printf("[WARN] [Privacy] Failed to mark export %s failed: %v", job.ID, failErr)
+			}
+			continue
+		}
+		done++
+	}
+	return done, nil
+}
+
+func (s *Service) runExport(ctx context.Context, job *repository.DataExportJob) error {
+	sections, err := s.store.ExportUserData(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	archive, err := buildArchive(sections, job.UserID, now)
+	if err != nil {
+		return err
+	}
+	expiresAt := now.Add(s.config.ExportTTL)
+	if err := s.store.CompleteExport(ctx, job.ID, archive, expiresAt); err != nil {
+		return err
+	}
+
+	s.notify(ctx, job.UserID, "Dữ liệu của bạn đã sẵn sàng",
+		fmt.Sprintf("Bản sao dữ liệu cá nhân có thể tải xuống đến %s.", expiresAt.Format("02/01/2006")),
+		"/settings/privacy")
+	return nil
+}
+
+// buildArchive writes one indented JSON file per section plus a README into a zip
+func buildArchive(sections []repository.ExportSection, userID string, generatedAt time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	readme, err := zw.CreateHeader(&zip.FileHeader{Name: "README.txt", Method: zip.Deflate, Modified: generatedAt})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(readme, exportReadme, generatedAt.UTC().Format(time.RFC3339), userID); err != nil {
+		return nil, err
+	}
+
+	for _, section := range sections {
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, section.Data, "", "  "); err != nil {
+			return nil, fmt.Errorf("invalid %s data: %w", section.Name, err)
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: section.Name + ".json", Method: zip.Deflate, Modified: generatedAt})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(pretty.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func archiveName(job *repository.DataExportJob) string {
+	created := job.RequestedAt
+	if job.CompletedAt != nil {
+		created = *job.CompletedAt
+	}
+	return fmt.Sprintf("personal-data-%s.zip", created.Format("2006-01-02"))
+}
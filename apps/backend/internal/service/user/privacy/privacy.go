@@ -0,0 +1,218 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/internal/service/notification"
+)
+
+// Errors returned by the privacy service
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrExportInProgress    = errors.New("a data export is already in progress")
+	ErrExportNotReady      = errors.New("data export is not ready for download")
+	ErrDeletionPending     = errors.New("account deletion is already scheduled")
+	ErrConfirmationInvalid = errors.New("confirmation email does not match the account")
+)
+
+// Export and deletion statuses
+const (
+	ExportPending = "PENDING"
+	ExportRunning = "RUNNING"
+	ExportReady   = "READY"
+	ExportFailed  = "FAILED"
+	ExportExpired = "EXPIRED"
+
+	DeletionPending   = "PENDING"
+	DeletionCancelled = "CANCELLED"
+	DeletionCompleted = "COMPLETED"
+)
+
+// store is the persistence used by the service, implemented by repository.PrivacyRepository
+type store interface {
+	CreateExportJob(ctx context.Context, userID string) (*repository.DataExportJob, error)
+	ListExportJobs(ctx context.Context, userID string) ([]*repository.DataExportJob, error)
+	GetExportArchive(ctx context.Context, userID, jobID string) (*repository.DataExportJob, error)
+	ClaimPendingExport(ctx context.Context) (*repository.DataExportJob, error)
+	CompleteExport(ctx context.Context, jobID string, archive []byte, expiresAt time.Time) error
+	FailExport(ctx context.Context, jobID, reason string) error
+	ExpireExports(ctx context.Context, now time.Time) (int64, error)
+	ExportUserData(ctx context.Context, userID string) ([]repository.ExportSection, error)
+	CreateDeletionRequest(ctx context.Context, userID, reason string, scheduledFor time.Time) (*repository.AccountDeletionRequest, error)
+	GetPendingDeletion(ctx context.Context, userID string) (*repository.AccountDeletionRequest, error)
+	CancelDeletion(ctx context.Context, userID string) (bool, error)
+	ListDueDeletions(ctx context.Context, now time.Time, limit int) ([]*repository.AccountDeletionRequest, error)
+	EraseUser(ctx context.Context, requestID, userID string) (bool, error)
+}
+
+// userLookup resolves users, implemented by repository.IUserRepository
+type userLookup interface {
+	GetByID(ctx context.Context, id string) (*repository.User, error)
+}
+
+// sessionTerminator signs a user out everywhere, implemented by session.SessionService
+type sessionTerminator interface {
+	TerminateAllSessions(ctx context.Context, userID string) error
+}
+
+// notifier delivers in-app notifications, implemented by notification.NotificationService
+type notifier interface {
+	CreateNotification(
+		ctx context.Context,
+		userID string,
+		notifType notification.NotificationType,
+		title string,
+		message string,
+		data *notification.NotificationData,
+		expiresIn *time.Duration,
+	) error
+}
+
+// Config controls export retention and the deletion grace period
+type Config struct {
+	ExportTTL     time.Duration // How long a finished archive can be downloaded
+	GracePeriod   time.Duration // Time between a deletion request and the erasure
+	CheckInterval time.Duration // How often the worker looks for exports and due deletions
+	BatchSize     int           // Exports or deletions handled per run
+}
+
+// Service handles personal data exports and account deletion
+type Service struct {
+	store    store
+	users    userLookup
+	sessions sessionTerminator
+	notifier notifier
+	config   Config
+	now      func() time.Time
+
+	mu   sync.Mutex
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewService creates a new privacy service
+func NewService(store store, users userLookup, sessions sessionTerminator, notifier notifier, config Config) *Service {
+	if config.ExportTTL <= 0 {
+		config.ExportTTL = 7 * 24 * time.Hour
+	}
+	if config.GracePeriod <= 0 {
+		config.GracePeriod = 30 * 24 * time.Hour
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = time.Minute
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 10
+	}
+
+	return &Service{
+		store:    store,
+		users:    users,
+		sessions: sessions,
+		notifier: notifier,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+// RequestExport queues a personal data export for the user. The archive is built in the
+// background and can be downloaded once the export is READY.
+func (s *Service) RequestExport(ctx context.Context, userID string) (*repository.DataExportJob, error) {
+	job, err := s.store.CreateExportJob(ctx, userID)
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return nil, ErrExportInProgress
+	}
+	return job, err
+}
+
+// ListExports returns the user's recent exports, newest first
+func (s *Service) ListExports(ctx context.Context, userID string) ([]*repository.DataExportJob, error) {
+	return s.store.ListExportJobs(ctx, userID)
+}
+
+// DownloadExport returns the archive of one of the user's READY exports and its file name
+func (s *Service) DownloadExport(ctx context.Context, userID, jobID string) ([]byte, string, error) {
+	job, err := s.store.GetExportArchive(ctx, userID, jobID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, "", ErrNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if job.Status != ExportReady || (job.ExpiresAt != nil && !s.now().Before(*job.ExpiresAt)) {
+		return nil, "", fmt.Errorf("%w: export is %s", ErrExportNotReady, strings.ToLower(job.Status))
+	}
+	return job.Archive, archiveName(job), nil
+}
+
+// RequestDeletion schedules the erasure of the user's account after the grace period.
+// confirmEmail must match the account email. All sessions are signed out; the user can
+// sign in again and cancel until the deletion runs.
+func (s *Service) RequestDeletion(ctx context.Context, userID, confirmEmail, reason string) (*repository.AccountDeletionRequest, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, ErrNotFound
+	}
+	if !strings.EqualFold(strings.TrimSpace(confirmEmail), user.Email) {
+		return nil, ErrConfirmationInvalid
+	}
+
+	req, err := s.store.CreateDeletionRequest(ctx, userID, strings.TrimSpace(reason), s.now().Add(s.config.GracePeriod))
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return nil, ErrDeletionPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessions.TerminateAllSessions(ctx, userID); err != nil {
+		log.Printf("[WARN] [Privacy] Failed to terminate sessions for %s: %v", userID, err)
+	}
+	s.notify(ctx, userID, "Tài khoản sẽ bị xoá",
+		fmt.Sprintf("Tài khoản của bạn sẽ bị xoá vĩnh viễn vào %s. Đăng nhập và huỷ yêu cầu nếu bạn đổi ý.", req.ScheduledFor.Format("02/01/2006")),
+		"/settings/privacy")
+	return req, nil
+}
+
+// CancelDeletion cancels the user's pending account deletion
+func (s *Service) CancelDeletion(ctx context.Context, userID string) error {
+	ok, err := s.store.CancelDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotFound
+	}
+	s.notify(ctx, userID, "Đã huỷ yêu cầu xoá tài khoản", "Tài khoản của bạn sẽ không bị xoá.", "/settings/privacy")
+	return nil
+}
+
+// DeletionStatus returns the user's pending deletion, or ErrNotFound when none is scheduled
+func (s *Service) DeletionStatus(ctx context.Context, userID string) (*repository.AccountDeletionRequest, error) {
+	req, err := s.store.GetPendingDeletion(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	return req, err
+}
+
+// notify sends an account notification; delivery failures never block the workflow
+func (s *Service) notify(ctx context.Context, userID, title, message, actionURL string) {
+	if s.notifier == nil {
+		return
+	}
+	data := &notification.NotificationData{
+		Priority:  notification.PriorityHigh,
+		ActionURL: actionURL,
+	}
+	if err := s.notifier.CreateNotification(ctx, userID, notification.TypeAccountActivity, title, message, data, nil); err != nil {
+		log.Printf("[WARN] [Privacy] Failed to send notification to %s: %v", userID, err)
+	}
+}
@@ -0,0 +1,323 @@
+package privacy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+)
+
+type memoryStore struct {
+	exports   map[string]*repository.DataExportJob
+	deletions map[string]*repository.AccountDeletionRequest
+	erased    []string
+	nextID    int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		exports:   map[string]*repository.DataExportJob{},
+		deletions: map[string]*repository.AccountDeletionRequest{},
+	}
+}
+
+func (m *memoryStore) id(prefix string) string {
+	m.nextID++
+	return fmt.Sprintf("%s-%d", prefix, m.nextID)
+}
+
+func (m *memoryStore) CreateExportJob(ctx context.Context, userID string) (*repository.DataExportJob, error) {
+	for _, job := range m.exports {
+		if job.UserID == userID && (job.Status == ExportPending || job.Status == ExportRunning) {
+			return nil, repository.ErrDuplicateKey
+		}
+	}
+	job := &repository.DataExportJob{ID: m.id("export"), UserID: userID, Status: ExportPending, RequestedAt: time.Now()}
+	m.exports[job.ID] = job
+	return job, nil
+}
+
+func (m *memoryStore) ListExportJobs(ctx context.Context, userID string) ([]*repository.DataExportJob, error) {
+	var jobs []*repository.DataExportJob
+	for _, job := range m.exports {
+		if job.UserID == userID {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+func (m *memoryStore) GetExportArchive(ctx context.Context, userID, jobID string) (*repository.DataExportJob, error) {
+	job, ok := m.exports[jobID]
+	if !ok || job.UserID != userID {
+		return nil, repository.ErrNotFound
+	}
+	copied := *job
+	return &copied, nil
+}
+
+func (m *memoryStore) ClaimPendingExport(ctx context.Context) (*repository.DataExportJob, error) {
+	for _, job := range m.exports {
+		if job.Status == ExportPending {
+			job.Status = ExportRunning
+			copied := *job
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) CompleteExport(ctx context.Context, jobID string, archive []byte, expiresAt time.Time) error {
+	job := m.exports[jobID]
+	job.Status = ExportReady
+	job.Archive = archive
+	job.FileSize = int64(len(archive))
+	job.ExpiresAt = &expiresAt
+	return nil
+}
+
+func (m *memoryStore) FailExport(ctx context.Context, jobID, reason string) error {
+	m.exports[jobID].Status = ExportFailed
+	m.exports[jobID].Error = reason
+	return nil
+}
+
+func (m *memoryStore) ExpireExports(ctx context.Context, now time.Time) (int64, error) {
+	var n int64
+	for _, job := range m.exports {
+		if job.Status == ExportReady && job.ExpiresAt.Before(now) {
+			job.Status = ExportExpired
+			job.Archive = nil
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *memoryStore) ExportUserData(ctx context.Context, userID string) ([]repository.ExportSection, error) {
+	if userID == "broken" {
+		return nil, errors.New("query failed")
+	}
+	return []repository.ExportSection{
+		{Name: "profile", Data: json.RawMessage(fmt.Sprintf(`[{"id":%q}]`, userID))},
+		{Name: "tasks", Data: json.RawMessage(`[]`)},
+	}, nil
+}
+
+func (m *memoryStore) CreateDeletionRequest(ctx context.Context, userID, reason string, scheduledFor time.Time) (*repository.AccountDeletionRequest, error) {
+	if _, err := m.GetPendingDeletion(ctx, userID); err == nil {
+		return nil, repository.ErrDuplicateKey
+	}
+	req := &repository.AccountDeletionRequest{ID: m.id("deletion"), UserID: userID, Status: DeletionPending, Reason: reason, ScheduledFor: scheduledFor}
+	m.deletions[req.ID] = req
+	return req, nil
+}
+
+func (m *memoryStore) GetPendingDeletion(ctx context.Context, userID string) (*repository.AccountDeletionRequest, error) {
+	for _, req := range m.deletions {
+		if req.UserID == userID && req.Status == DeletionPending {
+			return req, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (m *memoryStore) CancelDeletion(ctx context.Context, userID string) (bool, error) {
+	req, err := m.GetPendingDeletion(ctx, userID)
+	if err != nil {
+		return false, nil
+	}
+	req.Status = DeletionCancelled
+	return true, nil
+}
+
+func (m *memoryStore) ListDueDeletions(ctx context.Context, now time.Time, limit int) ([]*repository.AccountDeletionRequest, error) {
+	var due []*repository.AccountDeletionRequest
+	for _, req := range m.deletions {
+		if req.Status == DeletionPending && !req.ScheduledFor.After(now) {
+			due = append(due, req)
+		}
+	}
+	return due, nil
+}
+
+func (m *memoryStore) EraseUser(ctx context.Context, requestID, userID string) (bool, error) {
+	req := m.deletions[requestID]
+	if req.Status != DeletionPending {
+		return false, nil
+	}
+	req.Status = DeletionCompleted
+	m.erased = append(m.erased, userID)
+	return true, nil
+}
+
+type fakeUsers map[string]string
+
+func (f fakeUsers) GetByID(ctx context.Context, id string) (*repository.User, error) {
+	email, ok := f[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &repository.User{ID: id, Email: email}, nil
+}
+
+type fakeSessions struct{ terminated []string }
+
+func (f *fakeSessions) TerminateAllSessions(ctx context.Context, userID string) error {
+	f.terminated = append(f.terminated, userID)
+	return nil
+}
+
+func newTestService(now time.Time) (*Service, *memoryStore, *fakeSessions) {
+	store := newMemoryStore()
+	sessions := &fakeSessions{}
+	users := fakeUsers{"alice": "alice@example.com", "bob": "bob@example.com"}
+	svc := NewService(store, users, sessions, nil, Config{})
+	svc.now = func() time.Time { return now }
+	return svc, store, sessions
+}
+
+func TestRequestDeletionRequiresMatchingEmail(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, _, sessions := newTestService(now)
+	ctx := context.Background()
+
+	if _, err := svc.RequestDeletion(ctx, "alice", "bob@example.com", ""); !errors.Is(err, ErrConfirmationInvalid) {
+		t.Fatalf("expected ErrConfirmationInvalid, got %v", err)
+	}
+
+	req, err := svc.RequestDeletion(ctx, "alice", " Alice@Example.com ", "leaving")
+	if err != nil {
+		t.Fatalf("RequestDeletion: %v", err)
+	}
+	if want := now.Add(30 * 24 * time.Hour); !req.ScheduledFor.Equal(want) {
+		t.Fatalf("scheduled for %v, want %v", req.ScheduledFor, want)
+	}
+	if len(sessions.terminated) != 1 || sessions.terminated[0] != "alice" {
+		t.Fatalf("expected alice's sessions to be terminated, got %v", sessions.terminated)
+	}
+
+	if _, err := svc.RequestDeletion(ctx, "alice", "alice@example.com", ""); !errors.Is(err, ErrDeletionPending) {
+		t.Fatalf("expected ErrDeletionPending, got %v", err)
+	}
+}
+
+func TestCancelDeletionStopsErasure(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, store, _ := newTestService(now)
+	ctx := context.Background()
+
+	if _, err := svc.RequestDeletion(ctx, "alice", "alice@example.com", ""); err != nil {
+		t.Fatalf("RequestDeletion: %v", err)
+	}
+	if _, err := svc.RequestDeletion(ctx, "bob", "bob@example.com", ""); err != nil {
+		t.Fatalf("RequestDeletion: %v", err)
+	}
+	if err := svc.CancelDeletion(ctx, "bob"); err != nil {
+		t.Fatalf("CancelDeletion: %v", err)
+	}
+	if err := svc.CancelDeletion(ctx, "bob"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for a second cancel, got %v", err)
+	}
+
+	// Nothing is erased during the grace period
+	if n, err := svc.ProcessDueDeletions(ctx); err != nil || n != 0 {
+		t.Fatalf("expected no erasures during grace period, got %d, %v", n, err)
+	}
+
+	svc.now = func() time.Time { return now.Add(31 * 24 * time.Hour) }
+	if n, err := svc.ProcessDueDeletions(ctx); err != nil || n != 1 {
+		t.Fatalf("expected one erasure, got %d, %v", n, err)
+	}
+	if len(store.erased) != 1 || store.erased[0] != "alice" {
+		t.Fatalf("expected only alice to be erased, got %v", store.erased)
+	}
+	if _, err := svc.DeletionStatus(ctx, "alice"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected no pending deletion after erasure, got %v", err)
+	}
+}
+
+func TestExportBuildsDownloadableArchive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc, _, _ := newTestService(now)
+	ctx := context.Background()
+
+	job, err := svc.RequestExport(ctx, "alice")
+	if err != nil {
+		t.Fatalf("RequestExport: %v", err)
+	}
+	if _, err := svc.RequestExport(ctx, "alice"); !errors.Is(err, ErrExportInProgress) {
+		t.Fatalf("expected ErrExportInProgress, got %v", err)
+	}
+	if _, _, err := svc.DownloadExport(ctx, "alice", job.ID); !errors.Is(err, ErrExportNotReady) {
+		t.Fatalf("expected ErrExportNotReady before processing, got %v", err)
+	}
+
+	if n, err := svc.ProcessPendingExports(ctx); err != nil || n != 1 {
+		t.Fatalf("expected one export, got %d, %v", n, err)
+	}
+	if _, _, err := svc.DownloadExport(ctx, "bob", job.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected another user's export to be hidden, got %v", err)
+	}
+
+	archive, name, err := svc.DownloadExport(ctx, "alice", job.ID)
+	if err != nil {
+		t.Fatalf("DownloadExport: %v", err)
+	}
+	if want := "personal-data-" + job.RequestedAt.Format("2006-01-02") + ".zip"; name != want {
+		t.Fatalf("unexpected file name %q", name)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("archive is not a zip: %v", err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	for _, want := range []string{"README.txt", "profile.json", "tasks.json"} {
+		if _, ok := files[want]; !ok {
+			t.Fatalf("archive is missing %s, has %v", want, files)
+		}
+	}
+	var profile []map[string]string
+	if err := json.Unmarshal([]byte(files["profile.json"]), &profile); err != nil || len(profile) != 1 || profile[0]["id"] != "alice" {
+		t.Fatalf("unexpected profile.json: %s (%v)", files["profile.json"], err)
+	}
+
+	// Archives can no longer be downloaded once they expire
+	svc.now = func() time.Time { return now.Add(8 * 24 * time.Hour) }
+	if _, _, err := svc.DownloadExport(ctx, "alice", job.ID); !errors.Is(err, ErrExportNotReady) {
+		t.Fatalf("expected ErrExportNotReady after expiry, got %v", err)
+	}
+}
+
+func TestFailedExportIsMarkedFailed(t *testing.T) {
+	svc, store, _ := newTestService(time.Now())
+	ctx := context.Background()
+
+	job, err := svc.RequestExport(ctx, "broken")
+	if err != nil {
+		t.Fatalf("RequestExport: %v", err)
+	}
+	if n, err := svc.ProcessPendingExports(ctx); err != nil || n != 0 {
+		t.Fatalf("expected no completed exports, got %d, %v", n, err)
+	}
+	if got := store.exports[job.ID].Status; got != ExportFailed {
+		t.Fatalf("expected FAILED, got %s", got)
+	}
+}
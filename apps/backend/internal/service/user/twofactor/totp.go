@@ -0,0 +1,159 @@
+package twofactor
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 parameters; these match the defaults of common authenticator apps
+const (
+	totpDigits     = 6
+	totpPeriod     = 30
+	totpSecretSize = 20 // 160-bit secret as recommended by RFC 4226
+	totpSkew       = 1  // Accept one step before and after the current one
+)
+
+// Recovery codes are 10 characters, shown as xxxxx-xxxxx
+const (
+	recoveryCodeCount    = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random base32-encoded TOTP secret
+func GenerateSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+// ProvisioningURI builds the otpauth:// URI scanned by authenticator apps
+func ProvisioningURI(issuer, account, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// GenerateCode returns the code for the time step containing t
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, uint64(timeStep(t))), nil
+}
+
+// ValidateCode checks a code against the steps around t and returns the matching step
+func ValidateCode(secret, code string, t time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	current := timeStep(t)
+	for offset := int64(-totpSkew); offset <= totpSkew; offset++ {
+		step := current + offset
+		if step < 0 {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(step))), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// hotp implements RFC 4226 with dynamic truncation
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+func timeStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := base32NoPadding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret: %w", err)
+	}
+	return key, nil
+}
+
+// generateRecoveryCodes returns new plain-text recovery codes
+func generateRecoveryCodes() ([]string, error) {
+	// Largest multiple of the alphabet size that fits in a byte, to avoid modulo bias
+	limit := 256 - 256%len(recoveryCodeAlphabet)
+
+	codes := make([]string, 0, recoveryCodeCount)
+	buf := make([]byte, 1)
+	for len(codes) < recoveryCodeCount {
+		var b strings.Builder
+		for n := 0; n < recoveryCodeLength; {
+			if _, err := rand.Read(buf); err != nil {
+				return nil, fmt.Errorf("failed to generate recovery codes: %w", err)
+			}
+			if int(buf[0]) >= limit {
+				continue
+			}
+			if n == recoveryCodeLength/2 {
+				b.WriteByte('-')
+			}
+			b.WriteByte(recoveryCodeAlphabet[int(buf[0])%len(recoveryCodeAlphabet)])
+			n++
+		}
+		codes = append(codes, b.String())
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode lowercases a code and strips separators users may type
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// hashRecoveryCode returns the stored form of a recovery code
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,636 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+	"github.com/google/uuid"
+)
+
+// Errors returned by the two-factor service
+var (
+	ErrDisabled         = errors.New("two-factor authentication is disabled")
+	ErrInvalidCode      = errors.New("invalid two-factor code")
+	ErrChallengeInvalid = errors.New("two-factor challenge is invalid or expired")
+	ErrAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrNotEnrolled      = errors.New("two-factor authentication is not enabled")
+	ErrEnrollmentNeeded = errors.New("two-factor enrollment has not been started")
+	ErrEnforced         = errors.New("two-factor authentication is required for this role")
+)
+
+// Audit actions written for two-factor events
+const (
+	AuditActionEnrollStarted   = "TWO_FACTOR_ENROLL_STARTED"
+	AuditActionEnabled         = "TWO_FACTOR_ENABLED"
+	AuditActionDisabled        = "TWO_FACTOR_DISABLED"
+	AuditActionChallenge       = "TWO_FACTOR_CHALLENGE"
+	AuditActionVerified        = "TWO_FACTOR_VERIFIED"
+	AuditActionFailed          = "TWO_FACTOR_FAILED"
+	AuditActionRecoveryUsed    = "TWO_FACTOR_RECOVERY_CODE_USED"
+	AuditActionRecoveryRenewed = "TWO_FACTOR_RECOVERY_CODES_REGENERATED"
+	AuditActionReset           = "TWO_FACTOR_RESET"
+)
+
+// factorStore is the persistence used by the service, implemented by repository.TwoFactorRepository
+type factorStore interface {
+	GetFactor(ctx context.Context, userID string) (*repository.UserTwoFactor, error)
+	SavePendingFactor(ctx context.Context, userID, secretEncrypted string) error
+	EnableFactor(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error
+	DeleteFactor(ctx context.Context, userID string) error
+	AdvanceStep(ctx context.Context, userID string, step int64) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID string) (int, error)
+	CreateChallenge(ctx context.Context, challenge *repository.TwoFactorChallenge) error
+	GetChallengeByTokenHash(ctx context.Context, tokenHash string) (*repository.TwoFactorChallenge, error)
+	IncrementChallengeAttempts(ctx context.Context, id string) (int, error)
+	ConsumeChallenge(ctx context.Context, id string) (bool, error)
+}
+
+// codeMailer delivers step-up codes, implemented by email.EmailService
+type codeMailer interface {
+	SendLoginVerificationEmail(toEmail, userName, code, device, ipAddress string, ttl time.Duration) error
+}
+
+// auditWriter records security events, implemented by repository.AuditLogRepository
+type auditWriter interface {
+	Create(ctx context.Context, log *repository.AuditLog) error
+}
+
+// Config controls two-factor behaviour
+type Config struct {
+	Enabled              bool
+	Issuer               string
+	EnforcedRoles        []string // Role names without prefix, e.g. ADMIN, TEACHER
+	ChallengeTTL         time.Duration
+	MaxChallengeAttempts int
+	EncryptionKey        string // Used to encrypt TOTP secrets at rest
+}
+
+// ClientInfo identifies the client for audit entries
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// Enrollment is a pending TOTP secret to be added to an authenticator app
+type Enrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// LoginChallenge is returned by Login instead of tokens when a second step is needed
+type LoginChallenge struct {
+	Token      string
+	Purpose    string
+	ExpiresAt  time.Time
+	Enrollment *Enrollment // Set for ENROLL challenges
+}
+
+// LoginResult is the outcome of a completed challenge
+type LoginResult struct {
+	UserID        string
+	RecoveryCodes []string // Set when the challenge completed enrollment
+}
+
+// Status describes a user's two-factor state
+type Status struct {
+	Enabled                bool
+	Pending                bool
+	Enforced               bool
+	EnabledAt              *time.Time
+	RecoveryCodesRemaining int
+}
+
+// TwoFactorService implements TOTP enrollment, login challenges and recovery codes
+type TwoFactorService struct {
+	store  factorStore
+	audit  auditWriter
+	mailer codeMailer
+	config Config
+	key    []byte
+	now    func() time.Time
+}
+
+// NewTwoFactorService creates a new two-factor service
+func NewTwoFactorService(store factorStore, audit auditWriter, config Config) *TwoFactorService {
+	if config.Issuer == "" {
+		config.Issuer = "NyNus"
+	}
+	if config.ChallengeTTL <= 0 {
+		config.ChallengeTTL = 5 * time.Minute
+	}
+	if config.MaxChallengeAttempts <= 0 {
+		config.MaxChallengeAttempts = 5
+	}
+	key := sha256.Sum256([]byte(config.EncryptionKey))
+
+	return &TwoFactorService{
+		store:  store,
+		audit:  audit,
+		config: config,
+		key:    key[:],
+		now:    time.Now,
+	}
+}
+
+// SetMailer enables EMAIL step-up challenges for risky logins
+func (s *TwoFactorService) SetMailer(mailer codeMailer) {
+	s.mailer = mailer
+}
+
+// Enabled reports whether the EnableTwoFactor feature flag is on
+func (s *TwoFactorService) Enabled() bool {
+	return s.config.Enabled
+}
+
+// IsEnforced reports whether the role must use two-factor authentication
+func (s *TwoFactorService) IsEnforced(role common.UserRole) bool {
+	name := strings.TrimPrefix(role.String(), "USER_ROLE_")
+	for _, enforced := range s.config.EnforcedRoles {
+		if strings.EqualFold(strings.TrimSpace(enforced), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoginRequirement returns the challenge purpose a password login must go through,
+// or "" when tokens can be issued directly
+func (s *TwoFactorService) LoginRequirement(ctx context.Context, user *repository.User) (string, error) {
+	if !s.config.Enabled {
+		return "", nil
+	}
+
+	factor, err := s.store.GetFactor(ctx, user.ID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return "", err
+	}
+	if factor != nil && factor.Enabled {
+		return repository.TwoFactorChallengeLogin, nil
+	}
+	if s.IsEnforced(user.Role) {
+		return repository.TwoFactorChallengeEnroll, nil
+	}
+	return "", nil
+}
+
+// StartLoginChallenge issues a challenge token. ENROLL challenges also start enrollment,
+// so the user can add the secret before confirming it with the first code.
+func (s *TwoFactorService) StartLoginChallenge(ctx context.Context, user *repository.User, purpose string, client ClientInfo) (*LoginChallenge, error) {
+	challenge := &LoginChallenge{Purpose: purpose}
+	if purpose == repository.TwoFactorChallengeEnroll {
+		enrollment, err := s.BeginEnrollment(ctx, user, client)
+		if err != nil {
+			return nil, err
+		}
+		challenge.Enrollment = enrollment
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	challenge.Token = token
+	challenge.ExpiresAt = s.now().Add(s.config.ChallengeTTL)
+
+	if err := s.store.CreateChallenge(ctx, &repository.TwoFactorChallenge{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		Purpose:   purpose,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		ExpiresAt: challenge.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, user.ID, AuditActionChallenge, true, client, map[string]interface{}{"purpose": purpose})
+	return challenge, nil
+}
+
+// StartEmailChallenge emails a one-time code to the user and issues an EMAIL challenge.
+// It is used when the login risk evaluator asks for a step-up and the user has no TOTP
+// factor, so it works whether or not the two-factor feature flag is on.
+func (s *TwoFactorService) StartEmailChallenge(ctx context.Context, user *repository.User, device string, client ClientInfo) (*LoginChallenge, error) {
+	if s.mailer == nil {
+		return nil, ErrDisabled
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	code, err := generateEmailCode()
+	if err != nil {
+		return nil, err
+	}
+	challenge := &LoginChallenge{
+		Token:     token,
+		Purpose:   repository.TwoFactorChallengeEmail,
+		ExpiresAt: s.now().Add(s.config.ChallengeTTL),
+	}
+
+	if err := s.store.CreateChallenge(ctx, &repository.TwoFactorChallenge{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		Purpose:   repository.TwoFactorChallengeEmail,
+		CodeHash:  hashEmailCode(token, code),
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		ExpiresAt: challenge.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	userName := strings.TrimSpace(user.FirstName + " " + user.LastName)
+	if err := s.mailer.SendLoginVerificationEmail(user.Email, userName, code, device, client.IPAddress, s.config.ChallengeTTL); err != nil {
+		return nil, fmt.Errorf("failed to send login verification email: %w", err)
+	}
+
+	s.record(ctx, user.ID, AuditActionChallenge, true, client, map[string]interface{}{"purpose": repository.TwoFactorChallengeEmail})
+	return challenge, nil
+}
+
+// CompleteLoginChallenge verifies the code for a challenge and consumes it. LOGIN
+// challenges accept a TOTP code or a recovery code; ENROLL challenges confirm the
+// pending enrollment and return the new recovery codes; EMAIL challenges accept the
+// emailed code.
+func (s *TwoFactorService) CompleteLoginChallenge(ctx context.Context, token, code string, client ClientInfo) (*LoginResult, error) {
+	challenge, err := s.store.GetChallengeByTokenHash(ctx, hashToken(token))
+	if errors.Is(err, repository.ErrNotFound) {
+		if !s.config.Enabled {
+			return nil, ErrDisabled
+		}
+		return nil, ErrChallengeInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !s.config.Enabled && challenge.Purpose != repository.TwoFactorChallengeEmail {
+		return nil, ErrDisabled
+	}
+	if challenge.ConsumedAt != nil || !s.now().Before(challenge.ExpiresAt) ||
+		challenge.Attempts >= s.config.MaxChallengeAttempts {
+		return nil, ErrChallengeInvalid
+	}
+
+	result := &LoginResult{UserID: challenge.UserID}
+	switch challenge.Purpose {
+	case repository.TwoFactorChallengeEnroll:
+		result.RecoveryCodes, err = s.ConfirmEnrollment(ctx, challenge.UserID, code, client)
+	case repository.TwoFactorChallengeEmail:
+		if !hmac.Equal([]byte(hashEmailCode(token, strings.TrimSpace(code))), []byte(challenge.CodeHash)) {
+			err = ErrInvalidCode
+		}
+	default:
+		err = s.verifyCode(ctx, challenge.UserID, code, true, client)
+	}
+	if err != nil {
+		if errors.Is(err, ErrInvalidCode) {
+			if _, incErr := s.store.IncrementChallengeAttempts(ctx, challenge.ID); incErr != nil {
+				return nil, incErr
+			}
+			s.record(ctx, challenge.UserID, AuditActionFailed, false, client, map[string]interface{}{"purpose": challenge.Purpose})
+		}
+		return nil, err
+	}
+
+	consumed, err := s.store.ConsumeChallenge(ctx, challenge.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		return nil, ErrChallengeInvalid
+	}
+
+	s.record(ctx, challenge.UserID, AuditActionVerified, true, client, map[string]interface{}{"purpose": challenge.Purpose})
+	return result, nil
+}
+
+// BeginEnrollment creates a new pending secret, replacing any earlier pending one
+func (s *TwoFactorService) BeginEnrollment(ctx context.Context, user *repository.User, client ClientInfo) (*Enrollment, error) {
+	if !s.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := s.encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.SavePendingFactor(ctx, user.ID, encrypted); err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			return nil, ErrAlreadyEnabled
+		}
+		return nil, err
+	}
+
+	s.record(ctx, user.ID, AuditActionEnrollStarted, true, client, nil)
+	return &Enrollment{
+		Secret:          secret,
+		ProvisioningURI: ProvisioningURI(s.config.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables the pending secret once the user proves they can generate
+// codes with it, and returns the one-time recovery codes
+func (s *TwoFactorService) ConfirmEnrollment(ctx context.Context, userID, code string, client ClientInfo) ([]string, error) {
+	if !s.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	factor, err := s.store.GetFactor(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, ErrEnrollmentNeeded
+	}
+	if err != nil {
+		return nil, err
+	}
+	if factor.Enabled {
+		return nil, ErrAlreadyEnabled
+	}
+
+	secret, err := s.decrypt(factor.SecretEncrypted)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := ValidateCode(secret, code, s.now())
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.EnableFactor(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, userID, AuditActionEnabled, true, client, nil)
+	return codes, nil
+}
+
+// Disable removes the user's factor after verifying a code. Users in enforced roles
+// cannot disable it themselves; an admin reset is required.
+func (s *TwoFactorService) Disable(ctx context.Context, user *repository.User, code string, client ClientInfo) error {
+	if !s.config.Enabled {
+		return ErrDisabled
+	}
+	if s.IsEnforced(user.Role) {
+		return ErrEnforced
+	}
+
+	if err := s.verifyCode(ctx, user.ID, code, true, client); err != nil {
+		if errors.Is(err, ErrInvalidCode) {
+			s.record(ctx, user.ID, AuditActionFailed, false, client, map[string]interface{}{"operation": "disable"})
+		}
+		return err
+	}
+	if err := s.store.DeleteFactor(ctx, user.ID); err != nil {
+		return err
+	}
+
+	s.record(ctx, user.ID, AuditActionDisabled, true, client, nil)
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes. A current TOTP code is required;
+// recovery codes are not accepted here.
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID, code string, client ClientInfo) ([]string, error) {
+	if !s.config.Enabled {
+		return nil, ErrDisabled
+	}
+
+	if err := s.verifyCode(ctx, userID, code, false, client); err != nil {
+		if errors.Is(err, ErrInvalidCode) {
+			s.record(ctx, userID, AuditActionFailed, false, client, map[string]interface{}{"operation": "regenerate_recovery_codes"})
+		}
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, userID, AuditActionRecoveryRenewed, true, client, nil)
+	return codes, nil
+}
+
+// GetStatus returns the user's two-factor state
+func (s *TwoFactorService) GetStatus(ctx context.Context, user *repository.User) (*Status, error) {
+	status := &Status{Enforced: s.config.Enabled && s.IsEnforced(user.Role)}
+
+	factor, err := s.store.GetFactor(ctx, user.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return status, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status.Enabled = factor.Enabled
+	status.Pending = !factor.Enabled
+	status.EnabledAt = factor.EnabledAt
+	if factor.Enabled {
+		if status.RecoveryCodesRemaining, err = s.store.CountUnusedRecoveryCodes(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// Reset removes a user's factor on behalf of an admin, e.g. after a lost device.
+// Users in enforced roles will be asked to enroll again on their next login.
+func (s *TwoFactorService) Reset(ctx context.Context, adminID, userID string, client ClientInfo) error {
+	if err := s.store.DeleteFactor(ctx, userID); err != nil {
+		return err
+	}
+	s.record(ctx, userID, AuditActionReset, true, client, map[string]interface{}{"performed_by": adminID})
+	return nil
+}
+
+// verifyCode accepts a TOTP code, or a recovery code when allowRecovery is set.
+// Each TOTP time step is accepted once.
+func (s *TwoFactorService) verifyCode(ctx context.Context, userID, code string, allowRecovery bool, client ClientInfo) error {
+	factor, err := s.store.GetFactor(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotEnrolled
+	}
+	if err != nil {
+		return err
+	}
+	if !factor.Enabled {
+		return ErrNotEnrolled
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) == totpDigits {
+		secret, err := s.decrypt(factor.SecretEncrypted)
+		if err != nil {
+			return err
+		}
+		step, ok := ValidateCode(secret, code, s.now())
+		if !ok {
+			return ErrInvalidCode
+		}
+		advanced, err := s.store.AdvanceStep(ctx, userID, step)
+		if err != nil {
+			return err
+		}
+		if !advanced {
+			return ErrInvalidCode
+		}
+		return nil
+	}
+
+	if !allowRecovery || len(normalizeRecoveryCode(code)) != recoveryCodeLength {
+		return ErrInvalidCode
+	}
+	used, err := s.store.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidCode
+	}
+	s.record(ctx, userID, AuditActionRecoveryUsed, true, client, nil)
+	return nil
+}
+
+// record writes an audit entry; failures are logged and never block authentication
+func (s *TwoFactorService) record(ctx context.Context, userID, action string, success bool, client ClientInfo, metadata map[string]interface{}) {
+	if s.audit == nil {
+		return
+	}
+
+	metadataJSON := json.RawMessage(`{}`)
+	if len(metadata) > 0 {
+		if data, err := json.Marshal(metadata); err == nil {
+			metadataJSON = data
+		}
+	}
+
+	entry := &repository.AuditLog{
+		ID:         uuid.New().String(),
+		UserID:     &userID,
+		Action:     action,
+		Resource:   "USER",
+		ResourceID: userID,
+		OldValues:  json.RawMessage(`{}`),
+		NewValues:  json.RawMessage(`{}`),
+		IPAddress:  client.IPAddress,
+		UserAgent:  client.UserAgent,
+		Success:    success,
+		Metadata:   metadataJSON,
+		CreatedAt:  s.now(),
+	}
+	if err := s.audit.Create(ctx, entry); err != nil {
+		log.Printf("[2FA] Failed to write audit log %s for user %s: %v", action, userID, err)
+	}
+}
+
+// encrypt seals a secret with AES-256-GCM
+func (s *TwoFactorService) encrypt(plaintext string) (string, error) {
+	gcm, err := s.cipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt opens a secret sealed by encrypt
+func (s *TwoFactorService) decrypt(encoded string) (string, error) {
+	gcm, err := s.cipher()
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("failed to decode two-factor secret")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt two-factor secret: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func (s *TwoFactorService) cipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// newRecoveryCodes returns plain-text codes for the user and their hashes for storage
+func newRecoveryCodes() ([]string, []string, error) {
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = hashRecoveryCode(code)
+	}
+	return codes, hashes, nil
+}
+
+// randomToken returns a URL-safe challenge token
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate challenge token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateEmailCode returns a uniformly random 6-digit code
+func generateEmailCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashEmailCode binds an emailed code to its challenge token, so a stored hash
+// cannot be matched against the small code space without the token
+func hashEmailCode(token, code string) string {
+	return hashToken(token + ":" + code)
+}
@@ -0,0 +1,392 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"exam-bank-system/apps/backend/internal/repository"
+	"exam-bank-system/apps/backend/pkg/proto/common"
+)
+
+// RFC 6238 appendix B test secret ("12345678901234567890") in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateCode_RFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		code, err := GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if code != tt.code {
+			t.Errorf("t=%d: got %s, want %s", tt.unix, code, tt.code)
+		}
+	}
+}
+
+func TestValidateCode_Skew(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	previous, _ := GenerateCode(rfcSecret, now.Add(-30*time.Second))
+	stale, _ := GenerateCode(rfcSecret, now.Add(-90*time.Second))
+
+	if step, ok := ValidateCode(rfcSecret, previous, now); !ok || step != timeStep(now)-1 {
+		t.Errorf("expected previous step to be accepted, got step=%d ok=%v", step, ok)
+	}
+	if _, ok := ValidateCode(rfcSecret, stale, now); ok {
+		t.Error("expected code from three steps ago to be rejected")
+	}
+	if _, ok := ValidateCode(rfcSecret, "12345", now); ok {
+		t.Error("expected short code to be rejected")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("NyNus", "teacher@example.com", rfcSecret)
+	if !strings.HasPrefix(uri, "otpauth://totp/NyNus:teacher@example.com?") {
+		t.Errorf("unexpected URI: %s", uri)
+	}
+	for _, param := range []string{"secret=" + rfcSecret, "issuer=NyNus", "digits=6", "period=30"} {
+		if !strings.Contains(uri, param) {
+			t.Errorf("URI missing %s: %s", param, uri)
+		}
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("expected %d codes, got %d", recoveryCodeCount, len(codes))
+	}
+	if len(codes[0]) != recoveryCodeLength+1 || codes[0][recoveryCodeLength/2] != '-' {
+		t.Errorf("unexpected code format: %s", codes[0])
+	}
+	// Users may type codes without the separator or in upper case
+	if hashRecoveryCode(strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))) != hashes[0] {
+		t.Error("normalized code should hash to the stored value")
+	}
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	s := NewTwoFactorService(newMemoryStore(), nil, Config{EncryptionKey: "k1"})
+	sealed, err := s.encrypt(rfcSecret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(sealed, rfcSecret) {
+		t.Fatal("secret stored in plain text")
+	}
+	if plain, err := s.decrypt(sealed); err != nil || plain != rfcSecret {
+		t.Fatalf("round trip failed: %q %v", plain, err)
+	}
+
+	other := NewTwoFactorService(newMemoryStore(), nil, Config{EncryptionKey: "k2"})
+	if _, err := other.decrypt(sealed); err == nil {
+		t.Error("expected decryption with another key to fail")
+	}
+}
+
+func TestEnforcedEnrollmentThroughLogin(t *testing.T) {
+	store := newMemoryStore()
+	audit := &memoryAudit{}
+	s, clock := newTestService(store, audit)
+	teacher := &repository.User{ID: "teacher-001", Email: "t@example.com", Role: common.UserRole_USER_ROLE_TEACHER}
+	client := ClientInfo{IPAddress: "10.0.0.1"}
+
+	purpose, err := s.LoginRequirement(context.Background(), teacher)
+	if err != nil || purpose != repository.TwoFactorChallengeEnroll {
+		t.Fatalf("expected ENROLL for teacher without factor, got %q %v", purpose, err)
+	}
+
+	challenge, err := s.StartLoginChallenge(context.Background(), teacher, purpose, client)
+	if err != nil || challenge.Enrollment == nil {
+		t.Fatalf("expected enrollment in challenge, got %+v %v", challenge, err)
+	}
+
+	secret := challenge.Enrollment.Secret
+	code, _ := GenerateCode(secret, clock.now)
+	result, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, code, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.UserID != teacher.ID || len(result.RecoveryCodes) != recoveryCodeCount {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	// The challenge is single-use
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, code, client); !errors.Is(err, ErrChallengeInvalid) {
+		t.Errorf("expected consumed challenge to be rejected, got %v", err)
+	}
+
+	// Next login needs the factor; the code from enrollment cannot be replayed
+	purpose, _ = s.LoginRequirement(context.Background(), teacher)
+	if purpose != repository.TwoFactorChallengeLogin {
+		t.Fatalf("expected LOGIN requirement, got %q", purpose)
+	}
+	challenge, _ = s.StartLoginChallenge(context.Background(), teacher, purpose, client)
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, code, client); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected replayed code to be rejected, got %v", err)
+	}
+
+	// A recovery code works exactly once
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, result.RecoveryCodes[0], client); err != nil {
+		t.Fatalf("expected recovery code to be accepted, got %v", err)
+	}
+	challenge, _ = s.StartLoginChallenge(context.Background(), teacher, purpose, client)
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, result.RecoveryCodes[0], client); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("expected used recovery code to be rejected, got %v", err)
+	}
+
+	// Enforced roles cannot turn 2FA off themselves
+	clock.now = clock.now.Add(time.Minute)
+	fresh, _ := GenerateCode(secret, clock.now)
+	if err := s.Disable(context.Background(), teacher, fresh, client); !errors.Is(err, ErrEnforced) {
+		t.Errorf("expected ErrEnforced, got %v", err)
+	}
+
+	if !audit.has(AuditActionEnabled) || !audit.has(AuditActionRecoveryUsed) || !audit.has(AuditActionFailed) {
+		t.Errorf("missing audit entries: %v", audit.actions)
+	}
+}
+
+func TestChallengeAttemptLimit(t *testing.T) {
+	store := newMemoryStore()
+	s, clock := newTestService(store, nil)
+	student := &repository.User{ID: "student-001", Email: "s@example.com", Role: common.UserRole_USER_ROLE_STUDENT}
+
+	if purpose, _ := s.LoginRequirement(context.Background(), student); purpose != "" {
+		t.Fatalf("students without a factor should not be challenged, got %q", purpose)
+	}
+
+	enrollment, err := s.BeginEnrollment(context.Background(), student, ClientInfo{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	code, _ := GenerateCode(enrollment.Secret, clock.now)
+	if _, err := s.ConfirmEnrollment(context.Background(), student.ID, code, ClientInfo{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	challenge, _ := s.StartLoginChallenge(context.Background(), student, repository.TwoFactorChallengeLogin, ClientInfo{})
+	// "00000x" can never match, so no attempt succeeds by chance
+	for i := 0; i < s.config.MaxChallengeAttempts; i++ {
+		if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, "00000x", ClientInfo{}); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("attempt %d: expected ErrInvalidCode, got %v", i+1, err)
+		}
+	}
+
+	clock.now = clock.now.Add(30 * time.Second)
+	valid, _ := GenerateCode(enrollment.Secret, clock.now)
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, valid, ClientInfo{}); !errors.Is(err, ErrChallengeInvalid) {
+		t.Errorf("expected challenge to be locked after too many attempts, got %v", err)
+	}
+}
+
+type fakeMailer struct{ code string }
+
+func (f *fakeMailer) SendLoginVerificationEmail(toEmail, userName, code, device, ipAddress string, ttl time.Duration) error {
+	f.code = code
+	return nil
+}
+
+func TestEmailChallengeWithFeatureDisabled(t *testing.T) {
+	store := newMemoryStore()
+	s, _ := newTestService(store, nil)
+	s.config.Enabled = false
+	student := &repository.User{ID: "student-002", Email: "s2@example.com", Role: common.UserRole_USER_ROLE_STUDENT}
+
+	if _, err := s.StartEmailChallenge(context.Background(), student, "Chrome", ClientInfo{}); !errors.Is(err, ErrDisabled) {
+		t.Fatalf("expected ErrDisabled without a mailer, got %v", err)
+	}
+
+	mailer := &fakeMailer{}
+	s.SetMailer(mailer)
+	challenge, err := s.StartEmailChallenge(context.Background(), student, "Chrome", ClientInfo{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if challenge.Purpose != repository.TwoFactorChallengeEmail || len(mailer.code) != 6 {
+		t.Fatalf("unexpected challenge %+v, code %q", challenge, mailer.code)
+	}
+
+	wrong := "000000"
+	if mailer.code == wrong {
+		wrong = "111111"
+	}
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, wrong, ClientInfo{}); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("expected ErrInvalidCode, got %v", err)
+	}
+	result, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, mailer.code, ClientInfo{})
+	if err != nil || result.UserID != student.ID {
+		t.Fatalf("expected the emailed code to complete the challenge, got %+v, %v", result, err)
+	}
+	if _, err := s.CompleteLoginChallenge(context.Background(), challenge.Token, mailer.code, ClientInfo{}); !errors.Is(err, ErrChallengeInvalid) {
+		t.Fatalf("expected the challenge to be single use, got %v", err)
+	}
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func newTestService(store *memoryStore, audit auditWriter) (*TwoFactorService, *testClock) {
+	clock := &testClock{now: time.Unix(1700000000, 0)}
+	s := NewTwoFactorService(store, audit, Config{
+		Enabled:       true,
+		EnforcedRoles: []string{"ADMIN", "TEACHER"},
+		EncryptionKey: "test-key",
+	})
+	s.now = func() time.Time { return clock.now }
+	return s, clock
+}
+
+type memoryStore struct {
+	factors    map[string]*repository.UserTwoFactor
+	codes      map[string]map[string]bool // user -> hash -> used
+	challenges map[string]*repository.TwoFactorChallenge
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		factors:    make(map[string]*repository.UserTwoFactor),
+		codes:      make(map[string]map[string]bool),
+		challenges: make(map[string]*repository.TwoFactorChallenge),
+	}
+}
+
+func (m *memoryStore) GetFactor(_ context.Context, userID string) (*repository.UserTwoFactor, error) {
+	factor, ok := m.factors[userID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *factor
+	return &copied, nil
+}
+
+func (m *memoryStore) SavePendingFactor(_ context.Context, userID, secretEncrypted string) error {
+	if factor, ok := m.factors[userID]; ok && factor.Enabled {
+		return repository.ErrDuplicateKey
+	}
+	m.factors[userID] = &repository.UserTwoFactor{UserID: userID, SecretEncrypted: secretEncrypted}
+	return nil
+}
+
+func (m *memoryStore) EnableFactor(ctx context.Context, userID string, step int64, hashes []string) error {
+	factor, ok := m.factors[userID]
+	if !ok || factor.Enabled {
+		return repository.ErrNotFound
+	}
+	factor.Enabled = true
+	factor.LastUsedStep = step
+	return m.ReplaceRecoveryCodes(ctx, userID, hashes)
+}
+
+func (m *memoryStore) DeleteFactor(_ context.Context, userID string) error {
+	delete(m.factors, userID)
+	delete(m.codes, userID)
+	return nil
+}
+
+func (m *memoryStore) AdvanceStep(_ context.Context, userID string, step int64) (bool, error) {
+	factor := m.factors[userID]
+	if factor.LastUsedStep >= step {
+		return false, nil
+	}
+	factor.LastUsedStep = step
+	return true, nil
+}
+
+func (m *memoryStore) ReplaceRecoveryCodes(_ context.Context, userID string, hashes []string) error {
+	m.codes[userID] = make(map[string]bool)
+	for _, hash := range hashes {
+		m.codes[userID][hash] = false
+	}
+	return nil
+}
+
+func (m *memoryStore) UseRecoveryCode(_ context.Context, userID, hash string) (bool, error) {
+	used, ok := m.codes[userID][hash]
+	if !ok || used {
+		return false, nil
+	}
+	m.codes[userID][hash] = true
+	return true, nil
+}
+
+func (m *memoryStore) CountUnusedRecoveryCodes(_ context.Context, userID string) (int, error) {
+	count := 0
+	for _, used := range m.codes[userID] {
+		if !used {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *memoryStore) CreateChallenge(_ context.Context, challenge *repository.TwoFactorChallenge) error {
+	challenge.ID = challenge.TokenHash[:8]
+	copied := *challenge
+	m.challenges[challenge.TokenHash] = &copied
+	return nil
+}
+
+func (m *memoryStore) GetChallengeByTokenHash(_ context.Context, hash string) (*repository.TwoFactorChallenge, error) {
+	challenge, ok := m.challenges[hash]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *challenge
+	return &copied, nil
+}
+
+func (m *memoryStore) IncrementChallengeAttempts(_ context.Context, id string) (int, error) {
+	for _, challenge := range m.challenges {
+		if challenge.ID == id {
+			challenge.Attempts++
+			return challenge.Attempts, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+
+func (m *memoryStore) ConsumeChallenge(_ context.Context, id string) (bool, error) {
+	for _, challenge := range m.challenges {
+		if challenge.ID == id && challenge.ConsumedAt == nil {
+			now := time.Now()
+			challenge.ConsumedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type memoryAudit struct {
+	actions []string
+}
+
+func (a *memoryAudit) Create(_ context.Context, log *repository.AuditLog) error {
+	a.actions = append(a.actions, log.Action)
+	return nil
+}
+
+func (a *memoryAudit) has(action string) bool {
+	for _, a := range a.actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/smtp"
 	"os"
+	"time"
 )
 
 // EmailService handles email operations
@@ -200,6 +201,312 @@ func (s *EmailService) SendPasswordResetEmail(toEmail, userName, resetToken stri
 	return s.sendEmail(toEmail, subject, htmlBody.String())
 }
 
+// SendLoginCodeEmail sends a passwordless login code together with a magic link
+func (s *EmailService) SendLoginCodeEmail(toEmail, userName, code, linkToken string, ttl time.Duration) error {
+	subject := "Mã đăng nhập - NyNus"
+
+	baseURL := getEnvDefault("FRONTEND_URL", "http://localhost:3000")
+	loginURL := fmt.Sprintf("%s/login/magic?token=%s", baseURL, linkToken)
+
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); color: white; padding: 30px; text-align: center; border-radius: 10px 10px 0 0; }
+        .content { background: #f9f9f9; padding: 30px; border-radius: 0 0 10px 10px; }
+        .code { font-size: 32px; font-weight: bold; letter-spacing: 8px; text-align: center; color: #764ba2; margin: 20px 0; }
+        .button { display: inline-block; padding: 12px 30px; background: #667eea; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { margin-top: 30px; text-align: center; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Đăng nhập NyNus</h1>
+        </div>
+        <div class="content">
+            <h2>Xin chào {{.UserName}}!</h2>
+            <p>Mã đăng nhập của bạn là:</p>
+            <div class="code">{{.Code}}</div>
+
+            <p>Hoặc nhấn vào nút bên dưới để đăng nhập ngay trên thiết bị bạn đã yêu cầu:</p>
+            <div style="text-align: center;">
+                <a href="{{.LoginURL}}" class="button">Đăng nhập</a>
+            </div>
+
+            <p><strong>Lưu ý:</strong> Mã và link chỉ dùng được một lần và sẽ hết hạn sau {{.Minutes}} phút.</p>
+
+            <div class="footer">
+                <p>Nếu bạn không yêu cầu đăng nhập, vui lòng bỏ qua email này.</p>
+                <p>&copy; 2025 NyNus. All rights reserved.</p>
+            </div>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+	tmpl, err := template.New("login_code").Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse email template: %v", err)
+	}
+
+	var htmlBody bytes.Buffer
+	data := struct {
+		UserName string
+		Code     string
+		LoginURL string
+		Minutes  int
+	}{
+		UserName: userName,
+		Code:     code,
+		LoginURL: loginURL,
+		Minutes:  int(ttl.Minutes()),
+	}
+
+	if err := tmpl.Execute(&htmlBody, data); err != nil {
+		return fmt.Errorf("failed to execute email template: %v", err)
+	}
+
+	if s.isDev {
+		log.Printf("[DEV MODE] Login Code Email:\n")
+		log.Printf("  To: %s\n", toEmail)
+		log.Printf("  Subject: %s\n", subject)
+		log.Printf("  Code: %s\n", code)
+		log.Printf("  Login URL: %s\n", loginURL)
+		return nil
+	}
+
+	return s.sendEmail(toEmail, subject, htmlBody.String())
+}
+
+// SendLoginVerificationEmail sends the code that confirms a sign-in flagged as unusual
+func (s *EmailService) SendLoginVerificationEmail(toEmail, userName, code, device, ipAddress string, ttl time.Duration) error {
+	subject := "Xác minh đăng nhập - NyNus"
+
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); color: white; padding: 30px; text-align: center; border-radius: 10px 10px 0 0; }
+        .content { background: #f9f9f9; padding: 30px; border-radius: 0 0 10px 10px; }
+        .code { font-size: 32px; font-weight: bold; letter-spacing: 8px; text-align: center; color: #764ba2; margin: 20px 0; }
+        .warning { background: #fff3cd; border-left: 4px solid #ffc107; padding: 15px; margin: 20px 0; }
+        .footer { margin-top: 30px; text-align: center; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Xác minh đăng nhập</h1>
+        </div>
+        <div class="content">
+            <h2>Xin chào {{.UserName}}!</h2>
+            <p>Chúng tôi nhận thấy một lần đăng nhập bất thường vào tài khoản của bạn:</p>
+            <ul>
+                <li>Thiết bị: {{.Device}}</li>
+                <li>Địa chỉ IP: {{.IPAddress}}</li>
+            </ul>
+            <p>Nếu đó là bạn, hãy nhập mã sau để hoàn tất đăng nhập:</p>
+            <div class="code">{{.Code}}</div>
+
+            <p><strong>Lưu ý:</strong> Mã chỉ dùng được một lần và sẽ hết hạn sau {{.Minutes}} phút.</p>
+
+            <div class="warning">
+                <p>Nếu bạn không đăng nhập, hãy đổi mật khẩu ngay. Mật khẩu của bạn có thể đã bị lộ.</p>
+            </div>
+
+            <div class="footer">
+                <p>&copy; 2025 NyNus. All rights reserved.</p>
+            </div>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+	tmpl, err := template.New("login_verification").Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse email template: %v", err)
+	}
+
+	var htmlBody bytes.Buffer
+	data := struct {
+		UserName  string
+		Code      string
+		Device    string
+		IPAddress string
+		Minutes   int
+	}{
+		UserName:  userName,
+		Code:      code,
+		Device:    device,
+		IPAddress: ipAddress,
+		Minutes:   int(ttl.Minutes()),
+	}
+
+	if err := tmpl.Execute(&htmlBody, data); err != nil {
+		return fmt.Errorf("failed to execute email template: %v", err)
+	}
+
+	if s.isDev {
+		log.Printf("[DEV MODE] Login Verification Email:\n")
+		log.Printf("  To: %s\n", toEmail)
+		log.Printf("  Subject: %s\n", subject)
+		log.Printf("  Code: %s\n", code)
+		log.Printf("  Device: %s, IP: %s\n", device, ipAddress)
+		return nil
+	}
+
+	return s.sendEmail(toEmail, subject, htmlBody.String())
+}
+
+// GuardianDigest is the weekly progress summary sent to a guardian
+type GuardianDigest struct {
+	GuardianName  string
+	StudentName   string
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	FocusMinutes  int
+	CurrentStreak int
+	ExamsTaken    int
+	AverageScore  float64
+	Exams         []GuardianDigestExam
+}
+
+// GuardianDigestExam is one exam result listed in a guardian digest
+type GuardianDigestExam struct {
+	Title       string
+	Percentage  float64
+	Passed      bool
+	SubmittedAt time.Time
+}
+
+// SendGuardianDigestEmail sends the weekly progress digest for a linked student
+func (s *EmailService) SendGuardianDigestEmail(toEmail string, digest GuardianDigest) error {
+	subject := fmt.Sprintf("Tình hình học tập tuần này của %s - NyNus", digest.StudentName)
+
+	baseURL := getEnvDefault("FRONTEND_URL", "http://localhost:3000")
+	progressURL := fmt.Sprintf("%s/guardian", baseURL)
+
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); color: white; padding: 30px; text-align: center; border-radius: 10px 10px 0 0; }
+        .content { background: #f9f9f9; padding: 30px; border-radius: 0 0 10px 10px; }
+        .stats td { padding: 6px 12px; }
+        .button { display: inline-block; padding: 12px 30px; background: #667eea; color: white; text-decoration: none; border-radius: 5px; margin: 20px 0; }
+        .footer { margin-top: 30px; text-align: center; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Báo cáo học tập hàng tuần</h1>
+        </div>
+        <div class="content">
+            <h2>Xin chào {{.GuardianName}}!</h2>
+            <p>Tình hình học tập của <strong>{{.StudentName}}</strong> từ {{.PeriodStart}} đến {{.PeriodEnd}}:</p>
+            <table class="stats">
+                <tr><td>Thời gian tập trung</td><td><strong>{{.FocusMinutes}} phút</strong></td></tr>
+                <tr><td>Chuỗi ngày học</td><td><strong>{{.CurrentStreak}} ngày</strong></td></tr>
+                <tr><td>Số bài thi đã làm</td><td><strong>{{.ExamsTaken}}</strong></td></tr>
+                {{if .ExamsTaken}}<tr><td>Điểm trung bình</td><td><strong>{{.AverageScore}}%</strong></td></tr>{{end}}
+            </table>
+            {{if .Exams}}
+            <h3>Bài thi gần đây</h3>
+            <ul>
+                {{range .Exams}}<li>{{.Title}}: {{.Percentage}}% {{if .Passed}}(đạt){{else}}(chưa đạt){{end}} - {{.SubmittedAt}}</li>{{end}}
+            </ul>
+            {{end}}
+            <div style="text-align: center;">
+                <a href="{{.ProgressURL}}" class="button">Xem chi tiết</a>
+            </div>
+
+            <div class="footer">
+                <p>Bạn nhận được email này vì {{.StudentName}} đã cho phép bạn theo dõi tiến độ học tập. Bạn có thể tắt báo cáo hàng tuần trong trang phụ huynh.</p>
+                <p>&copy; 2025 NyNus. All rights reserved.</p>
+            </div>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+	tmpl, err := template.New("guardian_digest").Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse email template: %v", err)
+	}
+
+	type examRow struct {
+		Title       string
+		Percentage  string
+		Passed      bool
+		SubmittedAt string
+	}
+	exams := make([]examRow, len(digest.Exams))
+	for i, exam := range digest.Exams {
+		exams[i] = examRow{
+			Title:       exam.Title,
+			Percentage:  fmt.Sprintf("%.1f", exam.Percentage),
+			Passed:      exam.Passed,
+			SubmittedAt: exam.SubmittedAt.Format("02/01/2006"),
+		}
+	}
+
+	var htmlBody bytes.Buffer
+	data := struct {
+		GuardianName  string
+		StudentName   string
+		PeriodStart   string
+		PeriodEnd     string
+		FocusMinutes  int
+		CurrentStreak int
+		ExamsTaken    int
+		AverageScore  string
+		Exams         []examRow
+		ProgressURL   string
+	}{
+		GuardianName:  digest.GuardianName,
+		StudentName:   digest.StudentName,
+		PeriodStart:   digest.PeriodStart.Format("02/01/2006"),
+		PeriodEnd:     digest.PeriodEnd.Format("02/01/2006"),
+		FocusMinutes:  digest.FocusMinutes,
+		CurrentStreak: digest.CurrentStreak,
+		ExamsTaken:    digest.ExamsTaken,
+		AverageScore:  fmt.Sprintf("%.1f", digest.AverageScore),
+		Exams:         exams,
+		ProgressURL:   progressURL,
+	}
+
+	if err := tmpl.Execute(&htmlBody, data); err != nil {
+		return fmt.Errorf("failed to execute email template: %v", err)
+	}
+
+	if s.isDev {
+		log.Printf("[DEV MODE] Guardian Digest Email:\n")
+		log.Printf("  To: %s\n", toEmail)
+		log.Printf("  Subject: %s\n", subject)
+		log.Printf("  Focus minutes: %d, exams taken: %d\n", digest.FocusMinutes, digest.ExamsTaken)
+		return nil
+	}
+
+	return s.sendEmail(toEmail, subject, htmlBody.String())
+}
+
 // sendEmail sends email via SMTP
 func (s *EmailService) sendEmail(to, subject, htmlBody string) error {
 	if s.smtpHost == "" || s.smtpPort == "" {
@@ -19,9 +19,9 @@ const (
 
 // File size limits in bytes
 const (
-	MaxPDFSize   = 50 * 1024 * 1024  // 50 MB
-	MaxImageSize = 10 * 1024 * 1024  // 10 MB
-	MaxVideoSize = 500 * 1024 * 1024 // 500 MB
+	MaxPDFSize   = 50 * 1024 * 1024       // 50 MB
+	MaxImageSize = 10 * 1024 * 1024       // 10 MB
+	MaxVideoSize = 4 * 1024 * 1024 * 1024 // 4 GB
 )
 
 // Whitelist of allowed file extensions
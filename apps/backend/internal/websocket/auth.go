@@ -9,7 +9,8 @@ import (
 
 // JWTAuthenticator implements TokenAuthenticator interface for JWT validation.
 type JWTAuthenticator struct {
-	secret []byte
+	secret  []byte
+	keyfunc jwt.Keyfunc
 }
 
 // NewJWTAuthenticator creates a new JWT authenticator.
@@ -19,6 +20,12 @@ func NewJWTAuthenticator(secret string) *JWTAuthenticator {
 	}
 }
 
+// SetKeyfunc makes the authenticator resolve verification keys through the given
+// function, e.g. the JWT service keyring, instead of the shared HMAC secret.
+func (a *JWTAuthenticator) SetKeyfunc(keyfunc jwt.Keyfunc) {
+	a.keyfunc = keyfunc
+}
+
 // ValidateToken validates JWT token and returns user ID and role.
 // Implements task 2.2.4: Validate JWT token.
 func (a *JWTAuthenticator) ValidateToken(tokenString string) (userID string, role string, err error) {
@@ -28,6 +35,10 @@ func (a *JWTAuthenticator) ValidateToken(tokenString string) (userID string, rol
 
 	// Parse token
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if a.keyfunc != nil {
+			return a.keyfunc(token)
+		}
+
 		// Verify signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
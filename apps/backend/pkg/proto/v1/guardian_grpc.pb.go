@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: v1/guardian.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GuardianService_RequestGuardianLink_FullMethodName   = "/v1.GuardianService/RequestGuardianLink"
+	GuardianService_ListLinkedStudents_FullMethodName    = "/v1.GuardianService/ListLinkedStudents"
+	GuardianService_SetGuardianDigest_FullMethodName     = "/v1.GuardianService/SetGuardianDigest"
+	GuardianService_GetStudentExamResults_FullMethodName = "/v1.GuardianService/GetStudentExamResults"
+	GuardianService_GetStudentFocusStats_FullMethodName  = "/v1.GuardianService/GetStudentFocusStats"
+	GuardianService_ListMyGuardians_FullMethodName       = "/v1.GuardianService/ListMyGuardians"
+	GuardianService_RespondGuardianLink_FullMethodName   = "/v1.GuardianService/RespondGuardianLink"
+	GuardianService_RevokeGuardianLink_FullMethodName    = "/v1.GuardianService/RevokeGuardianLink"
+)
+
+// GuardianServiceClient is the client API for GuardianService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuardianServiceClient interface {
+	RequestGuardianLink(ctx context.Context, in *RequestGuardianLinkRequest, opts ...grpc.CallOption) (*RequestGuardianLinkResponse, error)
+	ListLinkedStudents(ctx context.Context, in *ListLinkedStudentsRequest, opts ...grpc.CallOption) (*ListLinkedStudentsResponse, error)
+	SetGuardianDigest(ctx context.Context, in *SetGuardianDigestRequest, opts ...grpc.CallOption) (*SetGuardianDigestResponse, error)
+	GetStudentExamResults(ctx context.Context, in *GetStudentExamResultsRequest, opts ...grpc.CallOption) (*GetStudentExamResultsResponse, error)
+	GetStudentFocusStats(ctx context.Context, in *GetStudentFocusStatsRequest, opts ...grpc.CallOption) (*GetStudentFocusStatsResponse, error)
+	ListMyGuardians(ctx context.Context, in *ListMyGuardiansRequest, opts ...grpc.CallOption) (*ListMyGuardiansResponse, error)
+	RespondGuardianLink(ctx context.Context, in *RespondGuardianLinkRequest, opts ...grpc.CallOption) (*RespondGuardianLinkResponse, error)
+	RevokeGuardianLink(ctx context.Context, in *RevokeGuardianLinkRequest, opts ...grpc.CallOption) (*RevokeGuardianLinkResponse, error)
+}
+
+type guardianServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuardianServiceClient(cc grpc.ClientConnInterface) GuardianServiceClient {
+	return &guardianServiceClient{cc}
+}
+
+func (c *guardianServiceClient) RequestGuardianLink(ctx context.Context, in *RequestGuardianLinkRequest, opts ...grpc.CallOption) (*RequestGuardianLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestGuardianLinkResponse)
+	err := c.cc.Invoke(ctx, GuardianService_RequestGuardianLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListLinkedStudents(ctx context.Context, in *ListLinkedStudentsRequest, opts ...grpc.CallOption) (*ListLinkedStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinkedStudentsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListLinkedStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) SetGuardianDigest(ctx context.Context, in *SetGuardianDigestRequest, opts ...grpc.CallOption) (*SetGuardianDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGuardianDigestResponse)
+	err := c.cc.Invoke(ctx, GuardianService_SetGuardianDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetStudentExamResults(ctx context.Context, in *GetStudentExamResultsRequest, opts ...grpc.CallOption) (*GetStudentExamResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentExamResultsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetStudentExamResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetStudentFocusStats(ctx context.Context, in *GetStudentFocusStatsRequest, opts ...grpc.CallOption) (*GetStudentFocusStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentFocusStatsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetStudentFocusStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListMyGuardians(ctx context.Context, in *ListMyGuardiansRequest, opts ...grpc.CallOption) (*ListMyGuardiansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyGuardiansResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListMyGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) RespondGuardianLink(ctx context.Context, in *RespondGuardianLinkRequest, opts ...grpc.CallOption) (*RespondGuardianLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondGuardianLinkResponse)
+	err := c.cc.Invoke(ctx, GuardianService_RespondGuardianLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) RevokeGuardianLink(ctx context.Context, in *RevokeGuardianLinkRequest, opts ...grpc.CallOption) (*RevokeGuardianLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeGuardianLinkResponse)
+	err := c.cc.Invoke(ctx, GuardianService_RevokeGuardianLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuardianServiceServer is the server API for GuardianService service.
+// All implementations must embed UnimplementedGuardianServiceServer
+// for forward compatibility.
+type GuardianServiceServer interface {
+	RequestGuardianLink(context.Context, *RequestGuardianLinkRequest) (*RequestGuardianLinkResponse, error)
+	ListLinkedStudents(context.Context, *ListLinkedStudentsRequest) (*ListLinkedStudentsResponse, error)
+	SetGuardianDigest(context.Context, *SetGuardianDigestRequest) (*SetGuardianDigestResponse, error)
+	GetStudentExamResults(context.Context, *GetStudentExamResultsRequest) (*GetStudentExamResultsResponse, error)
+	GetStudentFocusStats(context.Context, *GetStudentFocusStatsRequest) (*GetStudentFocusStatsResponse, error)
+	ListMyGuardians(context.Context, *ListMyGuardiansRequest) (*ListMyGuardiansResponse, error)
+	RespondGuardianLink(context.Context, *RespondGuardianLinkRequest) (*RespondGuardianLinkResponse, error)
+	RevokeGuardianLink(context.Context, *RevokeGuardianLinkRequest) (*RevokeGuardianLinkResponse, error)
+	mustEmbedUnimplementedGuardianServiceServer()
+}
+
+// UnimplementedGuardianServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuardianServiceServer struct{}
+
+func (UnimplementedGuardianServiceServer) RequestGuardianLink(context.Context, *RequestGuardianLinkRequest) (*RequestGuardianLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestGuardianLink not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListLinkedStudents(context.Context, *ListLinkedStudentsRequest) (*ListLinkedStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinkedStudents not implemented")
+}
+func (UnimplementedGuardianServiceServer) SetGuardianDigest(context.Context, *SetGuardianDigestRequest) (*SetGuardianDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuardianDigest not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetStudentExamResults(context.Context, *GetStudentExamResultsRequest) (*GetStudentExamResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentExamResults not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetStudentFocusStats(context.Context, *GetStudentFocusStatsRequest) (*GetStudentFocusStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentFocusStats not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListMyGuardians(context.Context, *ListMyGuardiansRequest) (*ListMyGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyGuardians not implemented")
+}
+func (UnimplementedGuardianServiceServer) RespondGuardianLink(context.Context, *RespondGuardianLinkRequest) (*RespondGuardianLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondGuardianLink not implemented")
+}
+func (UnimplementedGuardianServiceServer) RevokeGuardianLink(context.Context, *RevokeGuardianLinkRequest) (*RevokeGuardianLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGuardianLink not implemented")
+}
+func (UnimplementedGuardianServiceServer) mustEmbedUnimplementedGuardianServiceServer() {}
+func (UnimplementedGuardianServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeGuardianServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuardianServiceServer will
+// result in compilation errors.
+type UnsafeGuardianServiceServer interface {
+	mustEmbedUnimplementedGuardianServiceServer()
+}
+
+func RegisterGuardianServiceServer(s grpc.ServiceRegistrar, srv GuardianServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGuardianServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuardianService_ServiceDesc, srv)
+}
+
+func _GuardianService_RequestGuardianLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGuardianLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).RequestGuardianLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_RequestGuardianLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).RequestGuardianLink(ctx, req.(*RequestGuardianLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListLinkedStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkedStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListLinkedStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListLinkedStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListLinkedStudents(ctx, req.(*ListLinkedStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_SetGuardianDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuardianDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).SetGuardianDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_SetGuardianDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).SetGuardianDigest(ctx, req.(*SetGuardianDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetStudentExamResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentExamResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetStudentExamResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetStudentExamResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetStudentExamResults(ctx, req.(*GetStudentExamResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetStudentFocusStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentFocusStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetStudentFocusStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetStudentFocusStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetStudentFocusStats(ctx, req.(*GetStudentFocusStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListMyGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListMyGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListMyGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListMyGuardians(ctx, req.(*ListMyGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_RespondGuardianLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondGuardianLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).RespondGuardianLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_RespondGuardianLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).RespondGuardianLink(ctx, req.(*RespondGuardianLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_RevokeGuardianLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGuardianLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).RevokeGuardianLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_RevokeGuardianLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).RevokeGuardianLink(ctx, req.(*RevokeGuardianLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuardianService_ServiceDesc is the grpc.ServiceDesc for GuardianService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuardianService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.GuardianService",
+	HandlerType: (*GuardianServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestGuardianLink",
+			Handler:    _GuardianService_RequestGuardianLink_Handler,
+		},
+		{
+			MethodName: "ListLinkedStudents",
+			Handler:    _GuardianService_ListLinkedStudents_Handler,
+		},
+		{
+			MethodName: "SetGuardianDigest",
+			Handler:    _GuardianService_SetGuardianDigest_Handler,
+		},
+		{
+			MethodName: "GetStudentExamResults",
+			Handler:    _GuardianService_GetStudentExamResults_Handler,
+		},
+		{
+			MethodName: "GetStudentFocusStats",
+			Handler:    _GuardianService_GetStudentFocusStats_Handler,
+		},
+		{
+			MethodName: "ListMyGuardians",
+			Handler:    _GuardianService_ListMyGuardians_Handler,
+		},
+		{
+			MethodName: "RespondGuardianLink",
+			Handler:    _GuardianService_RespondGuardianLink_Handler,
+		},
+		{
+			MethodName: "RevokeGuardianLink",
+			Handler:    _GuardianService_RevokeGuardianLink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/guardian.proto",
+}
@@ -19,25 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LibraryService_ListItems_FullMethodName            = "/v1.LibraryService/ListItems"
-	LibraryService_GetItem_FullMethodName              = "/v1.LibraryService/GetItem"
-	LibraryService_CreateItem_FullMethodName           = "/v1.LibraryService/CreateItem"
-	LibraryService_UpdateItem_FullMethodName           = "/v1.LibraryService/UpdateItem"
-	LibraryService_ApproveItem_FullMethodName          = "/v1.LibraryService/ApproveItem"
-	LibraryService_RateItem_FullMethodName             = "/v1.LibraryService/RateItem"
-	LibraryService_BookmarkItem_FullMethodName         = "/v1.LibraryService/BookmarkItem"
-	LibraryService_DownloadItem_FullMethodName         = "/v1.LibraryService/DownloadItem"
-	LibraryService_SearchItems_FullMethodName          = "/v1.LibraryService/SearchItems"
-	LibraryService_CreateTag_FullMethodName            = "/v1.LibraryService/CreateTag"
-	LibraryService_GetTag_FullMethodName               = "/v1.LibraryService/GetTag"
-	LibraryService_ListTags_FullMethodName             = "/v1.LibraryService/ListTags"
-	LibraryService_UpdateTag_FullMethodName            = "/v1.LibraryService/UpdateTag"
-	LibraryService_DeleteTag_FullMethodName            = "/v1.LibraryService/DeleteTag"
-	LibraryService_GetPopularTags_FullMethodName       = "/v1.LibraryService/GetPopularTags"
-	LibraryService_GetAnalytics_FullMethodName         = "/v1.LibraryService/GetAnalytics"
-	LibraryService_GetTopDownloaded_FullMethodName     = "/v1.LibraryService/GetTopDownloaded"
-	LibraryService_GetTopRated_FullMethodName          = "/v1.LibraryService/GetTopRated"
-	LibraryService_GetSearchSuggestions_FullMethodName = "/v1.LibraryService/GetSearchSuggestions"
+	LibraryService_ListItems_FullMethodName             = "/v1.LibraryService/ListItems"
+	LibraryService_GetItem_FullMethodName               = "/v1.LibraryService/GetItem"
+	LibraryService_CreateItem_FullMethodName            = "/v1.LibraryService/CreateItem"
+	LibraryService_UpdateItem_FullMethodName            = "/v1.LibraryService/UpdateItem"
+	LibraryService_ApproveItem_FullMethodName           = "/v1.LibraryService/ApproveItem"
+	LibraryService_RateItem_FullMethodName              = "/v1.LibraryService/RateItem"
+	LibraryService_BookmarkItem_FullMethodName          = "/v1.LibraryService/BookmarkItem"
+	LibraryService_DownloadItem_FullMethodName          = "/v1.LibraryService/DownloadItem"
+	LibraryService_SearchItems_FullMethodName           = "/v1.LibraryService/SearchItems"
+	LibraryService_CreateTag_FullMethodName             = "/v1.LibraryService/CreateTag"
+	LibraryService_GetTag_FullMethodName                = "/v1.LibraryService/GetTag"
+	LibraryService_ListTags_FullMethodName              = "/v1.LibraryService/ListTags"
+	LibraryService_UpdateTag_FullMethodName             = "/v1.LibraryService/UpdateTag"
+	LibraryService_DeleteTag_FullMethodName             = "/v1.LibraryService/DeleteTag"
+	LibraryService_GetPopularTags_FullMethodName        = "/v1.LibraryService/GetPopularTags"
+	LibraryService_GetAnalytics_FullMethodName          = "/v1.LibraryService/GetAnalytics"
+	LibraryService_GetTopDownloaded_FullMethodName      = "/v1.LibraryService/GetTopDownloaded"
+	LibraryService_GetTopRated_FullMethodName           = "/v1.LibraryService/GetTopRated"
+	LibraryService_GetSearchSuggestions_FullMethodName  = "/v1.LibraryService/GetSearchSuggestions"
+	LibraryService_RecordSearchClick_FullMethodName     = "/v1.LibraryService/RecordSearchClick"
+	LibraryService_ClearSearchHistory_FullMethodName    = "/v1.LibraryService/ClearSearchHistory"
+	LibraryService_GetSearchReport_FullMethodName       = "/v1.LibraryService/GetSearchReport"
+	LibraryService_GetRecommendedItems_FullMethodName   = "/v1.LibraryService/GetRecommendedItems"
+	LibraryService_GetSimilarItems_FullMethodName       = "/v1.LibraryService/GetSimilarItems"
+	LibraryService_CreateCollection_FullMethodName      = "/v1.LibraryService/CreateCollection"
+	LibraryService_GetCollection_FullMethodName         = "/v1.LibraryService/GetCollection"
+	LibraryService_ListCollections_FullMethodName       = "/v1.LibraryService/ListCollections"
+	LibraryService_UpdateCollection_FullMethodName      = "/v1.LibraryService/UpdateCollection"
+	LibraryService_SetCollectionContent_FullMethodName  = "/v1.LibraryService/SetCollectionContent"
+	LibraryService_AddCollectionItem_FullMethodName     = "/v1.LibraryService/AddCollectionItem"
+	LibraryService_DeleteCollection_FullMethodName      = "/v1.LibraryService/DeleteCollection"
+	LibraryService_CopyCollection_FullMethodName        = "/v1.LibraryService/CopyCollection"
+	LibraryService_SetCollectionProgress_FullMethodName = "/v1.LibraryService/SetCollectionProgress"
+	LibraryService_GetCollectionProgress_FullMethodName = "/v1.LibraryService/GetCollectionProgress"
+	LibraryService_ListItemVersions_FullMethodName      = "/v1.LibraryService/ListItemVersions"
+	LibraryService_ReplaceItemFile_FullMethodName       = "/v1.LibraryService/ReplaceItemFile"
+	LibraryService_RollbackItemVersion_FullMethodName   = "/v1.LibraryService/RollbackItemVersion"
+	LibraryService_ListModerationQueue_FullMethodName   = "/v1.LibraryService/ListModerationQueue"
+	LibraryService_DecideModeration_FullMethodName      = "/v1.LibraryService/DecideModeration"
+	LibraryService_ListModerationReasons_FullMethodName = "/v1.LibraryService/ListModerationReasons"
+	LibraryService_GetModerationWorkload_FullMethodName = "/v1.LibraryService/GetModerationWorkload"
 )
 
 // LibraryServiceClient is the client API for LibraryService service.
@@ -68,6 +90,33 @@ type LibraryServiceClient interface {
 	GetTopRated(ctx context.Context, in *GetTopItemsRequest, opts ...grpc.CallOption) (*TopItemsResponse, error)
 	// Search Suggestions
 	GetSearchSuggestions(ctx context.Context, in *SearchSuggestionsRequest, opts ...grpc.CallOption) (*SearchSuggestionsResponse, error)
+	// Search History
+	RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickResponse, error)
+	ClearSearchHistory(ctx context.Context, in *ClearSearchHistoryRequest, opts ...grpc.CallOption) (*ClearSearchHistoryResponse, error)
+	GetSearchReport(ctx context.Context, in *GetSearchReportRequest, opts ...grpc.CallOption) (*GetSearchReportResponse, error)
+	// Recommendations
+	GetRecommendedItems(ctx context.Context, in *GetRecommendedItemsRequest, opts ...grpc.CallOption) (*GetRecommendedItemsResponse, error)
+	GetSimilarItems(ctx context.Context, in *GetSimilarItemsRequest, opts ...grpc.CallOption) (*GetSimilarItemsResponse, error)
+	// Collections
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	SetCollectionContent(ctx context.Context, in *SetCollectionContentRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	AddCollectionItem(ctx context.Context, in *AddCollectionItemRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	CopyCollection(ctx context.Context, in *CopyCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	SetCollectionProgress(ctx context.Context, in *SetCollectionProgressRequest, opts ...grpc.CallOption) (*SetCollectionProgressResponse, error)
+	GetCollectionProgress(ctx context.Context, in *GetCollectionProgressRequest, opts ...grpc.CallOption) (*GetCollectionProgressResponse, error)
+	// Versions
+	ListItemVersions(ctx context.Context, in *ListItemVersionsRequest, opts ...grpc.CallOption) (*ListItemVersionsResponse, error)
+	ReplaceItemFile(ctx context.Context, in *ReplaceItemFileRequest, opts ...grpc.CallOption) (*ItemVersionResponse, error)
+	RollbackItemVersion(ctx context.Context, in *RollbackItemVersionRequest, opts ...grpc.CallOption) (*ItemVersionResponse, error)
+	// Moderation queue of pending uploads and contributed questions (admins)
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	DecideModeration(ctx context.Context, in *DecideModerationRequest, opts ...grpc.CallOption) (*DecideModerationResponse, error)
+	ListModerationReasons(ctx context.Context, in *ListModerationReasonsRequest, opts ...grpc.CallOption) (*ListModerationReasonsResponse, error)
+	GetModerationWorkload(ctx context.Context, in *GetModerationWorkloadRequest, opts ...grpc.CallOption) (*GetModerationWorkloadResponse, error)
 }
 
 type libraryServiceClient struct {
@@ -268,6 +317,226 @@ func (c *libraryServiceClient) GetSearchSuggestions(ctx context.Context, in *Sea
 	return out, nil
 }
 
+func (c *libraryServiceClient) RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSearchClickResponse)
+	err := c.cc.Invoke(ctx, LibraryService_RecordSearchClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ClearSearchHistory(ctx context.Context, in *ClearSearchHistoryRequest, opts ...grpc.CallOption) (*ClearSearchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearSearchHistoryResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ClearSearchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) GetSearchReport(ctx context.Context, in *GetSearchReportRequest, opts ...grpc.CallOption) (*GetSearchReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchReportResponse)
+	err := c.cc.Invoke(ctx, LibraryService_GetSearchReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) GetRecommendedItems(ctx context.Context, in *GetRecommendedItemsRequest, opts ...grpc.CallOption) (*GetRecommendedItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecommendedItemsResponse)
+	err := c.cc.Invoke(ctx, LibraryService_GetRecommendedItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) GetSimilarItems(ctx context.Context, in *GetSimilarItemsRequest, opts ...grpc.CallOption) (*GetSimilarItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSimilarItemsResponse)
+	err := c.cc.Invoke(ctx, LibraryService_GetSimilarItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) SetCollectionContent(ctx context.Context, in *SetCollectionContentRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_SetCollectionContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) AddCollectionItem(ctx context.Context, in *AddCollectionItemRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_AddCollectionItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) CopyCollection(ctx context.Context, in *CopyCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_CopyCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) SetCollectionProgress(ctx context.Context, in *SetCollectionProgressRequest, opts ...grpc.CallOption) (*SetCollectionProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCollectionProgressResponse)
+	err := c.cc.Invoke(ctx, LibraryService_SetCollectionProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) GetCollectionProgress(ctx context.Context, in *GetCollectionProgressRequest, opts ...grpc.CallOption) (*GetCollectionProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionProgressResponse)
+	err := c.cc.Invoke(ctx, LibraryService_GetCollectionProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListItemVersions(ctx context.Context, in *ListItemVersionsRequest, opts ...grpc.CallOption) (*ListItemVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemVersionsResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListItemVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ReplaceItemFile(ctx context.Context, in *ReplaceItemFileRequest, opts ...grpc.CallOption) (*ItemVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemVersionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ReplaceItemFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) RollbackItemVersion(ctx context.Context, in *RollbackItemVersionRequest, opts ...grpc.CallOption) (*ItemVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemVersionResponse)
+	err := c.cc.Invoke(ctx, LibraryService_RollbackItemVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) DecideModeration(ctx context.Context, in *DecideModerationRequest, opts ...grpc.CallOption) (*DecideModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideModerationResponse)
+	err := c.cc.Invoke(ctx, LibraryService_DecideModeration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) ListModerationReasons(ctx context.Context, in *ListModerationReasonsRequest, opts ...grpc.CallOption) (*ListModerationReasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationReasonsResponse)
+	err := c.cc.Invoke(ctx, LibraryService_ListModerationReasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryServiceClient) GetModerationWorkload(ctx context.Context, in *GetModerationWorkloadRequest, opts ...grpc.CallOption) (*GetModerationWorkloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModerationWorkloadResponse)
+	err := c.cc.Invoke(ctx, LibraryService_GetModerationWorkload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LibraryServiceServer is the server API for LibraryService service.
 // All implementations must embed UnimplementedLibraryServiceServer
 // for forward compatibility.
@@ -296,6 +565,33 @@ type LibraryServiceServer interface {
 	GetTopRated(context.Context, *GetTopItemsRequest) (*TopItemsResponse, error)
 	// Search Suggestions
 	GetSearchSuggestions(context.Context, *SearchSuggestionsRequest) (*SearchSuggestionsResponse, error)
+	// Search History
+	RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickResponse, error)
+	ClearSearchHistory(context.Context, *ClearSearchHistoryRequest) (*ClearSearchHistoryResponse, error)
+	GetSearchReport(context.Context, *GetSearchReportRequest) (*GetSearchReportResponse, error)
+	// Recommendations
+	GetRecommendedItems(context.Context, *GetRecommendedItemsRequest) (*GetRecommendedItemsResponse, error)
+	GetSimilarItems(context.Context, *GetSimilarItemsRequest) (*GetSimilarItemsResponse, error)
+	// Collections
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionResponse, error)
+	GetCollection(context.Context, *GetCollectionRequest) (*CollectionResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionResponse, error)
+	SetCollectionContent(context.Context, *SetCollectionContentRequest) (*CollectionResponse, error)
+	AddCollectionItem(context.Context, *AddCollectionItemRequest) (*CollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	CopyCollection(context.Context, *CopyCollectionRequest) (*CollectionResponse, error)
+	SetCollectionProgress(context.Context, *SetCollectionProgressRequest) (*SetCollectionProgressResponse, error)
+	GetCollectionProgress(context.Context, *GetCollectionProgressRequest) (*GetCollectionProgressResponse, error)
+	// Versions
+	ListItemVersions(context.Context, *ListItemVersionsRequest) (*ListItemVersionsResponse, error)
+	ReplaceItemFile(context.Context, *ReplaceItemFileRequest) (*ItemVersionResponse, error)
+	RollbackItemVersion(context.Context, *RollbackItemVersionRequest) (*ItemVersionResponse, error)
+	// Moderation queue of pending uploads and contributed questions (admins)
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	DecideModeration(context.Context, *DecideModerationRequest) (*DecideModerationResponse, error)
+	ListModerationReasons(context.Context, *ListModerationReasonsRequest) (*ListModerationReasonsResponse, error)
+	GetModerationWorkload(context.Context, *GetModerationWorkloadRequest) (*GetModerationWorkloadResponse, error)
 	mustEmbedUnimplementedLibraryServiceServer()
 }
 
@@ -363,6 +659,72 @@ func (UnimplementedLibraryServiceServer) GetTopRated(context.Context, *GetTopIte
 func (UnimplementedLibraryServiceServer) GetSearchSuggestions(context.Context, *SearchSuggestionsRequest) (*SearchSuggestionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSearchSuggestions not implemented")
 }
+func (UnimplementedLibraryServiceServer) RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSearchClick not implemented")
+}
+func (UnimplementedLibraryServiceServer) ClearSearchHistory(context.Context, *ClearSearchHistoryRequest) (*ClearSearchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSearchHistory not implemented")
+}
+func (UnimplementedLibraryServiceServer) GetSearchReport(context.Context, *GetSearchReportRequest) (*GetSearchReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchReport not implemented")
+}
+func (UnimplementedLibraryServiceServer) GetRecommendedItems(context.Context, *GetRecommendedItemsRequest) (*GetRecommendedItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendedItems not implemented")
+}
+func (UnimplementedLibraryServiceServer) GetSimilarItems(context.Context, *GetSimilarItemsRequest) (*GetSimilarItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilarItems not implemented")
+}
+func (UnimplementedLibraryServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedLibraryServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedLibraryServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedLibraryServiceServer) SetCollectionContent(context.Context, *SetCollectionContentRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionContent not implemented")
+}
+func (UnimplementedLibraryServiceServer) AddCollectionItem(context.Context, *AddCollectionItemRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionItem not implemented")
+}
+func (UnimplementedLibraryServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedLibraryServiceServer) CopyCollection(context.Context, *CopyCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyCollection not implemented")
+}
+func (UnimplementedLibraryServiceServer) SetCollectionProgress(context.Context, *SetCollectionProgressRequest) (*SetCollectionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionProgress not implemented")
+}
+func (UnimplementedLibraryServiceServer) GetCollectionProgress(context.Context, *GetCollectionProgressRequest) (*GetCollectionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionProgress not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListItemVersions(context.Context, *ListItemVersionsRequest) (*ListItemVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemVersions not implemented")
+}
+func (UnimplementedLibraryServiceServer) ReplaceItemFile(context.Context, *ReplaceItemFileRequest) (*ItemVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceItemFile not implemented")
+}
+func (UnimplementedLibraryServiceServer) RollbackItemVersion(context.Context, *RollbackItemVersionRequest) (*ItemVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackItemVersion not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedLibraryServiceServer) DecideModeration(context.Context, *DecideModerationRequest) (*DecideModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideModeration not implemented")
+}
+func (UnimplementedLibraryServiceServer) ListModerationReasons(context.Context, *ListModerationReasonsRequest) (*ListModerationReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationReasons not implemented")
+}
+func (UnimplementedLibraryServiceServer) GetModerationWorkload(context.Context, *GetModerationWorkloadRequest) (*GetModerationWorkloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationWorkload not implemented")
+}
 func (UnimplementedLibraryServiceServer) mustEmbedUnimplementedLibraryServiceServer() {}
 func (UnimplementedLibraryServiceServer) testEmbeddedByValue()                        {}
 
@@ -726,6 +1088,402 @@ func _LibraryService_GetSearchSuggestions_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LibraryService_RecordSearchClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSearchClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).RecordSearchClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_RecordSearchClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).RecordSearchClick(ctx, req.(*RecordSearchClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ClearSearchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSearchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ClearSearchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ClearSearchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ClearSearchHistory(ctx, req.(*ClearSearchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_GetSearchReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).GetSearchReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_GetSearchReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).GetSearchReport(ctx, req.(*GetSearchReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_GetRecommendedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendedItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).GetRecommendedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_GetRecommendedItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).GetRecommendedItems(ctx, req.(*GetRecommendedItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_GetSimilarItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).GetSimilarItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_GetSimilarItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).GetSimilarItems(ctx, req.(*GetSimilarItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_SetCollectionContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).SetCollectionContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_SetCollectionContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).SetCollectionContent(ctx, req.(*SetCollectionContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_AddCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).AddCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_AddCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).AddCollectionItem(ctx, req.(*AddCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_CopyCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).CopyCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_CopyCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).CopyCollection(ctx, req.(*CopyCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_SetCollectionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).SetCollectionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_SetCollectionProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).SetCollectionProgress(ctx, req.(*SetCollectionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_GetCollectionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).GetCollectionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_GetCollectionProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).GetCollectionProgress(ctx, req.(*GetCollectionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListItemVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListItemVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListItemVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListItemVersions(ctx, req.(*ListItemVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ReplaceItemFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceItemFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ReplaceItemFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ReplaceItemFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ReplaceItemFile(ctx, req.(*ReplaceItemFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_RollbackItemVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackItemVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).RollbackItemVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_RollbackItemVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).RollbackItemVersion(ctx, req.(*RollbackItemVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_DecideModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).DecideModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_DecideModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).DecideModeration(ctx, req.(*DecideModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_ListModerationReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationReasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).ListModerationReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_ListModerationReasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).ListModerationReasons(ctx, req.(*ListModerationReasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LibraryService_GetModerationWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServiceServer).GetModerationWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LibraryService_GetModerationWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServiceServer).GetModerationWorkload(ctx, req.(*GetModerationWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LibraryService_ServiceDesc is the grpc.ServiceDesc for LibraryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -809,6 +1567,94 @@ var LibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSearchSuggestions",
 			Handler:    _LibraryService_GetSearchSuggestions_Handler,
 		},
+		{
+			MethodName: "RecordSearchClick",
+			Handler:    _LibraryService_RecordSearchClick_Handler,
+		},
+		{
+			MethodName: "ClearSearchHistory",
+			Handler:    _LibraryService_ClearSearchHistory_Handler,
+		},
+		{
+			MethodName: "GetSearchReport",
+			Handler:    _LibraryService_GetSearchReport_Handler,
+		},
+		{
+			MethodName: "GetRecommendedItems",
+			Handler:    _LibraryService_GetRecommendedItems_Handler,
+		},
+		{
+			MethodName: "GetSimilarItems",
+			Handler:    _LibraryService_GetSimilarItems_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _LibraryService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _LibraryService_GetCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _LibraryService_ListCollections_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _LibraryService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "SetCollectionContent",
+			Handler:    _LibraryService_SetCollectionContent_Handler,
+		},
+		{
+			MethodName: "AddCollectionItem",
+			Handler:    _LibraryService_AddCollectionItem_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _LibraryService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "CopyCollection",
+			Handler:    _LibraryService_CopyCollection_Handler,
+		},
+		{
+			MethodName: "SetCollectionProgress",
+			Handler:    _LibraryService_SetCollectionProgress_Handler,
+		},
+		{
+			MethodName: "GetCollectionProgress",
+			Handler:    _LibraryService_GetCollectionProgress_Handler,
+		},
+		{
+			MethodName: "ListItemVersions",
+			Handler:    _LibraryService_ListItemVersions_Handler,
+		},
+		{
+			MethodName: "ReplaceItemFile",
+			Handler:    _LibraryService_ReplaceItemFile_Handler,
+		},
+		{
+			MethodName: "RollbackItemVersion",
+			Handler:    _LibraryService_RollbackItemVersion_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _LibraryService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "DecideModeration",
+			Handler:    _LibraryService_DecideModeration_Handler,
+		},
+		{
+			MethodName: "ListModerationReasons",
+			Handler:    _LibraryService_ListModerationReasons_Handler,
+		},
+		{
+			MethodName: "GetModerationWorkload",
+			Handler:    _LibraryService_GetModerationWorkload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/library.proto",
@@ -31,6 +31,12 @@ const (
 	MapCodeService_GetMapCodeConfig_FullMethodName       = "/v1.MapCodeService/GetMapCodeConfig"
 	MapCodeService_GetMetrics_FullMethodName             = "/v1.MapCodeService/GetMetrics"
 	MapCodeService_ExportVersion_FullMethodName          = "/v1.MapCodeService/ExportVersion"
+	MapCodeService_GetCoverageReport_FullMethodName      = "/v1.MapCodeService/GetCoverageReport"
+	MapCodeService_ExportCoverageReport_FullMethodName   = "/v1.MapCodeService/ExportCoverageReport"
+	MapCodeService_DiffVersions_FullMethodName           = "/v1.MapCodeService/DiffVersions"
+	MapCodeService_PreviewActivation_FullMethodName      = "/v1.MapCodeService/PreviewActivation"
+	MapCodeService_RemapQuestionCodes_FullMethodName     = "/v1.MapCodeService/RemapQuestionCodes"
+	MapCodeService_ListCodeRemaps_FullMethodName         = "/v1.MapCodeService/ListCodeRemaps"
 )
 
 // MapCodeServiceClient is the client API for MapCodeService service.
@@ -57,6 +63,14 @@ type MapCodeServiceClient interface {
 	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
 	// Export Functionality
 	ExportVersion(ctx context.Context, in *ExportVersionRequest, opts ...grpc.CallOption) (*ExportVersionResponse, error)
+	// Coverage Reporting
+	GetCoverageReport(ctx context.Context, in *GetCoverageReportRequest, opts ...grpc.CallOption) (*GetCoverageReportResponse, error)
+	ExportCoverageReport(ctx context.Context, in *ExportCoverageReportRequest, opts ...grpc.CallOption) (*ExportCoverageReportResponse, error)
+	// Version Migration
+	DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error)
+	PreviewActivation(ctx context.Context, in *PreviewActivationRequest, opts ...grpc.CallOption) (*PreviewActivationResponse, error)
+	RemapQuestionCodes(ctx context.Context, in *RemapQuestionCodesRequest, opts ...grpc.CallOption) (*RemapQuestionCodesResponse, error)
+	ListCodeRemaps(ctx context.Context, in *ListCodeRemapsRequest, opts ...grpc.CallOption) (*ListCodeRemapsResponse, error)
 }
 
 type mapCodeServiceClient struct {
@@ -187,6 +201,66 @@ func (c *mapCodeServiceClient) ExportVersion(ctx context.Context, in *ExportVers
 	return out, nil
 }
 
+func (c *mapCodeServiceClient) GetCoverageReport(ctx context.Context, in *GetCoverageReportRequest, opts ...grpc.CallOption) (*GetCoverageReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoverageReportResponse)
+	err := c.cc.Invoke(ctx, MapCodeService_GetCoverageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapCodeServiceClient) ExportCoverageReport(ctx context.Context, in *ExportCoverageReportRequest, opts ...grpc.CallOption) (*ExportCoverageReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCoverageReportResponse)
+	err := c.cc.Invoke(ctx, MapCodeService_ExportCoverageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapCodeServiceClient) DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffVersionsResponse)
+	err := c.cc.Invoke(ctx, MapCodeService_DiffVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapCodeServiceClient) PreviewActivation(ctx context.Context, in *PreviewActivationRequest, opts ...grpc.CallOption) (*PreviewActivationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewActivationResponse)
+	err := c.cc.Invoke(ctx, MapCodeService_PreviewActivation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapCodeServiceClient) RemapQuestionCodes(ctx context.Context, in *RemapQuestionCodesRequest, opts ...grpc.CallOption) (*RemapQuestionCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemapQuestionCodesResponse)
+	err := c.cc.Invoke(ctx, MapCodeService_RemapQuestionCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapCodeServiceClient) ListCodeRemaps(ctx context.Context, in *ListCodeRemapsRequest, opts ...grpc.CallOption) (*ListCodeRemapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCodeRemapsResponse)
+	err := c.cc.Invoke(ctx, MapCodeService_ListCodeRemaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MapCodeServiceServer is the server API for MapCodeService service.
 // All implementations must embed UnimplementedMapCodeServiceServer
 // for forward compatibility.
@@ -211,6 +285,14 @@ type MapCodeServiceServer interface {
 	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
 	// Export Functionality
 	ExportVersion(context.Context, *ExportVersionRequest) (*ExportVersionResponse, error)
+	// Coverage Reporting
+	GetCoverageReport(context.Context, *GetCoverageReportRequest) (*GetCoverageReportResponse, error)
+	ExportCoverageReport(context.Context, *ExportCoverageReportRequest) (*ExportCoverageReportResponse, error)
+	// Version Migration
+	DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error)
+	PreviewActivation(context.Context, *PreviewActivationRequest) (*PreviewActivationResponse, error)
+	RemapQuestionCodes(context.Context, *RemapQuestionCodesRequest) (*RemapQuestionCodesResponse, error)
+	ListCodeRemaps(context.Context, *ListCodeRemapsRequest) (*ListCodeRemapsResponse, error)
 	mustEmbedUnimplementedMapCodeServiceServer()
 }
 
@@ -257,6 +339,24 @@ func (UnimplementedMapCodeServiceServer) GetMetrics(context.Context, *GetMetrics
 func (UnimplementedMapCodeServiceServer) ExportVersion(context.Context, *ExportVersionRequest) (*ExportVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportVersion not implemented")
 }
+func (UnimplementedMapCodeServiceServer) GetCoverageReport(context.Context, *GetCoverageReportRequest) (*GetCoverageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoverageReport not implemented")
+}
+func (UnimplementedMapCodeServiceServer) ExportCoverageReport(context.Context, *ExportCoverageReportRequest) (*ExportCoverageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCoverageReport not implemented")
+}
+func (UnimplementedMapCodeServiceServer) DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffVersions not implemented")
+}
+func (UnimplementedMapCodeServiceServer) PreviewActivation(context.Context, *PreviewActivationRequest) (*PreviewActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewActivation not implemented")
+}
+func (UnimplementedMapCodeServiceServer) RemapQuestionCodes(context.Context, *RemapQuestionCodesRequest) (*RemapQuestionCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemapQuestionCodes not implemented")
+}
+func (UnimplementedMapCodeServiceServer) ListCodeRemaps(context.Context, *ListCodeRemapsRequest) (*ListCodeRemapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCodeRemaps not implemented")
+}
 func (UnimplementedMapCodeServiceServer) mustEmbedUnimplementedMapCodeServiceServer() {}
 func (UnimplementedMapCodeServiceServer) testEmbeddedByValue()                        {}
 
@@ -494,6 +594,114 @@ func _MapCodeService_ExportVersion_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MapCodeService_GetCoverageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoverageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapCodeServiceServer).GetCoverageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapCodeService_GetCoverageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapCodeServiceServer).GetCoverageReport(ctx, req.(*GetCoverageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapCodeService_ExportCoverageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCoverageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapCodeServiceServer).ExportCoverageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapCodeService_ExportCoverageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapCodeServiceServer).ExportCoverageReport(ctx, req.(*ExportCoverageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapCodeService_DiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapCodeServiceServer).DiffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapCodeService_DiffVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapCodeServiceServer).DiffVersions(ctx, req.(*DiffVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapCodeService_PreviewActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapCodeServiceServer).PreviewActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapCodeService_PreviewActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapCodeServiceServer).PreviewActivation(ctx, req.(*PreviewActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapCodeService_RemapQuestionCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemapQuestionCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapCodeServiceServer).RemapQuestionCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapCodeService_RemapQuestionCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapCodeServiceServer).RemapQuestionCodes(ctx, req.(*RemapQuestionCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapCodeService_ListCodeRemaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCodeRemapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapCodeServiceServer).ListCodeRemaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapCodeService_ListCodeRemaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapCodeServiceServer).ListCodeRemaps(ctx, req.(*ListCodeRemapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MapCodeService_ServiceDesc is the grpc.ServiceDesc for MapCodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -549,6 +757,30 @@ var MapCodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportVersion",
 			Handler:    _MapCodeService_ExportVersion_Handler,
 		},
+		{
+			MethodName: "GetCoverageReport",
+			Handler:    _MapCodeService_GetCoverageReport_Handler,
+		},
+		{
+			MethodName: "ExportCoverageReport",
+			Handler:    _MapCodeService_ExportCoverageReport_Handler,
+		},
+		{
+			MethodName: "DiffVersions",
+			Handler:    _MapCodeService_DiffVersions_Handler,
+		},
+		{
+			MethodName: "PreviewActivation",
+			Handler:    _MapCodeService_PreviewActivation_Handler,
+		},
+		{
+			MethodName: "RemapQuestionCodes",
+			Handler:    _MapCodeService_RemapQuestionCodes_Handler,
+		},
+		{
+			MethodName: "ListCodeRemaps",
+			Handler:    _MapCodeService_ListCodeRemaps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/mapcode.proto",
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: v1/organisation.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrganisationService_CreateOrganisation_FullMethodName       = "/v1.OrganisationService/CreateOrganisation"
+	OrganisationService_ListOrganisations_FullMethodName        = "/v1.OrganisationService/ListOrganisations"
+	OrganisationService_GetOrganisation_FullMethodName          = "/v1.OrganisationService/GetOrganisation"
+	OrganisationService_ListOrganisationMembers_FullMethodName  = "/v1.OrganisationService/ListOrganisationMembers"
+	OrganisationService_SetOrganisationMember_FullMethodName    = "/v1.OrganisationService/SetOrganisationMember"
+	OrganisationService_RemoveOrganisationMember_FullMethodName = "/v1.OrganisationService/RemoveOrganisationMember"
+	OrganisationService_AssignToOrganisation_FullMethodName     = "/v1.OrganisationService/AssignToOrganisation"
+	OrganisationService_CreateClass_FullMethodName              = "/v1.OrganisationService/CreateClass"
+	OrganisationService_ListClasses_FullMethodName              = "/v1.OrganisationService/ListClasses"
+	OrganisationService_GetClass_FullMethodName                 = "/v1.OrganisationService/GetClass"
+	OrganisationService_ResetClassJoinCode_FullMethodName       = "/v1.OrganisationService/ResetClassJoinCode"
+	OrganisationService_JoinClass_FullMethodName                = "/v1.OrganisationService/JoinClass"
+	OrganisationService_GetClassRoster_FullMethodName           = "/v1.OrganisationService/GetClassRoster"
+	OrganisationService_AddClassMember_FullMethodName           = "/v1.OrganisationService/AddClassMember"
+	OrganisationService_RemoveClassMember_FullMethodName        = "/v1.OrganisationService/RemoveClassMember"
+	OrganisationService_ImportClassRoster_FullMethodName        = "/v1.OrganisationService/ImportClassRoster"
+)
+
+// OrganisationServiceClient is the client API for OrganisationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrganisationServiceClient interface {
+	CreateOrganisation(ctx context.Context, in *CreateOrganisationRequest, opts ...grpc.CallOption) (*CreateOrganisationResponse, error)
+	ListOrganisations(ctx context.Context, in *ListOrganisationsRequest, opts ...grpc.CallOption) (*ListOrganisationsResponse, error)
+	GetOrganisation(ctx context.Context, in *GetOrganisationRequest, opts ...grpc.CallOption) (*GetOrganisationResponse, error)
+	ListOrganisationMembers(ctx context.Context, in *ListOrganisationMembersRequest, opts ...grpc.CallOption) (*ListOrganisationMembersResponse, error)
+	SetOrganisationMember(ctx context.Context, in *SetOrganisationMemberRequest, opts ...grpc.CallOption) (*SetOrganisationMemberResponse, error)
+	RemoveOrganisationMember(ctx context.Context, in *RemoveOrganisationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganisationMemberResponse, error)
+	AssignToOrganisation(ctx context.Context, in *AssignToOrganisationRequest, opts ...grpc.CallOption) (*AssignToOrganisationResponse, error)
+	CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*CreateClassResponse, error)
+	ListClasses(ctx context.Context, in *ListClassesRequest, opts ...grpc.CallOption) (*ListClassesResponse, error)
+	GetClass(ctx context.Context, in *GetClassRequest, opts ...grpc.CallOption) (*GetClassResponse, error)
+	ResetClassJoinCode(ctx context.Context, in *ResetClassJoinCodeRequest, opts ...grpc.CallOption) (*ResetClassJoinCodeResponse, error)
+	JoinClass(ctx context.Context, in *JoinClassRequest, opts ...grpc.CallOption) (*JoinClassResponse, error)
+	GetClassRoster(ctx context.Context, in *GetClassRosterRequest, opts ...grpc.CallOption) (*GetClassRosterResponse, error)
+	AddClassMember(ctx context.Context, in *AddClassMemberRequest, opts ...grpc.CallOption) (*AddClassMemberResponse, error)
+	RemoveClassMember(ctx context.Context, in *RemoveClassMemberRequest, opts ...grpc.CallOption) (*RemoveClassMemberResponse, error)
+	ImportClassRoster(ctx context.Context, in *ImportClassRosterRequest, opts ...grpc.CallOption) (*ImportClassRosterResponse, error)
+}
+
+type organisationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrganisationServiceClient(cc grpc.ClientConnInterface) OrganisationServiceClient {
+	return &organisationServiceClient{cc}
+}
+
+func (c *organisationServiceClient) CreateOrganisation(ctx context.Context, in *CreateOrganisationRequest, opts ...grpc.CallOption) (*CreateOrganisationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganisationResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_CreateOrganisation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) ListOrganisations(ctx context.Context, in *ListOrganisationsRequest, opts ...grpc.CallOption) (*ListOrganisationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganisationsResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_ListOrganisations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) GetOrganisation(ctx context.Context, in *GetOrganisationRequest, opts ...grpc.CallOption) (*GetOrganisationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganisationResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_GetOrganisation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) ListOrganisationMembers(ctx context.Context, in *ListOrganisationMembersRequest, opts ...grpc.CallOption) (*ListOrganisationMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganisationMembersResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_ListOrganisationMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) SetOrganisationMember(ctx context.Context, in *SetOrganisationMemberRequest, opts ...grpc.CallOption) (*SetOrganisationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrganisationMemberResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_SetOrganisationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) RemoveOrganisationMember(ctx context.Context, in *RemoveOrganisationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganisationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrganisationMemberResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_RemoveOrganisationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) AssignToOrganisation(ctx context.Context, in *AssignToOrganisationRequest, opts ...grpc.CallOption) (*AssignToOrganisationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignToOrganisationResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_AssignToOrganisation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*CreateClassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClassResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_CreateClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) ListClasses(ctx context.Context, in *ListClassesRequest, opts ...grpc.CallOption) (*ListClassesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClassesResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_ListClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) GetClass(ctx context.Context, in *GetClassRequest, opts ...grpc.CallOption) (*GetClassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_GetClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) ResetClassJoinCode(ctx context.Context, in *ResetClassJoinCodeRequest, opts ...grpc.CallOption) (*ResetClassJoinCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetClassJoinCodeResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_ResetClassJoinCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) JoinClass(ctx context.Context, in *JoinClassRequest, opts ...grpc.CallOption) (*JoinClassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinClassResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_JoinClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) GetClassRoster(ctx context.Context, in *GetClassRosterRequest, opts ...grpc.CallOption) (*GetClassRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassRosterResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_GetClassRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) AddClassMember(ctx context.Context, in *AddClassMemberRequest, opts ...grpc.CallOption) (*AddClassMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddClassMemberResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_AddClassMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) RemoveClassMember(ctx context.Context, in *RemoveClassMemberRequest, opts ...grpc.CallOption) (*RemoveClassMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveClassMemberResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_RemoveClassMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organisationServiceClient) ImportClassRoster(ctx context.Context, in *ImportClassRosterRequest, opts ...grpc.CallOption) (*ImportClassRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportClassRosterResponse)
+	err := c.cc.Invoke(ctx, OrganisationService_ImportClassRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganisationServiceServer is the server API for OrganisationService service.
+// All implementations must embed UnimplementedOrganisationServiceServer
+// for forward compatibility.
+type OrganisationServiceServer interface {
+	CreateOrganisation(context.Context, *CreateOrganisationRequest) (*CreateOrganisationResponse, error)
+	ListOrganisations(context.Context, *ListOrganisationsRequest) (*ListOrganisationsResponse, error)
+	GetOrganisation(context.Context, *GetOrganisationRequest) (*GetOrganisationResponse, error)
+	ListOrganisationMembers(context.Context, *ListOrganisationMembersRequest) (*ListOrganisationMembersResponse, error)
+	SetOrganisationMember(context.Context, *SetOrganisationMemberRequest) (*SetOrganisationMemberResponse, error)
+	RemoveOrganisationMember(context.Context, *RemoveOrganisationMemberRequest) (*RemoveOrganisationMemberResponse, error)
+	AssignToOrganisation(context.Context, *AssignToOrganisationRequest) (*AssignToOrganisationResponse, error)
+	CreateClass(context.Context, *CreateClassRequest) (*CreateClassResponse, error)
+	ListClasses(context.Context, *ListClassesRequest) (*ListClassesResponse, error)
+	GetClass(context.Context, *GetClassRequest) (*GetClassResponse, error)
+	ResetClassJoinCode(context.Context, *ResetClassJoinCodeRequest) (*ResetClassJoinCodeResponse, error)
+	JoinClass(context.Context, *JoinClassRequest) (*JoinClassResponse, error)
+	GetClassRoster(context.Context, *GetClassRosterRequest) (*GetClassRosterResponse, error)
+	AddClassMember(context.Context, *AddClassMemberRequest) (*AddClassMemberResponse, error)
+	RemoveClassMember(context.Context, *RemoveClassMemberRequest) (*RemoveClassMemberResponse, error)
+	ImportClassRoster(context.Context, *ImportClassRosterRequest) (*ImportClassRosterResponse, error)
+	mustEmbedUnimplementedOrganisationServiceServer()
+}
+
+// UnimplementedOrganisationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrganisationServiceServer struct{}
+
+func (UnimplementedOrganisationServiceServer) CreateOrganisation(context.Context, *CreateOrganisationRequest) (*CreateOrganisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganisation not implemented")
+}
+func (UnimplementedOrganisationServiceServer) ListOrganisations(context.Context, *ListOrganisationsRequest) (*ListOrganisationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganisations not implemented")
+}
+func (UnimplementedOrganisationServiceServer) GetOrganisation(context.Context, *GetOrganisationRequest) (*GetOrganisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganisation not implemented")
+}
+func (UnimplementedOrganisationServiceServer) ListOrganisationMembers(context.Context, *ListOrganisationMembersRequest) (*ListOrganisationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganisationMembers not implemented")
+}
+func (UnimplementedOrganisationServiceServer) SetOrganisationMember(context.Context, *SetOrganisationMemberRequest) (*SetOrganisationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganisationMember not implemented")
+}
+func (UnimplementedOrganisationServiceServer) RemoveOrganisationMember(context.Context, *RemoveOrganisationMemberRequest) (*RemoveOrganisationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganisationMember not implemented")
+}
+func (UnimplementedOrganisationServiceServer) AssignToOrganisation(context.Context, *AssignToOrganisationRequest) (*AssignToOrganisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignToOrganisation not implemented")
+}
+func (UnimplementedOrganisationServiceServer) CreateClass(context.Context, *CreateClassRequest) (*CreateClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClass not implemented")
+}
+func (UnimplementedOrganisationServiceServer) ListClasses(context.Context, *ListClassesRequest) (*ListClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClasses not implemented")
+}
+func (UnimplementedOrganisationServiceServer) GetClass(context.Context, *GetClassRequest) (*GetClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClass not implemented")
+}
+func (UnimplementedOrganisationServiceServer) ResetClassJoinCode(context.Context, *ResetClassJoinCodeRequest) (*ResetClassJoinCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetClassJoinCode not implemented")
+}
+func (UnimplementedOrganisationServiceServer) JoinClass(context.Context, *JoinClassRequest) (*JoinClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinClass not implemented")
+}
+func (UnimplementedOrganisationServiceServer) GetClassRoster(context.Context, *GetClassRosterRequest) (*GetClassRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassRoster not implemented")
+}
+func (UnimplementedOrganisationServiceServer) AddClassMember(context.Context, *AddClassMemberRequest) (*AddClassMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClassMember not implemented")
+}
+func (UnimplementedOrganisationServiceServer) RemoveClassMember(context.Context, *RemoveClassMemberRequest) (*RemoveClassMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClassMember not implemented")
+}
+func (UnimplementedOrganisationServiceServer) ImportClassRoster(context.Context, *ImportClassRosterRequest) (*ImportClassRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportClassRoster not implemented")
+}
+func (UnimplementedOrganisationServiceServer) mustEmbedUnimplementedOrganisationServiceServer() {}
+func (UnimplementedOrganisationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeOrganisationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganisationServiceServer will
+// result in compilation errors.
+type UnsafeOrganisationServiceServer interface {
+	mustEmbedUnimplementedOrganisationServiceServer()
+}
+
+func RegisterOrganisationServiceServer(s grpc.ServiceRegistrar, srv OrganisationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrganisationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrganisationService_ServiceDesc, srv)
+}
+
+func _OrganisationService_CreateOrganisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).CreateOrganisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_CreateOrganisation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).CreateOrganisation(ctx, req.(*CreateOrganisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_ListOrganisations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganisationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).ListOrganisations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_ListOrganisations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).ListOrganisations(ctx, req.(*ListOrganisationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_GetOrganisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).GetOrganisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_GetOrganisation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).GetOrganisation(ctx, req.(*GetOrganisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_ListOrganisationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganisationMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).ListOrganisationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_ListOrganisationMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).ListOrganisationMembers(ctx, req.(*ListOrganisationMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_SetOrganisationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganisationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).SetOrganisationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_SetOrganisationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).SetOrganisationMember(ctx, req.(*SetOrganisationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_RemoveOrganisationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganisationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).RemoveOrganisationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_RemoveOrganisationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).RemoveOrganisationMember(ctx, req.(*RemoveOrganisationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_AssignToOrganisation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignToOrganisationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).AssignToOrganisation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_AssignToOrganisation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).AssignToOrganisation(ctx, req.(*AssignToOrganisationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_CreateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).CreateClass(ctx, req.(*CreateClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_ListClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).ListClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_ListClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).ListClasses(ctx, req.(*ListClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_GetClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).GetClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_GetClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).GetClass(ctx, req.(*GetClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_ResetClassJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetClassJoinCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).ResetClassJoinCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_ResetClassJoinCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).ResetClassJoinCode(ctx, req.(*ResetClassJoinCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_JoinClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).JoinClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_JoinClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).JoinClass(ctx, req.(*JoinClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_GetClassRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).GetClassRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_GetClassRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).GetClassRoster(ctx, req.(*GetClassRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_AddClassMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddClassMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).AddClassMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_AddClassMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).AddClassMember(ctx, req.(*AddClassMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_RemoveClassMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveClassMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).RemoveClassMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_RemoveClassMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).RemoveClassMember(ctx, req.(*RemoveClassMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganisationService_ImportClassRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportClassRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganisationServiceServer).ImportClassRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganisationService_ImportClassRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganisationServiceServer).ImportClassRoster(ctx, req.(*ImportClassRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrganisationService_ServiceDesc is the grpc.ServiceDesc for OrganisationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrganisationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.OrganisationService",
+	HandlerType: (*OrganisationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrganisation",
+			Handler:    _OrganisationService_CreateOrganisation_Handler,
+		},
+		{
+			MethodName: "ListOrganisations",
+			Handler:    _OrganisationService_ListOrganisations_Handler,
+		},
+		{
+			MethodName: "GetOrganisation",
+			Handler:    _OrganisationService_GetOrganisation_Handler,
+		},
+		{
+			MethodName: "ListOrganisationMembers",
+			Handler:    _OrganisationService_ListOrganisationMembers_Handler,
+		},
+		{
+			MethodName: "SetOrganisationMember",
+			Handler:    _OrganisationService_SetOrganisationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganisationMember",
+			Handler:    _OrganisationService_RemoveOrganisationMember_Handler,
+		},
+		{
+			MethodName: "AssignToOrganisation",
+			Handler:    _OrganisationService_AssignToOrganisation_Handler,
+		},
+		{
+			MethodName: "CreateClass",
+			Handler:    _OrganisationService_CreateClass_Handler,
+		},
+		{
+			MethodName: "ListClasses",
+			Handler:    _OrganisationService_ListClasses_Handler,
+		},
+		{
+			MethodName: "GetClass",
+			Handler:    _OrganisationService_GetClass_Handler,
+		},
+		{
+			MethodName: "ResetClassJoinCode",
+			Handler:    _OrganisationService_ResetClassJoinCode_Handler,
+		},
+		{
+			MethodName: "JoinClass",
+			Handler:    _OrganisationService_JoinClass_Handler,
+		},
+		{
+			MethodName: "GetClassRoster",
+			Handler:    _OrganisationService_GetClassRoster_Handler,
+		},
+		{
+			MethodName: "AddClassMember",
+			Handler:    _OrganisationService_AddClassMember_Handler,
+		},
+		{
+			MethodName: "RemoveClassMember",
+			Handler:    _OrganisationService_RemoveClassMember_Handler,
+		},
+		{
+			MethodName: "ImportClassRoster",
+			Handler:    _OrganisationService_ImportClassRoster_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/organisation.proto",
+}
@@ -19,13 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProfileService_GetProfile_FullMethodName           = "/v1.ProfileService/GetProfile"
-	ProfileService_UpdateProfile_FullMethodName        = "/v1.ProfileService/UpdateProfile"
-	ProfileService_GetSessions_FullMethodName          = "/v1.ProfileService/GetSessions"
-	ProfileService_TerminateSession_FullMethodName     = "/v1.ProfileService/TerminateSession"
-	ProfileService_TerminateAllSessions_FullMethodName = "/v1.ProfileService/TerminateAllSessions"
-	ProfileService_GetPreferences_FullMethodName       = "/v1.ProfileService/GetPreferences"
-	ProfileService_UpdatePreferences_FullMethodName    = "/v1.ProfileService/UpdatePreferences"
+	ProfileService_GetProfile_FullMethodName               = "/v1.ProfileService/GetProfile"
+	ProfileService_UpdateProfile_FullMethodName            = "/v1.ProfileService/UpdateProfile"
+	ProfileService_GetSessions_FullMethodName              = "/v1.ProfileService/GetSessions"
+	ProfileService_TerminateSession_FullMethodName         = "/v1.ProfileService/TerminateSession"
+	ProfileService_TerminateAllSessions_FullMethodName     = "/v1.ProfileService/TerminateAllSessions"
+	ProfileService_GetPreferences_FullMethodName           = "/v1.ProfileService/GetPreferences"
+	ProfileService_UpdatePreferences_FullMethodName        = "/v1.ProfileService/UpdatePreferences"
+	ProfileService_RequestDataExport_FullMethodName        = "/v1.ProfileService/RequestDataExport"
+	ProfileService_ListDataExports_FullMethodName          = "/v1.ProfileService/ListDataExports"
+	ProfileService_DownloadDataExport_FullMethodName       = "/v1.ProfileService/DownloadDataExport"
+	ProfileService_DeleteAccount_FullMethodName            = "/v1.ProfileService/DeleteAccount"
+	ProfileService_CancelAccountDeletion_FullMethodName    = "/v1.ProfileService/CancelAccountDeletion"
+	ProfileService_GetAccountDeletionStatus_FullMethodName = "/v1.ProfileService/GetAccountDeletionStatus"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -41,6 +47,12 @@ type ProfileServiceClient interface {
 	TerminateAllSessions(ctx context.Context, in *TerminateAllSessionsRequest, opts ...grpc.CallOption) (*TerminateAllSessionsResponse, error)
 	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
 	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	ListDataExports(ctx context.Context, in *ListDataExportsRequest, opts ...grpc.CallOption) (*ListDataExportsResponse, error)
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (*DownloadDataExportResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error)
+	GetAccountDeletionStatus(ctx context.Context, in *GetAccountDeletionStatusRequest, opts ...grpc.CallOption) (*GetAccountDeletionStatusResponse, error)
 }
 
 type profileServiceClient struct {
@@ -121,6 +133,66 @@ func (c *profileServiceClient) UpdatePreferences(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *profileServiceClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListDataExports(ctx context.Context, in *ListDataExportsRequest, opts ...grpc.CallOption) (*ListDataExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDataExportsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListDataExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (*DownloadDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadDataExportResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DownloadDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CancelAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetAccountDeletionStatus(ctx context.Context, in *GetAccountDeletionStatusRequest, opts ...grpc.CallOption) (*GetAccountDeletionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountDeletionStatusResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetAccountDeletionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
@@ -134,6 +206,12 @@ type ProfileServiceServer interface {
 	TerminateAllSessions(context.Context, *TerminateAllSessionsRequest) (*TerminateAllSessionsResponse, error)
 	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
 	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	ListDataExports(context.Context, *ListDataExportsRequest) (*ListDataExportsResponse, error)
+	DownloadDataExport(context.Context, *DownloadDataExportRequest) (*DownloadDataExportResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest) (*CancelAccountDeletionResponse, error)
+	GetAccountDeletionStatus(context.Context, *GetAccountDeletionStatusRequest) (*GetAccountDeletionStatusResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -165,6 +243,24 @@ func (UnimplementedProfileServiceServer) GetPreferences(context.Context, *GetPre
 func (UnimplementedProfileServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
 }
+func (UnimplementedProfileServiceServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedProfileServiceServer) ListDataExports(context.Context, *ListDataExportsRequest) (*ListDataExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDataExports not implemented")
+}
+func (UnimplementedProfileServiceServer) DownloadDataExport(context.Context, *DownloadDataExportRequest) (*DownloadDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedProfileServiceServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest) (*CancelAccountDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedProfileServiceServer) GetAccountDeletionStatus(context.Context, *GetAccountDeletionStatusRequest) (*GetAccountDeletionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDeletionStatus not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -312,6 +408,114 @@ func _ProfileService_UpdatePreferences_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListDataExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDataExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListDataExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListDataExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListDataExports(ctx, req.(*ListDataExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DownloadDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DownloadDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DownloadDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DownloadDataExport(ctx, req.(*DownloadDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetAccountDeletionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetAccountDeletionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetAccountDeletionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetAccountDeletionStatus(ctx, req.(*GetAccountDeletionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +551,30 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePreferences",
 			Handler:    _ProfileService_UpdatePreferences_Handler,
 		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _ProfileService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "ListDataExports",
+			Handler:    _ProfileService_ListDataExports_Handler,
+		},
+		{
+			MethodName: "DownloadDataExport",
+			Handler:    _ProfileService_DownloadDataExport_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _ProfileService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _ProfileService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "GetAccountDeletionStatus",
+			Handler:    _ProfileService_GetAccountDeletionStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/profile.proto",
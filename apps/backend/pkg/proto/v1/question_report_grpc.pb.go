@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: v1/question_report.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuestionReportService_ReportQuestionError_FullMethodName      = "/v1.QuestionReportService/ReportQuestionError"
+	QuestionReportService_ListReportQueue_FullMethodName          = "/v1.QuestionReportService/ListReportQueue"
+	QuestionReportService_ListQuestionErrorReports_FullMethodName = "/v1.QuestionReportService/ListQuestionErrorReports"
+	QuestionReportService_AcknowledgeErrorReports_FullMethodName  = "/v1.QuestionReportService/AcknowledgeErrorReports"
+	QuestionReportService_ResolveErrorReports_FullMethodName      = "/v1.QuestionReportService/ResolveErrorReports"
+	QuestionReportService_DismissErrorReports_FullMethodName      = "/v1.QuestionReportService/DismissErrorReports"
+	QuestionReportService_SuspendQuestion_FullMethodName          = "/v1.QuestionReportService/SuspendQuestion"
+	QuestionReportService_LiftQuestionSuspension_FullMethodName   = "/v1.QuestionReportService/LiftQuestionSuspension"
+)
+
+// QuestionReportServiceClient is the client API for QuestionReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuestionReportServiceClient interface {
+	// Report an error in a question (students)
+	ReportQuestionError(ctx context.Context, in *ReportQuestionErrorRequest, opts ...grpc.CallOption) (*ReportQuestionErrorResponse, error)
+	// Triage queue: questions with active reports, most credible first
+	ListReportQueue(ctx context.Context, in *ListReportQueueRequest, opts ...grpc.CallOption) (*ListReportQueueResponse, error)
+	// List the reports filed against a question
+	ListQuestionErrorReports(ctx context.Context, in *ListQuestionErrorReportsRequest, opts ...grpc.CallOption) (*ListQuestionErrorReportsResponse, error)
+	// Mark the open reports of a question as being worked on
+	AcknowledgeErrorReports(ctx context.Context, in *AcknowledgeErrorReportsRequest, opts ...grpc.CallOption) (*AcknowledgeErrorReportsResponse, error)
+	// Resolve the reports after fixing the question; re-grades attempts when the answer key was fixed
+	ResolveErrorReports(ctx context.Context, in *ResolveErrorReportsRequest, opts ...grpc.CallOption) (*ResolveErrorReportsResponse, error)
+	// Dismiss the reports without changes
+	DismissErrorReports(ctx context.Context, in *DismissErrorReportsRequest, opts ...grpc.CallOption) (*DismissErrorReportsResponse, error)
+	// Manually suspend a question from new exams
+	SuspendQuestion(ctx context.Context, in *SuspendQuestionRequest, opts ...grpc.CallOption) (*SuspendQuestionResponse, error)
+	// Lift a question suspension
+	LiftQuestionSuspension(ctx context.Context, in *LiftQuestionSuspensionRequest, opts ...grpc.CallOption) (*LiftQuestionSuspensionResponse, error)
+}
+
+type questionReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionReportServiceClient(cc grpc.ClientConnInterface) QuestionReportServiceClient {
+	return &questionReportServiceClient{cc}
+}
+
+func (c *questionReportServiceClient) ReportQuestionError(ctx context.Context, in *ReportQuestionErrorRequest, opts ...grpc.CallOption) (*ReportQuestionErrorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportQuestionErrorResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_ReportQuestionError_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) ListReportQueue(ctx context.Context, in *ListReportQueueRequest, opts ...grpc.CallOption) (*ListReportQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportQueueResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_ListReportQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) ListQuestionErrorReports(ctx context.Context, in *ListQuestionErrorReportsRequest, opts ...grpc.CallOption) (*ListQuestionErrorReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionErrorReportsResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_ListQuestionErrorReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) AcknowledgeErrorReports(ctx context.Context, in *AcknowledgeErrorReportsRequest, opts ...grpc.CallOption) (*AcknowledgeErrorReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeErrorReportsResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_AcknowledgeErrorReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) ResolveErrorReports(ctx context.Context, in *ResolveErrorReportsRequest, opts ...grpc.CallOption) (*ResolveErrorReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveErrorReportsResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_ResolveErrorReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) DismissErrorReports(ctx context.Context, in *DismissErrorReportsRequest, opts ...grpc.CallOption) (*DismissErrorReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissErrorReportsResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_DismissErrorReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) SuspendQuestion(ctx context.Context, in *SuspendQuestionRequest, opts ...grpc.CallOption) (*SuspendQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendQuestionResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_SuspendQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReportServiceClient) LiftQuestionSuspension(ctx context.Context, in *LiftQuestionSuspensionRequest, opts ...grpc.CallOption) (*LiftQuestionSuspensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LiftQuestionSuspensionResponse)
+	err := c.cc.Invoke(ctx, QuestionReportService_LiftQuestionSuspension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuestionReportServiceServer is the server API for QuestionReportService service.
+// All implementations must embed UnimplementedQuestionReportServiceServer
+// for forward compatibility.
+type QuestionReportServiceServer interface {
+	// Report an error in a question (students)
+	ReportQuestionError(context.Context, *ReportQuestionErrorRequest) (*ReportQuestionErrorResponse, error)
+	// Triage queue: questions with active reports, most credible first
+	ListReportQueue(context.Context, *ListReportQueueRequest) (*ListReportQueueResponse, error)
+	// List the reports filed against a question
+	ListQuestionErrorReports(context.Context, *ListQuestionErrorReportsRequest) (*ListQuestionErrorReportsResponse, error)
+	// Mark the open reports of a question as being worked on
+	AcknowledgeErrorReports(context.Context, *AcknowledgeErrorReportsRequest) (*AcknowledgeErrorReportsResponse, error)
+	// Resolve the reports after fixing the question; re-grades attempts when the answer key was fixed
+	ResolveErrorReports(context.Context, *ResolveErrorReportsRequest) (*ResolveErrorReportsResponse, error)
+	// Dismiss the reports without changes
+	DismissErrorReports(context.Context, *DismissErrorReportsRequest) (*DismissErrorReportsResponse, error)
+	// Manually suspend a question from new exams
+	SuspendQuestion(context.Context, *SuspendQuestionRequest) (*SuspendQuestionResponse, error)
+	// Lift a question suspension
+	LiftQuestionSuspension(context.Context, *LiftQuestionSuspensionRequest) (*LiftQuestionSuspensionResponse, error)
+	mustEmbedUnimplementedQuestionReportServiceServer()
+}
+
+// UnimplementedQuestionReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuestionReportServiceServer struct{}
+
+func (UnimplementedQuestionReportServiceServer) ReportQuestionError(context.Context, *ReportQuestionErrorRequest) (*ReportQuestionErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportQuestionError not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) ListReportQueue(context.Context, *ListReportQueueRequest) (*ListReportQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportQueue not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) ListQuestionErrorReports(context.Context, *ListQuestionErrorReportsRequest) (*ListQuestionErrorReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestionErrorReports not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) AcknowledgeErrorReports(context.Context, *AcknowledgeErrorReportsRequest) (*AcknowledgeErrorReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeErrorReports not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) ResolveErrorReports(context.Context, *ResolveErrorReportsRequest) (*ResolveErrorReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveErrorReports not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) DismissErrorReports(context.Context, *DismissErrorReportsRequest) (*DismissErrorReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissErrorReports not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) SuspendQuestion(context.Context, *SuspendQuestionRequest) (*SuspendQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendQuestion not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) LiftQuestionSuspension(context.Context, *LiftQuestionSuspensionRequest) (*LiftQuestionSuspensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftQuestionSuspension not implemented")
+}
+func (UnimplementedQuestionReportServiceServer) mustEmbedUnimplementedQuestionReportServiceServer() {}
+func (UnimplementedQuestionReportServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeQuestionReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuestionReportServiceServer will
+// result in compilation errors.
+type UnsafeQuestionReportServiceServer interface {
+	mustEmbedUnimplementedQuestionReportServiceServer()
+}
+
+func RegisterQuestionReportServiceServer(s grpc.ServiceRegistrar, srv QuestionReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuestionReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuestionReportService_ServiceDesc, srv)
+}
+
+func _QuestionReportService_ReportQuestionError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportQuestionErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).ReportQuestionError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_ReportQuestionError_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).ReportQuestionError(ctx, req.(*ReportQuestionErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_ListReportQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).ListReportQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_ListReportQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).ListReportQueue(ctx, req.(*ListReportQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_ListQuestionErrorReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionErrorReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).ListQuestionErrorReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_ListQuestionErrorReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).ListQuestionErrorReports(ctx, req.(*ListQuestionErrorReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_AcknowledgeErrorReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeErrorReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).AcknowledgeErrorReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_AcknowledgeErrorReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).AcknowledgeErrorReports(ctx, req.(*AcknowledgeErrorReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_ResolveErrorReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveErrorReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).ResolveErrorReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_ResolveErrorReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).ResolveErrorReports(ctx, req.(*ResolveErrorReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_DismissErrorReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissErrorReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).DismissErrorReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_DismissErrorReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).DismissErrorReports(ctx, req.(*DismissErrorReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_SuspendQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).SuspendQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_SuspendQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).SuspendQuestion(ctx, req.(*SuspendQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReportService_LiftQuestionSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftQuestionSuspensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReportServiceServer).LiftQuestionSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReportService_LiftQuestionSuspension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReportServiceServer).LiftQuestionSuspension(ctx, req.(*LiftQuestionSuspensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuestionReportService_ServiceDesc is the grpc.ServiceDesc for QuestionReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuestionReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.QuestionReportService",
+	HandlerType: (*QuestionReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportQuestionError",
+			Handler:    _QuestionReportService_ReportQuestionError_Handler,
+		},
+		{
+			MethodName: "ListReportQueue",
+			Handler:    _QuestionReportService_ListReportQueue_Handler,
+		},
+		{
+			MethodName: "ListQuestionErrorReports",
+			Handler:    _QuestionReportService_ListQuestionErrorReports_Handler,
+		},
+		{
+			MethodName: "AcknowledgeErrorReports",
+			Handler:    _QuestionReportService_AcknowledgeErrorReports_Handler,
+		},
+		{
+			MethodName: "ResolveErrorReports",
+			Handler:    _QuestionReportService_ResolveErrorReports_Handler,
+		},
+		{
+			MethodName: "DismissErrorReports",
+			Handler:    _QuestionReportService_DismissErrorReports_Handler,
+		},
+		{
+			MethodName: "SuspendQuestion",
+			Handler:    _QuestionReportService_SuspendQuestion_Handler,
+		},
+		{
+			MethodName: "LiftQuestionSuspension",
+			Handler:    _QuestionReportService_LiftQuestionSuspension_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/question_report.proto",
+}
@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: v1/question_review.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuestionReviewService_SubmitQuestionForReview_FullMethodName = "/v1.QuestionReviewService/SubmitQuestionForReview"
+	QuestionReviewService_AssignReviewer_FullMethodName          = "/v1.QuestionReviewService/AssignReviewer"
+	QuestionReviewService_SubmitReviewDecision_FullMethodName    = "/v1.QuestionReviewService/SubmitReviewDecision"
+	QuestionReviewService_GetReview_FullMethodName               = "/v1.QuestionReviewService/GetReview"
+	QuestionReviewService_ListQuestionReviews_FullMethodName     = "/v1.QuestionReviewService/ListQuestionReviews"
+	QuestionReviewService_AddReviewComment_FullMethodName        = "/v1.QuestionReviewService/AddReviewComment"
+	QuestionReviewService_ListReviewComments_FullMethodName      = "/v1.QuestionReviewService/ListReviewComments"
+	QuestionReviewService_ResolveReviewComment_FullMethodName    = "/v1.QuestionReviewService/ResolveReviewComment"
+	QuestionReviewService_GetReviewerDashboard_FullMethodName    = "/v1.QuestionReviewService/GetReviewerDashboard"
+	QuestionReviewService_SetReviewerSubjects_FullMethodName     = "/v1.QuestionReviewService/SetReviewerSubjects"
+	QuestionReviewService_ListReviewerSubjects_FullMethodName    = "/v1.QuestionReviewService/ListReviewerSubjects"
+)
+
+// QuestionReviewServiceClient is the client API for QuestionReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuestionReviewServiceClient interface {
+	// Submit a question for review (or resubmit after changes were requested)
+	SubmitQuestionForReview(ctx context.Context, in *SubmitQuestionForReviewRequest, opts ...grpc.CallOption) (*SubmitQuestionForReviewResponse, error)
+	// Assign or reassign the reviewer of an open review
+	AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*AssignReviewerResponse, error)
+	// Approve, reject or request changes on a review
+	SubmitReviewDecision(ctx context.Context, in *SubmitReviewDecisionRequest, opts ...grpc.CallOption) (*SubmitReviewDecisionResponse, error)
+	// Get a single review round
+	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error)
+	// List the review history of a question
+	ListQuestionReviews(ctx context.Context, in *ListQuestionReviewsRequest, opts ...grpc.CallOption) (*ListQuestionReviewsResponse, error)
+	// Add an inline comment or a reply to a comment thread
+	AddReviewComment(ctx context.Context, in *AddReviewCommentRequest, opts ...grpc.CallOption) (*AddReviewCommentResponse, error)
+	// List all comments of a review
+	ListReviewComments(ctx context.Context, in *ListReviewCommentsRequest, opts ...grpc.CallOption) (*ListReviewCommentsResponse, error)
+	// Resolve or reopen a comment thread
+	ResolveReviewComment(ctx context.Context, in *ResolveReviewCommentRequest, opts ...grpc.CallOption) (*ResolveReviewCommentResponse, error)
+	// Reviewer dashboard: outstanding reviews and recent activity
+	GetReviewerDashboard(ctx context.Context, in *GetReviewerDashboardRequest, opts ...grpc.CallOption) (*GetReviewerDashboardResponse, error)
+	// Replace the subjects a reviewer is responsible for (admin only)
+	SetReviewerSubjects(ctx context.Context, in *SetReviewerSubjectsRequest, opts ...grpc.CallOption) (*SetReviewerSubjectsResponse, error)
+	// List the subjects a reviewer is responsible for
+	ListReviewerSubjects(ctx context.Context, in *ListReviewerSubjectsRequest, opts ...grpc.CallOption) (*ListReviewerSubjectsResponse, error)
+}
+
+type questionReviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionReviewServiceClient(cc grpc.ClientConnInterface) QuestionReviewServiceClient {
+	return &questionReviewServiceClient{cc}
+}
+
+func (c *questionReviewServiceClient) SubmitQuestionForReview(ctx context.Context, in *SubmitQuestionForReviewRequest, opts ...grpc.CallOption) (*SubmitQuestionForReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitQuestionForReviewResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_SubmitQuestionForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*AssignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignReviewerResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_AssignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) SubmitReviewDecision(ctx context.Context, in *SubmitReviewDecisionRequest, opts ...grpc.CallOption) (*SubmitReviewDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitReviewDecisionResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_SubmitReviewDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) ListQuestionReviews(ctx context.Context, in *ListQuestionReviewsRequest, opts ...grpc.CallOption) (*ListQuestionReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionReviewsResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_ListQuestionReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) AddReviewComment(ctx context.Context, in *AddReviewCommentRequest, opts ...grpc.CallOption) (*AddReviewCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReviewCommentResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_AddReviewComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) ListReviewComments(ctx context.Context, in *ListReviewCommentsRequest, opts ...grpc.CallOption) (*ListReviewCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewCommentsResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_ListReviewComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) ResolveReviewComment(ctx context.Context, in *ResolveReviewCommentRequest, opts ...grpc.CallOption) (*ResolveReviewCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReviewCommentResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_ResolveReviewComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) GetReviewerDashboard(ctx context.Context, in *GetReviewerDashboardRequest, opts ...grpc.CallOption) (*GetReviewerDashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewerDashboardResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_GetReviewerDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) SetReviewerSubjects(ctx context.Context, in *SetReviewerSubjectsRequest, opts ...grpc.CallOption) (*SetReviewerSubjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReviewerSubjectsResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_SetReviewerSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionReviewServiceClient) ListReviewerSubjects(ctx context.Context, in *ListReviewerSubjectsRequest, opts ...grpc.CallOption) (*ListReviewerSubjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewerSubjectsResponse)
+	err := c.cc.Invoke(ctx, QuestionReviewService_ListReviewerSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuestionReviewServiceServer is the server API for QuestionReviewService service.
+// All implementations must embed UnimplementedQuestionReviewServiceServer
+// for forward compatibility.
+type QuestionReviewServiceServer interface {
+	// Submit a question for review (or resubmit after changes were requested)
+	SubmitQuestionForReview(context.Context, *SubmitQuestionForReviewRequest) (*SubmitQuestionForReviewResponse, error)
+	// Assign or reassign the reviewer of an open review
+	AssignReviewer(context.Context, *AssignReviewerRequest) (*AssignReviewerResponse, error)
+	// Approve, reject or request changes on a review
+	SubmitReviewDecision(context.Context, *SubmitReviewDecisionRequest) (*SubmitReviewDecisionResponse, error)
+	// Get a single review round
+	GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error)
+	// List the review history of a question
+	ListQuestionReviews(context.Context, *ListQuestionReviewsRequest) (*ListQuestionReviewsResponse, error)
+	// Add an inline comment or a reply to a comment thread
+	AddReviewComment(context.Context, *AddReviewCommentRequest) (*AddReviewCommentResponse, error)
+	// List all comments of a review
+	ListReviewComments(context.Context, *ListReviewCommentsRequest) (*ListReviewCommentsResponse, error)
+	// Resolve or reopen a comment thread
+	ResolveReviewComment(context.Context, *ResolveReviewCommentRequest) (*ResolveReviewCommentResponse, error)
+	// Reviewer dashboard: outstanding reviews and recent activity
+	GetReviewerDashboard(context.Context, *GetReviewerDashboardRequest) (*GetReviewerDashboardResponse, error)
+	// Replace the subjects a reviewer is responsible for (admin only)
+	SetReviewerSubjects(context.Context, *SetReviewerSubjectsRequest) (*SetReviewerSubjectsResponse, error)
+	// List the subjects a reviewer is responsible for
+	ListReviewerSubjects(context.Context, *ListReviewerSubjectsRequest) (*ListReviewerSubjectsResponse, error)
+	mustEmbedUnimplementedQuestionReviewServiceServer()
+}
+
+// UnimplementedQuestionReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuestionReviewServiceServer struct{}
+
+func (UnimplementedQuestionReviewServiceServer) SubmitQuestionForReview(context.Context, *SubmitQuestionForReviewRequest) (*SubmitQuestionForReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuestionForReview not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) AssignReviewer(context.Context, *AssignReviewerRequest) (*AssignReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignReviewer not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) SubmitReviewDecision(context.Context, *SubmitReviewDecisionRequest) (*SubmitReviewDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReviewDecision not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) ListQuestionReviews(context.Context, *ListQuestionReviewsRequest) (*ListQuestionReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestionReviews not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) AddReviewComment(context.Context, *AddReviewCommentRequest) (*AddReviewCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReviewComment not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) ListReviewComments(context.Context, *ListReviewCommentsRequest) (*ListReviewCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewComments not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) ResolveReviewComment(context.Context, *ResolveReviewCommentRequest) (*ResolveReviewCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReviewComment not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) GetReviewerDashboard(context.Context, *GetReviewerDashboardRequest) (*GetReviewerDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerDashboard not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) SetReviewerSubjects(context.Context, *SetReviewerSubjectsRequest) (*SetReviewerSubjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReviewerSubjects not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) ListReviewerSubjects(context.Context, *ListReviewerSubjectsRequest) (*ListReviewerSubjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewerSubjects not implemented")
+}
+func (UnimplementedQuestionReviewServiceServer) mustEmbedUnimplementedQuestionReviewServiceServer() {}
+func (UnimplementedQuestionReviewServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeQuestionReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuestionReviewServiceServer will
+// result in compilation errors.
+type UnsafeQuestionReviewServiceServer interface {
+	mustEmbedUnimplementedQuestionReviewServiceServer()
+}
+
+func RegisterQuestionReviewServiceServer(s grpc.ServiceRegistrar, srv QuestionReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuestionReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuestionReviewService_ServiceDesc, srv)
+}
+
+func _QuestionReviewService_SubmitQuestionForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuestionForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).SubmitQuestionForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_SubmitQuestionForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).SubmitQuestionForReview(ctx, req.(*SubmitQuestionForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_AssignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).AssignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_AssignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).AssignReviewer(ctx, req.(*AssignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_SubmitReviewDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).SubmitReviewDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_SubmitReviewDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).SubmitReviewDecision(ctx, req.(*SubmitReviewDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).GetReview(ctx, req.(*GetReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_ListQuestionReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).ListQuestionReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_ListQuestionReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).ListQuestionReviews(ctx, req.(*ListQuestionReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_AddReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReviewCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).AddReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_AddReviewComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).AddReviewComment(ctx, req.(*AddReviewCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_ListReviewComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).ListReviewComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_ListReviewComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).ListReviewComments(ctx, req.(*ListReviewCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_ResolveReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReviewCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).ResolveReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_ResolveReviewComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).ResolveReviewComment(ctx, req.(*ResolveReviewCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_GetReviewerDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewerDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).GetReviewerDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_GetReviewerDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).GetReviewerDashboard(ctx, req.(*GetReviewerDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_SetReviewerSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReviewerSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).SetReviewerSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_SetReviewerSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).SetReviewerSubjects(ctx, req.(*SetReviewerSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionReviewService_ListReviewerSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewerSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionReviewServiceServer).ListReviewerSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionReviewService_ListReviewerSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionReviewServiceServer).ListReviewerSubjects(ctx, req.(*ListReviewerSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuestionReviewService_ServiceDesc is the grpc.ServiceDesc for QuestionReviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuestionReviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.QuestionReviewService",
+	HandlerType: (*QuestionReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitQuestionForReview",
+			Handler:    _QuestionReviewService_SubmitQuestionForReview_Handler,
+		},
+		{
+			MethodName: "AssignReviewer",
+			Handler:    _QuestionReviewService_AssignReviewer_Handler,
+		},
+		{
+			MethodName: "SubmitReviewDecision",
+			Handler:    _QuestionReviewService_SubmitReviewDecision_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _QuestionReviewService_GetReview_Handler,
+		},
+		{
+			MethodName: "ListQuestionReviews",
+			Handler:    _QuestionReviewService_ListQuestionReviews_Handler,
+		},
+		{
+			MethodName: "AddReviewComment",
+			Handler:    _QuestionReviewService_AddReviewComment_Handler,
+		},
+		{
+			MethodName: "ListReviewComments",
+			Handler:    _QuestionReviewService_ListReviewComments_Handler,
+		},
+		{
+			MethodName: "ResolveReviewComment",
+			Handler:    _QuestionReviewService_ResolveReviewComment_Handler,
+		},
+		{
+			MethodName: "GetReviewerDashboard",
+			Handler:    _QuestionReviewService_GetReviewerDashboard_Handler,
+		},
+		{
+			MethodName: "SetReviewerSubjects",
+			Handler:    _QuestionReviewService_SetReviewerSubjects_Handler,
+		},
+		{
+			MethodName: "ListReviewerSubjects",
+			Handler:    _QuestionReviewService_ListReviewerSubjects_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/question_review.proto",
+}
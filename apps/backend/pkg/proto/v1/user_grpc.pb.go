@@ -19,19 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Login_FullMethodName                 = "/v1.UserService/Login"
-	UserService_GoogleLogin_FullMethodName           = "/v1.UserService/GoogleLogin"
-	UserService_RefreshToken_FullMethodName          = "/v1.UserService/RefreshToken"
-	UserService_VerifyEmail_FullMethodName           = "/v1.UserService/VerifyEmail"
-	UserService_SendVerificationEmail_FullMethodName = "/v1.UserService/SendVerificationEmail"
-	UserService_ForgotPassword_FullMethodName        = "/v1.UserService/ForgotPassword"
-	UserService_ResetPassword_FullMethodName         = "/v1.UserService/ResetPassword"
-	UserService_Register_FullMethodName              = "/v1.UserService/Register"
-	UserService_GetUser_FullMethodName               = "/v1.UserService/GetUser"
-	UserService_ListUsers_FullMethodName             = "/v1.UserService/ListUsers"
-	UserService_GetStudentList_FullMethodName        = "/v1.UserService/GetStudentList"
-	UserService_GetCurrentUser_FullMethodName        = "/v1.UserService/GetCurrentUser"
-	UserService_UpdateUser_FullMethodName            = "/v1.UserService/UpdateUser"
+	UserService_Login_FullMethodName                      = "/v1.UserService/Login"
+	UserService_GoogleLogin_FullMethodName                = "/v1.UserService/GoogleLogin"
+	UserService_RefreshToken_FullMethodName               = "/v1.UserService/RefreshToken"
+	UserService_VerifyEmail_FullMethodName                = "/v1.UserService/VerifyEmail"
+	UserService_SendVerificationEmail_FullMethodName      = "/v1.UserService/SendVerificationEmail"
+	UserService_ForgotPassword_FullMethodName             = "/v1.UserService/ForgotPassword"
+	UserService_ResetPassword_FullMethodName              = "/v1.UserService/ResetPassword"
+	UserService_Register_FullMethodName                   = "/v1.UserService/Register"
+	UserService_GetUser_FullMethodName                    = "/v1.UserService/GetUser"
+	UserService_ListUsers_FullMethodName                  = "/v1.UserService/ListUsers"
+	UserService_GetStudentList_FullMethodName             = "/v1.UserService/GetStudentList"
+	UserService_GetCurrentUser_FullMethodName             = "/v1.UserService/GetCurrentUser"
+	UserService_UpdateUser_FullMethodName                 = "/v1.UserService/UpdateUser"
+	UserService_VerifyTwoFactorLogin_FullMethodName       = "/v1.UserService/VerifyTwoFactorLogin"
+	UserService_GetTwoFactorStatus_FullMethodName         = "/v1.UserService/GetTwoFactorStatus"
+	UserService_BeginTwoFactorEnrollment_FullMethodName   = "/v1.UserService/BeginTwoFactorEnrollment"
+	UserService_ConfirmTwoFactorEnrollment_FullMethodName = "/v1.UserService/ConfirmTwoFactorEnrollment"
+	UserService_DisableTwoFactor_FullMethodName           = "/v1.UserService/DisableTwoFactor"
+	UserService_RegenerateRecoveryCodes_FullMethodName    = "/v1.UserService/RegenerateRecoveryCodes"
+	UserService_ResetUserTwoFactor_FullMethodName         = "/v1.UserService/ResetUserTwoFactor"
+	UserService_RequestLoginCode_FullMethodName           = "/v1.UserService/RequestLoginCode"
+	UserService_VerifyLoginCode_FullMethodName            = "/v1.UserService/VerifyLoginCode"
+	UserService_ListOIDCProviders_FullMethodName          = "/v1.UserService/ListOIDCProviders"
+	UserService_StartOIDCLogin_FullMethodName             = "/v1.UserService/StartOIDCLogin"
+	UserService_OIDCLogin_FullMethodName                  = "/v1.UserService/OIDCLogin"
+	UserService_ListLinkedAccounts_FullMethodName         = "/v1.UserService/ListLinkedAccounts"
+	UserService_LinkOIDCAccount_FullMethodName            = "/v1.UserService/LinkOIDCAccount"
+	UserService_UnlinkOIDCAccount_FullMethodName          = "/v1.UserService/UnlinkOIDCAccount"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -53,6 +68,26 @@ type UserServiceClient interface {
 	GetStudentList(ctx context.Context, in *GetStudentListRequest, opts ...grpc.CallOption) (*GetStudentListResponse, error)
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	// Two-factor authentication
+	VerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusResponse, error)
+	BeginTwoFactorEnrollment(ctx context.Context, in *BeginTwoFactorEnrollmentRequest, opts ...grpc.CallOption) (*BeginTwoFactorEnrollmentResponse, error)
+	ConfirmTwoFactorEnrollment(ctx context.Context, in *ConfirmTwoFactorEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorEnrollmentResponse, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	// Admin: remove a user's factor so they can enroll again
+	ResetUserTwoFactor(ctx context.Context, in *ResetUserTwoFactorRequest, opts ...grpc.CallOption) (*ResetUserTwoFactorResponse, error)
+	// Passwordless login: emails a one-time code and magic link, then logs in like Login
+	RequestLoginCode(ctx context.Context, in *RequestLoginCodeRequest, opts ...grpc.CallOption) (*RequestLoginCodeResponse, error)
+	VerifyLoginCode(ctx context.Context, in *VerifyLoginCodeRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Generic OIDC login and account linking
+	ListOIDCProviders(ctx context.Context, in *ListOIDCProvidersRequest, opts ...grpc.CallOption) (*ListOIDCProvidersResponse, error)
+	// Issues the state and nonce of an authorization request; both are checked by OIDCLogin
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	ListLinkedAccounts(ctx context.Context, in *ListLinkedAccountsRequest, opts ...grpc.CallOption) (*ListLinkedAccountsResponse, error)
+	LinkOIDCAccount(ctx context.Context, in *LinkOIDCAccountRequest, opts ...grpc.CallOption) (*LinkOIDCAccountResponse, error)
+	UnlinkOIDCAccount(ctx context.Context, in *UnlinkOIDCAccountRequest, opts ...grpc.CallOption) (*UnlinkOIDCAccountResponse, error)
 }
 
 type userServiceClient struct {
@@ -193,6 +228,156 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) VerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyTwoFactorLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusRequest, opts ...grpc.CallOption) (*GetTwoFactorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTwoFactorStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTwoFactorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginTwoFactorEnrollment(ctx context.Context, in *BeginTwoFactorEnrollmentRequest, opts ...grpc.CallOption) (*BeginTwoFactorEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTwoFactorEnrollmentResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginTwoFactorEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmTwoFactorEnrollment(ctx context.Context, in *ConfirmTwoFactorEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorEnrollmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorEnrollmentResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmTwoFactorEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, UserService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResetUserTwoFactor(ctx context.Context, in *ResetUserTwoFactorRequest, opts ...grpc.CallOption) (*ResetUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_ResetUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestLoginCode(ctx context.Context, in *RequestLoginCodeRequest, opts ...grpc.CallOption) (*RequestLoginCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestLoginCodeResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyLoginCode(ctx context.Context, in *VerifyLoginCodeRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListOIDCProviders(ctx context.Context, in *ListOIDCProvidersRequest, opts ...grpc.CallOption) (*ListOIDCProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOIDCProvidersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListOIDCProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, UserService_StartOIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, UserService_OIDCLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListLinkedAccounts(ctx context.Context, in *ListLinkedAccountsRequest, opts ...grpc.CallOption) (*ListLinkedAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinkedAccountsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListLinkedAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LinkOIDCAccount(ctx context.Context, in *LinkOIDCAccountRequest, opts ...grpc.CallOption) (*LinkOIDCAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkOIDCAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_LinkOIDCAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlinkOIDCAccount(ctx context.Context, in *UnlinkOIDCAccountRequest, opts ...grpc.CallOption) (*UnlinkOIDCAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkOIDCAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlinkOIDCAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -212,6 +397,26 @@ type UserServiceServer interface {
 	GetStudentList(context.Context, *GetStudentListRequest) (*GetStudentListResponse, error)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// Two-factor authentication
+	VerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginRequest) (*LoginResponse, error)
+	GetTwoFactorStatus(context.Context, *GetTwoFactorStatusRequest) (*GetTwoFactorStatusResponse, error)
+	BeginTwoFactorEnrollment(context.Context, *BeginTwoFactorEnrollmentRequest) (*BeginTwoFactorEnrollmentResponse, error)
+	ConfirmTwoFactorEnrollment(context.Context, *ConfirmTwoFactorEnrollmentRequest) (*ConfirmTwoFactorEnrollmentResponse, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	// Admin: remove a user's factor so they can enroll again
+	ResetUserTwoFactor(context.Context, *ResetUserTwoFactorRequest) (*ResetUserTwoFactorResponse, error)
+	// Passwordless login: emails a one-time code and magic link, then logs in like Login
+	RequestLoginCode(context.Context, *RequestLoginCodeRequest) (*RequestLoginCodeResponse, error)
+	VerifyLoginCode(context.Context, *VerifyLoginCodeRequest) (*LoginResponse, error)
+	// Generic OIDC login and account linking
+	ListOIDCProviders(context.Context, *ListOIDCProvidersRequest) (*ListOIDCProvidersResponse, error)
+	// Issues the state and nonce of an authorization request; both are checked by OIDCLogin
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error)
+	OIDCLogin(context.Context, *OIDCLoginRequest) (*LoginResponse, error)
+	ListLinkedAccounts(context.Context, *ListLinkedAccountsRequest) (*ListLinkedAccountsResponse, error)
+	LinkOIDCAccount(context.Context, *LinkOIDCAccountRequest) (*LinkOIDCAccountResponse, error)
+	UnlinkOIDCAccount(context.Context, *UnlinkOIDCAccountRequest) (*UnlinkOIDCAccountResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -261,6 +466,51 @@ func (UnimplementedUserServiceServer) GetCurrentUser(context.Context, *GetCurren
 func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedUserServiceServer) VerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactorLogin not implemented")
+}
+func (UnimplementedUserServiceServer) GetTwoFactorStatus(context.Context, *GetTwoFactorStatusRequest) (*GetTwoFactorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactorStatus not implemented")
+}
+func (UnimplementedUserServiceServer) BeginTwoFactorEnrollment(context.Context, *BeginTwoFactorEnrollmentRequest) (*BeginTwoFactorEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTwoFactorEnrollment not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmTwoFactorEnrollment(context.Context, *ConfirmTwoFactorEnrollmentRequest) (*ConfirmTwoFactorEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactorEnrollment not implemented")
+}
+func (UnimplementedUserServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedUserServiceServer) ResetUserTwoFactor(context.Context, *ResetUserTwoFactorRequest) (*ResetUserTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) RequestLoginCode(context.Context, *RequestLoginCodeRequest) (*RequestLoginCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLoginCode not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyLoginCode(context.Context, *VerifyLoginCodeRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLoginCode not implemented")
+}
+func (UnimplementedUserServiceServer) ListOIDCProviders(context.Context, *ListOIDCProvidersRequest) (*ListOIDCProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOIDCProviders not implemented")
+}
+func (UnimplementedUserServiceServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedUserServiceServer) OIDCLogin(context.Context, *OIDCLoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCLogin not implemented")
+}
+func (UnimplementedUserServiceServer) ListLinkedAccounts(context.Context, *ListLinkedAccountsRequest) (*ListLinkedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinkedAccounts not implemented")
+}
+func (UnimplementedUserServiceServer) LinkOIDCAccount(context.Context, *LinkOIDCAccountRequest) (*LinkOIDCAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOIDCAccount not implemented")
+}
+func (UnimplementedUserServiceServer) UnlinkOIDCAccount(context.Context, *UnlinkOIDCAccountRequest) (*UnlinkOIDCAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkOIDCAccount not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -516,6 +766,276 @@ func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_VerifyTwoFactorLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyTwoFactorLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyTwoFactorLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyTwoFactorLogin(ctx, req.(*VerifyTwoFactorLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTwoFactorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTwoFactorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTwoFactorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTwoFactorStatus(ctx, req.(*GetTwoFactorStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginTwoFactorEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTwoFactorEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginTwoFactorEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginTwoFactorEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginTwoFactorEnrollment(ctx, req.(*BeginTwoFactorEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmTwoFactorEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmTwoFactorEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmTwoFactorEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmTwoFactorEnrollment(ctx, req.(*ConfirmTwoFactorEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetUserTwoFactor(ctx, req.(*ResetUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestLoginCode(ctx, req.(*RequestLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyLoginCode(ctx, req.(*VerifyLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOIDCProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOIDCProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOIDCProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListOIDCProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOIDCProviders(ctx, req.(*ListOIDCProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartOIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_OIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).OIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_OIDCLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).OIDCLogin(ctx, req.(*OIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListLinkedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListLinkedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListLinkedAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListLinkedAccounts(ctx, req.(*ListLinkedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LinkOIDCAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkOIDCAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LinkOIDCAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LinkOIDCAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LinkOIDCAccount(ctx, req.(*LinkOIDCAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnlinkOIDCAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkOIDCAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlinkOIDCAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlinkOIDCAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlinkOIDCAccount(ctx, req.(*UnlinkOIDCAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -575,6 +1095,66 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _UserService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "VerifyTwoFactorLogin",
+			Handler:    _UserService_VerifyTwoFactorLogin_Handler,
+		},
+		{
+			MethodName: "GetTwoFactorStatus",
+			Handler:    _UserService_GetTwoFactorStatus_Handler,
+		},
+		{
+			MethodName: "BeginTwoFactorEnrollment",
+			Handler:    _UserService_BeginTwoFactorEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactorEnrollment",
+			Handler:    _UserService_ConfirmTwoFactorEnrollment_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _UserService_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _UserService_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "ResetUserTwoFactor",
+			Handler:    _UserService_ResetUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "RequestLoginCode",
+			Handler:    _UserService_RequestLoginCode_Handler,
+		},
+		{
+			MethodName: "VerifyLoginCode",
+			Handler:    _UserService_VerifyLoginCode_Handler,
+		},
+		{
+			MethodName: "ListOIDCProviders",
+			Handler:    _UserService_ListOIDCProviders_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _UserService_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "OIDCLogin",
+			Handler:    _UserService_OIDCLogin_Handler,
+		},
+		{
+			MethodName: "ListLinkedAccounts",
+			Handler:    _UserService_ListLinkedAccounts_Handler,
+		},
+		{
+			MethodName: "LinkOIDCAccount",
+			Handler:    _UserService_LinkOIDCAccount_Handler,
+		},
+		{
+			MethodName: "UnlinkOIDCAccount",
+			Handler:    _UserService_UnlinkOIDCAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/user.proto",